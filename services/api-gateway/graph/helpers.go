@@ -1,13 +1,28 @@
 package graph
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"strconv"
 
 	"github.com/damarteplok/damar-admin-cms/services/api-gateway/graph/model"
+	"github.com/damarteplok/damar-admin-cms/services/api-gateway/internal/middleware"
+	authPb "github.com/damarteplok/damar-admin-cms/shared/proto/auth"
 	mediaPb "github.com/damarteplok/damar-admin-cms/shared/proto/media"
+	productPb "github.com/damarteplok/damar-admin-cms/shared/proto/product"
+	tenantPb "github.com/damarteplok/damar-admin-cms/shared/proto/tenant"
+	"github.com/damarteplok/damar-admin-cms/shared/tenancy"
 	"github.com/damarteplok/damar-admin-cms/shared/util"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+// maxGeneratedDiscountCodes matches product-service's limit per
+// generateDiscountCodes call
+const maxGeneratedDiscountCodes = 50000
+
 // Helper function to convert protobuf media to GraphQL model
 func pbMediaToModel(m *mediaPb.Media) *model.Media {
 	if m == nil {
@@ -60,3 +75,389 @@ func pbMediaToModel(m *mediaPb.Media) *model.Media {
 		UpdatedAt: int32(m.UpdatedAt),
 	}
 }
+
+// Helper function to convert protobuf product price to GraphQL model
+func pbProductPriceToModel(p *productPb.ProductPrice) *model.ProductPrice {
+	return &model.ProductPrice{
+		ID:         fmt.Sprintf("%d", p.Id),
+		ProductID:  fmt.Sprintf("%d", p.ProductId),
+		CurrencyID: fmt.Sprintf("%d", p.CurrencyId),
+		Price:      int(p.Price),
+		CreatedAt:  int32(p.CreatedAt),
+		UpdatedAt:  int32(p.UpdatedAt),
+	}
+}
+
+// Helper function to convert protobuf currency to GraphQL model
+func pbCurrencyToModel(c *productPb.Currency) *model.Currency {
+	return &model.Currency{
+		ID:                fmt.Sprintf("%d", c.Id),
+		Code:              c.Code,
+		Name:              c.Name,
+		Symbol:            c.Symbol,
+		DecimalPlaces:     c.DecimalPlaces,
+		RoundingIncrement: int(c.RoundingIncrement),
+		RoundingMode:      c.RoundingMode,
+		CreatedAt:         int32(c.CreatedAt),
+		UpdatedAt:         int32(c.UpdatedAt),
+	}
+}
+
+// Helper function to convert protobuf exchange rate to GraphQL model
+func pbExchangeRateToModel(e *productPb.ExchangeRate) *model.ExchangeRate {
+	return &model.ExchangeRate{
+		ID:              fmt.Sprintf("%d", e.Id),
+		BaseCurrencyID:  fmt.Sprintf("%d", e.BaseCurrencyId),
+		QuoteCurrencyID: fmt.Sprintf("%d", e.QuoteCurrencyId),
+		Rate:            e.Rate,
+		Source:          e.Source,
+		EffectiveAt:     int32(e.EffectiveAt),
+		CreatedAt:       int32(e.CreatedAt),
+		UpdatedAt:       int32(e.UpdatedAt),
+	}
+}
+
+// Helper function to convert protobuf plan price to GraphQL model
+func pbPlanPriceToModel(p *productPb.PlanPrice) *model.PlanPrice {
+	price := &model.PlanPrice{
+		ID:           fmt.Sprintf("%d", p.Id),
+		PlanID:       fmt.Sprintf("%d", p.PlanId),
+		CurrencyID:   fmt.Sprintf("%d", p.CurrencyId),
+		Price:        p.Price,
+		PricePerUnit: p.PricePerUnit,
+		Type:         p.Type,
+		CreatedAt:    int32(p.CreatedAt),
+		UpdatedAt:    int32(p.UpdatedAt),
+	}
+	if p.Tiers != "" {
+		price.Tiers = &p.Tiers
+	}
+	if p.DerivedFromPriceId != 0 {
+		derivedFrom := fmt.Sprintf("%d", p.DerivedFromPriceId)
+		price.DerivedFromPriceID = &derivedFrom
+	}
+	return price
+}
+
+// Helper function to convert protobuf seat usage to GraphQL model
+func pbSeatUsageToModel(u *tenantPb.SeatUsage) *model.SeatUsage {
+	usage := &model.SeatUsage{
+		TenantID:  fmt.Sprintf("%d", u.TenantId),
+		Used:      int(u.Used),
+		Limit:     int(u.Limit),
+		Unlimited: u.Unlimited,
+		Remaining: int(u.Remaining),
+		Enforced:  u.Enforced,
+	}
+	if u.SubscriptionId != 0 {
+		subscriptionID := fmt.Sprintf("%d", u.SubscriptionId)
+		usage.SubscriptionID = &subscriptionID
+	}
+	return usage
+}
+
+// seatLimitMessage describes the RESOURCE_EXHAUSTED error tenant-service
+// returns when a tenant has no free seat. ok is false for other errors.
+func seatLimitMessage(err error) (message string, ok bool) {
+	st, isStatus := status.FromError(err)
+	if !isStatus || st.Code() != codes.ResourceExhausted {
+		return "", false
+	}
+	for _, detail := range st.Details() {
+		info, isInfo := detail.(*errdetails.ErrorInfo)
+		if isInfo && info.Reason == "SEAT_LIMIT_REACHED" {
+			return fmt.Sprintf("Seat limit reached: %s of %s seats in use", info.Metadata["seats_used"], info.Metadata["seat_limit"]), true
+		}
+	}
+	return "", false
+}
+
+// Helper function to convert protobuf tenant invitation to GraphQL model.
+// Zero IDs and timestamps are unset.
+func pbTenantInvitationToModel(i *tenantPb.TenantInvitation) *model.TenantInvitation {
+	optionalID := func(id int64) *string {
+		if id == 0 {
+			return nil
+		}
+		v := fmt.Sprintf("%d", id)
+		return &v
+	}
+	optionalTime := func(t int64) *int32 {
+		if t == 0 {
+			return nil
+		}
+		v := int32(t)
+		return &v
+	}
+
+	return &model.TenantInvitation{
+		ID:         fmt.Sprintf("%d", i.Id),
+		UUID:       i.Uuid,
+		TenantID:   fmt.Sprintf("%d", i.TenantId),
+		Email:      i.Email,
+		Role:       i.Role,
+		Status:     i.Status,
+		IsExpired:  i.IsExpired,
+		InvitedBy:  optionalID(i.InvitedBy),
+		AcceptedBy: optionalID(i.AcceptedBy),
+		ExpiresAt:  int32(i.ExpiresAt),
+		SentCount:  i.SentCount,
+		LastSentAt: int32(i.LastSentAt),
+		AcceptedAt: optionalTime(i.AcceptedAt),
+		DeclinedAt: optionalTime(i.DeclinedAt),
+		RevokedAt:  optionalTime(i.RevokedAt),
+		CreatedAt:  int32(i.CreatedAt),
+		UpdatedAt:  int32(i.UpdatedAt),
+	}
+}
+
+func pbDomainVerificationToModel(v *tenantPb.DomainVerification) *model.DomainVerification {
+	optionalTime := func(t int64) *int32 {
+		if t == 0 {
+			return nil
+		}
+		v := int32(t)
+		return &v
+	}
+
+	return &model.DomainVerification{
+		TenantID:      fmt.Sprintf("%d", v.TenantId),
+		Domain:        v.Domain,
+		RecordType:    v.RecordType,
+		RecordName:    v.RecordName,
+		RecordValue:   v.RecordValue,
+		Verified:      v.Verified,
+		VerifiedAt:    optionalTime(v.VerifiedAt),
+		LastCheckedAt: optionalTime(v.LastCheckedAt),
+		LastError:     util.StringPtr(v.LastError),
+	}
+}
+
+// Helper function to convert protobuf order to GraphQL model. Zero IDs and
+// timestamps are unset.
+func pbOrderToModel(o *productPb.Order) *model.Order {
+	optionalID := func(id int64) *string {
+		if id == 0 {
+			return nil
+		}
+		v := fmt.Sprintf("%d", id)
+		return &v
+	}
+	optionalTime := func(t int64) *int32 {
+		if t == 0 {
+			return nil
+		}
+		v := int32(t)
+		return &v
+	}
+
+	order := &model.Order{
+		ID:             fmt.Sprintf("%d", o.Id),
+		UUID:           o.Uuid,
+		UserID:         fmt.Sprintf("%d", o.UserId),
+		TenantID:       optionalID(o.TenantId),
+		CurrencyID:     fmt.Sprintf("%d", o.CurrencyId),
+		Status:         o.Status,
+		Subtotal:       int(o.Subtotal),
+		DiscountTotal:  int(o.DiscountTotal),
+		Total:          int(o.Total),
+		DiscountCodeID: optionalID(o.DiscountCodeId),
+		PaidAt:         optionalTime(o.PaidAt),
+		CancelledAt:    optionalTime(o.CancelledAt),
+		RefundedAt:     optionalTime(o.RefundedAt),
+		Items:          make([]*model.OrderItem, len(o.Items)),
+		CreatedAt:      int32(o.CreatedAt),
+		UpdatedAt:      int32(o.UpdatedAt),
+	}
+	if o.CheckoutUrl != "" {
+		order.CheckoutURL = &o.CheckoutUrl
+	}
+
+	for i, item := range o.Items {
+		order.Items[i] = &model.OrderItem{
+			ID:             fmt.Sprintf("%d", item.Id),
+			ProductID:      optionalID(item.ProductId),
+			Description:    item.Description,
+			Quantity:       int32(item.Quantity),
+			UnitAmount:     int(item.UnitAmount),
+			DiscountAmount: int(item.DiscountAmount),
+			Amount:         int(item.Amount),
+		}
+	}
+
+	return order
+}
+
+// Helper function to convert a protobuf order list to GraphQL model
+func pbOrderListToModel(l *productPb.OrderList) *model.OrderList {
+	orders := make([]*model.Order, len(l.Orders))
+	for i, o := range l.Orders {
+		orders[i] = pbOrderToModel(o)
+	}
+
+	return &model.OrderList{
+		Orders:  orders,
+		Total:   l.Total,
+		Page:    l.Page,
+		PerPage: l.PerPage,
+	}
+}
+
+// getAccessibleOrder fetches an order the current user owns, or any order
+// for admins
+func (r *Resolver) getAccessibleOrder(ctx context.Context, orderID int64) (*productPb.Order, error) {
+	currentUser, err := middleware.GetUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.ProductClient.GetOrderByID(ctx, &productPb.GetOrderByIDRequest{
+		Id: orderID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Get order failed: %v", err)
+	}
+	if !resp.Success {
+		return nil, errors.New(resp.Message)
+	}
+
+	if resp.Data.UserId != currentUser.Id && !currentUser.IsAdmin {
+		// Do not reveal that someone else's order exists
+		return nil, errors.New("order not found")
+	}
+
+	return resp.Data, nil
+}
+
+func pbTenantRoleToModel(role *tenantPb.TenantRole) *model.TenantRole {
+	result := &model.TenantRole{
+		TenantID:    strconv.FormatInt(role.TenantId, 10),
+		Name:        role.Name,
+		Description: util.StringPtr(role.Description),
+		Permissions: role.Permissions,
+		IsBuiltin:   role.IsBuiltin,
+	}
+	if result.Permissions == nil {
+		result.Permissions = []string{}
+	}
+	if !role.IsBuiltin {
+		id := strconv.FormatInt(role.Id, 10)
+		createdAt := int32(role.CreatedAt)
+		updatedAt := int32(role.UpdatedAt)
+		result.ID = &id
+		result.CreatedAt = &createdAt
+		result.UpdatedAt = &updatedAt
+	}
+	return result
+}
+
+// Helper function to convert protobuf ownership change to GraphQL model.
+// Zero user IDs are unset.
+func pbOwnershipChangeToModel(change *tenantPb.OwnershipChange) *model.OwnershipChange {
+	optionalID := func(id int64) *string {
+		if id == 0 {
+			return nil
+		}
+		v := fmt.Sprintf("%d", id)
+		return &v
+	}
+
+	createdAt := int32(change.CreatedAt)
+	return &model.OwnershipChange{
+		ID:          strconv.FormatInt(change.Id, 10),
+		TenantID:    strconv.FormatInt(change.TenantId, 10),
+		Action:      change.Action,
+		FromUserID:  optionalID(change.FromUserId),
+		ToUserID:    optionalID(change.ToUserId),
+		PerformedBy: optionalID(change.PerformedBy),
+		CreatedAt:   &createdAt,
+	}
+}
+
+// authorizeTenant lets platform admins through and checks the permission
+// against the member's tenant role for everyone else. The error message is
+// meant for the response.
+func (r *Resolver) authorizeTenant(ctx context.Context, tenantID int64, permission string) (*authPb.UserData, error) {
+	userClaims, err := middleware.GetUserFromContext(ctx)
+	if err != nil || userClaims == nil {
+		return nil, errors.New("Unauthorized: Please login first")
+	}
+	if userClaims.IsAdmin {
+		return userClaims, nil
+	}
+
+	resp, err := r.TenantClient.Authorize(ctx, &tenantPb.AuthorizeRequest{
+		UserId:     userClaims.Id,
+		TenantId:   tenantID,
+		Permission: permission,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Failed to check tenant permission: %v", err)
+	}
+	if !resp.Success {
+		return nil, errors.New(resp.Message)
+	}
+	if !resp.Allowed {
+		return nil, fmt.Errorf("Forbidden: %s permission required", permission)
+	}
+
+	return userClaims, nil
+}
+
+// authorizeRoleChange keeps members from granting, redefining or taking
+// away permissions their own role does not grant. Platform admins may make
+// any change. The error message is meant for the response.
+func (r *Resolver) authorizeRoleChange(ctx context.Context, change *tenantPb.AuthorizeRoleChangeRequest) error {
+	userClaims, err := middleware.GetUserFromContext(ctx)
+	if err != nil || userClaims == nil {
+		return errors.New("Unauthorized: Please login first")
+	}
+	if userClaims.IsAdmin {
+		return nil
+	}
+
+	change.UserId = userClaims.Id
+	resp, err := r.TenantClient.AuthorizeRoleChange(ctx, change)
+	if err != nil {
+		return fmt.Errorf("Failed to check role change: %v", err)
+	}
+	if !resp.Success {
+		return errors.New(resp.Message)
+	}
+	if !resp.Allowed {
+		return fmt.Errorf("Forbidden: %s", resp.Reason)
+	}
+
+	return nil
+}
+
+// resolveTenantID parses tenantID, or falls back to the request's active
+// tenant when it is left out
+func resolveTenantID(ctx context.Context, tenantID *string) (int64, error) {
+	if tenantID != nil && *tenantID != "" {
+		id, err := strconv.ParseInt(*tenantID, 10, 64)
+		if err != nil {
+			return 0, errors.New("Invalid tenant ID")
+		}
+		return id, nil
+	}
+
+	if id := tenancy.TenantID(ctx); id > 0 {
+		return id, nil
+	}
+	return 0, fmt.Errorf("No active tenant: pass tenantId or send the %s header", tenancy.HeaderName)
+}
+
+// tenantScope resolves the tenant a resolver works on and checks permission
+// like authorizeTenant. The returned context forwards that tenant to
+// downstream services as gRPC metadata.
+func (r *Resolver) tenantScope(ctx context.Context, tenantID *string, permission string) (context.Context, int64, error) {
+	id, err := resolveTenantID(ctx, tenantID)
+	if err != nil {
+		return ctx, 0, err
+	}
+	if _, err := r.authorizeTenant(ctx, id, permission); err != nil {
+		return ctx, 0, err
+	}
+	return tenancy.WithTenantID(ctx, id), id, nil
+}
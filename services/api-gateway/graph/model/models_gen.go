@@ -7,10 +7,10 @@ import (
 )
 
 type AddUserToTenantInput struct {
-	UserID    string `json:"userId"`
-	TenantID  string `json:"tenantId"`
-	Role      string `json:"role"`
-	IsDefault *bool  `json:"isDefault,omitempty"`
+	UserID    string  `json:"userId"`
+	TenantID  *string `json:"tenantId,omitempty"`
+	Role      string  `json:"role"`
+	IsDefault *bool   `json:"isDefault,omitempty"`
 }
 
 type BulkOperationResponse struct {
@@ -29,6 +29,15 @@ type ChangePasswordResponse struct {
 	Message string `json:"message"`
 }
 
+type CreateCurrencyInput struct {
+	Code              string  `json:"code"`
+	Name              string  `json:"name"`
+	Symbol            string  `json:"symbol"`
+	DecimalPlaces     *int32  `json:"decimalPlaces,omitempty"`
+	RoundingIncrement *int    `json:"roundingIncrement,omitempty"`
+	RoundingMode      *string `json:"roundingMode,omitempty"`
+}
+
 type CreateDiscountInput struct {
 	Name                           string  `json:"name"`
 	Description                    *string `json:"description,omitempty"`
@@ -48,6 +57,16 @@ type CreateDiscountInput struct {
 	IsEnabledForAllOneTimeProducts *bool   `json:"isEnabledForAllOneTimeProducts,omitempty"`
 }
 
+type CreateOrderInput struct {
+	TenantID        *string           `json:"tenantId,omitempty"`
+	CurrencyID      string            `json:"currencyId"`
+	Items           []*OrderItemInput `json:"items"`
+	DiscountCode    *string           `json:"discountCode,omitempty"`
+	PaymentProvider *string           `json:"paymentProvider,omitempty"`
+	SuccessURL      *string           `json:"successUrl,omitempty"`
+	CancelURL       *string           `json:"cancelUrl,omitempty"`
+}
+
 type CreatePlanInput struct {
 	Name               string  `json:"name"`
 	Slug               *string `json:"slug,omitempty"`
@@ -81,6 +100,13 @@ type CreateTenantInput struct {
 	Domain *string `json:"domain,omitempty"`
 }
 
+type CreateTenantRoleInput struct {
+	TenantID    *string  `json:"tenantId,omitempty"`
+	Name        string   `json:"name"`
+	Description *string  `json:"description,omitempty"`
+	Permissions []string `json:"permissions"`
+}
+
 type CreateUserInput struct {
 	Name        string  `json:"name"`
 	Email       string  `json:"email"`
@@ -91,11 +117,45 @@ type CreateUserInput struct {
 	IsAdmin     *bool   `json:"isAdmin,omitempty"`
 }
 
+type CurrenciesResponse struct {
+	Success bool        `json:"success"`
+	Message string      `json:"message"`
+	Data    []*Currency `json:"data,omitempty"`
+}
+
+type Currency struct {
+	ID                string `json:"id"`
+	Code              string `json:"code"`
+	Name              string `json:"name"`
+	Symbol            string `json:"symbol"`
+	DecimalPlaces     int32  `json:"decimalPlaces"`
+	RoundingIncrement int    `json:"roundingIncrement"`
+	RoundingMode      string `json:"roundingMode"`
+	CreatedAt         int32  `json:"createdAt"`
+	UpdatedAt         int32  `json:"updatedAt"`
+}
+
+type CurrencyResponse struct {
+	Success bool      `json:"success"`
+	Message string    `json:"message"`
+	Data    *Currency `json:"data,omitempty"`
+}
+
+type DeleteCurrencyResponse struct {
+	Success bool   `json:"success"`
+	Message string `json:"message"`
+}
+
 type DeleteDiscountResponse struct {
 	Success bool   `json:"success"`
 	Message string `json:"message"`
 }
 
+type DeleteExchangeRateResponse struct {
+	Success bool   `json:"success"`
+	Message string `json:"message"`
+}
+
 type DeleteMediaResponse struct {
 	Success bool   `json:"success"`
 	Message string `json:"message"`
@@ -106,6 +166,11 @@ type DeletePlanResponse struct {
 	Message string `json:"message"`
 }
 
+type DeleteProductPriceResponse struct {
+	Success bool   `json:"success"`
+	Message string `json:"message"`
+}
+
 type DeleteProductResponse struct {
 	Success bool   `json:"success"`
 	Message string `json:"message"`
@@ -126,6 +191,18 @@ type DeleteUserResponse struct {
 	Message string `json:"message"`
 }
 
+type DerivePlanPrices struct {
+	BasePrice *PlanPrice   `json:"basePrice"`
+	Derived   []*PlanPrice `json:"derived"`
+	Skipped   []string     `json:"skipped"`
+}
+
+type DerivePlanPricesResponse struct {
+	Success bool              `json:"success"`
+	Message string            `json:"message"`
+	Data    *DerivePlanPrices `json:"data,omitempty"`
+}
+
 type Discount struct {
 	ID                             string  `json:"id"`
 	Name                           string  `json:"name"`
@@ -149,6 +226,12 @@ type Discount struct {
 	UpdatedAt                      int32   `json:"updatedAt"`
 }
 
+type DiscountCodesExport struct {
+	DiscountID string `json:"discountId"`
+	Total      int32  `json:"total"`
+	CSV        string `json:"csv"`
+}
+
 type DiscountList struct {
 	Discounts []*Discount `json:"discounts"`
 	Total     int32       `json:"total"`
@@ -168,11 +251,86 @@ type DiscountResponse struct {
 	Data    *Discount `json:"data,omitempty"`
 }
 
+type DomainVerification struct {
+	TenantID      string  `json:"tenantId"`
+	Domain        string  `json:"domain"`
+	RecordType    string  `json:"recordType"`
+	RecordName    string  `json:"recordName"`
+	RecordValue   string  `json:"recordValue"`
+	Verified      bool    `json:"verified"`
+	VerifiedAt    *int32  `json:"verifiedAt,omitempty"`
+	LastCheckedAt *int32  `json:"lastCheckedAt,omitempty"`
+	LastError     *string `json:"lastError,omitempty"`
+}
+
+type DomainVerificationResponse struct {
+	Success bool                `json:"success"`
+	Message string              `json:"message"`
+	Data    *DomainVerification `json:"data,omitempty"`
+}
+
+type ExchangeRate struct {
+	ID              string `json:"id"`
+	BaseCurrencyID  string `json:"baseCurrencyId"`
+	QuoteCurrencyID string `json:"quoteCurrencyId"`
+	Rate            string `json:"rate"`
+	Source          string `json:"source"`
+	EffectiveAt     int32  `json:"effectiveAt"`
+	CreatedAt       int32  `json:"createdAt"`
+	UpdatedAt       int32  `json:"updatedAt"`
+}
+
+type ExchangeRateList struct {
+	ExchangeRates []*ExchangeRate `json:"exchangeRates"`
+	Total         int32           `json:"total"`
+	Page          int32           `json:"page"`
+	PerPage       int32           `json:"perPage"`
+}
+
+type ExchangeRateListResponse struct {
+	Success bool              `json:"success"`
+	Message string            `json:"message"`
+	Data    *ExchangeRateList `json:"data,omitempty"`
+}
+
+type ExchangeRateResponse struct {
+	Success bool          `json:"success"`
+	Message string        `json:"message"`
+	Data    *ExchangeRate `json:"data,omitempty"`
+}
+
+type ExportDiscountCodesResponse struct {
+	Success bool                 `json:"success"`
+	Message string               `json:"message"`
+	Data    *DiscountCodesExport `json:"data,omitempty"`
+}
+
 type ForgotPasswordResponse struct {
 	Success bool   `json:"success"`
 	Message string `json:"message"`
 }
 
+type GenerateDiscountCodesInput struct {
+	DiscountID string  `json:"discountId"`
+	Count      int32   `json:"count"`
+	Prefix     *string `json:"prefix,omitempty"`
+	Length     *int32  `json:"length,omitempty"`
+	Alphabet   *string `json:"alphabet,omitempty"`
+	MaxUses    *int32  `json:"maxUses,omitempty"`
+}
+
+type GenerateDiscountCodesResponse struct {
+	Success bool                    `json:"success"`
+	Message string                  `json:"message"`
+	Data    *GeneratedDiscountCodes `json:"data,omitempty"`
+}
+
+type GeneratedDiscountCodes struct {
+	DiscountID string   `json:"discountId"`
+	Generated  int32    `json:"generated"`
+	Codes      []string `json:"codes"`
+}
+
 type GetAllMediaInput struct {
 	Page           *int32  `json:"page,omitempty"`
 	PerPage        *int32  `json:"perPage,omitempty"`
@@ -188,6 +346,25 @@ type GetFilesByModelInput struct {
 	PerPage        *int32  `json:"perPage,omitempty"`
 }
 
+type ImportExchangeRatesResponse struct {
+	Success bool            `json:"success"`
+	Message string          `json:"message"`
+	Data    []*ExchangeRate `json:"data,omitempty"`
+}
+
+type InvitationByTokenResponse struct {
+	Success bool              `json:"success"`
+	Message string            `json:"message"`
+	Data    *TenantInvitation `json:"data,omitempty"`
+	Tenant  *Tenant           `json:"tenant,omitempty"`
+}
+
+type InviteUserToTenantInput struct {
+	TenantID *string `json:"tenantId,omitempty"`
+	Email    string  `json:"email"`
+	Role     string  `json:"role"`
+}
+
 type LoginData struct {
 	AccessToken  string `json:"accessToken"`
 	RefreshToken string `json:"refreshToken"`
@@ -265,6 +442,93 @@ type MediaURLResponse struct {
 type Mutation struct {
 }
 
+type Order struct {
+	ID             string       `json:"id"`
+	UUID           string       `json:"uuid"`
+	UserID         string       `json:"userId"`
+	TenantID       *string      `json:"tenantId,omitempty"`
+	CurrencyID     string       `json:"currencyId"`
+	Status         string       `json:"status"`
+	Subtotal       int          `json:"subtotal"`
+	DiscountTotal  int          `json:"discountTotal"`
+	Total          int          `json:"total"`
+	DiscountCodeID *string      `json:"discountCodeId,omitempty"`
+	CheckoutURL    *string      `json:"checkoutUrl,omitempty"`
+	PaidAt         *int32       `json:"paidAt,omitempty"`
+	CancelledAt    *int32       `json:"cancelledAt,omitempty"`
+	RefundedAt     *int32       `json:"refundedAt,omitempty"`
+	Items          []*OrderItem `json:"items"`
+	CreatedAt      int32        `json:"createdAt"`
+	UpdatedAt      int32        `json:"updatedAt"`
+}
+
+type OrderItem struct {
+	ID             string  `json:"id"`
+	ProductID      *string `json:"productId,omitempty"`
+	Description    string  `json:"description"`
+	Quantity       int32   `json:"quantity"`
+	UnitAmount     int     `json:"unitAmount"`
+	DiscountAmount int     `json:"discountAmount"`
+	Amount         int     `json:"amount"`
+}
+
+type OrderItemInput struct {
+	ProductID string `json:"productId"`
+	Quantity  int32  `json:"quantity"`
+}
+
+type OrderList struct {
+	Orders  []*Order `json:"orders"`
+	Total   int32    `json:"total"`
+	Page    int32    `json:"page"`
+	PerPage int32    `json:"perPage"`
+}
+
+type OrderListResponse struct {
+	Success bool       `json:"success"`
+	Message string     `json:"message"`
+	Data    *OrderList `json:"data,omitempty"`
+}
+
+type OrderResponse struct {
+	Success bool   `json:"success"`
+	Message string `json:"message"`
+	Data    *Order `json:"data,omitempty"`
+}
+
+type OwnershipChange struct {
+	ID          string  `json:"id"`
+	TenantID    string  `json:"tenantId"`
+	Action      string  `json:"action"`
+	FromUserID  *string `json:"fromUserId,omitempty"`
+	ToUserID    *string `json:"toUserId,omitempty"`
+	PerformedBy *string `json:"performedBy,omitempty"`
+	CreatedAt   *int32  `json:"createdAt,omitempty"`
+}
+
+type OwnershipChangeResponse struct {
+	Success bool             `json:"success"`
+	Message string           `json:"message"`
+	Data    *OwnershipChange `json:"data,omitempty"`
+}
+
+type OwnershipChangesResponse struct {
+	Success bool               `json:"success"`
+	Message string             `json:"message"`
+	Data    []*OwnershipChange `json:"data,omitempty"`
+}
+
+type Permission struct {
+	Key         string `json:"key"`
+	Description string `json:"description"`
+}
+
+type PermissionsResponse struct {
+	Success bool          `json:"success"`
+	Message string        `json:"message"`
+	Data    []*Permission `json:"data,omitempty"`
+}
+
 type Plan struct {
 	ID                 string  `json:"id"`
 	Name               string  `json:"name"`
@@ -298,6 +562,19 @@ type PlanListResponse struct {
 	Data    *PlanList `json:"data,omitempty"`
 }
 
+type PlanPrice struct {
+	ID                 string  `json:"id"`
+	PlanID             string  `json:"planId"`
+	CurrencyID         string  `json:"currencyId"`
+	Price              int32   `json:"price"`
+	PricePerUnit       int32   `json:"pricePerUnit"`
+	Type               string  `json:"type"`
+	Tiers              *string `json:"tiers,omitempty"`
+	DerivedFromPriceID *string `json:"derivedFromPriceId,omitempty"`
+	CreatedAt          int32   `json:"createdAt"`
+	UpdatedAt          int32   `json:"updatedAt"`
+}
+
 type PlanResponse struct {
 	Success bool   `json:"success"`
 	Message string `json:"message"`
@@ -336,6 +613,27 @@ type ProductListResponse struct {
 	Data    *ProductList `json:"data,omitempty"`
 }
 
+type ProductPrice struct {
+	ID         string `json:"id"`
+	ProductID  string `json:"productId"`
+	CurrencyID string `json:"currencyId"`
+	Price      int    `json:"price"`
+	CreatedAt  int32  `json:"createdAt"`
+	UpdatedAt  int32  `json:"updatedAt"`
+}
+
+type ProductPriceResponse struct {
+	Success bool          `json:"success"`
+	Message string        `json:"message"`
+	Data    *ProductPrice `json:"data,omitempty"`
+}
+
+type ProductPricesResponse struct {
+	Success bool            `json:"success"`
+	Message string          `json:"message"`
+	Data    []*ProductPrice `json:"data,omitempty"`
+}
+
 type ProductResponse struct {
 	Success bool     `json:"success"`
 	Message string   `json:"message"`
@@ -345,6 +643,33 @@ type ProductResponse struct {
 type Query struct {
 }
 
+type ReferralCode struct {
+	ID         string `json:"id"`
+	Code       string `json:"code"`
+	DiscountID string `json:"discountId"`
+}
+
+type ReferralCodeResponse struct {
+	Success bool          `json:"success"`
+	Message string        `json:"message"`
+	Data    *ReferralCode `json:"data,omitempty"`
+}
+
+type ReferralStats struct {
+	ReferrerUserID     string  `json:"referrerUserId"`
+	Code               *string `json:"code,omitempty"`
+	TotalReferrals     int32   `json:"totalReferrals"`
+	PendingReferrals   int32   `json:"pendingReferrals"`
+	ConvertedReferrals int32   `json:"convertedReferrals"`
+	BonusDaysEarned    int32   `json:"bonusDaysEarned"`
+}
+
+type ReferralStatsResponse struct {
+	Success bool           `json:"success"`
+	Message string         `json:"message"`
+	Data    *ReferralStats `json:"data,omitempty"`
+}
+
 type RefreshTokenData struct {
 	AccessToken  string `json:"accessToken"`
 	RefreshToken string `json:"refreshToken"`
@@ -370,6 +695,22 @@ type ResetPasswordResponse struct {
 	Message string `json:"message"`
 }
 
+type SeatUsage struct {
+	TenantID       string  `json:"tenantId"`
+	Used           int     `json:"used"`
+	Limit          int     `json:"limit"`
+	Unlimited      bool    `json:"unlimited"`
+	Remaining      int     `json:"remaining"`
+	SubscriptionID *string `json:"subscriptionId,omitempty"`
+	Enforced       bool    `json:"enforced"`
+}
+
+type SeatUsageResponse struct {
+	Success bool       `json:"success"`
+	Message string     `json:"message"`
+	Data    *SeatUsage `json:"data,omitempty"`
+}
+
 type SetDefaultTenantInput struct {
 	UserID   string `json:"userId"`
 	TenantID string `json:"tenantId"`
@@ -380,10 +721,23 @@ type SetDefaultTenantResponse struct {
 	Message string `json:"message"`
 }
 
+type SetExchangeRateInput struct {
+	BaseCurrencyID  string `json:"baseCurrencyId"`
+	QuoteCurrencyID string `json:"quoteCurrencyId"`
+	Rate            string `json:"rate"`
+	EffectiveAt     *int32 `json:"effectiveAt,omitempty"`
+}
+
+type SetProductPriceInput struct {
+	ProductID  string `json:"productId"`
+	CurrencyID string `json:"currencyId"`
+	Price      int    `json:"price"`
+}
+
 type SetSettingInput struct {
-	TenantID string `json:"tenantId"`
-	Key      string `json:"key"`
-	Value    string `json:"value"`
+	TenantID *string `json:"tenantId,omitempty"`
+	Key      string  `json:"key"`
+	Value    string  `json:"value"`
 }
 
 type Tenant struct {
@@ -398,6 +752,46 @@ type Tenant struct {
 	UpdatedAt           int32   `json:"updatedAt"`
 }
 
+type TenantAuthorizationResponse struct {
+	Success bool    `json:"success"`
+	Message string  `json:"message"`
+	Allowed bool    `json:"allowed"`
+	Role    *string `json:"role,omitempty"`
+	Reason  *string `json:"reason,omitempty"`
+}
+
+type TenantInvitation struct {
+	ID         string  `json:"id"`
+	UUID       string  `json:"uuid"`
+	TenantID   string  `json:"tenantId"`
+	Email      string  `json:"email"`
+	Role       string  `json:"role"`
+	Status     string  `json:"status"`
+	IsExpired  bool    `json:"isExpired"`
+	InvitedBy  *string `json:"invitedBy,omitempty"`
+	AcceptedBy *string `json:"acceptedBy,omitempty"`
+	ExpiresAt  int32   `json:"expiresAt"`
+	SentCount  int32   `json:"sentCount"`
+	LastSentAt int32   `json:"lastSentAt"`
+	AcceptedAt *int32  `json:"acceptedAt,omitempty"`
+	DeclinedAt *int32  `json:"declinedAt,omitempty"`
+	RevokedAt  *int32  `json:"revokedAt,omitempty"`
+	CreatedAt  int32   `json:"createdAt"`
+	UpdatedAt  int32   `json:"updatedAt"`
+}
+
+type TenantInvitationResponse struct {
+	Success bool              `json:"success"`
+	Message string            `json:"message"`
+	Data    *TenantInvitation `json:"data,omitempty"`
+}
+
+type TenantInvitationsResponse struct {
+	Success bool                `json:"success"`
+	Message string              `json:"message"`
+	Data    []*TenantInvitation `json:"data,omitempty"`
+}
+
 type TenantList struct {
 	Tenants []*Tenant `json:"tenants"`
 	Total   int32     `json:"total"`
@@ -417,6 +811,29 @@ type TenantResponse struct {
 	Data    *Tenant `json:"data,omitempty"`
 }
 
+type TenantRole struct {
+	ID          *string  `json:"id,omitempty"`
+	TenantID    string   `json:"tenantId"`
+	Name        string   `json:"name"`
+	Description *string  `json:"description,omitempty"`
+	Permissions []string `json:"permissions"`
+	IsBuiltin   bool     `json:"isBuiltin"`
+	CreatedAt   *int32   `json:"createdAt,omitempty"`
+	UpdatedAt   *int32   `json:"updatedAt,omitempty"`
+}
+
+type TenantRoleResponse struct {
+	Success bool        `json:"success"`
+	Message string      `json:"message"`
+	Data    *TenantRole `json:"data,omitempty"`
+}
+
+type TenantRolesResponse struct {
+	Success bool          `json:"success"`
+	Message string        `json:"message"`
+	Data    []*TenantRole `json:"data,omitempty"`
+}
+
 type TenantSetting struct {
 	ID        string `json:"id"`
 	TenantID  string `json:"tenantId"`
@@ -461,6 +878,21 @@ type TenantUsersResponse struct {
 	Data    []*TenantUser `json:"data,omitempty"`
 }
 
+type TransferTenantOwnershipInput struct {
+	TenantID   *string `json:"tenantId,omitempty"`
+	FromUserID *string `json:"fromUserId,omitempty"`
+	ToUserID   string  `json:"toUserId"`
+}
+
+type UpdateCurrencyInput struct {
+	ID                string  `json:"id"`
+	Name              *string `json:"name,omitempty"`
+	Symbol            *string `json:"symbol,omitempty"`
+	DecimalPlaces     *int32  `json:"decimalPlaces,omitempty"`
+	RoundingIncrement *int    `json:"roundingIncrement,omitempty"`
+	RoundingMode      *string `json:"roundingMode,omitempty"`
+}
+
 type UpdateDiscountInput struct {
 	ID                             string  `json:"id"`
 	Name                           string  `json:"name"`
@@ -515,6 +947,13 @@ type UpdateTenantInput struct {
 	Domain *string `json:"domain,omitempty"`
 }
 
+type UpdateTenantRoleInput struct {
+	ID          string   `json:"id"`
+	TenantID    *string  `json:"tenantId,omitempty"`
+	Description *string  `json:"description,omitempty"`
+	Permissions []string `json:"permissions"`
+}
+
 type UpdateUserInput struct {
 	ID          string  `json:"id"`
 	Name        string  `json:"name"`
@@ -527,9 +966,9 @@ type UpdateUserInput struct {
 }
 
 type UpdateUserRoleInput struct {
-	UserID   string `json:"userId"`
-	TenantID string `json:"tenantId"`
-	Role     string `json:"role"`
+	UserID   string  `json:"userId"`
+	TenantID *string `json:"tenantId,omitempty"`
+	Role     string  `json:"role"`
 }
 
 type UpdateUserRoleResponse struct {
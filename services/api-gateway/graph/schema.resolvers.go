@@ -12,6 +12,7 @@ import (
 	"reflect"
 	"strconv"
 
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/damarteplok/damar-admin-cms/services/api-gateway/graph/model"
 	"github.com/damarteplok/damar-admin-cms/services/api-gateway/internal/middleware"
 	"github.com/damarteplok/damar-admin-cms/shared/logger"
@@ -20,6 +21,8 @@ import (
 	productPb "github.com/damarteplok/damar-admin-cms/shared/proto/product"
 	tenantPb "github.com/damarteplok/damar-admin-cms/shared/proto/tenant"
 	userPb "github.com/damarteplok/damar-admin-cms/shared/proto/user"
+	"github.com/damarteplok/damar-admin-cms/shared/rbac"
+	"github.com/damarteplok/damar-admin-cms/shared/tenancy"
 	"github.com/damarteplok/damar-admin-cms/shared/util"
 	"go.uber.org/zap"
 )
@@ -765,20 +768,18 @@ func (r *mutationResolver) CreateTenant(ctx context.Context, input model.CreateT
 
 // UpdateTenant is the resolver for the updateTenant field.
 func (r *mutationResolver) UpdateTenant(ctx context.Context, input model.UpdateTenantInput) (*model.TenantResponse, error) {
-	// Check if user is admin
-	userClaims, err := middleware.GetUserFromContext(ctx)
-	if err != nil || userClaims == nil || !userClaims.IsAdmin {
+	tenantID, err := strconv.ParseInt(input.ID, 10, 64)
+	if err != nil {
 		return &model.TenantResponse{
 			Success: false,
-			Message: "Unauthorized: Admin access required",
+			Message: "Invalid tenant ID",
 		}, nil
 	}
 
-	tenantID, err := strconv.ParseInt(input.ID, 10, 64)
-	if err != nil {
+	if _, err := r.authorizeTenant(ctx, tenantID, rbac.PermTenantUpdate); err != nil {
 		return &model.TenantResponse{
 			Success: false,
-			Message: "Invalid tenant ID",
+			Message: err.Error(),
 		}, nil
 	}
 
@@ -822,20 +823,18 @@ func (r *mutationResolver) UpdateTenant(ctx context.Context, input model.UpdateT
 
 // DeleteTenant is the resolver for the deleteTenant field.
 func (r *mutationResolver) DeleteTenant(ctx context.Context, id string) (*model.DeleteTenantResponse, error) {
-	// Check if user is admin
-	userClaims, err := middleware.GetUserFromContext(ctx)
-	if err != nil || userClaims == nil || !userClaims.IsAdmin {
+	tenantID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
 		return &model.DeleteTenantResponse{
 			Success: false,
-			Message: "Unauthorized: Admin access required",
+			Message: "Invalid tenant ID",
 		}, nil
 	}
 
-	tenantID, err := strconv.ParseInt(id, 10, 64)
-	if err != nil {
+	if _, err := r.authorizeTenant(ctx, tenantID, rbac.PermTenantDelete); err != nil {
 		return &model.DeleteTenantResponse{
 			Success: false,
-			Message: "Invalid tenant ID",
+			Message: err.Error(),
 		}, nil
 	}
 
@@ -856,17 +855,95 @@ func (r *mutationResolver) DeleteTenant(ctx context.Context, id string) (*model.
 	}, nil
 }
 
-// AddUserToTenant is the resolver for the addUserToTenant field.
-func (r *mutationResolver) AddUserToTenant(ctx context.Context, input model.AddUserToTenantInput) (*model.TenantUserResponse, error) {
-	// Check if user is admin
-	userClaims, err := middleware.GetUserFromContext(ctx)
-	if err != nil || userClaims == nil || !userClaims.IsAdmin {
-		return &model.TenantUserResponse{
+// RestoreTenant is the resolver for the restoreTenant field.
+func (r *mutationResolver) RestoreTenant(ctx context.Context, id string) (*model.TenantResponse, error) {
+	// Admin only
+	if err := middleware.RequireAdmin(ctx); err != nil {
+		return &model.TenantResponse{
 			Success: false,
-			Message: "Unauthorized: Admin access required",
+			Message: "Admin access required",
+		}, nil
+	}
+
+	tenantID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return &model.TenantResponse{
+			Success: false,
+			Message: "Invalid tenant ID",
+		}, nil
+	}
+
+	resp, err := r.TenantClient.RestoreTenant(ctx, &tenantPb.RestoreTenantRequest{
+		Id: tenantID,
+	})
+	if err != nil {
+		return &model.TenantResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to restore tenant: %v", err),
+		}, nil
+	}
+
+	if !resp.Success {
+		return &model.TenantResponse{
+			Success: false,
+			Message: resp.Message,
+		}, nil
+	}
+
+	tenant := resp.Data
+	return &model.TenantResponse{
+		Success: true,
+		Message: resp.Message,
+		Data: &model.Tenant{
+			ID:                  strconv.FormatInt(tenant.Id, 10),
+			UUID:                tenant.Uuid,
+			Name:                tenant.Name,
+			Slug:                tenant.Slug,
+			Domain:              util.StringPtr(tenant.Domain),
+			IsNameAutoGenerated: tenant.IsNameAutoGenerated,
+			CreatedBy:           strconv.FormatInt(tenant.CreatedBy, 10),
+			CreatedAt:           int32(tenant.CreatedAt),
+			UpdatedAt:           int32(tenant.UpdatedAt),
+		},
+	}, nil
+}
+
+// VerifyTenantDomain is the resolver for the verifyTenantDomain field.
+func (r *mutationResolver) VerifyTenantDomain(ctx context.Context, tenantID *string) (*model.DomainVerificationResponse, error) {
+	ctx, parsedTenantID, err := r.tenantScope(ctx, tenantID, rbac.PermTenantUpdate)
+	if err != nil {
+		return &model.DomainVerificationResponse{
+			Success: false,
+			Message: err.Error(),
 		}, nil
 	}
 
+	resp, err := r.TenantClient.VerifyDomain(ctx, &tenantPb.VerifyDomainRequest{
+		TenantId: parsedTenantID,
+	})
+	if err != nil {
+		return &model.DomainVerificationResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to verify domain: %v", err),
+		}, nil
+	}
+
+	if !resp.Success {
+		return &model.DomainVerificationResponse{
+			Success: false,
+			Message: resp.Message,
+		}, nil
+	}
+
+	return &model.DomainVerificationResponse{
+		Success: true,
+		Message: resp.Message,
+		Data:    pbDomainVerificationToModel(resp.Data),
+	}, nil
+}
+
+// AddUserToTenant is the resolver for the addUserToTenant field.
+func (r *mutationResolver) AddUserToTenant(ctx context.Context, input model.AddUserToTenantInput) (*model.TenantUserResponse, error) {
 	userID, err := strconv.ParseInt(input.UserID, 10, 64)
 	if err != nil {
 		return &model.TenantUserResponse{
@@ -875,11 +952,21 @@ func (r *mutationResolver) AddUserToTenant(ctx context.Context, input model.AddU
 		}, nil
 	}
 
-	tenantID, err := strconv.ParseInt(input.TenantID, 10, 64)
+	ctx, tenantID, err := r.tenantScope(ctx, input.TenantID, rbac.PermMembersInvite)
 	if err != nil {
 		return &model.TenantUserResponse{
 			Success: false,
-			Message: "Invalid tenant ID",
+			Message: err.Error(),
+		}, nil
+	}
+
+	if err := r.authorizeRoleChange(ctx, &tenantPb.AuthorizeRoleChangeRequest{
+		TenantId: tenantID,
+		Role:     input.Role,
+	}); err != nil {
+		return &model.TenantUserResponse{
+			Success: false,
+			Message: err.Error(),
 		}, nil
 	}
 
@@ -908,6 +995,12 @@ func (r *mutationResolver) AddUserToTenant(ctx context.Context, input model.AddU
 		Email:     userResp.Data.Email,
 	})
 	if err != nil {
+		if message, ok := seatLimitMessage(err); ok {
+			return &model.TenantUserResponse{
+				Success: false,
+				Message: message,
+			}, nil
+		}
 		return &model.TenantUserResponse{
 			Success: false,
 			Message: fmt.Sprintf("Failed to add user to tenant: %v", err),
@@ -939,36 +1032,46 @@ func (r *mutationResolver) AddUserToTenant(ctx context.Context, input model.AddU
 }
 
 // RemoveUserFromTenant is the resolver for the removeUserFromTenant field.
-func (r *mutationResolver) RemoveUserFromTenant(ctx context.Context, userID string, tenantID string) (*model.DeleteTenantResponse, error) {
-	// Check if user is admin
-	userClaims, err := middleware.GetUserFromContext(ctx)
-	if err != nil || userClaims == nil || !userClaims.IsAdmin {
+func (r *mutationResolver) RemoveUserFromTenant(ctx context.Context, userID string, tenantID *string) (*model.DeleteTenantResponse, error) {
+	parsedUserID, err := strconv.ParseInt(userID, 10, 64)
+	if err != nil {
 		return &model.DeleteTenantResponse{
 			Success: false,
-			Message: "Unauthorized: Admin access required",
+			Message: "Invalid user ID",
 		}, nil
 	}
 
-	parsedUserID, err := strconv.ParseInt(userID, 10, 64)
+	ctx, parsedTenantID, err := r.tenantScope(ctx, tenantID, rbac.PermMembersRemove)
 	if err != nil {
 		return &model.DeleteTenantResponse{
 			Success: false,
-			Message: "Invalid user ID",
+			Message: err.Error(),
+		}, nil
+	}
+
+	if err := r.authorizeRoleChange(ctx, &tenantPb.AuthorizeRoleChangeRequest{
+		TenantId:     parsedTenantID,
+		TargetUserId: parsedUserID,
+	}); err != nil {
+		return &model.DeleteTenantResponse{
+			Success: false,
+			Message: err.Error(),
 		}, nil
 	}
 
-	parsedTenantID, err := strconv.ParseInt(tenantID, 10, 64)
+	userClaims, err := middleware.GetUserFromContext(ctx)
 	if err != nil {
 		return &model.DeleteTenantResponse{
 			Success: false,
-			Message: "Invalid tenant ID",
+			Message: err.Error(),
 		}, nil
 	}
 
 	// Call tenant-service via gRPC
 	resp, err := r.TenantClient.RemoveUserFromTenant(ctx, &tenantPb.RemoveUserFromTenantRequest{
-		UserId:   parsedUserID,
-		TenantId: parsedTenantID,
+		UserId:      parsedUserID,
+		TenantId:    parsedTenantID,
+		PerformedBy: userClaims.Id,
 	})
 	if err != nil {
 		return &model.DeleteTenantResponse{
@@ -985,35 +1088,46 @@ func (r *mutationResolver) RemoveUserFromTenant(ctx context.Context, userID stri
 
 // UpdateUserRole is the resolver for the updateUserRole field.
 func (r *mutationResolver) UpdateUserRole(ctx context.Context, input model.UpdateUserRoleInput) (*model.UpdateUserRoleResponse, error) {
-	// Check admin authorization
-	_, err := middleware.GetUserFromContext(ctx)
+	userID, err := strconv.ParseInt(input.UserID, 10, 64)
 	if err != nil {
 		return &model.UpdateUserRoleResponse{
 			Success: false,
-			Message: "Unauthorized: Please login first",
+			Message: "Invalid user ID",
 		}, nil
 	}
 
-	userID, err := strconv.ParseInt(input.UserID, 10, 64)
+	ctx, tenantID, err := r.tenantScope(ctx, input.TenantID, rbac.PermMembersUpdateRole)
 	if err != nil {
 		return &model.UpdateUserRoleResponse{
 			Success: false,
-			Message: "Invalid user ID",
+			Message: err.Error(),
 		}, nil
 	}
 
-	tenantID, err := strconv.ParseInt(input.TenantID, 10, 64)
+	if err := r.authorizeRoleChange(ctx, &tenantPb.AuthorizeRoleChangeRequest{
+		TenantId:     tenantID,
+		Role:         input.Role,
+		TargetUserId: userID,
+	}); err != nil {
+		return &model.UpdateUserRoleResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	userClaims, err := middleware.GetUserFromContext(ctx)
 	if err != nil {
 		return &model.UpdateUserRoleResponse{
 			Success: false,
-			Message: "Invalid tenant ID",
+			Message: err.Error(),
 		}, nil
 	}
 
 	resp, err := r.TenantClient.UpdateUserRole(ctx, &tenantPb.UpdateUserRoleRequest{
-		UserId:   userID,
-		TenantId: tenantID,
-		Role:     input.Role,
+		UserId:      userID,
+		TenantId:    tenantID,
+		Role:        input.Role,
+		PerformedBy: userClaims.Id,
 	})
 	if err != nil {
 		return &model.UpdateUserRoleResponse{
@@ -1072,1294 +1186,2444 @@ func (r *mutationResolver) SetDefaultTenant(ctx context.Context, input model.Set
 	}, nil
 }
 
-// SetTenantSetting is the resolver for the setTenantSetting field.
-func (r *mutationResolver) SetTenantSetting(ctx context.Context, input model.SetSettingInput) (*model.TenantSettingResponse, error) {
-	// Check admin authorization
-	_, err := middleware.GetUserFromContext(ctx)
+// InviteUserToTenant is the resolver for the inviteUserToTenant field.
+func (r *mutationResolver) InviteUserToTenant(ctx context.Context, input model.InviteUserToTenantInput) (*model.TenantInvitationResponse, error) {
+	ctx, tenantID, err := r.tenantScope(ctx, input.TenantID, rbac.PermMembersInvite)
 	if err != nil {
-		return &model.TenantSettingResponse{
+		return &model.TenantInvitationResponse{
 			Success: false,
-			Message: "Unauthorized: Please login first",
+			Message: err.Error(),
 		}, nil
 	}
 
-	tenantID, err := strconv.ParseInt(input.TenantID, 10, 64)
+	userClaims, err := middleware.GetUserFromContext(ctx)
 	if err != nil {
-		return &model.TenantSettingResponse{
+		return &model.TenantInvitationResponse{
 			Success: false,
-			Message: "Invalid tenant ID",
+			Message: err.Error(),
 		}, nil
 	}
 
-	resp, err := r.TenantClient.SetSetting(ctx, &tenantPb.SetSettingRequest{
+	if err := r.authorizeRoleChange(ctx, &tenantPb.AuthorizeRoleChangeRequest{
 		TenantId: tenantID,
-		Key:      input.Key,
-		Value:    input.Value,
+		Role:     input.Role,
+	}); err != nil {
+		return &model.TenantInvitationResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	// Call tenant-service via gRPC
+	resp, err := r.TenantClient.InviteUserToTenant(ctx, &tenantPb.InviteUserToTenantRequest{
+		TenantId:  tenantID,
+		Email:     input.Email,
+		Role:      input.Role,
+		InvitedBy: userClaims.Id,
 	})
 	if err != nil {
-		return &model.TenantSettingResponse{
+		if message, ok := seatLimitMessage(err); ok {
+			return &model.TenantInvitationResponse{
+				Success: false,
+				Message: message,
+			}, nil
+		}
+		return &model.TenantInvitationResponse{
 			Success: false,
-			Message: fmt.Sprintf("Failed to set tenant setting: %v", err),
+			Message: fmt.Sprintf("Failed to invite user to tenant: %v", err),
 		}, nil
 	}
 
-	if !resp.Success || resp.Data == nil {
-		return &model.TenantSettingResponse{
-			Success: resp.Success,
+	if !resp.Success {
+		return &model.TenantInvitationResponse{
+			Success: false,
 			Message: resp.Message,
 		}, nil
 	}
 
-	setting := resp.Data
-	return &model.TenantSettingResponse{
+	return &model.TenantInvitationResponse{
 		Success: true,
-		Message: "Tenant setting saved successfully",
-		Data: &model.TenantSetting{
-			ID:        strconv.FormatInt(setting.Id, 10),
-			TenantID:  strconv.FormatInt(setting.TenantId, 10),
-			Key:       setting.Key,
-			Value:     setting.Value,
-			CreatedAt: int32(setting.CreatedAt),
-			UpdatedAt: int32(setting.UpdatedAt),
-		},
+		Message: resp.Message,
+		Data:    pbTenantInvitationToModel(resp.Data),
 	}, nil
 }
 
-// DeleteTenantSetting is the resolver for the deleteTenantSetting field.
-func (r *mutationResolver) DeleteTenantSetting(ctx context.Context, tenantID string, key string) (*model.DeleteSettingResponse, error) {
-	// Check admin authorization
-	_, err := middleware.GetUserFromContext(ctx)
+// RevokeTenantInvitation is the resolver for the revokeTenantInvitation field.
+func (r *mutationResolver) RevokeTenantInvitation(ctx context.Context, tenantID *string, id string) (*model.TenantInvitationResponse, error) {
+	ctx, parsedTenantID, err := r.tenantScope(ctx, tenantID, rbac.PermMembersInvite)
 	if err != nil {
-		return &model.DeleteSettingResponse{
+		return &model.TenantInvitationResponse{
 			Success: false,
-			Message: "Unauthorized: Please login first",
+			Message: err.Error(),
 		}, nil
 	}
 
-	tenantIDInt, err := strconv.ParseInt(tenantID, 10, 64)
+	invitationID, err := strconv.ParseInt(id, 10, 64)
 	if err != nil {
-		return &model.DeleteSettingResponse{
+		return &model.TenantInvitationResponse{
 			Success: false,
-			Message: "Invalid tenant ID",
+			Message: "Invalid invitation ID",
 		}, nil
 	}
 
-	resp, err := r.TenantClient.DeleteSetting(ctx, &tenantPb.DeleteSettingRequest{
-		TenantId: tenantIDInt,
-		Key:      key,
+	resp, err := r.TenantClient.RevokeInvitation(ctx, &tenantPb.RevokeInvitationRequest{
+		Id:       invitationID,
+		TenantId: parsedTenantID,
 	})
 	if err != nil {
-		return &model.DeleteSettingResponse{
+		return &model.TenantInvitationResponse{
 			Success: false,
-			Message: fmt.Sprintf("Failed to delete tenant setting: %v", err),
+			Message: fmt.Sprintf("Failed to revoke invitation: %v", err),
 		}, nil
 	}
 
-	return &model.DeleteSettingResponse{
-		Success: resp.Success,
+	if !resp.Success {
+		return &model.TenantInvitationResponse{
+			Success: false,
+			Message: resp.Message,
+		}, nil
+	}
+
+	return &model.TenantInvitationResponse{
+		Success: true,
 		Message: resp.Message,
+		Data:    pbTenantInvitationToModel(resp.Data),
 	}, nil
 }
 
-// CreateProduct is the resolver for the createProduct field.
-func (r *mutationResolver) CreateProduct(ctx context.Context, input model.CreateProductInput) (*model.ProductResponse, error) {
-	// Admin only
-	if err := middleware.RequireAdmin(ctx); err != nil {
-		return &model.ProductResponse{
+// ResendTenantInvitation is the resolver for the resendTenantInvitation field.
+func (r *mutationResolver) ResendTenantInvitation(ctx context.Context, tenantID *string, id string) (*model.TenantInvitationResponse, error) {
+	ctx, parsedTenantID, err := r.tenantScope(ctx, tenantID, rbac.PermMembersInvite)
+	if err != nil {
+		return &model.TenantInvitationResponse{
 			Success: false,
-			Message: "Admin access required",
+			Message: err.Error(),
 		}, nil
 	}
 
-	// Prepare request with optional fields
-	req := &productPb.CreateProductRequest{
-		Name: input.Name,
-	}
-	if input.Slug != nil {
-		req.Slug = *input.Slug
+	invitationID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return &model.TenantInvitationResponse{
+			Success: false,
+			Message: "Invalid invitation ID",
+		}, nil
 	}
-	if input.Description != nil {
-		req.Description = *input.Description
+
+	resp, err := r.TenantClient.ResendInvitation(ctx, &tenantPb.ResendInvitationRequest{
+		Id:       invitationID,
+		TenantId: parsedTenantID,
+	})
+	if err != nil {
+		if message, ok := seatLimitMessage(err); ok {
+			return &model.TenantInvitationResponse{
+				Success: false,
+				Message: message,
+			}, nil
+		}
+		return &model.TenantInvitationResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to resend invitation: %v", err),
+		}, nil
 	}
-	if input.Metadata != nil {
-		req.Metadata = *input.Metadata
+
+	if !resp.Success {
+		return &model.TenantInvitationResponse{
+			Success: false,
+			Message: resp.Message,
+		}, nil
 	}
-	if input.Features != nil {
-		req.Features = *input.Features
-	}
-	if input.IsPopular != nil {
-		req.IsPopular = *input.IsPopular
+
+	return &model.TenantInvitationResponse{
+		Success: true,
+		Message: resp.Message,
+		Data:    pbTenantInvitationToModel(resp.Data),
+	}, nil
+}
+
+// CreateTenantRole is the resolver for the createTenantRole field.
+func (r *mutationResolver) CreateTenantRole(ctx context.Context, input model.CreateTenantRoleInput) (*model.TenantRoleResponse, error) {
+	ctx, tenantID, err := r.tenantScope(ctx, input.TenantID, rbac.PermRolesManage)
+	if err != nil {
+		return &model.TenantRoleResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
 	}
-	if input.IsDefault != nil {
-		req.IsDefault = *input.IsDefault
+
+	if err := r.authorizeRoleChange(ctx, &tenantPb.AuthorizeRoleChangeRequest{
+		TenantId:    tenantID,
+		Permissions: input.Permissions,
+	}); err != nil {
+		return &model.TenantRoleResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
 	}
 
-	// Call product-service via gRPC
-	resp, err := r.ProductClient.CreateProduct(ctx, req)
+	resp, err := r.TenantClient.CreateTenantRole(ctx, &tenantPb.CreateTenantRoleRequest{
+		TenantId:    tenantID,
+		Name:        input.Name,
+		Description: util.StringValue(input.Description),
+		Permissions: input.Permissions,
+	})
 	if err != nil {
-		return &model.ProductResponse{
+		return &model.TenantRoleResponse{
 			Success: false,
-			Message: fmt.Sprintf("Create product failed: %v", err),
+			Message: fmt.Sprintf("Failed to create role: %v", err),
 		}, nil
 	}
 
 	if !resp.Success {
-		return &model.ProductResponse{
+		return &model.TenantRoleResponse{
 			Success: false,
 			Message: resp.Message,
 		}, nil
 	}
 
-	// Convert proto product to GraphQL model
-	product := resp.Data
-	return &model.ProductResponse{
+	return &model.TenantRoleResponse{
 		Success: true,
 		Message: resp.Message,
-		Data: &model.Product{
-			ID:          strconv.FormatInt(product.Id, 10),
-			Name:        product.Name,
-			Slug:        product.Slug,
-			Description: &product.Description,
-			Metadata:    &product.Metadata,
-			Features:    &product.Features,
-			IsPopular:   product.IsPopular,
-			IsDefault:   product.IsDefault,
-			CreatedAt:   int32(product.CreatedAt),
-			UpdatedAt:   int32(product.UpdatedAt),
-		},
+		Data:    pbTenantRoleToModel(resp.Data),
 	}, nil
 }
 
-// UpdateProduct is the resolver for the updateProduct field.
-func (r *mutationResolver) UpdateProduct(ctx context.Context, input model.UpdateProductInput) (*model.ProductResponse, error) {
-	// Admin only
-	if err := middleware.RequireAdmin(ctx); err != nil {
-		return &model.ProductResponse{
+// UpdateTenantRole is the resolver for the updateTenantRole field.
+func (r *mutationResolver) UpdateTenantRole(ctx context.Context, input model.UpdateTenantRoleInput) (*model.TenantRoleResponse, error) {
+	roleID, err := strconv.ParseInt(input.ID, 10, 64)
+	if err != nil {
+		return &model.TenantRoleResponse{
 			Success: false,
-			Message: "Admin access required",
+			Message: "Invalid role ID",
 		}, nil
 	}
 
-	// Parse product ID
-	productID, err := strconv.ParseInt(input.ID, 10, 64)
+	ctx, tenantID, err := r.tenantScope(ctx, input.TenantID, rbac.PermRolesManage)
 	if err != nil {
-		return &model.ProductResponse{
+		return &model.TenantRoleResponse{
 			Success: false,
-			Message: "Invalid product ID",
+			Message: err.Error(),
 		}, nil
 	}
 
-	// Prepare request
-	req := &productPb.UpdateProductRequest{
-		Id:   productID,
-		Name: input.Name,
-	}
-	if input.Slug != nil {
-		req.Slug = *input.Slug
-	}
-	if input.Description != nil {
-		req.Description = *input.Description
-	}
-	if input.Metadata != nil {
-		req.Metadata = *input.Metadata
-	}
-	if input.Features != nil {
-		req.Features = *input.Features
-	}
-	if input.IsPopular != nil {
-		req.IsPopular = *input.IsPopular
-	}
-	if input.IsDefault != nil {
-		req.IsDefault = *input.IsDefault
+	// Both the role's current and its new permissions must be within the
+	// caller's, so nobody can strip or widen a role above their own
+	if err := r.authorizeRoleChange(ctx, &tenantPb.AuthorizeRoleChangeRequest{
+		TenantId:    tenantID,
+		Permissions: input.Permissions,
+		RoleId:      roleID,
+	}); err != nil {
+		return &model.TenantRoleResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
 	}
 
-	// Call product-service via gRPC
-	resp, err := r.ProductClient.UpdateProduct(ctx, req)
+	resp, err := r.TenantClient.UpdateTenantRole(ctx, &tenantPb.UpdateTenantRoleRequest{
+		Id:          roleID,
+		TenantId:    tenantID,
+		Description: util.StringValue(input.Description),
+		Permissions: input.Permissions,
+	})
 	if err != nil {
-		return &model.ProductResponse{
+		return &model.TenantRoleResponse{
 			Success: false,
-			Message: fmt.Sprintf("Update product failed: %v", err),
+			Message: fmt.Sprintf("Failed to update role: %v", err),
 		}, nil
 	}
 
 	if !resp.Success {
-		return &model.ProductResponse{
+		return &model.TenantRoleResponse{
 			Success: false,
 			Message: resp.Message,
 		}, nil
 	}
 
-	// Convert proto product to GraphQL model
-	product := resp.Data
-	return &model.ProductResponse{
+	return &model.TenantRoleResponse{
 		Success: true,
 		Message: resp.Message,
-		Data: &model.Product{
-			ID:          strconv.FormatInt(product.Id, 10),
-			Name:        product.Name,
-			Slug:        product.Slug,
-			Description: &product.Description,
-			Metadata:    &product.Metadata,
-			Features:    &product.Features,
-			IsPopular:   product.IsPopular,
-			IsDefault:   product.IsDefault,
-			CreatedAt:   int32(product.CreatedAt),
-			UpdatedAt:   int32(product.UpdatedAt),
-		},
+		Data:    pbTenantRoleToModel(resp.Data),
 	}, nil
 }
 
-// DeleteProduct is the resolver for the deleteProduct field.
-func (r *mutationResolver) DeleteProduct(ctx context.Context, id string) (*model.DeleteProductResponse, error) {
-	// Admin only
-	if err := middleware.RequireAdmin(ctx); err != nil {
-		return &model.DeleteProductResponse{
+// DeleteTenantRole is the resolver for the deleteTenantRole field.
+func (r *mutationResolver) DeleteTenantRole(ctx context.Context, tenantID *string, id string) (*model.DeleteTenantResponse, error) {
+	ctx, parsedTenantID, err := r.tenantScope(ctx, tenantID, rbac.PermRolesManage)
+	if err != nil {
+		return &model.DeleteTenantResponse{
 			Success: false,
-			Message: "Admin access required",
+			Message: err.Error(),
 		}, nil
 	}
 
-	// Parse product ID
-	productID, err := strconv.ParseInt(id, 10, 64)
+	roleID, err := strconv.ParseInt(id, 10, 64)
 	if err != nil {
-		return &model.DeleteProductResponse{
+		return &model.DeleteTenantResponse{
 			Success: false,
-			Message: "Invalid product ID",
+			Message: "Invalid role ID",
 		}, nil
 	}
 
-	// Call product-service via gRPC
-	resp, err := r.ProductClient.DeleteProduct(ctx, &productPb.DeleteProductRequest{
-		Id: productID,
+	resp, err := r.TenantClient.DeleteTenantRole(ctx, &tenantPb.DeleteTenantRoleRequest{
+		Id:       roleID,
+		TenantId: parsedTenantID,
 	})
 	if err != nil {
-		return &model.DeleteProductResponse{
+		return &model.DeleteTenantResponse{
 			Success: false,
-			Message: fmt.Sprintf("Delete product failed: %v", err),
+			Message: fmt.Sprintf("Failed to delete role: %v", err),
 		}, nil
 	}
 
-	return &model.DeleteProductResponse{
+	return &model.DeleteTenantResponse{
 		Success: resp.Success,
 		Message: resp.Message,
 	}, nil
 }
 
-// CreatePlan is the resolver for the createPlan field.
-func (r *mutationResolver) CreatePlan(ctx context.Context, input model.CreatePlanInput) (*model.PlanResponse, error) {
-	// Admin only
-	if err := middleware.RequireAdmin(ctx); err != nil {
-		return &model.PlanResponse{
+// TransferTenantOwnership is the resolver for the transferTenantOwnership field.
+func (r *mutationResolver) TransferTenantOwnership(ctx context.Context, input model.TransferTenantOwnershipInput) (*model.OwnershipChangeResponse, error) {
+	toUserID, err := strconv.ParseInt(input.ToUserID, 10, 64)
+	if err != nil {
+		return &model.OwnershipChangeResponse{
 			Success: false,
-			Message: "Admin access required",
+			Message: "Invalid user ID",
 		}, nil
 	}
 
-	// Parse IDs
-	intervalID, err := strconv.ParseInt(input.IntervalID, 10, 64)
+	// Members transfer their own ownership, and TransferOwnership requires
+	// the previous owner to be an owner
+	ctx, tenantID, err := r.tenantScope(ctx, input.TenantID, rbac.PermTenantDelete)
 	if err != nil {
-		return &model.PlanResponse{
+		return &model.OwnershipChangeResponse{
 			Success: false,
-			Message: "Invalid interval ID",
+			Message: err.Error(),
 		}, nil
 	}
 
-	productID, err := strconv.ParseInt(input.ProductID, 10, 64)
+	userClaims, err := middleware.GetUserFromContext(ctx)
 	if err != nil {
-		return &model.PlanResponse{
+		return &model.OwnershipChangeResponse{
 			Success: false,
-			Message: "Invalid product ID",
+			Message: err.Error(),
 		}, nil
 	}
 
-	// Prepare request
-	req := &productPb.CreatePlanRequest{
-		Name:          input.Name,
-		IntervalId:    intervalID,
-		ProductId:     productID,
-		IntervalCount: input.IntervalCount,
-		Type:          input.Type,
-	}
-
-	if input.Slug != nil {
-		req.Slug = input.Slug
-	}
-	if input.IsActive != nil {
-		req.IsActive = *input.IsActive
-	} else {
-		req.IsActive = true
-	}
-	if input.HasTrial != nil {
-		req.HasTrial = *input.HasTrial
-	}
-	if input.TrialIntervalID != nil {
-		trialIntervalID, err := strconv.ParseInt(*input.TrialIntervalID, 10, 64)
-		if err == nil {
-			req.TrialIntervalId = &trialIntervalID
+	fromUserID := userClaims.Id
+	if input.FromUserID != nil {
+		fromUserID, err = strconv.ParseInt(*input.FromUserID, 10, 64)
+		if err != nil {
+			return &model.OwnershipChangeResponse{
+				Success: false,
+				Message: "Invalid user ID",
+			}, nil
 		}
-	}
-	if input.TrialIntervalCount != nil {
-		req.TrialIntervalCount = *input.TrialIntervalCount
-	}
-	if input.Description != nil {
-		req.Description = *input.Description
-	}
-	if input.MaxUsersPerTenant != nil {
-		req.MaxUsersPerTenant = *input.MaxUsersPerTenant
-	}
-	if input.MeterID != nil {
-		meterID, err := strconv.ParseInt(*input.MeterID, 10, 64)
-		if err == nil {
-			req.MeterId = &meterID
+		if fromUserID != userClaims.Id && !userClaims.IsAdmin {
+			return &model.OwnershipChangeResponse{
+				Success: false,
+				Message: "Forbidden: only platform admins can transfer another owner's ownership",
+			}, nil
 		}
 	}
-	if input.IsVisible != nil {
-		req.IsVisible = *input.IsVisible
-	} else {
-		req.IsVisible = true
-	}
 
-	// Call product-service via gRPC
-	resp, err := r.ProductClient.CreatePlan(ctx, req)
+	resp, err := r.TenantClient.TransferOwnership(ctx, &tenantPb.TransferOwnershipRequest{
+		TenantId:    tenantID,
+		FromUserId:  fromUserID,
+		ToUserId:    toUserID,
+		PerformedBy: userClaims.Id,
+	})
 	if err != nil {
-		return &model.PlanResponse{
+		return &model.OwnershipChangeResponse{
 			Success: false,
-			Message: fmt.Sprintf("Create plan failed: %v", err),
+			Message: fmt.Sprintf("Failed to transfer ownership: %v", err),
 		}, nil
 	}
 
 	if !resp.Success {
-		return &model.PlanResponse{
+		return &model.OwnershipChangeResponse{
 			Success: false,
 			Message: resp.Message,
 		}, nil
 	}
 
-	// Convert proto plan to GraphQL model
-	plan := resp.Data
-	return &model.PlanResponse{
+	return &model.OwnershipChangeResponse{
 		Success: true,
 		Message: resp.Message,
-		Data: &model.Plan{
-			ID:         strconv.FormatInt(plan.Id, 10),
-			Name:       plan.Name,
-			Slug:       plan.Slug,
-			IntervalID: strconv.FormatInt(plan.IntervalId, 10),
-			ProductID:  strconv.FormatInt(plan.ProductId, 10),
-			IsActive:   plan.IsActive,
-			HasTrial:   plan.HasTrial,
-			TrialIntervalID: func() *string {
-				if plan.TrialIntervalId != nil {
-					s := strconv.FormatInt(*plan.TrialIntervalId, 10)
-					return &s
-				}
-				return nil
-			}(),
-			IntervalCount:      plan.IntervalCount,
-			TrialIntervalCount: &plan.TrialIntervalCount,
-			Description:        &plan.Description,
-			Type:               plan.Type,
-			MaxUsersPerTenant:  &plan.MaxUsersPerTenant,
-			MeterID: func() *string {
-				if plan.MeterId != nil {
-					s := strconv.FormatInt(*plan.MeterId, 10)
-					return &s
-				}
-				return nil
-			}(),
-			IsVisible: plan.IsVisible,
-			CreatedAt: int32(plan.CreatedAt),
-			UpdatedAt: int32(plan.UpdatedAt),
-		},
+		Data:    pbOwnershipChangeToModel(resp.Data),
 	}, nil
 }
 
-// UpdatePlan is the resolver for the updatePlan field.
-func (r *mutationResolver) UpdatePlan(ctx context.Context, input model.UpdatePlanInput) (*model.PlanResponse, error) {
-	// Admin only
-	if err := middleware.RequireAdmin(ctx); err != nil {
-		return &model.PlanResponse{
+// AcceptTenantInvitation is the resolver for the acceptTenantInvitation field.
+func (r *mutationResolver) AcceptTenantInvitation(ctx context.Context, token string) (*model.TenantInvitationResponse, error) {
+	// Signed-in invitees join now; others once they register
+	req := &tenantPb.AcceptInvitationRequest{Token: token}
+	if userClaims, _ := middleware.GetOptionalUserFromContext(ctx); userClaims != nil {
+		req.UserId = userClaims.Id
+		req.Email = userClaims.Email
+	}
+
+	resp, err := r.TenantClient.AcceptInvitation(ctx, req)
+	if err != nil {
+		return &model.TenantInvitationResponse{
 			Success: false,
-			Message: "Admin access required",
+			Message: fmt.Sprintf("Failed to accept invitation: %v", err),
 		}, nil
 	}
 
-	// Parse IDs
-	planID, err := strconv.ParseInt(input.ID, 10, 64)
-	if err != nil {
-		return &model.PlanResponse{
+	if !resp.Success {
+		return &model.TenantInvitationResponse{
 			Success: false,
-			Message: "Invalid plan ID",
+			Message: resp.Message,
 		}, nil
 	}
 
-	intervalID, err := strconv.ParseInt(input.IntervalID, 10, 64)
+	return &model.TenantInvitationResponse{
+		Success: true,
+		Message: resp.Message,
+		Data:    pbTenantInvitationToModel(resp.Data),
+	}, nil
+}
+
+// DeclineTenantInvitation is the resolver for the declineTenantInvitation field.
+func (r *mutationResolver) DeclineTenantInvitation(ctx context.Context, token string) (*model.TenantInvitationResponse, error) {
+	resp, err := r.TenantClient.DeclineInvitation(ctx, &tenantPb.DeclineInvitationRequest{
+		Token: token,
+	})
 	if err != nil {
-		return &model.PlanResponse{
+		return &model.TenantInvitationResponse{
 			Success: false,
-			Message: "Invalid interval ID",
+			Message: fmt.Sprintf("Failed to decline invitation: %v", err),
 		}, nil
 	}
 
-	// Prepare request
-	req := &productPb.UpdatePlanRequest{
-		Id:            planID,
-		Name:          input.Name,
-		IntervalId:    intervalID,
-		IntervalCount: input.IntervalCount,
-		Type:          input.Type,
+	if !resp.Success {
+		return &model.TenantInvitationResponse{
+			Success: false,
+			Message: resp.Message,
+		}, nil
 	}
 
-	if input.Slug != nil {
-		req.Slug = *input.Slug
-	}
-	if input.IsActive != nil {
-		req.IsActive = *input.IsActive
-	}
-	if input.HasTrial != nil {
-		req.HasTrial = *input.HasTrial
-	}
-	if input.TrialIntervalID != nil {
-		trialIntervalID, err := strconv.ParseInt(*input.TrialIntervalID, 10, 64)
-		if err == nil {
-			req.TrialIntervalId = &trialIntervalID
-		}
-	}
-	if input.TrialIntervalCount != nil {
-		req.TrialIntervalCount = *input.TrialIntervalCount
-	}
-	if input.Description != nil {
-		req.Description = *input.Description
-	}
-	if input.MaxUsersPerTenant != nil {
-		req.MaxUsersPerTenant = *input.MaxUsersPerTenant
-	}
-	if input.MeterID != nil {
-		meterID, err := strconv.ParseInt(*input.MeterID, 10, 64)
-		if err == nil {
-			req.MeterId = &meterID
-		}
-	}
-	if input.IsVisible != nil {
-		req.IsVisible = *input.IsVisible
-	}
+	return &model.TenantInvitationResponse{
+		Success: true,
+		Message: resp.Message,
+		Data:    pbTenantInvitationToModel(resp.Data),
+	}, nil
+}
 
-	// Call product-service via gRPC
-	resp, err := r.ProductClient.UpdatePlan(ctx, req)
+// SetTenantSetting is the resolver for the setTenantSetting field.
+func (r *mutationResolver) SetTenantSetting(ctx context.Context, input model.SetSettingInput) (*model.TenantSettingResponse, error) {
+	ctx, tenantID, err := r.tenantScope(ctx, input.TenantID, rbac.PermSettingsUpdate)
 	if err != nil {
-		return &model.PlanResponse{
+		return &model.TenantSettingResponse{
 			Success: false,
-			Message: fmt.Sprintf("Update plan failed: %v", err),
+			Message: err.Error(),
 		}, nil
 	}
 
-	if !resp.Success {
-		return &model.PlanResponse{
+	resp, err := r.TenantClient.SetSetting(ctx, &tenantPb.SetSettingRequest{
+		TenantId: tenantID,
+		Key:      input.Key,
+		Value:    input.Value,
+	})
+	if err != nil {
+		return &model.TenantSettingResponse{
 			Success: false,
+			Message: fmt.Sprintf("Failed to set tenant setting: %v", err),
+		}, nil
+	}
+
+	if !resp.Success || resp.Data == nil {
+		return &model.TenantSettingResponse{
+			Success: resp.Success,
 			Message: resp.Message,
 		}, nil
 	}
 
-	// Convert proto plan to GraphQL model
-	plan := resp.Data
-	return &model.PlanResponse{
+	setting := resp.Data
+	return &model.TenantSettingResponse{
 		Success: true,
-		Message: resp.Message,
-		Data: &model.Plan{
-			ID:         strconv.FormatInt(plan.Id, 10),
-			Name:       plan.Name,
-			Slug:       plan.Slug,
-			IntervalID: strconv.FormatInt(plan.IntervalId, 10),
-			ProductID:  strconv.FormatInt(plan.ProductId, 10),
-			IsActive:   plan.IsActive,
-			HasTrial:   plan.HasTrial,
-			TrialIntervalID: func() *string {
-				if plan.TrialIntervalId != nil {
-					s := strconv.FormatInt(*plan.TrialIntervalId, 10)
-					return &s
-				}
-				return nil
-			}(),
-			IntervalCount:      plan.IntervalCount,
-			TrialIntervalCount: &plan.TrialIntervalCount,
-			Description:        &plan.Description,
-			Type:               plan.Type,
-			MaxUsersPerTenant:  &plan.MaxUsersPerTenant,
-			MeterID: func() *string {
-				if plan.MeterId != nil {
-					s := strconv.FormatInt(*plan.MeterId, 10)
-					return &s
-				}
-				return nil
-			}(),
-			IsVisible: plan.IsVisible,
-			CreatedAt: int32(plan.CreatedAt),
-			UpdatedAt: int32(plan.UpdatedAt),
+		Message: "Tenant setting saved successfully",
+		Data: &model.TenantSetting{
+			ID:        strconv.FormatInt(setting.Id, 10),
+			TenantID:  strconv.FormatInt(setting.TenantId, 10),
+			Key:       setting.Key,
+			Value:     setting.Value,
+			CreatedAt: int32(setting.CreatedAt),
+			UpdatedAt: int32(setting.UpdatedAt),
 		},
 	}, nil
 }
 
-// DeletePlan is the resolver for the deletePlan field.
-func (r *mutationResolver) DeletePlan(ctx context.Context, id string) (*model.DeletePlanResponse, error) {
-	// Admin only
-	if err := middleware.RequireAdmin(ctx); err != nil {
-		return &model.DeletePlanResponse{
-			Success: false,
-			Message: "Admin access required",
-		}, nil
-	}
-
-	// Parse plan ID
-	planID, err := strconv.ParseInt(id, 10, 64)
+// DeleteTenantSetting is the resolver for the deleteTenantSetting field.
+func (r *mutationResolver) DeleteTenantSetting(ctx context.Context, tenantID *string, key string) (*model.DeleteSettingResponse, error) {
+	ctx, tenantIDInt, err := r.tenantScope(ctx, tenantID, rbac.PermSettingsUpdate)
 	if err != nil {
-		return &model.DeletePlanResponse{
+		return &model.DeleteSettingResponse{
 			Success: false,
-			Message: "Invalid plan ID",
+			Message: err.Error(),
 		}, nil
 	}
 
-	// Call product-service via gRPC
-	resp, err := r.ProductClient.DeletePlan(ctx, &productPb.DeletePlanRequest{
-		Id: planID,
+	resp, err := r.TenantClient.DeleteSetting(ctx, &tenantPb.DeleteSettingRequest{
+		TenantId: tenantIDInt,
+		Key:      key,
 	})
 	if err != nil {
-		return &model.DeletePlanResponse{
+		return &model.DeleteSettingResponse{
 			Success: false,
-			Message: fmt.Sprintf("Delete plan failed: %v", err),
+			Message: fmt.Sprintf("Failed to delete tenant setting: %v", err),
 		}, nil
 	}
 
-	return &model.DeletePlanResponse{
+	return &model.DeleteSettingResponse{
 		Success: resp.Success,
 		Message: resp.Message,
 	}, nil
 }
 
-// CreateDiscount is the resolver for the createDiscount field.
-func (r *mutationResolver) CreateDiscount(ctx context.Context, input model.CreateDiscountInput) (*model.DiscountResponse, error) {
+// CreateProduct is the resolver for the createProduct field.
+func (r *mutationResolver) CreateProduct(ctx context.Context, input model.CreateProductInput) (*model.ProductResponse, error) {
 	// Admin only
 	if err := middleware.RequireAdmin(ctx); err != nil {
-		return &model.DiscountResponse{
+		return &model.ProductResponse{
 			Success: false,
 			Message: "Admin access required",
 		}, nil
 	}
 
-	// Prepare request
-	req := &productPb.CreateDiscountRequest{
-		Name:        input.Name,
-		Type:        input.Type,
-		Amount:      input.Amount,
-		IsActive:    input.IsActive,
-		IsRecurring: input.IsRecurring,
-	}
-
-	// Handle optional fields
-	if input.Description != nil {
-		req.Description = input.Description
-	}
-	if input.ValidUntil != nil {
-		validUntil := int64(*input.ValidUntil)
-		req.ValidUntil = &validUntil
-	}
-	if input.ActionType != nil {
-		req.ActionType = input.ActionType
-	}
-	if input.MaxRedemptions != nil {
-		maxRedemptions := int32(*input.MaxRedemptions)
-		req.MaxRedemptions = &maxRedemptions
-	}
-	if input.MaxRedemptionsPerUser != nil {
-		maxRedemptionsPerUser := int32(*input.MaxRedemptionsPerUser)
-		req.MaxRedemptionsPerUser = &maxRedemptionsPerUser
+	// Prepare request with optional fields
+	req := &productPb.CreateProductRequest{
+		Name: input.Name,
 	}
-	if input.DurationInMonths != nil {
-		durationInMonths := int32(*input.DurationInMonths)
-		req.DurationInMonths = &durationInMonths
+	if input.Slug != nil {
+		req.Slug = *input.Slug
 	}
-	if input.MaximumRecurringIntervals != nil {
-		maximumRecurringIntervals := int32(*input.MaximumRecurringIntervals)
-		req.MaximumRecurringIntervals = &maximumRecurringIntervals
+	if input.Description != nil {
+		req.Description = *input.Description
 	}
-	if input.RedeemType != nil {
-		redeemType := int32(*input.RedeemType)
-		req.RedeemType = &redeemType
+	if input.Metadata != nil {
+		req.Metadata = *input.Metadata
 	}
-	if input.BonusDays != nil {
-		bonusDays := int32(*input.BonusDays)
-		req.BonusDays = &bonusDays
+	if input.Features != nil {
+		req.Features = *input.Features
 	}
-	if input.IsEnabledForAllPlans != nil {
-		req.IsEnabledForAllPlans = *input.IsEnabledForAllPlans
+	if input.IsPopular != nil {
+		req.IsPopular = *input.IsPopular
 	}
-	if input.IsEnabledForAllOneTimeProducts != nil {
-		req.IsEnabledForAllOneTimeProducts = *input.IsEnabledForAllOneTimeProducts
+	if input.IsDefault != nil {
+		req.IsDefault = *input.IsDefault
 	}
 
 	// Call product-service via gRPC
-	resp, err := r.ProductClient.CreateDiscount(ctx, req)
+	resp, err := r.ProductClient.CreateProduct(ctx, req)
 	if err != nil {
-		return &model.DiscountResponse{
+		return &model.ProductResponse{
 			Success: false,
-			Message: fmt.Sprintf("Create discount failed: %v", err),
+			Message: fmt.Sprintf("Create product failed: %v", err),
 		}, nil
 	}
 
 	if !resp.Success {
-		return &model.DiscountResponse{
+		return &model.ProductResponse{
 			Success: false,
 			Message: resp.Message,
 		}, nil
 	}
 
-	// Convert proto discount to GraphQL model
-	discount := resp.Data
-	return &model.DiscountResponse{
+	// Convert proto product to GraphQL model
+	product := resp.Data
+	return &model.ProductResponse{
 		Success: true,
 		Message: resp.Message,
-		Data: &model.Discount{
-			ID:          strconv.FormatInt(discount.Id, 10),
-			Name:        discount.Name,
-			Description: discount.Description,
-			Type:        discount.Type,
-			Amount:      discount.Amount,
-			ValidUntil: func() *int32 {
-				if discount.ValidUntil != nil {
-					v := int32(*discount.ValidUntil)
-					return &v
-				}
-				return nil
-			}(),
-			IsActive:   discount.IsActive,
-			ActionType: discount.ActionType,
-			MaxRedemptions: func() *int32 {
-				if discount.MaxRedemptions != nil {
-					v := int32(*discount.MaxRedemptions)
-					return &v
-				}
-				return nil
-			}(),
-			MaxRedemptionsPerUser: func() *int32 {
-				if discount.MaxRedemptionsPerUser != nil {
-					v := int32(*discount.MaxRedemptionsPerUser)
-					return &v
-				}
-				return nil
-			}(),
-			Redemptions: int32(discount.Redemptions),
-			IsRecurring: discount.IsRecurring,
-			DurationInMonths: func() *int32 {
-				if discount.DurationInMonths != nil {
-					v := int32(*discount.DurationInMonths)
-					return &v
-				}
-				return nil
-			}(),
-			MaximumRecurringIntervals: func() *int32 {
-				if discount.MaximumRecurringIntervals != nil {
-					v := int32(*discount.MaximumRecurringIntervals)
-					return &v
-				}
-				return nil
-			}(),
-			RedeemType: func() *int32 {
-				if discount.RedeemType != nil {
-					v := int32(*discount.RedeemType)
-					return &v
-				}
-				return nil
-			}(),
-			BonusDays: func() *int32 {
-				if discount.BonusDays != nil {
-					v := int32(*discount.BonusDays)
-					return &v
-				}
-				return nil
-			}(),
-			IsEnabledForAllPlans:           discount.IsEnabledForAllPlans,
-			IsEnabledForAllOneTimeProducts: discount.IsEnabledForAllOneTimeProducts,
-			CreatedAt:                      int32(discount.CreatedAt),
-			UpdatedAt:                      int32(discount.UpdatedAt),
+		Data: &model.Product{
+			ID:          strconv.FormatInt(product.Id, 10),
+			Name:        product.Name,
+			Slug:        product.Slug,
+			Description: &product.Description,
+			Metadata:    &product.Metadata,
+			Features:    &product.Features,
+			IsPopular:   product.IsPopular,
+			IsDefault:   product.IsDefault,
+			CreatedAt:   int32(product.CreatedAt),
+			UpdatedAt:   int32(product.UpdatedAt),
 		},
 	}, nil
 }
 
-// UpdateDiscount is the resolver for the updateDiscount field.
-func (r *mutationResolver) UpdateDiscount(ctx context.Context, input model.UpdateDiscountInput) (*model.DiscountResponse, error) {
+// UpdateProduct is the resolver for the updateProduct field.
+func (r *mutationResolver) UpdateProduct(ctx context.Context, input model.UpdateProductInput) (*model.ProductResponse, error) {
 	// Admin only
 	if err := middleware.RequireAdmin(ctx); err != nil {
-		return &model.DiscountResponse{
+		return &model.ProductResponse{
 			Success: false,
 			Message: "Admin access required",
 		}, nil
 	}
 
-	// Parse ID
-	discountID, err := strconv.ParseInt(input.ID, 10, 64)
+	// Parse product ID
+	productID, err := strconv.ParseInt(input.ID, 10, 64)
 	if err != nil {
-		return &model.DiscountResponse{
+		return &model.ProductResponse{
 			Success: false,
-			Message: "Invalid discount ID",
+			Message: "Invalid product ID",
 		}, nil
 	}
 
 	// Prepare request
-	req := &productPb.UpdateDiscountRequest{
-		Id:          discountID,
-		Name:        input.Name,
-		Type:        input.Type,
-		Amount:      input.Amount,
-		IsActive:    input.IsActive,
-		IsRecurring: input.IsRecurring,
-	}
-
-	// Handle optional fields
-	if input.Description != nil {
-		req.Description = input.Description
-	}
-	if input.ValidUntil != nil {
-		validUntil := int64(*input.ValidUntil)
-		req.ValidUntil = &validUntil
-	}
-	if input.ActionType != nil {
-		req.ActionType = input.ActionType
-	}
-	if input.MaxRedemptions != nil {
-		maxRedemptions := int32(*input.MaxRedemptions)
-		req.MaxRedemptions = &maxRedemptions
-	}
-	if input.MaxRedemptionsPerUser != nil {
-		maxRedemptionsPerUser := int32(*input.MaxRedemptionsPerUser)
-		req.MaxRedemptionsPerUser = &maxRedemptionsPerUser
+	req := &productPb.UpdateProductRequest{
+		Id:   productID,
+		Name: input.Name,
 	}
-	if input.DurationInMonths != nil {
-		durationInMonths := int32(*input.DurationInMonths)
-		req.DurationInMonths = &durationInMonths
+	if input.Slug != nil {
+		req.Slug = *input.Slug
 	}
-	if input.MaximumRecurringIntervals != nil {
-		maximumRecurringIntervals := int32(*input.MaximumRecurringIntervals)
-		req.MaximumRecurringIntervals = &maximumRecurringIntervals
+	if input.Description != nil {
+		req.Description = *input.Description
 	}
-	if input.RedeemType != nil {
-		redeemType := int32(*input.RedeemType)
-		req.RedeemType = &redeemType
+	if input.Metadata != nil {
+		req.Metadata = *input.Metadata
 	}
-	if input.BonusDays != nil {
-		bonusDays := int32(*input.BonusDays)
-		req.BonusDays = &bonusDays
+	if input.Features != nil {
+		req.Features = *input.Features
 	}
-	if input.IsEnabledForAllPlans != nil {
-		req.IsEnabledForAllPlans = *input.IsEnabledForAllPlans
+	if input.IsPopular != nil {
+		req.IsPopular = *input.IsPopular
 	}
-	if input.IsEnabledForAllOneTimeProducts != nil {
-		req.IsEnabledForAllOneTimeProducts = *input.IsEnabledForAllOneTimeProducts
+	if input.IsDefault != nil {
+		req.IsDefault = *input.IsDefault
 	}
 
 	// Call product-service via gRPC
-	resp, err := r.ProductClient.UpdateDiscount(ctx, req)
+	resp, err := r.ProductClient.UpdateProduct(ctx, req)
 	if err != nil {
-		return &model.DiscountResponse{
+		return &model.ProductResponse{
 			Success: false,
-			Message: fmt.Sprintf("Update discount failed: %v", err),
+			Message: fmt.Sprintf("Update product failed: %v", err),
 		}, nil
 	}
 
 	if !resp.Success {
-		return &model.DiscountResponse{
+		return &model.ProductResponse{
 			Success: false,
 			Message: resp.Message,
 		}, nil
 	}
 
-	// Convert proto discount to GraphQL model
-	discount := resp.Data
-	return &model.DiscountResponse{
+	// Convert proto product to GraphQL model
+	product := resp.Data
+	return &model.ProductResponse{
 		Success: true,
 		Message: resp.Message,
-		Data: &model.Discount{
-			ID:          strconv.FormatInt(discount.Id, 10),
-			Name:        discount.Name,
-			Description: discount.Description,
-			Type:        discount.Type,
-			Amount:      discount.Amount,
-			ValidUntil: func() *int32 {
-				if discount.ValidUntil != nil {
-					v := int32(*discount.ValidUntil)
-					return &v
-				}
-				return nil
-			}(),
-			IsActive:   discount.IsActive,
-			ActionType: discount.ActionType,
-			MaxRedemptions: func() *int32 {
-				if discount.MaxRedemptions != nil {
-					v := int32(*discount.MaxRedemptions)
-					return &v
-				}
-				return nil
-			}(),
-			MaxRedemptionsPerUser: func() *int32 {
-				if discount.MaxRedemptionsPerUser != nil {
-					v := int32(*discount.MaxRedemptionsPerUser)
-					return &v
-				}
-				return nil
-			}(),
-			Redemptions: int32(discount.Redemptions),
-			IsRecurring: discount.IsRecurring,
-			DurationInMonths: func() *int32 {
-				if discount.DurationInMonths != nil {
-					v := int32(*discount.DurationInMonths)
-					return &v
-				}
-				return nil
-			}(),
-			MaximumRecurringIntervals: func() *int32 {
-				if discount.MaximumRecurringIntervals != nil {
-					v := int32(*discount.MaximumRecurringIntervals)
-					return &v
-				}
-				return nil
-			}(),
-			RedeemType: func() *int32 {
-				if discount.RedeemType != nil {
-					v := int32(*discount.RedeemType)
-					return &v
-				}
-				return nil
-			}(),
-			BonusDays: func() *int32 {
-				if discount.BonusDays != nil {
-					v := int32(*discount.BonusDays)
-					return &v
-				}
-				return nil
-			}(),
-			IsEnabledForAllPlans:           discount.IsEnabledForAllPlans,
-			IsEnabledForAllOneTimeProducts: discount.IsEnabledForAllOneTimeProducts,
-			CreatedAt:                      int32(discount.CreatedAt),
-			UpdatedAt:                      int32(discount.UpdatedAt),
+		Data: &model.Product{
+			ID:          strconv.FormatInt(product.Id, 10),
+			Name:        product.Name,
+			Slug:        product.Slug,
+			Description: &product.Description,
+			Metadata:    &product.Metadata,
+			Features:    &product.Features,
+			IsPopular:   product.IsPopular,
+			IsDefault:   product.IsDefault,
+			CreatedAt:   int32(product.CreatedAt),
+			UpdatedAt:   int32(product.UpdatedAt),
 		},
 	}, nil
 }
 
-// DeleteDiscount is the resolver for the deleteDiscount field.
-func (r *mutationResolver) DeleteDiscount(ctx context.Context, id string) (*model.DeleteDiscountResponse, error) {
+// DeleteProduct is the resolver for the deleteProduct field.
+func (r *mutationResolver) DeleteProduct(ctx context.Context, id string) (*model.DeleteProductResponse, error) {
 	// Admin only
 	if err := middleware.RequireAdmin(ctx); err != nil {
-		return &model.DeleteDiscountResponse{
+		return &model.DeleteProductResponse{
 			Success: false,
 			Message: "Admin access required",
 		}, nil
 	}
 
-	// Parse ID
-	discountID, err := strconv.ParseInt(id, 10, 64)
+	// Parse product ID
+	productID, err := strconv.ParseInt(id, 10, 64)
 	if err != nil {
-		return &model.DeleteDiscountResponse{
+		return &model.DeleteProductResponse{
 			Success: false,
-			Message: "Invalid discount ID",
+			Message: "Invalid product ID",
 		}, nil
 	}
 
 	// Call product-service via gRPC
-	resp, err := r.ProductClient.DeleteDiscount(ctx, &productPb.DeleteDiscountRequest{
-		Id: discountID,
+	resp, err := r.ProductClient.DeleteProduct(ctx, &productPb.DeleteProductRequest{
+		Id: productID,
 	})
 	if err != nil {
-		return &model.DeleteDiscountResponse{
+		return &model.DeleteProductResponse{
 			Success: false,
-			Message: fmt.Sprintf("Delete discount failed: %v", err),
+			Message: fmt.Sprintf("Delete product failed: %v", err),
 		}, nil
 	}
 
-	return &model.DeleteDiscountResponse{
+	return &model.DeleteProductResponse{
 		Success: resp.Success,
 		Message: resp.Message,
 	}, nil
 }
 
-// UploadFile is the resolver for the uploadFile field.
-func (r *mutationResolver) UploadFile(ctx context.Context, input model.UploadFileInput) (*model.MediaResponse, error) {
-	// Convert model ID to int64
-	modelID, err := strconv.ParseInt(input.ModelID, 10, 64)
-	if err != nil {
-		return &model.MediaResponse{
+// CreatePlan is the resolver for the createPlan field.
+func (r *mutationResolver) CreatePlan(ctx context.Context, input model.CreatePlanInput) (*model.PlanResponse, error) {
+	// Admin only
+	if err := middleware.RequireAdmin(ctx); err != nil {
+		return &model.PlanResponse{
 			Success: false,
-			Message: "Invalid model ID",
+			Message: "Admin access required",
 		}, nil
 	}
 
-	// graphql.Upload struct - use reflection to access File field
-	v := reflect.ValueOf(input.Content)
-
-	// Get File field
-	fileField := v.FieldByName("File")
-	if !fileField.IsValid() {
-		return &model.MediaResponse{
+	// Parse IDs
+	intervalID, err := strconv.ParseInt(input.IntervalID, 10, 64)
+	if err != nil {
+		return &model.PlanResponse{
 			Success: false,
-			Message: "Upload struct has no File field",
+			Message: "Invalid interval ID",
 		}, nil
 	}
 
-	// File field is io.Reader (transport.bytesReader internally)
-	reader, ok := fileField.Interface().(io.Reader)
-	if !ok {
-		return &model.MediaResponse{
+	productID, err := strconv.ParseInt(input.ProductID, 10, 64)
+	if err != nil {
+		return &model.PlanResponse{
 			Success: false,
-			Message: fmt.Sprintf("File field does not implement io.Reader. Type: %T", fileField.Interface()),
+			Message: "Invalid product ID",
 		}, nil
 	}
 
-	// Read file content
-	fileContent, err := io.ReadAll(reader)
-	if err != nil {
-		return &model.MediaResponse{
-			Success: false,
-			Message: "Failed to read file content",
-		}, nil
+	// Prepare request
+	req := &productPb.CreatePlanRequest{
+		Name:          input.Name,
+		IntervalId:    intervalID,
+		ProductId:     productID,
+		IntervalCount: input.IntervalCount,
+		Type:          input.Type,
 	}
 
-	// Handle optional name field
-	name := ""
-	if input.Name != nil {
-		name = *input.Name
+	if input.Slug != nil {
+		req.Slug = input.Slug
 	}
-
-	// Delete existing media with same model_type, model_id, and collection_name
-	// This ensures only one avatar exists per user
-	existingMediaResp, err := r.Resolver.MediaClient.GetFilesByModel(ctx, &mediaPb.GetFilesByModelRequest{
-		ModelType:      input.ModelType,
-		ModelId:        modelID,
-		CollectionName: input.CollectionName,
-		Page:           1,
-		PerPage:        100, // Get all existing media
-	})
-	if err == nil && existingMediaResp.Success && existingMediaResp.Data != nil {
-		// Delete each existing media
-		for _, media := range existingMediaResp.Data.Media {
-			_, _ = r.Resolver.MediaClient.DeleteFile(ctx, &mediaPb.DeleteFileRequest{
-				Id: media.Id,
-			})
+	if input.IsActive != nil {
+		req.IsActive = *input.IsActive
+	} else {
+		req.IsActive = true
+	}
+	if input.HasTrial != nil {
+		req.HasTrial = *input.HasTrial
+	}
+	if input.TrialIntervalID != nil {
+		trialIntervalID, err := strconv.ParseInt(*input.TrialIntervalID, 10, 64)
+		if err == nil {
+			req.TrialIntervalId = &trialIntervalID
+		}
+	}
+	if input.TrialIntervalCount != nil {
+		req.TrialIntervalCount = *input.TrialIntervalCount
+	}
+	if input.Description != nil {
+		req.Description = *input.Description
+	}
+	if input.MaxUsersPerTenant != nil {
+		req.MaxUsersPerTenant = *input.MaxUsersPerTenant
+	}
+	if input.MeterID != nil {
+		meterID, err := strconv.ParseInt(*input.MeterID, 10, 64)
+		if err == nil {
+			req.MeterId = &meterID
 		}
 	}
+	if input.IsVisible != nil {
+		req.IsVisible = *input.IsVisible
+	} else {
+		req.IsVisible = true
+	}
 
-	// Call media-service via gRPC
-	uploadResp, err := r.Resolver.MediaClient.UploadFile(ctx, &mediaPb.UploadFileRequest{
-		Content:        fileContent,
-		FileName:       input.FileName,
-		MimeType:       input.MimeType,
-		Size:           int64(len(fileContent)),
-		ModelType:      input.ModelType,
-		ModelId:        modelID,
-		CollectionName: input.CollectionName,
-		Name:           name,
-		Disk:           input.Disk,
-	})
+	// Call product-service via gRPC
+	resp, err := r.ProductClient.CreatePlan(ctx, req)
 	if err != nil {
-		return &model.MediaResponse{
+		return &model.PlanResponse{
 			Success: false,
-			Message: fmt.Sprintf("Failed to upload file: %v", err),
+			Message: fmt.Sprintf("Create plan failed: %v", err),
 		}, nil
 	}
 
-	if !uploadResp.Success {
-		return &model.MediaResponse{
+	if !resp.Success {
+		return &model.PlanResponse{
 			Success: false,
-			Message: uploadResp.Message,
+			Message: resp.Message,
 		}, nil
 	}
 
-	media := pbMediaToModel(uploadResp.Data)
-
-	// Invalidate media cache for this model
-	if r.Resolver.MediaCache != nil {
-		_ = r.Resolver.MediaCache.InvalidateModelMedia(ctx, input.ModelType, input.ModelID, input.CollectionName)
-		// Also invalidate user avatar cache if this is a user avatar
-		if input.ModelType == "user" && input.CollectionName == "avatar" {
-			_ = r.Resolver.MediaCache.InvalidateUserAvatar(ctx, input.ModelID)
-		}
-	}
-
-	return &model.MediaResponse{
+	// Convert proto plan to GraphQL model
+	plan := resp.Data
+	return &model.PlanResponse{
 		Success: true,
-		Message: "File uploaded successfully",
-		Data:    media,
+		Message: resp.Message,
+		Data: &model.Plan{
+			ID:         strconv.FormatInt(plan.Id, 10),
+			Name:       plan.Name,
+			Slug:       plan.Slug,
+			IntervalID: strconv.FormatInt(plan.IntervalId, 10),
+			ProductID:  strconv.FormatInt(plan.ProductId, 10),
+			IsActive:   plan.IsActive,
+			HasTrial:   plan.HasTrial,
+			TrialIntervalID: func() *string {
+				if plan.TrialIntervalId != nil {
+					s := strconv.FormatInt(*plan.TrialIntervalId, 10)
+					return &s
+				}
+				return nil
+			}(),
+			IntervalCount:      plan.IntervalCount,
+			TrialIntervalCount: &plan.TrialIntervalCount,
+			Description:        &plan.Description,
+			Type:               plan.Type,
+			MaxUsersPerTenant:  &plan.MaxUsersPerTenant,
+			MeterID: func() *string {
+				if plan.MeterId != nil {
+					s := strconv.FormatInt(*plan.MeterId, 10)
+					return &s
+				}
+				return nil
+			}(),
+			IsVisible: plan.IsVisible,
+			CreatedAt: int32(plan.CreatedAt),
+			UpdatedAt: int32(plan.UpdatedAt),
+		},
 	}, nil
 }
 
-// DeleteMedia is the resolver for the deleteMedia field.
-func (r *mutationResolver) DeleteMedia(ctx context.Context, id string) (*model.DeleteMediaResponse, error) {
-	mediaID, err := strconv.ParseInt(id, 10, 64)
-	if err != nil {
-		return &model.DeleteMediaResponse{
+// UpdatePlan is the resolver for the updatePlan field.
+func (r *mutationResolver) UpdatePlan(ctx context.Context, input model.UpdatePlanInput) (*model.PlanResponse, error) {
+	// Admin only
+	if err := middleware.RequireAdmin(ctx); err != nil {
+		return &model.PlanResponse{
 			Success: false,
-			Message: "Invalid media ID",
+			Message: "Admin access required",
 		}, nil
 	}
 
-	resp, err := r.MediaClient.DeleteFile(ctx, &mediaPb.DeleteFileRequest{
-		Id: mediaID,
-	})
+	// Parse IDs
+	planID, err := strconv.ParseInt(input.ID, 10, 64)
 	if err != nil {
-		return &model.DeleteMediaResponse{
+		return &model.PlanResponse{
 			Success: false,
-			Message: fmt.Sprintf("Failed to delete media: %v", err),
+			Message: "Invalid plan ID",
 		}, nil
 	}
 
-	return &model.DeleteMediaResponse{
-		Success: resp.Success,
-		Message: resp.Message,
-	}, nil
-}
-
-// User is the resolver for the user field.
-func (r *queryResolver) User(ctx context.Context, id string) (*model.UserResponse, error) {
-	userID, err := strconv.ParseInt(id, 10, 64)
+	intervalID, err := strconv.ParseInt(input.IntervalID, 10, 64)
 	if err != nil {
-		return &model.UserResponse{
+		return &model.PlanResponse{
 			Success: false,
-			Message: "Invalid user ID",
+			Message: "Invalid interval ID",
 		}, nil
 	}
 
-	// Call user-service via gRPC
-	resp, err := r.UserClient.GetUserByID(ctx, &userPb.GetUserByIDRequest{
-		Id: userID,
-	})
+	// Prepare request
+	req := &productPb.UpdatePlanRequest{
+		Id:            planID,
+		Name:          input.Name,
+		IntervalId:    intervalID,
+		IntervalCount: input.IntervalCount,
+		Type:          input.Type,
+	}
+
+	if input.Slug != nil {
+		req.Slug = *input.Slug
+	}
+	if input.IsActive != nil {
+		req.IsActive = *input.IsActive
+	}
+	if input.HasTrial != nil {
+		req.HasTrial = *input.HasTrial
+	}
+	if input.TrialIntervalID != nil {
+		trialIntervalID, err := strconv.ParseInt(*input.TrialIntervalID, 10, 64)
+		if err == nil {
+			req.TrialIntervalId = &trialIntervalID
+		}
+	}
+	if input.TrialIntervalCount != nil {
+		req.TrialIntervalCount = *input.TrialIntervalCount
+	}
+	if input.Description != nil {
+		req.Description = *input.Description
+	}
+	if input.MaxUsersPerTenant != nil {
+		req.MaxUsersPerTenant = *input.MaxUsersPerTenant
+	}
+	if input.MeterID != nil {
+		meterID, err := strconv.ParseInt(*input.MeterID, 10, 64)
+		if err == nil {
+			req.MeterId = &meterID
+		}
+	}
+	if input.IsVisible != nil {
+		req.IsVisible = *input.IsVisible
+	}
+
+	// Call product-service via gRPC
+	resp, err := r.ProductClient.UpdatePlan(ctx, req)
 	if err != nil {
-		return &model.UserResponse{
+		return &model.PlanResponse{
 			Success: false,
-			Message: fmt.Sprintf("Get user failed: %v", err),
+			Message: fmt.Sprintf("Update plan failed: %v", err),
 		}, nil
 	}
 
 	if !resp.Success {
-		return &model.UserResponse{
+		return &model.PlanResponse{
 			Success: false,
 			Message: resp.Message,
 		}, nil
 	}
 
-	emailVerifiedAt := int32(resp.Data.EmailVerifiedAt)
-	lastLoginAt := int32(resp.Data.LastLoginAt)
-	createdAt := int32(resp.Data.CreatedAt)
-	updatedAt := int32(resp.Data.UpdatedAt)
-
-	return &model.UserResponse{
+	// Convert proto plan to GraphQL model
+	plan := resp.Data
+	return &model.PlanResponse{
 		Success: true,
-		Message: "User retrieved successfully",
-		Data: &model.User{
-			ID:              fmt.Sprintf("%d", resp.Data.Id),
-			Name:            resp.Data.Name,
-			Email:           resp.Data.Email,
-			PublicName:      &resp.Data.PublicName,
-			IsAdmin:         resp.Data.IsAdmin,
-			IsBlocked:       resp.Data.IsBlocked,
-			PhoneNumber:     &resp.Data.PhoneNumber,
-			Position:        &resp.Data.Position,
-			EmailVerified:   resp.Data.EmailVerified,
-			EmailVerifiedAt: &emailVerifiedAt,
-			LastLoginAt:     &lastLoginAt,
-			CreatedAt:       &createdAt,
-			UpdatedAt:       &updatedAt,
+		Message: resp.Message,
+		Data: &model.Plan{
+			ID:         strconv.FormatInt(plan.Id, 10),
+			Name:       plan.Name,
+			Slug:       plan.Slug,
+			IntervalID: strconv.FormatInt(plan.IntervalId, 10),
+			ProductID:  strconv.FormatInt(plan.ProductId, 10),
+			IsActive:   plan.IsActive,
+			HasTrial:   plan.HasTrial,
+			TrialIntervalID: func() *string {
+				if plan.TrialIntervalId != nil {
+					s := strconv.FormatInt(*plan.TrialIntervalId, 10)
+					return &s
+				}
+				return nil
+			}(),
+			IntervalCount:      plan.IntervalCount,
+			TrialIntervalCount: &plan.TrialIntervalCount,
+			Description:        &plan.Description,
+			Type:               plan.Type,
+			MaxUsersPerTenant:  &plan.MaxUsersPerTenant,
+			MeterID: func() *string {
+				if plan.MeterId != nil {
+					s := strconv.FormatInt(*plan.MeterId, 10)
+					return &s
+				}
+				return nil
+			}(),
+			IsVisible: plan.IsVisible,
+			CreatedAt: int32(plan.CreatedAt),
+			UpdatedAt: int32(plan.UpdatedAt),
 		},
 	}, nil
 }
 
-// Users is the resolver for the users field.
-func (r *queryResolver) Users(ctx context.Context, page *int32, perPage *int32) (*model.UserListResponse, error) {
-	// Default pagination
-	pageValue := int32(1)
-	if page != nil {
-		pageValue = *page
-	}
-	perPageValue := int32(10)
-	if perPage != nil {
-		perPageValue = *perPage
-	}
-
-	// Call user-service via gRPC
-	resp, err := r.UserClient.GetAllUsers(ctx, &userPb.GetAllUsersRequest{
-		Page:    pageValue,
-		PerPage: perPageValue,
-	})
-	if err != nil {
-		return &model.UserListResponse{
-			Success: false,
-			Message: fmt.Sprintf("Get users failed: %v", err),
-		}, nil
-	}
-
-	if !resp.Success {
-		return &model.UserListResponse{
+// DeletePlan is the resolver for the deletePlan field.
+func (r *mutationResolver) DeletePlan(ctx context.Context, id string) (*model.DeletePlanResponse, error) {
+	// Admin only
+	if err := middleware.RequireAdmin(ctx); err != nil {
+		return &model.DeletePlanResponse{
 			Success: false,
-			Message: resp.Message,
+			Message: "Admin access required",
 		}, nil
 	}
 
-	// Convert users
-	users := make([]*model.User, len(resp.Data.Users))
-	for i, u := range resp.Data.Users {
-		users[i] = &model.User{
-			ID:          fmt.Sprintf("%d", u.Id),
-			Name:        u.Name,
-			Email:       u.Email,
-			PublicName:  &u.PublicName,
-			IsAdmin:     u.IsAdmin,
-			IsBlocked:   u.IsBlocked,
-			PhoneNumber: &u.PhoneNumber,
-			Position:    &u.Position,
-		}
-	}
-
-	return &model.UserListResponse{
-		Success: true,
-		Message: "Users retrieved successfully",
-		Data: &model.UserList{
-			Users:   users,
-			Total:   resp.Data.Total,
-			Page:    resp.Data.Page,
-			PerPage: resp.Data.PerPage,
-		},
-	}, nil
-}
-
-// Media is the resolver for the media field.
-func (r *queryResolver) Media(ctx context.Context, id string) (*model.MediaResponse, error) {
-	mediaID, err := strconv.ParseInt(id, 10, 64)
+	// Parse plan ID
+	planID, err := strconv.ParseInt(id, 10, 64)
 	if err != nil {
-		return &model.MediaResponse{
+		return &model.DeletePlanResponse{
 			Success: false,
-			Message: "Invalid media ID",
+			Message: "Invalid plan ID",
 		}, nil
 	}
 
-	resp, err := r.MediaClient.GetFileByID(ctx, &mediaPb.GetFileByIDRequest{
-		Id: mediaID,
+	// Call product-service via gRPC
+	resp, err := r.ProductClient.DeletePlan(ctx, &productPb.DeletePlanRequest{
+		Id: planID,
 	})
 	if err != nil {
-		return &model.MediaResponse{
-			Success: false,
-			Message: fmt.Sprintf("Failed to get media: %v", err),
-		}, nil
-	}
-
-	if !resp.Success {
-		return &model.MediaResponse{
+		return &model.DeletePlanResponse{
 			Success: false,
-			Message: resp.Message,
+			Message: fmt.Sprintf("Delete plan failed: %v", err),
 		}, nil
 	}
 
-	return &model.MediaResponse{
-		Success: true,
+	return &model.DeletePlanResponse{
+		Success: resp.Success,
 		Message: resp.Message,
-		Data:    pbMediaToModel(resp.Data),
 	}, nil
 }
 
-// MediaByUUID is the resolver for the mediaByUUID field.
-func (r *queryResolver) MediaByUUID(ctx context.Context, uuid string) (*model.MediaResponse, error) {
-	resp, err := r.MediaClient.GetFileByUUID(ctx, &mediaPb.GetFileByUUIDRequest{
-		Uuid: uuid,
-	})
-	if err != nil {
-		return &model.MediaResponse{
+// CreateDiscount is the resolver for the createDiscount field.
+func (r *mutationResolver) CreateDiscount(ctx context.Context, input model.CreateDiscountInput) (*model.DiscountResponse, error) {
+	// Admin only
+	if err := middleware.RequireAdmin(ctx); err != nil {
+		return &model.DiscountResponse{
 			Success: false,
-			Message: fmt.Sprintf("Failed to get media: %v", err),
+			Message: "Admin access required",
 		}, nil
 	}
 
-	if !resp.Success {
-		return &model.MediaResponse{
-			Success: false,
-			Message: resp.Message,
-		}, nil
+	// Prepare request
+	req := &productPb.CreateDiscountRequest{
+		Name:        input.Name,
+		Type:        input.Type,
+		Amount:      input.Amount,
+		IsActive:    input.IsActive,
+		IsRecurring: input.IsRecurring,
 	}
 
-	return &model.MediaResponse{
-		Success: true,
-		Message: resp.Message,
-		Data:    pbMediaToModel(resp.Data),
-	}, nil
-}
-
-// MediaByModel is the resolver for the mediaByModel field.
-func (r *queryResolver) MediaByModel(ctx context.Context, input model.GetFilesByModelInput) (*model.MediaListResponse, error) {
-	page := int32(1)
-	if input.Page != nil {
-		page = int32(*input.Page)
+	// Handle optional fields
+	if input.Description != nil {
+		req.Description = input.Description
 	}
-
-	perPage := int32(20)
-	if input.PerPage != nil {
-		perPage = int32(*input.PerPage)
+	if input.ValidUntil != nil {
+		validUntil := int64(*input.ValidUntil)
+		req.ValidUntil = &validUntil
 	}
-
-	modelID, err := strconv.ParseInt(input.ModelID, 10, 64)
-	if err != nil {
-		return &model.MediaListResponse{
-			Success: false,
-			Message: "Invalid model ID",
-		}, nil
+	if input.ActionType != nil {
+		req.ActionType = input.ActionType
 	}
-
-	collectionName := ""
-	if input.CollectionName != nil {
-		collectionName = *input.CollectionName
+	if input.MaxRedemptions != nil {
+		maxRedemptions := int32(*input.MaxRedemptions)
+		req.MaxRedemptions = &maxRedemptions
+	}
+	if input.MaxRedemptionsPerUser != nil {
+		maxRedemptionsPerUser := int32(*input.MaxRedemptionsPerUser)
+		req.MaxRedemptionsPerUser = &maxRedemptionsPerUser
+	}
+	if input.DurationInMonths != nil {
+		durationInMonths := int32(*input.DurationInMonths)
+		req.DurationInMonths = &durationInMonths
+	}
+	if input.MaximumRecurringIntervals != nil {
+		maximumRecurringIntervals := int32(*input.MaximumRecurringIntervals)
+		req.MaximumRecurringIntervals = &maximumRecurringIntervals
+	}
+	if input.RedeemType != nil {
+		redeemType := int32(*input.RedeemType)
+		req.RedeemType = &redeemType
+	}
+	if input.BonusDays != nil {
+		bonusDays := int32(*input.BonusDays)
+		req.BonusDays = &bonusDays
+	}
+	if input.IsEnabledForAllPlans != nil {
+		req.IsEnabledForAllPlans = *input.IsEnabledForAllPlans
+	}
+	if input.IsEnabledForAllOneTimeProducts != nil {
+		req.IsEnabledForAllOneTimeProducts = *input.IsEnabledForAllOneTimeProducts
 	}
 
-	resp, err := r.MediaClient.GetFilesByModel(ctx, &mediaPb.GetFilesByModelRequest{
-		ModelType:      input.ModelType,
-		ModelId:        modelID,
-		CollectionName: collectionName,
-		Page:           page,
-		PerPage:        perPage,
-	})
+	// Call product-service via gRPC
+	resp, err := r.ProductClient.CreateDiscount(ctx, req)
 	if err != nil {
-		return &model.MediaListResponse{
+		return &model.DiscountResponse{
 			Success: false,
-			Message: fmt.Sprintf("Failed to get media: %v", err),
+			Message: fmt.Sprintf("Create discount failed: %v", err),
 		}, nil
 	}
 
 	if !resp.Success {
-		return &model.MediaListResponse{
+		return &model.DiscountResponse{
 			Success: false,
 			Message: resp.Message,
 		}, nil
 	}
 
-	mediaList := make([]*model.Media, len(resp.Data.Media))
-	for i, m := range resp.Data.Media {
-		mediaList[i] = pbMediaToModel(m)
-	}
-
-	return &model.MediaListResponse{
+	// Convert proto discount to GraphQL model
+	discount := resp.Data
+	return &model.DiscountResponse{
 		Success: true,
 		Message: resp.Message,
-		Data: &model.MediaListData{
-			Media:   mediaList,
-			Total:   resp.Data.Total,
-			Page:    resp.Data.Page,
-			PerPage: resp.Data.PerPage,
-		},
-	}, nil
-}
-
-// AllMedia is the resolver for the allMedia field.
-func (r *queryResolver) AllMedia(ctx context.Context, input *model.GetAllMediaInput) (*model.MediaListResponse, error) {
-	page := int32(1)
-	perPage := int32(20)
-	modelType := ""
-	collectionName := ""
-
-	if input != nil {
-		if input.Page != nil {
-			page = int32(*input.Page)
-		}
+		Data: &model.Discount{
+			ID:          strconv.FormatInt(discount.Id, 10),
+			Name:        discount.Name,
+			Description: discount.Description,
+			Type:        discount.Type,
+			Amount:      discount.Amount,
+			ValidUntil: func() *int32 {
+				if discount.ValidUntil != nil {
+					v := int32(*discount.ValidUntil)
+					return &v
+				}
+				return nil
+			}(),
+			IsActive:   discount.IsActive,
+			ActionType: discount.ActionType,
+			MaxRedemptions: func() *int32 {
+				if discount.MaxRedemptions != nil {
+					v := int32(*discount.MaxRedemptions)
+					return &v
+				}
+				return nil
+			}(),
+			MaxRedemptionsPerUser: func() *int32 {
+				if discount.MaxRedemptionsPerUser != nil {
+					v := int32(*discount.MaxRedemptionsPerUser)
+					return &v
+				}
+				return nil
+			}(),
+			Redemptions: int32(discount.Redemptions),
+			IsRecurring: discount.IsRecurring,
+			DurationInMonths: func() *int32 {
+				if discount.DurationInMonths != nil {
+					v := int32(*discount.DurationInMonths)
+					return &v
+				}
+				return nil
+			}(),
+			MaximumRecurringIntervals: func() *int32 {
+				if discount.MaximumRecurringIntervals != nil {
+					v := int32(*discount.MaximumRecurringIntervals)
+					return &v
+				}
+				return nil
+			}(),
+			RedeemType: func() *int32 {
+				if discount.RedeemType != nil {
+					v := int32(*discount.RedeemType)
+					return &v
+				}
+				return nil
+			}(),
+			BonusDays: func() *int32 {
+				if discount.BonusDays != nil {
+					v := int32(*discount.BonusDays)
+					return &v
+				}
+				return nil
+			}(),
+			IsEnabledForAllPlans:           discount.IsEnabledForAllPlans,
+			IsEnabledForAllOneTimeProducts: discount.IsEnabledForAllOneTimeProducts,
+			CreatedAt:                      int32(discount.CreatedAt),
+			UpdatedAt:                      int32(discount.UpdatedAt),
+		},
+	}, nil
+}
+
+// UpdateDiscount is the resolver for the updateDiscount field.
+func (r *mutationResolver) UpdateDiscount(ctx context.Context, input model.UpdateDiscountInput) (*model.DiscountResponse, error) {
+	// Admin only
+	if err := middleware.RequireAdmin(ctx); err != nil {
+		return &model.DiscountResponse{
+			Success: false,
+			Message: "Admin access required",
+		}, nil
+	}
+
+	// Parse ID
+	discountID, err := strconv.ParseInt(input.ID, 10, 64)
+	if err != nil {
+		return &model.DiscountResponse{
+			Success: false,
+			Message: "Invalid discount ID",
+		}, nil
+	}
+
+	// Prepare request
+	req := &productPb.UpdateDiscountRequest{
+		Id:          discountID,
+		Name:        input.Name,
+		Type:        input.Type,
+		Amount:      input.Amount,
+		IsActive:    input.IsActive,
+		IsRecurring: input.IsRecurring,
+	}
+
+	// Handle optional fields
+	if input.Description != nil {
+		req.Description = input.Description
+	}
+	if input.ValidUntil != nil {
+		validUntil := int64(*input.ValidUntil)
+		req.ValidUntil = &validUntil
+	}
+	if input.ActionType != nil {
+		req.ActionType = input.ActionType
+	}
+	if input.MaxRedemptions != nil {
+		maxRedemptions := int32(*input.MaxRedemptions)
+		req.MaxRedemptions = &maxRedemptions
+	}
+	if input.MaxRedemptionsPerUser != nil {
+		maxRedemptionsPerUser := int32(*input.MaxRedemptionsPerUser)
+		req.MaxRedemptionsPerUser = &maxRedemptionsPerUser
+	}
+	if input.DurationInMonths != nil {
+		durationInMonths := int32(*input.DurationInMonths)
+		req.DurationInMonths = &durationInMonths
+	}
+	if input.MaximumRecurringIntervals != nil {
+		maximumRecurringIntervals := int32(*input.MaximumRecurringIntervals)
+		req.MaximumRecurringIntervals = &maximumRecurringIntervals
+	}
+	if input.RedeemType != nil {
+		redeemType := int32(*input.RedeemType)
+		req.RedeemType = &redeemType
+	}
+	if input.BonusDays != nil {
+		bonusDays := int32(*input.BonusDays)
+		req.BonusDays = &bonusDays
+	}
+	if input.IsEnabledForAllPlans != nil {
+		req.IsEnabledForAllPlans = *input.IsEnabledForAllPlans
+	}
+	if input.IsEnabledForAllOneTimeProducts != nil {
+		req.IsEnabledForAllOneTimeProducts = *input.IsEnabledForAllOneTimeProducts
+	}
+
+	// Call product-service via gRPC
+	resp, err := r.ProductClient.UpdateDiscount(ctx, req)
+	if err != nil {
+		return &model.DiscountResponse{
+			Success: false,
+			Message: fmt.Sprintf("Update discount failed: %v", err),
+		}, nil
+	}
+
+	if !resp.Success {
+		return &model.DiscountResponse{
+			Success: false,
+			Message: resp.Message,
+		}, nil
+	}
+
+	// Convert proto discount to GraphQL model
+	discount := resp.Data
+	return &model.DiscountResponse{
+		Success: true,
+		Message: resp.Message,
+		Data: &model.Discount{
+			ID:          strconv.FormatInt(discount.Id, 10),
+			Name:        discount.Name,
+			Description: discount.Description,
+			Type:        discount.Type,
+			Amount:      discount.Amount,
+			ValidUntil: func() *int32 {
+				if discount.ValidUntil != nil {
+					v := int32(*discount.ValidUntil)
+					return &v
+				}
+				return nil
+			}(),
+			IsActive:   discount.IsActive,
+			ActionType: discount.ActionType,
+			MaxRedemptions: func() *int32 {
+				if discount.MaxRedemptions != nil {
+					v := int32(*discount.MaxRedemptions)
+					return &v
+				}
+				return nil
+			}(),
+			MaxRedemptionsPerUser: func() *int32 {
+				if discount.MaxRedemptionsPerUser != nil {
+					v := int32(*discount.MaxRedemptionsPerUser)
+					return &v
+				}
+				return nil
+			}(),
+			Redemptions: int32(discount.Redemptions),
+			IsRecurring: discount.IsRecurring,
+			DurationInMonths: func() *int32 {
+				if discount.DurationInMonths != nil {
+					v := int32(*discount.DurationInMonths)
+					return &v
+				}
+				return nil
+			}(),
+			MaximumRecurringIntervals: func() *int32 {
+				if discount.MaximumRecurringIntervals != nil {
+					v := int32(*discount.MaximumRecurringIntervals)
+					return &v
+				}
+				return nil
+			}(),
+			RedeemType: func() *int32 {
+				if discount.RedeemType != nil {
+					v := int32(*discount.RedeemType)
+					return &v
+				}
+				return nil
+			}(),
+			BonusDays: func() *int32 {
+				if discount.BonusDays != nil {
+					v := int32(*discount.BonusDays)
+					return &v
+				}
+				return nil
+			}(),
+			IsEnabledForAllPlans:           discount.IsEnabledForAllPlans,
+			IsEnabledForAllOneTimeProducts: discount.IsEnabledForAllOneTimeProducts,
+			CreatedAt:                      int32(discount.CreatedAt),
+			UpdatedAt:                      int32(discount.UpdatedAt),
+		},
+	}, nil
+}
+
+// DeleteDiscount is the resolver for the deleteDiscount field.
+func (r *mutationResolver) DeleteDiscount(ctx context.Context, id string) (*model.DeleteDiscountResponse, error) {
+	// Admin only
+	if err := middleware.RequireAdmin(ctx); err != nil {
+		return &model.DeleteDiscountResponse{
+			Success: false,
+			Message: "Admin access required",
+		}, nil
+	}
+
+	// Parse ID
+	discountID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return &model.DeleteDiscountResponse{
+			Success: false,
+			Message: "Invalid discount ID",
+		}, nil
+	}
+
+	// Call product-service via gRPC
+	resp, err := r.ProductClient.DeleteDiscount(ctx, &productPb.DeleteDiscountRequest{
+		Id: discountID,
+	})
+	if err != nil {
+		return &model.DeleteDiscountResponse{
+			Success: false,
+			Message: fmt.Sprintf("Delete discount failed: %v", err),
+		}, nil
+	}
+
+	return &model.DeleteDiscountResponse{
+		Success: resp.Success,
+		Message: resp.Message,
+	}, nil
+}
+
+// GenerateDiscountCodes is the resolver for the generateDiscountCodes field.
+func (r *mutationResolver) GenerateDiscountCodes(ctx context.Context, input model.GenerateDiscountCodesInput) (*model.GenerateDiscountCodesResponse, error) {
+	// Admin only
+	if err := middleware.RequireAdmin(ctx); err != nil {
+		return &model.GenerateDiscountCodesResponse{
+			Success: false,
+			Message: "Admin access required",
+		}, nil
+	}
+
+	// Parse ID
+	discountID, err := strconv.ParseInt(input.DiscountID, 10, 64)
+	if err != nil {
+		return &model.GenerateDiscountCodesResponse{
+			Success: false,
+			Message: "Invalid discount ID",
+		}, nil
+	}
+
+	// Checked here as well, since the count sizes the response buffer
+	if input.Count <= 0 || input.Count > maxGeneratedDiscountCodes {
+		return &model.GenerateDiscountCodesResponse{
+			Success: false,
+			Message: fmt.Sprintf("Count must be between 1 and %d", maxGeneratedDiscountCodes),
+		}, nil
+	}
+
+	req := &productPb.GenerateDiscountCodesRequest{
+		DiscountId: discountID,
+		Count:      input.Count,
+		Prefix:     util.StringValue(input.Prefix),
+		Alphabet:   util.StringValue(input.Alphabet),
+		MaxUses:    input.MaxUses,
+	}
+	if input.Length != nil {
+		req.Length = *input.Length
+	}
+
+	// Call product-service via gRPC and collect the streamed batches
+	stream, err := r.ProductClient.GenerateDiscountCodes(ctx, req)
+	if err != nil {
+		return &model.GenerateDiscountCodesResponse{
+			Success: false,
+			Message: fmt.Sprintf("Generate discount codes failed: %v", err),
+		}, nil
+	}
+
+	result := &model.GeneratedDiscountCodes{
+		DiscountID: input.DiscountID,
+		Codes:      make([]string, 0, input.Count),
+	}
+	message := ""
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return &model.GenerateDiscountCodesResponse{
+				Success: false,
+				Message: fmt.Sprintf("Generate discount codes failed: %v", err),
+				Data:    result,
+			}, nil
+		}
+
+		for _, code := range resp.Data {
+			result.Codes = append(result.Codes, code.Code)
+		}
+		result.Generated = resp.Generated
+		message = resp.Message
+
+		if !resp.Success {
+			return &model.GenerateDiscountCodesResponse{
+				Success: false,
+				Message: resp.Message,
+				Data:    result,
+			}, nil
+		}
+	}
+
+	return &model.GenerateDiscountCodesResponse{
+		Success: true,
+		Message: message,
+		Data:    result,
+	}, nil
+}
+
+// CreateReferralCode is the resolver for the createReferralCode field.
+func (r *mutationResolver) CreateReferralCode(ctx context.Context) (*model.ReferralCodeResponse, error) {
+	// Get authenticated user from context
+	currentUser, err := middleware.GetUserFromContext(ctx)
+	if err != nil {
+		return &model.ReferralCodeResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	// Call product-service via gRPC; an existing code is returned as is
+	resp, err := r.ProductClient.GetReferralCode(ctx, &productPb.GetReferralCodeRequest{
+		UserId: currentUser.Id,
+	})
+	if err != nil {
+		return &model.ReferralCodeResponse{
+			Success: false,
+			Message: fmt.Sprintf("Create referral code failed: %v", err),
+		}, nil
+	}
+
+	if !resp.Success {
+		return &model.ReferralCodeResponse{
+			Success: false,
+			Message: resp.Message,
+		}, nil
+	}
+
+	return &model.ReferralCodeResponse{
+		Success: true,
+		Message: resp.Message,
+		Data: &model.ReferralCode{
+			ID:         strconv.FormatInt(resp.Data.Id, 10),
+			Code:       resp.Data.Code,
+			DiscountID: strconv.FormatInt(resp.Data.DiscountId, 10),
+		},
+	}, nil
+}
+
+// SetProductPrice is the resolver for the setProductPrice field.
+func (r *mutationResolver) SetProductPrice(ctx context.Context, input model.SetProductPriceInput) (*model.ProductPriceResponse, error) {
+	// Admin only
+	if err := middleware.RequireAdmin(ctx); err != nil {
+		return &model.ProductPriceResponse{
+			Success: false,
+			Message: "Admin access required",
+		}, nil
+	}
+
+	productID, err := strconv.ParseInt(input.ProductID, 10, 64)
+	if err != nil {
+		return &model.ProductPriceResponse{
+			Success: false,
+			Message: "Invalid product ID",
+		}, nil
+	}
+	currencyID, err := strconv.ParseInt(input.CurrencyID, 10, 64)
+	if err != nil {
+		return &model.ProductPriceResponse{
+			Success: false,
+			Message: "Invalid currency ID",
+		}, nil
+	}
+
+	// Call product-service via gRPC
+	resp, err := r.ProductClient.SetProductPrice(ctx, &productPb.SetProductPriceRequest{
+		ProductId:  productID,
+		CurrencyId: currencyID,
+		Price:      int64(input.Price),
+	})
+	if err != nil {
+		return &model.ProductPriceResponse{
+			Success: false,
+			Message: fmt.Sprintf("Set product price failed: %v", err),
+		}, nil
+	}
+
+	if !resp.Success {
+		return &model.ProductPriceResponse{
+			Success: false,
+			Message: resp.Message,
+		}, nil
+	}
+
+	return &model.ProductPriceResponse{
+		Success: true,
+		Message: resp.Message,
+		Data:    pbProductPriceToModel(resp.Data),
+	}, nil
+}
+
+// DeleteProductPrice is the resolver for the deleteProductPrice field.
+func (r *mutationResolver) DeleteProductPrice(ctx context.Context, id string) (*model.DeleteProductPriceResponse, error) {
+	// Admin only
+	if err := middleware.RequireAdmin(ctx); err != nil {
+		return &model.DeleteProductPriceResponse{
+			Success: false,
+			Message: "Admin access required",
+		}, nil
+	}
+
+	priceID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return &model.DeleteProductPriceResponse{
+			Success: false,
+			Message: "Invalid product price ID",
+		}, nil
+	}
+
+	// Call product-service via gRPC
+	resp, err := r.ProductClient.DeleteProductPrice(ctx, &productPb.DeleteProductPriceRequest{
+		Id: priceID,
+	})
+	if err != nil {
+		return &model.DeleteProductPriceResponse{
+			Success: false,
+			Message: fmt.Sprintf("Delete product price failed: %v", err),
+		}, nil
+	}
+
+	return &model.DeleteProductPriceResponse{
+		Success: resp.Success,
+		Message: resp.Message,
+	}, nil
+}
+
+// CreateOrder is the resolver for the createOrder field.
+func (r *mutationResolver) CreateOrder(ctx context.Context, input model.CreateOrderInput) (*model.OrderResponse, error) {
+	// Get authenticated user from context
+	currentUser, err := middleware.GetUserFromContext(ctx)
+	if err != nil {
+		return &model.OrderResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	currencyID, err := strconv.ParseInt(input.CurrencyID, 10, 64)
+	if err != nil {
+		return &model.OrderResponse{
+			Success: false,
+			Message: "Invalid currency ID",
+		}, nil
+	}
+
+	req := &productPb.CreateOrderRequest{
+		UserId:          currentUser.Id,
+		CurrencyId:      currencyID,
+		DiscountCode:    util.StringValue(input.DiscountCode),
+		PaymentProvider: util.StringValue(input.PaymentProvider),
+		SuccessUrl:      util.StringValue(input.SuccessURL),
+		CancelUrl:       util.StringValue(input.CancelURL),
+	}
+	if input.TenantID != nil {
+		// The buyer must belong to the tenant they order for
+		tenantCtx, tenantID, err := r.tenantScope(ctx, input.TenantID, rbac.PermTenantRead)
+		if err != nil {
+			return &model.OrderResponse{
+				Success: false,
+				Message: err.Error(),
+			}, nil
+		}
+		ctx = tenantCtx
+		req.TenantId = tenantID
+	}
+	for _, item := range input.Items {
+		productID, err := strconv.ParseInt(item.ProductID, 10, 64)
+		if err != nil {
+			return &model.OrderResponse{
+				Success: false,
+				Message: "Invalid product ID",
+			}, nil
+		}
+		req.Items = append(req.Items, &productPb.OrderItemInput{
+			ProductId: productID,
+			Quantity:  int64(item.Quantity),
+		})
+	}
+
+	// Call product-service via gRPC
+	resp, err := r.ProductClient.CreateOrder(ctx, req)
+	if err != nil {
+		return &model.OrderResponse{
+			Success: false,
+			Message: fmt.Sprintf("Create order failed: %v", err),
+		}, nil
+	}
+
+	if !resp.Success {
+		return &model.OrderResponse{
+			Success: false,
+			Message: resp.Message,
+		}, nil
+	}
+
+	return &model.OrderResponse{
+		Success: true,
+		Message: resp.Message,
+		Data:    pbOrderToModel(resp.Data),
+	}, nil
+}
+
+// CancelOrder is the resolver for the cancelOrder field.
+func (r *mutationResolver) CancelOrder(ctx context.Context, id string) (*model.OrderResponse, error) {
+	orderID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return &model.OrderResponse{
+			Success: false,
+			Message: "Invalid order ID",
+		}, nil
+	}
+
+	// Customers cancel their own orders; admins can cancel any
+	if _, err := r.getAccessibleOrder(ctx, orderID); err != nil {
+		return &model.OrderResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	// Call product-service via gRPC
+	resp, err := r.ProductClient.CancelOrder(ctx, &productPb.CancelOrderRequest{
+		Id: orderID,
+	})
+	if err != nil {
+		return &model.OrderResponse{
+			Success: false,
+			Message: fmt.Sprintf("Cancel order failed: %v", err),
+		}, nil
+	}
+
+	if !resp.Success {
+		return &model.OrderResponse{
+			Success: false,
+			Message: resp.Message,
+		}, nil
+	}
+
+	return &model.OrderResponse{
+		Success: true,
+		Message: resp.Message,
+		Data:    pbOrderToModel(resp.Data),
+	}, nil
+}
+
+// MarkOrderPaid is the resolver for the markOrderPaid field.
+func (r *mutationResolver) MarkOrderPaid(ctx context.Context, id string) (*model.OrderResponse, error) {
+	// Admin only
+	if err := middleware.RequireAdmin(ctx); err != nil {
+		return &model.OrderResponse{
+			Success: false,
+			Message: "Admin access required",
+		}, nil
+	}
+
+	orderID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return &model.OrderResponse{
+			Success: false,
+			Message: "Invalid order ID",
+		}, nil
+	}
+
+	// Call product-service via gRPC
+	resp, err := r.ProductClient.MarkOrderPaid(ctx, &productPb.MarkOrderPaidRequest{
+		Id: orderID,
+	})
+	if err != nil {
+		return &model.OrderResponse{
+			Success: false,
+			Message: fmt.Sprintf("Mark order paid failed: %v", err),
+		}, nil
+	}
+
+	if !resp.Success {
+		return &model.OrderResponse{
+			Success: false,
+			Message: resp.Message,
+		}, nil
+	}
+
+	return &model.OrderResponse{
+		Success: true,
+		Message: resp.Message,
+		Data:    pbOrderToModel(resp.Data),
+	}, nil
+}
+
+// RefundOrder is the resolver for the refundOrder field.
+func (r *mutationResolver) RefundOrder(ctx context.Context, id string) (*model.OrderResponse, error) {
+	// Admin only
+	if err := middleware.RequireAdmin(ctx); err != nil {
+		return &model.OrderResponse{
+			Success: false,
+			Message: "Admin access required",
+		}, nil
+	}
+
+	orderID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return &model.OrderResponse{
+			Success: false,
+			Message: "Invalid order ID",
+		}, nil
+	}
+
+	// Call product-service via gRPC
+	resp, err := r.ProductClient.RefundOrder(ctx, &productPb.RefundOrderRequest{
+		Id: orderID,
+	})
+	if err != nil {
+		return &model.OrderResponse{
+			Success: false,
+			Message: fmt.Sprintf("Refund order failed: %v", err),
+		}, nil
+	}
+
+	if !resp.Success {
+		return &model.OrderResponse{
+			Success: false,
+			Message: resp.Message,
+		}, nil
+	}
+
+	return &model.OrderResponse{
+		Success: true,
+		Message: resp.Message,
+		Data:    pbOrderToModel(resp.Data),
+	}, nil
+}
+
+// CreateCurrency is the resolver for the createCurrency field.
+func (r *mutationResolver) CreateCurrency(ctx context.Context, input model.CreateCurrencyInput) (*model.CurrencyResponse, error) {
+	// Admin only
+	if err := middleware.RequireAdmin(ctx); err != nil {
+		return &model.CurrencyResponse{
+			Success: false,
+			Message: "Admin access required",
+		}, nil
+	}
+
+	req := &productPb.CreateCurrencyRequest{
+		Code:         input.Code,
+		Name:         input.Name,
+		Symbol:       input.Symbol,
+		RoundingMode: util.StringValue(input.RoundingMode),
+	}
+	if input.DecimalPlaces != nil {
+		req.DecimalPlaces = input.DecimalPlaces
+	}
+	if input.RoundingIncrement != nil {
+		req.RoundingIncrement = int64(*input.RoundingIncrement)
+	}
+
+	// Call product-service via gRPC
+	resp, err := r.ProductClient.CreateCurrency(ctx, req)
+	if err != nil {
+		return &model.CurrencyResponse{
+			Success: false,
+			Message: fmt.Sprintf("Create currency failed: %v", err),
+		}, nil
+	}
+
+	if !resp.Success {
+		return &model.CurrencyResponse{
+			Success: false,
+			Message: resp.Message,
+		}, nil
+	}
+
+	return &model.CurrencyResponse{
+		Success: true,
+		Message: resp.Message,
+		Data:    pbCurrencyToModel(resp.Data),
+	}, nil
+}
+
+// UpdateCurrency is the resolver for the updateCurrency field.
+func (r *mutationResolver) UpdateCurrency(ctx context.Context, input model.UpdateCurrencyInput) (*model.CurrencyResponse, error) {
+	// Admin only
+	if err := middleware.RequireAdmin(ctx); err != nil {
+		return &model.CurrencyResponse{
+			Success: false,
+			Message: "Admin access required",
+		}, nil
+	}
+
+	currencyID, err := strconv.ParseInt(input.ID, 10, 64)
+	if err != nil {
+		return &model.CurrencyResponse{
+			Success: false,
+			Message: "Invalid currency ID",
+		}, nil
+	}
+
+	req := &productPb.UpdateCurrencyRequest{
+		Id:            currencyID,
+		Name:          input.Name,
+		Symbol:        input.Symbol,
+		DecimalPlaces: input.DecimalPlaces,
+		RoundingMode:  input.RoundingMode,
+	}
+	if input.RoundingIncrement != nil {
+		increment := int64(*input.RoundingIncrement)
+		req.RoundingIncrement = &increment
+	}
+
+	// Call product-service via gRPC
+	resp, err := r.ProductClient.UpdateCurrency(ctx, req)
+	if err != nil {
+		return &model.CurrencyResponse{
+			Success: false,
+			Message: fmt.Sprintf("Update currency failed: %v", err),
+		}, nil
+	}
+
+	if !resp.Success {
+		return &model.CurrencyResponse{
+			Success: false,
+			Message: resp.Message,
+		}, nil
+	}
+
+	return &model.CurrencyResponse{
+		Success: true,
+		Message: resp.Message,
+		Data:    pbCurrencyToModel(resp.Data),
+	}, nil
+}
+
+// DeleteCurrency is the resolver for the deleteCurrency field.
+func (r *mutationResolver) DeleteCurrency(ctx context.Context, id string) (*model.DeleteCurrencyResponse, error) {
+	// Admin only
+	if err := middleware.RequireAdmin(ctx); err != nil {
+		return &model.DeleteCurrencyResponse{
+			Success: false,
+			Message: "Admin access required",
+		}, nil
+	}
+
+	currencyID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return &model.DeleteCurrencyResponse{
+			Success: false,
+			Message: "Invalid currency ID",
+		}, nil
+	}
+
+	// Call product-service via gRPC
+	resp, err := r.ProductClient.DeleteCurrency(ctx, &productPb.DeleteCurrencyRequest{
+		Id: currencyID,
+	})
+	if err != nil {
+		return &model.DeleteCurrencyResponse{
+			Success: false,
+			Message: fmt.Sprintf("Delete currency failed: %v", err),
+		}, nil
+	}
+
+	return &model.DeleteCurrencyResponse{
+		Success: resp.Success,
+		Message: resp.Message,
+	}, nil
+}
+
+// SetExchangeRate is the resolver for the setExchangeRate field.
+func (r *mutationResolver) SetExchangeRate(ctx context.Context, input model.SetExchangeRateInput) (*model.ExchangeRateResponse, error) {
+	// Admin only
+	if err := middleware.RequireAdmin(ctx); err != nil {
+		return &model.ExchangeRateResponse{
+			Success: false,
+			Message: "Admin access required",
+		}, nil
+	}
+
+	baseCurrencyID, err := strconv.ParseInt(input.BaseCurrencyID, 10, 64)
+	if err != nil {
+		return &model.ExchangeRateResponse{
+			Success: false,
+			Message: "Invalid base currency ID",
+		}, nil
+	}
+	quoteCurrencyID, err := strconv.ParseInt(input.QuoteCurrencyID, 10, 64)
+	if err != nil {
+		return &model.ExchangeRateResponse{
+			Success: false,
+			Message: "Invalid quote currency ID",
+		}, nil
+	}
+
+	req := &productPb.SetExchangeRateRequest{
+		BaseCurrencyId:  baseCurrencyID,
+		QuoteCurrencyId: quoteCurrencyID,
+		Rate:            input.Rate,
+	}
+	if input.EffectiveAt != nil {
+		req.EffectiveAt = int64(*input.EffectiveAt)
+	}
+
+	// Call product-service via gRPC
+	resp, err := r.ProductClient.SetExchangeRate(ctx, req)
+	if err != nil {
+		return &model.ExchangeRateResponse{
+			Success: false,
+			Message: fmt.Sprintf("Set exchange rate failed: %v", err),
+		}, nil
+	}
+
+	if !resp.Success {
+		return &model.ExchangeRateResponse{
+			Success: false,
+			Message: resp.Message,
+		}, nil
+	}
+
+	return &model.ExchangeRateResponse{
+		Success: true,
+		Message: resp.Message,
+		Data:    pbExchangeRateToModel(resp.Data),
+	}, nil
+}
+
+// ImportExchangeRates is the resolver for the importExchangeRates field.
+func (r *mutationResolver) ImportExchangeRates(ctx context.Context, file graphql.Upload) (*model.ImportExchangeRatesResponse, error) {
+	// Admin only
+	if err := middleware.RequireAdmin(ctx); err != nil {
+		return &model.ImportExchangeRatesResponse{
+			Success: false,
+			Message: "Admin access required",
+		}, nil
+	}
+
+	content, err := io.ReadAll(file.File)
+	if err != nil {
+		return &model.ImportExchangeRatesResponse{
+			Success: false,
+			Message: "Failed to read file content",
+		}, nil
+	}
+
+	// Call product-service via gRPC
+	resp, err := r.ProductClient.ImportExchangeRates(ctx, &productPb.ImportExchangeRatesRequest{
+		Content: content,
+	})
+	if err != nil {
+		return &model.ImportExchangeRatesResponse{
+			Success: false,
+			Message: fmt.Sprintf("Import exchange rates failed: %v", err),
+		}, nil
+	}
+
+	if !resp.Success {
+		return &model.ImportExchangeRatesResponse{
+			Success: false,
+			Message: resp.Message,
+		}, nil
+	}
+
+	rates := make([]*model.ExchangeRate, len(resp.Data))
+	for i, rate := range resp.Data {
+		rates[i] = pbExchangeRateToModel(rate)
+	}
+
+	return &model.ImportExchangeRatesResponse{
+		Success: true,
+		Message: resp.Message,
+		Data:    rates,
+	}, nil
+}
+
+// DeleteExchangeRate is the resolver for the deleteExchangeRate field.
+func (r *mutationResolver) DeleteExchangeRate(ctx context.Context, id string) (*model.DeleteExchangeRateResponse, error) {
+	// Admin only
+	if err := middleware.RequireAdmin(ctx); err != nil {
+		return &model.DeleteExchangeRateResponse{
+			Success: false,
+			Message: "Admin access required",
+		}, nil
+	}
+
+	rateID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return &model.DeleteExchangeRateResponse{
+			Success: false,
+			Message: "Invalid exchange rate ID",
+		}, nil
+	}
+
+	// Call product-service via gRPC
+	resp, err := r.ProductClient.DeleteExchangeRate(ctx, &productPb.DeleteExchangeRateRequest{
+		Id: rateID,
+	})
+	if err != nil {
+		return &model.DeleteExchangeRateResponse{
+			Success: false,
+			Message: fmt.Sprintf("Delete exchange rate failed: %v", err),
+		}, nil
+	}
+
+	return &model.DeleteExchangeRateResponse{
+		Success: resp.Success,
+		Message: resp.Message,
+	}, nil
+}
+
+// DerivePlanPrices is the resolver for the derivePlanPrices field.
+func (r *mutationResolver) DerivePlanPrices(ctx context.Context, planID string) (*model.DerivePlanPricesResponse, error) {
+	// Admin only
+	if err := middleware.RequireAdmin(ctx); err != nil {
+		return &model.DerivePlanPricesResponse{
+			Success: false,
+			Message: "Admin access required",
+		}, nil
+	}
+
+	id, err := strconv.ParseInt(planID, 10, 64)
+	if err != nil {
+		return &model.DerivePlanPricesResponse{
+			Success: false,
+			Message: "Invalid plan ID",
+		}, nil
+	}
+
+	// Call product-service via gRPC
+	resp, err := r.ProductClient.DerivePlanPrices(ctx, &productPb.DerivePlanPricesRequest{
+		PlanId: id,
+	})
+	if err != nil {
+		return &model.DerivePlanPricesResponse{
+			Success: false,
+			Message: fmt.Sprintf("Derive plan prices failed: %v", err),
+		}, nil
+	}
+
+	if !resp.Success {
+		return &model.DerivePlanPricesResponse{
+			Success: false,
+			Message: resp.Message,
+		}, nil
+	}
+
+	derived := make([]*model.PlanPrice, len(resp.Derived))
+	for i, price := range resp.Derived {
+		derived[i] = pbPlanPriceToModel(price)
+	}
+
+	return &model.DerivePlanPricesResponse{
+		Success: true,
+		Message: resp.Message,
+		Data: &model.DerivePlanPrices{
+			BasePrice: pbPlanPriceToModel(resp.BasePrice),
+			Derived:   derived,
+			Skipped:   resp.Skipped,
+		},
+	}, nil
+}
+
+// UploadFile is the resolver for the uploadFile field.
+func (r *mutationResolver) UploadFile(ctx context.Context, input model.UploadFileInput) (*model.MediaResponse, error) {
+	// Convert model ID to int64
+	modelID, err := strconv.ParseInt(input.ModelID, 10, 64)
+	if err != nil {
+		return &model.MediaResponse{
+			Success: false,
+			Message: "Invalid model ID",
+		}, nil
+	}
+
+	// graphql.Upload struct - use reflection to access File field
+	v := reflect.ValueOf(input.Content)
+
+	// Get File field
+	fileField := v.FieldByName("File")
+	if !fileField.IsValid() {
+		return &model.MediaResponse{
+			Success: false,
+			Message: "Upload struct has no File field",
+		}, nil
+	}
+
+	// File field is io.Reader (transport.bytesReader internally)
+	reader, ok := fileField.Interface().(io.Reader)
+	if !ok {
+		return &model.MediaResponse{
+			Success: false,
+			Message: fmt.Sprintf("File field does not implement io.Reader. Type: %T", fileField.Interface()),
+		}, nil
+	}
+
+	// Read file content
+	fileContent, err := io.ReadAll(reader)
+	if err != nil {
+		return &model.MediaResponse{
+			Success: false,
+			Message: "Failed to read file content",
+		}, nil
+	}
+
+	// Handle optional name field
+	name := ""
+	if input.Name != nil {
+		name = *input.Name
+	}
+
+	// Delete existing media with same model_type, model_id, and collection_name
+	// This ensures only one avatar exists per user
+	existingMediaResp, err := r.Resolver.MediaClient.GetFilesByModel(ctx, &mediaPb.GetFilesByModelRequest{
+		ModelType:      input.ModelType,
+		ModelId:        modelID,
+		CollectionName: input.CollectionName,
+		Page:           1,
+		PerPage:        100, // Get all existing media
+	})
+	if err == nil && existingMediaResp.Success && existingMediaResp.Data != nil {
+		// Delete each existing media
+		for _, media := range existingMediaResp.Data.Media {
+			_, _ = r.Resolver.MediaClient.DeleteFile(ctx, &mediaPb.DeleteFileRequest{
+				Id: media.Id,
+			})
+		}
+	}
+
+	// Call media-service via gRPC
+	uploadResp, err := r.Resolver.MediaClient.UploadFile(ctx, &mediaPb.UploadFileRequest{
+		Content:        fileContent,
+		FileName:       input.FileName,
+		MimeType:       input.MimeType,
+		Size:           int64(len(fileContent)),
+		ModelType:      input.ModelType,
+		ModelId:        modelID,
+		CollectionName: input.CollectionName,
+		Name:           name,
+		Disk:           input.Disk,
+	})
+	if err != nil {
+		return &model.MediaResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to upload file: %v", err),
+		}, nil
+	}
+
+	if !uploadResp.Success {
+		return &model.MediaResponse{
+			Success: false,
+			Message: uploadResp.Message,
+		}, nil
+	}
+
+	media := pbMediaToModel(uploadResp.Data)
+
+	// Invalidate media cache for this model
+	if r.Resolver.MediaCache != nil {
+		_ = r.Resolver.MediaCache.InvalidateModelMedia(ctx, input.ModelType, input.ModelID, input.CollectionName)
+		// Also invalidate user avatar cache if this is a user avatar
+		if input.ModelType == "user" && input.CollectionName == "avatar" {
+			_ = r.Resolver.MediaCache.InvalidateUserAvatar(ctx, input.ModelID)
+		}
+	}
+
+	return &model.MediaResponse{
+		Success: true,
+		Message: "File uploaded successfully",
+		Data:    media,
+	}, nil
+}
+
+// DeleteMedia is the resolver for the deleteMedia field.
+func (r *mutationResolver) DeleteMedia(ctx context.Context, id string) (*model.DeleteMediaResponse, error) {
+	mediaID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return &model.DeleteMediaResponse{
+			Success: false,
+			Message: "Invalid media ID",
+		}, nil
+	}
+
+	resp, err := r.MediaClient.DeleteFile(ctx, &mediaPb.DeleteFileRequest{
+		Id: mediaID,
+	})
+	if err != nil {
+		return &model.DeleteMediaResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to delete media: %v", err),
+		}, nil
+	}
+
+	return &model.DeleteMediaResponse{
+		Success: resp.Success,
+		Message: resp.Message,
+	}, nil
+}
+
+// User is the resolver for the user field.
+func (r *queryResolver) User(ctx context.Context, id string) (*model.UserResponse, error) {
+	userID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return &model.UserResponse{
+			Success: false,
+			Message: "Invalid user ID",
+		}, nil
+	}
+
+	// Call user-service via gRPC
+	resp, err := r.UserClient.GetUserByID(ctx, &userPb.GetUserByIDRequest{
+		Id: userID,
+	})
+	if err != nil {
+		return &model.UserResponse{
+			Success: false,
+			Message: fmt.Sprintf("Get user failed: %v", err),
+		}, nil
+	}
+
+	if !resp.Success {
+		return &model.UserResponse{
+			Success: false,
+			Message: resp.Message,
+		}, nil
+	}
+
+	emailVerifiedAt := int32(resp.Data.EmailVerifiedAt)
+	lastLoginAt := int32(resp.Data.LastLoginAt)
+	createdAt := int32(resp.Data.CreatedAt)
+	updatedAt := int32(resp.Data.UpdatedAt)
+
+	return &model.UserResponse{
+		Success: true,
+		Message: "User retrieved successfully",
+		Data: &model.User{
+			ID:              fmt.Sprintf("%d", resp.Data.Id),
+			Name:            resp.Data.Name,
+			Email:           resp.Data.Email,
+			PublicName:      &resp.Data.PublicName,
+			IsAdmin:         resp.Data.IsAdmin,
+			IsBlocked:       resp.Data.IsBlocked,
+			PhoneNumber:     &resp.Data.PhoneNumber,
+			Position:        &resp.Data.Position,
+			EmailVerified:   resp.Data.EmailVerified,
+			EmailVerifiedAt: &emailVerifiedAt,
+			LastLoginAt:     &lastLoginAt,
+			CreatedAt:       &createdAt,
+			UpdatedAt:       &updatedAt,
+		},
+	}, nil
+}
+
+// Users is the resolver for the users field.
+func (r *queryResolver) Users(ctx context.Context, page *int32, perPage *int32) (*model.UserListResponse, error) {
+	// Default pagination
+	pageValue := int32(1)
+	if page != nil {
+		pageValue = *page
+	}
+	perPageValue := int32(10)
+	if perPage != nil {
+		perPageValue = *perPage
+	}
+
+	// Call user-service via gRPC
+	resp, err := r.UserClient.GetAllUsers(ctx, &userPb.GetAllUsersRequest{
+		Page:    pageValue,
+		PerPage: perPageValue,
+	})
+	if err != nil {
+		return &model.UserListResponse{
+			Success: false,
+			Message: fmt.Sprintf("Get users failed: %v", err),
+		}, nil
+	}
+
+	if !resp.Success {
+		return &model.UserListResponse{
+			Success: false,
+			Message: resp.Message,
+		}, nil
+	}
+
+	// Convert users
+	users := make([]*model.User, len(resp.Data.Users))
+	for i, u := range resp.Data.Users {
+		users[i] = &model.User{
+			ID:          fmt.Sprintf("%d", u.Id),
+			Name:        u.Name,
+			Email:       u.Email,
+			PublicName:  &u.PublicName,
+			IsAdmin:     u.IsAdmin,
+			IsBlocked:   u.IsBlocked,
+			PhoneNumber: &u.PhoneNumber,
+			Position:    &u.Position,
+		}
+	}
+
+	return &model.UserListResponse{
+		Success: true,
+		Message: "Users retrieved successfully",
+		Data: &model.UserList{
+			Users:   users,
+			Total:   resp.Data.Total,
+			Page:    resp.Data.Page,
+			PerPage: resp.Data.PerPage,
+		},
+	}, nil
+}
+
+// Media is the resolver for the media field.
+func (r *queryResolver) Media(ctx context.Context, id string) (*model.MediaResponse, error) {
+	mediaID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return &model.MediaResponse{
+			Success: false,
+			Message: "Invalid media ID",
+		}, nil
+	}
+
+	resp, err := r.MediaClient.GetFileByID(ctx, &mediaPb.GetFileByIDRequest{
+		Id: mediaID,
+	})
+	if err != nil {
+		return &model.MediaResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to get media: %v", err),
+		}, nil
+	}
+
+	if !resp.Success {
+		return &model.MediaResponse{
+			Success: false,
+			Message: resp.Message,
+		}, nil
+	}
+
+	return &model.MediaResponse{
+		Success: true,
+		Message: resp.Message,
+		Data:    pbMediaToModel(resp.Data),
+	}, nil
+}
+
+// MediaByUUID is the resolver for the mediaByUUID field.
+func (r *queryResolver) MediaByUUID(ctx context.Context, uuid string) (*model.MediaResponse, error) {
+	resp, err := r.MediaClient.GetFileByUUID(ctx, &mediaPb.GetFileByUUIDRequest{
+		Uuid: uuid,
+	})
+	if err != nil {
+		return &model.MediaResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to get media: %v", err),
+		}, nil
+	}
+
+	if !resp.Success {
+		return &model.MediaResponse{
+			Success: false,
+			Message: resp.Message,
+		}, nil
+	}
+
+	return &model.MediaResponse{
+		Success: true,
+		Message: resp.Message,
+		Data:    pbMediaToModel(resp.Data),
+	}, nil
+}
+
+// MediaByModel is the resolver for the mediaByModel field.
+func (r *queryResolver) MediaByModel(ctx context.Context, input model.GetFilesByModelInput) (*model.MediaListResponse, error) {
+	page := int32(1)
+	if input.Page != nil {
+		page = int32(*input.Page)
+	}
+
+	perPage := int32(20)
+	if input.PerPage != nil {
+		perPage = int32(*input.PerPage)
+	}
+
+	modelID, err := strconv.ParseInt(input.ModelID, 10, 64)
+	if err != nil {
+		return &model.MediaListResponse{
+			Success: false,
+			Message: "Invalid model ID",
+		}, nil
+	}
+
+	collectionName := ""
+	if input.CollectionName != nil {
+		collectionName = *input.CollectionName
+	}
+
+	resp, err := r.MediaClient.GetFilesByModel(ctx, &mediaPb.GetFilesByModelRequest{
+		ModelType:      input.ModelType,
+		ModelId:        modelID,
+		CollectionName: collectionName,
+		Page:           page,
+		PerPage:        perPage,
+	})
+	if err != nil {
+		return &model.MediaListResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to get media: %v", err),
+		}, nil
+	}
+
+	if !resp.Success {
+		return &model.MediaListResponse{
+			Success: false,
+			Message: resp.Message,
+		}, nil
+	}
+
+	mediaList := make([]*model.Media, len(resp.Data.Media))
+	for i, m := range resp.Data.Media {
+		mediaList[i] = pbMediaToModel(m)
+	}
+
+	return &model.MediaListResponse{
+		Success: true,
+		Message: resp.Message,
+		Data: &model.MediaListData{
+			Media:   mediaList,
+			Total:   resp.Data.Total,
+			Page:    resp.Data.Page,
+			PerPage: resp.Data.PerPage,
+		},
+	}, nil
+}
+
+// AllMedia is the resolver for the allMedia field.
+func (r *queryResolver) AllMedia(ctx context.Context, input *model.GetAllMediaInput) (*model.MediaListResponse, error) {
+	page := int32(1)
+	perPage := int32(20)
+	modelType := ""
+	collectionName := ""
+
+	if input != nil {
+		if input.Page != nil {
+			page = int32(*input.Page)
+		}
 		if input.PerPage != nil {
 			perPage = int32(*input.PerPage)
 		}
@@ -2371,36 +3635,543 @@ func (r *queryResolver) AllMedia(ctx context.Context, input *model.GetAllMediaIn
 		}
 	}
 
-	resp, err := r.MediaClient.GetAllMedia(ctx, &mediaPb.GetAllMediaRequest{
-		Page:           page,
-		PerPage:        perPage,
-		ModelType:      modelType,
-		CollectionName: collectionName,
+	resp, err := r.MediaClient.GetAllMedia(ctx, &mediaPb.GetAllMediaRequest{
+		Page:           page,
+		PerPage:        perPage,
+		ModelType:      modelType,
+		CollectionName: collectionName,
+	})
+	if err != nil {
+		return &model.MediaListResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to get media: %v", err),
+		}, nil
+	}
+
+	if !resp.Success {
+		return &model.MediaListResponse{
+			Success: false,
+			Message: resp.Message,
+		}, nil
+	}
+
+	mediaList := make([]*model.Media, len(resp.Data.Media))
+	for i, m := range resp.Data.Media {
+		mediaList[i] = pbMediaToModel(m)
+	}
+
+	return &model.MediaListResponse{
+		Success: true,
+		Message: resp.Message,
+		Data: &model.MediaListData{
+			Media:   mediaList,
+			Total:   resp.Data.Total,
+			Page:    resp.Data.Page,
+			PerPage: resp.Data.PerPage,
+		},
+	}, nil
+}
+
+// MediaURL is the resolver for the mediaURL field.
+func (r *queryResolver) MediaURL(ctx context.Context, id string, expirySeconds *int32) (*model.MediaURLResponse, error) {
+	mediaID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return &model.MediaURLResponse{
+			Success: false,
+			Message: "Invalid media ID",
+		}, nil
+	}
+
+	expiry := int32(900) // Default 15 minutes
+	if expirySeconds != nil {
+		expiry = *expirySeconds
+	}
+
+	resp, err := r.MediaClient.GetFileURL(ctx, &mediaPb.GetFileURLRequest{
+		Id:            mediaID,
+		ExpirySeconds: expiry,
+	})
+	if err != nil {
+		return &model.MediaURLResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to get media URL: %v", err),
+		}, nil
+	}
+
+	if !resp.Success {
+		return &model.MediaURLResponse{
+			Success: false,
+			Message: resp.Message,
+		}, nil
+	}
+
+	return &model.MediaURLResponse{
+		Success: true,
+		Message: resp.Message,
+		Data: &model.MediaURLData{
+			URL:       resp.Data.Url,
+			ExpiresAt: int32(resp.Data.ExpiresAt),
+		},
+	}, nil
+}
+
+// SearchUsers is the resolver for the searchUsers field.
+func (r *queryResolver) SearchUsers(ctx context.Context, query string, page *int32, perPage *int32) (*model.UserListResponse, error) {
+	// Default pagination
+	pageValue := int32(1)
+	if page != nil {
+		pageValue = *page
+	}
+	perPageValue := int32(10)
+	if perPage != nil {
+		perPageValue = *perPage
+	}
+
+	// Call user-service via gRPC
+	resp, err := r.UserClient.SearchUsers(ctx, &userPb.SearchUsersRequest{
+		Query:   query,
+		Page:    pageValue,
+		PerPage: perPageValue,
+	})
+	if err != nil {
+		return &model.UserListResponse{
+			Success: false,
+			Message: fmt.Sprintf("Search users failed: %v", err),
+		}, nil
+	}
+
+	if !resp.Success {
+		return &model.UserListResponse{
+			Success: false,
+			Message: resp.Message,
+		}, nil
+	}
+
+	// Convert users
+	users := make([]*model.User, len(resp.Data.Users))
+	for i, u := range resp.Data.Users {
+		users[i] = &model.User{
+			ID:          fmt.Sprintf("%d", u.Id),
+			Name:        u.Name,
+			Email:       u.Email,
+			PublicName:  &u.PublicName,
+			IsAdmin:     u.IsAdmin,
+			IsBlocked:   u.IsBlocked,
+			PhoneNumber: &u.PhoneNumber,
+			Position:    &u.Position,
+		}
+	}
+
+	return &model.UserListResponse{
+		Success: true,
+		Message: "Users search completed successfully",
+		Data: &model.UserList{
+			Users:   users,
+			Total:   resp.Data.Total,
+			Page:    resp.Data.Page,
+			PerPage: resp.Data.PerPage,
+		},
+	}, nil
+}
+
+// Me is the resolver for the me field.
+func (r *queryResolver) Me(ctx context.Context) (*model.UserResponse, error) {
+	logger.Info("========== Me (GetMyProfile) query called ==========")
+
+	// Get authenticated user from context
+	currentUser, err := middleware.GetUserFromContext(ctx)
+	if err != nil {
+		logger.Error("Failed to get user from context", zap.Error(err))
+		return &model.UserResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	logger.Info("Current user from context",
+		zap.Int64("user_id", currentUser.Id),
+		zap.String("email", currentUser.Email),
+	)
+
+	// Get user details from user-service
+	logger.Debug("Calling user-service GetUserByID", zap.Int64("user_id", currentUser.Id))
+	resp, err := r.UserClient.GetUserByID(ctx, &userPb.GetUserByIDRequest{
+		Id: currentUser.Id,
+	})
+	if err != nil {
+		logger.Error("user-service GetUserByID failed", zap.Error(err))
+		return &model.UserResponse{
+			Success: false,
+			Message: fmt.Sprintf("Get current user failed: %v", err),
+		}, nil
+	}
+
+	if !resp.Success {
+		logger.Warn("user-service returned unsuccessful", zap.String("message", resp.Message))
+		return &model.UserResponse{
+			Success: false,
+			Message: resp.Message,
+		}, nil
+	}
+
+	logger.Info("User data retrieved from user-service",
+		zap.Int64("user_id", resp.Data.Id),
+		zap.String("name", resp.Data.Name),
+		zap.String("email", resp.Data.Email),
+	)
+
+	emailVerifiedAt := int32(resp.Data.EmailVerifiedAt)
+	lastLoginAt := int32(resp.Data.LastLoginAt)
+	createdAt := int32(resp.Data.CreatedAt)
+	updatedAt := int32(resp.Data.UpdatedAt)
+
+	userModel := &model.User{
+		ID:              fmt.Sprintf("%d", resp.Data.Id),
+		Name:            resp.Data.Name,
+		Email:           resp.Data.Email,
+		PublicName:      &resp.Data.PublicName,
+		IsAdmin:         resp.Data.IsAdmin,
+		IsBlocked:       resp.Data.IsBlocked,
+		PhoneNumber:     &resp.Data.PhoneNumber,
+		Position:        &resp.Data.Position,
+		EmailVerified:   resp.Data.EmailVerified,
+		EmailVerifiedAt: &emailVerifiedAt,
+		LastLoginAt:     &lastLoginAt,
+		CreatedAt:       &createdAt,
+		UpdatedAt:       &updatedAt,
+	}
+
+	logger.Info("Returning user model",
+		zap.String("user_id", userModel.ID),
+		zap.String("name", userModel.Name),
+	)
+	logger.Info("========== Me query completed (Avatar resolver will be called next if requested) ==========")
+
+	return &model.UserResponse{
+		Success: true,
+		Message: "Current user retrieved successfully",
+		Data:    userModel,
+	}, nil
+}
+
+// VerifyResetToken is the resolver for the verifyResetToken field.
+func (r *queryResolver) VerifyResetToken(ctx context.Context, token string) (*model.ForgotPasswordResponse, error) {
+	// Call auth-service via gRPC
+	resp, err := r.AuthClient.VerifyResetToken(ctx, &authPb.VerifyResetTokenRequest{
+		Token: token,
+	})
+	if err != nil {
+		return &model.ForgotPasswordResponse{
+			Success: false,
+			Message: fmt.Sprintf("Verify reset token failed: %v", err),
+		}, nil
+	}
+
+	if !resp.Valid {
+		return &model.ForgotPasswordResponse{
+			Success: false,
+			Message: resp.Message,
+		}, nil
+	}
+
+	return &model.ForgotPasswordResponse{
+		Success: true,
+		Message: "Reset token is valid",
+	}, nil
+}
+
+// Tenant is the resolver for the tenant field.
+func (r *queryResolver) Tenant(ctx context.Context, id string) (*model.TenantResponse, error) {
+	tenantID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return &model.TenantResponse{
+			Success: false,
+			Message: "Invalid tenant ID",
+		}, nil
+	}
+
+	if _, err := r.authorizeTenant(ctx, tenantID, rbac.PermTenantRead); err != nil {
+		return &model.TenantResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	// Call tenant-service via gRPC
+	resp, err := r.TenantClient.GetTenantByID(ctx, &tenantPb.GetTenantByIDRequest{
+		Id: tenantID,
+	})
+	if err != nil {
+		return &model.TenantResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to get tenant: %v", err),
+		}, nil
+	}
+
+	if !resp.Success {
+		return &model.TenantResponse{
+			Success: false,
+			Message: resp.Message,
+		}, nil
+	}
+
+	tenant := resp.Data
+	return &model.TenantResponse{
+		Success: true,
+		Message: "Tenant retrieved successfully",
+		Data: &model.Tenant{
+			ID:                  strconv.FormatInt(tenant.Id, 10),
+			UUID:                tenant.Uuid,
+			Name:                tenant.Name,
+			Slug:                tenant.Slug,
+			Domain:              util.StringPtr(tenant.Domain),
+			IsNameAutoGenerated: tenant.IsNameAutoGenerated,
+			CreatedBy:           strconv.FormatInt(tenant.CreatedBy, 10),
+			CreatedAt:           int32(tenant.CreatedAt),
+			UpdatedAt:           int32(tenant.UpdatedAt),
+		},
+	}, nil
+}
+
+// TenantBySlug is the resolver for the tenantBySlug field.
+func (r *queryResolver) TenantBySlug(ctx context.Context, slug string) (*model.TenantResponse, error) {
+	// Call tenant-service via gRPC
+	resp, err := r.TenantClient.GetTenantBySlug(ctx, &tenantPb.GetTenantBySlugRequest{
+		Slug: slug,
+	})
+	if err != nil {
+		return &model.TenantResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to get tenant: %v", err),
+		}, nil
+	}
+
+	if !resp.Success {
+		return &model.TenantResponse{
+			Success: false,
+			Message: resp.Message,
+		}, nil
+	}
+
+	if _, err := r.authorizeTenant(ctx, resp.Data.Id, rbac.PermTenantRead); err != nil {
+		return &model.TenantResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	tenant := resp.Data
+	return &model.TenantResponse{
+		Success: true,
+		Message: "Tenant retrieved successfully",
+		Data: &model.Tenant{
+			ID:                  strconv.FormatInt(tenant.Id, 10),
+			UUID:                tenant.Uuid,
+			Name:                tenant.Name,
+			Slug:                tenant.Slug,
+			Domain:              util.StringPtr(tenant.Domain),
+			IsNameAutoGenerated: tenant.IsNameAutoGenerated,
+			CreatedBy:           strconv.FormatInt(tenant.CreatedBy, 10),
+			CreatedAt:           int32(tenant.CreatedAt),
+			UpdatedAt:           int32(tenant.UpdatedAt),
+		},
+	}, nil
+}
+
+// TenantDomainVerification is the resolver for the tenantDomainVerification field.
+func (r *queryResolver) TenantDomainVerification(ctx context.Context, tenantID *string) (*model.DomainVerificationResponse, error) {
+	ctx, parsedTenantID, err := r.tenantScope(ctx, tenantID, rbac.PermTenantUpdate)
+	if err != nil {
+		return &model.DomainVerificationResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	resp, err := r.TenantClient.GetDomainVerification(ctx, &tenantPb.GetDomainVerificationRequest{
+		TenantId: parsedTenantID,
+	})
+	if err != nil {
+		return &model.DomainVerificationResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to get domain verification: %v", err),
+		}, nil
+	}
+
+	if !resp.Success {
+		return &model.DomainVerificationResponse{
+			Success: false,
+			Message: resp.Message,
+		}, nil
+	}
+
+	return &model.DomainVerificationResponse{
+		Success: true,
+		Message: resp.Message,
+		Data:    pbDomainVerificationToModel(resp.Data),
+	}, nil
+}
+
+// CurrentTenant is the resolver for the currentTenant field.
+func (r *queryResolver) CurrentTenant(ctx context.Context) (*model.TenantResponse, error) {
+	tenant := middleware.GetHostTenantFromContext(ctx)
+	if tenant == nil {
+		return &model.TenantResponse{
+			Success: false,
+			Message: "No tenant for this host",
+		}, nil
+	}
+
+	return &model.TenantResponse{
+		Success: true,
+		Message: "Tenant retrieved successfully",
+		Data: &model.Tenant{
+			ID:                  strconv.FormatInt(tenant.Id, 10),
+			UUID:                tenant.Uuid,
+			Name:                tenant.Name,
+			Slug:                tenant.Slug,
+			Domain:              util.StringPtr(tenant.Domain),
+			IsNameAutoGenerated: tenant.IsNameAutoGenerated,
+			CreatedBy:           strconv.FormatInt(tenant.CreatedBy, 10),
+			CreatedAt:           int32(tenant.CreatedAt),
+			UpdatedAt:           int32(tenant.UpdatedAt),
+		},
+	}, nil
+}
+
+// ActiveTenant is the resolver for the activeTenant field.
+func (r *queryResolver) ActiveTenant(ctx context.Context) (*model.TenantResponse, error) {
+	if _, err := middleware.GetUserFromContext(ctx); err != nil {
+		return &model.TenantResponse{
+			Success: false,
+			Message: "Unauthorized: Please login first",
+		}, nil
+	}
+
+	tenantID := tenancy.TenantID(ctx)
+	if tenantID == 0 {
+		return &model.TenantResponse{
+			Success: false,
+			Message: "No active tenant",
+		}, nil
+	}
+
+	// Call tenant-service via gRPC
+	resp, err := r.TenantClient.GetTenantByID(ctx, &tenantPb.GetTenantByIDRequest{
+		Id: tenantID,
+	})
+	if err != nil {
+		return &model.TenantResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to get tenant: %v", err),
+		}, nil
+	}
+
+	if !resp.Success {
+		return &model.TenantResponse{
+			Success: false,
+			Message: resp.Message,
+		}, nil
+	}
+
+	tenant := resp.Data
+	return &model.TenantResponse{
+		Success: true,
+		Message: "Tenant retrieved successfully",
+		Data: &model.Tenant{
+			ID:                  strconv.FormatInt(tenant.Id, 10),
+			UUID:                tenant.Uuid,
+			Name:                tenant.Name,
+			Slug:                tenant.Slug,
+			Domain:              util.StringPtr(tenant.Domain),
+			IsNameAutoGenerated: tenant.IsNameAutoGenerated,
+			CreatedBy:           strconv.FormatInt(tenant.CreatedBy, 10),
+			CreatedAt:           int32(tenant.CreatedAt),
+			UpdatedAt:           int32(tenant.UpdatedAt),
+		},
+	}, nil
+}
+
+// Tenants is the resolver for the tenants field.
+func (r *queryResolver) Tenants(ctx context.Context, page *int32, perPage *int32, search *string, sortBy *string, sortOrder *string) (*model.TenantListResponse, error) {
+	// Check if user is admin
+	userClaims, err := middleware.GetUserFromContext(ctx)
+	if err != nil || userClaims == nil || !userClaims.IsAdmin {
+		return &model.TenantListResponse{
+			Success: false,
+			Message: "Unauthorized: Admin access required",
+		}, nil
+	}
+
+	// Set default pagination
+	pageValue := int32(1)
+	perPageValue := int32(10)
+	if page != nil {
+		pageValue = *page
+	}
+	if perPage != nil {
+		perPageValue = *perPage
+	}
+
+	// Extract optional search and sort params
+	searchStr := ""
+	if search != nil {
+		searchStr = *search
+	}
+
+	sortByStr := ""
+	if sortBy != nil {
+		sortByStr = *sortBy
+	}
+
+	sortOrderStr := ""
+	if sortOrder != nil {
+		sortOrderStr = *sortOrder
+	}
+
+	// Call tenant-service via gRPC
+	resp, err := r.TenantClient.GetAllTenants(ctx, &tenantPb.GetAllTenantsRequest{
+		Page:      pageValue,
+		PerPage:   perPageValue,
+		Search:    searchStr,
+		SortBy:    sortByStr,
+		SortOrder: sortOrderStr,
 	})
 	if err != nil {
-		return &model.MediaListResponse{
+		return &model.TenantListResponse{
 			Success: false,
-			Message: fmt.Sprintf("Failed to get media: %v", err),
+			Message: fmt.Sprintf("Failed to get tenants: %v", err),
 		}, nil
 	}
 
 	if !resp.Success {
-		return &model.MediaListResponse{
+		return &model.TenantListResponse{
 			Success: false,
 			Message: resp.Message,
 		}, nil
 	}
 
-	mediaList := make([]*model.Media, len(resp.Data.Media))
-	for i, m := range resp.Data.Media {
-		mediaList[i] = pbMediaToModel(m)
+	// Convert tenants
+	tenants := make([]*model.Tenant, len(resp.Data.Tenants))
+	for i, t := range resp.Data.Tenants {
+		tenants[i] = &model.Tenant{
+			ID:                  strconv.FormatInt(t.Id, 10),
+			UUID:                t.Uuid,
+			Name:                t.Name,
+			Slug:                t.Slug,
+			Domain:              util.StringPtr(t.Domain),
+			IsNameAutoGenerated: t.IsNameAutoGenerated,
+			CreatedBy:           strconv.FormatInt(t.CreatedBy, 10),
+			CreatedAt:           int32(t.CreatedAt),
+			UpdatedAt:           int32(t.UpdatedAt),
+		}
 	}
 
-	return &model.MediaListResponse{
+	return &model.TenantListResponse{
 		Success: true,
-		Message: resp.Message,
-		Data: &model.MediaListData{
-			Media:   mediaList,
+		Message: "Tenants retrieved successfully",
+		Data: &model.TenantList{
+			Tenants: tenants,
 			Total:   resp.Data.Total,
 			Page:    resp.Data.Page,
 			PerPage: resp.Data.PerPage,
@@ -2408,1312 +4179,1579 @@ func (r *queryResolver) AllMedia(ctx context.Context, input *model.GetAllMediaIn
 	}, nil
 }
 
-// MediaURL is the resolver for the mediaURL field.
-func (r *queryResolver) MediaURL(ctx context.Context, id string, expirySeconds *int32) (*model.MediaURLResponse, error) {
-	mediaID, err := strconv.ParseInt(id, 10, 64)
+// TenantUsers is the resolver for the tenantUsers field.
+func (r *queryResolver) TenantUsers(ctx context.Context, tenantID *string) (*model.TenantUsersResponse, error) {
+	ctx, parsedTenantID, err := r.tenantScope(ctx, tenantID, rbac.PermMembersRead)
 	if err != nil {
-		return &model.MediaURLResponse{
+		return &model.TenantUsersResponse{
 			Success: false,
-			Message: "Invalid media ID",
+			Message: err.Error(),
 		}, nil
 	}
 
-	expiry := int32(900) // Default 15 minutes
-	if expirySeconds != nil {
-		expiry = *expirySeconds
+	// Call tenant-service via gRPC
+	resp, err := r.TenantClient.GetTenantUsers(ctx, &tenantPb.GetTenantUsersRequest{
+		TenantId: parsedTenantID,
+	})
+	if err != nil {
+		return &model.TenantUsersResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to get tenant users: %v", err),
+		}, nil
 	}
 
-	resp, err := r.MediaClient.GetFileURL(ctx, &mediaPb.GetFileURLRequest{
-		Id:            mediaID,
-		ExpirySeconds: expiry,
+	if !resp.Success {
+		return &model.TenantUsersResponse{
+			Success: false,
+			Message: resp.Message,
+		}, nil
+	}
+
+	// Convert tenant users
+	tenantUsers := make([]*model.TenantUser, len(resp.Data))
+	for i, tu := range resp.Data {
+		tenantUsers[i] = &model.TenantUser{
+			ID:        strconv.FormatInt(tu.Id, 10),
+			UserID:    strconv.FormatInt(tu.UserId, 10),
+			TenantID:  strconv.FormatInt(tu.TenantId, 10),
+			Role:      tu.Role,
+			IsDefault: tu.IsDefault,
+			Email:     tu.Email,
+			CreatedAt: int32(tu.CreatedAt),
+			UpdatedAt: int32(tu.UpdatedAt),
+		}
+	}
+
+	return &model.TenantUsersResponse{
+		Success: true,
+		Message: "Tenant users retrieved successfully",
+		Data:    tenantUsers,
+	}, nil
+}
+
+// TenantSeatUsage is the resolver for the tenantSeatUsage field.
+func (r *queryResolver) TenantSeatUsage(ctx context.Context, tenantID *string) (*model.SeatUsageResponse, error) {
+	ctx, parsedTenantID, err := r.tenantScope(ctx, tenantID, rbac.PermMembersRead)
+	if err != nil {
+		return &model.SeatUsageResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	// Call tenant-service via gRPC
+	resp, err := r.TenantClient.GetSeatUsage(ctx, &tenantPb.GetSeatUsageRequest{
+		TenantId: parsedTenantID,
 	})
 	if err != nil {
-		return &model.MediaURLResponse{
+		return &model.SeatUsageResponse{
 			Success: false,
-			Message: fmt.Sprintf("Failed to get media URL: %v", err),
+			Message: fmt.Sprintf("Failed to get seat usage: %v", err),
 		}, nil
 	}
 
 	if !resp.Success {
-		return &model.MediaURLResponse{
+		return &model.SeatUsageResponse{
+			Success: false,
+			Message: resp.Message,
+		}, nil
+	}
+
+	return &model.SeatUsageResponse{
+		Success: true,
+		Message: resp.Message,
+		Data:    pbSeatUsageToModel(resp.Data),
+	}, nil
+}
+
+// TenantInvitations is the resolver for the tenantInvitations field.
+func (r *queryResolver) TenantInvitations(ctx context.Context, tenantID *string, status *string) (*model.TenantInvitationsResponse, error) {
+	ctx, parsedTenantID, err := r.tenantScope(ctx, tenantID, rbac.PermMembersRead)
+	if err != nil {
+		return &model.TenantInvitationsResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	req := &tenantPb.GetTenantInvitationsRequest{TenantId: parsedTenantID}
+	if status != nil {
+		req.Status = *status
+	}
+
+	resp, err := r.TenantClient.GetTenantInvitations(ctx, req)
+	if err != nil {
+		return &model.TenantInvitationsResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to get invitations: %v", err),
+		}, nil
+	}
+
+	if !resp.Success {
+		return &model.TenantInvitationsResponse{
+			Success: false,
+			Message: resp.Message,
+		}, nil
+	}
+
+	invitations := make([]*model.TenantInvitation, len(resp.Data))
+	for i, invitation := range resp.Data {
+		invitations[i] = pbTenantInvitationToModel(invitation)
+	}
+
+	return &model.TenantInvitationsResponse{
+		Success: true,
+		Message: resp.Message,
+		Data:    invitations,
+	}, nil
+}
+
+// UserTenants is the resolver for the userTenants field.
+func (r *queryResolver) UserTenants(ctx context.Context, userID string) (*model.TenantUsersResponse, error) {
+	// Check admin authorization
+	_, err := middleware.GetUserFromContext(ctx)
+	if err != nil {
+		return &model.TenantUsersResponse{
+			Success: false,
+			Message: "Unauthorized: Please login first",
+		}, nil
+	}
+
+	userIDInt, err := strconv.ParseInt(userID, 10, 64)
+	if err != nil {
+		return &model.TenantUsersResponse{
+			Success: false,
+			Message: "Invalid user ID",
+		}, nil
+	}
+
+	resp, err := r.TenantClient.GetUserTenants(ctx, &tenantPb.GetUserTenantsRequest{
+		UserId: userIDInt,
+	})
+	if err != nil {
+		return &model.TenantUsersResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to get user tenants: %v", err),
+		}, nil
+	}
+
+	if !resp.Success {
+		return &model.TenantUsersResponse{
+			Success: false,
+			Message: resp.Message,
+		}, nil
+	}
+
+	var tenantUsers []*model.TenantUser
+	for _, tu := range resp.Data {
+		tenantUsers = append(tenantUsers, &model.TenantUser{
+			ID:        strconv.FormatInt(tu.Id, 10),
+			UserID:    strconv.FormatInt(tu.UserId, 10),
+			TenantID:  strconv.FormatInt(tu.TenantId, 10),
+			Role:      tu.Role,
+			IsDefault: tu.IsDefault,
+			Email:     tu.Email,
+			CreatedAt: int32(tu.CreatedAt),
+			UpdatedAt: int32(tu.UpdatedAt),
+		})
+	}
+
+	return &model.TenantUsersResponse{
+		Success: true,
+		Message: "User tenants retrieved successfully",
+		Data:    tenantUsers,
+	}, nil
+}
+
+// Permissions is the resolver for the permissions field.
+func (r *queryResolver) Permissions(ctx context.Context) (*model.PermissionsResponse, error) {
+	if _, err := middleware.GetUserFromContext(ctx); err != nil {
+		return &model.PermissionsResponse{
+			Success: false,
+			Message: "Unauthorized: Please login first",
+		}, nil
+	}
+
+	resp, err := r.TenantClient.GetPermissions(ctx, &tenantPb.GetPermissionsRequest{})
+	if err != nil {
+		return &model.PermissionsResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to get permissions: %v", err),
+		}, nil
+	}
+
+	permissions := make([]*model.Permission, len(resp.Data))
+	for i, permission := range resp.Data {
+		permissions[i] = &model.Permission{
+			Key:         permission.Key,
+			Description: permission.Description,
+		}
+	}
+
+	return &model.PermissionsResponse{
+		Success: resp.Success,
+		Message: resp.Message,
+		Data:    permissions,
+	}, nil
+}
+
+// TenantRoles is the resolver for the tenantRoles field.
+func (r *queryResolver) TenantRoles(ctx context.Context, tenantID *string) (*model.TenantRolesResponse, error) {
+	ctx, parsedTenantID, err := r.tenantScope(ctx, tenantID, rbac.PermMembersRead)
+	if err != nil {
+		return &model.TenantRolesResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	resp, err := r.TenantClient.GetTenantRoles(ctx, &tenantPb.GetTenantRolesRequest{
+		TenantId: parsedTenantID,
+	})
+	if err != nil {
+		return &model.TenantRolesResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to get roles: %v", err),
+		}, nil
+	}
+
+	if !resp.Success {
+		return &model.TenantRolesResponse{
 			Success: false,
 			Message: resp.Message,
 		}, nil
 	}
 
-	return &model.MediaURLResponse{
+	roles := make([]*model.TenantRole, len(resp.Data))
+	for i, role := range resp.Data {
+		roles[i] = pbTenantRoleToModel(role)
+	}
+
+	return &model.TenantRolesResponse{
 		Success: true,
 		Message: resp.Message,
-		Data: &model.MediaURLData{
-			URL:       resp.Data.Url,
-			ExpiresAt: int32(resp.Data.ExpiresAt),
-		},
+		Data:    roles,
 	}, nil
 }
 
-// SearchUsers is the resolver for the searchUsers field.
-func (r *queryResolver) SearchUsers(ctx context.Context, query string, page *int32, perPage *int32) (*model.UserListResponse, error) {
-	// Default pagination
-	pageValue := int32(1)
-	if page != nil {
-		pageValue = *page
+// TenantAuthorize is the resolver for the tenantAuthorize field.
+func (r *queryResolver) TenantAuthorize(ctx context.Context, tenantID *string, permission string) (*model.TenantAuthorizationResponse, error) {
+	userClaims, err := middleware.GetUserFromContext(ctx)
+	if err != nil {
+		return &model.TenantAuthorizationResponse{
+			Success: false,
+			Message: "Unauthorized: Please login first",
+		}, nil
 	}
-	perPageValue := int32(10)
-	if perPage != nil {
-		perPageValue = *perPage
+
+	parsedTenantID, err := resolveTenantID(ctx, tenantID)
+	if err != nil {
+		return &model.TenantAuthorizationResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
 	}
 
-	// Call user-service via gRPC
-	resp, err := r.UserClient.SearchUsers(ctx, &userPb.SearchUsersRequest{
-		Query:   query,
-		Page:    pageValue,
-		PerPage: perPageValue,
+	// Lets the frontend hide what the signed-in user cannot do
+	resp, err := r.TenantClient.Authorize(ctx, &tenantPb.AuthorizeRequest{
+		UserId:     userClaims.Id,
+		TenantId:   parsedTenantID,
+		Permission: permission,
 	})
 	if err != nil {
-		return &model.UserListResponse{
+		return &model.TenantAuthorizationResponse{
 			Success: false,
-			Message: fmt.Sprintf("Search users failed: %v", err),
+			Message: fmt.Sprintf("Failed to check tenant permission: %v", err),
 		}, nil
 	}
 
 	if !resp.Success {
-		return &model.UserListResponse{
+		return &model.TenantAuthorizationResponse{
 			Success: false,
 			Message: resp.Message,
 		}, nil
 	}
 
-	// Convert users
-	users := make([]*model.User, len(resp.Data.Users))
-	for i, u := range resp.Data.Users {
-		users[i] = &model.User{
-			ID:          fmt.Sprintf("%d", u.Id),
-			Name:        u.Name,
-			Email:       u.Email,
-			PublicName:  &u.PublicName,
-			IsAdmin:     u.IsAdmin,
-			IsBlocked:   u.IsBlocked,
-			PhoneNumber: &u.PhoneNumber,
-			Position:    &u.Position,
-		}
+	// Platform admins pass every tenant check
+	result := &model.TenantAuthorizationResponse{
+		Success: true,
+		Message: resp.Message,
+		Allowed: resp.Allowed || userClaims.IsAdmin,
+		Role:    util.StringPtr(resp.Role),
+	}
+	if !result.Allowed {
+		result.Reason = util.StringPtr(resp.Reason)
 	}
 
-	return &model.UserListResponse{
-		Success: true,
-		Message: "Users search completed successfully",
-		Data: &model.UserList{
-			Users:   users,
-			Total:   resp.Data.Total,
-			Page:    resp.Data.Page,
-			PerPage: resp.Data.PerPage,
-		},
-	}, nil
+	return result, nil
 }
 
-// Me is the resolver for the me field.
-func (r *queryResolver) Me(ctx context.Context) (*model.UserResponse, error) {
-	logger.Info("========== Me (GetMyProfile) query called ==========")
-
-	// Get authenticated user from context
-	currentUser, err := middleware.GetUserFromContext(ctx)
+// TenantOwnershipChanges is the resolver for the tenantOwnershipChanges field.
+func (r *queryResolver) TenantOwnershipChanges(ctx context.Context, tenantID *string) (*model.OwnershipChangesResponse, error) {
+	ctx, parsedTenantID, err := r.tenantScope(ctx, tenantID, rbac.PermMembersRead)
 	if err != nil {
-		logger.Error("Failed to get user from context", zap.Error(err))
-		return &model.UserResponse{
+		return &model.OwnershipChangesResponse{
 			Success: false,
 			Message: err.Error(),
 		}, nil
 	}
 
-	logger.Info("Current user from context",
-		zap.Int64("user_id", currentUser.Id),
-		zap.String("email", currentUser.Email),
-	)
-
-	// Get user details from user-service
-	logger.Debug("Calling user-service GetUserByID", zap.Int64("user_id", currentUser.Id))
-	resp, err := r.UserClient.GetUserByID(ctx, &userPb.GetUserByIDRequest{
-		Id: currentUser.Id,
+	resp, err := r.TenantClient.GetOwnershipChanges(ctx, &tenantPb.GetOwnershipChangesRequest{
+		TenantId: parsedTenantID,
 	})
 	if err != nil {
-		logger.Error("user-service GetUserByID failed", zap.Error(err))
-		return &model.UserResponse{
+		return &model.OwnershipChangesResponse{
 			Success: false,
-			Message: fmt.Sprintf("Get current user failed: %v", err),
+			Message: fmt.Sprintf("Failed to get ownership changes: %v", err),
 		}, nil
 	}
 
 	if !resp.Success {
-		logger.Warn("user-service returned unsuccessful", zap.String("message", resp.Message))
-		return &model.UserResponse{
+		return &model.OwnershipChangesResponse{
 			Success: false,
 			Message: resp.Message,
 		}, nil
 	}
 
-	logger.Info("User data retrieved from user-service",
-		zap.Int64("user_id", resp.Data.Id),
-		zap.String("name", resp.Data.Name),
-		zap.String("email", resp.Data.Email),
-	)
-
-	emailVerifiedAt := int32(resp.Data.EmailVerifiedAt)
-	lastLoginAt := int32(resp.Data.LastLoginAt)
-	createdAt := int32(resp.Data.CreatedAt)
-	updatedAt := int32(resp.Data.UpdatedAt)
-
-	userModel := &model.User{
-		ID:              fmt.Sprintf("%d", resp.Data.Id),
-		Name:            resp.Data.Name,
-		Email:           resp.Data.Email,
-		PublicName:      &resp.Data.PublicName,
-		IsAdmin:         resp.Data.IsAdmin,
-		IsBlocked:       resp.Data.IsBlocked,
-		PhoneNumber:     &resp.Data.PhoneNumber,
-		Position:        &resp.Data.Position,
-		EmailVerified:   resp.Data.EmailVerified,
-		EmailVerifiedAt: &emailVerifiedAt,
-		LastLoginAt:     &lastLoginAt,
-		CreatedAt:       &createdAt,
-		UpdatedAt:       &updatedAt,
+	changes := make([]*model.OwnershipChange, len(resp.Data))
+	for i, change := range resp.Data {
+		changes[i] = pbOwnershipChangeToModel(change)
 	}
 
-	logger.Info("Returning user model",
-		zap.String("user_id", userModel.ID),
-		zap.String("name", userModel.Name),
-	)
-	logger.Info("========== Me query completed (Avatar resolver will be called next if requested) ==========")
-
-	return &model.UserResponse{
+	return &model.OwnershipChangesResponse{
 		Success: true,
-		Message: "Current user retrieved successfully",
-		Data:    userModel,
+		Message: resp.Message,
+		Data:    changes,
 	}, nil
 }
 
-// VerifyResetToken is the resolver for the verifyResetToken field.
-func (r *queryResolver) VerifyResetToken(ctx context.Context, token string) (*model.ForgotPasswordResponse, error) {
-	// Call auth-service via gRPC
-	resp, err := r.AuthClient.VerifyResetToken(ctx, &authPb.VerifyResetTokenRequest{
+// InvitationByToken is the resolver for the invitationByToken field.
+func (r *queryResolver) InvitationByToken(ctx context.Context, token string) (*model.InvitationByTokenResponse, error) {
+	resp, err := r.TenantClient.GetInvitationByToken(ctx, &tenantPb.GetInvitationByTokenRequest{
 		Token: token,
 	})
 	if err != nil {
-		return &model.ForgotPasswordResponse{
+		return &model.InvitationByTokenResponse{
 			Success: false,
-			Message: fmt.Sprintf("Verify reset token failed: %v", err),
+			Message: fmt.Sprintf("Failed to get invitation: %v", err),
 		}, nil
 	}
 
-	if !resp.Valid {
-		return &model.ForgotPasswordResponse{
+	if !resp.Success {
+		return &model.InvitationByTokenResponse{
 			Success: false,
 			Message: resp.Message,
 		}, nil
 	}
 
-	return &model.ForgotPasswordResponse{
+	tenant := resp.Tenant
+	return &model.InvitationByTokenResponse{
 		Success: true,
-		Message: "Reset token is valid",
+		Message: resp.Message,
+		Data:    pbTenantInvitationToModel(resp.Data),
+		Tenant: &model.Tenant{
+			ID:                  strconv.FormatInt(tenant.Id, 10),
+			UUID:                tenant.Uuid,
+			Name:                tenant.Name,
+			Slug:                tenant.Slug,
+			Domain:              util.StringPtr(tenant.Domain),
+			IsNameAutoGenerated: tenant.IsNameAutoGenerated,
+			CreatedBy:           strconv.FormatInt(tenant.CreatedBy, 10),
+			CreatedAt:           int32(tenant.CreatedAt),
+			UpdatedAt:           int32(tenant.UpdatedAt),
+		},
 	}, nil
 }
 
-// Tenant is the resolver for the tenant field.
-func (r *queryResolver) Tenant(ctx context.Context, id string) (*model.TenantResponse, error) {
-	// Check if user is admin
-	userClaims, err := middleware.GetUserFromContext(ctx)
-	if err != nil || userClaims == nil || !userClaims.IsAdmin {
-		return &model.TenantResponse{
+// TenantSetting is the resolver for the tenantSetting field.
+func (r *queryResolver) TenantSetting(ctx context.Context, tenantID *string, key string) (*model.TenantSettingResponse, error) {
+	ctx, tenantIDInt, err := r.tenantScope(ctx, tenantID, rbac.PermSettingsRead)
+	if err != nil {
+		return &model.TenantSettingResponse{
 			Success: false,
-			Message: "Unauthorized: Admin access required",
+			Message: err.Error(),
 		}, nil
 	}
 
-	tenantID, err := strconv.ParseInt(id, 10, 64)
+	resp, err := r.TenantClient.GetSetting(ctx, &tenantPb.GetSettingRequest{
+		TenantId: tenantIDInt,
+		Key:      key,
+	})
 	if err != nil {
-		return &model.TenantResponse{
+		return &model.TenantSettingResponse{
 			Success: false,
-			Message: "Invalid tenant ID",
+			Message: fmt.Sprintf("Failed to get tenant setting: %v", err),
 		}, nil
 	}
 
-	// Call tenant-service via gRPC
-	resp, err := r.TenantClient.GetTenantByID(ctx, &tenantPb.GetTenantByIDRequest{
-		Id: tenantID,
+	if !resp.Success || resp.Data == nil {
+		return &model.TenantSettingResponse{
+			Success: resp.Success,
+			Message: resp.Message,
+		}, nil
+	}
+
+	setting := resp.Data
+	return &model.TenantSettingResponse{
+		Success: true,
+		Message: "Tenant setting retrieved successfully",
+		Data: &model.TenantSetting{
+			ID:        strconv.FormatInt(setting.Id, 10),
+			TenantID:  strconv.FormatInt(setting.TenantId, 10),
+			Key:       setting.Key,
+			Value:     setting.Value,
+			CreatedAt: int32(setting.CreatedAt),
+			UpdatedAt: int32(setting.UpdatedAt),
+		},
+	}, nil
+}
+
+// TenantSettings is the resolver for the tenantSettings field.
+func (r *queryResolver) TenantSettings(ctx context.Context, tenantID *string) (*model.TenantSettingsResponse, error) {
+	ctx, tenantIDInt, err := r.tenantScope(ctx, tenantID, rbac.PermSettingsRead)
+	if err != nil {
+		return &model.TenantSettingsResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	resp, err := r.TenantClient.GetAllSettings(ctx, &tenantPb.GetAllSettingsRequest{
+		TenantId: tenantIDInt,
 	})
 	if err != nil {
-		return &model.TenantResponse{
+		return &model.TenantSettingsResponse{
 			Success: false,
-			Message: fmt.Sprintf("Failed to get tenant: %v", err),
+			Message: fmt.Sprintf("Failed to get tenant settings: %v", err),
 		}, nil
 	}
 
 	if !resp.Success {
-		return &model.TenantResponse{
+		return &model.TenantSettingsResponse{
 			Success: false,
 			Message: resp.Message,
 		}, nil
 	}
 
-	tenant := resp.Data
-	return &model.TenantResponse{
+	var settings []*model.TenantSetting
+	for _, s := range resp.Data {
+		settings = append(settings, &model.TenantSetting{
+			ID:        strconv.FormatInt(s.Id, 10),
+			TenantID:  strconv.FormatInt(s.TenantId, 10),
+			Key:       s.Key,
+			Value:     s.Value,
+			CreatedAt: int32(s.CreatedAt),
+			UpdatedAt: int32(s.UpdatedAt),
+		})
+	}
+
+	return &model.TenantSettingsResponse{
 		Success: true,
-		Message: "Tenant retrieved successfully",
-		Data: &model.Tenant{
-			ID:                  strconv.FormatInt(tenant.Id, 10),
-			UUID:                tenant.Uuid,
-			Name:                tenant.Name,
-			Slug:                tenant.Slug,
-			Domain:              util.StringPtr(tenant.Domain),
-			IsNameAutoGenerated: tenant.IsNameAutoGenerated,
-			CreatedBy:           strconv.FormatInt(tenant.CreatedBy, 10),
-			CreatedAt:           int32(tenant.CreatedAt),
-			UpdatedAt:           int32(tenant.UpdatedAt),
-		},
+		Message: "Tenant settings retrieved successfully",
+		Data:    settings,
 	}, nil
 }
 
-// TenantBySlug is the resolver for the tenantBySlug field.
-func (r *queryResolver) TenantBySlug(ctx context.Context, slug string) (*model.TenantResponse, error) {
-	// Check if user is admin
-	userClaims, err := middleware.GetUserFromContext(ctx)
-	if err != nil || userClaims == nil || !userClaims.IsAdmin {
-		return &model.TenantResponse{
+// Product is the resolver for the product field.
+func (r *queryResolver) Product(ctx context.Context, id string) (*model.ProductResponse, error) {
+	productID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return &model.ProductResponse{
+			Success: false,
+			Message: "Invalid product ID",
+		}, nil
+	}
+
+	// Call product-service via gRPC
+	resp, err := r.ProductClient.GetProductByID(ctx, &productPb.GetProductByIDRequest{
+		Id: productID,
+	})
+	if err != nil {
+		return &model.ProductResponse{
+			Success: false,
+			Message: fmt.Sprintf("Get product failed: %v", err),
+		}, nil
+	}
+
+	if !resp.Success {
+		return &model.ProductResponse{
 			Success: false,
-			Message: "Unauthorized: Admin access required",
+			Message: resp.Message,
 		}, nil
 	}
 
-	// Call tenant-service via gRPC
-	resp, err := r.TenantClient.GetTenantBySlug(ctx, &tenantPb.GetTenantBySlugRequest{
+	product := resp.Data
+	return &model.ProductResponse{
+		Success: true,
+		Message: resp.Message,
+		Data: &model.Product{
+			ID:          strconv.FormatInt(product.Id, 10),
+			Name:        product.Name,
+			Slug:        product.Slug,
+			Description: &product.Description,
+			Metadata:    &product.Metadata,
+			Features:    &product.Features,
+			IsPopular:   product.IsPopular,
+			IsDefault:   product.IsDefault,
+			CreatedAt:   int32(product.CreatedAt),
+			UpdatedAt:   int32(product.UpdatedAt),
+		},
+	}, nil
+}
+
+// ProductBySlug is the resolver for the productBySlug field.
+func (r *queryResolver) ProductBySlug(ctx context.Context, slug string) (*model.ProductResponse, error) {
+	// Call product-service via gRPC
+	resp, err := r.ProductClient.GetProductBySlug(ctx, &productPb.GetProductBySlugRequest{
 		Slug: slug,
 	})
 	if err != nil {
-		return &model.TenantResponse{
+		return &model.ProductResponse{
 			Success: false,
-			Message: fmt.Sprintf("Failed to get tenant: %v", err),
+			Message: fmt.Sprintf("Get product failed: %v", err),
 		}, nil
 	}
 
 	if !resp.Success {
-		return &model.TenantResponse{
+		return &model.ProductResponse{
 			Success: false,
 			Message: resp.Message,
 		}, nil
 	}
 
-	tenant := resp.Data
-	return &model.TenantResponse{
+	product := resp.Data
+	return &model.ProductResponse{
 		Success: true,
-		Message: "Tenant retrieved successfully",
-		Data: &model.Tenant{
-			ID:                  strconv.FormatInt(tenant.Id, 10),
-			UUID:                tenant.Uuid,
-			Name:                tenant.Name,
-			Slug:                tenant.Slug,
-			Domain:              util.StringPtr(tenant.Domain),
-			IsNameAutoGenerated: tenant.IsNameAutoGenerated,
-			CreatedBy:           strconv.FormatInt(tenant.CreatedBy, 10),
-			CreatedAt:           int32(tenant.CreatedAt),
-			UpdatedAt:           int32(tenant.UpdatedAt),
+		Message: resp.Message,
+		Data: &model.Product{
+			ID:          strconv.FormatInt(product.Id, 10),
+			Name:        product.Name,
+			Slug:        product.Slug,
+			Description: &product.Description,
+			Metadata:    &product.Metadata,
+			Features:    &product.Features,
+			IsPopular:   product.IsPopular,
+			IsDefault:   product.IsDefault,
+			CreatedAt:   int32(product.CreatedAt),
+			UpdatedAt:   int32(product.UpdatedAt),
 		},
 	}, nil
 }
 
-// Tenants is the resolver for the tenants field.
-func (r *queryResolver) Tenants(ctx context.Context, page *int32, perPage *int32, search *string, sortBy *string, sortOrder *string) (*model.TenantListResponse, error) {
-	// Check if user is admin
-	userClaims, err := middleware.GetUserFromContext(ctx)
-	if err != nil || userClaims == nil || !userClaims.IsAdmin {
-		return &model.TenantListResponse{
-			Success: false,
-			Message: "Unauthorized: Admin access required",
-		}, nil
-	}
-
+// Products is the resolver for the products field.
+func (r *queryResolver) Products(ctx context.Context, page *int32, perPage *int32, search *string, sortBy *string, sortOrder *string) (*model.ProductListResponse, error) {
 	// Set default pagination
-	pageValue := int32(1)
-	perPageValue := int32(10)
-	if page != nil {
-		pageValue = *page
+	pageNum := int32(1)
+	pageSize := int32(10)
+	if page != nil && *page > 0 {
+		pageNum = *page
 	}
-	if perPage != nil {
-		perPageValue = *perPage
+	if perPage != nil && *perPage > 0 {
+		pageSize = *perPage
 	}
 
 	// Extract optional search and sort params
 	searchStr := ""
+	sortByStr := ""
+	sortOrderStr := ""
 	if search != nil {
 		searchStr = *search
 	}
-
-	sortByStr := ""
 	if sortBy != nil {
 		sortByStr = *sortBy
 	}
-
-	sortOrderStr := ""
 	if sortOrder != nil {
 		sortOrderStr = *sortOrder
 	}
 
-	// Call tenant-service via gRPC
-	resp, err := r.TenantClient.GetAllTenants(ctx, &tenantPb.GetAllTenantsRequest{
-		Page:      pageValue,
-		PerPage:   perPageValue,
+	// Call product-service via gRPC
+	resp, err := r.ProductClient.GetAllProducts(ctx, &productPb.GetAllProductsRequest{
+		Page:      pageNum,
+		PerPage:   pageSize,
 		Search:    searchStr,
 		SortBy:    sortByStr,
 		SortOrder: sortOrderStr,
 	})
 	if err != nil {
-		return &model.TenantListResponse{
+		return &model.ProductListResponse{
 			Success: false,
-			Message: fmt.Sprintf("Failed to get tenants: %v", err),
+			Message: fmt.Sprintf("Get products failed: %v", err),
 		}, nil
 	}
 
 	if !resp.Success {
-		return &model.TenantListResponse{
+		return &model.ProductListResponse{
 			Success: false,
 			Message: resp.Message,
 		}, nil
 	}
 
-	// Convert tenants
-	tenants := make([]*model.Tenant, len(resp.Data.Tenants))
-	for i, t := range resp.Data.Tenants {
-		tenants[i] = &model.Tenant{
-			ID:                  strconv.FormatInt(t.Id, 10),
-			UUID:                t.Uuid,
-			Name:                t.Name,
-			Slug:                t.Slug,
-			Domain:              util.StringPtr(t.Domain),
-			IsNameAutoGenerated: t.IsNameAutoGenerated,
-			CreatedBy:           strconv.FormatInt(t.CreatedBy, 10),
-			CreatedAt:           int32(t.CreatedAt),
-			UpdatedAt:           int32(t.UpdatedAt),
+	// Convert products
+	products := make([]*model.Product, len(resp.Data.Products))
+	for i, p := range resp.Data.Products {
+		products[i] = &model.Product{
+			ID:          strconv.FormatInt(p.Id, 10),
+			Name:        p.Name,
+			Slug:        p.Slug,
+			Description: &p.Description,
+			Metadata:    &p.Metadata,
+			Features:    &p.Features,
+			IsPopular:   p.IsPopular,
+			IsDefault:   p.IsDefault,
+			CreatedAt:   int32(p.CreatedAt),
+			UpdatedAt:   int32(p.UpdatedAt),
 		}
 	}
 
-	return &model.TenantListResponse{
+	return &model.ProductListResponse{
 		Success: true,
-		Message: "Tenants retrieved successfully",
-		Data: &model.TenantList{
-			Tenants: tenants,
-			Total:   resp.Data.Total,
-			Page:    resp.Data.Page,
-			PerPage: resp.Data.PerPage,
+		Message: resp.Message,
+		Data: &model.ProductList{
+			Products: products,
+			Total:    resp.Data.Total,
+			Page:     resp.Data.Page,
+			PerPage:  resp.Data.PerPage,
 		},
 	}, nil
 }
 
-// TenantUsers is the resolver for the tenantUsers field.
-func (r *queryResolver) TenantUsers(ctx context.Context, tenantID string) (*model.TenantUsersResponse, error) {
-	// Check if user is admin
-	userClaims, err := middleware.GetUserFromContext(ctx)
-	if err != nil || userClaims == nil || !userClaims.IsAdmin {
-		return &model.TenantUsersResponse{
-			Success: false,
-			Message: "Unauthorized: Admin access required",
-		}, nil
-	}
-
-	parsedTenantID, err := strconv.ParseInt(tenantID, 10, 64)
+// Plan is the resolver for the plan field.
+func (r *queryResolver) Plan(ctx context.Context, id string) (*model.PlanResponse, error) {
+	planID, err := strconv.ParseInt(id, 10, 64)
 	if err != nil {
-		return &model.TenantUsersResponse{
+		return &model.PlanResponse{
 			Success: false,
-			Message: "Invalid tenant ID",
+			Message: "Invalid plan ID",
 		}, nil
 	}
 
-	// Call tenant-service via gRPC
-	resp, err := r.TenantClient.GetTenantUsers(ctx, &tenantPb.GetTenantUsersRequest{
-		TenantId: parsedTenantID,
+	// Call product-service via gRPC
+	resp, err := r.ProductClient.GetPlanByID(ctx, &productPb.GetPlanByIDRequest{
+		Id: planID,
 	})
 	if err != nil {
-		return &model.TenantUsersResponse{
+		return &model.PlanResponse{
 			Success: false,
-			Message: fmt.Sprintf("Failed to get tenant users: %v", err),
+			Message: fmt.Sprintf("Get plan failed: %v", err),
 		}, nil
 	}
 
 	if !resp.Success {
-		return &model.TenantUsersResponse{
+		return &model.PlanResponse{
 			Success: false,
 			Message: resp.Message,
 		}, nil
 	}
 
-	// Convert tenant users
-	tenantUsers := make([]*model.TenantUser, len(resp.Data))
-	for i, tu := range resp.Data {
-		tenantUsers[i] = &model.TenantUser{
-			ID:        strconv.FormatInt(tu.Id, 10),
-			UserID:    strconv.FormatInt(tu.UserId, 10),
-			TenantID:  strconv.FormatInt(tu.TenantId, 10),
-			Role:      tu.Role,
-			IsDefault: tu.IsDefault,
-			Email:     tu.Email,
-			CreatedAt: int32(tu.CreatedAt),
-			UpdatedAt: int32(tu.UpdatedAt),
-		}
-	}
-
-	return &model.TenantUsersResponse{
+	plan := resp.Data
+	return &model.PlanResponse{
 		Success: true,
-		Message: "Tenant users retrieved successfully",
-		Data:    tenantUsers,
+		Message: resp.Message,
+		Data: &model.Plan{
+			ID:         strconv.FormatInt(plan.Id, 10),
+			Name:       plan.Name,
+			Slug:       plan.Slug,
+			IntervalID: strconv.FormatInt(plan.IntervalId, 10),
+			ProductID:  strconv.FormatInt(plan.ProductId, 10),
+			IsActive:   plan.IsActive,
+			HasTrial:   plan.HasTrial,
+			TrialIntervalID: func() *string {
+				if plan.TrialIntervalId != nil {
+					s := strconv.FormatInt(*plan.TrialIntervalId, 10)
+					return &s
+				}
+				return nil
+			}(),
+			IntervalCount:      plan.IntervalCount,
+			TrialIntervalCount: &plan.TrialIntervalCount,
+			Description:        &plan.Description,
+			Type:               plan.Type,
+			MaxUsersPerTenant:  &plan.MaxUsersPerTenant,
+			MeterID: func() *string {
+				if plan.MeterId != nil {
+					s := strconv.FormatInt(*plan.MeterId, 10)
+					return &s
+				}
+				return nil
+			}(),
+			IsVisible: plan.IsVisible,
+			CreatedAt: int32(plan.CreatedAt),
+			UpdatedAt: int32(plan.UpdatedAt),
+		},
 	}, nil
 }
 
-// UserTenants is the resolver for the userTenants field.
-func (r *queryResolver) UserTenants(ctx context.Context, userID string) (*model.TenantUsersResponse, error) {
-	// Check admin authorization
-	_, err := middleware.GetUserFromContext(ctx)
-	if err != nil {
-		return &model.TenantUsersResponse{
-			Success: false,
-			Message: "Unauthorized: Please login first",
-		}, nil
-	}
-
-	userIDInt, err := strconv.ParseInt(userID, 10, 64)
-	if err != nil {
-		return &model.TenantUsersResponse{
-			Success: false,
-			Message: "Invalid user ID",
-		}, nil
-	}
-
-	resp, err := r.TenantClient.GetUserTenants(ctx, &tenantPb.GetUserTenantsRequest{
-		UserId: userIDInt,
+// PlanBySlug is the resolver for the planBySlug field.
+func (r *queryResolver) PlanBySlug(ctx context.Context, slug string) (*model.PlanResponse, error) {
+	// Call product-service via gRPC
+	resp, err := r.ProductClient.GetPlanBySlug(ctx, &productPb.GetPlanBySlugRequest{
+		Slug: slug,
 	})
 	if err != nil {
-		return &model.TenantUsersResponse{
+		return &model.PlanResponse{
 			Success: false,
-			Message: fmt.Sprintf("Failed to get user tenants: %v", err),
+			Message: fmt.Sprintf("Get plan failed: %v", err),
 		}, nil
 	}
 
 	if !resp.Success {
-		return &model.TenantUsersResponse{
+		return &model.PlanResponse{
 			Success: false,
 			Message: resp.Message,
 		}, nil
 	}
 
-	var tenantUsers []*model.TenantUser
-	for _, tu := range resp.Data {
-		tenantUsers = append(tenantUsers, &model.TenantUser{
-			ID:        strconv.FormatInt(tu.Id, 10),
-			UserID:    strconv.FormatInt(tu.UserId, 10),
-			TenantID:  strconv.FormatInt(tu.TenantId, 10),
-			Role:      tu.Role,
-			IsDefault: tu.IsDefault,
-			Email:     tu.Email,
-			CreatedAt: int32(tu.CreatedAt),
-			UpdatedAt: int32(tu.UpdatedAt),
-		})
-	}
-
-	return &model.TenantUsersResponse{
+	plan := resp.Data
+	return &model.PlanResponse{
 		Success: true,
-		Message: "User tenants retrieved successfully",
-		Data:    tenantUsers,
+		Message: resp.Message,
+		Data: &model.Plan{
+			ID:         strconv.FormatInt(plan.Id, 10),
+			Name:       plan.Name,
+			Slug:       plan.Slug,
+			IntervalID: strconv.FormatInt(plan.IntervalId, 10),
+			ProductID:  strconv.FormatInt(plan.ProductId, 10),
+			IsActive:   plan.IsActive,
+			HasTrial:   plan.HasTrial,
+			TrialIntervalID: func() *string {
+				if plan.TrialIntervalId != nil {
+					s := strconv.FormatInt(*plan.TrialIntervalId, 10)
+					return &s
+				}
+				return nil
+			}(),
+			IntervalCount:      plan.IntervalCount,
+			TrialIntervalCount: &plan.TrialIntervalCount,
+			Description:        &plan.Description,
+			Type:               plan.Type,
+			MaxUsersPerTenant:  &plan.MaxUsersPerTenant,
+			MeterID: func() *string {
+				if plan.MeterId != nil {
+					s := strconv.FormatInt(*plan.MeterId, 10)
+					return &s
+				}
+				return nil
+			}(),
+			IsVisible: plan.IsVisible,
+			CreatedAt: int32(plan.CreatedAt),
+			UpdatedAt: int32(plan.UpdatedAt),
+		},
 	}, nil
 }
 
-// TenantSetting is the resolver for the tenantSetting field.
-func (r *queryResolver) TenantSetting(ctx context.Context, tenantID string, key string) (*model.TenantSettingResponse, error) {
-	// Check admin authorization
-	_, err := middleware.GetUserFromContext(ctx)
-	if err != nil {
-		return &model.TenantSettingResponse{
-			Success: false,
-			Message: "Unauthorized: Please login first",
-		}, nil
+// Plans is the resolver for the plans field.
+func (r *queryResolver) Plans(ctx context.Context, page *int32, perPage *int32, search *string, sortBy *string, sortOrder *string, activeOnly *bool, visibleOnly *bool) (*model.PlanListResponse, error) {
+	// Set default pagination
+	pageNum := int32(1)
+	pageSize := int32(10)
+	if page != nil && *page > 0 {
+		pageNum = *page
+	}
+	if perPage != nil && *perPage > 0 {
+		pageSize = *perPage
 	}
 
-	tenantIDInt, err := strconv.ParseInt(tenantID, 10, 64)
-	if err != nil {
-		return &model.TenantSettingResponse{
-			Success: false,
-			Message: "Invalid tenant ID",
-		}, nil
+	// Prepare filters
+	req := &productPb.GetAllPlansRequest{
+		Page:    pageNum,
+		PerPage: pageSize,
+	}
+	if search != nil {
+		req.Search = *search
+	}
+	if sortBy != nil {
+		req.SortBy = *sortBy
+	}
+	if sortOrder != nil {
+		req.SortOrder = *sortOrder
+	}
+	if activeOnly != nil {
+		req.ActiveOnly = *activeOnly
+	}
+	if visibleOnly != nil {
+		req.VisibleOnly = *visibleOnly
 	}
 
-	resp, err := r.TenantClient.GetSetting(ctx, &tenantPb.GetSettingRequest{
-		TenantId: tenantIDInt,
-		Key:      key,
-	})
+	// Call product-service via gRPC
+	resp, err := r.ProductClient.GetAllPlans(ctx, req)
 	if err != nil {
-		return &model.TenantSettingResponse{
+		return &model.PlanListResponse{
 			Success: false,
-			Message: fmt.Sprintf("Failed to get tenant setting: %v", err),
+			Message: fmt.Sprintf("Get plans failed: %v", err),
 		}, nil
 	}
 
-	if !resp.Success || resp.Data == nil {
-		return &model.TenantSettingResponse{
-			Success: resp.Success,
+	if !resp.Success {
+		return &model.PlanListResponse{
+			Success: false,
 			Message: resp.Message,
 		}, nil
 	}
 
-	setting := resp.Data
-	return &model.TenantSettingResponse{
+	// Convert plans
+	plans := make([]*model.Plan, len(resp.Data.Plans))
+	for i, p := range resp.Data.Plans {
+		plans[i] = &model.Plan{
+			ID:         strconv.FormatInt(p.Id, 10),
+			Name:       p.Name,
+			Slug:       p.Slug,
+			IntervalID: strconv.FormatInt(p.IntervalId, 10),
+			ProductID:  strconv.FormatInt(p.ProductId, 10),
+			IsActive:   p.IsActive,
+			HasTrial:   p.HasTrial,
+			TrialIntervalID: func() *string {
+				if p.TrialIntervalId != nil {
+					s := strconv.FormatInt(*p.TrialIntervalId, 10)
+					return &s
+				}
+				return nil
+			}(),
+			IntervalCount:      p.IntervalCount,
+			TrialIntervalCount: &p.TrialIntervalCount,
+			Description:        &p.Description,
+			Type:               p.Type,
+			MaxUsersPerTenant:  &p.MaxUsersPerTenant,
+			MeterID: func() *string {
+				if p.MeterId != nil {
+					s := strconv.FormatInt(*p.MeterId, 10)
+					return &s
+				}
+				return nil
+			}(),
+			IsVisible: p.IsVisible,
+			CreatedAt: int32(p.CreatedAt),
+			UpdatedAt: int32(p.UpdatedAt),
+		}
+	}
+
+	return &model.PlanListResponse{
 		Success: true,
-		Message: "Tenant setting retrieved successfully",
-		Data: &model.TenantSetting{
-			ID:        strconv.FormatInt(setting.Id, 10),
-			TenantID:  strconv.FormatInt(setting.TenantId, 10),
-			Key:       setting.Key,
-			Value:     setting.Value,
-			CreatedAt: int32(setting.CreatedAt),
-			UpdatedAt: int32(setting.UpdatedAt),
+		Message: resp.Message,
+		Data: &model.PlanList{
+			Plans:   plans,
+			Total:   resp.Data.Total,
+			Page:    resp.Data.Page,
+			PerPage: resp.Data.PerPage,
 		},
 	}, nil
 }
 
-// TenantSettings is the resolver for the tenantSettings field.
-func (r *queryResolver) TenantSettings(ctx context.Context, tenantID string) (*model.TenantSettingsResponse, error) {
-	// Check admin authorization
-	_, err := middleware.GetUserFromContext(ctx)
-	if err != nil {
-		return &model.TenantSettingsResponse{
-			Success: false,
-			Message: "Unauthorized: Please login first",
-		}, nil
-	}
-
-	tenantIDInt, err := strconv.ParseInt(tenantID, 10, 64)
+// PlansByProduct is the resolver for the plansByProduct field.
+func (r *queryResolver) PlansByProduct(ctx context.Context, productID string) (*model.PlansResponse, error) {
+	productIDInt, err := strconv.ParseInt(productID, 10, 64)
 	if err != nil {
-		return &model.TenantSettingsResponse{
+		return &model.PlansResponse{
 			Success: false,
-			Message: "Invalid tenant ID",
+			Message: "Invalid product ID",
 		}, nil
 	}
 
-	resp, err := r.TenantClient.GetAllSettings(ctx, &tenantPb.GetAllSettingsRequest{
-		TenantId: tenantIDInt,
+	// Call product-service via gRPC
+	resp, err := r.ProductClient.GetPlansByProduct(ctx, &productPb.GetPlansByProductRequest{
+		ProductId: productIDInt,
 	})
 	if err != nil {
-		return &model.TenantSettingsResponse{
+		return &model.PlansResponse{
 			Success: false,
-			Message: fmt.Sprintf("Failed to get tenant settings: %v", err),
+			Message: fmt.Sprintf("Get plans failed: %v", err),
 		}, nil
 	}
 
 	if !resp.Success {
-		return &model.TenantSettingsResponse{
+		return &model.PlansResponse{
 			Success: false,
 			Message: resp.Message,
 		}, nil
 	}
 
-	var settings []*model.TenantSetting
-	for _, s := range resp.Data {
-		settings = append(settings, &model.TenantSetting{
-			ID:        strconv.FormatInt(s.Id, 10),
-			TenantID:  strconv.FormatInt(s.TenantId, 10),
-			Key:       s.Key,
-			Value:     s.Value,
-			CreatedAt: int32(s.CreatedAt),
-			UpdatedAt: int32(s.UpdatedAt),
-		})
+	// Convert plans
+	plans := make([]*model.Plan, len(resp.Data))
+	for i, p := range resp.Data {
+		plans[i] = &model.Plan{
+			ID:         strconv.FormatInt(p.Id, 10),
+			Name:       p.Name,
+			Slug:       p.Slug,
+			IntervalID: strconv.FormatInt(p.IntervalId, 10),
+			ProductID:  strconv.FormatInt(p.ProductId, 10),
+			IsActive:   p.IsActive,
+			HasTrial:   p.HasTrial,
+			TrialIntervalID: func() *string {
+				if p.TrialIntervalId != nil {
+					s := strconv.FormatInt(*p.TrialIntervalId, 10)
+					return &s
+				}
+				return nil
+			}(),
+			IntervalCount:      p.IntervalCount,
+			TrialIntervalCount: &p.TrialIntervalCount,
+			Description:        &p.Description,
+			Type:               p.Type,
+			MaxUsersPerTenant:  &p.MaxUsersPerTenant,
+			MeterID: func() *string {
+				if p.MeterId != nil {
+					s := strconv.FormatInt(*p.MeterId, 10)
+					return &s
+				}
+				return nil
+			}(),
+			IsVisible: p.IsVisible,
+			CreatedAt: int32(p.CreatedAt),
+			UpdatedAt: int32(p.UpdatedAt),
+		}
 	}
 
-	return &model.TenantSettingsResponse{
+	return &model.PlansResponse{
 		Success: true,
-		Message: "Tenant settings retrieved successfully",
-		Data:    settings,
+		Message: resp.Message,
+		Data:    plans,
 	}, nil
 }
 
-// Product is the resolver for the product field.
-func (r *queryResolver) Product(ctx context.Context, id string) (*model.ProductResponse, error) {
-	productID, err := strconv.ParseInt(id, 10, 64)
+// Discount is the resolver for the discount field.
+func (r *queryResolver) Discount(ctx context.Context, id string) (*model.DiscountResponse, error) {
+	// Parse ID
+	discountID, err := strconv.ParseInt(id, 10, 64)
 	if err != nil {
-		return &model.ProductResponse{
+		return &model.DiscountResponse{
 			Success: false,
-			Message: "Invalid product ID",
+			Message: "Invalid discount ID",
 		}, nil
 	}
 
 	// Call product-service via gRPC
-	resp, err := r.ProductClient.GetProductByID(ctx, &productPb.GetProductByIDRequest{
-		Id: productID,
+	resp, err := r.ProductClient.GetDiscountByID(ctx, &productPb.GetDiscountByIDRequest{
+		Id: discountID,
 	})
 	if err != nil {
-		return &model.ProductResponse{
+		return &model.DiscountResponse{
 			Success: false,
-			Message: fmt.Sprintf("Get product failed: %v", err),
+			Message: fmt.Sprintf("Get discount failed: %v", err),
 		}, nil
 	}
 
 	if !resp.Success {
-		return &model.ProductResponse{
+		return &model.DiscountResponse{
 			Success: false,
 			Message: resp.Message,
 		}, nil
 	}
 
-	product := resp.Data
-	return &model.ProductResponse{
+	// Convert proto discount to GraphQL model
+	discount := resp.Data
+	return &model.DiscountResponse{
 		Success: true,
 		Message: resp.Message,
-		Data: &model.Product{
-			ID:          strconv.FormatInt(product.Id, 10),
-			Name:        product.Name,
-			Slug:        product.Slug,
-			Description: &product.Description,
-			Metadata:    &product.Metadata,
-			Features:    &product.Features,
-			IsPopular:   product.IsPopular,
-			IsDefault:   product.IsDefault,
-			CreatedAt:   int32(product.CreatedAt),
-			UpdatedAt:   int32(product.UpdatedAt),
+		Data: &model.Discount{
+			ID:          strconv.FormatInt(discount.Id, 10),
+			Name:        discount.Name,
+			Description: discount.Description,
+			Type:        discount.Type,
+			Amount:      discount.Amount,
+			ValidUntil: func() *int32 {
+				if discount.ValidUntil != nil {
+					v := int32(*discount.ValidUntil)
+					return &v
+				}
+				return nil
+			}(),
+			IsActive:   discount.IsActive,
+			ActionType: discount.ActionType,
+			MaxRedemptions: func() *int32 {
+				if discount.MaxRedemptions != nil {
+					v := int32(*discount.MaxRedemptions)
+					return &v
+				}
+				return nil
+			}(),
+			MaxRedemptionsPerUser: func() *int32 {
+				if discount.MaxRedemptionsPerUser != nil {
+					v := int32(*discount.MaxRedemptionsPerUser)
+					return &v
+				}
+				return nil
+			}(),
+			Redemptions: int32(discount.Redemptions),
+			IsRecurring: discount.IsRecurring,
+			DurationInMonths: func() *int32 {
+				if discount.DurationInMonths != nil {
+					v := int32(*discount.DurationInMonths)
+					return &v
+				}
+				return nil
+			}(),
+			MaximumRecurringIntervals: func() *int32 {
+				if discount.MaximumRecurringIntervals != nil {
+					v := int32(*discount.MaximumRecurringIntervals)
+					return &v
+				}
+				return nil
+			}(),
+			RedeemType: func() *int32 {
+				if discount.RedeemType != nil {
+					v := int32(*discount.RedeemType)
+					return &v
+				}
+				return nil
+			}(),
+			BonusDays: func() *int32 {
+				if discount.BonusDays != nil {
+					v := int32(*discount.BonusDays)
+					return &v
+				}
+				return nil
+			}(),
+			IsEnabledForAllPlans:           discount.IsEnabledForAllPlans,
+			IsEnabledForAllOneTimeProducts: discount.IsEnabledForAllOneTimeProducts,
+			CreatedAt:                      int32(discount.CreatedAt),
+			UpdatedAt:                      int32(discount.UpdatedAt),
 		},
 	}, nil
 }
 
-// ProductBySlug is the resolver for the productBySlug field.
-func (r *queryResolver) ProductBySlug(ctx context.Context, slug string) (*model.ProductResponse, error) {
+// Discounts is the resolver for the discounts field.
+func (r *queryResolver) Discounts(ctx context.Context, page *int32, perPage *int32, activeOnly *bool, search *string, sortBy *string, sortOrder *string) (*model.DiscountListResponse, error) {
+	// Set defaults
+	p := int32(1)
+	if page != nil {
+		p = *page
+	}
+	pp := int32(10)
+	if perPage != nil {
+		pp = *perPage
+	}
+	ao := false
+	if activeOnly != nil {
+		ao = *activeOnly
+	}
+
+	// Handle optional search, sortBy, sortOrder
+	s := ""
+	if search != nil {
+		s = *search
+	}
+	sb := ""
+	if sortBy != nil {
+		sb = *sortBy
+	}
+	so := ""
+	if sortOrder != nil {
+		so = *sortOrder
+	}
+
 	// Call product-service via gRPC
-	resp, err := r.ProductClient.GetProductBySlug(ctx, &productPb.GetProductBySlugRequest{
-		Slug: slug,
+	resp, err := r.ProductClient.GetAllDiscounts(ctx, &productPb.GetAllDiscountsRequest{
+		Page:       p,
+		PerPage:    pp,
+		ActiveOnly: ao,
+		Search:     s,
+		SortBy:     sb,
+		SortOrder:  so,
 	})
 	if err != nil {
-		return &model.ProductResponse{
+		return &model.DiscountListResponse{
 			Success: false,
-			Message: fmt.Sprintf("Get product failed: %v", err),
+			Message: fmt.Sprintf("Get discounts failed: %v", err),
 		}, nil
 	}
 
 	if !resp.Success {
-		return &model.ProductResponse{
+		return &model.DiscountListResponse{
 			Success: false,
 			Message: resp.Message,
 		}, nil
 	}
 
-	product := resp.Data
-	return &model.ProductResponse{
+	// Convert proto discounts to GraphQL models
+	discounts := make([]*model.Discount, len(resp.Data.Discounts))
+	for i, d := range resp.Data.Discounts {
+		discounts[i] = &model.Discount{
+			ID:          strconv.FormatInt(d.Id, 10),
+			Name:        d.Name,
+			Description: d.Description,
+			Type:        d.Type,
+			Amount:      d.Amount,
+			ValidUntil: func() *int32 {
+				if d.ValidUntil != nil {
+					v := int32(*d.ValidUntil)
+					return &v
+				}
+				return nil
+			}(),
+			IsActive:   d.IsActive,
+			ActionType: d.ActionType,
+			MaxRedemptions: func() *int32 {
+				if d.MaxRedemptions != nil {
+					v := int32(*d.MaxRedemptions)
+					return &v
+				}
+				return nil
+			}(),
+			MaxRedemptionsPerUser: func() *int32 {
+				if d.MaxRedemptionsPerUser != nil {
+					v := int32(*d.MaxRedemptionsPerUser)
+					return &v
+				}
+				return nil
+			}(),
+			Redemptions: int32(d.Redemptions),
+			IsRecurring: d.IsRecurring,
+			DurationInMonths: func() *int32 {
+				if d.DurationInMonths != nil {
+					v := int32(*d.DurationInMonths)
+					return &v
+				}
+				return nil
+			}(),
+			MaximumRecurringIntervals: func() *int32 {
+				if d.MaximumRecurringIntervals != nil {
+					v := int32(*d.MaximumRecurringIntervals)
+					return &v
+				}
+				return nil
+			}(),
+			RedeemType: func() *int32 {
+				if d.RedeemType != nil {
+					v := int32(*d.RedeemType)
+					return &v
+				}
+				return nil
+			}(),
+			BonusDays: func() *int32 {
+				if d.BonusDays != nil {
+					v := int32(*d.BonusDays)
+					return &v
+				}
+				return nil
+			}(),
+			IsEnabledForAllPlans:           d.IsEnabledForAllPlans,
+			IsEnabledForAllOneTimeProducts: d.IsEnabledForAllOneTimeProducts,
+			CreatedAt:                      int32(d.CreatedAt),
+			UpdatedAt:                      int32(d.UpdatedAt),
+		}
+	}
+
+	return &model.DiscountListResponse{
 		Success: true,
 		Message: resp.Message,
-		Data: &model.Product{
-			ID:          strconv.FormatInt(product.Id, 10),
-			Name:        product.Name,
-			Slug:        product.Slug,
-			Description: &product.Description,
-			Metadata:    &product.Metadata,
-			Features:    &product.Features,
-			IsPopular:   product.IsPopular,
-			IsDefault:   product.IsDefault,
-			CreatedAt:   int32(product.CreatedAt),
-			UpdatedAt:   int32(product.UpdatedAt),
+		Data: &model.DiscountList{
+			Discounts: discounts,
+			Total:     int32(resp.Data.Total),
+			Page:      resp.Data.Page,
+			PerPage:   resp.Data.PerPage,
 		},
 	}, nil
 }
 
-// Products is the resolver for the products field.
-func (r *queryResolver) Products(ctx context.Context, page *int32, perPage *int32, search *string, sortBy *string, sortOrder *string) (*model.ProductListResponse, error) {
-	// Set default pagination
-	pageNum := int32(1)
-	pageSize := int32(10)
-	if page != nil && *page > 0 {
-		pageNum = *page
-	}
-	if perPage != nil && *perPage > 0 {
-		pageSize = *perPage
+// ExportDiscountCodes is the resolver for the exportDiscountCodes field.
+func (r *queryResolver) ExportDiscountCodes(ctx context.Context, discountID string) (*model.ExportDiscountCodesResponse, error) {
+	// Admin only
+	if err := middleware.RequireAdmin(ctx); err != nil {
+		return &model.ExportDiscountCodesResponse{
+			Success: false,
+			Message: "Admin access required",
+		}, nil
 	}
 
-	// Extract optional search and sort params
-	searchStr := ""
-	sortByStr := ""
-	sortOrderStr := ""
-	if search != nil {
-		searchStr = *search
-	}
-	if sortBy != nil {
-		sortByStr = *sortBy
-	}
-	if sortOrder != nil {
-		sortOrderStr = *sortOrder
+	// Parse ID
+	id, err := strconv.ParseInt(discountID, 10, 64)
+	if err != nil {
+		return &model.ExportDiscountCodesResponse{
+			Success: false,
+			Message: "Invalid discount ID",
+		}, nil
 	}
 
 	// Call product-service via gRPC
-	resp, err := r.ProductClient.GetAllProducts(ctx, &productPb.GetAllProductsRequest{
-		Page:      pageNum,
-		PerPage:   pageSize,
-		Search:    searchStr,
-		SortBy:    sortByStr,
-		SortOrder: sortOrderStr,
+	resp, err := r.ProductClient.ExportDiscountCodes(ctx, &productPb.ExportDiscountCodesRequest{
+		DiscountId: id,
 	})
 	if err != nil {
-		return &model.ProductListResponse{
+		return &model.ExportDiscountCodesResponse{
 			Success: false,
-			Message: fmt.Sprintf("Get products failed: %v", err),
+			Message: fmt.Sprintf("Export discount codes failed: %v", err),
 		}, nil
 	}
 
 	if !resp.Success {
-		return &model.ProductListResponse{
+		return &model.ExportDiscountCodesResponse{
 			Success: false,
 			Message: resp.Message,
 		}, nil
 	}
 
-	// Convert products
-	products := make([]*model.Product, len(resp.Data.Products))
-	for i, p := range resp.Data.Products {
-		products[i] = &model.Product{
-			ID:          strconv.FormatInt(p.Id, 10),
-			Name:        p.Name,
-			Slug:        p.Slug,
-			Description: &p.Description,
-			Metadata:    &p.Metadata,
-			Features:    &p.Features,
-			IsPopular:   p.IsPopular,
-			IsDefault:   p.IsDefault,
-			CreatedAt:   int32(p.CreatedAt),
-			UpdatedAt:   int32(p.UpdatedAt),
-		}
-	}
-
-	return &model.ProductListResponse{
+	return &model.ExportDiscountCodesResponse{
 		Success: true,
 		Message: resp.Message,
-		Data: &model.ProductList{
-			Products: products,
-			Total:    resp.Data.Total,
-			Page:     resp.Data.Page,
-			PerPage:  resp.Data.PerPage,
+		Data: &model.DiscountCodesExport{
+			DiscountID: discountID,
+			Total:      resp.Total,
+			CSV:        resp.Csv,
 		},
 	}, nil
 }
 
-// Plan is the resolver for the plan field.
-func (r *queryResolver) Plan(ctx context.Context, id string) (*model.PlanResponse, error) {
-	planID, err := strconv.ParseInt(id, 10, 64)
+// ReferralStats is the resolver for the referralStats field.
+func (r *queryResolver) ReferralStats(ctx context.Context, userID *string) (*model.ReferralStatsResponse, error) {
+	// Get authenticated user from context
+	currentUser, err := middleware.GetUserFromContext(ctx)
 	if err != nil {
-		return &model.PlanResponse{
+		return &model.ReferralStatsResponse{
 			Success: false,
-			Message: "Invalid plan ID",
+			Message: err.Error(),
 		}, nil
 	}
 
+	// Users see their own stats; admins can look up anyone
+	referrerID := currentUser.Id
+	if userID != nil {
+		id, err := strconv.ParseInt(*userID, 10, 64)
+		if err != nil {
+			return &model.ReferralStatsResponse{
+				Success: false,
+				Message: "Invalid user ID",
+			}, nil
+		}
+		if id != currentUser.Id && !currentUser.IsAdmin {
+			return &model.ReferralStatsResponse{
+				Success: false,
+				Message: "Admin access required",
+			}, nil
+		}
+		referrerID = id
+	}
+
 	// Call product-service via gRPC
-	resp, err := r.ProductClient.GetPlanByID(ctx, &productPb.GetPlanByIDRequest{
-		Id: planID,
+	resp, err := r.ProductClient.GetReferralStats(ctx, &productPb.GetReferralStatsRequest{
+		UserId: referrerID,
 	})
 	if err != nil {
-		return &model.PlanResponse{
+		return &model.ReferralStatsResponse{
 			Success: false,
-			Message: fmt.Sprintf("Get plan failed: %v", err),
+			Message: fmt.Sprintf("Get referral stats failed: %v", err),
 		}, nil
 	}
 
 	if !resp.Success {
-		return &model.PlanResponse{
+		return &model.ReferralStatsResponse{
 			Success: false,
 			Message: resp.Message,
 		}, nil
 	}
 
-	plan := resp.Data
-	return &model.PlanResponse{
+	stats := &model.ReferralStats{
+		ReferrerUserID:     strconv.FormatInt(resp.Data.ReferrerUserId, 10),
+		TotalReferrals:     resp.Data.TotalReferrals,
+		PendingReferrals:   resp.Data.PendingReferrals,
+		ConvertedReferrals: resp.Data.ConvertedReferrals,
+		BonusDaysEarned:    int32(resp.Data.BonusDaysEarned),
+	}
+	if resp.Data.Code != nil {
+		stats.Code = &resp.Data.Code.Code
+	}
+
+	return &model.ReferralStatsResponse{
 		Success: true,
 		Message: resp.Message,
-		Data: &model.Plan{
-			ID:         strconv.FormatInt(plan.Id, 10),
-			Name:       plan.Name,
-			Slug:       plan.Slug,
-			IntervalID: strconv.FormatInt(plan.IntervalId, 10),
-			ProductID:  strconv.FormatInt(plan.ProductId, 10),
-			IsActive:   plan.IsActive,
-			HasTrial:   plan.HasTrial,
-			TrialIntervalID: func() *string {
-				if plan.TrialIntervalId != nil {
-					s := strconv.FormatInt(*plan.TrialIntervalId, 10)
-					return &s
-				}
-				return nil
-			}(),
-			IntervalCount:      plan.IntervalCount,
-			TrialIntervalCount: &plan.TrialIntervalCount,
-			Description:        &plan.Description,
-			Type:               plan.Type,
-			MaxUsersPerTenant:  &plan.MaxUsersPerTenant,
-			MeterID: func() *string {
-				if plan.MeterId != nil {
-					s := strconv.FormatInt(*plan.MeterId, 10)
-					return &s
-				}
-				return nil
-			}(),
-			IsVisible: plan.IsVisible,
-			CreatedAt: int32(plan.CreatedAt),
-			UpdatedAt: int32(plan.UpdatedAt),
-		},
+		Data:    stats,
 	}, nil
 }
 
-// PlanBySlug is the resolver for the planBySlug field.
-func (r *queryResolver) PlanBySlug(ctx context.Context, slug string) (*model.PlanResponse, error) {
+// ProductPrices is the resolver for the productPrices field.
+func (r *queryResolver) ProductPrices(ctx context.Context, productID string) (*model.ProductPricesResponse, error) {
+	id, err := strconv.ParseInt(productID, 10, 64)
+	if err != nil {
+		return &model.ProductPricesResponse{
+			Success: false,
+			Message: "Invalid product ID",
+		}, nil
+	}
+
 	// Call product-service via gRPC
-	resp, err := r.ProductClient.GetPlanBySlug(ctx, &productPb.GetPlanBySlugRequest{
-		Slug: slug,
+	resp, err := r.ProductClient.GetProductPrices(ctx, &productPb.GetProductPricesRequest{
+		ProductId: id,
 	})
 	if err != nil {
-		return &model.PlanResponse{
+		return &model.ProductPricesResponse{
 			Success: false,
-			Message: fmt.Sprintf("Get plan failed: %v", err),
+			Message: fmt.Sprintf("Get product prices failed: %v", err),
 		}, nil
 	}
 
 	if !resp.Success {
-		return &model.PlanResponse{
+		return &model.ProductPricesResponse{
 			Success: false,
 			Message: resp.Message,
 		}, nil
 	}
 
-	plan := resp.Data
-	return &model.PlanResponse{
+	prices := make([]*model.ProductPrice, len(resp.Data))
+	for i, price := range resp.Data {
+		prices[i] = pbProductPriceToModel(price)
+	}
+
+	return &model.ProductPricesResponse{
 		Success: true,
 		Message: resp.Message,
-		Data: &model.Plan{
-			ID:         strconv.FormatInt(plan.Id, 10),
-			Name:       plan.Name,
-			Slug:       plan.Slug,
-			IntervalID: strconv.FormatInt(plan.IntervalId, 10),
-			ProductID:  strconv.FormatInt(plan.ProductId, 10),
-			IsActive:   plan.IsActive,
-			HasTrial:   plan.HasTrial,
-			TrialIntervalID: func() *string {
-				if plan.TrialIntervalId != nil {
-					s := strconv.FormatInt(*plan.TrialIntervalId, 10)
-					return &s
-				}
-				return nil
-			}(),
-			IntervalCount:      plan.IntervalCount,
-			TrialIntervalCount: &plan.TrialIntervalCount,
-			Description:        &plan.Description,
-			Type:               plan.Type,
-			MaxUsersPerTenant:  &plan.MaxUsersPerTenant,
-			MeterID: func() *string {
-				if plan.MeterId != nil {
-					s := strconv.FormatInt(*plan.MeterId, 10)
-					return &s
-				}
-				return nil
-			}(),
-			IsVisible: plan.IsVisible,
-			CreatedAt: int32(plan.CreatedAt),
-			UpdatedAt: int32(plan.UpdatedAt),
-		},
+		Data:    prices,
 	}, nil
 }
 
-// Plans is the resolver for the plans field.
-func (r *queryResolver) Plans(ctx context.Context, page *int32, perPage *int32, search *string, sortBy *string, sortOrder *string, activeOnly *bool, visibleOnly *bool) (*model.PlanListResponse, error) {
-	// Set default pagination
-	pageNum := int32(1)
-	pageSize := int32(10)
-	if page != nil && *page > 0 {
-		pageNum = *page
-	}
-	if perPage != nil && *perPage > 0 {
-		pageSize = *perPage
+// Order is the resolver for the order field.
+func (r *queryResolver) Order(ctx context.Context, id string) (*model.OrderResponse, error) {
+	orderID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return &model.OrderResponse{
+			Success: false,
+			Message: "Invalid order ID",
+		}, nil
 	}
 
-	// Prepare filters
-	req := &productPb.GetAllPlansRequest{
-		Page:    pageNum,
-		PerPage: pageSize,
-	}
-	if search != nil {
-		req.Search = *search
-	}
-	if sortBy != nil {
-		req.SortBy = *sortBy
+	// Customers see their own orders; admins see any
+	order, err := r.getAccessibleOrder(ctx, orderID)
+	if err != nil {
+		return &model.OrderResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
 	}
-	if sortOrder != nil {
-		req.SortOrder = *sortOrder
+
+	return &model.OrderResponse{
+		Success: true,
+		Message: "Order retrieved successfully",
+		Data:    pbOrderToModel(order),
+	}, nil
+}
+
+// MyOrders is the resolver for the myOrders field.
+func (r *queryResolver) MyOrders(ctx context.Context, page *int32, perPage *int32) (*model.OrderListResponse, error) {
+	// Get authenticated user from context
+	currentUser, err := middleware.GetUserFromContext(ctx)
+	if err != nil {
+		return &model.OrderListResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
 	}
-	if activeOnly != nil {
-		req.ActiveOnly = *activeOnly
+
+	// Set defaults
+	p := int32(1)
+	if page != nil {
+		p = *page
 	}
-	if visibleOnly != nil {
-		req.VisibleOnly = *visibleOnly
+	pp := int32(10)
+	if perPage != nil {
+		pp = *perPage
 	}
 
 	// Call product-service via gRPC
-	resp, err := r.ProductClient.GetAllPlans(ctx, req)
+	resp, err := r.ProductClient.GetOrdersByUser(ctx, &productPb.GetOrdersByUserRequest{
+		UserId:  currentUser.Id,
+		Page:    p,
+		PerPage: pp,
+	})
 	if err != nil {
-		return &model.PlanListResponse{
+		return &model.OrderListResponse{
 			Success: false,
-			Message: fmt.Sprintf("Get plans failed: %v", err),
+			Message: fmt.Sprintf("Get orders failed: %v", err),
 		}, nil
 	}
 
 	if !resp.Success {
-		return &model.PlanListResponse{
+		return &model.OrderListResponse{
 			Success: false,
 			Message: resp.Message,
 		}, nil
 	}
 
-	// Convert plans
-	plans := make([]*model.Plan, len(resp.Data.Plans))
-	for i, p := range resp.Data.Plans {
-		plans[i] = &model.Plan{
-			ID:         strconv.FormatInt(p.Id, 10),
-			Name:       p.Name,
-			Slug:       p.Slug,
-			IntervalID: strconv.FormatInt(p.IntervalId, 10),
-			ProductID:  strconv.FormatInt(p.ProductId, 10),
-			IsActive:   p.IsActive,
-			HasTrial:   p.HasTrial,
-			TrialIntervalID: func() *string {
-				if p.TrialIntervalId != nil {
-					s := strconv.FormatInt(*p.TrialIntervalId, 10)
-					return &s
-				}
-				return nil
-			}(),
-			IntervalCount:      p.IntervalCount,
-			TrialIntervalCount: &p.TrialIntervalCount,
-			Description:        &p.Description,
-			Type:               p.Type,
-			MaxUsersPerTenant:  &p.MaxUsersPerTenant,
-			MeterID: func() *string {
-				if p.MeterId != nil {
-					s := strconv.FormatInt(*p.MeterId, 10)
-					return &s
-				}
-				return nil
-			}(),
-			IsVisible: p.IsVisible,
-			CreatedAt: int32(p.CreatedAt),
-			UpdatedAt: int32(p.UpdatedAt),
-		}
-	}
-
-	return &model.PlanListResponse{
+	return &model.OrderListResponse{
 		Success: true,
 		Message: resp.Message,
-		Data: &model.PlanList{
-			Plans:   plans,
-			Total:   resp.Data.Total,
-			Page:    resp.Data.Page,
-			PerPage: resp.Data.PerPage,
-		},
+		Data:    pbOrderListToModel(resp.Data),
 	}, nil
 }
 
-// PlansByProduct is the resolver for the plansByProduct field.
-func (r *queryResolver) PlansByProduct(ctx context.Context, productID string) (*model.PlansResponse, error) {
-	productIDInt, err := strconv.ParseInt(productID, 10, 64)
+// Orders is the resolver for the orders field.
+func (r *queryResolver) Orders(ctx context.Context, status *string, page *int32, perPage *int32) (*model.OrderListResponse, error) {
+	// Admin only
+	if err := middleware.RequireAdmin(ctx); err != nil {
+		return &model.OrderListResponse{
+			Success: false,
+			Message: "Admin access required",
+		}, nil
+	}
+
+	// Set defaults
+	p := int32(1)
+	if page != nil {
+		p = *page
+	}
+	pp := int32(10)
+	if perPage != nil {
+		pp = *perPage
+	}
+
+	// Call product-service via gRPC
+	resp, err := r.ProductClient.GetAllOrders(ctx, &productPb.GetAllOrdersRequest{
+		Status:  util.StringValue(status),
+		Page:    p,
+		PerPage: pp,
+	})
 	if err != nil {
-		return &model.PlansResponse{
+		return &model.OrderListResponse{
 			Success: false,
-			Message: "Invalid product ID",
+			Message: fmt.Sprintf("Get orders failed: %v", err),
+		}, nil
+	}
+
+	if !resp.Success {
+		return &model.OrderListResponse{
+			Success: false,
+			Message: resp.Message,
 		}, nil
 	}
 
+	return &model.OrderListResponse{
+		Success: true,
+		Message: resp.Message,
+		Data:    pbOrderListToModel(resp.Data),
+	}, nil
+}
+
+// Currencies is the resolver for the currencies field.
+func (r *queryResolver) Currencies(ctx context.Context) (*model.CurrenciesResponse, error) {
 	// Call product-service via gRPC
-	resp, err := r.ProductClient.GetPlansByProduct(ctx, &productPb.GetPlansByProductRequest{
-		ProductId: productIDInt,
-	})
+	resp, err := r.ProductClient.GetCurrencies(ctx, &productPb.GetCurrenciesRequest{})
 	if err != nil {
-		return &model.PlansResponse{
+		return &model.CurrenciesResponse{
 			Success: false,
-			Message: fmt.Sprintf("Get plans failed: %v", err),
+			Message: fmt.Sprintf("Get currencies failed: %v", err),
 		}, nil
 	}
 
 	if !resp.Success {
-		return &model.PlansResponse{
+		return &model.CurrenciesResponse{
 			Success: false,
 			Message: resp.Message,
 		}, nil
 	}
 
-	// Convert plans
-	plans := make([]*model.Plan, len(resp.Data))
-	for i, p := range resp.Data {
-		plans[i] = &model.Plan{
-			ID:         strconv.FormatInt(p.Id, 10),
-			Name:       p.Name,
-			Slug:       p.Slug,
-			IntervalID: strconv.FormatInt(p.IntervalId, 10),
-			ProductID:  strconv.FormatInt(p.ProductId, 10),
-			IsActive:   p.IsActive,
-			HasTrial:   p.HasTrial,
-			TrialIntervalID: func() *string {
-				if p.TrialIntervalId != nil {
-					s := strconv.FormatInt(*p.TrialIntervalId, 10)
-					return &s
-				}
-				return nil
-			}(),
-			IntervalCount:      p.IntervalCount,
-			TrialIntervalCount: &p.TrialIntervalCount,
-			Description:        &p.Description,
-			Type:               p.Type,
-			MaxUsersPerTenant:  &p.MaxUsersPerTenant,
-			MeterID: func() *string {
-				if p.MeterId != nil {
-					s := strconv.FormatInt(*p.MeterId, 10)
-					return &s
-				}
-				return nil
-			}(),
-			IsVisible: p.IsVisible,
-			CreatedAt: int32(p.CreatedAt),
-			UpdatedAt: int32(p.UpdatedAt),
-		}
+	currencies := make([]*model.Currency, len(resp.Data))
+	for i, currency := range resp.Data {
+		currencies[i] = pbCurrencyToModel(currency)
 	}
 
-	return &model.PlansResponse{
+	return &model.CurrenciesResponse{
 		Success: true,
 		Message: resp.Message,
-		Data:    plans,
+		Data:    currencies,
 	}, nil
 }
 
-// Discount is the resolver for the discount field.
-func (r *queryResolver) Discount(ctx context.Context, id string) (*model.DiscountResponse, error) {
-	// Parse ID
-	discountID, err := strconv.ParseInt(id, 10, 64)
+// Currency is the resolver for the currency field.
+func (r *queryResolver) Currency(ctx context.Context, id string) (*model.CurrencyResponse, error) {
+	currencyID, err := strconv.ParseInt(id, 10, 64)
 	if err != nil {
-		return &model.DiscountResponse{
+		return &model.CurrencyResponse{
 			Success: false,
-			Message: "Invalid discount ID",
+			Message: "Invalid currency ID",
 		}, nil
 	}
 
 	// Call product-service via gRPC
-	resp, err := r.ProductClient.GetDiscountByID(ctx, &productPb.GetDiscountByIDRequest{
-		Id: discountID,
+	resp, err := r.ProductClient.GetCurrencyByID(ctx, &productPb.GetCurrencyByIDRequest{
+		Id: currencyID,
 	})
 	if err != nil {
-		return &model.DiscountResponse{
+		return &model.CurrencyResponse{
 			Success: false,
-			Message: fmt.Sprintf("Get discount failed: %v", err),
+			Message: fmt.Sprintf("Get currency failed: %v", err),
 		}, nil
 	}
 
 	if !resp.Success {
-		return &model.DiscountResponse{
+		return &model.CurrencyResponse{
 			Success: false,
 			Message: resp.Message,
 		}, nil
 	}
 
-	// Convert proto discount to GraphQL model
-	discount := resp.Data
-	return &model.DiscountResponse{
+	return &model.CurrencyResponse{
 		Success: true,
 		Message: resp.Message,
-		Data: &model.Discount{
-			ID:          strconv.FormatInt(discount.Id, 10),
-			Name:        discount.Name,
-			Description: discount.Description,
-			Type:        discount.Type,
-			Amount:      discount.Amount,
-			ValidUntil: func() *int32 {
-				if discount.ValidUntil != nil {
-					v := int32(*discount.ValidUntil)
-					return &v
-				}
-				return nil
-			}(),
-			IsActive:   discount.IsActive,
-			ActionType: discount.ActionType,
-			MaxRedemptions: func() *int32 {
-				if discount.MaxRedemptions != nil {
-					v := int32(*discount.MaxRedemptions)
-					return &v
-				}
-				return nil
-			}(),
-			MaxRedemptionsPerUser: func() *int32 {
-				if discount.MaxRedemptionsPerUser != nil {
-					v := int32(*discount.MaxRedemptionsPerUser)
-					return &v
-				}
-				return nil
-			}(),
-			Redemptions: int32(discount.Redemptions),
-			IsRecurring: discount.IsRecurring,
-			DurationInMonths: func() *int32 {
-				if discount.DurationInMonths != nil {
-					v := int32(*discount.DurationInMonths)
-					return &v
-				}
-				return nil
-			}(),
-			MaximumRecurringIntervals: func() *int32 {
-				if discount.MaximumRecurringIntervals != nil {
-					v := int32(*discount.MaximumRecurringIntervals)
-					return &v
-				}
-				return nil
-			}(),
-			RedeemType: func() *int32 {
-				if discount.RedeemType != nil {
-					v := int32(*discount.RedeemType)
-					return &v
-				}
-				return nil
-			}(),
-			BonusDays: func() *int32 {
-				if discount.BonusDays != nil {
-					v := int32(*discount.BonusDays)
-					return &v
-				}
-				return nil
-			}(),
-			IsEnabledForAllPlans:           discount.IsEnabledForAllPlans,
-			IsEnabledForAllOneTimeProducts: discount.IsEnabledForAllOneTimeProducts,
-			CreatedAt:                      int32(discount.CreatedAt),
-			UpdatedAt:                      int32(discount.UpdatedAt),
-		},
+		Data:    pbCurrencyToModel(resp.Data),
 	}, nil
 }
 
-// Discounts is the resolver for the discounts field.
-func (r *queryResolver) Discounts(ctx context.Context, page *int32, perPage *int32, activeOnly *bool, search *string, sortBy *string, sortOrder *string) (*model.DiscountListResponse, error) {
-	// Set defaults
-	p := int32(1)
-	if page != nil {
-		p = *page
+// ExchangeRates is the resolver for the exchangeRates field.
+func (r *queryResolver) ExchangeRates(ctx context.Context, baseCurrencyID *string, quoteCurrencyID *string, page *int32, perPage *int32) (*model.ExchangeRateListResponse, error) {
+	// Admin only
+	if err := middleware.RequireAdmin(ctx); err != nil {
+		return &model.ExchangeRateListResponse{
+			Success: false,
+			Message: "Admin access required",
+		}, nil
 	}
-	pp := int32(10)
-	if perPage != nil {
-		pp = *perPage
+
+	req := &productPb.GetExchangeRatesRequest{
+		Page:    1,
+		PerPage: 10,
 	}
-	ao := false
-	if activeOnly != nil {
-		ao = *activeOnly
+	if page != nil {
+		req.Page = *page
 	}
-
-	// Handle optional search, sortBy, sortOrder
-	s := ""
-	if search != nil {
-		s = *search
+	if perPage != nil {
+		req.PerPage = *perPage
 	}
-	sb := ""
-	if sortBy != nil {
-		sb = *sortBy
+	if baseCurrencyID != nil {
+		id, err := strconv.ParseInt(*baseCurrencyID, 10, 64)
+		if err != nil {
+			return &model.ExchangeRateListResponse{
+				Success: false,
+				Message: "Invalid base currency ID",
+			}, nil
+		}
+		req.BaseCurrencyId = id
 	}
-	so := ""
-	if sortOrder != nil {
-		so = *sortOrder
+	if quoteCurrencyID != nil {
+		id, err := strconv.ParseInt(*quoteCurrencyID, 10, 64)
+		if err != nil {
+			return &model.ExchangeRateListResponse{
+				Success: false,
+				Message: "Invalid quote currency ID",
+			}, nil
+		}
+		req.QuoteCurrencyId = id
 	}
 
 	// Call product-service via gRPC
-	resp, err := r.ProductClient.GetAllDiscounts(ctx, &productPb.GetAllDiscountsRequest{
-		Page:       p,
-		PerPage:    pp,
-		ActiveOnly: ao,
-		Search:     s,
-		SortBy:     sb,
-		SortOrder:  so,
-	})
+	resp, err := r.ProductClient.GetExchangeRates(ctx, req)
 	if err != nil {
-		return &model.DiscountListResponse{
+		return &model.ExchangeRateListResponse{
 			Success: false,
-			Message: fmt.Sprintf("Get discounts failed: %v", err),
+			Message: fmt.Sprintf("Get exchange rates failed: %v", err),
 		}, nil
 	}
 
 	if !resp.Success {
-		return &model.DiscountListResponse{
+		return &model.ExchangeRateListResponse{
 			Success: false,
 			Message: resp.Message,
 		}, nil
 	}
 
-	// Convert proto discounts to GraphQL models
-	discounts := make([]*model.Discount, len(resp.Data.Discounts))
-	for i, d := range resp.Data.Discounts {
-		discounts[i] = &model.Discount{
-			ID:          strconv.FormatInt(d.Id, 10),
-			Name:        d.Name,
-			Description: d.Description,
-			Type:        d.Type,
-			Amount:      d.Amount,
-			ValidUntil: func() *int32 {
-				if d.ValidUntil != nil {
-					v := int32(*d.ValidUntil)
-					return &v
-				}
-				return nil
-			}(),
-			IsActive:   d.IsActive,
-			ActionType: d.ActionType,
-			MaxRedemptions: func() *int32 {
-				if d.MaxRedemptions != nil {
-					v := int32(*d.MaxRedemptions)
-					return &v
-				}
-				return nil
-			}(),
-			MaxRedemptionsPerUser: func() *int32 {
-				if d.MaxRedemptionsPerUser != nil {
-					v := int32(*d.MaxRedemptionsPerUser)
-					return &v
-				}
-				return nil
-			}(),
-			Redemptions: int32(d.Redemptions),
-			IsRecurring: d.IsRecurring,
-			DurationInMonths: func() *int32 {
-				if d.DurationInMonths != nil {
-					v := int32(*d.DurationInMonths)
-					return &v
-				}
-				return nil
-			}(),
-			MaximumRecurringIntervals: func() *int32 {
-				if d.MaximumRecurringIntervals != nil {
-					v := int32(*d.MaximumRecurringIntervals)
-					return &v
-				}
-				return nil
-			}(),
-			RedeemType: func() *int32 {
-				if d.RedeemType != nil {
-					v := int32(*d.RedeemType)
-					return &v
-				}
-				return nil
-			}(),
-			BonusDays: func() *int32 {
-				if d.BonusDays != nil {
-					v := int32(*d.BonusDays)
-					return &v
-				}
-				return nil
-			}(),
-			IsEnabledForAllPlans:           d.IsEnabledForAllPlans,
-			IsEnabledForAllOneTimeProducts: d.IsEnabledForAllOneTimeProducts,
-			CreatedAt:                      int32(d.CreatedAt),
-			UpdatedAt:                      int32(d.UpdatedAt),
-		}
+	rates := make([]*model.ExchangeRate, len(resp.Data))
+	for i, rate := range resp.Data {
+		rates[i] = pbExchangeRateToModel(rate)
 	}
 
-	return &model.DiscountListResponse{
+	return &model.ExchangeRateListResponse{
 		Success: true,
 		Message: resp.Message,
-		Data: &model.DiscountList{
-			Discounts: discounts,
-			Total:     int32(resp.Data.Total),
-			Page:      resp.Data.Page,
-			PerPage:   resp.Data.PerPage,
+		Data: &model.ExchangeRateList{
+			ExchangeRates: rates,
+			Total:         resp.Total,
+			Page:          resp.Page,
+			PerPage:       resp.PerPage,
 		},
 	}, nil
 }
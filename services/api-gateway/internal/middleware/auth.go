@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	authPb "github.com/damarteplok/damar-admin-cms/shared/proto/auth"
+	"github.com/damarteplok/damar-admin-cms/shared/tenancy"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -78,12 +79,14 @@ func AuthMiddleware(authClient authPb.AuthServiceClient) func(http.Handler) http
 				return
 			}
 
-			// Valid token - add user to context
+			// Valid token - add user to context. Downstream services get the
+			// user ID as gRPC metadata and require an active tenant for it.
 			ctx := context.WithValue(r.Context(), UserContextKey, &authPb.UserData{
 				Id:      resp.UserId,
 				Email:   resp.Email,
 				IsAdmin: resp.IsAdmin,
 			})
+			ctx = tenancy.WithUserID(ctx, resp.UserId)
 			next.ServeHTTP(w, r.WithContext(ctx))
 		})
 	}
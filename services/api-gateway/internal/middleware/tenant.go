@@ -0,0 +1,283 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/damarteplok/damar-admin-cms/shared/logger"
+	tenantPb "github.com/damarteplok/damar-admin-cms/shared/proto/tenant"
+	"github.com/damarteplok/damar-admin-cms/shared/tenancy"
+	"go.uber.org/zap"
+)
+
+const (
+	HostTenantContextKey contextKey = "host_tenant"
+)
+
+// maxHostTenantEntries bounds the cache, since clients choose the Host header
+const maxHostTenantEntries = 10000
+
+// hostTenantEntry caches the tenant of a host; a nil tenant caches a miss
+type hostTenantEntry struct {
+	tenant    *tenantPb.Tenant
+	expiresAt time.Time
+}
+
+// HostTenantResolver finds the tenant whose verified custom domain is the
+// request host. Lookups, including misses, are cached for ttl.
+type HostTenantResolver struct {
+	tenantClient  tenantPb.TenantServiceClient
+	platformHosts map[string]bool
+	ttl           time.Duration
+
+	mu      sync.Mutex
+	entries map[string]hostTenantEntry
+}
+
+// NewHostTenantResolver creates the resolver. Requests to platformHosts, the
+// gateway's own hosts, are never looked up.
+func NewHostTenantResolver(tenantClient tenantPb.TenantServiceClient, platformHosts []string, ttl time.Duration) *HostTenantResolver {
+	hosts := make(map[string]bool, len(platformHosts))
+	for _, host := range platformHosts {
+		if host = normalizeHost(host); host != "" {
+			hosts[host] = true
+		}
+	}
+	return &HostTenantResolver{
+		tenantClient:  tenantClient,
+		platformHosts: hosts,
+		ttl:           ttl,
+		entries:       make(map[string]hostTenantEntry),
+	}
+}
+
+// Middleware adds the host's tenant to the context when there is one.
+// Unknown hosts are served without a tenant.
+func (h *HostTenantResolver) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if tenant := h.resolve(r.Context(), r.Host); tenant != nil {
+			ctx := context.WithValue(r.Context(), HostTenantContextKey, tenant)
+			r = r.WithContext(ctx)
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (h *HostTenantResolver) resolve(ctx context.Context, rawHost string) *tenantPb.Tenant {
+	host := normalizeHost(rawHost)
+	if host == "" || h.platformHosts[host] || net.ParseIP(host) != nil {
+		return nil
+	}
+
+	now := time.Now()
+	h.mu.Lock()
+	entry, ok := h.entries[host]
+	h.mu.Unlock()
+	if ok && now.Before(entry.expiresAt) {
+		return entry.tenant
+	}
+
+	resp, err := h.tenantClient.GetTenantByDomain(ctx, &tenantPb.GetTenantByDomainRequest{
+		Domain: host,
+	})
+	if err != nil {
+		// Do not cache transport errors
+		logger.Warn("Failed to resolve tenant by host", zap.String("host", host), zap.Error(err))
+		return nil
+	}
+
+	var tenant *tenantPb.Tenant
+	if resp.Success {
+		tenant = resp.Data
+	}
+
+	h.mu.Lock()
+	if len(h.entries) >= maxHostTenantEntries {
+		h.entries = make(map[string]hostTenantEntry)
+	}
+	h.entries[host] = hostTenantEntry{tenant: tenant, expiresAt: now.Add(h.ttl)}
+	h.mu.Unlock()
+
+	return tenant
+}
+
+// GetHostTenantFromContext returns the tenant resolved from the request
+// host, or nil
+func GetHostTenantFromContext(ctx context.Context) *tenantPb.Tenant {
+	tenant, _ := ctx.Value(HostTenantContextKey).(*tenantPb.Tenant)
+	return tenant
+}
+
+// maxMembershipEntries bounds the membership cache
+const maxMembershipEntries = 10000
+
+// membershipEntry caches the tenants a user belongs to
+type membershipEntry struct {
+	memberships []*tenantPb.TenantUser
+	expiresAt   time.Time
+}
+
+// ActiveTenantResolver picks the signed-in user's active tenant. Memberships
+// are cached per user for ttl, so a request does not cost a tenant-service
+// call; a header naming a tenant missing from the cached list is checked
+// against a fresh one before it is refused. TenantEventSubscriber evicts
+// cached memberships that a removal or a deleted tenant ends early.
+type ActiveTenantResolver struct {
+	tenantClient tenantPb.TenantServiceClient
+	ttl          time.Duration
+
+	mu      sync.Mutex
+	entries map[int64]membershipEntry
+}
+
+func NewActiveTenantResolver(tenantClient tenantPb.TenantServiceClient, ttl time.Duration) *ActiveTenantResolver {
+	return &ActiveTenantResolver{
+		tenantClient: tenantClient,
+		ttl:          ttl,
+		entries:      make(map[int64]membershipEntry),
+	}
+}
+
+// Middleware adds the active tenant to the context with
+// tenancy.WithTenantID. The X-Tenant-ID header wins, then the host's tenant,
+// then the user's default tenant. Users must belong to the tenant; platform
+// admins may pick any tenant with the header. It runs after AuthMiddleware.
+func (a *ActiveTenantResolver) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, _ := GetOptionalUserFromContext(r.Context())
+		header := strings.TrimSpace(r.Header.Get(tenancy.HeaderName))
+
+		if user == nil {
+			if header != "" {
+				writeGraphQLError(w, "Unauthorized: login required to select a tenant", http.StatusUnauthorized)
+				return
+			}
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		var requested int64
+		if header != "" {
+			id, err := strconv.ParseInt(header, 10, 64)
+			if err != nil || id <= 0 {
+				writeGraphQLError(w, "Invalid "+tenancy.HeaderName+" header", http.StatusBadRequest)
+				return
+			}
+			if user.IsAdmin {
+				ctx := tenancy.WithTenantID(r.Context(), id)
+				next.ServeHTTP(w, r.WithContext(ctx))
+				return
+			}
+			requested = id
+		} else if hostTenant := GetHostTenantFromContext(r.Context()); hostTenant != nil {
+			requested = hostTenant.Id
+		}
+
+		memberships, err := a.memberships(r.Context(), user.Id, false)
+		if err == nil && header != "" && activeTenant(memberships, requested) == 0 {
+			// The user may have joined the tenant since the list was cached
+			memberships, err = a.memberships(r.Context(), user.Id, true)
+		}
+		if err != nil {
+			logger.Warn("Failed to load user tenants", zap.Int64("user_id", user.Id), zap.Error(err))
+			if header != "" {
+				writeGraphQLError(w, "Failed to verify tenant membership", http.StatusInternalServerError)
+				return
+			}
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		active := activeTenant(memberships, requested)
+		if header != "" && active == 0 {
+			writeGraphQLError(w, "Forbidden: not a member of this tenant", http.StatusForbidden)
+			return
+		}
+
+		ctx := r.Context()
+		if active > 0 {
+			ctx = tenancy.WithTenantID(ctx, active)
+		}
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// memberships returns the user's tenants, from the cache unless refresh is set
+func (a *ActiveTenantResolver) memberships(ctx context.Context, userID int64, refresh bool) ([]*tenantPb.TenantUser, error) {
+	now := time.Now()
+	if !refresh {
+		a.mu.Lock()
+		entry, ok := a.entries[userID]
+		a.mu.Unlock()
+		if ok && now.Before(entry.expiresAt) {
+			return entry.memberships, nil
+		}
+	}
+
+	resp, err := a.tenantClient.GetUserTenants(ctx, &tenantPb.GetUserTenantsRequest{
+		UserId: userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !resp.Success {
+		return nil, errors.New(resp.Message)
+	}
+
+	a.mu.Lock()
+	if len(a.entries) >= maxMembershipEntries {
+		a.entries = make(map[int64]membershipEntry)
+	}
+	a.entries[userID] = membershipEntry{memberships: resp.Data, expiresAt: now.Add(a.ttl)}
+	a.mu.Unlock()
+
+	return resp.Data, nil
+}
+
+// EvictUser drops the cached memberships of userID
+func (a *ActiveTenantResolver) EvictUser(userID int64) {
+	a.mu.Lock()
+	delete(a.entries, userID)
+	a.mu.Unlock()
+}
+
+// EvictTenant drops the cached memberships of every user who belongs to
+// tenantID
+func (a *ActiveTenantResolver) EvictTenant(tenantID int64) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for userID, entry := range a.entries {
+		for _, membership := range entry.memberships {
+			if membership.TenantId == tenantID {
+				delete(a.entries, userID)
+				break
+			}
+		}
+	}
+}
+
+// activeTenant returns the requested tenant, or the default one when none
+// was requested, if the user belongs to it
+func activeTenant(memberships []*tenantPb.TenantUser, requested int64) int64 {
+	for _, membership := range memberships {
+		if (requested > 0 && membership.TenantId == requested) || (requested == 0 && membership.IsDefault) {
+			return membership.TenantId
+		}
+	}
+	return 0
+}
+
+// normalizeHost lowercases a host and strips its port and trailing dot
+func normalizeHost(host string) string {
+	host = strings.ToLower(strings.TrimSpace(host))
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	return strings.TrimSuffix(host, ".")
+}
@@ -0,0 +1,76 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/damarteplok/damar-admin-cms/shared/amqp"
+	"github.com/damarteplok/damar-admin-cms/shared/contracts"
+	"github.com/damarteplok/damar-admin-cms/shared/logger"
+	"go.uber.org/zap"
+)
+
+// TenantEventSubscriber evicts the memberships ActiveTenantResolver has
+// cached when tenant-service removes a member or deletes a tenant, so the
+// user loses the tenant right away rather than when the cache expires. Each
+// gateway instance consumes on a queue of its own and evicts its own cache.
+type TenantEventSubscriber struct {
+	resolver *ActiveTenantResolver
+	amqpConn *amqp.Connection
+}
+
+func NewTenantEventSubscriber(resolver *ActiveTenantResolver, amqpConn *amqp.Connection) *TenantEventSubscriber {
+	return &TenantEventSubscriber{
+		resolver: resolver,
+		amqpConn: amqpConn,
+	}
+}
+
+// Start begins listening to tenant events
+func (s *TenantEventSubscriber) Start(ctx context.Context) error {
+	consumer, err := amqp.NewBroadcastConsumer(
+		s.amqpConn,
+		"damar.events",
+		contracts.TenantEventMemberRemoved,
+		contracts.TenantEventDeleted,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create tenant event consumer: %w", err)
+	}
+
+	go func() {
+		if err := consumer.Consume(s.handle); err != nil {
+			logger.Error("Failed to consume tenant events", zap.Error(err))
+		}
+	}()
+
+	logger.Info("Tenant event subscriber started for membership cache eviction")
+	return nil
+}
+
+// handle evicts the user of a member_removed event, or every member of the
+// tenant of a deleted event. Both carry tenant_id; only the former has user_id.
+func (s *TenantEventSubscriber) handle(body []byte) error {
+	var msg contracts.AmqpMessage
+	if err := json.Unmarshal(body, &msg); err != nil {
+		logger.Warn("Failed to unmarshal AMQP message", zap.Error(err))
+		return nil
+	}
+
+	var data struct {
+		TenantID int64 `json:"tenant_id"`
+		UserID   int64 `json:"user_id"`
+	}
+	if err := json.Unmarshal(msg.Data, &data); err != nil {
+		logger.Warn("Failed to parse tenant event data", zap.Error(err))
+		return nil
+	}
+
+	if data.UserID > 0 {
+		s.resolver.EvictUser(data.UserID)
+	} else if data.TenantID > 0 {
+		s.resolver.EvictTenant(data.TenantID)
+	}
+	return nil
+}
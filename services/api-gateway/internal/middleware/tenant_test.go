@@ -0,0 +1,88 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/damarteplok/damar-admin-cms/shared/contracts"
+	authPb "github.com/damarteplok/damar-admin-cms/shared/proto/auth"
+	tenantPb "github.com/damarteplok/damar-admin-cms/shared/proto/tenant"
+	"github.com/damarteplok/damar-admin-cms/shared/tenancy"
+	"google.golang.org/grpc"
+)
+
+// fakeUserTenants answers GetUserTenants from the memberships it holds
+type fakeUserTenants struct {
+	tenantPb.TenantServiceClient
+	memberships map[int64][]*tenantPb.TenantUser
+}
+
+func (f *fakeUserTenants) GetUserTenants(ctx context.Context, in *tenantPb.GetUserTenantsRequest, opts ...grpc.CallOption) (*tenantPb.GetUserTenantsResponse, error) {
+	return &tenantPb.GetUserTenantsResponse{Success: true, Data: f.memberships[in.UserId]}, nil
+}
+
+// requestTenant sends a request for tenantID as userID and returns the status
+func requestTenant(handler http.Handler, userID, tenantID int64) int {
+	r := httptest.NewRequest(http.MethodPost, "/graphql", nil)
+	r.Header.Set(tenancy.HeaderName, strconv.FormatInt(tenantID, 10))
+	r = r.WithContext(context.WithValue(r.Context(), UserContextKey, &authPb.UserData{Id: userID}))
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	return w.Code
+}
+
+func tenantEvent(t *testing.T, data map[string]int64) []byte {
+	t.Helper()
+	dataBytes, err := json.Marshal(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, err := json.Marshal(contracts.AmqpMessage{OwnerID: "tenant-uuid", Data: dataBytes})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return body
+}
+
+func TestTenantEventsEvictMemberships(t *testing.T) {
+	tests := []struct {
+		name  string
+		event map[string]int64
+	}{
+		{"member removed", map[string]int64{"tenant_id": 5, "user_id": 1}},
+		{"tenant deleted", map[string]int64{"tenant_id": 5}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &fakeUserTenants{memberships: map[int64][]*tenantPb.TenantUser{
+				1: {{UserId: 1, TenantId: 5}},
+			}}
+			resolver := NewActiveTenantResolver(client, time.Hour)
+			subscriber := NewTenantEventSubscriber(resolver, nil)
+			handler := resolver.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+			if code := requestTenant(handler, 1, 5); code != http.StatusOK {
+				t.Fatalf("member request = %d, want %d", code, http.StatusOK)
+			}
+
+			// The membership ends; the cached list still has it until evicted
+			client.memberships[1] = nil
+			if code := requestTenant(handler, 1, 5); code != http.StatusOK {
+				t.Fatalf("cached request = %d, want %d", code, http.StatusOK)
+			}
+
+			if err := subscriber.handle(tenantEvent(t, tt.event)); err != nil {
+				t.Fatalf("handle() error = %v", err)
+			}
+			if code := requestTenant(handler, 1, 5); code != http.StatusForbidden {
+				t.Errorf("request after %s = %d, want %d", tt.name, code, http.StatusForbidden)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// Dunning run statuses
+const (
+	DunningStatusActive    = "active"    // retries are scheduled
+	DunningStatusRecovered = "recovered" // the invoice got paid
+	DunningStatusExhausted = "exhausted" // every retry failed and the subscription was cancelled
+	DunningStatusClosed    = "closed"    // the invoice was voided while dunning
+)
+
+// TenantSettingDunningRetryDays is the tenant_settings key holding a tenant's
+// retry schedule as a JSON array of days after the first failure, e.g. [1, 3, 7]
+const TenantSettingDunningRetryDays = "dunning_retry_days"
+
+// DunningRun tracks the retries of one unpaid invoice. RetryDays is copied
+// from the tenant's schedule when the run starts, so changing the setting
+// only affects later runs.
+type DunningRun struct {
+	ID                 int64
+	InvoiceID          int64
+	SubscriptionID     *int64
+	TenantID           int64 // 0 once the tenant has been purged
+	Status             string
+	RetryDays          []int
+	AttemptCount       int32 // retries made so far
+	NextAttemptAt      *time.Time
+	LastAttemptAt      *time.Time
+	LastFailureMessage *string
+	StartedAt          time.Time
+	ClosedAt           *time.Time
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
+
+type DunningRepository interface {
+	// Create inserts an active run. It returns false when the invoice
+	// already has one.
+	Create(ctx context.Context, run *DunningRun) (bool, error)
+	// GetActiveByInvoice returns the invoice's active run, or nil
+	GetActiveByInvoice(ctx context.Context, invoiceID int64) (*DunningRun, error)
+	// ClaimDue returns active runs whose next attempt is due and pushes their
+	// next_attempt_at out by lease, so other replicas skip them meanwhile.
+	// Runs of soft-deleted tenants are skipped.
+	ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*DunningRun, error)
+	Update(ctx context.Context, run *DunningRun) error
+	// CloseByTenant closes the tenant's active runs and returns how many
+	CloseByTenant(ctx context.Context, tenantID int64) (int64, error)
+}
+
+// TenantSettingRepository reads tenant_settings, owned by tenant-service
+type TenantSettingRepository interface {
+	// GetValue returns the raw JSON value, or nil when the key is not set
+	GetValue(ctx context.Context, tenantID int64, key string) (json.RawMessage, error)
+}
+
+// BillingContact is who dunning emails go to
+type BillingContact struct {
+	UserID int64
+	Name   string
+	Email  string
+}
+
+// UserRepository reads users, owned by user-service
+type UserRepository interface {
+	GetContact(ctx context.Context, id int64) (*BillingContact, error)
+}
+
+// SubscriptionLifecycle changes subscription status through
+// subscription-service, so its transition rules, audit trail and events apply
+type SubscriptionLifecycle interface {
+	MarkPastDue(ctx context.Context, id int64) error
+	Reactivate(ctx context.Context, id int64) error
+	Cancel(ctx context.Context, id int64, reason string) error
+}
+
+type DunningService interface {
+	// Start begins dunning an open subscription invoice whose payment
+	// failed. It is a no-op when the invoice is already being dunned.
+	Start(ctx context.Context, invoiceID int64, failureMessage string) (*DunningRun, error)
+	// Resolve ends the invoice's active run once the invoice is paid or voided
+	Resolve(ctx context.Context, invoiceID int64) error
+	// ProcessDue retries every due run and returns how many were processed
+	ProcessDue(ctx context.Context, now time.Time) (int, error)
+	// ReleaseTenant closes the active runs of a tenant that is being purged.
+	// Running it again is harmless.
+	ReleaseTenant(ctx context.Context, tenantID int64) error
+}
@@ -0,0 +1,115 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/damarteplok/damar-admin-cms/shared/payment"
+)
+
+const (
+	InvoiceStatusDraft = "draft"
+	InvoiceStatusOpen  = "open"
+	InvoiceStatusPaid  = "paid"
+	InvoiceStatusVoid  = "void"
+)
+
+const (
+	LineItemTypeSubscription = "subscription"
+	LineItemTypeUsage        = "usage"
+	LineItemTypeDiscount     = "discount"
+	LineItemTypeProration    = "proration"
+)
+
+type Invoice struct {
+	ID             int64
+	UUID           string
+	TenantID       int64  // 0 once the tenant has been purged
+	SubscriptionID *int64 // nullable
+	UserID         *int64 // nullable
+	CurrencyID     int64
+	Number         *string // nullable until finalized
+	Sequence       *int64  // nullable until finalized
+	Status         string  // see invoice_state.go for allowed transitions
+	PeriodStart    *time.Time
+	PeriodEnd      *time.Time
+	Subtotal       int64
+	DiscountTotal  int64
+	Total          int64
+	DueAt          *time.Time
+	FinalizedAt    *time.Time
+	PaidAt         *time.Time
+	VoidedAt       *time.Time
+	VoidReason     *string
+	// PaymentProviderID and PaymentProviderChargeID point at the last charge
+	// attempted for the invoice
+	PaymentProviderID       *int64
+	PaymentProviderChargeID *string
+	Metadata                json.RawMessage // nullable JSON
+	LineItems               []*InvoiceLineItem
+	CreatedAt               time.Time
+	UpdatedAt               time.Time
+}
+
+type InvoiceLineItem struct {
+	ID                     int64
+	InvoiceID              int64
+	Type                   string // subscription, usage, discount, proration
+	Description            string
+	Quantity               int64
+	UnitAmount             int64 // zero when the line is not priced per unit
+	Amount                 int64 // negative for discounts and proration credits
+	SubscriptionDiscountID *int64
+	CreatedAt              time.Time
+	UpdatedAt              time.Time
+}
+
+type InvoiceRepository interface {
+	GetByID(ctx context.Context, id int64) (*Invoice, error)
+	GetByNumber(ctx context.Context, tenantID int64, number string) (*Invoice, error)
+	// GetBySubscriptionPeriod returns the non-void invoice for a period, or nil
+	GetBySubscriptionPeriod(ctx context.Context, subscriptionID int64, periodStart time.Time) (*Invoice, error)
+	// GetByPaymentCharge returns the invoice a provider charge pays, or nil
+	GetByPaymentCharge(ctx context.Context, providerID int64, chargeID string) (*Invoice, error)
+	GetByTenant(ctx context.Context, tenantID int64, status string, page, perPage int) ([]*Invoice, int, error)
+	GetBySubscription(ctx context.Context, subscriptionID int64, page, perPage int) ([]*Invoice, int, error)
+	// Create inserts the invoice together with its line items
+	Create(ctx context.Context, invoice *Invoice) error
+	// Finalize takes the tenant's next invoice sequence, formats it with
+	// formatNumber and stores it with the open status in one transaction
+	Finalize(ctx context.Context, invoice *Invoice, formatNumber func(sequence int64) string) error
+	// UpdateStatus saves the fields that may change after finalization:
+	// status, paid/void details and the payment charge
+	UpdateStatus(ctx context.Context, invoice *Invoice) error
+}
+
+type InvoiceService interface {
+	// GenerateForSubscription builds a draft invoice for one subscription
+	// period. A nil period means the last completed one.
+	GenerateForSubscription(ctx context.Context, subscriptionID int64, periodStart, periodEnd *time.Time) (*Invoice, error)
+	// GenerateForEndedPeriod builds a draft invoice for a period closed by a
+	// renewal, priced on the terms it ran on
+	GenerateForEndedPeriod(ctx context.Context, subscriptionID int64, period *EndedPeriod) (*Invoice, error)
+	// GenerateForPlanChange builds a draft invoice for an immediate plan
+	// change, or returns nil when the change is not billed
+	GenerateForPlanChange(ctx context.Context, subscriptionID int64, change *PlanChange) (*Invoice, error)
+	GetByID(ctx context.Context, id int64) (*Invoice, error)
+	GetByNumber(ctx context.Context, tenantID int64, number string) (*Invoice, error)
+	GetByTenant(ctx context.Context, tenantID int64, status string, page, perPage int) ([]*Invoice, int, error)
+	GetBySubscription(ctx context.Context, subscriptionID int64, page, perPage int) ([]*Invoice, int, error)
+	// GetSubscription returns the subscription invoices are billed for
+	GetSubscription(ctx context.Context, subscriptionID int64) (*BillableSubscription, error)
+	Finalize(ctx context.Context, id int64) (*Invoice, error)
+	MarkPaid(ctx context.Context, id int64) (*Invoice, error)
+	Void(ctx context.Context, id int64, reason string) (*Invoice, error)
+	// Pay charges an open invoice through a payment provider. An empty
+	// providerSlug uses the subscription's provider, falling back to manual.
+	Pay(ctx context.Context, id int64, providerSlug, paymentMethodID string) (*Invoice, *payment.Charge, error)
+	// Retry charges an open invoice again for a dunning attempt, with an
+	// idempotency key of its own
+	Retry(ctx context.Context, id int64, attempt int32) (*Invoice, *payment.Charge, error)
+	// Collect finalizes a draft invoice if needed and charges it through
+	// the subscription's provider
+	Collect(ctx context.Context, id int64) (*Invoice, *payment.Charge, error)
+}
@@ -0,0 +1,31 @@
+package domain
+
+import "fmt"
+
+// invoiceTransitions lists the statuses an invoice may move to from each
+// status. Paid and void invoices are final.
+var invoiceTransitions = map[string][]string{
+	InvoiceStatusDraft: {InvoiceStatusOpen, InvoiceStatusVoid},
+	InvoiceStatusOpen:  {InvoiceStatusPaid, InvoiceStatusVoid},
+	InvoiceStatusPaid:  {},
+	InvoiceStatusVoid:  {},
+}
+
+func IsValidInvoiceStatus(status string) bool {
+	_, ok := invoiceTransitions[status]
+	return ok
+}
+
+// ValidateInvoiceTransition returns an error when an invoice in status from
+// may not move to status to
+func ValidateInvoiceTransition(from, to string) error {
+	if !IsValidInvoiceStatus(to) {
+		return fmt.Errorf("invalid invoice status: %s", to)
+	}
+	for _, allowed := range invoiceTransitions[from] {
+		if allowed == to {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid invoice status transition from %s to %s", from, to)
+}
@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// PaymentProvider is a payment_providers row. The implementation for a slug
+// comes from the payment.Registry.
+type PaymentProvider struct {
+	ID        int64
+	Name      string
+	Slug      string
+	IsActive  bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type Currency struct {
+	ID     int64
+	Code   string // ISO 4217
+	Name   string
+	Symbol string
+}
+
+type PaymentProviderRepository interface {
+	GetByID(ctx context.Context, id int64) (*PaymentProvider, error)
+	GetBySlug(ctx context.Context, slug string) (*PaymentProvider, error)
+}
+
+type CurrencyRepository interface {
+	GetByID(ctx context.Context, id int64) (*Currency, error)
+}
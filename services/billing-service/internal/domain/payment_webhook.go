@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+)
+
+// Payment webhook event statuses
+const (
+	WebhookEventStatusReceived   = "received"
+	WebhookEventStatusProcessing = "processing"
+	WebhookEventStatusProcessed  = "processed"
+	WebhookEventStatusFailed     = "failed"
+)
+
+var (
+	ErrInvalidWebhookPayload = errors.New("invalid webhook payload")
+	// ErrWebhookInProgress means another delivery of the event holds the
+	// processing lease; the provider should retry later
+	ErrWebhookInProgress = errors.New("webhook event is being processed by another delivery")
+)
+
+// PaymentWebhookEvent is a raw provider webhook as it was delivered, kept for
+// deduplication and auditing
+type PaymentWebhookEvent struct {
+	ID                int64
+	PaymentProviderID int64
+	EventID           string
+	Type              string // provider event type
+	Status            string // received, processing, processed, failed
+	Payload           json.RawMessage
+	Error             *string
+	Attempts          int32 // deliveries received for this event
+	ProcessedAt       *time.Time
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+type PaymentWebhookEventRepository interface {
+	// Record stores a delivery. A redelivered event keeps its row and
+	// status and only bumps Attempts.
+	Record(ctx context.Context, event *PaymentWebhookEvent) error
+	// Claim marks an event as processing. It returns false when the event is
+	// already processed or another delivery is processing it.
+	Claim(ctx context.Context, id int64) (bool, error)
+	UpdateStatus(ctx context.Context, event *PaymentWebhookEvent) error
+}
+
+type WebhookService interface {
+	// HandleWebhook verifies, records and applies a provider webhook.
+	// Redeliveries of a processed event are acknowledged without side effects;
+	// while another delivery is processing it, ErrWebhookInProgress is returned.
+	HandleWebhook(ctx context.Context, providerSlug string, payload []byte, header http.Header) (*PaymentWebhookEvent, error)
+}
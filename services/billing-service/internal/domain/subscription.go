@@ -0,0 +1,96 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// BillableSubscription is the read-only view of a subscription that invoice
+// generation needs, joined with its plan and interval
+type BillableSubscription struct {
+	ID                int64
+	TenantID          int64
+	UserID            int64
+	PlanID            int64
+	PlanName          string
+	MeterID           *int64 // set for metered plans
+	CurrencyID        int64
+	Price             int32
+	PriceType         string // flat_rate, per_unit, tiered
+	PricePerUnit      *string
+	PriceTiers        json.RawMessage
+	Quantity          int32
+	Status            string
+	PaymentProviderID *int64
+	TrialEndsAt       *time.Time
+	EndsAt            *time.Time
+	BillingAnchor     *time.Time // period ends are counted from it; nil on old rows
+	IntervalSlug      string
+	IntervalCount     int32
+}
+
+// BillablePlan is the plan a period is billed for
+type BillablePlan struct {
+	ID      int64
+	Name    string
+	MeterID *int64
+}
+
+// EndedPeriod is a period closed by a renewal and the terms it ran on, as
+// carried by subscription.event.renewed. A scheduled plan change has already
+// replaced the terms on the subscription when the event is consumed.
+type EndedPeriod struct {
+	Start        time.Time
+	End          time.Time
+	PlanID       int64
+	Quantity     int32
+	Price        int32
+	PriceType    string
+	PricePerUnit *string
+	PriceTiers   json.RawMessage
+}
+
+// PlanChange is an immediate plan change and its proration, as carried by
+// subscription.event.plan_changed. Amounts are in the currency's minor unit.
+type PlanChange struct {
+	PreviousPlanID int64
+	PlanID         int64
+	EffectiveAt    time.Time
+	// PreviousPeriodStart is the start of the period the change interrupted;
+	// nil when no paid period was running
+	PreviousPeriodStart *time.Time
+	// PeriodStart and PeriodEnd bound the subscription's period after the change
+	PeriodStart  time.Time
+	PeriodEnd    time.Time
+	UsedAmount   int64 // old plan, from PreviousPeriodStart to EffectiveAt
+	CreditAmount int64 // old plan, from EffectiveAt to the old period end
+	ChargeAmount int64 // new plan, from EffectiveAt to PeriodEnd
+}
+
+// BillableDiscount is a subscription_discounts row that applies to an invoice
+type BillableDiscount struct {
+	ID          int64
+	DiscountID  int64
+	Name        string
+	Type        string // percentage, fixed
+	Amount      float64
+	IsRecurring bool
+}
+
+// SubscriptionRepository reads the subscription tables owned by
+// subscription-service. The only column billing writes is
+// payment_provider_status, which follows provider webhooks.
+type SubscriptionRepository interface {
+	GetByID(ctx context.Context, id int64) (*BillableSubscription, error)
+	// GetByProviderSubscriptionID returns nil when no subscription matches
+	GetByProviderSubscriptionID(ctx context.Context, providerID int64, providerSubscriptionID string) (*BillableSubscription, error)
+	UpdatePaymentProviderStatus(ctx context.Context, id int64, status string) error
+	GetPlan(ctx context.Context, id int64) (*BillablePlan, error)
+	// UsageQuantity aggregates the subscription_usages of a meter recorded in
+	// [from, to) with the meter's aggregation (sum, max or last)
+	UsageQuantity(ctx context.Context, subscriptionID, meterID int64, from, to time.Time) (int64, error)
+	// GetBillableDiscounts returns recurring discounts still valid at
+	// periodStart and one-off discounts not yet used on a live invoice
+	GetBillableDiscounts(ctx context.Context, subscriptionID int64, periodStart time.Time) ([]*BillableDiscount, error)
+}
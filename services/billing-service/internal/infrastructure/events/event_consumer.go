@@ -0,0 +1,502 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/damarteplok/damar-admin-cms/services/billing-service/internal/domain"
+	"github.com/damarteplok/damar-admin-cms/shared/amqp"
+	"github.com/damarteplok/damar-admin-cms/shared/contracts"
+	"github.com/damarteplok/damar-admin-cms/shared/logger"
+	"go.uber.org/zap"
+)
+
+type EventConsumer struct {
+	conn           *amqp.Connection
+	invoiceService domain.InvoiceService
+	dunningService domain.DunningService
+	autoCollect    bool
+}
+
+// NewEventConsumer creates the billing consumers. With autoCollect, invoices
+// generated for ended periods are finalized and charged right away.
+func NewEventConsumer(conn *amqp.Connection, invoiceService domain.InvoiceService, dunningService domain.DunningService, autoCollect bool) *EventConsumer {
+	return &EventConsumer{
+		conn:           conn,
+		invoiceService: invoiceService,
+		dunningService: dunningService,
+		autoCollect:    autoCollect,
+	}
+}
+
+// ConsumeSubscriptionRenewed invoices the period that just ended whenever a
+// subscription rolls over into a new one
+func (ec *EventConsumer) ConsumeSubscriptionRenewed(ctx context.Context) error {
+	consumer, err := amqp.NewConsumer(
+		ec.conn,
+		"billing.subscription.renewed",
+		"damar.events",
+		contracts.SubscriptionEventRenewed,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create consumer: %w", err)
+	}
+
+	logger.Info("Started consuming subscription.renewed events")
+
+	return consumer.Consume(func(body []byte) error {
+		return ec.invoiceEndedPeriod(ctx, body, contracts.SubscriptionEventRenewed)
+	})
+}
+
+// ConsumeSubscriptionExpired invoices the final period of a subscription that
+// ran until the end of its cycle. Subscriptions cancelled immediately are not
+// billed for the period they left.
+func (ec *EventConsumer) ConsumeSubscriptionExpired(ctx context.Context) error {
+	consumer, err := amqp.NewConsumer(
+		ec.conn,
+		"billing.subscription.expired",
+		"damar.events",
+		contracts.SubscriptionEventExpired,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create consumer: %w", err)
+	}
+
+	logger.Info("Started consuming subscription.expired events")
+
+	return consumer.Consume(func(body []byte) error {
+		return ec.invoiceEndedPeriod(ctx, body, contracts.SubscriptionEventExpired)
+	})
+}
+
+func (ec *EventConsumer) invoiceEndedPeriod(ctx context.Context, body []byte, routingKey string) error {
+	var message contracts.AmqpMessage
+	if err := json.Unmarshal(body, &message); err != nil {
+		logger.Error("Failed to unmarshal subscription message",
+			zap.String("routing_key", routingKey),
+			zap.Error(err))
+		return err
+	}
+
+	var eventData map[string]interface{}
+	if err := json.Unmarshal(message.Data, &eventData); err != nil {
+		logger.Error("Failed to unmarshal event data", zap.Error(err))
+		return err
+	}
+
+	subscriptionID, err := strconv.ParseInt(message.OwnerID, 10, 64)
+	if err != nil {
+		logger.Error("Invalid subscription ID in event",
+			zap.String("owner_id", message.OwnerID),
+			zap.Error(err))
+		return nil
+	}
+
+	previousStatus, _ := eventData["previous_status"].(string)
+	if routingKey == contracts.SubscriptionEventExpired && previousStatus == "cancelled" {
+		return nil
+	}
+
+	logger.Info("Generating invoice for ended subscription period",
+		zap.String("routing_key", routingKey),
+		zap.Int64("subscription_id", subscriptionID))
+
+	// Renewals carry the ended period and its terms; older messages and
+	// expiries fall back to the last completed period on the current terms
+	period, err := endedPeriod(message.Data)
+	if err != nil {
+		logger.Error("Invalid billed period in event",
+			zap.Int64("subscription_id", subscriptionID),
+			zap.Error(err))
+		return nil
+	}
+
+	var invoice *domain.Invoice
+	if period != nil {
+		invoice, err = ec.invoiceService.GenerateForEndedPeriod(ctx, subscriptionID, period)
+	} else {
+		invoice, err = ec.invoiceService.GenerateForSubscription(ctx, subscriptionID, nil, nil)
+	}
+	if err != nil {
+		// Requeueing would fail the same way (trial period, missing price),
+		// so the message is acknowledged and the invoice can be generated
+		// through the API once the cause is fixed
+		logger.Error("Failed to generate invoice",
+			zap.Int64("subscription_id", subscriptionID),
+			zap.Error(err))
+		return nil
+	}
+
+	logger.Info("Invoice generated",
+		zap.Int64("subscription_id", subscriptionID),
+		zap.Int64("invoice_id", invoice.ID),
+		zap.Int64("total", invoice.Total))
+
+	ec.collect(ctx, invoice)
+	return nil
+}
+
+// ConsumeSubscriptionPlanChanged invoices the proration of plan changes that
+// apply immediately
+func (ec *EventConsumer) ConsumeSubscriptionPlanChanged(ctx context.Context) error {
+	consumer, err := amqp.NewConsumer(
+		ec.conn,
+		"billing.subscription.plan_changed",
+		"damar.events",
+		contracts.SubscriptionEventPlanChanged,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create consumer: %w", err)
+	}
+
+	logger.Info("Started consuming subscription.plan_changed events")
+
+	return consumer.Consume(func(body []byte) error {
+		var message contracts.AmqpMessage
+		if err := json.Unmarshal(body, &message); err != nil {
+			logger.Error("Failed to unmarshal subscription.plan_changed message", zap.Error(err))
+			return err
+		}
+
+		subscriptionID, err := strconv.ParseInt(message.OwnerID, 10, 64)
+		if err != nil {
+			logger.Error("Invalid subscription ID in event",
+				zap.String("owner_id", message.OwnerID),
+				zap.Error(err))
+			return nil
+		}
+
+		// Scheduled changes carry no proration; the renewal that applies
+		// them bills the period that ended
+		change, err := planChange(message.Data)
+		if err != nil {
+			logger.Error("Invalid proration in event",
+				zap.Int64("subscription_id", subscriptionID),
+				zap.Error(err))
+			return nil
+		}
+		if change == nil {
+			return nil
+		}
+
+		invoice, err := ec.invoiceService.GenerateForPlanChange(ctx, subscriptionID, change)
+		if err != nil {
+			logger.Error("Failed to generate plan change invoice",
+				zap.Int64("subscription_id", subscriptionID),
+				zap.Error(err))
+			return nil
+		}
+		if invoice == nil {
+			return nil
+		}
+
+		logger.Info("Plan change invoice generated",
+			zap.Int64("subscription_id", subscriptionID),
+			zap.Int64("invoice_id", invoice.ID),
+			zap.Int64("total", invoice.Total))
+
+		ec.collect(ctx, invoice)
+		return nil
+	})
+}
+
+// planChange reads the proration of an immediate plan change, or returns nil
+// when the event has none
+func planChange(data []byte) (*domain.PlanChange, error) {
+	var payload struct {
+		PlanID         int64 `json:"plan_id"`
+		PreviousPlanID int64 `json:"previous_plan_id"`
+		Proration      *struct {
+			Mode                string `json:"mode"`
+			EffectiveAt         int64  `json:"effective_at"`
+			PreviousPeriodStart int64  `json:"previous_period_start"`
+			PeriodStart         int64  `json:"period_start"`
+			PeriodEnd           int64  `json:"period_end"`
+			UsedAmount          int64  `json:"used_amount"`
+			CreditAmount        int64  `json:"credit_amount"`
+			ChargeAmount        int64  `json:"charge_amount"`
+		} `json:"proration"`
+	}
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return nil, err
+	}
+
+	proration := payload.Proration
+	if proration == nil || proration.Mode != "now" {
+		return nil, nil
+	}
+	if proration.UsedAmount == 0 && proration.CreditAmount == 0 && proration.ChargeAmount == 0 {
+		return nil, nil
+	}
+	if payload.PlanID <= 0 || payload.PreviousPlanID <= 0 || proration.PeriodStart <= 0 || proration.PeriodEnd <= 0 {
+		return nil, fmt.Errorf("incomplete proration")
+	}
+
+	change := &domain.PlanChange{
+		PreviousPlanID: payload.PreviousPlanID,
+		PlanID:         payload.PlanID,
+		EffectiveAt:    time.Unix(proration.EffectiveAt, 0).UTC(),
+		PeriodStart:    time.Unix(proration.PeriodStart, 0).UTC(),
+		PeriodEnd:      time.Unix(proration.PeriodEnd, 0).UTC(),
+		UsedAmount:     proration.UsedAmount,
+		CreditAmount:   proration.CreditAmount,
+		ChargeAmount:   proration.ChargeAmount,
+	}
+	if proration.PreviousPeriodStart > 0 {
+		previousPeriodStart := time.Unix(proration.PreviousPeriodStart, 0).UTC()
+		change.PreviousPeriodStart = &previousPeriodStart
+	}
+	return change, nil
+}
+
+// collect finalizes and charges a generated invoice when auto-collection is
+// on. Invoices with a negative total are credits and stay drafts.
+func (ec *EventConsumer) collect(ctx context.Context, invoice *domain.Invoice) {
+	if !ec.autoCollect || invoice.Status == domain.InvoiceStatusPaid || invoice.Status == domain.InvoiceStatusVoid {
+		return
+	}
+	if invoice.Total < 0 {
+		logger.Info("Leaving credit invoice as a draft",
+			zap.Int64("invoice_id", invoice.ID),
+			zap.Int64("total", invoice.Total))
+		return
+	}
+
+	// A failed charge publishes invoice.event.payment_failed, which starts
+	// dunning
+	_, charge, err := ec.invoiceService.Collect(ctx, invoice.ID)
+	if err != nil {
+		logger.Warn("Failed to collect invoice",
+			zap.Int64("invoice_id", invoice.ID),
+			zap.Error(err))
+		return
+	}
+	if charge != nil {
+		logger.Info("Invoice collected",
+			zap.Int64("invoice_id", invoice.ID),
+			zap.String("charge_status", charge.Status))
+	}
+}
+
+// endedPeriod reads the billed_period of a subscription event, or returns nil
+// when there is none
+func endedPeriod(data []byte) (*domain.EndedPeriod, error) {
+	var payload struct {
+		BilledPeriod *struct {
+			PeriodStart  int64           `json:"period_start"`
+			PeriodEnd    int64           `json:"period_end"`
+			PlanID       int64           `json:"plan_id"`
+			Quantity     int32           `json:"quantity"`
+			Price        int32           `json:"price"`
+			PriceType    string          `json:"price_type"`
+			PricePerUnit *string         `json:"price_per_unit"`
+			PriceTiers   json.RawMessage `json:"price_tiers"`
+		} `json:"billed_period"`
+	}
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return nil, err
+	}
+
+	billed := payload.BilledPeriod
+	if billed == nil {
+		return nil, nil
+	}
+	if billed.PlanID <= 0 || billed.PeriodStart <= 0 || billed.PeriodEnd <= 0 {
+		return nil, fmt.Errorf("incomplete billed period")
+	}
+
+	period := &domain.EndedPeriod{
+		Start:        time.Unix(billed.PeriodStart, 0).UTC(),
+		End:          time.Unix(billed.PeriodEnd, 0).UTC(),
+		PlanID:       billed.PlanID,
+		Quantity:     billed.Quantity,
+		Price:        billed.Price,
+		PriceType:    billed.PriceType,
+		PricePerUnit: billed.PricePerUnit,
+	}
+	if string(billed.PriceTiers) != "null" {
+		period.PriceTiers = billed.PriceTiers
+	}
+	return period, nil
+}
+
+// ConsumeInvoicePaymentFailed starts dunning when charging an invoice fails
+func (ec *EventConsumer) ConsumeInvoicePaymentFailed(ctx context.Context) error {
+	consumer, err := amqp.NewConsumer(
+		ec.conn,
+		"billing.invoice.payment_failed",
+		"damar.events",
+		contracts.InvoiceEventPaymentFailed,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create consumer: %w", err)
+	}
+
+	logger.Info("Started consuming invoice.payment_failed events")
+
+	return consumer.Consume(func(body []byte) error {
+		var message contracts.AmqpMessage
+		if err := json.Unmarshal(body, &message); err != nil {
+			logger.Error("Failed to unmarshal invoice.payment_failed message", zap.Error(err))
+			return err
+		}
+
+		invoiceID, err := strconv.ParseInt(message.OwnerID, 10, 64)
+		if err != nil {
+			logger.Error("Invalid invoice ID in event",
+				zap.String("owner_id", message.OwnerID),
+				zap.Error(err))
+			return nil
+		}
+
+		ec.startDunning(ctx, invoiceID, "payment failed")
+		return nil
+	})
+}
+
+// ConsumePaymentFailed starts dunning when a provider reports, through a
+// webhook, that the charge of an invoice failed
+func (ec *EventConsumer) ConsumePaymentFailed(ctx context.Context) error {
+	consumer, err := amqp.NewConsumer(
+		ec.conn,
+		"billing.payment.failed",
+		"damar.events",
+		contracts.PaymentEventFailed,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create consumer: %w", err)
+	}
+
+	logger.Info("Started consuming payment.failed events")
+
+	return consumer.Consume(func(body []byte) error {
+		var message contracts.AmqpMessage
+		if err := json.Unmarshal(body, &message); err != nil {
+			logger.Error("Failed to unmarshal payment.failed message", zap.Error(err))
+			return err
+		}
+
+		var eventData map[string]interface{}
+		if err := json.Unmarshal(message.Data, &eventData); err != nil {
+			logger.Error("Failed to unmarshal event data", zap.Error(err))
+			return err
+		}
+
+		// Charges that do not belong to an invoice are not dunned
+		invoiceID, ok := eventData["invoice_id"].(float64)
+		if !ok {
+			return nil
+		}
+
+		failureMessage, _ := eventData["failure_message"].(string)
+		if failureMessage == "" {
+			failureMessage = "payment failed"
+		}
+
+		ec.startDunning(ctx, int64(invoiceID), failureMessage)
+		return nil
+	})
+}
+
+// ConsumeInvoicePaid ends dunning once the invoice is paid, however it was paid
+func (ec *EventConsumer) ConsumeInvoicePaid(ctx context.Context) error {
+	return ec.consumeInvoiceSettled(ctx, "billing.invoice.paid", contracts.InvoiceEventPaid)
+}
+
+// ConsumeInvoiceVoided ends dunning for invoices that are voided
+func (ec *EventConsumer) ConsumeInvoiceVoided(ctx context.Context) error {
+	return ec.consumeInvoiceSettled(ctx, "billing.invoice.voided", contracts.InvoiceEventVoided)
+}
+
+func (ec *EventConsumer) consumeInvoiceSettled(ctx context.Context, queue, routingKey string) error {
+	consumer, err := amqp.NewConsumer(ec.conn, queue, "damar.events", routingKey)
+	if err != nil {
+		return fmt.Errorf("failed to create consumer: %w", err)
+	}
+
+	logger.Info("Started consuming invoice events for dunning", zap.String("routing_key", routingKey))
+
+	return consumer.Consume(func(body []byte) error {
+		var message contracts.AmqpMessage
+		if err := json.Unmarshal(body, &message); err != nil {
+			logger.Error("Failed to unmarshal invoice message",
+				zap.String("routing_key", routingKey),
+				zap.Error(err))
+			return err
+		}
+
+		invoiceID, err := strconv.ParseInt(message.OwnerID, 10, 64)
+		if err != nil {
+			logger.Error("Invalid invoice ID in event",
+				zap.String("owner_id", message.OwnerID),
+				zap.Error(err))
+			return nil
+		}
+
+		if err := ec.dunningService.Resolve(ctx, invoiceID); err != nil {
+			logger.Error("Failed to resolve dunning",
+				zap.Int64("invoice_id", invoiceID),
+				zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// ConsumeTenantPurgeRequested closes the dunning runs of tenants whose grace
+// period has ended, so tenant-service can purge them
+func (ec *EventConsumer) ConsumeTenantPurgeRequested(ctx context.Context) error {
+	consumer, err := amqp.NewConsumer(
+		ec.conn,
+		"billing.tenant.purge_requested",
+		"damar.events",
+		contracts.TenantEventPurgeRequested,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create consumer: %w", err)
+	}
+
+	logger.Info("Started consuming tenant.purge_requested events")
+
+	return consumer.Consume(func(body []byte) error {
+		var message contracts.AmqpMessage
+		if err := json.Unmarshal(body, &message); err != nil {
+			logger.Error("Failed to unmarshal tenant.purge_requested message", zap.Error(err))
+			return err
+		}
+
+		var eventData struct {
+			TenantID int64 `json:"tenant_id"`
+		}
+		if err := json.Unmarshal(message.Data, &eventData); err != nil {
+			logger.Error("Failed to unmarshal event data", zap.Error(err))
+			return err
+		}
+
+		if err := ec.dunningService.ReleaseTenant(ctx, eventData.TenantID); err != nil {
+			// The purge worker requests it again on its next run
+			logger.Error("Failed to release tenant dunning runs",
+				zap.Int64("tenant_id", eventData.TenantID),
+				zap.Error(err))
+		}
+		return nil
+	})
+}
+
+func (ec *EventConsumer) startDunning(ctx context.Context, invoiceID int64, failureMessage string) {
+	run, err := ec.dunningService.Start(ctx, invoiceID, failureMessage)
+	if err != nil {
+		logger.Error("Failed to start dunning",
+			zap.Int64("invoice_id", invoiceID),
+			zap.Error(err))
+		return
+	}
+	if run != nil {
+		logger.Info("Invoice in dunning",
+			zap.Int64("invoice_id", invoiceID),
+			zap.Int64("dunning_run_id", run.ID))
+	}
+}
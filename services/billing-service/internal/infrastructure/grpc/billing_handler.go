@@ -0,0 +1,473 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/damarteplok/damar-admin-cms/services/billing-service/internal/domain"
+	"github.com/damarteplok/damar-admin-cms/services/billing-service/pkg/types"
+	"github.com/damarteplok/damar-admin-cms/shared/payment"
+	pb "github.com/damarteplok/damar-admin-cms/shared/proto/billing"
+	"github.com/damarteplok/damar-admin-cms/shared/tenancy"
+	"github.com/damarteplok/damar-admin-cms/shared/util"
+	"github.com/damarteplok/damar-admin-cms/shared/validation"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type BillingHandler struct {
+	pb.UnimplementedBillingServiceServer
+	invoiceService domain.InvoiceService
+}
+
+func NewBillingHandler(invoiceService domain.InvoiceService) *BillingHandler {
+	return &BillingHandler{
+		invoiceService: invoiceService,
+	}
+}
+
+// Invoice operations
+
+func (h *BillingHandler) GenerateInvoice(ctx context.Context, req *pb.GenerateInvoiceRequest) (*pb.GenerateInvoiceResponse, error) {
+	if err := validation.ValidateStruct(&types.GenerateInvoiceValidation{
+		SubscriptionID: req.SubscriptionId,
+		PeriodStart:    req.PeriodStart,
+		PeriodEnd:      req.PeriodEnd,
+	}); err != nil {
+		return &pb.GenerateInvoiceResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	ctx, err := h.scopeSubscription(ctx, req.SubscriptionId)
+	if err != nil {
+		return &pb.GenerateInvoiceResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	invoice, err := h.invoiceService.GenerateForSubscription(ctx, req.SubscriptionId, unixToTime(req.PeriodStart), unixToTime(req.PeriodEnd))
+	if err != nil {
+		return &pb.GenerateInvoiceResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &pb.GenerateInvoiceResponse{
+		Success: true,
+		Message: "Invoice generated successfully",
+		Data:    domainInvoiceToPb(invoice),
+	}, nil
+}
+
+func (h *BillingHandler) GetInvoiceByID(ctx context.Context, req *pb.GetInvoiceByIDRequest) (*pb.GetInvoiceByIDResponse, error) {
+	if err := validation.ValidateStruct(&types.IDValidation{ID: req.Id}); err != nil {
+		return &pb.GetInvoiceByIDResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	ctx, err := h.scopeInvoice(ctx, req.Id)
+	if err != nil {
+		return &pb.GetInvoiceByIDResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	invoice, err := h.invoiceService.GetByID(ctx, req.Id)
+	if err != nil {
+		return &pb.GetInvoiceByIDResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &pb.GetInvoiceByIDResponse{
+		Success: true,
+		Message: "Invoice retrieved successfully",
+		Data:    domainInvoiceToPb(invoice),
+	}, nil
+}
+
+func (h *BillingHandler) GetInvoiceByNumber(ctx context.Context, req *pb.GetInvoiceByNumberRequest) (*pb.GetInvoiceByNumberResponse, error) {
+	ctx, tenantID, err := tenancy.Scope(ctx, req.TenantId)
+	if err != nil {
+		return &pb.GetInvoiceByNumberResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+	req.TenantId = tenantID
+
+	if err := validation.ValidateStruct(&types.GetInvoiceByNumberValidation{
+		TenantID: req.TenantId,
+		Number:   req.Number,
+	}); err != nil {
+		return &pb.GetInvoiceByNumberResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	invoice, err := h.invoiceService.GetByNumber(ctx, req.TenantId, req.Number)
+	if err != nil {
+		return &pb.GetInvoiceByNumberResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &pb.GetInvoiceByNumberResponse{
+		Success: true,
+		Message: "Invoice retrieved successfully",
+		Data:    domainInvoiceToPb(invoice),
+	}, nil
+}
+
+func (h *BillingHandler) GetInvoicesByTenant(ctx context.Context, req *pb.GetInvoicesByTenantRequest) (*pb.GetInvoicesByTenantResponse, error) {
+	ctx, tenantID, err := tenancy.Scope(ctx, req.TenantId)
+	if err != nil {
+		return &pb.GetInvoicesByTenantResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+	req.TenantId = tenantID
+
+	if err := validation.ValidateStruct(&types.GetInvoicesByTenantValidation{
+		TenantID: req.TenantId,
+		Page:     req.Page,
+		PerPage:  req.PerPage,
+		Status:   req.Status,
+	}); err != nil {
+		return &pb.GetInvoicesByTenantResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	page, perPage := paginationFromRequest(req.Page, req.PerPage)
+	invoices, total, err := h.invoiceService.GetByTenant(ctx, req.TenantId, req.Status, page, perPage)
+	if err != nil {
+		return &pb.GetInvoicesByTenantResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &pb.GetInvoicesByTenantResponse{
+		Success: true,
+		Message: "Invoices retrieved successfully",
+		Data:    invoicesToPbData(invoices, total, page, perPage),
+	}, nil
+}
+
+func (h *BillingHandler) GetInvoicesBySubscription(ctx context.Context, req *pb.GetInvoicesBySubscriptionRequest) (*pb.GetInvoicesBySubscriptionResponse, error) {
+	if err := validation.ValidateStruct(&types.GetInvoicesBySubscriptionValidation{
+		SubscriptionID: req.SubscriptionId,
+		Page:           req.Page,
+		PerPage:        req.PerPage,
+	}); err != nil {
+		return &pb.GetInvoicesBySubscriptionResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	ctx, err := h.scopeSubscription(ctx, req.SubscriptionId)
+	if err != nil {
+		return &pb.GetInvoicesBySubscriptionResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	page, perPage := paginationFromRequest(req.Page, req.PerPage)
+	invoices, total, err := h.invoiceService.GetBySubscription(ctx, req.SubscriptionId, page, perPage)
+	if err != nil {
+		return &pb.GetInvoicesBySubscriptionResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &pb.GetInvoicesBySubscriptionResponse{
+		Success: true,
+		Message: "Invoices retrieved successfully",
+		Data:    invoicesToPbData(invoices, total, page, perPage),
+	}, nil
+}
+
+func (h *BillingHandler) FinalizeInvoice(ctx context.Context, req *pb.FinalizeInvoiceRequest) (*pb.FinalizeInvoiceResponse, error) {
+	if err := validation.ValidateStruct(&types.IDValidation{ID: req.Id}); err != nil {
+		return &pb.FinalizeInvoiceResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	ctx, err := h.scopeInvoice(ctx, req.Id)
+	if err != nil {
+		return &pb.FinalizeInvoiceResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	invoice, err := h.invoiceService.Finalize(ctx, req.Id)
+	if err != nil {
+		return &pb.FinalizeInvoiceResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &pb.FinalizeInvoiceResponse{
+		Success: true,
+		Message: "Invoice finalized successfully",
+		Data:    domainInvoiceToPb(invoice),
+	}, nil
+}
+
+func (h *BillingHandler) MarkInvoicePaid(ctx context.Context, req *pb.MarkInvoicePaidRequest) (*pb.MarkInvoicePaidResponse, error) {
+	if err := validation.ValidateStruct(&types.IDValidation{ID: req.Id}); err != nil {
+		return &pb.MarkInvoicePaidResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	ctx, err := h.scopeInvoice(ctx, req.Id)
+	if err != nil {
+		return &pb.MarkInvoicePaidResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	invoice, err := h.invoiceService.MarkPaid(ctx, req.Id)
+	if err != nil {
+		return &pb.MarkInvoicePaidResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &pb.MarkInvoicePaidResponse{
+		Success: true,
+		Message: "Invoice marked as paid successfully",
+		Data:    domainInvoiceToPb(invoice),
+	}, nil
+}
+
+func (h *BillingHandler) VoidInvoice(ctx context.Context, req *pb.VoidInvoiceRequest) (*pb.VoidInvoiceResponse, error) {
+	if err := validation.ValidateStruct(&types.VoidInvoiceValidation{
+		ID:     req.Id,
+		Reason: req.Reason,
+	}); err != nil {
+		return &pb.VoidInvoiceResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	ctx, err := h.scopeInvoice(ctx, req.Id)
+	if err != nil {
+		return &pb.VoidInvoiceResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	invoice, err := h.invoiceService.Void(ctx, req.Id, req.Reason)
+	if err != nil {
+		return &pb.VoidInvoiceResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &pb.VoidInvoiceResponse{
+		Success: true,
+		Message: "Invoice voided successfully",
+		Data:    domainInvoiceToPb(invoice),
+	}, nil
+}
+
+func (h *BillingHandler) PayInvoice(ctx context.Context, req *pb.PayInvoiceRequest) (*pb.PayInvoiceResponse, error) {
+	if err := validation.ValidateStruct(&types.PayInvoiceValidation{
+		ID:              req.Id,
+		PaymentProvider: req.PaymentProvider,
+		PaymentMethodID: req.PaymentMethodId,
+	}); err != nil {
+		return &pb.PayInvoiceResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	ctx, err := h.scopeInvoice(ctx, req.Id)
+	if err != nil {
+		return &pb.PayInvoiceResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	invoice, charge, err := h.invoiceService.Pay(ctx, req.Id, req.PaymentProvider, req.PaymentMethodId)
+	if err != nil {
+		return &pb.PayInvoiceResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	response := &pb.PayInvoiceResponse{
+		Success: true,
+		Message: "Invoice paid successfully",
+		Data:    domainInvoiceToPb(invoice),
+	}
+	if charge != nil {
+		response.ChargeId = charge.ID
+		response.ChargeStatus = charge.Status
+		if charge.Status == payment.StatusPending {
+			response.Message = "Invoice payment is pending"
+		}
+	}
+
+	return response, nil
+}
+
+// Helper functions
+
+// scopeInvoice loads the invoice a call names and checks it belongs to the
+// caller's active tenant, returning ctx scoped to that tenant
+func (h *BillingHandler) scopeInvoice(ctx context.Context, id int64) (context.Context, error) {
+	invoice, err := h.invoiceService.GetByID(ctx, id)
+	if err != nil {
+		return ctx, err
+	}
+	return scopeOwner(ctx, invoice.TenantID)
+}
+
+// scopeSubscription does the same for the subscription invoices are billed for
+func (h *BillingHandler) scopeSubscription(ctx context.Context, id int64) (context.Context, error) {
+	// The lookup spans tenants; its tenant is checked below
+	subscription, err := h.invoiceService.GetSubscription(tenancy.WithoutTenant(ctx), id)
+	if err != nil {
+		return ctx, err
+	}
+	return scopeOwner(ctx, subscription.TenantID)
+}
+
+// scopeOwner checks a record's tenant against the caller's active tenant.
+// Invoices of a purged tenant belong to no tenant, so only internal callers
+// reach them.
+func scopeOwner(ctx context.Context, tenantID int64) (context.Context, error) {
+	if tenantID == 0 {
+		if tenancy.TenantID(ctx) > 0 || tenancy.UserID(ctx) > 0 {
+			return ctx, status.Error(codes.PermissionDenied, "the record does not belong to the active tenant")
+		}
+		return tenancy.WithoutTenant(ctx), nil
+	}
+	ctx, _, err := tenancy.Scope(ctx, tenantID)
+	return ctx, err
+}
+
+func paginationFromRequest(page, perPage int32) (int, int) {
+	p := int(page)
+	pp := int(perPage)
+	if p < 1 {
+		p = 1
+	}
+	if pp < 1 {
+		pp = 10
+	}
+	if pp > 100 {
+		pp = 100
+	}
+	return p, pp
+}
+
+func unixToTime(v int64) *time.Time {
+	if v == 0 {
+		return nil
+	}
+	t := time.Unix(v, 0)
+	return &t
+}
+
+func int64Value(v *int64) int64 {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
+
+func invoicesToPbData(invoices []*domain.Invoice, total, page, perPage int) *pb.GetInvoicesData {
+	pbInvoices := make([]*pb.Invoice, len(invoices))
+	for i, invoice := range invoices {
+		pbInvoices[i] = domainInvoiceToPb(invoice)
+	}
+
+	return &pb.GetInvoicesData{
+		Invoices: pbInvoices,
+		Total:    int32(total),
+		Page:     int32(page),
+		PerPage:  int32(perPage),
+	}
+}
+
+func domainInvoiceToPb(invoice *domain.Invoice) *pb.Invoice {
+	lineItems := make([]*pb.InvoiceLineItem, len(invoice.LineItems))
+	for i, item := range invoice.LineItems {
+		lineItems[i] = domainInvoiceLineItemToPb(item)
+	}
+
+	return &pb.Invoice{
+		Id:                      invoice.ID,
+		Uuid:                    invoice.UUID,
+		TenantId:                invoice.TenantID,
+		SubscriptionId:          int64Value(invoice.SubscriptionID),
+		UserId:                  int64Value(invoice.UserID),
+		CurrencyId:              invoice.CurrencyID,
+		Number:                  util.StringValue(invoice.Number),
+		Status:                  invoice.Status,
+		PeriodStart:             util.TimeToUnix(invoice.PeriodStart),
+		PeriodEnd:               util.TimeToUnix(invoice.PeriodEnd),
+		Subtotal:                invoice.Subtotal,
+		DiscountTotal:           invoice.DiscountTotal,
+		Total:                   invoice.Total,
+		DueAt:                   util.TimeToUnix(invoice.DueAt),
+		FinalizedAt:             util.TimeToUnix(invoice.FinalizedAt),
+		PaidAt:                  util.TimeToUnix(invoice.PaidAt),
+		VoidedAt:                util.TimeToUnix(invoice.VoidedAt),
+		VoidReason:              util.StringValue(invoice.VoidReason),
+		Metadata:                string(invoice.Metadata),
+		LineItems:               lineItems,
+		CreatedAt:               invoice.CreatedAt.Unix(),
+		UpdatedAt:               invoice.UpdatedAt.Unix(),
+		PaymentProviderId:       int64Value(invoice.PaymentProviderID),
+		PaymentProviderChargeId: util.StringValue(invoice.PaymentProviderChargeID),
+	}
+}
+
+func domainInvoiceLineItemToPb(item *domain.InvoiceLineItem) *pb.InvoiceLineItem {
+	return &pb.InvoiceLineItem{
+		Id:                     item.ID,
+		InvoiceId:              item.InvoiceID,
+		Type:                   item.Type,
+		Description:            item.Description,
+		Quantity:               item.Quantity,
+		UnitAmount:             item.UnitAmount,
+		Amount:                 item.Amount,
+		SubscriptionDiscountId: int64Value(item.SubscriptionDiscountID),
+		CreatedAt:              item.CreatedAt.Unix(),
+		UpdatedAt:              item.UpdatedAt.Unix(),
+	}
+}
@@ -0,0 +1,56 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/damarteplok/damar-admin-cms/services/billing-service/internal/domain"
+	subscriptionPb "github.com/damarteplok/damar-admin-cms/shared/proto/subscription"
+)
+
+// SubscriptionClient implements domain.SubscriptionLifecycle on top of the
+// subscription-service gRPC API
+type SubscriptionClient struct {
+	client subscriptionPb.SubscriptionServiceClient
+}
+
+func NewSubscriptionClient(client subscriptionPb.SubscriptionServiceClient) domain.SubscriptionLifecycle {
+	return &SubscriptionClient{client: client}
+}
+
+func (c *SubscriptionClient) MarkPastDue(ctx context.Context, id int64) error {
+	return c.setStatus(ctx, id, "past_due")
+}
+
+func (c *SubscriptionClient) Reactivate(ctx context.Context, id int64) error {
+	return c.setStatus(ctx, id, "active")
+}
+
+func (c *SubscriptionClient) Cancel(ctx context.Context, id int64, reason string) error {
+	resp, err := c.client.CancelSubscription(ctx, &subscriptionPb.CancelSubscriptionRequest{
+		Id:                 id,
+		CancellationReason: reason,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to cancel subscription: %w", err)
+	}
+	if !resp.Success {
+		return errors.New(resp.Message)
+	}
+	return nil
+}
+
+func (c *SubscriptionClient) setStatus(ctx context.Context, id int64, status string) error {
+	resp, err := c.client.UpdateSubscription(ctx, &subscriptionPb.UpdateSubscriptionRequest{
+		Id:     id,
+		Status: status,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update subscription status: %w", err)
+	}
+	if !resp.Success {
+		return errors.New(resp.Message)
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/damarteplok/damar-admin-cms/services/billing-service/internal/domain"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type CurrencyRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewCurrencyRepository(db *pgxpool.Pool) domain.CurrencyRepository {
+	return &CurrencyRepository{db: db}
+}
+
+func (r *CurrencyRepository) GetByID(ctx context.Context, id int64) (*domain.Currency, error) {
+	query := `SELECT id, code, name, symbol FROM currencies WHERE id = $1`
+
+	currency := &domain.Currency{}
+	err := r.db.QueryRow(ctx, query, id).Scan(
+		&currency.ID,
+		&currency.Code,
+		&currency.Name,
+		&currency.Symbol,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get currency by ID: %w", err)
+	}
+
+	return currency, nil
+}
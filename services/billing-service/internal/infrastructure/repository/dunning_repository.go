@@ -0,0 +1,195 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/damarteplok/damar-admin-cms/services/billing-service/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// tenant_id is NULL once the tenant has been purged and reads as 0
+const dunningRunColumns = `
+	id, invoice_id, subscription_id, COALESCE(tenant_id, 0), status, retry_days, attempt_count,
+	next_attempt_at, last_attempt_at, last_failure_message, started_at, closed_at,
+	created_at, updated_at
+`
+
+type DunningRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewDunningRepository(db *pgxpool.Pool) domain.DunningRepository {
+	return &DunningRepository{db: db}
+}
+
+func scanDunningRun(row pgx.Row, run *domain.DunningRun) error {
+	var retryDays []byte
+	err := row.Scan(
+		&run.ID,
+		&run.InvoiceID,
+		&run.SubscriptionID,
+		&run.TenantID,
+		&run.Status,
+		&retryDays,
+		&run.AttemptCount,
+		&run.NextAttemptAt,
+		&run.LastAttemptAt,
+		&run.LastFailureMessage,
+		&run.StartedAt,
+		&run.ClosedAt,
+		&run.CreatedAt,
+		&run.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(retryDays, &run.RetryDays); err != nil {
+		return fmt.Errorf("invalid retry days: %w", err)
+	}
+	return nil
+}
+
+func (r *DunningRepository) Create(ctx context.Context, run *domain.DunningRun) (bool, error) {
+	retryDays, err := json.Marshal(run.RetryDays)
+	if err != nil {
+		return false, fmt.Errorf("failed to encode retry days: %w", err)
+	}
+
+	query := `
+		INSERT INTO dunning_runs (invoice_id, subscription_id, tenant_id, status, retry_days,
+		                          attempt_count, next_attempt_at, last_attempt_at,
+		                          last_failure_message, started_at, closed_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, NOW(), NOW())
+		ON CONFLICT (invoice_id) WHERE status = 'active' DO NOTHING
+		RETURNING id, created_at, updated_at
+	`
+
+	err = r.db.QueryRow(
+		ctx,
+		query,
+		run.InvoiceID,
+		run.SubscriptionID,
+		run.TenantID,
+		run.Status,
+		retryDays,
+		run.AttemptCount,
+		run.NextAttemptAt,
+		run.LastAttemptAt,
+		run.LastFailureMessage,
+		run.StartedAt,
+		run.ClosedAt,
+	).Scan(&run.ID, &run.CreatedAt, &run.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to create dunning run: %w", err)
+	}
+
+	return true, nil
+}
+
+func (r *DunningRepository) GetActiveByInvoice(ctx context.Context, invoiceID int64) (*domain.DunningRun, error) {
+	query := `SELECT ` + dunningRunColumns + ` FROM dunning_runs WHERE invoice_id = $1 AND status = 'active'`
+
+	run := &domain.DunningRun{}
+	err := scanDunningRun(r.db.QueryRow(ctx, query, invoiceID), run)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get active dunning run: %w", err)
+	}
+
+	return run, nil
+}
+
+func (r *DunningRepository) ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*domain.DunningRun, error) {
+	// Returned rows carry the pushed-out next_attempt_at; callers set the
+	// real one after the retry. Runs of a deleted tenant are not retried
+	// during its grace period.
+	query := `
+		UPDATE dunning_runs
+		SET next_attempt_at = $2, updated_at = NOW()
+		WHERE id IN (
+			SELECT id FROM dunning_runs
+			WHERE status = 'active' AND next_attempt_at <= $1
+			  AND NOT EXISTS (
+				SELECT 1 FROM tenants t
+				WHERE t.id = dunning_runs.tenant_id AND t.deleted_at IS NOT NULL
+			  )
+			ORDER BY next_attempt_at
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + dunningRunColumns
+
+	rows, err := r.db.Query(ctx, query, now, now.Add(lease), limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim due dunning runs: %w", err)
+	}
+	defer rows.Close()
+
+	runs := make([]*domain.DunningRun, 0)
+	for rows.Next() {
+		run := &domain.DunningRun{}
+		if err := scanDunningRun(rows, run); err != nil {
+			return nil, fmt.Errorf("failed to scan dunning run: %w", err)
+		}
+		runs = append(runs, run)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating dunning runs: %w", err)
+	}
+
+	return runs, nil
+}
+
+func (r *DunningRepository) Update(ctx context.Context, run *domain.DunningRun) error {
+	query := `
+		UPDATE dunning_runs
+		SET status = $1, attempt_count = $2, next_attempt_at = $3, last_attempt_at = $4,
+		    last_failure_message = $5, closed_at = $6, updated_at = NOW()
+		WHERE id = $7
+		RETURNING updated_at
+	`
+
+	err := r.db.QueryRow(
+		ctx,
+		query,
+		run.Status,
+		run.AttemptCount,
+		run.NextAttemptAt,
+		run.LastAttemptAt,
+		run.LastFailureMessage,
+		run.ClosedAt,
+		run.ID,
+	).Scan(&run.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to update dunning run: %w", err)
+	}
+
+	return nil
+}
+
+func (r *DunningRepository) CloseByTenant(ctx context.Context, tenantID int64) (int64, error) {
+	query := `
+		UPDATE dunning_runs
+		SET status = 'closed', next_attempt_at = NULL, closed_at = NOW(), updated_at = NOW()
+		WHERE tenant_id = $1 AND status = 'active'
+	`
+
+	result, err := r.db.Exec(ctx, query, tenantID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to close dunning runs: %w", err)
+	}
+
+	return result.RowsAffected(), nil
+}
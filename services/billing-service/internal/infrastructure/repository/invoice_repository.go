@@ -0,0 +1,360 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/damarteplok/damar-admin-cms/services/billing-service/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// invoiceColumns is shared by every SELECT so scanInvoice stays in sync.
+// tenant_id is NULL once the tenant has been purged and reads as 0.
+const invoiceColumns = `
+	id, uuid, COALESCE(tenant_id, 0), subscription_id, user_id, currency_id, number, sequence,
+	status, period_start, period_end, subtotal, discount_total, total, due_at,
+	finalized_at, paid_at, voided_at, void_reason, payment_provider_id,
+	payment_provider_charge_id, metadata, created_at, updated_at
+`
+
+type InvoiceRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewInvoiceRepository(db *pgxpool.Pool) domain.InvoiceRepository {
+	return &InvoiceRepository{db: db}
+}
+
+func scanInvoice(row pgx.Row, invoice *domain.Invoice) error {
+	return row.Scan(
+		&invoice.ID,
+		&invoice.UUID,
+		&invoice.TenantID,
+		&invoice.SubscriptionID,
+		&invoice.UserID,
+		&invoice.CurrencyID,
+		&invoice.Number,
+		&invoice.Sequence,
+		&invoice.Status,
+		&invoice.PeriodStart,
+		&invoice.PeriodEnd,
+		&invoice.Subtotal,
+		&invoice.DiscountTotal,
+		&invoice.Total,
+		&invoice.DueAt,
+		&invoice.FinalizedAt,
+		&invoice.PaidAt,
+		&invoice.VoidedAt,
+		&invoice.VoidReason,
+		&invoice.PaymentProviderID,
+		&invoice.PaymentProviderChargeID,
+		&invoice.Metadata,
+		&invoice.CreatedAt,
+		&invoice.UpdatedAt,
+	)
+}
+
+func (r *InvoiceRepository) GetByID(ctx context.Context, id int64) (*domain.Invoice, error) {
+	query := `SELECT ` + invoiceColumns + ` FROM invoices WHERE id = $1`
+
+	invoice := &domain.Invoice{}
+	if err := scanInvoice(r.db.QueryRow(ctx, query, id), invoice); err != nil {
+		return nil, fmt.Errorf("failed to get invoice by ID: %w", err)
+	}
+
+	if err := r.loadLineItems(ctx, invoice); err != nil {
+		return nil, err
+	}
+
+	return invoice, nil
+}
+
+func (r *InvoiceRepository) GetByNumber(ctx context.Context, tenantID int64, number string) (*domain.Invoice, error) {
+	query := `SELECT ` + invoiceColumns + ` FROM invoices WHERE tenant_id = $1 AND number = $2`
+
+	invoice := &domain.Invoice{}
+	if err := scanInvoice(r.db.QueryRow(ctx, query, tenantID, number), invoice); err != nil {
+		return nil, fmt.Errorf("failed to get invoice by number: %w", err)
+	}
+
+	if err := r.loadLineItems(ctx, invoice); err != nil {
+		return nil, err
+	}
+
+	return invoice, nil
+}
+
+func (r *InvoiceRepository) GetBySubscriptionPeriod(ctx context.Context, subscriptionID int64, periodStart time.Time) (*domain.Invoice, error) {
+	query := `SELECT ` + invoiceColumns + ` FROM invoices
+		WHERE subscription_id = $1 AND period_start = $2 AND status <> 'void'`
+
+	invoice := &domain.Invoice{}
+	err := scanInvoice(r.db.QueryRow(ctx, query, subscriptionID, periodStart), invoice)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get invoice by subscription period: %w", err)
+	}
+
+	if err := r.loadLineItems(ctx, invoice); err != nil {
+		return nil, err
+	}
+
+	return invoice, nil
+}
+
+func (r *InvoiceRepository) GetByPaymentCharge(ctx context.Context, providerID int64, chargeID string) (*domain.Invoice, error) {
+	query := `SELECT ` + invoiceColumns + ` FROM invoices
+		WHERE payment_provider_id = $1 AND payment_provider_charge_id = $2`
+
+	invoice := &domain.Invoice{}
+	err := scanInvoice(r.db.QueryRow(ctx, query, providerID, chargeID), invoice)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get invoice by payment charge: %w", err)
+	}
+
+	return invoice, nil
+}
+
+func (r *InvoiceRepository) GetByTenant(ctx context.Context, tenantID int64, status string, page, perPage int) ([]*domain.Invoice, int, error) {
+	if status != "" {
+		return r.list(ctx, "tenant_id = $1 AND status = $2", []interface{}{tenantID, status}, page, perPage)
+	}
+	return r.list(ctx, "tenant_id = $1", []interface{}{tenantID}, page, perPage)
+}
+
+func (r *InvoiceRepository) GetBySubscription(ctx context.Context, subscriptionID int64, page, perPage int) ([]*domain.Invoice, int, error) {
+	return r.list(ctx, "subscription_id = $1", []interface{}{subscriptionID}, page, perPage)
+}
+
+// list runs a paginated query against invoices with the given WHERE clause.
+// Line items are only loaded for single-invoice lookups.
+func (r *InvoiceRepository) list(ctx context.Context, where string, args []interface{}, page, perPage int) ([]*domain.Invoice, int, error) {
+	offset := (page - 1) * perPage
+
+	var total int
+	countQuery := `SELECT COUNT(*) FROM invoices WHERE ` + where
+	if err := r.db.QueryRow(ctx, countQuery, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count invoices: %w", err)
+	}
+
+	argCounter := len(args) + 1
+	query := `SELECT ` + invoiceColumns + ` FROM invoices WHERE ` + where +
+		fmt.Sprintf(` ORDER BY created_at DESC, id DESC LIMIT $%d OFFSET $%d`, argCounter, argCounter+1)
+	args = append(args, perPage, offset)
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get invoices: %w", err)
+	}
+	defer rows.Close()
+
+	invoices := make([]*domain.Invoice, 0)
+	for rows.Next() {
+		invoice := &domain.Invoice{}
+		if err := scanInvoice(rows, invoice); err != nil {
+			return nil, 0, fmt.Errorf("failed to scan invoice: %w", err)
+		}
+		invoices = append(invoices, invoice)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating invoices: %w", err)
+	}
+
+	return invoices, total, nil
+}
+
+func (r *InvoiceRepository) loadLineItems(ctx context.Context, invoice *domain.Invoice) error {
+	query := `
+		SELECT id, invoice_id, type, description, quantity, unit_amount, amount,
+		       subscription_discount_id, created_at, updated_at
+		FROM invoice_line_items
+		WHERE invoice_id = $1
+		ORDER BY id
+	`
+
+	rows, err := r.db.Query(ctx, query, invoice.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get invoice line items: %w", err)
+	}
+	defer rows.Close()
+
+	invoice.LineItems = make([]*domain.InvoiceLineItem, 0)
+	for rows.Next() {
+		item := &domain.InvoiceLineItem{}
+		err := rows.Scan(
+			&item.ID,
+			&item.InvoiceID,
+			&item.Type,
+			&item.Description,
+			&item.Quantity,
+			&item.UnitAmount,
+			&item.Amount,
+			&item.SubscriptionDiscountID,
+			&item.CreatedAt,
+			&item.UpdatedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to scan invoice line item: %w", err)
+		}
+		invoice.LineItems = append(invoice.LineItems, item)
+	}
+
+	if err = rows.Err(); err != nil {
+		return fmt.Errorf("error iterating invoice line items: %w", err)
+	}
+
+	return nil
+}
+
+func (r *InvoiceRepository) Create(ctx context.Context, invoice *domain.Invoice) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		INSERT INTO invoices (uuid, tenant_id, subscription_id, user_id, currency_id, status,
+		                      period_start, period_end, subtotal, discount_total, total,
+		                      metadata, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, NOW(), NOW())
+		RETURNING id, created_at, updated_at
+	`
+
+	err = tx.QueryRow(
+		ctx,
+		query,
+		invoice.UUID,
+		invoice.TenantID,
+		invoice.SubscriptionID,
+		invoice.UserID,
+		invoice.CurrencyID,
+		invoice.Status,
+		invoice.PeriodStart,
+		invoice.PeriodEnd,
+		invoice.Subtotal,
+		invoice.DiscountTotal,
+		invoice.Total,
+		invoice.Metadata,
+	).Scan(&invoice.ID, &invoice.CreatedAt, &invoice.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create invoice: %w", err)
+	}
+
+	itemQuery := `
+		INSERT INTO invoice_line_items (invoice_id, type, description, quantity, unit_amount,
+		                                amount, subscription_discount_id, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, NOW(), NOW())
+		RETURNING id, created_at, updated_at
+	`
+
+	for _, item := range invoice.LineItems {
+		item.InvoiceID = invoice.ID
+		err := tx.QueryRow(
+			ctx,
+			itemQuery,
+			item.InvoiceID,
+			item.Type,
+			item.Description,
+			item.Quantity,
+			item.UnitAmount,
+			item.Amount,
+			item.SubscriptionDiscountID,
+		).Scan(&item.ID, &item.CreatedAt, &item.UpdatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to create invoice line item: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit invoice: %w", err)
+	}
+
+	return nil
+}
+
+func (r *InvoiceRepository) Finalize(ctx context.Context, invoice *domain.Invoice, formatNumber func(sequence int64) string) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// The upsert row-locks the tenant's counter until commit, so concurrent
+	// finalizations queue up and numbers stay gapless
+	sequenceQuery := `
+		INSERT INTO invoice_sequences (tenant_id, last_sequence, created_at, updated_at)
+		VALUES ($1, 1, NOW(), NOW())
+		ON CONFLICT (tenant_id) DO UPDATE
+		SET last_sequence = invoice_sequences.last_sequence + 1, updated_at = NOW()
+		RETURNING last_sequence
+	`
+
+	var sequence int64
+	if err := tx.QueryRow(ctx, sequenceQuery, invoice.TenantID).Scan(&sequence); err != nil {
+		return fmt.Errorf("failed to allocate invoice number: %w", err)
+	}
+	number := formatNumber(sequence)
+
+	// Guarding on the draft status keeps a concurrent finalize from taking a
+	// second number for the same invoice
+	query := `
+		UPDATE invoices
+		SET number = $1, sequence = $2, status = $3, finalized_at = $4, due_at = $5, updated_at = NOW()
+		WHERE id = $6 AND status = 'draft'
+		RETURNING updated_at
+	`
+
+	err = tx.QueryRow(ctx, query, number, sequence, invoice.Status, invoice.FinalizedAt, invoice.DueAt, invoice.ID).
+		Scan(&invoice.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return errors.New("invoice is no longer a draft")
+	}
+	if err != nil {
+		return fmt.Errorf("failed to finalize invoice: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit invoice finalization: %w", err)
+	}
+
+	invoice.Number = &number
+	invoice.Sequence = &sequence
+	return nil
+}
+
+func (r *InvoiceRepository) UpdateStatus(ctx context.Context, invoice *domain.Invoice) error {
+	query := `
+		UPDATE invoices
+		SET status = $1, paid_at = $2, voided_at = $3, void_reason = $4,
+		    payment_provider_id = $5, payment_provider_charge_id = $6, updated_at = NOW()
+		WHERE id = $7
+		RETURNING updated_at
+	`
+
+	err := r.db.QueryRow(
+		ctx,
+		query,
+		invoice.Status,
+		invoice.PaidAt,
+		invoice.VoidedAt,
+		invoice.VoidReason,
+		invoice.PaymentProviderID,
+		invoice.PaymentProviderChargeID,
+		invoice.ID,
+	).Scan(&invoice.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to update invoice status: %w", err)
+	}
+
+	return nil
+}
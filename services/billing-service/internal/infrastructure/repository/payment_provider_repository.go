@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/damarteplok/damar-admin-cms/services/billing-service/internal/domain"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PaymentProviderRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewPaymentProviderRepository(db *pgxpool.Pool) domain.PaymentProviderRepository {
+	return &PaymentProviderRepository{db: db}
+}
+
+func (r *PaymentProviderRepository) GetByID(ctx context.Context, id int64) (*domain.PaymentProvider, error) {
+	query := `
+		SELECT id, name, slug, is_active, COALESCE(created_at, NOW()), COALESCE(updated_at, NOW())
+		FROM payment_providers
+		WHERE id = $1
+	`
+
+	provider := &domain.PaymentProvider{}
+	err := r.db.QueryRow(ctx, query, id).Scan(
+		&provider.ID,
+		&provider.Name,
+		&provider.Slug,
+		&provider.IsActive,
+		&provider.CreatedAt,
+		&provider.UpdatedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get payment provider by ID: %w", err)
+	}
+
+	return provider, nil
+}
+
+func (r *PaymentProviderRepository) GetBySlug(ctx context.Context, slug string) (*domain.PaymentProvider, error) {
+	query := `
+		SELECT id, name, slug, is_active, COALESCE(created_at, NOW()), COALESCE(updated_at, NOW())
+		FROM payment_providers
+		WHERE slug = $1
+	`
+
+	provider := &domain.PaymentProvider{}
+	err := r.db.QueryRow(ctx, query, slug).Scan(
+		&provider.ID,
+		&provider.Name,
+		&provider.Slug,
+		&provider.IsActive,
+		&provider.CreatedAt,
+		&provider.UpdatedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get payment provider by slug: %w", err)
+	}
+
+	return provider, nil
+}
@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/damarteplok/damar-admin-cms/services/billing-service/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PaymentWebhookEventRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewPaymentWebhookEventRepository(db *pgxpool.Pool) domain.PaymentWebhookEventRepository {
+	return &PaymentWebhookEventRepository{db: db}
+}
+
+func (r *PaymentWebhookEventRepository) Record(ctx context.Context, event *domain.PaymentWebhookEvent) error {
+	query := `
+		INSERT INTO payment_webhook_events (payment_provider_id, event_id, type, status, payload,
+		                                    attempts, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, 1, NOW(), NOW())
+		ON CONFLICT (payment_provider_id, event_id) DO UPDATE
+		SET attempts = payment_webhook_events.attempts + 1, updated_at = NOW()
+		RETURNING id, status, error, attempts, processed_at, created_at, updated_at
+	`
+
+	err := r.db.QueryRow(
+		ctx,
+		query,
+		event.PaymentProviderID,
+		event.EventID,
+		event.Type,
+		domain.WebhookEventStatusReceived,
+		event.Payload,
+	).Scan(
+		&event.ID,
+		&event.Status,
+		&event.Error,
+		&event.Attempts,
+		&event.ProcessedAt,
+		&event.CreatedAt,
+		&event.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to record payment webhook event: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PaymentWebhookEventRepository) Claim(ctx context.Context, id int64) (bool, error) {
+	// A delivery stuck in processing for a while is assumed to have crashed
+	// and can be taken over by a redelivery. The lease runs from claimed_at,
+	// which redeliveries leave alone.
+	query := `
+		UPDATE payment_webhook_events
+		SET status = 'processing', claimed_at = NOW(), updated_at = NOW()
+		WHERE id = $1
+		  AND (status IN ('received', 'failed')
+		       OR (status = 'processing'
+		           AND (claimed_at IS NULL OR claimed_at < NOW() - INTERVAL '5 minutes')))
+		RETURNING id
+	`
+
+	var claimedID int64
+	err := r.db.QueryRow(ctx, query, id).Scan(&claimedID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to claim payment webhook event: %w", err)
+	}
+
+	return true, nil
+}
+
+func (r *PaymentWebhookEventRepository) UpdateStatus(ctx context.Context, event *domain.PaymentWebhookEvent) error {
+	query := `
+		UPDATE payment_webhook_events
+		SET status = $1, error = $2, processed_at = $3, updated_at = NOW()
+		WHERE id = $4
+		RETURNING updated_at
+	`
+
+	err := r.db.QueryRow(ctx, query, event.Status, event.Error, event.ProcessedAt, event.ID).
+		Scan(&event.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to update payment webhook event status: %w", err)
+	}
+
+	return nil
+}
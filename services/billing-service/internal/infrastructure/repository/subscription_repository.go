@@ -0,0 +1,181 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/damarteplok/damar-admin-cms/services/billing-service/internal/domain"
+	"github.com/damarteplok/damar-admin-cms/shared/database"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// SubscriptionRepository uses tenantDB for subscriptions, which has row-level
+// security. Usage and discounts have none and use the pool.
+type SubscriptionRepository struct {
+	db       *pgxpool.Pool
+	tenantDB *database.TenantDB
+}
+
+func NewSubscriptionRepository(db *pgxpool.Pool, tenantDB *database.TenantDB) domain.SubscriptionRepository {
+	return &SubscriptionRepository{db: db, tenantDB: tenantDB}
+}
+
+// billableSubscriptionQuery is completed with a WHERE clause by its callers
+const billableSubscriptionQuery = `
+	SELECT s.id, s.tenant_id, s.user_id, s.plan_id, p.name, p.meter_id, s.currency_id,
+	       s.price, s.price_type, s.price_per_unit, s.price_tiers, s.quantity, s.status,
+	       s.payment_provider_id, s.trial_ends_at, s.ends_at, s.billing_anchor, i.slug,
+	       s.interval_count
+	FROM subscriptions s
+	JOIN plans p ON p.id = s.plan_id
+	JOIN intervals i ON i.id = s.interval_id
+`
+
+func scanBillableSubscription(row pgx.Row, subscription *domain.BillableSubscription) error {
+	return row.Scan(
+		&subscription.ID,
+		&subscription.TenantID,
+		&subscription.UserID,
+		&subscription.PlanID,
+		&subscription.PlanName,
+		&subscription.MeterID,
+		&subscription.CurrencyID,
+		&subscription.Price,
+		&subscription.PriceType,
+		&subscription.PricePerUnit,
+		&subscription.PriceTiers,
+		&subscription.Quantity,
+		&subscription.Status,
+		&subscription.PaymentProviderID,
+		&subscription.TrialEndsAt,
+		&subscription.EndsAt,
+		&subscription.BillingAnchor,
+		&subscription.IntervalSlug,
+		&subscription.IntervalCount,
+	)
+}
+
+func (r *SubscriptionRepository) GetByID(ctx context.Context, id int64) (*domain.BillableSubscription, error) {
+	query := billableSubscriptionQuery + `WHERE s.id = $1`
+
+	subscription := &domain.BillableSubscription{}
+	if err := scanBillableSubscription(r.tenantDB.QueryRow(ctx, query, id), subscription); err != nil {
+		return nil, fmt.Errorf("failed to get subscription by ID: %w", err)
+	}
+
+	return subscription, nil
+}
+
+func (r *SubscriptionRepository) GetByProviderSubscriptionID(ctx context.Context, providerID int64, providerSubscriptionID string) (*domain.BillableSubscription, error) {
+	query := billableSubscriptionQuery +
+		`WHERE s.payment_provider_id = $1 AND s.payment_provider_subscription_id = $2`
+
+	subscription := &domain.BillableSubscription{}
+	err := scanBillableSubscription(r.tenantDB.QueryRow(ctx, query, providerID, providerSubscriptionID), subscription)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get subscription by provider subscription ID: %w", err)
+	}
+
+	return subscription, nil
+}
+
+func (r *SubscriptionRepository) UpdatePaymentProviderStatus(ctx context.Context, id int64, status string) error {
+	query := `UPDATE subscriptions SET payment_provider_status = $1, updated_at = NOW() WHERE id = $2`
+
+	result, err := r.tenantDB.Exec(ctx, query, status, id)
+	if err != nil {
+		return fmt.Errorf("failed to update subscription payment provider status: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("subscription not found")
+	}
+
+	return nil
+}
+
+func (r *SubscriptionRepository) GetPlan(ctx context.Context, id int64) (*domain.BillablePlan, error) {
+	query := `SELECT id, name, meter_id FROM plans WHERE id = $1`
+
+	plan := &domain.BillablePlan{}
+	if err := r.db.QueryRow(ctx, query, id).Scan(&plan.ID, &plan.Name, &plan.MeterID); err != nil {
+		return nil, fmt.Errorf("failed to get plan by ID: %w", err)
+	}
+
+	return plan, nil
+}
+
+func (r *SubscriptionRepository) UsageQuantity(ctx context.Context, subscriptionID, meterID int64, from, to time.Time) (int64, error) {
+	query := `
+		SELECT COALESCE(CASE m.aggregation
+		           WHEN 'max' THEN MAX(u.unit_count)
+		           WHEN 'last' THEN (ARRAY_AGG(u.unit_count ORDER BY u.recorded_at DESC, u.id DESC))[1]
+		           ELSE SUM(u.unit_count)
+		       END, 0)
+		FROM plan_meters m
+		LEFT JOIN subscription_usages u
+		       ON u.plan_meter_id = m.id
+		      AND u.subscription_id = $1
+		      AND u.recorded_at >= $3 AND u.recorded_at < $4
+		WHERE m.id = $2
+		GROUP BY m.id, m.aggregation
+	`
+
+	var quantity int64
+	if err := r.db.QueryRow(ctx, query, subscriptionID, meterID, from, to).Scan(&quantity); err != nil {
+		return 0, fmt.Errorf("failed to aggregate subscription usage: %w", err)
+	}
+
+	return quantity, nil
+}
+
+func (r *SubscriptionRepository) GetBillableDiscounts(ctx context.Context, subscriptionID int64, periodStart time.Time) ([]*domain.BillableDiscount, error) {
+	query := `
+		SELECT sd.id, sd.discount_id, d.name, sd.type, sd.amount, sd.is_recurring
+		FROM subscription_discounts sd
+		JOIN discounts d ON d.id = sd.discount_id
+		WHERE sd.subscription_id = $1
+		  AND (sd.valid_until IS NULL OR sd.valid_until >= $2)
+		  AND (sd.is_recurring = TRUE OR NOT EXISTS (
+		      SELECT 1
+		      FROM invoice_line_items li
+		      JOIN invoices i ON i.id = li.invoice_id
+		      WHERE li.subscription_discount_id = sd.id AND i.status <> 'void'
+		  ))
+		ORDER BY sd.id
+	`
+
+	rows, err := r.db.Query(ctx, query, subscriptionID, periodStart)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get billable discounts: %w", err)
+	}
+	defer rows.Close()
+
+	discounts := make([]*domain.BillableDiscount, 0)
+	for rows.Next() {
+		discount := &domain.BillableDiscount{}
+		err := rows.Scan(
+			&discount.ID,
+			&discount.DiscountID,
+			&discount.Name,
+			&discount.Type,
+			&discount.Amount,
+			&discount.IsRecurring,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan billable discount: %w", err)
+		}
+		discounts = append(discounts, discount)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating billable discounts: %w", err)
+	}
+
+	return discounts, nil
+}
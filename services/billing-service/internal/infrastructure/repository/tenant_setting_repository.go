@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/damarteplok/damar-admin-cms/services/billing-service/internal/domain"
+	"github.com/damarteplok/damar-admin-cms/shared/database"
+	"github.com/jackc/pgx/v5"
+)
+
+// TenantSettingRepository reads tenant_settings, which has row-level
+// security, through tenantDB
+type TenantSettingRepository struct {
+	tenantDB *database.TenantDB
+}
+
+func NewTenantSettingRepository(tenantDB *database.TenantDB) domain.TenantSettingRepository {
+	return &TenantSettingRepository{tenantDB: tenantDB}
+}
+
+func (r *TenantSettingRepository) GetValue(ctx context.Context, tenantID int64, key string) (json.RawMessage, error) {
+	query := `SELECT value FROM tenant_settings WHERE tenant_id = $1 AND key = $2`
+
+	var value []byte
+	err := r.tenantDB.QueryRow(ctx, query, tenantID, key).Scan(&value)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant setting: %w", err)
+	}
+
+	return value, nil
+}
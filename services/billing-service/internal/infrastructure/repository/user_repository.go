@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/damarteplok/damar-admin-cms/services/billing-service/internal/domain"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type UserRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewUserRepository(db *pgxpool.Pool) domain.UserRepository {
+	return &UserRepository{db: db}
+}
+
+func (r *UserRepository) GetContact(ctx context.Context, id int64) (*domain.BillingContact, error) {
+	query := `SELECT id, name, email FROM users WHERE id = $1`
+
+	contact := &domain.BillingContact{}
+	if err := r.db.QueryRow(ctx, query, id).Scan(&contact.UserID, &contact.Name, &contact.Email); err != nil {
+		return nil, fmt.Errorf("failed to get user contact: %w", err)
+	}
+
+	return contact, nil
+}
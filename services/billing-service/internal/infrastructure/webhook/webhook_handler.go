@@ -0,0 +1,104 @@
+package webhook
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/damarteplok/damar-admin-cms/services/billing-service/internal/domain"
+	"github.com/damarteplok/damar-admin-cms/shared/contracts"
+	"github.com/damarteplok/damar-admin-cms/shared/logger"
+	"github.com/damarteplok/damar-admin-cms/shared/payment"
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+)
+
+// maxPayloadBytes bounds webhook bodies; provider events are a few KB
+const maxPayloadBytes = 1 << 20
+
+type WebhookHandler struct {
+	webhookService domain.WebhookService
+}
+
+func NewWebhookHandler(webhookService domain.WebhookService) *WebhookHandler {
+	return &WebhookHandler{webhookService: webhookService}
+}
+
+// Routes mounts POST /webhooks/{provider}
+func (h *WebhookHandler) Routes() http.Handler {
+	router := chi.NewRouter()
+	router.Post("/webhooks/{provider}", h.HandleWebhook)
+	router.Get("/health", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, contracts.APIResponse{Success: true, Message: "ok"})
+	})
+	return router
+}
+
+// HandleWebhook answers 2xx once an event is processed or known to be a
+// duplicate, 409 while another delivery is processing it, other 4xx for
+// requests the provider should not retry and 5xx when processing failed and
+// a redelivery should try again
+func (h *WebhookHandler) HandleWebhook(w http.ResponseWriter, r *http.Request) {
+	provider := chi.URLParam(r, "provider")
+
+	payload, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxPayloadBytes))
+	if err != nil {
+		writeJSON(w, http.StatusRequestEntityTooLarge, contracts.APIResponse{
+			Success: false,
+			Message: "payload too large",
+		})
+		return
+	}
+
+	event, err := h.webhookService.HandleWebhook(r.Context(), provider, payload, r.Header)
+	if err != nil {
+		status := statusForError(err)
+		if status >= http.StatusInternalServerError {
+			logger.Error("Failed to process payment webhook",
+				zap.String("provider", provider),
+				zap.Error(err))
+		} else {
+			logger.Warn("Rejected payment webhook",
+				zap.String("provider", provider),
+				zap.Error(err))
+		}
+		writeJSON(w, status, contracts.APIResponse{
+			Success: false,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	writeJSON(w, http.StatusOK, contracts.APIResponse{
+		Success: true,
+		Message: "Webhook received",
+		Data: map[string]interface{}{
+			"id":       event.ID,
+			"event_id": event.EventID,
+			"status":   event.Status,
+			"attempts": event.Attempts,
+		},
+	})
+}
+
+func statusForError(err error) int {
+	switch {
+	case errors.Is(err, payment.ErrProviderNotFound), errors.Is(err, payment.ErrWebhookNotSupported):
+		return http.StatusNotFound
+	case errors.Is(err, payment.ErrInvalidSignature), errors.Is(err, payment.ErrWebhookExpired):
+		return http.StatusUnauthorized
+	case errors.Is(err, domain.ErrInvalidWebhookPayload):
+		return http.StatusBadRequest
+	case errors.Is(err, domain.ErrWebhookInProgress):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, body contracts.APIResponse) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,57 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/damarteplok/damar-admin-cms/services/billing-service/internal/domain"
+	"github.com/damarteplok/damar-admin-cms/shared/logger"
+	"github.com/damarteplok/damar-admin-cms/shared/tenancy"
+	"go.uber.org/zap"
+)
+
+// DunningScheduler retries due dunning runs every interval. Runs are claimed
+// with FOR UPDATE SKIP LOCKED, so every replica can run it.
+type DunningScheduler struct {
+	dunning  domain.DunningService
+	interval time.Duration
+}
+
+func NewDunningScheduler(dunning domain.DunningService, interval time.Duration) *DunningScheduler {
+	if interval <= 0 {
+		interval = 5 * time.Minute
+	}
+
+	return &DunningScheduler{
+		dunning:  dunning,
+		interval: interval,
+	}
+}
+
+// Run retries due runs every interval until ctx is cancelled
+func (s *DunningScheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	s.RunOnce(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.RunOnce(ctx)
+		}
+	}
+}
+
+func (s *DunningScheduler) RunOnce(ctx context.Context) {
+	// Dunning runs span tenants
+	ctx = tenancy.WithoutTenant(ctx)
+	processed, err := s.dunning.ProcessDue(ctx, time.Now())
+	if err != nil && ctx.Err() == nil {
+		logger.Error("Failed to process due dunning runs", zap.Error(err))
+	}
+	if processed > 0 {
+		logger.Info("Processed due dunning runs", zap.Int("count", processed))
+	}
+}
@@ -0,0 +1,508 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/damarteplok/damar-admin-cms/services/billing-service/internal/domain"
+	"github.com/damarteplok/damar-admin-cms/shared/amqp"
+	"github.com/damarteplok/damar-admin-cms/shared/contracts"
+	"github.com/damarteplok/damar-admin-cms/shared/logger"
+	"github.com/damarteplok/damar-admin-cms/shared/payment"
+	"github.com/damarteplok/damar-admin-cms/shared/util"
+	"go.uber.org/zap"
+)
+
+// dunningLease is how long a claimed run is hidden from other replicas while
+// its retry is in flight
+const dunningLease = 10 * time.Minute
+
+// dunningPendingRecheck is how long a run whose retry is pending waits for
+// the provider's webhook before checking the charge again
+const dunningPendingRecheck = 24 * time.Hour
+
+// dunningCancellationReason is stored on subscriptions cancelled by dunning
+const dunningCancellationReason = "payment_failed"
+
+type dunningService struct {
+	repo             domain.DunningRepository
+	invoiceService   domain.InvoiceService
+	subscriptionRepo domain.SubscriptionRepository
+	currencyRepo     domain.CurrencyRepository
+	settingRepo      domain.TenantSettingRepository
+	userRepo         domain.UserRepository
+	subscriptions    domain.SubscriptionLifecycle
+	publisher        *amqp.Publisher
+	defaultRetryDays []int
+	batchSize        int
+}
+
+// NewDunningService creates the dunning service. defaultRetryDays applies to
+// tenants without a dunning_retry_days setting.
+func NewDunningService(
+	repo domain.DunningRepository,
+	invoiceService domain.InvoiceService,
+	subscriptionRepo domain.SubscriptionRepository,
+	currencyRepo domain.CurrencyRepository,
+	settingRepo domain.TenantSettingRepository,
+	userRepo domain.UserRepository,
+	subscriptions domain.SubscriptionLifecycle,
+	publisher *amqp.Publisher,
+	defaultRetryDays []int,
+	batchSize int,
+) domain.DunningService {
+	if batchSize < 1 {
+		batchSize = 50
+	}
+
+	return &dunningService{
+		repo:             repo,
+		invoiceService:   invoiceService,
+		subscriptionRepo: subscriptionRepo,
+		currencyRepo:     currencyRepo,
+		settingRepo:      settingRepo,
+		userRepo:         userRepo,
+		subscriptions:    subscriptions,
+		publisher:        publisher,
+		defaultRetryDays: defaultRetryDays,
+		batchSize:        batchSize,
+	}
+}
+
+func (s *dunningService) Start(ctx context.Context, invoiceID int64, failureMessage string) (*domain.DunningRun, error) {
+	if invoiceID <= 0 {
+		return nil, errors.New("invalid invoice ID")
+	}
+
+	existing, err := s.repo.GetActiveByInvoice(ctx, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return existing, nil
+	}
+
+	invoice, err := s.invoiceService.GetByID(ctx, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	// Only unpaid renewals are dunned; one-off invoices are left to the admin
+	if invoice.Status != domain.InvoiceStatusOpen || invoice.SubscriptionID == nil {
+		return nil, nil
+	}
+
+	subscription, err := s.subscriptionRepo.GetByID(ctx, *invoice.SubscriptionID)
+	if err != nil {
+		return nil, err
+	}
+	if subscription.Status == "cancelled" || subscription.Status == "expired" {
+		return nil, nil
+	}
+
+	retryDays, err := s.retryDays(ctx, invoice.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	run := &domain.DunningRun{
+		InvoiceID:          invoice.ID,
+		SubscriptionID:     invoice.SubscriptionID,
+		TenantID:           invoice.TenantID,
+		Status:             domain.DunningStatusActive,
+		RetryDays:          retryDays,
+		LastAttemptAt:      &now,
+		LastFailureMessage: util.StringPtr(failureMessage),
+		StartedAt:          now,
+	}
+	// With no retries configured the run starts out due, and the first
+	// scheduler pass exhausts it
+	nextAttemptAt := now
+	if len(retryDays) > 0 {
+		nextAttemptAt = now.AddDate(0, 0, retryDays[0])
+	}
+	run.NextAttemptAt = &nextAttemptAt
+
+	created, err := s.repo.Create(ctx, run)
+	if err != nil {
+		return nil, err
+	}
+	if !created {
+		return s.repo.GetActiveByInvoice(ctx, invoiceID)
+	}
+
+	if err := s.subscriptions.MarkPastDue(ctx, *run.SubscriptionID); err != nil {
+		logger.Warn("Failed to mark subscription past due",
+			zap.Int64("subscription_id", *run.SubscriptionID),
+			zap.Error(err))
+	}
+
+	logger.Info("Started dunning",
+		zap.Int64("dunning_run_id", run.ID),
+		zap.Int64("invoice_id", invoice.ID),
+		zap.Ints("retry_days", retryDays))
+
+	s.publishEvent(ctx, contracts.DunningEventAttemptFailed, run, invoice)
+	return run, nil
+}
+
+func (s *dunningService) Resolve(ctx context.Context, invoiceID int64) error {
+	run, err := s.repo.GetActiveByInvoice(ctx, invoiceID)
+	if err != nil || run == nil {
+		return err
+	}
+
+	invoice, err := s.invoiceService.GetByID(ctx, invoiceID)
+	if err != nil {
+		return err
+	}
+
+	switch invoice.Status {
+	case domain.InvoiceStatusPaid:
+		return s.recover(ctx, run, invoice)
+	case domain.InvoiceStatusVoid:
+		return s.close(ctx, run, domain.DunningStatusClosed)
+	default:
+		return nil
+	}
+}
+
+func (s *dunningService) ProcessDue(ctx context.Context, now time.Time) (int, error) {
+	processed := 0
+	for {
+		if ctx.Err() != nil {
+			return processed, ctx.Err()
+		}
+
+		runs, err := s.repo.ClaimDue(ctx, now, dunningLease, s.batchSize)
+		if err != nil {
+			return processed, err
+		}
+
+		for _, run := range runs {
+			if err := s.retry(ctx, run); err != nil {
+				// The lease expires and the run is picked up again later
+				logger.Error("Failed to retry dunning run",
+					zap.Int64("dunning_run_id", run.ID),
+					zap.Int64("invoice_id", run.InvoiceID),
+					zap.Error(err))
+				continue
+			}
+			processed++
+		}
+
+		if len(runs) < s.batchSize {
+			return processed, nil
+		}
+	}
+}
+
+// retry charges the invoice again and moves the run to its next attempt, or
+// cancels the subscription when the schedule is used up
+func (s *dunningService) retry(ctx context.Context, run *domain.DunningRun) error {
+	invoice, err := s.invoiceService.GetByID(ctx, run.InvoiceID)
+	if err != nil {
+		return err
+	}
+
+	switch invoice.Status {
+	case domain.InvoiceStatusPaid:
+		return s.recover(ctx, run, invoice)
+	case domain.InvoiceStatusVoid:
+		return s.close(ctx, run, domain.DunningStatusClosed)
+	}
+
+	now := time.Now()
+	run.LastAttemptAt = &now
+
+	// Exhausted runs cancel without charging again: the last scheduled retry
+	// already failed, or no retries were configured
+	if int(run.AttemptCount) < len(run.RetryDays) {
+		attempt := run.AttemptCount + 1
+
+		paid, charge, err := s.invoiceService.Retry(ctx, invoice.ID, attempt)
+		if err == nil && paid.Status == domain.InvoiceStatusPaid {
+			run.AttemptCount = attempt
+			return s.recover(ctx, run, paid)
+		}
+
+		// A pending charge is settled by the provider's webhook, so the run
+		// waits for it without using up a retry. Checking again reuses the
+		// attempt's idempotency key and cannot charge twice.
+		if err == nil && charge != nil && charge.Status == payment.StatusPending {
+			next := now.Add(dunningPendingRecheck)
+			run.NextAttemptAt = &next
+			if err := s.repo.Update(ctx, run); err != nil {
+				return err
+			}
+
+			logger.Info("Dunning retry pending",
+				zap.Int64("dunning_run_id", run.ID),
+				zap.Int32("attempt", attempt),
+				zap.Time("next_attempt_at", next))
+			return nil
+		}
+
+		run.AttemptCount = attempt
+		message := "payment not collected"
+		if err != nil {
+			message = err.Error()
+		} else if charge != nil && charge.FailureMessage != "" {
+			message = charge.FailureMessage
+		}
+		run.LastFailureMessage = &message
+
+		if int(run.AttemptCount) < len(run.RetryDays) {
+			next := run.StartedAt.AddDate(0, 0, run.RetryDays[run.AttemptCount])
+			if next.Before(now) {
+				next = now
+			}
+			run.NextAttemptAt = &next
+
+			if err := s.repo.Update(ctx, run); err != nil {
+				return err
+			}
+
+			logger.Info("Dunning retry failed",
+				zap.Int64("dunning_run_id", run.ID),
+				zap.Int32("attempt", run.AttemptCount),
+				zap.Time("next_attempt_at", next))
+
+			s.publishEvent(ctx, contracts.DunningEventAttemptFailed, run, invoice)
+			return nil
+		}
+	}
+
+	return s.exhaust(ctx, run, invoice)
+}
+
+func (s *dunningService) exhaust(ctx context.Context, run *domain.DunningRun, invoice *domain.Invoice) error {
+	// Save the final attempt first so a failed cancellation is retried
+	// without charging again
+	if err := s.repo.Update(ctx, run); err != nil {
+		return err
+	}
+
+	if run.SubscriptionID != nil {
+		subscription, err := s.subscriptionRepo.GetByID(ctx, *run.SubscriptionID)
+		if err != nil {
+			return err
+		}
+		if subscription.Status != "cancelled" && subscription.Status != "expired" {
+			if err := s.subscriptions.Cancel(ctx, subscription.ID, dunningCancellationReason); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := s.close(ctx, run, domain.DunningStatusExhausted); err != nil {
+		return err
+	}
+
+	logger.Info("Dunning exhausted, subscription cancelled",
+		zap.Int64("dunning_run_id", run.ID),
+		zap.Int64("invoice_id", invoice.ID))
+
+	s.publishEvent(ctx, contracts.DunningEventExhausted, run, invoice)
+	return nil
+}
+
+func (s *dunningService) recover(ctx context.Context, run *domain.DunningRun, invoice *domain.Invoice) error {
+	if err := s.close(ctx, run, domain.DunningStatusRecovered); err != nil {
+		return err
+	}
+
+	if run.SubscriptionID != nil {
+		subscription, err := s.subscriptionRepo.GetByID(ctx, *run.SubscriptionID)
+		if err != nil {
+			return err
+		}
+		if subscription.Status == "past_due" {
+			if err := s.subscriptions.Reactivate(ctx, subscription.ID); err != nil {
+				logger.Warn("Failed to reactivate subscription",
+					zap.Int64("subscription_id", subscription.ID),
+					zap.Error(err))
+			}
+		}
+	}
+
+	logger.Info("Dunning recovered",
+		zap.Int64("dunning_run_id", run.ID),
+		zap.Int64("invoice_id", invoice.ID))
+
+	s.publishEvent(ctx, contracts.DunningEventRecovered, run, invoice)
+	return nil
+}
+
+// ReleaseTenant closes the runs of a tenant that tenant-service is about to
+// purge; nobody is left to charge once its subscriptions are cancelled. The
+// invoices and runs themselves are kept. tenant-service purges the tenant
+// once dunning.event.tenant_released arrives.
+func (s *dunningService) ReleaseTenant(ctx context.Context, tenantID int64) error {
+	if tenantID <= 0 {
+		return errors.New("invalid tenant ID")
+	}
+
+	closed, err := s.repo.CloseByTenant(ctx, tenantID)
+	if err != nil {
+		return err
+	}
+	if closed > 0 {
+		logger.Info("Closed dunning runs of purged tenant",
+			zap.Int64("tenant_id", tenantID),
+			zap.Int64("count", closed))
+	}
+
+	s.publishTenantReleased(ctx, tenantID)
+	return nil
+}
+
+func (s *dunningService) close(ctx context.Context, run *domain.DunningRun, status string) error {
+	now := time.Now()
+	run.Status = status
+	run.NextAttemptAt = nil
+	run.ClosedAt = &now
+	return s.repo.Update(ctx, run)
+}
+
+// retryDays returns the tenant's schedule, falling back to the default when
+// the setting is missing or invalid
+func (s *dunningService) retryDays(ctx context.Context, tenantID int64) ([]int, error) {
+	value, err := s.settingRepo.GetValue(ctx, tenantID, domain.TenantSettingDunningRetryDays)
+	if err != nil {
+		return nil, err
+	}
+	if value == nil {
+		return s.defaultRetryDays, nil
+	}
+
+	var days []int
+	if err := json.Unmarshal(value, &days); err == nil {
+		if err := validateRetryDays(days); err == nil {
+			return days, nil
+		}
+	}
+
+	logger.Warn("Ignoring invalid dunning retry schedule",
+		zap.Int64("tenant_id", tenantID),
+		zap.String("value", string(value)))
+	return s.defaultRetryDays, nil
+}
+
+// publishEvent sends a dunning event carrying what the notification-service
+// needs for the dunning email
+func (s *dunningService) publishEvent(ctx context.Context, routingKey string, run *domain.DunningRun, invoice *domain.Invoice) {
+	if s.publisher == nil {
+		return
+	}
+
+	eventData := map[string]interface{}{
+		"dunning_run_id":  run.ID,
+		"invoice_id":      invoice.ID,
+		"invoice_uuid":    invoice.UUID,
+		"invoice_number":  util.StringValue(invoice.Number),
+		"subscription_id": run.SubscriptionID,
+		"tenant_id":       run.TenantID,
+		"status":          run.Status,
+		"amount":          invoice.Total,
+		"attempt":         run.AttemptCount,
+		"max_attempts":    len(run.RetryDays),
+		"next_attempt_at": util.TimeToUnix(run.NextAttemptAt),
+		"failure_message": util.StringValue(run.LastFailureMessage),
+	}
+
+	if currency, err := s.currencyRepo.GetByID(ctx, invoice.CurrencyID); err == nil {
+		eventData["currency"] = currency.Code
+	}
+	if invoice.UserID != nil {
+		contact, err := s.userRepo.GetContact(ctx, *invoice.UserID)
+		if err != nil {
+			logger.Warn("Failed to load billing contact",
+				zap.Int64("user_id", *invoice.UserID),
+				zap.Error(err))
+		} else {
+			eventData["user_id"] = contact.UserID
+			eventData["email"] = contact.Email
+			eventData["name"] = contact.Name
+		}
+	}
+
+	dataBytes, _ := json.Marshal(eventData)
+	message := contracts.AmqpMessage{
+		OwnerID: fmt.Sprintf("%d", run.ID),
+		Data:    dataBytes,
+	}
+
+	if err := s.publisher.Publish(ctx, routingKey, message); err != nil {
+		logger.Error("Failed to publish dunning event",
+			zap.String("routing_key", routingKey),
+			zap.Int64("dunning_run_id", run.ID),
+			zap.Error(err))
+	} else {
+		logger.Info("Published dunning event",
+			zap.String("routing_key", routingKey),
+			zap.Int64("dunning_run_id", run.ID),
+			zap.Int32("attempt", run.AttemptCount))
+	}
+}
+
+// publishTenantReleased tells tenant-service the tenant's dunning runs are
+// closed. When it is lost, the next purge request releases the tenant again.
+func (s *dunningService) publishTenantReleased(ctx context.Context, tenantID int64) {
+	if s.publisher == nil {
+		return
+	}
+
+	dataBytes, _ := json.Marshal(map[string]interface{}{"tenant_id": tenantID})
+	message := contracts.AmqpMessage{
+		OwnerID: fmt.Sprintf("%d", tenantID),
+		Data:    dataBytes,
+	}
+
+	if err := s.publisher.Publish(ctx, contracts.DunningEventTenantReleased, message); err != nil {
+		logger.Error("Failed to publish tenant released event",
+			zap.Int64("tenant_id", tenantID),
+			zap.Error(err))
+	} else {
+		logger.Info("Published tenant released event",
+			zap.Int64("tenant_id", tenantID))
+	}
+}
+
+// ParseRetryDays parses a comma separated retry schedule such as "1,3,7"
+func ParseRetryDays(raw string) ([]int, error) {
+	days := make([]int, 0)
+	for _, part := range strings.Split(raw, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		day, err := strconv.Atoi(part)
+		if err != nil {
+			return nil, fmt.Errorf("invalid retry day: %s", part)
+		}
+		days = append(days, day)
+	}
+
+	if err := validateRetryDays(days); err != nil {
+		return nil, err
+	}
+	return days, nil
+}
+
+// validateRetryDays requires positive, strictly increasing days
+func validateRetryDays(days []int) error {
+	previous := 0
+	for _, day := range days {
+		if day <= previous {
+			return errors.New("retry days must be positive and increasing")
+		}
+		previous = day
+	}
+	return nil
+}
@@ -0,0 +1,243 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/damarteplok/damar-admin-cms/services/billing-service/internal/domain"
+	"github.com/damarteplok/damar-admin-cms/shared/payment"
+)
+
+// fakeInvoices charges like a provider that honours idempotency keys: a key
+// it has seen gets its first result back
+type fakeInvoices struct {
+	domain.InvoiceService
+	invoice  *domain.Invoice
+	outcomes []string
+	results  map[string]string
+	keys     []string
+}
+
+func (f *fakeInvoices) GetByID(ctx context.Context, id int64) (*domain.Invoice, error) {
+	return f.current(), nil
+}
+
+func (f *fakeInvoices) Retry(ctx context.Context, id int64, attempt int32) (*domain.Invoice, *payment.Charge, error) {
+	key := f.invoice.UUID + ":dunning:" + strconv.FormatInt(int64(attempt), 10)
+	status, seen := f.results[key]
+	if !seen {
+		status = f.outcomes[0]
+		f.outcomes = f.outcomes[1:]
+		f.results[key] = status
+		f.keys = append(f.keys, key)
+	}
+
+	switch status {
+	case payment.StatusSucceeded:
+		f.invoice.Status = domain.InvoiceStatusPaid
+		return f.current(), &payment.Charge{ID: key, Status: status}, nil
+	case payment.StatusPending:
+		return f.current(), &payment.Charge{ID: key, Status: status}, nil
+	default:
+		return nil, nil, errors.New("payment failed: card declined")
+	}
+}
+
+func (f *fakeInvoices) current() *domain.Invoice {
+	copied := *f.invoice
+	return &copied
+}
+
+type fakeDunningRepo struct {
+	domain.DunningRepository
+}
+
+func (f *fakeDunningRepo) Update(ctx context.Context, run *domain.DunningRun) error {
+	return nil
+}
+
+type fakeBillableSubscriptions struct {
+	domain.SubscriptionRepository
+	subscription *domain.BillableSubscription
+	usage        int64
+	discounts    []*domain.BillableDiscount
+}
+
+func (f *fakeBillableSubscriptions) GetByID(ctx context.Context, id int64) (*domain.BillableSubscription, error) {
+	return f.subscription, nil
+}
+
+func (f *fakeBillableSubscriptions) UsageQuantity(ctx context.Context, subscriptionID, meterID int64, from, to time.Time) (int64, error) {
+	return f.usage, nil
+}
+
+func (f *fakeBillableSubscriptions) GetBillableDiscounts(ctx context.Context, subscriptionID int64, periodStart time.Time) ([]*domain.BillableDiscount, error) {
+	return f.discounts, nil
+}
+
+type fakeSettings struct{}
+
+func (fakeSettings) GetValue(ctx context.Context, tenantID int64, key string) (json.RawMessage, error) {
+	return nil, nil
+}
+
+type fakeLifecycle struct {
+	subscription *domain.BillableSubscription
+	cancelled    bool
+	reactivated  bool
+}
+
+func (f *fakeLifecycle) MarkPastDue(ctx context.Context, id int64) error {
+	f.subscription.Status = "past_due"
+	return nil
+}
+
+func (f *fakeLifecycle) Reactivate(ctx context.Context, id int64) error {
+	f.reactivated = true
+	f.subscription.Status = "active"
+	return nil
+}
+
+func (f *fakeLifecycle) Cancel(ctx context.Context, id int64, reason string) error {
+	f.cancelled = true
+	f.subscription.Status = "cancelled"
+	return nil
+}
+
+type dunningFixture struct {
+	service   *dunningService
+	invoices  *fakeInvoices
+	lifecycle *fakeLifecycle
+	run       *domain.DunningRun
+}
+
+func newDunningFixture(retryDays []int, outcomes ...string) *dunningFixture {
+	subscriptionID := int64(7)
+	subscription := &domain.BillableSubscription{ID: subscriptionID, TenantID: 3, Status: "past_due"}
+	invoices := &fakeInvoices{
+		invoice: &domain.Invoice{
+			ID:             11,
+			UUID:           "invoice-uuid",
+			TenantID:       3,
+			SubscriptionID: &subscriptionID,
+			Status:         domain.InvoiceStatusOpen,
+		},
+		outcomes: outcomes,
+		results:  make(map[string]string),
+	}
+	lifecycle := &fakeLifecycle{subscription: subscription}
+
+	return &dunningFixture{
+		service: &dunningService{
+			repo:             &fakeDunningRepo{},
+			invoiceService:   invoices,
+			subscriptionRepo: &fakeBillableSubscriptions{subscription: subscription},
+			settingRepo:      fakeSettings{},
+			subscriptions:    lifecycle,
+			defaultRetryDays: retryDays,
+			batchSize:        50,
+		},
+		invoices:  invoices,
+		lifecycle: lifecycle,
+		run: &domain.DunningRun{
+			ID:             5,
+			InvoiceID:      11,
+			SubscriptionID: &subscriptionID,
+			TenantID:       3,
+			Status:         domain.DunningStatusActive,
+			RetryDays:      retryDays,
+			StartedAt:      time.Now().AddDate(0, 0, -1),
+		},
+	}
+}
+
+func TestDunningRetryRecoversAfterDecline(t *testing.T) {
+	f := newDunningFixture([]int{1, 3, 7}, payment.StatusFailed, payment.StatusSucceeded)
+	ctx := context.Background()
+
+	if err := f.service.retry(ctx, f.run); err != nil {
+		t.Fatalf("first retry() error = %v", err)
+	}
+	if f.run.Status != domain.DunningStatusActive || f.run.AttemptCount != 1 {
+		t.Fatalf("after decline: status %s, attempts %d, want active, 1", f.run.Status, f.run.AttemptCount)
+	}
+	wantNext := f.run.StartedAt.AddDate(0, 0, 3)
+	if f.run.NextAttemptAt == nil || !f.run.NextAttemptAt.Equal(wantNext) {
+		t.Errorf("after decline: next attempt %v, want %v", f.run.NextAttemptAt, wantNext)
+	}
+
+	if err := f.service.retry(ctx, f.run); err != nil {
+		t.Fatalf("second retry() error = %v", err)
+	}
+	if f.run.Status != domain.DunningStatusRecovered || f.run.AttemptCount != 2 {
+		t.Errorf("after success: status %s, attempts %d, want recovered, 2", f.run.Status, f.run.AttemptCount)
+	}
+	if !f.lifecycle.reactivated || f.lifecycle.cancelled {
+		t.Errorf("subscription reactivated %v, cancelled %v, want true, false", f.lifecycle.reactivated, f.lifecycle.cancelled)
+	}
+	if len(f.invoices.keys) != 2 || f.invoices.keys[0] == f.invoices.keys[1] {
+		t.Errorf("idempotency keys = %v, want one per attempt", f.invoices.keys)
+	}
+}
+
+func TestDunningRetryPendingKeepsAttempt(t *testing.T) {
+	f := newDunningFixture([]int{1, 3}, payment.StatusPending)
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		if err := f.service.retry(ctx, f.run); err != nil {
+			t.Fatalf("retry() error = %v", err)
+		}
+		if f.run.Status != domain.DunningStatusActive || f.run.AttemptCount != 0 {
+			t.Fatalf("pending: status %s, attempts %d, want active, 0", f.run.Status, f.run.AttemptCount)
+		}
+		if f.run.NextAttemptAt == nil || time.Until(*f.run.NextAttemptAt) < dunningPendingRecheck-time.Minute {
+			t.Errorf("pending: next attempt %v, want about %v from now", f.run.NextAttemptAt, dunningPendingRecheck)
+		}
+	}
+
+	if len(f.invoices.keys) != 1 {
+		t.Errorf("charges = %v, want the pending one checked again", f.invoices.keys)
+	}
+	if f.lifecycle.cancelled {
+		t.Error("subscription cancelled while a charge is pending")
+	}
+}
+
+func TestDunningRetryExhaustion(t *testing.T) {
+	tests := []struct {
+		name      string
+		retryDays []int
+		outcomes  []string
+		retries   int
+		charges   int
+	}{
+		{"every retry declined", []int{1, 3}, []string{payment.StatusFailed, payment.StatusFailed}, 2, 2},
+		{"no retries configured", []int{}, nil, 1, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newDunningFixture(tt.retryDays, tt.outcomes...)
+			for i := 0; i < tt.retries; i++ {
+				if err := f.service.retry(context.Background(), f.run); err != nil {
+					t.Fatalf("retry() error = %v", err)
+				}
+			}
+
+			if f.run.Status != domain.DunningStatusExhausted || f.run.NextAttemptAt != nil {
+				t.Errorf("status %s, next attempt %v, want exhausted, nil", f.run.Status, f.run.NextAttemptAt)
+			}
+			if !f.lifecycle.cancelled {
+				t.Error("subscription not cancelled")
+			}
+			if len(f.invoices.keys) != tt.charges {
+				t.Errorf("charges = %d, want %d", len(f.invoices.keys), tt.charges)
+			}
+		})
+	}
+}
@@ -0,0 +1,168 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/damarteplok/damar-admin-cms/services/billing-service/internal/domain"
+	"github.com/damarteplok/damar-admin-cms/shared/contracts"
+	"github.com/damarteplok/damar-admin-cms/shared/logger"
+	"github.com/damarteplok/damar-admin-cms/shared/payment"
+	"go.uber.org/zap"
+)
+
+// Pay charges an open invoice. The invoice UUID is the idempotency key, so
+// paying the same invoice twice through one provider never charges twice.
+// Pending charges leave the invoice open until the provider confirms them.
+func (s *invoiceService) Pay(ctx context.Context, id int64, providerSlug, paymentMethodID string) (*domain.Invoice, *payment.Charge, error) {
+	return s.pay(ctx, id, providerSlug, paymentMethodID, "")
+}
+
+// Retry charges an open invoice again through the subscription's provider.
+// Each dunning attempt has its own idempotency key, since a provider that
+// remembers keys would otherwise return the first decline on every retry.
+func (s *invoiceService) Retry(ctx context.Context, id int64, attempt int32) (*domain.Invoice, *payment.Charge, error) {
+	return s.pay(ctx, id, "", "", ":dunning:"+strconv.FormatInt(int64(attempt), 10))
+}
+
+// pay charges the invoice with the invoice UUID plus keySuffix as the
+// idempotency key
+func (s *invoiceService) pay(ctx context.Context, id int64, providerSlug, paymentMethodID, keySuffix string) (*domain.Invoice, *payment.Charge, error) {
+	invoice, err := s.GetByID(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if invoice.Status != domain.InvoiceStatusOpen {
+		return nil, nil, fmt.Errorf("cannot pay invoice in status %s", invoice.Status)
+	}
+
+	// Nothing to collect once discounts cover the whole invoice
+	if invoice.Total <= 0 {
+		invoice, err = s.MarkPaid(ctx, invoice.ID)
+		return invoice, nil, err
+	}
+
+	provider, implementation, err := s.resolveProvider(ctx, invoice, providerSlug)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	currency, err := s.currencyRepo.GetByID(ctx, invoice.CurrencyID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	charge, err := implementation.Charge(ctx, payment.ChargeRequest{
+		Amount:          invoice.Total,
+		Currency:        currency.Code,
+		Description:     invoiceDescription(invoice),
+		PaymentMethodID: paymentMethodID,
+		IdempotencyKey:  invoice.UUID + keySuffix,
+		Metadata: map[string]string{
+			"invoice_uuid": invoice.UUID,
+			"tenant_id":    strconv.FormatInt(invoice.TenantID, 10),
+		},
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to charge invoice: %w", err)
+	}
+
+	invoice.PaymentProviderID = &provider.ID
+	invoice.PaymentProviderChargeID = &charge.ID
+
+	switch charge.Status {
+	case payment.StatusSucceeded:
+		now := time.Now()
+		invoice.Status = domain.InvoiceStatusPaid
+		invoice.PaidAt = &now
+		if err := s.repo.UpdateStatus(ctx, invoice); err != nil {
+			return nil, nil, err
+		}
+		s.publishEvent(ctx, contracts.InvoiceEventPaid, invoice)
+
+	case payment.StatusFailed:
+		if err := s.repo.UpdateStatus(ctx, invoice); err != nil {
+			return nil, nil, err
+		}
+		s.publishEvent(ctx, contracts.InvoiceEventPaymentFailed, invoice)
+		return nil, nil, fmt.Errorf("payment failed: %s", charge.FailureMessage)
+
+	default:
+		if err := s.repo.UpdateStatus(ctx, invoice); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	logger.Info("Charged invoice",
+		zap.Int64("invoice_id", invoice.ID),
+		zap.String("provider", provider.Slug),
+		zap.String("charge_id", charge.ID),
+		zap.String("charge_status", charge.Status))
+
+	return invoice, charge, nil
+}
+
+func (s *invoiceService) Collect(ctx context.Context, id int64) (*domain.Invoice, *payment.Charge, error) {
+	invoice, err := s.GetByID(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if invoice.Status == domain.InvoiceStatusDraft {
+		if _, err := s.Finalize(ctx, invoice.ID); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return s.Pay(ctx, invoice.ID, "", "")
+}
+
+// resolveProvider picks the requested provider, else the one the
+// subscription was set up with, else manual
+func (s *invoiceService) resolveProvider(ctx context.Context, invoice *domain.Invoice, slug string) (*domain.PaymentProvider, payment.PaymentProvider, error) {
+	if s.providers == nil {
+		return nil, nil, errors.New("no payment providers configured")
+	}
+
+	var provider *domain.PaymentProvider
+	var err error
+	switch {
+	case slug != "":
+		provider, err = s.providerRepo.GetBySlug(ctx, slug)
+	case invoice.SubscriptionID != nil:
+		subscription, subErr := s.subscriptionRepo.GetByID(ctx, *invoice.SubscriptionID)
+		if subErr != nil {
+			return nil, nil, subErr
+		}
+		if subscription.PaymentProviderID != nil {
+			provider, err = s.providerRepo.GetByID(ctx, *subscription.PaymentProviderID)
+		} else {
+			provider, err = s.providerRepo.GetBySlug(ctx, payment.SlugManual)
+		}
+	default:
+		provider, err = s.providerRepo.GetBySlug(ctx, payment.SlugManual)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	if !provider.IsActive {
+		return nil, nil, fmt.Errorf("payment provider %s is not active", provider.Slug)
+	}
+
+	implementation, err := s.providers.Get(provider.Slug)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return provider, implementation, nil
+}
+
+func invoiceDescription(invoice *domain.Invoice) string {
+	if invoice.Number != nil {
+		return "Invoice " + *invoice.Number
+	}
+	return "Invoice " + invoice.UUID
+}
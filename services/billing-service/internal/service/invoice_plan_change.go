@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/damarteplok/damar-admin-cms/services/billing-service/internal/domain"
+)
+
+// planChangeMetadata marks the invoice a plan change produced, so a
+// redelivered event returns it
+type planChangeMetadata struct {
+	PlanChangeAt int64 `json:"plan_change_at"`
+}
+
+// GenerateForPlanChange invoices an immediate plan change. Periods are
+// invoiced once they end, so the change is invoiced as the period it falls
+// in: the old plan up to the change and the new plan after it. The renewal
+// that ends the period finds this invoice and bills nothing more. When the
+// period has been invoiced already, by an earlier change or by hand, only
+// the credit and charge of this change are invoiced, which may leave a
+// negative total.
+func (s *invoiceService) GenerateForPlanChange(ctx context.Context, subscriptionID int64, change *domain.PlanChange) (*domain.Invoice, error) {
+	if subscriptionID <= 0 {
+		return nil, errors.New("invalid subscription ID")
+	}
+	// Trials change plan without proration
+	if change.UsedAmount == 0 && change.CreditAmount == 0 && change.ChargeAmount == 0 {
+		return nil, nil
+	}
+	if !change.PeriodEnd.After(change.PeriodStart) {
+		return nil, errors.New("period end must be after period start")
+	}
+
+	subscription, err := s.subscriptionRepo.GetByID(ctx, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+	previousPlan, err := s.subscriptionRepo.GetPlan(ctx, change.PreviousPlanID)
+	if err != nil {
+		return nil, err
+	}
+	plan, err := s.subscriptionRepo.GetPlan(ctx, change.PlanID)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata, err := json.Marshal(planChangeMetadata{PlanChangeAt: change.EffectiveAt.Unix()})
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.repo.GetBySubscriptionPeriod(ctx, subscription.ID, change.PeriodStart)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		lineItems := make([]*domain.InvoiceLineItem, 0, 2)
+		if change.PreviousPeriodStart != nil {
+			lineItems = appendProration(lineItems, previousPlan.Name, *change.PreviousPeriodStart, change.EffectiveAt, change.UsedAmount)
+		}
+		lineItems = appendProration(lineItems, plan.Name, change.EffectiveAt, change.PeriodEnd, change.ChargeAmount)
+
+		var subtotal int64
+		for _, item := range lineItems {
+			subtotal += item.Amount
+		}
+		discountItems, err := s.buildDiscountItems(ctx, subscription, change.PeriodStart, subtotal)
+		if err != nil {
+			return nil, err
+		}
+
+		return s.create(ctx, subscription, change.PeriodStart, change.PeriodEnd, append(lineItems, discountItems...), metadata)
+	}
+	if isPlanChangeInvoice(existing, change.EffectiveAt) {
+		return existing, nil
+	}
+
+	// The period is invoiced already; this change is billed on its own
+	existing, err = s.repo.GetBySubscriptionPeriod(ctx, subscription.ID, change.EffectiveAt)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return existing, nil
+	}
+
+	lineItems := make([]*domain.InvoiceLineItem, 0, 2)
+	lineItems = appendProration(lineItems, "Unused time on "+previousPlan.Name, change.EffectiveAt, change.PeriodEnd, -change.CreditAmount)
+	lineItems = appendProration(lineItems, plan.Name, change.EffectiveAt, change.PeriodEnd, change.ChargeAmount)
+
+	return s.create(ctx, subscription, change.EffectiveAt, change.PeriodEnd, lineItems, metadata)
+}
+
+// appendProration adds a proration line for name over [from, to), unless
+// amount is zero
+func appendProration(lineItems []*domain.InvoiceLineItem, name string, from, to time.Time, amount int64) []*domain.InvoiceLineItem {
+	if amount == 0 {
+		return lineItems
+	}
+	return append(lineItems, &domain.InvoiceLineItem{
+		Type:        domain.LineItemTypeProration,
+		Description: fmt.Sprintf("%s (%s - %s)", name, from.Format("2006-01-02"), to.Format("2006-01-02")),
+		Quantity:    1,
+		UnitAmount:  amount,
+		Amount:      amount,
+	})
+}
+
+func isPlanChangeInvoice(invoice *domain.Invoice, effectiveAt time.Time) bool {
+	var metadata planChangeMetadata
+	if len(invoice.Metadata) == 0 || json.Unmarshal(invoice.Metadata, &metadata) != nil {
+		return false
+	}
+	return metadata.PlanChangeAt == effectiveAt.Unix()
+}
@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/damarteplok/damar-admin-cms/services/billing-service/internal/domain"
+	"github.com/damarteplok/damar-admin-cms/shared/pricing"
+)
+
+func newPlanChangeSubscription(start, end time.Time) *domain.BillableSubscription {
+	return &domain.BillableSubscription{
+		ID:            7,
+		TenantID:      3,
+		PlanID:        2,
+		PlanName:      "Plan 2",
+		PriceType:     pricing.TypeFlatRate,
+		Price:         2000,
+		Quantity:      1,
+		EndsAt:        &end,
+		BillingAnchor: &start,
+		IntervalSlug:  "monthly",
+		IntervalCount: 1,
+	}
+}
+
+func TestGenerateForPlanChange(t *testing.T) {
+	start := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	changedAt := time.Date(2026, 3, 11, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+	yearEnd := changedAt.AddDate(1, 0, 0)
+
+	tests := []struct {
+		name        string
+		change      domain.PlanChange
+		wantStart   time.Time
+		wantAmounts []int64
+		wantTotal   int64
+	}{
+		{
+			name: "upgrade within the period",
+			change: domain.PlanChange{
+				PreviousPlanID: 1, PlanID: 2, EffectiveAt: changedAt,
+				PreviousPeriodStart: &start, PeriodStart: start, PeriodEnd: end,
+				UsedAmount: 323, CreditAmount: 677, ChargeAmount: 1355,
+			},
+			wantStart:   start,
+			wantAmounts: []int64{323, 1355},
+			wantTotal:   1678,
+		},
+		{
+			name: "downgrade within the period",
+			change: domain.PlanChange{
+				PreviousPlanID: 2, PlanID: 1, EffectiveAt: changedAt,
+				PreviousPeriodStart: &start, PeriodStart: start, PeriodEnd: end,
+				UsedAmount: 645, CreditAmount: 1355, ChargeAmount: 677,
+			},
+			wantStart:   start,
+			wantAmounts: []int64{645, 677},
+			wantTotal:   1322,
+		},
+		{
+			name: "change to a yearly plan starts a new period",
+			change: domain.PlanChange{
+				PreviousPlanID: 1, PlanID: 2, EffectiveAt: changedAt,
+				PreviousPeriodStart: &start, PeriodStart: changedAt, PeriodEnd: yearEnd,
+				UsedAmount: 323, CreditAmount: 677, ChargeAmount: 9000,
+			},
+			wantStart:   changedAt,
+			wantAmounts: []int64{323, 9000},
+			wantTotal:   9323,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, repo := newTestInvoiceService(newPlanChangeSubscription(start, tt.change.PeriodEnd), &fakeBillableSubscriptions{})
+
+			invoice, err := service.GenerateForPlanChange(context.Background(), 7, &tt.change)
+			if err != nil || invoice == nil {
+				t.Fatalf("GenerateForPlanChange() = %v, %v, want an invoice", invoice, err)
+			}
+			if !invoice.PeriodStart.Equal(tt.wantStart) || !invoice.PeriodEnd.Equal(tt.change.PeriodEnd) {
+				t.Errorf("period = %v - %v, want %v - %v", invoice.PeriodStart, invoice.PeriodEnd, tt.wantStart, tt.change.PeriodEnd)
+			}
+			if len(invoice.LineItems) != len(tt.wantAmounts) {
+				t.Fatalf("line items = %d, want %d", len(invoice.LineItems), len(tt.wantAmounts))
+			}
+			for i, item := range invoice.LineItems {
+				if item.Type != domain.LineItemTypeProration || item.Amount != tt.wantAmounts[i] {
+					t.Errorf("line item %d = %s %d, want proration %d", i, item.Type, item.Amount, tt.wantAmounts[i])
+				}
+			}
+			if invoice.Total != tt.wantTotal {
+				t.Errorf("total = %d, want %d", invoice.Total, tt.wantTotal)
+			}
+
+			// A redelivered event and the renewal that ends the period get
+			// this invoice back
+			again, err := service.GenerateForPlanChange(context.Background(), 7, &tt.change)
+			if err != nil || again.ID != invoice.ID {
+				t.Errorf("GenerateForPlanChange() again = %v, %v, want invoice %d", again, err, invoice.ID)
+			}
+			renewed, err := service.GenerateForEndedPeriod(context.Background(), 7, &domain.EndedPeriod{
+				Start: tt.wantStart, End: tt.change.PeriodEnd, PlanID: 2, Quantity: 1, Price: 2000, PriceType: pricing.TypeFlatRate,
+			})
+			if err != nil || renewed.ID != invoice.ID || repo.created != 1 {
+				t.Errorf("GenerateForEndedPeriod() = %v, %v, created %d, want the plan change invoice", renewed, err, repo.created)
+			}
+		})
+	}
+}
+
+func TestGenerateForPlanChangeInInvoicedPeriod(t *testing.T) {
+	start := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	firstChange := time.Date(2026, 3, 11, 0, 0, 0, 0, time.UTC)
+	secondChange := time.Date(2026, 3, 21, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+	service, repo := newTestInvoiceService(newPlanChangeSubscription(start, end), &fakeBillableSubscriptions{})
+	ctx := context.Background()
+
+	first, err := service.GenerateForPlanChange(ctx, 7, &domain.PlanChange{
+		PreviousPlanID: 1, PlanID: 2, EffectiveAt: firstChange,
+		PreviousPeriodStart: &start, PeriodStart: start, PeriodEnd: end,
+		UsedAmount: 323, CreditAmount: 677, ChargeAmount: 1355,
+	})
+	if err != nil {
+		t.Fatalf("GenerateForPlanChange() error = %v", err)
+	}
+
+	// Moving back down credits the rest of the upgrade
+	second, err := service.GenerateForPlanChange(ctx, 7, &domain.PlanChange{
+		PreviousPlanID: 2, PlanID: 1, EffectiveAt: secondChange,
+		PreviousPeriodStart: &start, PeriodStart: start, PeriodEnd: end,
+		UsedAmount: 1355, CreditAmount: 645, ChargeAmount: 323,
+	})
+	if err != nil {
+		t.Fatalf("GenerateForPlanChange() second error = %v", err)
+	}
+	if second.ID == first.ID || repo.created != 2 {
+		t.Fatalf("second change returned invoice %d, created %d, want a separate invoice", second.ID, repo.created)
+	}
+	if !second.PeriodStart.Equal(secondChange) || second.Total != 323-645 {
+		t.Errorf("second invoice starts %v with total %d, want %v and %d", second.PeriodStart, second.Total, secondChange, 323-645)
+	}
+}
+
+func TestGenerateForPlanChangeDuringTrial(t *testing.T) {
+	start := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 1, 0)
+	service, repo := newTestInvoiceService(newPlanChangeSubscription(start, end), &fakeBillableSubscriptions{})
+
+	invoice, err := service.GenerateForPlanChange(context.Background(), 7, &domain.PlanChange{
+		PreviousPlanID: 1, PlanID: 2, EffectiveAt: start, PeriodStart: start, PeriodEnd: end,
+	})
+	if err != nil || invoice != nil || repo.created != 0 {
+		t.Errorf("GenerateForPlanChange() = %v, %v, created %d, want nothing billed", invoice, err, repo.created)
+	}
+}
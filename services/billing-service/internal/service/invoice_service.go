@@ -0,0 +1,488 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/damarteplok/damar-admin-cms/services/billing-service/internal/domain"
+	"github.com/damarteplok/damar-admin-cms/shared/amqp"
+	"github.com/damarteplok/damar-admin-cms/shared/contracts"
+	"github.com/damarteplok/damar-admin-cms/shared/logger"
+	"github.com/damarteplok/damar-admin-cms/shared/payment"
+	"github.com/damarteplok/damar-admin-cms/shared/pricing"
+	"github.com/damarteplok/damar-admin-cms/shared/util"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type invoiceService struct {
+	repo             domain.InvoiceRepository
+	subscriptionRepo domain.SubscriptionRepository
+	providerRepo     domain.PaymentProviderRepository
+	currencyRepo     domain.CurrencyRepository
+	providers        *payment.Registry
+	publisher        *amqp.Publisher
+	numberPrefix     string
+	dueDays          int
+}
+
+func NewInvoiceService(
+	repo domain.InvoiceRepository,
+	subscriptionRepo domain.SubscriptionRepository,
+	providerRepo domain.PaymentProviderRepository,
+	currencyRepo domain.CurrencyRepository,
+	providers *payment.Registry,
+	publisher *amqp.Publisher,
+	numberPrefix string,
+	dueDays int,
+) domain.InvoiceService {
+	if numberPrefix == "" {
+		numberPrefix = "INV"
+	}
+	if dueDays < 0 {
+		dueDays = 0
+	}
+
+	return &invoiceService{
+		repo:             repo,
+		subscriptionRepo: subscriptionRepo,
+		providerRepo:     providerRepo,
+		currencyRepo:     currencyRepo,
+		providers:        providers,
+		publisher:        publisher,
+		numberPrefix:     numberPrefix,
+		dueDays:          dueDays,
+	}
+}
+
+func (s *invoiceService) GenerateForSubscription(ctx context.Context, subscriptionID int64, periodStart, periodEnd *time.Time) (*domain.Invoice, error) {
+	if subscriptionID <= 0 {
+		return nil, errors.New("invalid subscription ID")
+	}
+	if (periodStart == nil) != (periodEnd == nil) {
+		return nil, errors.New("period start and period end must be given together")
+	}
+
+	subscription, err := s.subscriptionRepo.GetByID(ctx, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	var start, end time.Time
+	if periodStart != nil {
+		start, end = *periodStart, *periodEnd
+		if !end.After(start) {
+			return nil, errors.New("period end must be after period start")
+		}
+	} else {
+		start, end, err = lastCompletedPeriod(subscription, time.Now())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return s.generate(ctx, subscription, start, end)
+}
+
+func (s *invoiceService) GenerateForEndedPeriod(ctx context.Context, subscriptionID int64, period *domain.EndedPeriod) (*domain.Invoice, error) {
+	if subscriptionID <= 0 {
+		return nil, errors.New("invalid subscription ID")
+	}
+	if !period.End.After(period.Start) {
+		return nil, errors.New("period end must be after period start")
+	}
+
+	subscription, err := s.subscriptionRepo.GetByID(ctx, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if period.PlanID != subscription.PlanID {
+		plan, err := s.subscriptionRepo.GetPlan(ctx, period.PlanID)
+		if err != nil {
+			return nil, err
+		}
+		subscription.PlanID = plan.ID
+		subscription.PlanName = plan.Name
+		subscription.MeterID = plan.MeterID
+	}
+	subscription.Quantity = period.Quantity
+	subscription.Price = period.Price
+	subscription.PriceType = period.PriceType
+	subscription.PricePerUnit = period.PricePerUnit
+	subscription.PriceTiers = period.PriceTiers
+
+	return s.generate(ctx, subscription, period.Start, period.End)
+}
+
+// generate builds and stores the draft invoice of a period, or returns the
+// one that exists
+func (s *invoiceService) generate(ctx context.Context, subscription *domain.BillableSubscription, start, end time.Time) (*domain.Invoice, error) {
+	if subscription.TrialEndsAt != nil && !end.After(*subscription.TrialEndsAt) {
+		return nil, errors.New("billing period falls within the trial")
+	}
+
+	// Generating twice for the same period returns the invoice that exists
+	existing, err := s.repo.GetBySubscriptionPeriod(ctx, subscription.ID, start)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return existing, nil
+	}
+
+	lineItems, err := s.buildLineItems(ctx, subscription, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.create(ctx, subscription, start, end, lineItems, nil)
+}
+
+// create stores a draft invoice with its line items and totals
+func (s *invoiceService) create(ctx context.Context, subscription *domain.BillableSubscription, start, end time.Time, lineItems []*domain.InvoiceLineItem, metadata json.RawMessage) (*domain.Invoice, error) {
+	invoice := &domain.Invoice{
+		UUID:           uuid.New().String(),
+		TenantID:       subscription.TenantID,
+		SubscriptionID: &subscription.ID,
+		UserID:         &subscription.UserID,
+		CurrencyID:     subscription.CurrencyID,
+		Status:         domain.InvoiceStatusDraft,
+		PeriodStart:    &start,
+		PeriodEnd:      &end,
+		Metadata:       metadata,
+		LineItems:      lineItems,
+	}
+	for _, item := range lineItems {
+		if item.Type == domain.LineItemTypeDiscount {
+			invoice.DiscountTotal -= item.Amount
+		} else {
+			invoice.Subtotal += item.Amount
+		}
+	}
+	invoice.Total = invoice.Subtotal - invoice.DiscountTotal
+
+	if err := s.repo.Create(ctx, invoice); err != nil {
+		return nil, err
+	}
+
+	s.publishEvent(ctx, contracts.InvoiceEventCreated, invoice)
+	return invoice, nil
+}
+
+// buildLineItems charges the subscription price for the period, or the
+// metered usage for metered per_unit/tiered plans, then applies discounts
+func (s *invoiceService) buildLineItems(ctx context.Context, subscription *domain.BillableSubscription, start, end time.Time) ([]*domain.InvoiceLineItem, error) {
+	price, err := subscriptionPrice(subscription)
+	if err != nil {
+		return nil, err
+	}
+
+	description := fmt.Sprintf("%s (%s - %s)", subscription.PlanName, start.Format("2006-01-02"), end.Format("2006-01-02"))
+	item := &domain.InvoiceLineItem{
+		Type:        domain.LineItemTypeSubscription,
+		Description: description,
+		Quantity:    int64(subscription.Quantity),
+	}
+
+	if subscription.MeterID != nil && price.Type != pricing.TypeFlatRate {
+		usage, err := s.subscriptionRepo.UsageQuantity(ctx, subscription.ID, *subscription.MeterID, start, end)
+		if err != nil {
+			return nil, err
+		}
+		item.Type = domain.LineItemTypeUsage
+		item.Description = "Usage: " + description
+		item.Quantity = usage
+	}
+
+	if item.Quantity > 0 {
+		item.Amount, err = price.Amount(item.Quantity)
+		if err != nil {
+			return nil, err
+		}
+	}
+	switch price.Type {
+	case pricing.TypePerUnit:
+		item.UnitAmount = price.Price
+		if price.PricePerUnit != nil {
+			item.UnitAmount = *price.PricePerUnit
+		}
+	case pricing.TypeFlatRate:
+		item.Quantity = 1
+		item.UnitAmount = item.Amount
+	}
+
+	discountItems, err := s.buildDiscountItems(ctx, subscription, start, item.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]*domain.InvoiceLineItem{item}, discountItems...), nil
+}
+
+// buildDiscountItems applies the subscription's discounts for the period
+// starting at start to subtotal
+func (s *invoiceService) buildDiscountItems(ctx context.Context, subscription *domain.BillableSubscription, start time.Time, subtotal int64) ([]*domain.InvoiceLineItem, error) {
+	discounts, err := s.subscriptionRepo.GetBillableDiscounts(ctx, subscription.ID, start)
+	if err != nil {
+		return nil, err
+	}
+
+	// Percentages apply to the subtotal, not to what earlier discounts left,
+	// and the invoice never goes below zero
+	lineItems := make([]*domain.InvoiceLineItem, 0, len(discounts))
+	remaining := subtotal
+	for _, discount := range discounts {
+		if remaining <= 0 {
+			break
+		}
+
+		amount, err := pricing.DiscountAmount(discount.Type, discount.Amount, subtotal)
+		if err != nil {
+			logger.Warn("Skipping discount with unknown type",
+				zap.Int64("subscription_discount_id", discount.ID),
+				zap.String("type", discount.Type))
+			continue
+		}
+		if amount > remaining {
+			amount = remaining
+		}
+		if amount <= 0 {
+			continue
+		}
+		remaining -= amount
+
+		discountID := discount.ID
+		lineItems = append(lineItems, &domain.InvoiceLineItem{
+			Type:                   domain.LineItemTypeDiscount,
+			Description:            "Discount: " + discount.Name,
+			Quantity:               1,
+			UnitAmount:             -amount,
+			Amount:                 -amount,
+			SubscriptionDiscountID: &discountID,
+		})
+	}
+
+	return lineItems, nil
+}
+
+func (s *invoiceService) GetByID(ctx context.Context, id int64) (*domain.Invoice, error) {
+	if id <= 0 {
+		return nil, errors.New("invalid invoice ID")
+	}
+	return s.repo.GetByID(ctx, id)
+}
+
+func (s *invoiceService) GetSubscription(ctx context.Context, subscriptionID int64) (*domain.BillableSubscription, error) {
+	if subscriptionID <= 0 {
+		return nil, errors.New("invalid subscription ID")
+	}
+	return s.subscriptionRepo.GetByID(ctx, subscriptionID)
+}
+
+func (s *invoiceService) GetByNumber(ctx context.Context, tenantID int64, number string) (*domain.Invoice, error) {
+	if tenantID <= 0 {
+		return nil, errors.New("invalid tenant ID")
+	}
+	if number == "" {
+		return nil, errors.New("invoice number cannot be empty")
+	}
+	return s.repo.GetByNumber(ctx, tenantID, number)
+}
+
+func (s *invoiceService) GetByTenant(ctx context.Context, tenantID int64, status string, page, perPage int) ([]*domain.Invoice, int, error) {
+	if tenantID <= 0 {
+		return nil, 0, errors.New("invalid tenant ID")
+	}
+	if status != "" && !domain.IsValidInvoiceStatus(status) {
+		return nil, 0, fmt.Errorf("invalid status: %s", status)
+	}
+	page, perPage = normalizePagination(page, perPage)
+	return s.repo.GetByTenant(ctx, tenantID, status, page, perPage)
+}
+
+func (s *invoiceService) GetBySubscription(ctx context.Context, subscriptionID int64, page, perPage int) ([]*domain.Invoice, int, error) {
+	if subscriptionID <= 0 {
+		return nil, 0, errors.New("invalid subscription ID")
+	}
+	page, perPage = normalizePagination(page, perPage)
+	return s.repo.GetBySubscription(ctx, subscriptionID, page, perPage)
+}
+
+// Finalize issues a draft invoice: it gets the tenant's next number and a
+// due date, and its amounts can no longer change
+func (s *invoiceService) Finalize(ctx context.Context, id int64) (*domain.Invoice, error) {
+	invoice, err := s.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := domain.ValidateInvoiceTransition(invoice.Status, domain.InvoiceStatusOpen); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	dueAt := now.AddDate(0, 0, s.dueDays)
+	invoice.Status = domain.InvoiceStatusOpen
+	invoice.FinalizedAt = &now
+	invoice.DueAt = &dueAt
+
+	err = s.repo.Finalize(ctx, invoice, func(sequence int64) string {
+		return fmt.Sprintf("%s-%06d", s.numberPrefix, sequence)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishEvent(ctx, contracts.InvoiceEventFinalized, invoice)
+	return invoice, nil
+}
+
+func (s *invoiceService) MarkPaid(ctx context.Context, id int64) (*domain.Invoice, error) {
+	invoice, err := s.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := domain.ValidateInvoiceTransition(invoice.Status, domain.InvoiceStatusPaid); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	invoice.Status = domain.InvoiceStatusPaid
+	invoice.PaidAt = &now
+
+	if err := s.repo.UpdateStatus(ctx, invoice); err != nil {
+		return nil, err
+	}
+
+	s.publishEvent(ctx, contracts.InvoiceEventPaid, invoice)
+	return invoice, nil
+}
+
+func (s *invoiceService) Void(ctx context.Context, id int64, reason string) (*domain.Invoice, error) {
+	invoice, err := s.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := domain.ValidateInvoiceTransition(invoice.Status, domain.InvoiceStatusVoid); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	invoice.Status = domain.InvoiceStatusVoid
+	invoice.VoidedAt = &now
+	invoice.VoidReason = util.StringPtr(reason)
+
+	if err := s.repo.UpdateStatus(ctx, invoice); err != nil {
+		return nil, err
+	}
+
+	s.publishEvent(ctx, contracts.InvoiceEventVoided, invoice)
+	return invoice, nil
+}
+
+func (s *invoiceService) publishEvent(ctx context.Context, routingKey string, invoice *domain.Invoice) {
+	if s.publisher == nil {
+		return
+	}
+
+	eventData := map[string]interface{}{
+		"invoice_id":      invoice.ID,
+		"uuid":            invoice.UUID,
+		"tenant_id":       invoice.TenantID,
+		"subscription_id": invoice.SubscriptionID,
+		"user_id":         invoice.UserID,
+		"currency_id":     invoice.CurrencyID,
+		"number":          util.StringValue(invoice.Number),
+		"status":          invoice.Status,
+		"total":           invoice.Total,
+		"due_at":          util.TimeToUnix(invoice.DueAt),
+		"charge_id":       util.StringValue(invoice.PaymentProviderChargeID),
+	}
+	dataBytes, _ := json.Marshal(eventData)
+	message := contracts.AmqpMessage{
+		OwnerID: fmt.Sprintf("%d", invoice.ID),
+		Data:    dataBytes,
+	}
+
+	if err := s.publisher.Publish(ctx, routingKey, message); err != nil {
+		logger.Error("Failed to publish invoice event",
+			zap.String("routing_key", routingKey),
+			zap.Int64("invoice_id", invoice.ID),
+			zap.Error(err))
+	} else {
+		logger.Info("Published invoice event",
+			zap.String("routing_key", routingKey),
+			zap.Int64("invoice_id", invoice.ID),
+			zap.String("status", invoice.Status))
+	}
+}
+
+// Helper functions
+
+func normalizePagination(page, perPage int) (int, int) {
+	if page < 1 {
+		page = 1
+	}
+	if perPage < 1 {
+		perPage = 10
+	}
+	if perPage > 100 {
+		perPage = 100
+	}
+	return page, perPage
+}
+
+// lastCompletedPeriod returns the most recent period, counted from the
+// subscription's billing anchor, that ended at or before now
+func lastCompletedPeriod(subscription *domain.BillableSubscription, now time.Time) (time.Time, time.Time, error) {
+	if subscription.EndsAt == nil {
+		return time.Time{}, time.Time{}, errors.New("subscription has no billing period")
+	}
+
+	anchor := *subscription.EndsAt
+	if subscription.BillingAnchor != nil {
+		anchor = *subscription.BillingAnchor
+	}
+
+	// Period n contains now, so n-1 is the last one that has ended
+	n, err := pricing.PeriodIndex(anchor, subscription.IntervalSlug, subscription.IntervalCount, now)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	start, err := pricing.PeriodEnd(anchor, subscription.IntervalSlug, subscription.IntervalCount, n-2)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	end, err := pricing.PeriodEnd(anchor, subscription.IntervalSlug, subscription.IntervalCount, n-1)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	return start, end, nil
+}
+
+func subscriptionPrice(subscription *domain.BillableSubscription) (pricing.Price, error) {
+	price := pricing.Price{
+		Type:  subscription.PriceType,
+		Price: int64(subscription.Price),
+	}
+
+	if subscription.PricePerUnit != nil && *subscription.PricePerUnit != "" {
+		pricePerUnit, err := strconv.ParseInt(*subscription.PricePerUnit, 10, 64)
+		if err != nil {
+			return pricing.Price{}, fmt.Errorf("invalid price per unit: %s", *subscription.PricePerUnit)
+		}
+		price.PricePerUnit = &pricePerUnit
+	}
+
+	tiers, err := pricing.ParseTiers(subscription.PriceTiers)
+	if err != nil {
+		return pricing.Price{}, err
+	}
+	price.Tiers = tiers
+
+	return price, nil
+}
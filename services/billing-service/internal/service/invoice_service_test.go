@@ -0,0 +1,192 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/damarteplok/damar-admin-cms/services/billing-service/internal/domain"
+	"github.com/damarteplok/damar-admin-cms/shared/pricing"
+)
+
+// fakeInvoiceRepo stores created invoices by subscription period
+type fakeInvoiceRepo struct {
+	domain.InvoiceRepository
+	byPeriod map[time.Time]*domain.Invoice
+	created  int
+}
+
+func (f *fakeInvoiceRepo) GetBySubscriptionPeriod(ctx context.Context, subscriptionID int64, periodStart time.Time) (*domain.Invoice, error) {
+	return f.byPeriod[periodStart], nil
+}
+
+func (f *fakeInvoiceRepo) Create(ctx context.Context, invoice *domain.Invoice) error {
+	f.created++
+	invoice.ID = int64(f.created)
+	f.byPeriod[*invoice.PeriodStart] = invoice
+	return nil
+}
+
+func (f *fakeBillableSubscriptions) GetPlan(ctx context.Context, id int64) (*domain.BillablePlan, error) {
+	return &domain.BillablePlan{ID: id, Name: fmt.Sprintf("Plan %d", id)}, nil
+}
+
+func newTestInvoiceService(subscription *domain.BillableSubscription, subscriptions *fakeBillableSubscriptions) (*invoiceService, *fakeInvoiceRepo) {
+	repo := &fakeInvoiceRepo{byPeriod: make(map[time.Time]*domain.Invoice)}
+	subscriptions.subscription = subscription
+	return &invoiceService{repo: repo, subscriptionRepo: subscriptions}, repo
+}
+
+func TestGenerateForSubscription(t *testing.T) {
+	perUnit := "250"
+	meterID := int64(9)
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 1, 0)
+
+	tests := []struct {
+		name         string
+		subscription domain.BillableSubscription
+		usage        int64
+		discounts    []*domain.BillableDiscount
+		wantTypes    []string
+		wantAmounts  []int64
+		wantTotal    int64
+	}{
+		{
+			name:         "flat rate",
+			subscription: domain.BillableSubscription{PriceType: pricing.TypeFlatRate, Price: 1000, Quantity: 3},
+			wantTypes:    []string{domain.LineItemTypeSubscription},
+			wantAmounts:  []int64{1000},
+			wantTotal:    1000,
+		},
+		{
+			name:         "per unit",
+			subscription: domain.BillableSubscription{PriceType: pricing.TypePerUnit, PricePerUnit: &perUnit, Quantity: 3},
+			wantTypes:    []string{domain.LineItemTypeSubscription},
+			wantAmounts:  []int64{750},
+			wantTotal:    750,
+		},
+		{
+			name:         "metered usage",
+			subscription: domain.BillableSubscription{PriceType: pricing.TypePerUnit, PricePerUnit: &perUnit, Quantity: 1, MeterID: &meterID},
+			usage:        4,
+			wantTypes:    []string{domain.LineItemTypeUsage},
+			wantAmounts:  []int64{1000},
+			wantTotal:    1000,
+		},
+		{
+			name:         "discounts are capped at the subtotal",
+			subscription: domain.BillableSubscription{PriceType: pricing.TypeFlatRate, Price: 1000, Quantity: 1},
+			discounts: []*domain.BillableDiscount{
+				{ID: 1, Name: "ten percent", Type: pricing.DiscountTypePercentage, Amount: 10},
+				{ID: 2, Name: "fixed", Type: pricing.DiscountTypeFixed, Amount: 950},
+			},
+			wantTypes:   []string{domain.LineItemTypeSubscription, domain.LineItemTypeDiscount, domain.LineItemTypeDiscount},
+			wantAmounts: []int64{1000, -100, -900},
+			wantTotal:   0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subscription := tt.subscription
+			subscription.ID, subscription.TenantID, subscription.PlanName = 7, 3, "Pro"
+			service, _ := newTestInvoiceService(&subscription, &fakeBillableSubscriptions{usage: tt.usage, discounts: tt.discounts})
+
+			invoice, err := service.GenerateForSubscription(context.Background(), 7, &start, &end)
+			if err != nil {
+				t.Fatalf("GenerateForSubscription() error = %v", err)
+			}
+			if len(invoice.LineItems) != len(tt.wantTypes) {
+				t.Fatalf("line items = %d, want %d", len(invoice.LineItems), len(tt.wantTypes))
+			}
+			for i, item := range invoice.LineItems {
+				if item.Type != tt.wantTypes[i] || item.Amount != tt.wantAmounts[i] {
+					t.Errorf("line item %d = %s %d, want %s %d", i, item.Type, item.Amount, tt.wantTypes[i], tt.wantAmounts[i])
+				}
+			}
+			if invoice.Total != tt.wantTotal || invoice.Subtotal-invoice.DiscountTotal != invoice.Total {
+				t.Errorf("subtotal %d, discount %d, total %d, want total %d", invoice.Subtotal, invoice.DiscountTotal, invoice.Total, tt.wantTotal)
+			}
+			if invoice.Status != domain.InvoiceStatusDraft || invoice.TenantID != 3 {
+				t.Errorf("status %s, tenant %d, want draft, 3", invoice.Status, invoice.TenantID)
+			}
+		})
+	}
+}
+
+func TestGenerateForSubscriptionOncePerPeriod(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 1, 0)
+	subscription := &domain.BillableSubscription{ID: 7, TenantID: 3, PriceType: pricing.TypeFlatRate, Price: 1000, Quantity: 1}
+	service, repo := newTestInvoiceService(subscription, &fakeBillableSubscriptions{})
+
+	first, err := service.GenerateForSubscription(context.Background(), 7, &start, &end)
+	if err != nil {
+		t.Fatalf("GenerateForSubscription() error = %v", err)
+	}
+	second, err := service.GenerateForSubscription(context.Background(), 7, &start, &end)
+	if err != nil {
+		t.Fatalf("GenerateForSubscription() again error = %v", err)
+	}
+	if repo.created != 1 || second.ID != first.ID {
+		t.Errorf("created %d invoices, second ID %d, want the first invoice %d back", repo.created, second.ID, first.ID)
+	}
+
+	trialEndsAt := end.Add(time.Hour)
+	subscription.TrialEndsAt = &trialEndsAt
+	if _, err := service.GenerateForSubscription(context.Background(), 7, &start, &end); err == nil {
+		t.Error("GenerateForSubscription() within the trial succeeded")
+	}
+}
+
+func TestGenerateForEndedPeriodWithScheduledPlanChange(t *testing.T) {
+	// The renewal moved a monthly subscription to a yearly plan and reset the
+	// anchor to the end of the last monthly period
+	oldEnd := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	newEnd := oldEnd.AddDate(1, 0, 0)
+	subscription := &domain.BillableSubscription{
+		ID:            7,
+		TenantID:      3,
+		PlanID:        2,
+		PlanName:      "Plan 2",
+		PriceType:     pricing.TypeFlatRate,
+		Price:         90000,
+		Quantity:      1,
+		EndsAt:        &newEnd,
+		BillingAnchor: &oldEnd,
+		IntervalSlug:  "yearly",
+		IntervalCount: 1,
+	}
+	service, repo := newTestInvoiceService(subscription, &fakeBillableSubscriptions{})
+
+	period := &domain.EndedPeriod{
+		Start:     time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		End:       oldEnd,
+		PlanID:    1,
+		Quantity:  1,
+		Price:     1000,
+		PriceType: pricing.TypeFlatRate,
+	}
+	invoice, err := service.GenerateForEndedPeriod(context.Background(), 7, period)
+	if err != nil {
+		t.Fatalf("GenerateForEndedPeriod() error = %v", err)
+	}
+	if !invoice.PeriodStart.Equal(period.Start) || !invoice.PeriodEnd.Equal(period.End) {
+		t.Errorf("period = %v - %v, want the ended month %v - %v", invoice.PeriodStart, invoice.PeriodEnd, period.Start, period.End)
+	}
+	if invoice.Total != 1000 || len(invoice.LineItems) != 1 {
+		t.Fatalf("total = %d with %d line items, want 1000 on the old price", invoice.Total, len(invoice.LineItems))
+	}
+	if !strings.HasPrefix(invoice.LineItems[0].Description, "Plan 1 ") {
+		t.Errorf("description = %q, want the old plan", invoice.LineItems[0].Description)
+	}
+
+	// The same renewal delivered again returns the invoice
+	again, err := service.GenerateForEndedPeriod(context.Background(), 7, period)
+	if err != nil || again.ID != invoice.ID || repo.created != 1 {
+		t.Errorf("GenerateForEndedPeriod() again = %v, %v, created %d, want the first invoice", again, err, repo.created)
+	}
+}
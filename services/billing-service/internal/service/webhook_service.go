@@ -0,0 +1,259 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/damarteplok/damar-admin-cms/services/billing-service/internal/domain"
+	"github.com/damarteplok/damar-admin-cms/shared/amqp"
+	"github.com/damarteplok/damar-admin-cms/shared/contracts"
+	"github.com/damarteplok/damar-admin-cms/shared/logger"
+	"github.com/damarteplok/damar-admin-cms/shared/payment"
+	"github.com/damarteplok/damar-admin-cms/shared/tenancy"
+	"go.uber.org/zap"
+)
+
+// webhookRoutingKeys maps normalized webhook types to the events published
+// for them
+var webhookRoutingKeys = map[string]string{
+	payment.WebhookPaymentSucceeded: contracts.PaymentEventSuccess,
+	payment.WebhookPaymentFailed:    contracts.PaymentEventFailed,
+	payment.WebhookPaymentCancelled: contracts.PaymentEventCancelled,
+}
+
+// defaultProviderStatuses is stored in subscriptions.payment_provider_status
+// when the provider does not report a subscription status itself
+var defaultProviderStatuses = map[string]string{
+	payment.WebhookPaymentSucceeded: "active",
+	payment.WebhookPaymentFailed:    "past_due",
+	payment.WebhookPaymentCancelled: "cancelled",
+}
+
+type webhookService struct {
+	repo             domain.PaymentWebhookEventRepository
+	providerRepo     domain.PaymentProviderRepository
+	invoiceRepo      domain.InvoiceRepository
+	subscriptionRepo domain.SubscriptionRepository
+	invoiceService   domain.InvoiceService
+	providers        *payment.Registry
+	publisher        *amqp.Publisher
+	secrets          map[string]string
+	tolerance        time.Duration
+}
+
+// NewWebhookService creates the webhook service. secrets holds the signing
+// secret per provider slug; providers without one reject every webhook.
+func NewWebhookService(
+	repo domain.PaymentWebhookEventRepository,
+	providerRepo domain.PaymentProviderRepository,
+	invoiceRepo domain.InvoiceRepository,
+	subscriptionRepo domain.SubscriptionRepository,
+	invoiceService domain.InvoiceService,
+	providers *payment.Registry,
+	publisher *amqp.Publisher,
+	secrets map[string]string,
+	tolerance time.Duration,
+) domain.WebhookService {
+	if tolerance <= 0 {
+		tolerance = payment.DefaultWebhookTolerance
+	}
+
+	return &webhookService{
+		repo:             repo,
+		providerRepo:     providerRepo,
+		invoiceRepo:      invoiceRepo,
+		subscriptionRepo: subscriptionRepo,
+		invoiceService:   invoiceService,
+		providers:        providers,
+		publisher:        publisher,
+		secrets:          secrets,
+		tolerance:        tolerance,
+	}
+}
+
+func (s *webhookService) HandleWebhook(ctx context.Context, providerSlug string, payload []byte, header http.Header) (*domain.PaymentWebhookEvent, error) {
+	// Providers send one endpoint the events of every tenant
+	ctx = tenancy.WithoutTenant(ctx)
+
+	implementation, err := s.providers.Get(providerSlug)
+	if err != nil {
+		return nil, err
+	}
+	webhookProvider, ok := implementation.(payment.WebhookProvider)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", payment.ErrWebhookNotSupported, providerSlug)
+	}
+
+	// Verify before touching the database so unsigned requests cost nothing
+	if err := webhookProvider.VerifyWebhook(payload, header, s.secrets[providerSlug], s.tolerance); err != nil {
+		return nil, err
+	}
+
+	event, err := webhookProvider.ParseWebhook(ctx, payload)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrInvalidWebhookPayload, err)
+	}
+
+	provider, err := s.providerRepo.GetBySlug(ctx, providerSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	record := &domain.PaymentWebhookEvent{
+		PaymentProviderID: provider.ID,
+		EventID:           event.ID,
+		Type:              event.ProviderType,
+		Payload:           json.RawMessage(payload),
+	}
+	if err := s.repo.Record(ctx, record); err != nil {
+		return nil, err
+	}
+
+	claimed, err := s.repo.Claim(ctx, record.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !claimed {
+		// Only a processed event may be acknowledged; the delivery holding
+		// the lease can still fail
+		if record.ProcessedAt == nil {
+			logger.Info("Payment webhook is being processed by another delivery",
+				zap.String("provider", providerSlug),
+				zap.String("event_id", event.ID),
+				zap.Int32("attempts", record.Attempts))
+			return nil, domain.ErrWebhookInProgress
+		}
+		logger.Info("Skipping duplicate payment webhook",
+			zap.String("provider", providerSlug),
+			zap.String("event_id", event.ID),
+			zap.Int32("attempts", record.Attempts))
+		return record, nil
+	}
+
+	if err := s.apply(ctx, provider, event, record); err != nil {
+		message := err.Error()
+		record.Status = domain.WebhookEventStatusFailed
+		record.Error = &message
+		if updateErr := s.repo.UpdateStatus(ctx, record); updateErr != nil {
+			logger.Error("Failed to mark payment webhook as failed",
+				zap.Int64("webhook_event_id", record.ID),
+				zap.Error(updateErr))
+		}
+		return nil, err
+	}
+
+	now := time.Now()
+	record.Status = domain.WebhookEventStatusProcessed
+	record.Error = nil
+	record.ProcessedAt = &now
+	if err := s.repo.UpdateStatus(ctx, record); err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// apply updates the invoice and subscription the event refers to and
+// publishes the matching payment event
+func (s *webhookService) apply(ctx context.Context, provider *domain.PaymentProvider, event *payment.WebhookEvent, record *domain.PaymentWebhookEvent) error {
+	var invoice *domain.Invoice
+	var err error
+	if event.ChargeID != "" {
+		invoice, err = s.invoiceRepo.GetByPaymentCharge(ctx, provider.ID, event.ChargeID)
+		if err != nil {
+			return err
+		}
+	}
+
+	var subscription *domain.BillableSubscription
+	if event.SubscriptionID != "" {
+		subscription, err = s.subscriptionRepo.GetByProviderSubscriptionID(ctx, provider.ID, event.SubscriptionID)
+		if err != nil {
+			return err
+		}
+	}
+	if subscription == nil && invoice != nil && invoice.SubscriptionID != nil {
+		subscription, err = s.subscriptionRepo.GetByID(ctx, *invoice.SubscriptionID)
+		if err != nil {
+			return err
+		}
+	}
+
+	if subscription != nil {
+		status := event.SubscriptionStatus
+		if status == "" {
+			status = defaultProviderStatuses[event.Type]
+		}
+		if err := s.subscriptionRepo.UpdatePaymentProviderStatus(ctx, subscription.ID, status); err != nil {
+			return err
+		}
+	}
+
+	if invoice != nil && event.Type == payment.WebhookPaymentSucceeded && invoice.Status == domain.InvoiceStatusOpen {
+		if _, err := s.invoiceService.MarkPaid(ctx, invoice.ID); err != nil {
+			return err
+		}
+	}
+
+	s.publishEvent(ctx, provider, event, record, invoice, subscription)
+	return nil
+}
+
+func (s *webhookService) publishEvent(
+	ctx context.Context,
+	provider *domain.PaymentProvider,
+	event *payment.WebhookEvent,
+	record *domain.PaymentWebhookEvent,
+	invoice *domain.Invoice,
+	subscription *domain.BillableSubscription,
+) {
+	if s.publisher == nil {
+		return
+	}
+
+	routingKey, ok := webhookRoutingKeys[event.Type]
+	if !ok {
+		return
+	}
+
+	eventData := map[string]interface{}{
+		"webhook_event_id":    record.ID,
+		"provider":            provider.Slug,
+		"provider_event_id":   event.ID,
+		"provider_event_type": event.ProviderType,
+		"charge_id":           event.ChargeID,
+		"checkout_session_id": event.CheckoutSessionID,
+		"amount":              event.Amount,
+		"currency":            event.Currency,
+		"failure_message":     event.FailureMessage,
+		"occurred_at":         event.OccurredAt.Unix(),
+	}
+	if invoice != nil {
+		eventData["invoice_id"] = invoice.ID
+		eventData["tenant_id"] = invoice.TenantID
+	}
+	if subscription != nil {
+		eventData["subscription_id"] = subscription.ID
+		eventData["tenant_id"] = subscription.TenantID
+	}
+	dataBytes, _ := json.Marshal(eventData)
+	message := contracts.AmqpMessage{
+		OwnerID: fmt.Sprintf("%d", record.ID),
+		Data:    dataBytes,
+	}
+
+	if err := s.publisher.Publish(ctx, routingKey, message); err != nil {
+		logger.Error("Failed to publish payment event",
+			zap.String("routing_key", routingKey),
+			zap.Int64("webhook_event_id", record.ID),
+			zap.Error(err))
+	} else {
+		logger.Info("Published payment event",
+			zap.String("routing_key", routingKey),
+			zap.Int64("webhook_event_id", record.ID),
+			zap.String("provider", provider.Slug))
+	}
+}
@@ -0,0 +1,77 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/damarteplok/damar-admin-cms/services/billing-service/internal/domain"
+	"github.com/damarteplok/damar-admin-cms/shared/payment"
+)
+
+type fakeWebhookProviders struct {
+	domain.PaymentProviderRepository
+}
+
+func (fakeWebhookProviders) GetBySlug(ctx context.Context, slug string) (*domain.PaymentProvider, error) {
+	return &domain.PaymentProvider{ID: 1, Slug: slug}, nil
+}
+
+// fakeWebhookEvents holds one recorded event that a redelivery finds and
+// fails to claim
+type fakeWebhookEvents struct {
+	domain.PaymentWebhookEventRepository
+	existing *domain.PaymentWebhookEvent
+}
+
+func (f *fakeWebhookEvents) Record(ctx context.Context, event *domain.PaymentWebhookEvent) error {
+	event.ID = f.existing.ID
+	event.Status = f.existing.Status
+	event.ProcessedAt = f.existing.ProcessedAt
+	event.Attempts = f.existing.Attempts + 1
+	return nil
+}
+
+func (f *fakeWebhookEvents) Claim(ctx context.Context, id int64) (bool, error) {
+	return false, nil
+}
+
+func TestHandleWebhookRedelivery(t *testing.T) {
+	processedAt := time.Now()
+	payload := []byte(`{"id":"evt_1","type":"charge.succeeded","created":1,"data":{"charge_id":"ch_1","amount":1000,"currency":"usd"}}`)
+
+	tests := []struct {
+		name     string
+		existing *domain.PaymentWebhookEvent
+		wantErr  error
+	}{
+		{"processed", &domain.PaymentWebhookEvent{ID: 4, Status: domain.WebhookEventStatusProcessed, ProcessedAt: &processedAt}, nil},
+		{"being processed", &domain.PaymentWebhookEvent{ID: 4, Status: domain.WebhookEventStatusProcessing}, domain.ErrWebhookInProgress},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewWebhookService(
+				&fakeWebhookEvents{existing: tt.existing},
+				fakeWebhookProviders{},
+				nil, nil, nil,
+				payment.NewRegistry(payment.NewFakeProvider("")),
+				nil,
+				map[string]string{payment.SlugFake: "secret"},
+				0,
+			)
+			header := http.Header{}
+			header.Set(payment.FakeSignatureHeader, payment.SignHMAC(payload, "secret", time.Now()))
+
+			event, err := service.HandleWebhook(context.Background(), payment.SlugFake, payload, header)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("HandleWebhook() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && (event == nil || event.ID != tt.existing.ID) {
+				t.Errorf("HandleWebhook() = %v, want event %d acknowledged", event, tt.existing.ID)
+			}
+		})
+	}
+}
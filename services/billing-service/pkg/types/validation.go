@@ -0,0 +1,48 @@
+package types
+
+// IDValidation validates ID parameters
+type IDValidation struct {
+	ID int64 `validate:"required,gt=0"`
+}
+
+// PaginationValidation validates pagination parameters
+type PaginationValidation struct {
+	Page    int32 `validate:"omitempty,gte=1"`
+	PerPage int32 `validate:"omitempty,gte=1,lte=100"`
+}
+
+// Invoice validation
+type GenerateInvoiceValidation struct {
+	SubscriptionID int64 `validate:"required,gt=0"`
+	PeriodStart    int64 `validate:"omitempty,gt=0"`
+	PeriodEnd      int64 `validate:"omitempty,gt=0"`
+}
+
+type GetInvoiceByNumberValidation struct {
+	TenantID int64  `validate:"required,gt=0"`
+	Number   string `validate:"required"`
+}
+
+type GetInvoicesByTenantValidation struct {
+	TenantID int64  `validate:"required,gt=0"`
+	Page     int32  `validate:"omitempty,gte=1"`
+	PerPage  int32  `validate:"omitempty,gte=1,lte=100"`
+	Status   string `validate:"omitempty,oneof=draft open paid void"`
+}
+
+type GetInvoicesBySubscriptionValidation struct {
+	SubscriptionID int64 `validate:"required,gt=0"`
+	Page           int32 `validate:"omitempty,gte=1"`
+	PerPage        int32 `validate:"omitempty,gte=1,lte=100"`
+}
+
+type VoidInvoiceValidation struct {
+	ID     int64  `validate:"required,gt=0"`
+	Reason string `validate:"omitempty,max=255"`
+}
+
+type PayInvoiceValidation struct {
+	ID              int64  `validate:"required,gt=0"`
+	PaymentProvider string `validate:"omitempty,oneof=stripe paddle paypal manual fake"`
+	PaymentMethodID string `validate:"omitempty,max=255"`
+}
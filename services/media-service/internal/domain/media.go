@@ -60,6 +60,8 @@ type MediaService interface {
 	GetFileByUUID(ctx context.Context, uuid string) (*Media, error)
 	GetFilesByModel(ctx context.Context, modelType string, modelID int64, collectionName string, page, perPage int) ([]*Media, int64, error)
 	DeleteFile(ctx context.Context, id int64) error
+	// DeleteFilesByModel deletes every file of a model and returns how many
+	DeleteFilesByModel(ctx context.Context, modelType string, modelID int64) (int64, error)
 	GetFileURL(ctx context.Context, id int64, expirySeconds int) (string, int64, error)
 	GetAllMedia(ctx context.Context, page, perPage int, modelType, collectionName string) ([]*Media, int64, error)
 	GeneratePresignedURL(ctx context.Context, modelType string, modelID int64, uuid, fileName string, expirySeconds int) (string, error)
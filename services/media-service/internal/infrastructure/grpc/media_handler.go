@@ -213,6 +213,34 @@ func (s *MediaGRPCServer) DeleteFile(ctx context.Context, req *pb.DeleteFileRequ
 	}, nil
 }
 
+func (s *MediaGRPCServer) DeleteFilesByModel(ctx context.Context, req *pb.DeleteFilesByModelRequest) (*pb.DeleteFilesByModelResponse, error) {
+	// Validate request
+	if err := validation.ValidateStruct(&types.GetFilesByModelValidation{
+		ModelType: req.ModelType,
+		ModelID:   req.ModelId,
+	}); err != nil {
+		return &pb.DeleteFilesByModelResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	deleted, err := s.service.DeleteFilesByModel(ctx, req.ModelType, req.ModelId)
+	if err != nil {
+		return &pb.DeleteFilesByModelResponse{
+			Success: false,
+			Message: err.Error(),
+			Deleted: deleted,
+		}, nil
+	}
+
+	return &pb.DeleteFilesByModelResponse{
+		Success: true,
+		Message: "Files deleted successfully",
+		Deleted: deleted,
+	}, nil
+}
+
 func (s *MediaGRPCServer) GetFileURL(ctx context.Context, req *pb.GetFileURLRequest) (*pb.GetFileURLResponse, error) {
 	// Validate request
 	if err := validation.ValidateStruct(&types.IDValidation{ID: req.Id}); err != nil {
@@ -176,6 +176,29 @@ func (s *MediaService) DeleteFile(ctx context.Context, id int64) error {
 	return nil
 }
 
+func (s *MediaService) DeleteFilesByModel(ctx context.Context, modelType string, modelID int64) (int64, error) {
+	// Input validation is handled at gRPC layer
+
+	// Deleted files drop out of the listing, so keep reading the first page
+	var deleted int64
+	for {
+		mediaList, _, err := s.repo.GetByModel(ctx, modelType, modelID, "", 1, 100)
+		if err != nil {
+			return deleted, fmt.Errorf("failed to get files: %w", err)
+		}
+		if len(mediaList) == 0 {
+			return deleted, nil
+		}
+
+		for _, media := range mediaList {
+			if err := s.DeleteFile(ctx, media.ID); err != nil {
+				return deleted, err
+			}
+			deleted++
+		}
+	}
+}
+
 func (s *MediaService) GetFileURL(ctx context.Context, id int64, expirySeconds int) (string, int64, error) {
 	// Input validation is handled at gRPC layer
 
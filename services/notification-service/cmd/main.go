@@ -95,6 +95,30 @@ func main() {
 		}
 	}()
 
+	go func() {
+		if err := eventConsumer.ConsumeDunningAttemptFailed(ctx); err != nil {
+			logger.Fatal("Failed to consume dunning.attempt_failed events", zap.Error(err))
+		}
+	}()
+
+	go func() {
+		if err := eventConsumer.ConsumeDunningExhausted(ctx); err != nil {
+			logger.Fatal("Failed to consume dunning.exhausted events", zap.Error(err))
+		}
+	}()
+
+	go func() {
+		if err := eventConsumer.ConsumeTenantInvitationSent(ctx); err != nil {
+			logger.Fatal("Failed to consume tenant.invitation_sent events", zap.Error(err))
+		}
+	}()
+
+	go func() {
+		if err := eventConsumer.ConsumeTenantOwnershipTransferred(ctx); err != nil {
+			logger.Fatal("Failed to consume tenant.ownership_transferred events", zap.Error(err))
+		}
+	}()
+
 	logger.Info("Notification service started and consuming events")
 
 	// Graceful shutdown
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/damarteplok/damar-admin-cms/services/notification-service/internal/service"
 	"github.com/damarteplok/damar-admin-cms/shared/amqp"
@@ -303,3 +304,210 @@ func (ec *EventConsumer) ConsumeProductDeleted(ctx context.Context) error {
 		return nil
 	})
 }
+
+// ConsumeTenantInvitationSent emails the invitation link to the invitee
+func (ec *EventConsumer) ConsumeTenantInvitationSent(ctx context.Context) error {
+	consumer, err := amqp.NewConsumer(
+		ec.conn,
+		"notification.tenant.invitation_sent",
+		"damar.events",
+		contracts.TenantEventInvitationSent,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create consumer: %w", err)
+	}
+
+	logger.Info("Started consuming tenant invitation events")
+
+	return consumer.Consume(func(body []byte) error {
+		var message contracts.AmqpMessage
+		if err := json.Unmarshal(body, &message); err != nil {
+			logger.Error("Failed to unmarshal tenant invitation message", zap.Error(err))
+			return err
+		}
+
+		var eventData map[string]interface{}
+		if err := json.Unmarshal(message.Data, &eventData); err != nil {
+			logger.Error("Failed to unmarshal event data", zap.Error(err))
+			return err
+		}
+
+		invitation := service.TenantInvitationEmail{}
+		invitation.Email, _ = eventData["email"].(string)
+		invitation.TenantName, _ = eventData["tenant_name"].(string)
+		invitation.Role, _ = eventData["role"].(string)
+		invitation.Token, _ = eventData["token"].(string)
+		if expiresAt, ok := eventData["expires_at"].(float64); ok && expiresAt > 0 {
+			invitation.ExpiresAt = time.Unix(int64(expiresAt), 0)
+		}
+		if invitation.Email == "" || invitation.Token == "" {
+			logger.Warn("Skipping tenant invitation without recipient or token",
+				zap.String("invitation_uuid", message.OwnerID))
+			return nil
+		}
+
+		logger.Info("Processing tenant invitation event",
+			zap.String("invitation_uuid", message.OwnerID),
+			zap.String("email", invitation.Email))
+
+		if err := ec.emailService.SendTenantInvitationEmail(invitation); err != nil {
+			logger.Error("Failed to send tenant invitation email",
+				zap.String("email", invitation.Email),
+				zap.Error(err))
+			return err
+		}
+
+		logger.Info("Tenant invitation email sent successfully",
+			zap.String("email", invitation.Email))
+
+		return nil
+	})
+}
+
+// ConsumeTenantOwnershipTransferred emails the previous and the new owner
+// of a tenant
+func (ec *EventConsumer) ConsumeTenantOwnershipTransferred(ctx context.Context) error {
+	consumer, err := amqp.NewConsumer(
+		ec.conn,
+		"notification.tenant.ownership_transferred",
+		"damar.events",
+		contracts.TenantEventOwnershipTransferred,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create consumer: %w", err)
+	}
+
+	logger.Info("Started consuming tenant ownership events")
+
+	return consumer.Consume(func(body []byte) error {
+		var message contracts.AmqpMessage
+		if err := json.Unmarshal(body, &message); err != nil {
+			logger.Error("Failed to unmarshal tenant ownership message", zap.Error(err))
+			return err
+		}
+
+		var eventData map[string]interface{}
+		if err := json.Unmarshal(message.Data, &eventData); err != nil {
+			logger.Error("Failed to unmarshal event data", zap.Error(err))
+			return err
+		}
+
+		transfer := service.TenantOwnershipEmail{}
+		transfer.TenantName, _ = eventData["tenant_name"].(string)
+		transfer.FromName, _ = eventData["from_name"].(string)
+		transfer.FromEmail, _ = eventData["from_email"].(string)
+		transfer.ToName, _ = eventData["to_name"].(string)
+		transfer.ToEmail, _ = eventData["to_email"].(string)
+
+		logger.Info("Processing tenant ownership event",
+			zap.String("tenant_uuid", message.OwnerID),
+			zap.String("from_email", transfer.FromEmail),
+			zap.String("to_email", transfer.ToEmail))
+
+		if transfer.FromEmail != "" {
+			if err := ec.emailService.SendOwnershipTransferredEmail(transfer); err != nil {
+				logger.Error("Failed to send ownership transferred email",
+					zap.String("email", transfer.FromEmail),
+					zap.Error(err))
+				return err
+			}
+		}
+
+		if transfer.ToEmail != "" {
+			if err := ec.emailService.SendOwnershipReceivedEmail(transfer); err != nil {
+				logger.Error("Failed to send ownership received email",
+					zap.String("email", transfer.ToEmail),
+					zap.Error(err))
+				return err
+			}
+		}
+
+		logger.Info("Tenant ownership emails sent successfully",
+			zap.String("tenant_uuid", message.OwnerID))
+
+		return nil
+	})
+}
+
+// ConsumeDunningAttemptFailed emails the customer each time a dunning
+// attempt fails
+func (ec *EventConsumer) ConsumeDunningAttemptFailed(ctx context.Context) error {
+	return ec.consumeDunning(ctx, "notification.dunning.attempt_failed", contracts.DunningEventAttemptFailed, ec.emailService.SendPaymentFailedEmail)
+}
+
+// ConsumeDunningExhausted emails the customer when dunning gave up and the
+// subscription was cancelled
+func (ec *EventConsumer) ConsumeDunningExhausted(ctx context.Context) error {
+	return ec.consumeDunning(ctx, "notification.dunning.exhausted", contracts.DunningEventExhausted, ec.emailService.SendSubscriptionCancelledEmail)
+}
+
+func (ec *EventConsumer) consumeDunning(ctx context.Context, queue, routingKey string, send func(service.PaymentFailedEmail) error) error {
+	consumer, err := amqp.NewConsumer(
+		ec.conn,
+		queue,
+		"damar.events",
+		routingKey,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create consumer: %w", err)
+	}
+
+	logger.Info("Started consuming dunning events", zap.String("routing_key", routingKey))
+
+	return consumer.Consume(func(body []byte) error {
+		var message contracts.AmqpMessage
+		if err := json.Unmarshal(body, &message); err != nil {
+			logger.Error("Failed to unmarshal dunning message", zap.Error(err))
+			return err
+		}
+
+		var eventData map[string]interface{}
+		if err := json.Unmarshal(message.Data, &eventData); err != nil {
+			logger.Error("Failed to unmarshal event data", zap.Error(err))
+			return err
+		}
+
+		email, _ := eventData["email"].(string)
+		if email == "" {
+			logger.Warn("Skipping dunning email without recipient",
+				zap.String("dunning_run_id", message.OwnerID))
+			return nil
+		}
+
+		payment := service.PaymentFailedEmail{Email: email}
+		payment.Name, _ = eventData["name"].(string)
+		payment.InvoiceUUID, _ = eventData["invoice_uuid"].(string)
+		payment.InvoiceNumber, _ = eventData["invoice_number"].(string)
+		payment.Currency, _ = eventData["currency"].(string)
+		payment.FailureMessage, _ = eventData["failure_message"].(string)
+		if amount, ok := eventData["amount"].(float64); ok {
+			payment.Amount = int64(amount)
+		}
+		if attempt, ok := eventData["attempt"].(float64); ok {
+			payment.Attempt = int(attempt)
+		}
+		if maxAttempts, ok := eventData["max_attempts"].(float64); ok {
+			payment.MaxAttempts = int(maxAttempts)
+		}
+		if nextAttemptAt, ok := eventData["next_attempt_at"].(float64); ok && nextAttemptAt > 0 {
+			payment.NextAttemptAt = time.Unix(int64(nextAttemptAt), 0)
+		}
+
+		logger.Info("Processing dunning event",
+			zap.String("routing_key", routingKey),
+			zap.String("dunning_run_id", message.OwnerID),
+			zap.String("email", email))
+
+		if err := send(payment); err != nil {
+			logger.Error("Failed to send dunning email",
+				zap.String("email", email),
+				zap.Error(err))
+			return err
+		}
+
+		logger.Info("Dunning email sent successfully",
+			zap.String("email", email))
+
+		return nil
+	})
+}
@@ -196,5 +196,174 @@ var emailTemplates = map[string]string{
         </div>
     </div>
 </body>
+</html>`,
+	"payment_failed": `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <style>
+        body { font-family: Arial, sans-serif; line-height: 1.6; color: #333; }
+        .container { max-width: 600px; margin: 0 auto; padding: 20px; }
+        .header { background-color: #F44336; color: white; padding: 20px; text-align: center; }
+        .content { padding: 20px; background-color: #f9f9f9; }
+        .button { display: inline-block; padding: 10px 20px; background-color: #F44336; color: white; text-decoration: none; border-radius: 5px; }
+        .footer { text-align: center; padding: 20px; font-size: 12px; color: #666; }
+        .warning { background-color: #fff3cd; border-left: 4px solid #ffc107; padding: 10px; margin: 20px 0; }
+    </style>
+</head>
+<body>
+    <div class="container">
+        <div class="header">
+            <h1>Payment Failed</h1>
+        </div>
+        <div class="content">
+            <p>Hello <strong>{{.Name}}</strong>,</p>
+            <p>We couldn't collect the payment of <strong>{{.Amount}}</strong>{{if .InvoiceNumber}} for invoice <strong>{{.InvoiceNumber}}</strong>{{end}}.</p>
+            {{if .FailureMessage}}<p>Reason: {{.FailureMessage}}</p>{{end}}
+            <p style="text-align: center; margin: 30px 0;">
+                <a href="{{.InvoiceURL}}" class="button">Update Payment</a>
+            </p>
+            <div class="warning">
+                {{if .NextAttemptAt}}We will try again on <strong>{{.NextAttemptAt}}</strong>{{if .RemainingAttempts}} ({{.RemainingAttempts}} attempts left){{end}}.{{end}}
+                Your subscription will be cancelled if the payment keeps failing.
+            </div>
+        </div>
+        <div class="footer">
+            <p>&copy; 2025 Damar Admin CMS. All rights reserved.</p>
+        </div>
+    </div>
+</body>
+</html>`,
+	"subscription_cancelled": `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <style>
+        body { font-family: Arial, sans-serif; line-height: 1.6; color: #333; }
+        .container { max-width: 600px; margin: 0 auto; padding: 20px; }
+        .header { background-color: #607D8B; color: white; padding: 20px; text-align: center; }
+        .content { padding: 20px; background-color: #f9f9f9; }
+        .button { display: inline-block; padding: 10px 20px; background-color: #607D8B; color: white; text-decoration: none; border-radius: 5px; }
+        .footer { text-align: center; padding: 20px; font-size: 12px; color: #666; }
+    </style>
+</head>
+<body>
+    <div class="container">
+        <div class="header">
+            <h1>Subscription Cancelled</h1>
+        </div>
+        <div class="content">
+            <p>Hello <strong>{{.Name}}</strong>,</p>
+            <p>We tried several times to collect <strong>{{.Amount}}</strong>{{if .InvoiceNumber}} for invoice <strong>{{.InvoiceNumber}}</strong>{{end}}, but every attempt failed. Your subscription has been cancelled.</p>
+            <p>You can still pay the outstanding invoice and subscribe again at any time:</p>
+            <p style="text-align: center; margin: 30px 0;">
+                <a href="{{.InvoiceURL}}" class="button">View Invoice</a>
+            </p>
+        </div>
+        <div class="footer">
+            <p>&copy; 2025 Damar Admin CMS. All rights reserved.</p>
+        </div>
+    </div>
+</body>
+</html>`,
+	"tenant_invitation": `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <style>
+        body { font-family: Arial, sans-serif; line-height: 1.6; color: #333; }
+        .container { max-width: 600px; margin: 0 auto; padding: 20px; }
+        .header { background-color: #3F51B5; color: white; padding: 20px; text-align: center; }
+        .content { padding: 20px; background-color: #f9f9f9; }
+        .button { display: inline-block; padding: 10px 20px; background-color: #3F51B5; color: white; text-decoration: none; border-radius: 5px; }
+        .footer { text-align: center; padding: 20px; font-size: 12px; color: #666; }
+    </style>
+</head>
+<body>
+    <div class="container">
+        <div class="header">
+            <h1>You're Invited</h1>
+        </div>
+        <div class="content">
+            <p>Hello,</p>
+            <p>You have been invited to join <strong>{{.TenantName}}</strong> as <strong>{{.Role}}</strong>.</p>
+            <p style="text-align: center; margin: 30px 0;">
+                <a href="{{.AcceptURL}}" class="button">Accept Invitation</a>
+            </p>
+            <p>If you don't have an account yet, sign up with this email address after accepting.</p>
+            {{if .ExpiresAt}}<p>This invitation expires on <strong>{{.ExpiresAt}}</strong>.</p>{{end}}
+            <p>If you weren't expecting this invitation, you can ignore this email or decline it from the link above.</p>
+        </div>
+        <div class="footer">
+            <p>&copy; 2025 Damar Admin CMS. All rights reserved.</p>
+        </div>
+    </div>
+</body>
+</html>`,
+	"tenant_ownership_transferred": `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <style>
+        body { font-family: Arial, sans-serif; line-height: 1.6; color: #333; }
+        .container { max-width: 600px; margin: 0 auto; padding: 20px; }
+        .header { background-color: #3F51B5; color: white; padding: 20px; text-align: center; }
+        .content { padding: 20px; background-color: #f9f9f9; }
+        .footer { text-align: center; padding: 20px; font-size: 12px; color: #666; }
+        .warning { background-color: #fff3cd; border-left: 4px solid #ffc107; padding: 10px; margin: 20px 0; }
+    </style>
+</head>
+<body>
+    <div class="container">
+        <div class="header">
+            <h1>Ownership Transferred</h1>
+        </div>
+        <div class="content">
+            <p>Hello <strong>{{.Name}}</strong>,</p>
+            <p>Ownership of <strong>{{.TenantName}}</strong> has been transferred to <strong>{{.OtherName}}</strong> ({{.OtherEmail}}).</p>
+            <p>You stay in the tenant as an admin.</p>
+            <div class="warning">
+                If you did not expect this change, contact the new owner or support right away.
+            </div>
+        </div>
+        <div class="footer">
+            <p>&copy; 2025 Damar Admin CMS. All rights reserved.</p>
+        </div>
+    </div>
+</body>
+</html>`,
+	"tenant_ownership_received": `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <style>
+        body { font-family: Arial, sans-serif; line-height: 1.6; color: #333; }
+        .container { max-width: 600px; margin: 0 auto; padding: 20px; }
+        .header { background-color: #3F51B5; color: white; padding: 20px; text-align: center; }
+        .content { padding: 20px; background-color: #f9f9f9; }
+        .footer { text-align: center; padding: 20px; font-size: 12px; color: #666; }
+        .warning { background-color: #fff3cd; border-left: 4px solid #ffc107; padding: 10px; margin: 20px 0; }
+    </style>
+</head>
+<body>
+    <div class="container">
+        <div class="header">
+            <h1>You Are Now the Owner</h1>
+        </div>
+        <div class="content">
+            <p>Hello <strong>{{.Name}}</strong>,</p>
+            <p><strong>{{.OtherName}}</strong> ({{.OtherEmail}}) has transferred ownership of <strong>{{.TenantName}}</strong> to you.</p>
+            <p>As owner you can manage members, roles, billing and settings, and delete the tenant.</p>
+        </div>
+        <div class="footer">
+            <p>&copy; 2025 Damar Admin CMS. All rights reserved.</p>
+        </div>
+    </div>
+</body>
 </html>`,
 }
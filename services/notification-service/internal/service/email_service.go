@@ -2,6 +2,9 @@ package service
 
 import (
 	"fmt"
+	"net/url"
+	"strings"
+	"time"
 
 	"github.com/damarteplok/damar-admin-cms/services/notification-service/internal/infrastructure/smtp"
 )
@@ -70,3 +73,131 @@ func (s *EmailService) SendEmailVerification(email, name, verificationToken stri
 		data,
 	)
 }
+
+// PaymentFailedEmail describes a failed invoice payment for a dunning email
+type PaymentFailedEmail struct {
+	Email          string
+	Name           string
+	InvoiceUUID    string
+	InvoiceNumber  string
+	Amount         int64 // minor unit
+	Currency       string
+	Attempt        int
+	MaxAttempts    int
+	NextAttemptAt  time.Time // zero when no retry is left
+	FailureMessage string
+}
+
+func (s *EmailService) SendPaymentFailedEmail(payment PaymentFailedEmail) error {
+	data := map[string]string{
+		"Name":           payment.Name,
+		"InvoiceNumber":  payment.InvoiceNumber,
+		"Amount":         formatAmount(payment.Amount, payment.Currency),
+		"FailureMessage": payment.FailureMessage,
+		"InvoiceURL":     fmt.Sprintf("%s/billing/invoices/%s", s.frontendURL, payment.InvoiceUUID),
+	}
+	if !payment.NextAttemptAt.IsZero() {
+		data["NextAttemptAt"] = payment.NextAttemptAt.Format("January 2, 2006")
+	}
+	if remaining := payment.MaxAttempts - payment.Attempt; remaining > 0 {
+		data["RemainingAttempts"] = fmt.Sprintf("%d", remaining)
+	}
+
+	return s.smtpClient.SendTemplateEmail(
+		payment.Email,
+		"Payment Failed - Damar Admin CMS",
+		"payment_failed",
+		data,
+	)
+}
+
+func (s *EmailService) SendSubscriptionCancelledEmail(payment PaymentFailedEmail) error {
+	data := map[string]string{
+		"Name":          payment.Name,
+		"InvoiceNumber": payment.InvoiceNumber,
+		"Amount":        formatAmount(payment.Amount, payment.Currency),
+		"InvoiceURL":    fmt.Sprintf("%s/billing/invoices/%s", s.frontendURL, payment.InvoiceUUID),
+	}
+
+	return s.smtpClient.SendTemplateEmail(
+		payment.Email,
+		"Subscription Cancelled - Damar Admin CMS",
+		"subscription_cancelled",
+		data,
+	)
+}
+
+// TenantInvitationEmail describes an invitation to join a tenant
+type TenantInvitationEmail struct {
+	Email      string
+	TenantName string
+	Role       string
+	Token      string
+	ExpiresAt  time.Time
+}
+
+func (s *EmailService) SendTenantInvitationEmail(invitation TenantInvitationEmail) error {
+	data := map[string]string{
+		"TenantName": invitation.TenantName,
+		"Role":       invitation.Role,
+		"AcceptURL":  fmt.Sprintf("%s/invitations/accept?token=%s", s.frontendURL, url.QueryEscape(invitation.Token)),
+	}
+	if !invitation.ExpiresAt.IsZero() {
+		data["ExpiresAt"] = invitation.ExpiresAt.Format("January 2, 2006")
+	}
+
+	return s.smtpClient.SendTemplateEmail(
+		invitation.Email,
+		fmt.Sprintf("You're invited to join %s - Damar Admin CMS", invitation.TenantName),
+		"tenant_invitation",
+		data,
+	)
+}
+
+// TenantOwnershipEmail describes a transfer of tenant ownership
+type TenantOwnershipEmail struct {
+	TenantName string
+	FromName   string
+	FromEmail  string
+	ToName     string
+	ToEmail    string
+}
+
+// SendOwnershipTransferredEmail tells the previous owner who owns the tenant now
+func (s *EmailService) SendOwnershipTransferredEmail(transfer TenantOwnershipEmail) error {
+	data := map[string]string{
+		"Name":       transfer.FromName,
+		"TenantName": transfer.TenantName,
+		"OtherName":  transfer.ToName,
+		"OtherEmail": transfer.ToEmail,
+	}
+
+	return s.smtpClient.SendTemplateEmail(
+		transfer.FromEmail,
+		fmt.Sprintf("You transferred ownership of %s - Damar Admin CMS", transfer.TenantName),
+		"tenant_ownership_transferred",
+		data,
+	)
+}
+
+// SendOwnershipReceivedEmail tells the new owner they own the tenant
+func (s *EmailService) SendOwnershipReceivedEmail(transfer TenantOwnershipEmail) error {
+	data := map[string]string{
+		"Name":       transfer.ToName,
+		"TenantName": transfer.TenantName,
+		"OtherName":  transfer.FromName,
+		"OtherEmail": transfer.FromEmail,
+	}
+
+	return s.smtpClient.SendTemplateEmail(
+		transfer.ToEmail,
+		fmt.Sprintf("You are now the owner of %s - Damar Admin CMS", transfer.TenantName),
+		"tenant_ownership_received",
+		data,
+	)
+}
+
+// formatAmount renders a minor-unit amount, e.g. 1999 USD as "19.99 USD"
+func formatAmount(amount int64, currency string) string {
+	return strings.TrimSpace(fmt.Sprintf("%d.%02d %s", amount/100, amount%100, currency))
+}
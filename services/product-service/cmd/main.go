@@ -8,6 +8,8 @@ import (
 	"os/signal"
 	"syscall"
 
+	"github.com/damarteplok/damar-admin-cms/services/product-service/internal/domain"
+	"github.com/damarteplok/damar-admin-cms/services/product-service/internal/infrastructure/events"
 	"github.com/damarteplok/damar-admin-cms/services/product-service/internal/infrastructure/grpc"
 	"github.com/damarteplok/damar-admin-cms/services/product-service/internal/infrastructure/repository"
 	"github.com/damarteplok/damar-admin-cms/services/product-service/internal/service"
@@ -15,7 +17,9 @@ import (
 	"github.com/damarteplok/damar-admin-cms/shared/database"
 	"github.com/damarteplok/damar-admin-cms/shared/env"
 	"github.com/damarteplok/damar-admin-cms/shared/logger"
+	"github.com/damarteplok/damar-admin-cms/shared/payment"
 	pb "github.com/damarteplok/damar-admin-cms/shared/proto/product"
+	"github.com/damarteplok/damar-admin-cms/shared/tenancy"
 	"go.uber.org/zap"
 	grpcLib "google.golang.org/grpc"
 )
@@ -70,6 +74,10 @@ func main() {
 	planRepo := repository.NewPlanRepository(pool)
 	planPriceRepo := repository.NewPlanPriceRepository(pool)
 	planMeterRepo := repository.NewPlanMeterRepository(pool)
+	intervalRepo := repository.NewIntervalRepository(pool)
+	currencyRepo := repository.NewCurrencyRepository(pool, database.NewTenantDB(pool))
+	exchangeRateRepo := repository.NewExchangeRateRepository(pool)
+	planEntitlementRepo := repository.NewPlanEntitlementRepository(pool)
 
 	// Initialize discount repositories
 	discountRepo := repository.NewDiscountRepository(pool)
@@ -78,12 +86,35 @@ func main() {
 	discountPlanRepo := repository.NewDiscountPlanRepository(pool)
 	discountOneTimeProductRepo := repository.NewDiscountOneTimeProductRepository(pool)
 	discountPaymentProviderDataRepo := repository.NewDiscountPaymentProviderDataRepository(pool)
+	referralRepo := repository.NewReferralRepository(pool)
+
+	// Initialize order repositories
+	productPriceRepo := repository.NewProductPriceRepository(pool)
+	orderRepo := repository.NewOrderRepository(pool)
+	providerRepo := repository.NewPaymentProviderRepository(pool)
+
+	// Plan prices in PRICE_BASE_CURRENCY can be converted into every other
+	// currency. With PRICE_AUTO_DERIVE on, derived prices are refreshed
+	// whenever base prices, exchange rates or rounding rules change
+	priceDerivationService := service.NewPriceDerivationService(
+		planPriceRepo,
+		currencyRepo,
+		exchangeRateRepo,
+		env.GetString("PRICE_BASE_CURRENCY", "USD"),
+	)
+	var autoDeriver domain.PriceDerivationService
+	if env.GetBool("PRICE_AUTO_DERIVE", false) {
+		autoDeriver = priceDerivationService
+	}
 
 	// Initialize services
 	productService := service.NewProductService(productRepo, publisher)
 	planService := service.NewPlanService(planRepo, productRepo)
-	planPriceService := service.NewPlanPriceService(planPriceRepo, planRepo)
+	planPriceService := service.NewPlanPriceService(planPriceRepo, planRepo, autoDeriver)
+	currencyService := service.NewCurrencyService(currencyRepo, autoDeriver)
+	exchangeRateService := service.NewExchangeRateService(exchangeRateRepo, currencyRepo, autoDeriver)
 	planMeterService := service.NewPlanMeterService(planMeterRepo)
+	planEntitlementService := service.NewPlanEntitlementService(planEntitlementRepo, planRepo, planMeterRepo)
 
 	// Initialize discount services
 	discountService := service.NewDiscountService(discountRepo)
@@ -103,6 +134,47 @@ func main() {
 		discountPaymentProviderDataRepo,
 		discountRepo,
 	)
+	checkoutService := service.NewCheckoutService(
+		planPriceRepo,
+		planRepo,
+		intervalRepo,
+		discountRepo,
+		discountCodeRepo,
+		discountPlanRepo,
+	)
+
+	// Personal referral codes are created on REFERRAL_DISCOUNT_ID; referrers
+	// earn REFERRAL_REWARD_BONUS_DAYS per converted referral
+	referralService := service.NewReferralService(
+		referralRepo,
+		discountCodeRepo,
+		discountRepo,
+		publisher,
+		int64(env.GetInt("REFERRAL_DISCOUNT_ID", 0)),
+		int32(env.GetInt("REFERRAL_REWARD_BONUS_DAYS", 30)),
+	)
+
+	// Orders are paid through checkout sessions. The fake provider is on by
+	// default outside production, as in billing-service
+	providers := payment.NewRegistry(payment.NewManualProvider())
+	if env.GetBool("PAYMENT_FAKE_PROVIDER_ENABLED", environment != "production") {
+		providers.Register(payment.NewFakeProvider(env.GetString("PAYMENT_FAKE_BASE_URL", "http://localhost:8080")))
+	}
+
+	productPriceService := service.NewProductPriceService(productPriceRepo, productRepo, currencyRepo, publisher)
+	orderService := service.NewOrderService(
+		orderRepo,
+		productPriceRepo,
+		productRepo,
+		currencyRepo,
+		providerRepo,
+		discountRepo,
+		discountCodeRepo,
+		discountOneTimeProductRepo,
+		discountCodeRedemptionService,
+		providers,
+		publisher,
+	)
 
 	// Initialize gRPC handler
 	productHandler := grpc.NewProductHandler(
@@ -116,6 +188,14 @@ func main() {
 		discountPlanService,
 		discountOneTimeProductService,
 		discountPaymentProviderDataService,
+		checkoutService,
+		referralService,
+		productPriceService,
+		orderService,
+		currencyService,
+		exchangeRateService,
+		priceDerivationService,
+		planEntitlementService,
 	)
 
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", grpcPort))
@@ -123,7 +203,10 @@ func main() {
 		logger.Fatal("Failed to listen", zap.Int("port", grpcPort), zap.Error(err))
 	}
 
-	grpcServer := grpcLib.NewServer()
+	grpcServer := grpcLib.NewServer(
+		grpcLib.UnaryInterceptor(tenancy.UnaryServerInterceptor()),
+		grpcLib.StreamInterceptor(tenancy.StreamServerInterceptor()),
+	)
 	pb.RegisterProductServiceServer(grpcServer, productHandler)
 
 	logger.Info("Product service gRPC server listening", zap.Int("port", grpcPort))
@@ -134,6 +217,28 @@ func main() {
 		}
 	}()
 
+	// Convert referrals when the referred user pays, and settle orders when
+	// their checkout completes
+	eventConsumer := events.NewEventConsumer(rabbitmqConn, referralService, orderService)
+
+	go func() {
+		if err := eventConsumer.ConsumeInvoicePaid(ctx); err != nil {
+			logger.Fatal("Failed to consume invoice.paid events", zap.Error(err))
+		}
+	}()
+
+	go func() {
+		if err := eventConsumer.ConsumePaymentSucceeded(ctx); err != nil {
+			logger.Fatal("Failed to consume payment.success events", zap.Error(err))
+		}
+	}()
+
+	go func() {
+		if err := eventConsumer.ConsumePaymentCancelled(ctx); err != nil {
+			logger.Fatal("Failed to consume payment.cancelled events", zap.Error(err))
+		}
+	}()
+
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// Checkout line types
+const (
+	CheckoutLineTypePlan     = "plan"
+	CheckoutLineTypeDiscount = "discount"
+)
+
+// CheckoutLine is one row of a checkout breakdown. Discount lines have a
+// negative amount. Amounts are in the currency's minor unit.
+type CheckoutLine struct {
+	Type        string
+	Description string
+	Quantity    int64
+	UnitAmount  int64
+	Amount      int64
+}
+
+// CheckoutPreview is what a checkout would charge, without redeeming anything
+type CheckoutPreview struct {
+	Plan           *Plan
+	PlanPrice      *PlanPrice
+	Discount       *Discount     // nil without a discount code
+	DiscountCode   *DiscountCode // nil without a discount code
+	Quantity       int64
+	Lines          []CheckoutLine
+	Subtotal       int64
+	DiscountAmount int64 // total taken off by the discount
+	Total          int64 // due for the first billing cycle
+	// RecurringTotal is what each later cycle costs once the discount no
+	// longer applies
+	RecurringTotal int64
+	// DiscountCycles is how many billing cycles, the first included, the
+	// discount covers. It is 0 when the discount never runs out
+	// (DiscountForever) or there is no discount.
+	DiscountCycles  int32
+	DiscountForever bool
+	TrialDays       int32
+	BonusDays       int32
+	TrialEndsAt     *time.Time // nullable, nil without trial or bonus days
+}
+
+type CheckoutService interface {
+	PreviewCheckout(ctx context.Context, planPriceID, quantity int64, discountCode string) (*CheckoutPreview, error)
+}
@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/damarteplok/damar-admin-cms/shared/pricing"
+)
+
+type Currency struct {
+	ID                int64
+	Code              string // ISO 4217
+	Name              string
+	Symbol            string
+	DecimalPlaces     int32  // minor-unit digits, 0 for IDR
+	RoundingIncrement int64  // converted amounts snap to this many minor units
+	RoundingMode      string // nearest, up, down
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+// Rounding returns the rules used when converting amounts into the currency
+func (c *Currency) Rounding() pricing.Rounding {
+	return pricing.Rounding{
+		DecimalPlaces: c.DecimalPlaces,
+		Increment:     c.RoundingIncrement,
+		Mode:          c.RoundingMode,
+	}
+}
+
+type CurrencyRepository interface {
+	GetByID(ctx context.Context, id int64) (*Currency, error)
+	GetByCode(ctx context.Context, code string) (*Currency, error)
+	GetAll(ctx context.Context) ([]*Currency, error)
+	Create(ctx context.Context, currency *Currency) error
+	Update(ctx context.Context, currency *Currency) error
+	Delete(ctx context.Context, id int64) error
+	// IsInUse reports whether any price, subscription, invoice or order
+	// references the currency
+	IsInUse(ctx context.Context, id int64) (bool, error)
+}
+
+// UpdateCurrencyParams changes the fields that are set. The code of a
+// currency never changes.
+type UpdateCurrencyParams struct {
+	ID                int64
+	Name              *string
+	Symbol            *string
+	DecimalPlaces     *int32
+	RoundingIncrement *int64
+	RoundingMode      *string
+}
+
+type CurrencyService interface {
+	GetByID(ctx context.Context, id int64) (*Currency, error)
+	GetAll(ctx context.Context) ([]*Currency, error)
+	Create(ctx context.Context, currency *Currency) error
+	Update(ctx context.Context, params UpdateCurrencyParams) (*Currency, error)
+	// Delete removes a currency nothing references
+	Delete(ctx context.Context, id int64) error
+}
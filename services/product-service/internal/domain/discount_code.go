@@ -2,21 +2,33 @@ package domain
 
 import (
 	"context"
+	"io"
 	"time"
 )
 
 // DiscountCode represents discount_codes table
 type DiscountCode struct {
-	ID         int64      `json:"id"`
-	DiscountID int64      `json:"discount_id"`
-	Code       string     `json:"code"`
-	ValidFrom  *time.Time `json:"valid_from"`
-	ValidUntil *time.Time `json:"valid_until"`
-	MaxUses    *int32     `json:"max_uses"`
-	TimesUsed  int32      `json:"times_used"`
-	IsActive   bool       `json:"is_active"`
-	CreatedAt  time.Time  `json:"created_at"`
-	UpdatedAt  time.Time  `json:"updated_at"`
+	ID             int64      `json:"id"`
+	DiscountID     int64      `json:"discount_id"`
+	Code           string     `json:"code"`
+	ValidFrom      *time.Time `json:"valid_from"`
+	ValidUntil     *time.Time `json:"valid_until"`
+	MaxUses        *int32     `json:"max_uses"`
+	TimesUsed      int32      `json:"times_used"`
+	IsActive       bool       `json:"is_active"`
+	ReferrerUserID *int64     `json:"referrer_user_id"` // set on personal referral codes
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+// GenerateDiscountCodesParams describes a bulk code generation run
+type GenerateDiscountCodesParams struct {
+	DiscountID int64
+	Count      int
+	Prefix     string
+	Length     int
+	Alphabet   string
+	MaxUses    *int32
 }
 
 // DiscountCodeRepository defines data access operations for discount codes
@@ -24,11 +36,16 @@ type DiscountCodeRepository interface {
 	GetByID(ctx context.Context, id int64) (*DiscountCode, error)
 	GetByCode(ctx context.Context, code string) (*DiscountCode, error)
 	GetByDiscount(ctx context.Context, discountID int64) ([]*DiscountCode, error)
+	// GetByReferrer returns the referrer's personal code for the discount
+	GetByReferrer(ctx context.Context, referrerUserID, discountID int64) (*DiscountCode, error)
 	Create(ctx context.Context, code *DiscountCode) error
 	Update(ctx context.Context, code *DiscountCode) error
 	Delete(ctx context.Context, id int64) error
 	GetAll(ctx context.Context, page, perPage int, activeOnly bool) ([]*DiscountCode, int, error)
 	IncrementUsage(ctx context.Context, id int64) error
+	// CreateBatch inserts codes sharing the template's settings and returns the
+	// rows that were inserted. Codes that already exist are skipped.
+	CreateBatch(ctx context.Context, template *DiscountCode, codes []string) ([]*DiscountCode, error)
 }
 
 // DiscountCodeService defines business logic operations for discount codes
@@ -41,4 +58,8 @@ type DiscountCodeService interface {
 	Delete(ctx context.Context, id int64) error
 	GetAll(ctx context.Context, page, perPage int, activeOnly bool) ([]*DiscountCode, int, error)
 	ValidateCode(ctx context.Context, code string) (bool, string, error)
+	// Generate creates params.Count unique codes and passes each inserted batch
+	// to emit. It returns how many codes were created.
+	Generate(ctx context.Context, params GenerateDiscountCodesParams, emit func([]*DiscountCode) error) (int, error)
+	ExportCSV(ctx context.Context, discountID int64, w io.Writer) (int, error)
 }
@@ -21,13 +21,33 @@ type DiscountCodeRedemptionRepository interface {
 	GetByDiscountCode(ctx context.Context, discountCodeID int64) ([]*DiscountCodeRedemption, error)
 	GetByUser(ctx context.Context, userID int64) ([]*DiscountCodeRedemption, error)
 	Create(ctx context.Context, redemption *DiscountCodeRedemption) error
+	// Redeem locks the code and its discount rows, calls check with their
+	// current state and the user's redemptions of the discount, and when it
+	// passes records redemption and bumps both usage counters, all in one
+	// transaction. If the code was already redeemed for the same
+	// subscription or order, redemption is filled from that row and false
+	// is returned.
+	Redeem(ctx context.Context, redemption *DiscountCodeRedemption, check RedemptionCheck) (bool, error)
+	// ReleaseOrder deletes the order's redemption of the code and gives the
+	// use back to both usage counters, under the same locks as Redeem. It
+	// returns false when the order has no redemption of the code.
+	ReleaseOrder(ctx context.Context, discountCodeID, orderID int64) (bool, error)
 	GetAll(ctx context.Context, page, perPage int) ([]*DiscountCodeRedemption, int, error)
 }
 
+// RedemptionCheck decides whether a code can be redeemed, given the locked
+// code and discount rows and how often the user already redeemed the discount
+type RedemptionCheck func(code *DiscountCode, discount *Discount, userRedemptions int32) error
+
 type DiscountCodeRedemptionService interface {
 	GetByID(ctx context.Context, id int64) (*DiscountCodeRedemption, error)
 	GetByDiscountCode(ctx context.Context, discountCodeID int64) ([]*DiscountCodeRedemption, error)
 	GetByUser(ctx context.Context, userID int64) ([]*DiscountCodeRedemption, error)
-	RedeemCode(ctx context.Context, discountCodeID, userID int64, subscriptionID, orderID *int64) error
+	// RedeemCode is safe to retry: redeeming the same code again for the
+	// same subscription or order returns the first redemption
+	RedeemCode(ctx context.Context, discountCodeID, userID int64, subscriptionID, orderID *int64) (*DiscountCodeRedemption, error)
+	// ReleaseOrderCode undoes the redemption of a cancelled order. Releasing
+	// twice is a no-op.
+	ReleaseOrderCode(ctx context.Context, discountCodeID, orderID int64) error
 	GetAll(ctx context.Context, page, perPage int) ([]*DiscountCodeRedemption, int, error)
 }
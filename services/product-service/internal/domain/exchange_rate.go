@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// Exchange rate sources stored in exchange_rates.source
+const (
+	ExchangeRateSourceManual = "manual"
+	ExchangeRateSourceImport = "import"
+)
+
+// ExchangeRate says 1 unit of the base currency is worth Rate units of the
+// quote currency from EffectiveAt on. Rate is a decimal string so no
+// precision is lost on the way to and from NUMERIC.
+type ExchangeRate struct {
+	ID              int64
+	BaseCurrencyID  int64
+	QuoteCurrencyID int64
+	Rate            string
+	Source          string // manual, import
+	EffectiveAt     time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+type ExchangeRateRepository interface {
+	GetByID(ctx context.Context, id int64) (*ExchangeRate, error)
+	// GetLatest returns the newest rate for the pair effective at at, or nil
+	// if there is none
+	GetLatest(ctx context.Context, baseCurrencyID, quoteCurrencyID int64, at time.Time) (*ExchangeRate, error)
+	// GetAll pages through the rates, newest first. A zero currency ID
+	// matches every currency.
+	GetAll(ctx context.Context, baseCurrencyID, quoteCurrencyID int64, page, perPage int) ([]*ExchangeRate, int, error)
+	// CreateBatch inserts the rates in one transaction
+	CreateBatch(ctx context.Context, rates []*ExchangeRate) error
+	Delete(ctx context.Context, id int64) error
+}
+
+type ExchangeRateService interface {
+	GetAll(ctx context.Context, baseCurrencyID, quoteCurrencyID int64, page, perPage int) ([]*ExchangeRate, int, error)
+	// SetRate records a manual rate. A nil effectiveAt means now.
+	SetRate(ctx context.Context, baseCurrencyID, quoteCurrencyID int64, rate string, effectiveAt *time.Time) (*ExchangeRate, error)
+	// ImportRates records the rates of a CSV file with the columns
+	// base,quote,rate[,effective_at]. Currencies are ISO 4217 codes. The
+	// whole file is rejected if any row is invalid.
+	ImportRates(ctx context.Context, data []byte) ([]*ExchangeRate, error)
+	Delete(ctx context.Context, id int64) error
+}
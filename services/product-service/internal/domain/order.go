@@ -0,0 +1,104 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	OrderStatusPending   = "pending"
+	OrderStatusPaid      = "paid"
+	OrderStatusCancelled = "cancelled"
+	OrderStatusRefunded  = "refunded"
+)
+
+// Order represents orders table. An order is a one-time purchase of
+// products, paid through a payment provider checkout session.
+type Order struct {
+	ID             int64
+	UUID           string
+	UserID         int64
+	TenantID       *int64 // nullable
+	CurrencyID     int64
+	Status         string // see order_state.go for allowed transitions
+	Subtotal       int64
+	DiscountTotal  int64
+	Total          int64
+	DiscountCodeID *int64 // nullable
+	// PaymentProviderID and PaymentProviderCheckoutID identify the checkout
+	// session the order is paid through
+	PaymentProviderID         *int64
+	PaymentProviderCheckoutID *string
+	CheckoutURL               *string // empty when the customer pays outside the provider
+	PaidAt                    *time.Time
+	CancelledAt               *time.Time
+	RefundedAt                *time.Time
+	Items                     []*OrderItem
+	CreatedAt                 time.Time
+	UpdatedAt                 time.Time
+}
+
+// OrderItem is one product of an order. Amount is what is charged for the
+// line after its share of the order discount.
+type OrderItem struct {
+	ID             int64
+	OrderID        int64
+	ProductID      *int64 // nullable once the product is deleted
+	Description    string
+	Quantity       int64
+	UnitAmount     int64
+	DiscountAmount int64
+	Amount         int64
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+type OrderItemParams struct {
+	ProductID int64
+	Quantity  int64
+}
+
+type CreateOrderParams struct {
+	UserID          int64
+	TenantID        *int64
+	CurrencyID      int64
+	Items           []OrderItemParams
+	DiscountCode    string
+	PaymentProvider string // slug, defaults to manual
+	SuccessURL      string
+	CancelURL       string
+}
+
+type OrderRepository interface {
+	GetByID(ctx context.Context, id int64) (*Order, error)
+	// GetByCheckout returns the order paid through a checkout session, or nil
+	GetByCheckout(ctx context.Context, providerID int64, checkoutID string) (*Order, error)
+	GetByUser(ctx context.Context, userID int64, page, perPage int) ([]*Order, int, error)
+	GetAll(ctx context.Context, status string, page, perPage int) ([]*Order, int, error)
+	// Create inserts the order together with its items
+	Create(ctx context.Context, order *Order) error
+	SetCheckout(ctx context.Context, order *Order) error
+	// UpdateStatus stores the order's status and timestamps if its status is
+	// still from, and returns false when another request changed it first
+	UpdateStatus(ctx context.Context, order *Order, from string) (bool, error)
+}
+
+type OrderService interface {
+	// Create prices the items, applies the discount code where the discount
+	// covers the products and opens a checkout session. Orders that cost
+	// nothing are paid straight away.
+	Create(ctx context.Context, params CreateOrderParams) (*Order, error)
+	GetByID(ctx context.Context, id int64) (*Order, error)
+	GetByUser(ctx context.Context, userID int64, page, perPage int) ([]*Order, int, error)
+	GetAll(ctx context.Context, status string, page, perPage int) ([]*Order, int, error)
+	// MarkPaid redeems the order's discount code and publishes
+	// order.event.paid. Paying a paid order is a no-op.
+	MarkPaid(ctx context.Context, id int64) (*Order, error)
+	// MarkCheckoutPaid and CancelCheckout act on the order of a provider
+	// checkout session and return nil when no order uses it
+	MarkCheckoutPaid(ctx context.Context, providerSlug, checkoutID string) (*Order, error)
+	CancelCheckout(ctx context.Context, providerSlug, checkoutID string) (*Order, error)
+	Cancel(ctx context.Context, id int64) (*Order, error)
+	// Refund records that a paid order was refunded through the provider
+	Refund(ctx context.Context, id int64) (*Order, error)
+}
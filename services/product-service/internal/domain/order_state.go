@@ -0,0 +1,31 @@
+package domain
+
+import "fmt"
+
+// orderTransitions lists the statuses an order may move to from each
+// status. Cancelled and refunded orders are final.
+var orderTransitions = map[string][]string{
+	OrderStatusPending:   {OrderStatusPaid, OrderStatusCancelled},
+	OrderStatusPaid:      {OrderStatusRefunded},
+	OrderStatusCancelled: {},
+	OrderStatusRefunded:  {},
+}
+
+func IsValidOrderStatus(status string) bool {
+	_, ok := orderTransitions[status]
+	return ok
+}
+
+// ValidateOrderTransition returns an error when an order in status from may
+// not move to status to
+func ValidateOrderTransition(from, to string) error {
+	if !IsValidOrderStatus(to) {
+		return fmt.Errorf("invalid order status: %s", to)
+	}
+	for _, allowed := range orderTransitions[from] {
+		if allowed == to {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid order status transition from %s to %s", from, to)
+}
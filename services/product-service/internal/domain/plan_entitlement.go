@@ -0,0 +1,36 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// PlanEntitlement grants a plan's subscribers one feature. Boolean
+// entitlements use IsEnabled; limits and quotas also carry LimitValue (nil is
+// unlimited). Quotas count the usage of MeterID, or of the plan's meter when
+// nil, per billing period. See shared/entitlement.
+type PlanEntitlement struct {
+	ID         int64
+	PlanID     int64
+	FeatureKey string
+	Type       string // boolean, limit, quota
+	IsEnabled  bool
+	LimitValue *int64 // nullable
+	MeterID    *int64 // nullable
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+type PlanEntitlementRepository interface {
+	GetByID(ctx context.Context, id int64) (*PlanEntitlement, error)
+	GetByPlan(ctx context.Context, planID int64) ([]*PlanEntitlement, error)
+	// Upsert creates the plan's entitlement for the feature key or replaces it
+	Upsert(ctx context.Context, entitlement *PlanEntitlement) error
+	Delete(ctx context.Context, id int64) error
+}
+
+type PlanEntitlementService interface {
+	GetByPlan(ctx context.Context, planID int64) ([]*PlanEntitlement, error)
+	Set(ctx context.Context, entitlement *PlanEntitlement) error
+	Delete(ctx context.Context, id int64) error
+}
@@ -6,10 +6,11 @@ import (
 )
 
 type PlanMeter struct {
-	ID        int64
-	Name      string
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID          int64
+	Name        string
+	Aggregation string // sum, max, last (see shared/pricing)
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
 }
 
 type PlanMeterRepository interface {
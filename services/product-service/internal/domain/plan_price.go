@@ -3,6 +3,8 @@ package domain
 import (
 	"context"
 	"time"
+
+	"github.com/damarteplok/damar-admin-cms/shared/pricing"
 )
 
 type PlanPrice struct {
@@ -11,15 +13,26 @@ type PlanPrice struct {
 	CurrencyID   int64
 	Price        int32
 	PricePerUnit int32
-	Type         string                 // flat_rate, per_unit, tiered
-	Tiers        map[string]interface{} // JSON object for tiered pricing
-	CreatedAt    time.Time
-	UpdatedAt    time.Time
+	Type         string         // flat_rate, per_unit, tiered
+	Tiers        *pricing.Tiers // nullable, set for tiered prices
+	// DerivedFromID points at the base currency price this price was
+	// converted from; nil for prices set by hand
+	DerivedFromID *int64
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// PriceQuote is the amount a plan price charges for one period at a quantity
+type PriceQuote struct {
+	PlanPrice *PlanPrice
+	Quote     *pricing.Quote
 }
 
 type PlanPriceRepository interface {
 	GetByID(ctx context.Context, id int64) (*PlanPrice, error)
 	GetByPlan(ctx context.Context, planID int64) ([]*PlanPrice, error)
+	// GetManualByCurrency returns the hand-set prices in the currency
+	GetManualByCurrency(ctx context.Context, currencyID int64) ([]*PlanPrice, error)
 	Create(ctx context.Context, planPrice *PlanPrice) error
 	Update(ctx context.Context, planPrice *PlanPrice) error
 	Delete(ctx context.Context, id int64) error
@@ -31,4 +44,7 @@ type PlanPriceService interface {
 	Create(ctx context.Context, planPrice *PlanPrice) error
 	Update(ctx context.Context, planPrice *PlanPrice) error
 	Delete(ctx context.Context, id int64) error
+	// Quote prices quantity units for one period with shared/pricing, the
+	// same math billing-service uses for invoices
+	Quote(ctx context.Context, id int64, quantity int64) (*PriceQuote, error)
 }
@@ -0,0 +1,32 @@
+package domain
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrNoBasePrice is returned when a plan has no manual price in the base
+// currency to derive from
+var ErrNoBasePrice = errors.New("plan has no manual price in the base currency")
+
+// PriceDerivation is the outcome of deriving a plan's prices from its base
+// currency price
+type PriceDerivation struct {
+	BasePrice *PlanPrice
+	// Derived holds the prices created or refreshed
+	Derived []*PlanPrice
+	// Skipped holds the codes of currencies left alone, either because they
+	// have a manual price or because no exchange rate is known
+	Skipped []string
+}
+
+// PriceDerivationService fills in a plan's missing currencies by converting
+// its base currency price with the current exchange rates and each
+// currency's rounding rules. Manual prices are never touched.
+type PriceDerivationService interface {
+	BaseCurrency(ctx context.Context) (*Currency, error)
+	DerivePlan(ctx context.Context, planID int64) (*PriceDerivation, error)
+	// DeriveAll derives every plan that has a base currency price and
+	// returns how many prices were created or refreshed
+	DeriveAll(ctx context.Context) (int, error)
+}
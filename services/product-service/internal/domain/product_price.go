@@ -0,0 +1,32 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// ProductPrice is the one-time purchase price of a product in one currency,
+// in the currency's minor unit
+type ProductPrice struct {
+	ID         int64     `json:"id"`
+	ProductID  int64     `json:"product_id"`
+	CurrencyID int64     `json:"currency_id"`
+	Price      int64     `json:"price"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+type ProductPriceRepository interface {
+	GetByID(ctx context.Context, id int64) (*ProductPrice, error)
+	GetByProduct(ctx context.Context, productID int64) ([]*ProductPrice, error)
+	GetByProductAndCurrency(ctx context.Context, productID, currencyID int64) (*ProductPrice, error)
+	// Upsert creates the product's price in the currency or replaces it
+	Upsert(ctx context.Context, price *ProductPrice) error
+	Delete(ctx context.Context, id int64) error
+}
+
+type ProductPriceService interface {
+	GetByProduct(ctx context.Context, productID int64) ([]*ProductPrice, error)
+	SetPrice(ctx context.Context, productID, currencyID, price int64) (*ProductPrice, error)
+	Delete(ctx context.Context, id int64) error
+}
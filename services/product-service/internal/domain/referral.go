@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	ReferralStatusPending   = "pending"
+	ReferralStatusConverted = "converted"
+)
+
+// Referral represents referrals table. A row is created when a user redeems
+// someone's personal referral code and converts once they pay.
+type Referral struct {
+	ID                       int64      `json:"id"`
+	DiscountCodeID           int64      `json:"discount_code_id"`
+	DiscountCodeRedemptionID int64      `json:"discount_code_redemption_id"`
+	ReferrerUserID           int64      `json:"referrer_user_id"`
+	ReferredUserID           int64      `json:"referred_user_id"`
+	SubscriptionID           *int64     `json:"subscription_id"`
+	Status                   string     `json:"status"`
+	RewardBonusDays          int32      `json:"reward_bonus_days"`
+	ConvertedAt              *time.Time `json:"converted_at"`
+	CreatedAt                time.Time  `json:"created_at"`
+	UpdatedAt                time.Time  `json:"updated_at"`
+}
+
+// ReferralStats summarizes the referrals of one referrer
+type ReferralStats struct {
+	ReferrerUserID     int64
+	Code               *DiscountCode // nil until the referrer asks for a code
+	TotalReferrals     int32
+	PendingReferrals   int32
+	ConvertedReferrals int32
+	BonusDaysEarned    int64
+}
+
+type ReferralRepository interface {
+	GetByReferrer(ctx context.Context, referrerUserID int64, page, perPage int) ([]*Referral, int, error)
+	GetStats(ctx context.Context, referrerUserID int64) (*ReferralStats, error)
+	// Convert marks the pending referral of referredUserID as converted and
+	// returns it, or nil when the user has no pending referral
+	Convert(ctx context.Context, referredUserID int64, subscriptionID *int64, rewardBonusDays int32, convertedAt time.Time) (*Referral, error)
+}
+
+type ReferralService interface {
+	// GetOrCreateCode returns the user's personal referral code, creating it
+	// on the configured referral discount the first time
+	GetOrCreateCode(ctx context.Context, referrerUserID int64) (*DiscountCode, error)
+	GetByReferrer(ctx context.Context, referrerUserID int64, page, perPage int) ([]*Referral, int, error)
+	GetStats(ctx context.Context, referrerUserID int64) (*ReferralStats, error)
+	// ConvertOnPayment converts the pending referral of a user who just paid
+	// and publishes referral.event.converted. It returns nil when there is
+	// nothing to convert.
+	ConvertOnPayment(ctx context.Context, referredUserID int64, subscriptionID *int64) (*Referral, error)
+}
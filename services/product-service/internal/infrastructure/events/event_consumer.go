@@ -0,0 +1,156 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/damarteplok/damar-admin-cms/services/product-service/internal/domain"
+	"github.com/damarteplok/damar-admin-cms/shared/amqp"
+	"github.com/damarteplok/damar-admin-cms/shared/contracts"
+	"github.com/damarteplok/damar-admin-cms/shared/logger"
+	"go.uber.org/zap"
+)
+
+type EventConsumer struct {
+	conn            *amqp.Connection
+	referralService domain.ReferralService
+	orderService    domain.OrderService
+}
+
+func NewEventConsumer(conn *amqp.Connection, referralService domain.ReferralService, orderService domain.OrderService) *EventConsumer {
+	return &EventConsumer{
+		conn:            conn,
+		referralService: referralService,
+		orderService:    orderService,
+	}
+}
+
+// ConsumeInvoicePaid converts the referral of a user once they pay for a
+// subscription. Free invoices, such as those of discounted first cycles, do
+// not count.
+func (ec *EventConsumer) ConsumeInvoicePaid(ctx context.Context) error {
+	consumer, err := amqp.NewConsumer(
+		ec.conn,
+		"product.invoice.paid",
+		"damar.events",
+		contracts.InvoiceEventPaid,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create consumer: %w", err)
+	}
+
+	logger.Info("Started consuming invoice.paid events")
+
+	return consumer.Consume(func(body []byte) error {
+		var message contracts.AmqpMessage
+		if err := json.Unmarshal(body, &message); err != nil {
+			logger.Error("Failed to unmarshal invoice.paid message", zap.Error(err))
+			return err
+		}
+
+		var eventData struct {
+			InvoiceID      int64 `json:"invoice_id"`
+			SubscriptionID int64 `json:"subscription_id"`
+			UserID         int64 `json:"user_id"`
+			Total          int64 `json:"total"`
+		}
+		if err := json.Unmarshal(message.Data, &eventData); err != nil {
+			logger.Error("Failed to unmarshal event data", zap.Error(err))
+			return err
+		}
+
+		if eventData.Total <= 0 || eventData.UserID <= 0 || eventData.SubscriptionID <= 0 {
+			return nil
+		}
+
+		referral, err := ec.referralService.ConvertOnPayment(ctx, eventData.UserID, &eventData.SubscriptionID)
+		if err != nil {
+			logger.Error("Failed to convert referral",
+				zap.Int64("invoice_id", eventData.InvoiceID),
+				zap.Int64("user_id", eventData.UserID),
+				zap.Error(err))
+			return err
+		}
+		if referral != nil {
+			logger.Info("Referral converted",
+				zap.Int64("referral_id", referral.ID),
+				zap.Int64("referrer_user_id", referral.ReferrerUserID),
+				zap.Int64("invoice_id", eventData.InvoiceID))
+		}
+		return nil
+	})
+}
+
+// checkoutEvent is the part of a payment event that identifies the checkout
+// session it settles
+type checkoutEvent struct {
+	Provider          string `json:"provider"`
+	CheckoutSessionID string `json:"checkout_session_id"`
+}
+
+// ConsumePaymentSucceeded marks orders paid when the provider reports their
+// checkout session as completed
+func (ec *EventConsumer) ConsumePaymentSucceeded(ctx context.Context) error {
+	return ec.consumeCheckoutEvent(ctx, "product.payment.success", contracts.PaymentEventSuccess, ec.orderService.MarkCheckoutPaid)
+}
+
+// ConsumePaymentCancelled cancels pending orders whose checkout session
+// expired or was abandoned
+func (ec *EventConsumer) ConsumePaymentCancelled(ctx context.Context) error {
+	return ec.consumeCheckoutEvent(ctx, "product.payment.cancelled", contracts.PaymentEventCancelled, ec.orderService.CancelCheckout)
+}
+
+func (ec *EventConsumer) consumeCheckoutEvent(
+	ctx context.Context,
+	queueName string,
+	routingKey string,
+	apply func(ctx context.Context, providerSlug, checkoutID string) (*domain.Order, error),
+) error {
+	consumer, err := amqp.NewConsumer(
+		ec.conn,
+		queueName,
+		"damar.events",
+		routingKey,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create consumer: %w", err)
+	}
+
+	logger.Info("Started consuming payment events for orders", zap.String("routing_key", routingKey))
+
+	return consumer.Consume(func(body []byte) error {
+		var message contracts.AmqpMessage
+		if err := json.Unmarshal(body, &message); err != nil {
+			logger.Error("Failed to unmarshal payment message", zap.Error(err))
+			return err
+		}
+
+		var eventData checkoutEvent
+		if err := json.Unmarshal(message.Data, &eventData); err != nil {
+			logger.Error("Failed to unmarshal event data", zap.Error(err))
+			return err
+		}
+
+		// Charges of invoices carry no checkout session
+		if eventData.Provider == "" || eventData.CheckoutSessionID == "" {
+			return nil
+		}
+
+		order, err := apply(ctx, eventData.Provider, eventData.CheckoutSessionID)
+		if err != nil {
+			logger.Error("Failed to update order from payment event",
+				zap.String("routing_key", routingKey),
+				zap.String("provider", eventData.Provider),
+				zap.String("checkout_session_id", eventData.CheckoutSessionID),
+				zap.Error(err))
+			return err
+		}
+		if order != nil {
+			logger.Info("Order updated from payment event",
+				zap.Int64("order_id", order.ID),
+				zap.String("status", order.Status))
+		}
+		return nil
+	})
+}
@@ -0,0 +1,64 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/damarteplok/damar-admin-cms/services/product-service/internal/domain"
+	pb "github.com/damarteplok/damar-admin-cms/shared/proto/product"
+	"github.com/damarteplok/damar-admin-cms/shared/util"
+)
+
+// Checkout operations
+
+func (h *ProductHandler) PreviewCheckout(ctx context.Context, req *pb.PreviewCheckoutRequest) (*pb.PreviewCheckoutResponse, error) {
+	preview, err := h.checkoutService.PreviewCheckout(ctx, req.PlanPriceId, req.Quantity, req.DiscountCode)
+	if err != nil {
+		return &pb.PreviewCheckoutResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &pb.PreviewCheckoutResponse{
+		Success: true,
+		Message: "Checkout previewed successfully",
+		Data:    domainCheckoutPreviewToPb(preview),
+	}, nil
+}
+
+func domainCheckoutPreviewToPb(preview *domain.CheckoutPreview) *pb.CheckoutPreview {
+	lines := make([]*pb.CheckoutLine, len(preview.Lines))
+	for i, line := range preview.Lines {
+		lines[i] = &pb.CheckoutLine{
+			Type:        line.Type,
+			Description: line.Description,
+			Quantity:    line.Quantity,
+			UnitAmount:  line.UnitAmount,
+			Amount:      line.Amount,
+		}
+	}
+
+	result := &pb.CheckoutPreview{
+		PlanId:          preview.Plan.ID,
+		PlanPriceId:     preview.PlanPrice.ID,
+		CurrencyId:      preview.PlanPrice.CurrencyID,
+		Quantity:        preview.Quantity,
+		Lines:           lines,
+		Subtotal:        preview.Subtotal,
+		DiscountAmount:  preview.DiscountAmount,
+		Total:           preview.Total,
+		RecurringTotal:  preview.RecurringTotal,
+		DiscountCycles:  preview.DiscountCycles,
+		DiscountForever: preview.DiscountForever,
+		TrialDays:       preview.TrialDays,
+		BonusDays:       preview.BonusDays,
+		TrialEndsAt:     util.TimeToUnix(preview.TrialEndsAt),
+	}
+	if preview.Discount != nil {
+		result.Discount = domainDiscountToPb(preview.Discount)
+	}
+	if preview.DiscountCode != nil {
+		result.DiscountCode = domainDiscountCodeToPb(preview.DiscountCode)
+	}
+	return result
+}
@@ -0,0 +1,246 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/damarteplok/damar-admin-cms/services/product-service/internal/domain"
+	pb "github.com/damarteplok/damar-admin-cms/shared/proto/product"
+)
+
+// defaultDecimalPlaces applies when a new currency does not say otherwise
+const defaultDecimalPlaces = 2
+
+// Currency operations
+
+func (h *ProductHandler) GetCurrencies(ctx context.Context, req *pb.GetCurrenciesRequest) (*pb.GetCurrenciesResponse, error) {
+	currencies, err := h.currencyService.GetAll(ctx)
+	if err != nil {
+		return &pb.GetCurrenciesResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	data := make([]*pb.Currency, len(currencies))
+	for i, currency := range currencies {
+		data[i] = domainCurrencyToPb(currency)
+	}
+
+	return &pb.GetCurrenciesResponse{
+		Success: true,
+		Message: "Currencies retrieved successfully",
+		Data:    data,
+	}, nil
+}
+
+func (h *ProductHandler) GetCurrencyByID(ctx context.Context, req *pb.GetCurrencyByIDRequest) (*pb.GetCurrencyByIDResponse, error) {
+	currency, err := h.currencyService.GetByID(ctx, req.Id)
+	if err != nil {
+		return &pb.GetCurrencyByIDResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &pb.GetCurrencyByIDResponse{
+		Success: true,
+		Message: "Currency retrieved successfully",
+		Data:    domainCurrencyToPb(currency),
+	}, nil
+}
+
+func (h *ProductHandler) CreateCurrency(ctx context.Context, req *pb.CreateCurrencyRequest) (*pb.CreateCurrencyResponse, error) {
+	currency := &domain.Currency{
+		Code:              req.Code,
+		Name:              req.Name,
+		Symbol:            req.Symbol,
+		DecimalPlaces:     defaultDecimalPlaces,
+		RoundingIncrement: req.RoundingIncrement,
+		RoundingMode:      req.RoundingMode,
+	}
+	if req.DecimalPlaces != nil {
+		currency.DecimalPlaces = *req.DecimalPlaces
+	}
+
+	if err := h.currencyService.Create(ctx, currency); err != nil {
+		return &pb.CreateCurrencyResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &pb.CreateCurrencyResponse{
+		Success: true,
+		Message: "Currency created successfully",
+		Data:    domainCurrencyToPb(currency),
+	}, nil
+}
+
+func (h *ProductHandler) UpdateCurrency(ctx context.Context, req *pb.UpdateCurrencyRequest) (*pb.UpdateCurrencyResponse, error) {
+	currency, err := h.currencyService.Update(ctx, domain.UpdateCurrencyParams{
+		ID:                req.Id,
+		Name:              req.Name,
+		Symbol:            req.Symbol,
+		DecimalPlaces:     req.DecimalPlaces,
+		RoundingIncrement: req.RoundingIncrement,
+		RoundingMode:      req.RoundingMode,
+	})
+	if err != nil {
+		return &pb.UpdateCurrencyResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &pb.UpdateCurrencyResponse{
+		Success: true,
+		Message: "Currency updated successfully",
+		Data:    domainCurrencyToPb(currency),
+	}, nil
+}
+
+func (h *ProductHandler) DeleteCurrency(ctx context.Context, req *pb.DeleteCurrencyRequest) (*pb.DeleteCurrencyResponse, error) {
+	if err := h.currencyService.Delete(ctx, req.Id); err != nil {
+		return &pb.DeleteCurrencyResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &pb.DeleteCurrencyResponse{
+		Success: true,
+		Message: "Currency deleted successfully",
+	}, nil
+}
+
+// Exchange rate operations
+
+func (h *ProductHandler) GetExchangeRates(ctx context.Context, req *pb.GetExchangeRatesRequest) (*pb.GetExchangeRatesResponse, error) {
+	rates, total, err := h.exchangeRateService.GetAll(ctx, req.BaseCurrencyId, req.QuoteCurrencyId, int(req.Page), int(req.PerPage))
+	if err != nil {
+		return &pb.GetExchangeRatesResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &pb.GetExchangeRatesResponse{
+		Success: true,
+		Message: "Exchange rates retrieved successfully",
+		Data:    domainExchangeRatesToPb(rates),
+		Total:   int32(total),
+		Page:    req.Page,
+		PerPage: req.PerPage,
+	}, nil
+}
+
+func (h *ProductHandler) SetExchangeRate(ctx context.Context, req *pb.SetExchangeRateRequest) (*pb.SetExchangeRateResponse, error) {
+	var effectiveAt *time.Time
+	if req.EffectiveAt > 0 {
+		t := time.Unix(req.EffectiveAt, 0)
+		effectiveAt = &t
+	}
+
+	rate, err := h.exchangeRateService.SetRate(ctx, req.BaseCurrencyId, req.QuoteCurrencyId, req.Rate, effectiveAt)
+	if err != nil {
+		return &pb.SetExchangeRateResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &pb.SetExchangeRateResponse{
+		Success: true,
+		Message: "Exchange rate saved successfully",
+		Data:    domainExchangeRateToPb(rate),
+	}, nil
+}
+
+func (h *ProductHandler) ImportExchangeRates(ctx context.Context, req *pb.ImportExchangeRatesRequest) (*pb.ImportExchangeRatesResponse, error) {
+	rates, err := h.exchangeRateService.ImportRates(ctx, req.Content)
+	if err != nil {
+		return &pb.ImportExchangeRatesResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &pb.ImportExchangeRatesResponse{
+		Success: true,
+		Message: "Exchange rates imported successfully",
+		Data:    domainExchangeRatesToPb(rates),
+	}, nil
+}
+
+func (h *ProductHandler) DeleteExchangeRate(ctx context.Context, req *pb.DeleteExchangeRateRequest) (*pb.DeleteExchangeRateResponse, error) {
+	if err := h.exchangeRateService.Delete(ctx, req.Id); err != nil {
+		return &pb.DeleteExchangeRateResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &pb.DeleteExchangeRateResponse{
+		Success: true,
+		Message: "Exchange rate deleted successfully",
+	}, nil
+}
+
+func (h *ProductHandler) DerivePlanPrices(ctx context.Context, req *pb.DerivePlanPricesRequest) (*pb.DerivePlanPricesResponse, error) {
+	derivation, err := h.priceDerivationService.DerivePlan(ctx, req.PlanId)
+	if err != nil {
+		return &pb.DerivePlanPricesResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	derived := make([]*pb.PlanPrice, len(derivation.Derived))
+	for i, price := range derivation.Derived {
+		derived[i] = domainPlanPriceToPb(price)
+	}
+
+	return &pb.DerivePlanPricesResponse{
+		Success:   true,
+		Message:   "Plan prices derived successfully",
+		BasePrice: domainPlanPriceToPb(derivation.BasePrice),
+		Derived:   derived,
+		Skipped:   derivation.Skipped,
+	}, nil
+}
+
+func domainCurrencyToPb(currency *domain.Currency) *pb.Currency {
+	return &pb.Currency{
+		Id:                currency.ID,
+		Code:              currency.Code,
+		Name:              currency.Name,
+		Symbol:            currency.Symbol,
+		DecimalPlaces:     currency.DecimalPlaces,
+		RoundingIncrement: currency.RoundingIncrement,
+		RoundingMode:      currency.RoundingMode,
+		CreatedAt:         currency.CreatedAt.Unix(),
+		UpdatedAt:         currency.UpdatedAt.Unix(),
+	}
+}
+
+func domainExchangeRatesToPb(rates []*domain.ExchangeRate) []*pb.ExchangeRate {
+	data := make([]*pb.ExchangeRate, len(rates))
+	for i, rate := range rates {
+		data[i] = domainExchangeRateToPb(rate)
+	}
+	return data
+}
+
+func domainExchangeRateToPb(rate *domain.ExchangeRate) *pb.ExchangeRate {
+	return &pb.ExchangeRate{
+		Id:              rate.ID,
+		BaseCurrencyId:  rate.BaseCurrencyID,
+		QuoteCurrencyId: rate.QuoteCurrencyID,
+		Rate:            rate.Rate,
+		Source:          rate.Source,
+		EffectiveAt:     rate.EffectiveAt.Unix(),
+		CreatedAt:       rate.CreatedAt.Unix(),
+		UpdatedAt:       rate.UpdatedAt.Unix(),
+	}
+}
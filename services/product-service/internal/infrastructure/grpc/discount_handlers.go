@@ -4,6 +4,7 @@ package grpc
 // This file contains all discount-related RPC methods
 
 import (
+	"bytes"
 	"context"
 	"time"
 
@@ -311,6 +312,64 @@ func (h *ProductHandler) DeleteDiscountCode(ctx context.Context, req *pb.DeleteD
 	}, nil
 }
 
+// GenerateDiscountCodes creates codes in bulk and streams each inserted batch
+func (h *ProductHandler) GenerateDiscountCodes(req *pb.GenerateDiscountCodesRequest, stream pb.ProductService_GenerateDiscountCodesServer) error {
+	params := domain.GenerateDiscountCodesParams{
+		DiscountID: req.DiscountId,
+		Count:      int(req.Count),
+		Prefix:     req.Prefix,
+		Length:     int(req.Length),
+		Alphabet:   req.Alphabet,
+		MaxUses:    req.MaxUses,
+	}
+
+	generated := 0
+	_, err := h.discountCodeService.Generate(stream.Context(), params, func(codes []*domain.DiscountCode) error {
+		generated += len(codes)
+
+		pbCodes := make([]*pb.DiscountCode, len(codes))
+		for i, code := range codes {
+			pbCodes[i] = domainDiscountCodeToPb(code)
+		}
+
+		return stream.Send(&pb.GenerateDiscountCodesResponse{
+			Success:   true,
+			Message:   "Discount codes generated successfully",
+			Data:      pbCodes,
+			Generated: int32(generated),
+		})
+	})
+	if err != nil {
+		// Batches already sent stay committed; the last message reports how far it got
+		return stream.Send(&pb.GenerateDiscountCodesResponse{
+			Success:   false,
+			Message:   err.Error(),
+			Generated: int32(generated),
+		})
+	}
+
+	return nil
+}
+
+// ExportDiscountCodes returns all codes of a discount as CSV
+func (h *ProductHandler) ExportDiscountCodes(ctx context.Context, req *pb.ExportDiscountCodesRequest) (*pb.ExportDiscountCodesResponse, error) {
+	var buf bytes.Buffer
+	total, err := h.discountCodeService.ExportCSV(ctx, req.DiscountId, &buf)
+	if err != nil {
+		return &pb.ExportDiscountCodesResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &pb.ExportDiscountCodesResponse{
+		Success: true,
+		Message: "Discount codes exported successfully",
+		Csv:     buf.String(),
+		Total:   int32(total),
+	}, nil
+}
+
 // Discount Plan operations
 
 // AddPlanToDiscount adds a plan to a discount
@@ -423,6 +482,33 @@ func (h *ProductHandler) GetOneTimeProductsByDiscount(ctx context.Context, req *
 	}, nil
 }
 
+// Discount Code Redemption operations
+
+// RedeemDiscountCode records a redemption, enforcing the code and discount limits
+func (h *ProductHandler) RedeemDiscountCode(ctx context.Context, req *pb.RedeemDiscountCodeRequest) (*pb.RedeemDiscountCodeResponse, error) {
+	code, err := h.discountCodeService.GetByCode(ctx, req.Code)
+	if err != nil {
+		return &pb.RedeemDiscountCodeResponse{
+			Success: false,
+			Message: "discount code not found",
+		}, nil
+	}
+
+	redemption, err := h.discountCodeRedemptionService.RedeemCode(ctx, code.ID, req.UserId, optionalID(req.SubscriptionId), optionalID(req.OrderId))
+	if err != nil {
+		return &pb.RedeemDiscountCodeResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &pb.RedeemDiscountCodeResponse{
+		Success: true,
+		Message: "Discount code redeemed successfully",
+		Data:    domainDiscountCodeRedemptionToPb(redemption),
+	}, nil
+}
+
 // Discount Payment Provider Data operations
 // Note: Proto uses different field names than domain (payment_provider_id vs Provider)
 // We'll need to map these appropriately or update proto/domain to match
@@ -545,13 +631,43 @@ func domainDiscountToPb(discount *domain.Discount) *pb.Discount {
 }
 
 func domainDiscountCodeToPb(code *domain.DiscountCode) *pb.DiscountCode {
-	return &pb.DiscountCode{
+	result := &pb.DiscountCode{
 		Id:         code.ID,
 		Code:       code.Code,
 		DiscountId: code.DiscountID,
 		CreatedAt:  code.CreatedAt.Unix(),
 		UpdatedAt:  code.UpdatedAt.Unix(),
 	}
+	if code.ReferrerUserID != nil {
+		result.ReferrerUserId = *code.ReferrerUserID
+	}
+	return result
+}
+
+func domainDiscountCodeRedemptionToPb(redemption *domain.DiscountCodeRedemption) *pb.DiscountCodeRedemption {
+	result := &pb.DiscountCodeRedemption{
+		Id:             redemption.ID,
+		DiscountCodeId: redemption.DiscountCodeID,
+		UserId:         redemption.UserID,
+		RedeemedAt:     redemption.RedeemedAt.Unix(),
+		CreatedAt:      redemption.CreatedAt.Unix(),
+		UpdatedAt:      redemption.UpdatedAt.Unix(),
+	}
+	if redemption.SubscriptionID != nil {
+		result.SubscriptionId = *redemption.SubscriptionID
+	}
+	if redemption.OrderID != nil {
+		result.OrderId = *redemption.OrderID
+	}
+	return result
+}
+
+// optionalID maps an unset (zero) proto ID to nil
+func optionalID(id int64) *int64 {
+	if id <= 0 {
+		return nil
+	}
+	return &id
 }
 
 func domainDiscountPaymentProviderDataToPb(data *domain.DiscountPaymentProviderData) *pb.DiscountPaymentProviderData {
@@ -0,0 +1,83 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/damarteplok/damar-admin-cms/services/product-service/internal/domain"
+	pb "github.com/damarteplok/damar-admin-cms/shared/proto/product"
+)
+
+// Plan entitlement operations
+
+func (h *ProductHandler) GetPlanEntitlements(ctx context.Context, req *pb.GetPlanEntitlementsRequest) (*pb.GetPlanEntitlementsResponse, error) {
+	entitlements, err := h.planEntitlementService.GetByPlan(ctx, req.PlanId)
+	if err != nil {
+		return &pb.GetPlanEntitlementsResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	data := make([]*pb.PlanEntitlement, len(entitlements))
+	for i, entitlement := range entitlements {
+		data[i] = domainPlanEntitlementToPb(entitlement)
+	}
+
+	return &pb.GetPlanEntitlementsResponse{
+		Success: true,
+		Message: "Plan entitlements retrieved successfully",
+		Data:    data,
+	}, nil
+}
+
+func (h *ProductHandler) SetPlanEntitlement(ctx context.Context, req *pb.SetPlanEntitlementRequest) (*pb.SetPlanEntitlementResponse, error) {
+	entitlement := &domain.PlanEntitlement{
+		PlanID:     req.PlanId,
+		FeatureKey: req.FeatureKey,
+		Type:       req.Type,
+		IsEnabled:  req.IsEnabled,
+		LimitValue: req.LimitValue,
+		MeterID:    req.MeterId,
+	}
+
+	if err := h.planEntitlementService.Set(ctx, entitlement); err != nil {
+		return &pb.SetPlanEntitlementResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &pb.SetPlanEntitlementResponse{
+		Success: true,
+		Message: "Plan entitlement saved successfully",
+		Data:    domainPlanEntitlementToPb(entitlement),
+	}, nil
+}
+
+func (h *ProductHandler) DeletePlanEntitlement(ctx context.Context, req *pb.DeletePlanEntitlementRequest) (*pb.DeletePlanEntitlementResponse, error) {
+	if err := h.planEntitlementService.Delete(ctx, req.Id); err != nil {
+		return &pb.DeletePlanEntitlementResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &pb.DeletePlanEntitlementResponse{
+		Success: true,
+		Message: "Plan entitlement deleted successfully",
+	}, nil
+}
+
+func domainPlanEntitlementToPb(entitlement *domain.PlanEntitlement) *pb.PlanEntitlement {
+	return &pb.PlanEntitlement{
+		Id:         entitlement.ID,
+		PlanId:     entitlement.PlanID,
+		FeatureKey: entitlement.FeatureKey,
+		Type:       entitlement.Type,
+		IsEnabled:  entitlement.IsEnabled,
+		LimitValue: entitlement.LimitValue,
+		MeterId:    entitlement.MeterID,
+		CreatedAt:  entitlement.CreatedAt.Unix(),
+		UpdatedAt:  entitlement.UpdatedAt.Unix(),
+	}
+}
@@ -0,0 +1,329 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/damarteplok/damar-admin-cms/services/product-service/internal/domain"
+	pb "github.com/damarteplok/damar-admin-cms/shared/proto/product"
+	"github.com/damarteplok/damar-admin-cms/shared/tenancy"
+	"github.com/damarteplok/damar-admin-cms/shared/util"
+)
+
+// Product price operations
+
+func (h *ProductHandler) SetProductPrice(ctx context.Context, req *pb.SetProductPriceRequest) (*pb.SetProductPriceResponse, error) {
+	price, err := h.productPriceService.SetPrice(ctx, req.ProductId, req.CurrencyId, req.Price)
+	if err != nil {
+		return &pb.SetProductPriceResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &pb.SetProductPriceResponse{
+		Success: true,
+		Message: "Product price saved successfully",
+		Data:    domainProductPriceToPb(price),
+	}, nil
+}
+
+func (h *ProductHandler) GetProductPrices(ctx context.Context, req *pb.GetProductPricesRequest) (*pb.GetProductPricesResponse, error) {
+	prices, err := h.productPriceService.GetByProduct(ctx, req.ProductId)
+	if err != nil {
+		return &pb.GetProductPricesResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	data := make([]*pb.ProductPrice, len(prices))
+	for i, price := range prices {
+		data[i] = domainProductPriceToPb(price)
+	}
+
+	return &pb.GetProductPricesResponse{
+		Success: true,
+		Message: "Product prices retrieved successfully",
+		Data:    data,
+	}, nil
+}
+
+func (h *ProductHandler) DeleteProductPrice(ctx context.Context, req *pb.DeleteProductPriceRequest) (*pb.DeleteProductPriceResponse, error) {
+	if err := h.productPriceService.Delete(ctx, req.Id); err != nil {
+		return &pb.DeleteProductPriceResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &pb.DeleteProductPriceResponse{
+		Success: true,
+		Message: "Product price deleted successfully",
+	}, nil
+}
+
+// Order operations
+
+func (h *ProductHandler) CreateOrder(ctx context.Context, req *pb.CreateOrderRequest) (*pb.CreateOrderResponse, error) {
+	params := domain.CreateOrderParams{
+		UserID:          req.UserId,
+		CurrencyID:      req.CurrencyId,
+		DiscountCode:    req.DiscountCode,
+		PaymentProvider: req.PaymentProvider,
+		SuccessURL:      req.SuccessUrl,
+		CancelURL:       req.CancelUrl,
+	}
+	if req.TenantId > 0 {
+		// An order for a tenant must be for the caller's active tenant
+		scoped, tenantID, err := tenancy.Scope(ctx, req.TenantId)
+		if err != nil {
+			return &pb.CreateOrderResponse{
+				Success: false,
+				Message: err.Error(),
+			}, nil
+		}
+		ctx = scoped
+		params.TenantID = &tenantID
+	}
+	for _, item := range req.Items {
+		params.Items = append(params.Items, domain.OrderItemParams{
+			ProductID: item.ProductId,
+			Quantity:  item.Quantity,
+		})
+	}
+
+	order, err := h.orderService.Create(ctx, params)
+	if err != nil {
+		return &pb.CreateOrderResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &pb.CreateOrderResponse{
+		Success: true,
+		Message: "Order created successfully",
+		Data:    domainOrderToPb(order),
+	}, nil
+}
+
+func (h *ProductHandler) GetOrderByID(ctx context.Context, req *pb.GetOrderByIDRequest) (*pb.GetOrderByIDResponse, error) {
+	ctx, err := h.scopeOrder(ctx, req.Id)
+	if err != nil {
+		return &pb.GetOrderByIDResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	order, err := h.orderService.GetByID(ctx, req.Id)
+	if err != nil {
+		return &pb.GetOrderByIDResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &pb.GetOrderByIDResponse{
+		Success: true,
+		Message: "Order retrieved successfully",
+		Data:    domainOrderToPb(order),
+	}, nil
+}
+
+func (h *ProductHandler) GetOrdersByUser(ctx context.Context, req *pb.GetOrdersByUserRequest) (*pb.GetOrdersByUserResponse, error) {
+	orders, total, err := h.orderService.GetByUser(ctx, req.UserId, int(req.Page), int(req.PerPage))
+	if err != nil {
+		return &pb.GetOrdersByUserResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &pb.GetOrdersByUserResponse{
+		Success: true,
+		Message: "Orders retrieved successfully",
+		Data:    domainOrderListToPb(orders, total, req.Page, req.PerPage),
+	}, nil
+}
+
+func (h *ProductHandler) GetAllOrders(ctx context.Context, req *pb.GetAllOrdersRequest) (*pb.GetAllOrdersResponse, error) {
+	orders, total, err := h.orderService.GetAll(ctx, req.Status, int(req.Page), int(req.PerPage))
+	if err != nil {
+		return &pb.GetAllOrdersResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &pb.GetAllOrdersResponse{
+		Success: true,
+		Message: "Orders retrieved successfully",
+		Data:    domainOrderListToPb(orders, total, req.Page, req.PerPage),
+	}, nil
+}
+
+func (h *ProductHandler) MarkOrderPaid(ctx context.Context, req *pb.MarkOrderPaidRequest) (*pb.MarkOrderPaidResponse, error) {
+	ctx, err := h.scopeOrder(ctx, req.Id)
+	if err != nil {
+		return &pb.MarkOrderPaidResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	order, err := h.orderService.MarkPaid(ctx, req.Id)
+	if err != nil {
+		return &pb.MarkOrderPaidResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &pb.MarkOrderPaidResponse{
+		Success: true,
+		Message: "Order marked as paid",
+		Data:    domainOrderToPb(order),
+	}, nil
+}
+
+func (h *ProductHandler) CancelOrder(ctx context.Context, req *pb.CancelOrderRequest) (*pb.CancelOrderResponse, error) {
+	ctx, err := h.scopeOrder(ctx, req.Id)
+	if err != nil {
+		return &pb.CancelOrderResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	order, err := h.orderService.Cancel(ctx, req.Id)
+	if err != nil {
+		return &pb.CancelOrderResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &pb.CancelOrderResponse{
+		Success: true,
+		Message: "Order cancelled successfully",
+		Data:    domainOrderToPb(order),
+	}, nil
+}
+
+func (h *ProductHandler) RefundOrder(ctx context.Context, req *pb.RefundOrderRequest) (*pb.RefundOrderResponse, error) {
+	ctx, err := h.scopeOrder(ctx, req.Id)
+	if err != nil {
+		return &pb.RefundOrderResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	order, err := h.orderService.Refund(ctx, req.Id)
+	if err != nil {
+		return &pb.RefundOrderResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &pb.RefundOrderResponse{
+		Success: true,
+		Message: "Order marked as refunded",
+		Data:    domainOrderToPb(order),
+	}, nil
+}
+
+// scopeOrder checks an order placed for a tenant belongs to the caller's
+// active tenant, returning ctx scoped to it. Personal orders are left to
+// the caller's own checks.
+func (h *ProductHandler) scopeOrder(ctx context.Context, id int64) (context.Context, error) {
+	order, err := h.orderService.GetByID(ctx, id)
+	if err != nil {
+		return ctx, err
+	}
+	if order.TenantID == nil {
+		return ctx, nil
+	}
+	ctx, _, err = tenancy.Scope(ctx, *order.TenantID)
+	return ctx, err
+}
+
+func domainProductPriceToPb(price *domain.ProductPrice) *pb.ProductPrice {
+	return &pb.ProductPrice{
+		Id:         price.ID,
+		ProductId:  price.ProductID,
+		CurrencyId: price.CurrencyID,
+		Price:      price.Price,
+		CreatedAt:  price.CreatedAt.Unix(),
+		UpdatedAt:  price.UpdatedAt.Unix(),
+	}
+}
+
+func domainOrderListToPb(orders []*domain.Order, total int, page, perPage int32) *pb.OrderList {
+	pbOrders := make([]*pb.Order, len(orders))
+	for i, order := range orders {
+		pbOrders[i] = domainOrderToPb(order)
+	}
+
+	return &pb.OrderList{
+		Orders:  pbOrders,
+		Total:   int32(total),
+		Page:    page,
+		PerPage: perPage,
+	}
+}
+
+func domainOrderToPb(order *domain.Order) *pb.Order {
+	result := &pb.Order{
+		Id:            order.ID,
+		Uuid:          order.UUID,
+		UserId:        order.UserID,
+		CurrencyId:    order.CurrencyID,
+		Status:        order.Status,
+		Subtotal:      order.Subtotal,
+		DiscountTotal: order.DiscountTotal,
+		Total:         order.Total,
+		PaidAt:        util.TimeToUnix(order.PaidAt),
+		CancelledAt:   util.TimeToUnix(order.CancelledAt),
+		RefundedAt:    util.TimeToUnix(order.RefundedAt),
+		CreatedAt:     order.CreatedAt.Unix(),
+		UpdatedAt:     order.UpdatedAt.Unix(),
+	}
+	if order.TenantID != nil {
+		result.TenantId = *order.TenantID
+	}
+	if order.DiscountCodeID != nil {
+		result.DiscountCodeId = *order.DiscountCodeID
+	}
+	if order.PaymentProviderID != nil {
+		result.PaymentProviderId = *order.PaymentProviderID
+	}
+	if order.PaymentProviderCheckoutID != nil {
+		result.PaymentProviderCheckoutId = *order.PaymentProviderCheckoutID
+	}
+	if order.CheckoutURL != nil {
+		result.CheckoutUrl = *order.CheckoutURL
+	}
+
+	for _, item := range order.Items {
+		pbItem := &pb.OrderItem{
+			Id:             item.ID,
+			OrderId:        item.OrderID,
+			Description:    item.Description,
+			Quantity:       item.Quantity,
+			UnitAmount:     item.UnitAmount,
+			DiscountAmount: item.DiscountAmount,
+			Amount:         item.Amount,
+			CreatedAt:      item.CreatedAt.Unix(),
+			UpdatedAt:      item.UpdatedAt.Unix(),
+		}
+		if item.ProductID != nil {
+			pbItem.ProductId = *item.ProductID
+		}
+		result.Items = append(result.Items, pbItem)
+	}
+
+	return result
+}
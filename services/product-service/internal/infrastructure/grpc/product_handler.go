@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/damarteplok/damar-admin-cms/services/product-service/internal/domain"
+	"github.com/damarteplok/damar-admin-cms/shared/pricing"
 	pb "github.com/damarteplok/damar-admin-cms/shared/proto/product"
 )
 
@@ -21,6 +22,14 @@ type ProductHandler struct {
 	discountPlanService                domain.DiscountPlanService
 	discountOneTimeProductService      domain.DiscountOneTimeProductService
 	discountPaymentProviderDataService domain.DiscountPaymentProviderDataService
+	checkoutService                    domain.CheckoutService
+	referralService                    domain.ReferralService
+	productPriceService                domain.ProductPriceService
+	orderService                       domain.OrderService
+	currencyService                    domain.CurrencyService
+	exchangeRateService                domain.ExchangeRateService
+	priceDerivationService             domain.PriceDerivationService
+	planEntitlementService             domain.PlanEntitlementService
 }
 
 func NewProductHandler(
@@ -34,6 +43,14 @@ func NewProductHandler(
 	discountPlanService domain.DiscountPlanService,
 	discountOneTimeProductService domain.DiscountOneTimeProductService,
 	discountPaymentProviderDataService domain.DiscountPaymentProviderDataService,
+	checkoutService domain.CheckoutService,
+	referralService domain.ReferralService,
+	productPriceService domain.ProductPriceService,
+	orderService domain.OrderService,
+	currencyService domain.CurrencyService,
+	exchangeRateService domain.ExchangeRateService,
+	priceDerivationService domain.PriceDerivationService,
+	planEntitlementService domain.PlanEntitlementService,
 ) *ProductHandler {
 	return &ProductHandler{
 		productService:                     productService,
@@ -46,6 +63,14 @@ func NewProductHandler(
 		discountPlanService:                discountPlanService,
 		discountOneTimeProductService:      discountOneTimeProductService,
 		discountPaymentProviderDataService: discountPaymentProviderDataService,
+		checkoutService:                    checkoutService,
+		referralService:                    referralService,
+		productPriceService:                productPriceService,
+		orderService:                       orderService,
+		currencyService:                    currencyService,
+		exchangeRateService:                exchangeRateService,
+		priceDerivationService:             priceDerivationService,
+		planEntitlementService:             planEntitlementService,
 	}
 }
 
@@ -442,9 +467,12 @@ func (h *ProductHandler) GetPlanPricesByPlan(ctx context.Context, req *pb.GetPla
 }
 
 func (h *ProductHandler) CreatePlanPrice(ctx context.Context, req *pb.CreatePlanPriceRequest) (*pb.CreatePlanPriceResponse, error) {
-	tiers := make(map[string]interface{})
-	if req.Tiers != "" {
-		json.Unmarshal([]byte(req.Tiers), &tiers)
+	tiers, err := pricing.ParseTiers([]byte(req.Tiers))
+	if err != nil {
+		return &pb.CreatePlanPriceResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
 	}
 
 	planPrice := &domain.PlanPrice{
@@ -456,7 +484,7 @@ func (h *ProductHandler) CreatePlanPrice(ctx context.Context, req *pb.CreatePlan
 		Tiers:        tiers,
 	}
 
-	err := h.planPriceService.Create(ctx, planPrice)
+	err = h.planPriceService.Create(ctx, planPrice)
 	if err != nil {
 		return &pb.CreatePlanPriceResponse{
 			Success: false,
@@ -472,9 +500,12 @@ func (h *ProductHandler) CreatePlanPrice(ctx context.Context, req *pb.CreatePlan
 }
 
 func (h *ProductHandler) UpdatePlanPrice(ctx context.Context, req *pb.UpdatePlanPriceRequest) (*pb.UpdatePlanPriceResponse, error) {
-	tiers := make(map[string]interface{})
-	if req.Tiers != "" {
-		json.Unmarshal([]byte(req.Tiers), &tiers)
+	tiers, err := pricing.ParseTiers([]byte(req.Tiers))
+	if err != nil {
+		return &pb.UpdatePlanPriceResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
 	}
 
 	planPrice := &domain.PlanPrice{
@@ -485,7 +516,7 @@ func (h *ProductHandler) UpdatePlanPrice(ctx context.Context, req *pb.UpdatePlan
 		Tiers:        tiers,
 	}
 
-	err := h.planPriceService.Update(ctx, planPrice)
+	err = h.planPriceService.Update(ctx, planPrice)
 	if err != nil {
 		return &pb.UpdatePlanPriceResponse{
 			Success: false,
@@ -515,6 +546,22 @@ func (h *ProductHandler) DeletePlanPrice(ctx context.Context, req *pb.DeletePlan
 	}, nil
 }
 
+func (h *ProductHandler) QuotePrice(ctx context.Context, req *pb.QuotePriceRequest) (*pb.QuotePriceResponse, error) {
+	quote, err := h.planPriceService.Quote(ctx, req.PlanPriceId, req.Quantity)
+	if err != nil {
+		return &pb.QuotePriceResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &pb.QuotePriceResponse{
+		Success: true,
+		Message: "Price quoted successfully",
+		Data:    domainPriceQuoteToPb(quote),
+	}, nil
+}
+
 // Plan Meter operations
 
 func (h *ProductHandler) GetPlanMeterByID(ctx context.Context, req *pb.GetPlanMeterByIDRequest) (*pb.GetPlanMeterByIDResponse, error) {
@@ -570,7 +617,8 @@ func (h *ProductHandler) GetAllPlanMeters(ctx context.Context, req *pb.GetAllPla
 
 func (h *ProductHandler) CreatePlanMeter(ctx context.Context, req *pb.CreatePlanMeterRequest) (*pb.CreatePlanMeterResponse, error) {
 	planMeter := &domain.PlanMeter{
-		Name: req.Name,
+		Name:        req.Name,
+		Aggregation: req.Aggregation,
 	}
 
 	err := h.planMeterService.Create(ctx, planMeter)
@@ -590,8 +638,9 @@ func (h *ProductHandler) CreatePlanMeter(ctx context.Context, req *pb.CreatePlan
 
 func (h *ProductHandler) UpdatePlanMeter(ctx context.Context, req *pb.UpdatePlanMeterRequest) (*pb.UpdatePlanMeterResponse, error) {
 	planMeter := &domain.PlanMeter{
-		ID:   req.Id,
-		Name: req.Name,
+		ID:          req.Id,
+		Name:        req.Name,
+		Aggregation: req.Aggregation,
 	}
 
 	err := h.planMeterService.Update(ctx, planMeter)
@@ -667,9 +716,12 @@ func domainPlanToPb(plan *domain.Plan) *pb.Plan {
 }
 
 func domainPlanPriceToPb(planPrice *domain.PlanPrice) *pb.PlanPrice {
-	tiersJSON, _ := json.Marshal(planPrice.Tiers)
+	var tiersJSON []byte
+	if planPrice.Tiers != nil {
+		tiersJSON, _ = json.Marshal(planPrice.Tiers)
+	}
 
-	return &pb.PlanPrice{
+	result := &pb.PlanPrice{
 		Id:           planPrice.ID,
 		PlanId:       planPrice.PlanID,
 		CurrencyId:   planPrice.CurrencyID,
@@ -680,13 +732,49 @@ func domainPlanPriceToPb(planPrice *domain.PlanPrice) *pb.PlanPrice {
 		CreatedAt:    planPrice.CreatedAt.Unix(),
 		UpdatedAt:    planPrice.UpdatedAt.Unix(),
 	}
+	if planPrice.DerivedFromID != nil {
+		result.DerivedFromPriceId = *planPrice.DerivedFromID
+	}
+	return result
+}
+
+func domainPriceQuoteToPb(quote *domain.PriceQuote) *pb.PriceQuote {
+	lines := make([]*pb.PriceQuoteLine, len(quote.Quote.Lines))
+	for i, line := range quote.Quote.Lines {
+		lines[i] = &pb.PriceQuoteLine{
+			Tier:      int32(line.Tier),
+			Quantity:  line.Quantity,
+			UnitPrice: line.UnitPrice,
+			FlatPrice: line.FlatPrice,
+			Amount:    line.Amount,
+		}
+	}
+
+	tierMode := ""
+	if quote.PlanPrice.Type == pricing.TypeTiered && quote.PlanPrice.Tiers != nil {
+		tierMode = quote.PlanPrice.Tiers.Mode
+		if tierMode == "" {
+			tierMode = pricing.TierModeGraduated
+		}
+	}
+
+	return &pb.PriceQuote{
+		PlanPriceId: quote.PlanPrice.ID,
+		CurrencyId:  quote.PlanPrice.CurrencyID,
+		Type:        quote.PlanPrice.Type,
+		TierMode:    tierMode,
+		Quantity:    quote.Quote.Quantity,
+		Amount:      quote.Quote.Amount,
+		Lines:       lines,
+	}
 }
 
 func domainPlanMeterToPb(planMeter *domain.PlanMeter) *pb.PlanMeter {
 	return &pb.PlanMeter{
-		Id:        planMeter.ID,
-		Name:      planMeter.Name,
-		CreatedAt: planMeter.CreatedAt.Unix(),
-		UpdatedAt: planMeter.UpdatedAt.Unix(),
+		Id:          planMeter.ID,
+		Name:        planMeter.Name,
+		Aggregation: planMeter.Aggregation,
+		CreatedAt:   planMeter.CreatedAt.Unix(),
+		UpdatedAt:   planMeter.UpdatedAt.Unix(),
 	}
 }
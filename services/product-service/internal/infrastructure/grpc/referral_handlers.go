@@ -0,0 +1,99 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/damarteplok/damar-admin-cms/services/product-service/internal/domain"
+	pb "github.com/damarteplok/damar-admin-cms/shared/proto/product"
+	"github.com/damarteplok/damar-admin-cms/shared/util"
+)
+
+// Referral operations
+
+func (h *ProductHandler) GetReferralCode(ctx context.Context, req *pb.GetReferralCodeRequest) (*pb.GetReferralCodeResponse, error) {
+	code, err := h.referralService.GetOrCreateCode(ctx, req.UserId)
+	if err != nil {
+		return &pb.GetReferralCodeResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &pb.GetReferralCodeResponse{
+		Success: true,
+		Message: "Referral code retrieved successfully",
+		Data:    domainDiscountCodeToPb(code),
+	}, nil
+}
+
+func (h *ProductHandler) GetReferralStats(ctx context.Context, req *pb.GetReferralStatsRequest) (*pb.GetReferralStatsResponse, error) {
+	stats, err := h.referralService.GetStats(ctx, req.UserId)
+	if err != nil {
+		return &pb.GetReferralStatsResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	data := &pb.ReferralStats{
+		ReferrerUserId:     stats.ReferrerUserID,
+		TotalReferrals:     stats.TotalReferrals,
+		PendingReferrals:   stats.PendingReferrals,
+		ConvertedReferrals: stats.ConvertedReferrals,
+		BonusDaysEarned:    stats.BonusDaysEarned,
+	}
+	if stats.Code != nil {
+		data.Code = domainDiscountCodeToPb(stats.Code)
+	}
+
+	return &pb.GetReferralStatsResponse{
+		Success: true,
+		Message: "Referral stats retrieved successfully",
+		Data:    data,
+	}, nil
+}
+
+func (h *ProductHandler) GetReferralsByReferrer(ctx context.Context, req *pb.GetReferralsByReferrerRequest) (*pb.GetReferralsByReferrerResponse, error) {
+	referrals, total, err := h.referralService.GetByReferrer(ctx, req.UserId, int(req.Page), int(req.PerPage))
+	if err != nil {
+		return &pb.GetReferralsByReferrerResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	pbReferrals := make([]*pb.Referral, len(referrals))
+	for i, referral := range referrals {
+		pbReferrals[i] = domainReferralToPb(referral)
+	}
+
+	return &pb.GetReferralsByReferrerResponse{
+		Success: true,
+		Message: "Referrals retrieved successfully",
+		Data: &pb.GetReferralsByReferrerData{
+			Referrals: pbReferrals,
+			Total:     int32(total),
+			Page:      req.Page,
+			PerPage:   req.PerPage,
+		},
+	}, nil
+}
+
+func domainReferralToPb(referral *domain.Referral) *pb.Referral {
+	result := &pb.Referral{
+		Id:                       referral.ID,
+		DiscountCodeId:           referral.DiscountCodeID,
+		DiscountCodeRedemptionId: referral.DiscountCodeRedemptionID,
+		ReferrerUserId:           referral.ReferrerUserID,
+		ReferredUserId:           referral.ReferredUserID,
+		Status:                   referral.Status,
+		RewardBonusDays:          referral.RewardBonusDays,
+		ConvertedAt:              util.TimeToUnix(referral.ConvertedAt),
+		CreatedAt:                referral.CreatedAt.Unix(),
+		UpdatedAt:                referral.UpdatedAt.Unix(),
+	}
+	if referral.SubscriptionID != nil {
+		result.SubscriptionId = *referral.SubscriptionID
+	}
+	return result
+}
@@ -0,0 +1,173 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/damarteplok/damar-admin-cms/services/product-service/internal/domain"
+	"github.com/damarteplok/damar-admin-cms/shared/database"
+	"github.com/damarteplok/damar-admin-cms/shared/tenancy"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// CurrencyRepository uses tenantDB only to check subscriptions, which has
+// row-level security, for a currency's use
+type CurrencyRepository struct {
+	db       *pgxpool.Pool
+	tenantDB *database.TenantDB
+}
+
+func NewCurrencyRepository(db *pgxpool.Pool, tenantDB *database.TenantDB) domain.CurrencyRepository {
+	return &CurrencyRepository{db: db, tenantDB: tenantDB}
+}
+
+// currencyColumns matches the scan order of scanCurrency. Seeded rows may
+// lack timestamps.
+const currencyColumns = `id, code, name, symbol, decimal_places, rounding_increment, rounding_mode,
+	COALESCE(created_at, NOW()), COALESCE(updated_at, NOW())`
+
+func scanCurrency(row pgx.Row, currency *domain.Currency) error {
+	return row.Scan(
+		&currency.ID,
+		&currency.Code,
+		&currency.Name,
+		&currency.Symbol,
+		&currency.DecimalPlaces,
+		&currency.RoundingIncrement,
+		&currency.RoundingMode,
+		&currency.CreatedAt,
+		&currency.UpdatedAt,
+	)
+}
+
+func (r *CurrencyRepository) GetByID(ctx context.Context, id int64) (*domain.Currency, error) {
+	query := `SELECT ` + currencyColumns + ` FROM currencies WHERE id = $1`
+
+	currency := &domain.Currency{}
+	if err := scanCurrency(r.db.QueryRow(ctx, query, id), currency); err != nil {
+		return nil, fmt.Errorf("failed to get currency by ID: %w", err)
+	}
+
+	return currency, nil
+}
+
+func (r *CurrencyRepository) GetByCode(ctx context.Context, code string) (*domain.Currency, error) {
+	query := `SELECT ` + currencyColumns + ` FROM currencies WHERE code = $1`
+
+	currency := &domain.Currency{}
+	if err := scanCurrency(r.db.QueryRow(ctx, query, code), currency); err != nil {
+		return nil, fmt.Errorf("failed to get currency by code: %w", err)
+	}
+
+	return currency, nil
+}
+
+func (r *CurrencyRepository) GetAll(ctx context.Context) ([]*domain.Currency, error) {
+	query := `SELECT ` + currencyColumns + ` FROM currencies ORDER BY code`
+
+	rows, err := r.db.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get currencies: %w", err)
+	}
+	defer rows.Close()
+
+	currencies := make([]*domain.Currency, 0)
+	for rows.Next() {
+		currency := &domain.Currency{}
+		if err := scanCurrency(rows, currency); err != nil {
+			return nil, fmt.Errorf("failed to scan currency: %w", err)
+		}
+		currencies = append(currencies, currency)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating currencies: %w", err)
+	}
+
+	return currencies, nil
+}
+
+func (r *CurrencyRepository) Create(ctx context.Context, currency *domain.Currency) error {
+	query := `
+		INSERT INTO currencies (code, name, symbol, decimal_places, rounding_increment, rounding_mode,
+		                        created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, NOW(), NOW())
+		RETURNING id, created_at, updated_at
+	`
+
+	err := r.db.QueryRow(
+		ctx,
+		query,
+		currency.Code,
+		currency.Name,
+		currency.Symbol,
+		currency.DecimalPlaces,
+		currency.RoundingIncrement,
+		currency.RoundingMode,
+	).Scan(&currency.ID, &currency.CreatedAt, &currency.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create currency: %w", err)
+	}
+
+	return nil
+}
+
+func (r *CurrencyRepository) Update(ctx context.Context, currency *domain.Currency) error {
+	query := `
+		UPDATE currencies
+		SET name = $1, symbol = $2, decimal_places = $3, rounding_increment = $4, rounding_mode = $5,
+		    updated_at = NOW()
+		WHERE id = $6
+		RETURNING updated_at
+	`
+
+	err := r.db.QueryRow(
+		ctx,
+		query,
+		currency.Name,
+		currency.Symbol,
+		currency.DecimalPlaces,
+		currency.RoundingIncrement,
+		currency.RoundingMode,
+		currency.ID,
+	).Scan(&currency.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to update currency: %w", err)
+	}
+
+	return nil
+}
+
+func (r *CurrencyRepository) Delete(ctx context.Context, id int64) error {
+	query := `DELETE FROM currencies WHERE id = $1`
+
+	result, err := r.db.Exec(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete currency: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("currency not found")
+	}
+
+	return nil
+}
+
+func (r *CurrencyRepository) IsInUse(ctx context.Context, id int64) (bool, error) {
+	query := `
+		SELECT EXISTS (SELECT 1 FROM plan_prices WHERE currency_id = $1)
+		    OR EXISTS (SELECT 1 FROM product_prices WHERE currency_id = $1)
+		    OR EXISTS (SELECT 1 FROM subscriptions WHERE currency_id = $1)
+		    OR EXISTS (SELECT 1 FROM invoices WHERE currency_id = $1)
+		    OR EXISTS (SELECT 1 FROM orders WHERE currency_id = $1)
+	`
+
+	// A currency is in use if any tenant's subscriptions use it
+	var inUse bool
+	if err := r.tenantDB.QueryRow(tenancy.WithoutTenant(ctx), query, id).Scan(&inUse); err != nil {
+		return false, fmt.Errorf("failed to check currency usage: %w", err)
+	}
+
+	return inUse, nil
+}
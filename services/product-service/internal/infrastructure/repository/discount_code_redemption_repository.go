@@ -2,9 +2,11 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/damarteplok/damar-admin-cms/services/product-service/internal/domain"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -144,6 +146,161 @@ func (r *DiscountCodeRedemptionRepository) Create(ctx context.Context, redemptio
 	return nil
 }
 
+func (r *DiscountCodeRedemptionRepository) Redeem(ctx context.Context, redemption *domain.DiscountCodeRedemption, check domain.RedemptionCheck) (bool, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Always lock the code before its discount so concurrent redemptions of
+	// codes sharing a discount cannot deadlock
+	code := &domain.DiscountCode{}
+	codeQuery := `SELECT ` + discountCodeColumns + ` FROM discount_codes WHERE id = $1 FOR UPDATE`
+	if err := scanDiscountCode(tx.QueryRow(ctx, codeQuery, redemption.DiscountCodeID), code); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, fmt.Errorf("discount code not found")
+		}
+		return false, fmt.Errorf("failed to lock discount code: %w", err)
+	}
+
+	discount := &domain.Discount{}
+	discountQuery := `SELECT ` + discountColumns + ` FROM discounts WHERE id = $1 FOR UPDATE`
+	if err := scanDiscount(tx.QueryRow(ctx, discountQuery, code.DiscountID), discount); err != nil {
+		return false, fmt.Errorf("failed to lock discount: %w", err)
+	}
+
+	// A retried checkout finds its first redemption once the locks are held
+	if redemption.SubscriptionID != nil || redemption.OrderID != nil {
+		existingQuery := `
+			SELECT id, discount_code_id, user_id, subscription_id, order_id, redeemed_at, created_at, updated_at
+			FROM discount_code_redemptions
+			WHERE discount_code_id = $1
+			  AND (($2::BIGINT IS NOT NULL AND subscription_id = $2) OR ($3::BIGINT IS NOT NULL AND order_id = $3))
+			LIMIT 1
+		`
+		err := tx.QueryRow(ctx, existingQuery, code.ID, redemption.SubscriptionID, redemption.OrderID).Scan(
+			&redemption.ID,
+			&redemption.DiscountCodeID,
+			&redemption.UserID,
+			&redemption.SubscriptionID,
+			&redemption.OrderID,
+			&redemption.RedeemedAt,
+			&redemption.CreatedAt,
+			&redemption.UpdatedAt,
+		)
+		if err == nil {
+			return false, nil
+		}
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return false, fmt.Errorf("failed to get existing redemption: %w", err)
+		}
+	}
+
+	var userRedemptions int32
+	countQuery := `
+		SELECT COUNT(*)
+		FROM discount_code_redemptions r
+		JOIN discount_codes c ON c.id = r.discount_code_id
+		WHERE c.discount_id = $1 AND r.user_id = $2
+	`
+	if err := tx.QueryRow(ctx, countQuery, discount.ID, redemption.UserID).Scan(&userRedemptions); err != nil {
+		return false, fmt.Errorf("failed to count user redemptions: %w", err)
+	}
+
+	if err := check(code, discount, userRedemptions); err != nil {
+		return false, err
+	}
+
+	insertQuery := `
+		INSERT INTO discount_code_redemptions (discount_code_id, user_id, subscription_id, order_id, redeemed_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, NOW(), NOW())
+		RETURNING id, created_at, updated_at
+	`
+	err = tx.QueryRow(
+		ctx,
+		insertQuery,
+		redemption.DiscountCodeID,
+		redemption.UserID,
+		redemption.SubscriptionID,
+		redemption.OrderID,
+		redemption.RedeemedAt,
+	).Scan(&redemption.ID, &redemption.CreatedAt, &redemption.UpdatedAt)
+	if err != nil {
+		return false, fmt.Errorf("failed to create redemption: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, `UPDATE discount_codes SET times_used = times_used + 1, updated_at = NOW() WHERE id = $1`, code.ID); err != nil {
+		return false, fmt.Errorf("failed to increment discount code usage: %w", err)
+	}
+	if _, err := tx.Exec(ctx, `UPDATE discounts SET redemptions = redemptions + 1, updated_at = NOW() WHERE id = $1`, discount.ID); err != nil {
+		return false, fmt.Errorf("failed to increment discount redemptions: %w", err)
+	}
+
+	// Redeeming a personal referral code makes the user a referral of its
+	// owner. Users are referred once; later referral codes only discount.
+	if code.ReferrerUserID != nil {
+		referralQuery := `
+			INSERT INTO referrals (discount_code_id, discount_code_redemption_id, referrer_user_id, referred_user_id, subscription_id, status, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $5, 'pending', NOW(), NOW())
+			ON CONFLICT (referred_user_id) DO NOTHING
+		`
+		_, err := tx.Exec(ctx, referralQuery, code.ID, redemption.ID, *code.ReferrerUserID, redemption.UserID, redemption.SubscriptionID)
+		if err != nil {
+			return false, fmt.Errorf("failed to create referral: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, fmt.Errorf("failed to commit redemption: %w", err)
+	}
+
+	return true, nil
+}
+
+func (r *DiscountCodeRedemptionRepository) ReleaseOrder(ctx context.Context, discountCodeID, orderID int64) (bool, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Same lock order as Redeem
+	var discountID int64
+	if err := tx.QueryRow(ctx, `SELECT discount_id FROM discount_codes WHERE id = $1 FOR UPDATE`, discountCodeID).Scan(&discountID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, fmt.Errorf("discount code not found")
+		}
+		return false, fmt.Errorf("failed to lock discount code: %w", err)
+	}
+	if _, err := tx.Exec(ctx, `SELECT id FROM discounts WHERE id = $1 FOR UPDATE`, discountID); err != nil {
+		return false, fmt.Errorf("failed to lock discount: %w", err)
+	}
+
+	// A referral created by the redemption goes with it (ON DELETE CASCADE)
+	result, err := tx.Exec(ctx, `DELETE FROM discount_code_redemptions WHERE discount_code_id = $1 AND order_id = $2`, discountCodeID, orderID)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete redemption: %w", err)
+	}
+	released := result.RowsAffected()
+	if released == 0 {
+		return false, nil
+	}
+
+	if _, err := tx.Exec(ctx, `UPDATE discount_codes SET times_used = GREATEST(times_used - $2, 0), updated_at = NOW() WHERE id = $1`, discountCodeID, released); err != nil {
+		return false, fmt.Errorf("failed to decrement discount code usage: %w", err)
+	}
+	if _, err := tx.Exec(ctx, `UPDATE discounts SET redemptions = GREATEST(redemptions - $2, 0), updated_at = NOW() WHERE id = $1`, discountID, released); err != nil {
+		return false, fmt.Errorf("failed to decrement discount redemptions: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, fmt.Errorf("failed to commit release: %w", err)
+	}
+
+	return true, nil
+}
+
 func (r *DiscountCodeRedemptionRepository) GetAll(ctx context.Context, page, perPage int) ([]*domain.DiscountCodeRedemption, int, error) {
 	offset := (page - 1) * perPage
 
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/damarteplok/damar-admin-cms/services/product-service/internal/domain"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -16,15 +17,11 @@ func NewDiscountCodeRepository(db *pgxpool.Pool) domain.DiscountCodeRepository {
 	return &DiscountCodeRepository{db: db}
 }
 
-func (r *DiscountCodeRepository) GetByID(ctx context.Context, id int64) (*domain.DiscountCode, error) {
-	query := `
-		SELECT id, discount_id, code, valid_from, valid_until, max_uses, times_used, is_active, created_at, updated_at
-		FROM discount_codes 
-		WHERE id = $1
-	`
+// discountCodeColumns matches the scan order of scanDiscountCode
+const discountCodeColumns = `id, discount_id, code, valid_from, valid_until, max_uses, times_used, is_active, referrer_user_id, created_at, updated_at`
 
-	code := &domain.DiscountCode{}
-	err := r.db.QueryRow(ctx, query, id).Scan(
+func scanDiscountCode(row pgx.Row, code *domain.DiscountCode) error {
+	return row.Scan(
 		&code.ID,
 		&code.DiscountID,
 		&code.Code,
@@ -33,10 +30,17 @@ func (r *DiscountCodeRepository) GetByID(ctx context.Context, id int64) (*domain
 		&code.MaxUses,
 		&code.TimesUsed,
 		&code.IsActive,
+		&code.ReferrerUserID,
 		&code.CreatedAt,
 		&code.UpdatedAt,
 	)
-	if err != nil {
+}
+
+func (r *DiscountCodeRepository) GetByID(ctx context.Context, id int64) (*domain.DiscountCode, error) {
+	query := `SELECT ` + discountCodeColumns + ` FROM discount_codes WHERE id = $1`
+
+	code := &domain.DiscountCode{}
+	if err := scanDiscountCode(r.db.QueryRow(ctx, query, id), code); err != nil {
 		return nil, fmt.Errorf("failed to get discount code by ID: %w", err)
 	}
 
@@ -44,26 +48,10 @@ func (r *DiscountCodeRepository) GetByID(ctx context.Context, id int64) (*domain
 }
 
 func (r *DiscountCodeRepository) GetByCode(ctx context.Context, code string) (*domain.DiscountCode, error) {
-	query := `
-		SELECT id, discount_id, code, valid_from, valid_until, max_uses, times_used, is_active, created_at, updated_at
-		FROM discount_codes 
-		WHERE code = $1
-	`
+	query := `SELECT ` + discountCodeColumns + ` FROM discount_codes WHERE code = $1`
 
 	discountCode := &domain.DiscountCode{}
-	err := r.db.QueryRow(ctx, query, code).Scan(
-		&discountCode.ID,
-		&discountCode.DiscountID,
-		&discountCode.Code,
-		&discountCode.ValidFrom,
-		&discountCode.ValidUntil,
-		&discountCode.MaxUses,
-		&discountCode.TimesUsed,
-		&discountCode.IsActive,
-		&discountCode.CreatedAt,
-		&discountCode.UpdatedAt,
-	)
-	if err != nil {
+	if err := scanDiscountCode(r.db.QueryRow(ctx, query, code), discountCode); err != nil {
 		return nil, fmt.Errorf("failed to get discount code by code: %w", err)
 	}
 
@@ -72,7 +60,7 @@ func (r *DiscountCodeRepository) GetByCode(ctx context.Context, code string) (*d
 
 func (r *DiscountCodeRepository) GetByDiscount(ctx context.Context, discountID int64) ([]*domain.DiscountCode, error) {
 	query := `
-		SELECT id, discount_id, code, valid_from, valid_until, max_uses, times_used, is_active, created_at, updated_at
+		SELECT ` + discountCodeColumns + `
 		FROM discount_codes 
 		WHERE discount_id = $1
 		ORDER BY created_at DESC
@@ -87,19 +75,7 @@ func (r *DiscountCodeRepository) GetByDiscount(ctx context.Context, discountID i
 	codes := make([]*domain.DiscountCode, 0)
 	for rows.Next() {
 		code := &domain.DiscountCode{}
-		err := rows.Scan(
-			&code.ID,
-			&code.DiscountID,
-			&code.Code,
-			&code.ValidFrom,
-			&code.ValidUntil,
-			&code.MaxUses,
-			&code.TimesUsed,
-			&code.IsActive,
-			&code.CreatedAt,
-			&code.UpdatedAt,
-		)
-		if err != nil {
+		if err := scanDiscountCode(rows, code); err != nil {
 			return nil, fmt.Errorf("failed to scan discount code: %w", err)
 		}
 		codes = append(codes, code)
@@ -112,10 +88,21 @@ func (r *DiscountCodeRepository) GetByDiscount(ctx context.Context, discountID i
 	return codes, nil
 }
 
+func (r *DiscountCodeRepository) GetByReferrer(ctx context.Context, referrerUserID, discountID int64) (*domain.DiscountCode, error) {
+	query := `SELECT ` + discountCodeColumns + ` FROM discount_codes WHERE referrer_user_id = $1 AND discount_id = $2`
+
+	code := &domain.DiscountCode{}
+	if err := scanDiscountCode(r.db.QueryRow(ctx, query, referrerUserID, discountID), code); err != nil {
+		return nil, fmt.Errorf("failed to get referral code: %w", err)
+	}
+
+	return code, nil
+}
+
 func (r *DiscountCodeRepository) Create(ctx context.Context, code *domain.DiscountCode) error {
 	query := `
-		INSERT INTO discount_codes (discount_id, code, valid_from, valid_until, max_uses, times_used, is_active, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, NOW(), NOW())
+		INSERT INTO discount_codes (discount_id, code, valid_from, valid_until, max_uses, times_used, is_active, referrer_user_id, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW(), NOW())
 		RETURNING id, created_at, updated_at
 	`
 
@@ -129,6 +116,7 @@ func (r *DiscountCodeRepository) Create(ctx context.Context, code *domain.Discou
 		code.MaxUses,
 		code.TimesUsed,
 		code.IsActive,
+		code.ReferrerUserID,
 	).Scan(&code.ID, &code.CreatedAt, &code.UpdatedAt)
 	if err != nil {
 		return fmt.Errorf("failed to create discount code: %w", err)
@@ -185,7 +173,7 @@ func (r *DiscountCodeRepository) GetAll(ctx context.Context, page, perPage int,
 
 	countQuery := `SELECT COUNT(*) FROM discount_codes WHERE 1=1`
 	query := `
-		SELECT id, discount_id, code, valid_from, valid_until, max_uses, times_used, is_active, created_at, updated_at
+		SELECT ` + discountCodeColumns + `
 		FROM discount_codes 
 		WHERE 1=1
 	`
@@ -212,19 +200,7 @@ func (r *DiscountCodeRepository) GetAll(ctx context.Context, page, perPage int,
 	codes := make([]*domain.DiscountCode, 0)
 	for rows.Next() {
 		code := &domain.DiscountCode{}
-		err := rows.Scan(
-			&code.ID,
-			&code.DiscountID,
-			&code.Code,
-			&code.ValidFrom,
-			&code.ValidUntil,
-			&code.MaxUses,
-			&code.TimesUsed,
-			&code.IsActive,
-			&code.CreatedAt,
-			&code.UpdatedAt,
-		)
-		if err != nil {
+		if err := scanDiscountCode(rows, code); err != nil {
 			return nil, 0, fmt.Errorf("failed to scan discount code: %w", err)
 		}
 		codes = append(codes, code)
@@ -251,3 +227,65 @@ func (r *DiscountCodeRepository) IncrementUsage(ctx context.Context, id int64) e
 
 	return nil
 }
+
+func (r *DiscountCodeRepository) CreateBatch(ctx context.Context, template *domain.DiscountCode, codes []string) ([]*domain.DiscountCode, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// COPY cannot skip duplicates, so the batch is staged in a temp table and
+	// moved over with ON CONFLICT DO NOTHING. Collisions are left for the
+	// caller to regenerate.
+	_, err = tx.Exec(ctx, `CREATE TEMP TABLE discount_codes_import (code VARCHAR(255) NOT NULL) ON COMMIT DROP`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create import table: %w", err)
+	}
+
+	_, err = tx.CopyFrom(ctx, pgx.Identifier{"discount_codes_import"}, []string{"code"},
+		pgx.CopyFromSlice(len(codes), func(i int) ([]any, error) {
+			return []any{codes[i]}, nil
+		}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to copy discount codes: %w", err)
+	}
+
+	query := `
+		INSERT INTO discount_codes (discount_id, code, valid_from, valid_until, max_uses, times_used, is_active, created_at, updated_at)
+		SELECT DISTINCT ON (code) $1::BIGINT, code, $2::TIMESTAMP, $3::TIMESTAMP, $4::INTEGER, 0, $5::BOOLEAN, NOW(), NOW()
+		FROM discount_codes_import
+		ON CONFLICT (code) DO NOTHING
+		RETURNING ` + discountCodeColumns
+
+	rows, err := tx.Query(ctx, query,
+		template.DiscountID,
+		template.ValidFrom,
+		template.ValidUntil,
+		template.MaxUses,
+		template.IsActive,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert discount codes: %w", err)
+	}
+	defer rows.Close()
+
+	created := make([]*domain.DiscountCode, 0, len(codes))
+	for rows.Next() {
+		code := &domain.DiscountCode{}
+		if err := scanDiscountCode(rows, code); err != nil {
+			return nil, fmt.Errorf("failed to scan discount code: %w", err)
+		}
+		created = append(created, code)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating discount codes: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return created, nil
+}
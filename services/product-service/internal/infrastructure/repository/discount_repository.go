@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/damarteplok/damar-admin-cms/services/product-service/internal/domain"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -16,19 +17,15 @@ func NewDiscountRepository(db *pgxpool.Pool) domain.DiscountRepository {
 	return &DiscountRepository{db: db}
 }
 
-func (r *DiscountRepository) GetByID(ctx context.Context, id int64) (*domain.Discount, error) {
-	query := `
-		SELECT id, name, type, amount, is_active, description, valid_until, action_type, 
-		       max_redemptions, max_redemptions_per_user, redemptions, is_recurring,
-		       duration_in_months, maximum_recurring_intervals, redeem_type, bonus_days,
-		       is_enabled_for_all_plans, is_enabled_for_all_one_time_products, 
-		       created_at, updated_at
-		FROM discounts 
-		WHERE id = $1
-	`
+// discountColumns matches the scan order of scanDiscount
+const discountColumns = `id, name, type, amount, is_active, description, valid_until, action_type,
+	max_redemptions, max_redemptions_per_user, redemptions, is_recurring,
+	duration_in_months, maximum_recurring_intervals, redeem_type, bonus_days,
+	is_enabled_for_all_plans, is_enabled_for_all_one_time_products,
+	created_at, updated_at`
 
-	discount := &domain.Discount{}
-	err := r.db.QueryRow(ctx, query, id).Scan(
+func scanDiscount(row pgx.Row, discount *domain.Discount) error {
+	return row.Scan(
 		&discount.ID,
 		&discount.Name,
 		&discount.Type,
@@ -50,7 +47,13 @@ func (r *DiscountRepository) GetByID(ctx context.Context, id int64) (*domain.Dis
 		&discount.CreatedAt,
 		&discount.UpdatedAt,
 	)
-	if err != nil {
+}
+
+func (r *DiscountRepository) GetByID(ctx context.Context, id int64) (*domain.Discount, error) {
+	query := `SELECT ` + discountColumns + ` FROM discounts WHERE id = $1`
+
+	discount := &domain.Discount{}
+	if err := scanDiscount(r.db.QueryRow(ctx, query, id), discount); err != nil {
 		return nil, fmt.Errorf("failed to get discount by ID: %w", err)
 	}
 
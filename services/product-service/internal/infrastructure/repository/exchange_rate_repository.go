@@ -0,0 +1,160 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/damarteplok/damar-admin-cms/services/product-service/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ExchangeRateRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewExchangeRateRepository(db *pgxpool.Pool) domain.ExchangeRateRepository {
+	return &ExchangeRateRepository{db: db}
+}
+
+// exchangeRateColumns matches the scan order of scanExchangeRate. The rate is
+// read as text to keep every digit of the NUMERIC.
+const exchangeRateColumns = `id, base_currency_id, quote_currency_id, rate::text, source, effective_at,
+	created_at, updated_at`
+
+func scanExchangeRate(row pgx.Row, rate *domain.ExchangeRate) error {
+	return row.Scan(
+		&rate.ID,
+		&rate.BaseCurrencyID,
+		&rate.QuoteCurrencyID,
+		&rate.Rate,
+		&rate.Source,
+		&rate.EffectiveAt,
+		&rate.CreatedAt,
+		&rate.UpdatedAt,
+	)
+}
+
+func (r *ExchangeRateRepository) GetByID(ctx context.Context, id int64) (*domain.ExchangeRate, error) {
+	query := `SELECT ` + exchangeRateColumns + ` FROM exchange_rates WHERE id = $1`
+
+	rate := &domain.ExchangeRate{}
+	if err := scanExchangeRate(r.db.QueryRow(ctx, query, id), rate); err != nil {
+		return nil, fmt.Errorf("failed to get exchange rate by ID: %w", err)
+	}
+
+	return rate, nil
+}
+
+func (r *ExchangeRateRepository) GetLatest(ctx context.Context, baseCurrencyID, quoteCurrencyID int64, at time.Time) (*domain.ExchangeRate, error) {
+	query := `
+		SELECT ` + exchangeRateColumns + `
+		FROM exchange_rates
+		WHERE base_currency_id = $1 AND quote_currency_id = $2 AND effective_at <= $3
+		ORDER BY effective_at DESC, id DESC
+		LIMIT 1
+	`
+
+	rate := &domain.ExchangeRate{}
+	if err := scanExchangeRate(r.db.QueryRow(ctx, query, baseCurrencyID, quoteCurrencyID, at), rate); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get latest exchange rate: %w", err)
+	}
+
+	return rate, nil
+}
+
+func (r *ExchangeRateRepository) GetAll(ctx context.Context, baseCurrencyID, quoteCurrencyID int64, page, perPage int) ([]*domain.ExchangeRate, int, error) {
+	offset := (page - 1) * perPage
+	where := `($1 = 0 OR base_currency_id = $1) AND ($2 = 0 OR quote_currency_id = $2)`
+
+	var total int
+	countQuery := `SELECT COUNT(*) FROM exchange_rates WHERE ` + where
+	if err := r.db.QueryRow(ctx, countQuery, baseCurrencyID, quoteCurrencyID).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count exchange rates: %w", err)
+	}
+
+	query := `
+		SELECT ` + exchangeRateColumns + `
+		FROM exchange_rates
+		WHERE ` + where + `
+		ORDER BY effective_at DESC, id DESC
+		LIMIT $3 OFFSET $4
+	`
+
+	rows, err := r.db.Query(ctx, query, baseCurrencyID, quoteCurrencyID, perPage, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get exchange rates: %w", err)
+	}
+	defer rows.Close()
+
+	rates := make([]*domain.ExchangeRate, 0)
+	for rows.Next() {
+		rate := &domain.ExchangeRate{}
+		if err := scanExchangeRate(rows, rate); err != nil {
+			return nil, 0, fmt.Errorf("failed to scan exchange rate: %w", err)
+		}
+		rates = append(rates, rate)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating exchange rates: %w", err)
+	}
+
+	return rates, total, nil
+}
+
+func (r *ExchangeRateRepository) CreateBatch(ctx context.Context, rates []*domain.ExchangeRate) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		INSERT INTO exchange_rates (base_currency_id, quote_currency_id, rate, source, effective_at,
+		                            created_at, updated_at)
+		VALUES ($1, $2, $3::numeric, $4, $5, NOW(), NOW())
+		RETURNING id, rate::text, created_at, updated_at
+	`
+
+	for _, rate := range rates {
+		err := tx.QueryRow(
+			ctx,
+			query,
+			rate.BaseCurrencyID,
+			rate.QuoteCurrencyID,
+			rate.Rate,
+			rate.Source,
+			rate.EffectiveAt,
+		).Scan(&rate.ID, &rate.Rate, &rate.CreatedAt, &rate.UpdatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to create exchange rate: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit exchange rates: %w", err)
+	}
+
+	return nil
+}
+
+func (r *ExchangeRateRepository) Delete(ctx context.Context, id int64) error {
+	query := `DELETE FROM exchange_rates WHERE id = $1`
+
+	result, err := r.db.Exec(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete exchange rate: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("exchange rate not found")
+	}
+
+	return nil
+}
@@ -0,0 +1,291 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/damarteplok/damar-admin-cms/services/product-service/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type OrderRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewOrderRepository(db *pgxpool.Pool) domain.OrderRepository {
+	return &OrderRepository{db: db}
+}
+
+// orderColumns matches the scan order of scanOrder
+const orderColumns = `id, uuid, user_id, tenant_id, currency_id, status, subtotal, discount_total, total,
+	discount_code_id, payment_provider_id, payment_provider_checkout_id, checkout_url,
+	paid_at, cancelled_at, refunded_at, created_at, updated_at`
+
+func scanOrder(row pgx.Row, order *domain.Order) error {
+	return row.Scan(
+		&order.ID,
+		&order.UUID,
+		&order.UserID,
+		&order.TenantID,
+		&order.CurrencyID,
+		&order.Status,
+		&order.Subtotal,
+		&order.DiscountTotal,
+		&order.Total,
+		&order.DiscountCodeID,
+		&order.PaymentProviderID,
+		&order.PaymentProviderCheckoutID,
+		&order.CheckoutURL,
+		&order.PaidAt,
+		&order.CancelledAt,
+		&order.RefundedAt,
+		&order.CreatedAt,
+		&order.UpdatedAt,
+	)
+}
+
+func (r *OrderRepository) GetByID(ctx context.Context, id int64) (*domain.Order, error) {
+	query := `SELECT ` + orderColumns + ` FROM orders WHERE id = $1`
+
+	order := &domain.Order{}
+	if err := scanOrder(r.db.QueryRow(ctx, query, id), order); err != nil {
+		return nil, fmt.Errorf("failed to get order by ID: %w", err)
+	}
+
+	if err := r.loadItems(ctx, order); err != nil {
+		return nil, err
+	}
+
+	return order, nil
+}
+
+func (r *OrderRepository) GetByCheckout(ctx context.Context, providerID int64, checkoutID string) (*domain.Order, error) {
+	query := `
+		SELECT ` + orderColumns + `
+		FROM orders
+		WHERE payment_provider_id = $1 AND payment_provider_checkout_id = $2
+	`
+
+	order := &domain.Order{}
+	if err := scanOrder(r.db.QueryRow(ctx, query, providerID, checkoutID), order); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get order by checkout: %w", err)
+	}
+
+	if err := r.loadItems(ctx, order); err != nil {
+		return nil, err
+	}
+
+	return order, nil
+}
+
+func (r *OrderRepository) GetByUser(ctx context.Context, userID int64, page, perPage int) ([]*domain.Order, int, error) {
+	return r.list(ctx, `user_id = $1`, userID, page, perPage)
+}
+
+func (r *OrderRepository) GetAll(ctx context.Context, status string, page, perPage int) ([]*domain.Order, int, error) {
+	return r.list(ctx, `($1 = '' OR status = $1)`, status, page, perPage)
+}
+
+// list pages through the orders matching where, which takes a single
+// argument, newest first. Items are not loaded.
+func (r *OrderRepository) list(ctx context.Context, where string, arg interface{}, page, perPage int) ([]*domain.Order, int, error) {
+	offset := (page - 1) * perPage
+
+	var total int
+	countQuery := `SELECT COUNT(*) FROM orders WHERE ` + where
+	if err := r.db.QueryRow(ctx, countQuery, arg).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count orders: %w", err)
+	}
+
+	query := `
+		SELECT ` + orderColumns + `
+		FROM orders
+		WHERE ` + where + `
+		ORDER BY created_at DESC, id DESC
+		LIMIT $2 OFFSET $3
+	`
+
+	rows, err := r.db.Query(ctx, query, arg, perPage, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get orders: %w", err)
+	}
+	defer rows.Close()
+
+	orders := make([]*domain.Order, 0)
+	for rows.Next() {
+		order := &domain.Order{}
+		if err := scanOrder(rows, order); err != nil {
+			return nil, 0, fmt.Errorf("failed to scan order: %w", err)
+		}
+		orders = append(orders, order)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating orders: %w", err)
+	}
+
+	return orders, total, nil
+}
+
+func (r *OrderRepository) loadItems(ctx context.Context, order *domain.Order) error {
+	query := `
+		SELECT id, order_id, product_id, description, quantity, unit_amount, discount_amount,
+		       amount, created_at, updated_at
+		FROM order_items
+		WHERE order_id = $1
+		ORDER BY id
+	`
+
+	rows, err := r.db.Query(ctx, query, order.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get order items: %w", err)
+	}
+	defer rows.Close()
+
+	order.Items = make([]*domain.OrderItem, 0)
+	for rows.Next() {
+		item := &domain.OrderItem{}
+		err := rows.Scan(
+			&item.ID,
+			&item.OrderID,
+			&item.ProductID,
+			&item.Description,
+			&item.Quantity,
+			&item.UnitAmount,
+			&item.DiscountAmount,
+			&item.Amount,
+			&item.CreatedAt,
+			&item.UpdatedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to scan order item: %w", err)
+		}
+		order.Items = append(order.Items, item)
+	}
+
+	if err = rows.Err(); err != nil {
+		return fmt.Errorf("error iterating order items: %w", err)
+	}
+
+	return nil
+}
+
+func (r *OrderRepository) Create(ctx context.Context, order *domain.Order) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		INSERT INTO orders (uuid, user_id, tenant_id, currency_id, status, subtotal, discount_total,
+		                    total, discount_code_id, paid_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, NOW(), NOW())
+		RETURNING id, created_at, updated_at
+	`
+
+	err = tx.QueryRow(
+		ctx,
+		query,
+		order.UUID,
+		order.UserID,
+		order.TenantID,
+		order.CurrencyID,
+		order.Status,
+		order.Subtotal,
+		order.DiscountTotal,
+		order.Total,
+		order.DiscountCodeID,
+		order.PaidAt,
+	).Scan(&order.ID, &order.CreatedAt, &order.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create order: %w", err)
+	}
+
+	itemQuery := `
+		INSERT INTO order_items (order_id, product_id, description, quantity, unit_amount,
+		                         discount_amount, amount, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, NOW(), NOW())
+		RETURNING id, created_at, updated_at
+	`
+
+	for _, item := range order.Items {
+		item.OrderID = order.ID
+		err := tx.QueryRow(
+			ctx,
+			itemQuery,
+			item.OrderID,
+			item.ProductID,
+			item.Description,
+			item.Quantity,
+			item.UnitAmount,
+			item.DiscountAmount,
+			item.Amount,
+		).Scan(&item.ID, &item.CreatedAt, &item.UpdatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to create order item: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit order: %w", err)
+	}
+
+	return nil
+}
+
+func (r *OrderRepository) SetCheckout(ctx context.Context, order *domain.Order) error {
+	query := `
+		UPDATE orders
+		SET payment_provider_id = $1, payment_provider_checkout_id = $2, checkout_url = $3, updated_at = NOW()
+		WHERE id = $4
+		RETURNING updated_at
+	`
+
+	err := r.db.QueryRow(
+		ctx,
+		query,
+		order.PaymentProviderID,
+		order.PaymentProviderCheckoutID,
+		order.CheckoutURL,
+		order.ID,
+	).Scan(&order.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to set order checkout: %w", err)
+	}
+
+	return nil
+}
+
+func (r *OrderRepository) UpdateStatus(ctx context.Context, order *domain.Order, from string) (bool, error) {
+	query := `
+		UPDATE orders
+		SET status = $1, paid_at = $2, cancelled_at = $3, refunded_at = $4, updated_at = NOW()
+		WHERE id = $5 AND status = $6
+		RETURNING updated_at
+	`
+
+	err := r.db.QueryRow(
+		ctx,
+		query,
+		order.Status,
+		order.PaidAt,
+		order.CancelledAt,
+		order.RefundedAt,
+		order.ID,
+		from,
+	).Scan(&order.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to update order status: %w", err)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/damarteplok/damar-admin-cms/services/product-service/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PlanEntitlementRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewPlanEntitlementRepository(db *pgxpool.Pool) domain.PlanEntitlementRepository {
+	return &PlanEntitlementRepository{db: db}
+}
+
+// planEntitlementColumns matches the scan order of scanPlanEntitlement
+const planEntitlementColumns = `id, plan_id, feature_key, type, is_enabled, limit_value, meter_id, created_at, updated_at`
+
+func scanPlanEntitlement(row pgx.Row, entitlement *domain.PlanEntitlement) error {
+	return row.Scan(
+		&entitlement.ID,
+		&entitlement.PlanID,
+		&entitlement.FeatureKey,
+		&entitlement.Type,
+		&entitlement.IsEnabled,
+		&entitlement.LimitValue,
+		&entitlement.MeterID,
+		&entitlement.CreatedAt,
+		&entitlement.UpdatedAt,
+	)
+}
+
+func (r *PlanEntitlementRepository) GetByID(ctx context.Context, id int64) (*domain.PlanEntitlement, error) {
+	query := `SELECT ` + planEntitlementColumns + ` FROM plan_entitlements WHERE id = $1`
+
+	entitlement := &domain.PlanEntitlement{}
+	if err := scanPlanEntitlement(r.db.QueryRow(ctx, query, id), entitlement); err != nil {
+		return nil, fmt.Errorf("failed to get plan entitlement by ID: %w", err)
+	}
+
+	return entitlement, nil
+}
+
+func (r *PlanEntitlementRepository) GetByPlan(ctx context.Context, planID int64) ([]*domain.PlanEntitlement, error) {
+	query := `SELECT ` + planEntitlementColumns + ` FROM plan_entitlements WHERE plan_id = $1 ORDER BY feature_key`
+
+	rows, err := r.db.Query(ctx, query, planID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get plan entitlements: %w", err)
+	}
+	defer rows.Close()
+
+	entitlements := make([]*domain.PlanEntitlement, 0)
+	for rows.Next() {
+		entitlement := &domain.PlanEntitlement{}
+		if err := scanPlanEntitlement(rows, entitlement); err != nil {
+			return nil, fmt.Errorf("failed to scan plan entitlement: %w", err)
+		}
+		entitlements = append(entitlements, entitlement)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating plan entitlements: %w", err)
+	}
+
+	return entitlements, nil
+}
+
+func (r *PlanEntitlementRepository) Upsert(ctx context.Context, entitlement *domain.PlanEntitlement) error {
+	query := `
+		INSERT INTO plan_entitlements (plan_id, feature_key, type, is_enabled, limit_value, meter_id,
+		                               created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, NOW(), NOW())
+		ON CONFLICT (plan_id, feature_key)
+		DO UPDATE SET type = EXCLUDED.type, is_enabled = EXCLUDED.is_enabled,
+		              limit_value = EXCLUDED.limit_value, meter_id = EXCLUDED.meter_id, updated_at = NOW()
+		RETURNING ` + planEntitlementColumns
+
+	err := scanPlanEntitlement(r.db.QueryRow(
+		ctx,
+		query,
+		entitlement.PlanID,
+		entitlement.FeatureKey,
+		entitlement.Type,
+		entitlement.IsEnabled,
+		entitlement.LimitValue,
+		entitlement.MeterID,
+	), entitlement)
+	if err != nil {
+		return fmt.Errorf("failed to save plan entitlement: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PlanEntitlementRepository) Delete(ctx context.Context, id int64) error {
+	result, err := r.db.Exec(ctx, `DELETE FROM plan_entitlements WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete plan entitlement: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("plan entitlement not found")
+	}
+
+	return nil
+}
@@ -18,7 +18,7 @@ func NewPlanMeterRepository(db *pgxpool.Pool) domain.PlanMeterRepository {
 
 func (r *PlanMeterRepository) GetByID(ctx context.Context, id int64) (*domain.PlanMeter, error) {
 	query := `
-		SELECT id, name, created_at, updated_at
+		SELECT id, name, aggregation, created_at, updated_at
 		FROM plan_meters 
 		WHERE id = $1
 	`
@@ -27,6 +27,7 @@ func (r *PlanMeterRepository) GetByID(ctx context.Context, id int64) (*domain.Pl
 	err := r.db.QueryRow(ctx, query, id).Scan(
 		&meter.ID,
 		&meter.Name,
+		&meter.Aggregation,
 		&meter.CreatedAt,
 		&meter.UpdatedAt,
 	)
@@ -39,12 +40,12 @@ func (r *PlanMeterRepository) GetByID(ctx context.Context, id int64) (*domain.Pl
 
 func (r *PlanMeterRepository) Create(ctx context.Context, meter *domain.PlanMeter) error {
 	query := `
-		INSERT INTO plan_meters (name, created_at, updated_at)
-		VALUES ($1, NOW(), NOW())
+		INSERT INTO plan_meters (name, aggregation, created_at, updated_at)
+		VALUES ($1, $2, NOW(), NOW())
 		RETURNING id, created_at, updated_at
 	`
 
-	err := r.db.QueryRow(ctx, query, meter.Name).Scan(
+	err := r.db.QueryRow(ctx, query, meter.Name, meter.Aggregation).Scan(
 		&meter.ID,
 		&meter.CreatedAt,
 		&meter.UpdatedAt,
@@ -59,12 +60,12 @@ func (r *PlanMeterRepository) Create(ctx context.Context, meter *domain.PlanMete
 func (r *PlanMeterRepository) Update(ctx context.Context, meter *domain.PlanMeter) error {
 	query := `
 		UPDATE plan_meters 
-		SET name = $1, updated_at = NOW()
-		WHERE id = $2
+		SET name = $1, aggregation = $2, updated_at = NOW()
+		WHERE id = $3
 		RETURNING updated_at
 	`
 
-	err := r.db.QueryRow(ctx, query, meter.Name, meter.ID).Scan(&meter.UpdatedAt)
+	err := r.db.QueryRow(ctx, query, meter.Name, meter.Aggregation, meter.ID).Scan(&meter.UpdatedAt)
 	if err != nil {
 		return fmt.Errorf("failed to update plan meter: %w", err)
 	}
@@ -100,7 +101,7 @@ func (r *PlanMeterRepository) GetAll(ctx context.Context, page, perPage int) ([]
 
 	// Get plan meters
 	query := `
-		SELECT id, name, created_at, updated_at
+		SELECT id, name, aggregation, created_at, updated_at
 		FROM plan_meters 
 		ORDER BY created_at DESC
 		LIMIT $1 OFFSET $2
@@ -118,6 +119,7 @@ func (r *PlanMeterRepository) GetAll(ctx context.Context, page, perPage int) ([]
 		err := rows.Scan(
 			&meter.ID,
 			&meter.Name,
+			&meter.Aggregation,
 			&meter.CreatedAt,
 			&meter.UpdatedAt,
 		)
@@ -6,6 +6,8 @@ import (
 	"fmt"
 
 	"github.com/damarteplok/damar-admin-cms/services/product-service/internal/domain"
+	"github.com/damarteplok/damar-admin-cms/shared/pricing"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -17,17 +19,13 @@ func NewPlanPriceRepository(db *pgxpool.Pool) domain.PlanPriceRepository {
 	return &PlanPriceRepository{db: db}
 }
 
-func (r *PlanPriceRepository) GetByID(ctx context.Context, id int64) (*domain.PlanPrice, error) {
-	query := `
-		SELECT id, plan_id, currency_id, price, price_per_unit, type, tiers, created_at, updated_at
-		FROM plan_prices 
-		WHERE id = $1
-	`
+// planPriceColumns matches the scan order of scanPlanPrice
+const planPriceColumns = `id, plan_id, currency_id, price, price_per_unit, type, tiers, derived_from_price_id,
+	created_at, updated_at`
 
-	planPrice := &domain.PlanPrice{}
+func scanPlanPrice(row pgx.Row, planPrice *domain.PlanPrice) error {
 	var tiersJSON []byte
-
-	err := r.db.QueryRow(ctx, query, id).Scan(
+	err := row.Scan(
 		&planPrice.ID,
 		&planPrice.PlanID,
 		&planPrice.CurrencyID,
@@ -35,18 +33,27 @@ func (r *PlanPriceRepository) GetByID(ctx context.Context, id int64) (*domain.Pl
 		&planPrice.PricePerUnit,
 		&planPrice.Type,
 		&tiersJSON,
+		&planPrice.DerivedFromID,
 		&planPrice.CreatedAt,
 		&planPrice.UpdatedAt,
 	)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get plan price by ID: %w", err)
+		return err
 	}
 
-	// Parse JSON tiers
-	if len(tiersJSON) > 0 {
-		if err := json.Unmarshal(tiersJSON, &planPrice.Tiers); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal tiers: %w", err)
-		}
+	planPrice.Tiers, err = pricing.ParseTiers(tiersJSON)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal tiers: %w", err)
+	}
+	return nil
+}
+
+func (r *PlanPriceRepository) GetByID(ctx context.Context, id int64) (*domain.PlanPrice, error) {
+	query := `SELECT ` + planPriceColumns + ` FROM plan_prices WHERE id = $1`
+
+	planPrice := &domain.PlanPrice{}
+	if err := scanPlanPrice(r.db.QueryRow(ctx, query, id), planPrice); err != nil {
+		return nil, fmt.Errorf("failed to get plan price by ID: %w", err)
 	}
 
 	return planPrice, nil
@@ -54,45 +61,39 @@ func (r *PlanPriceRepository) GetByID(ctx context.Context, id int64) (*domain.Pl
 
 func (r *PlanPriceRepository) GetByPlan(ctx context.Context, planID int64) ([]*domain.PlanPrice, error) {
 	query := `
-		SELECT id, plan_id, currency_id, price, price_per_unit, type, tiers, created_at, updated_at
+		SELECT ` + planPriceColumns + `
 		FROM plan_prices 
 		WHERE plan_id = $1
 		ORDER BY created_at DESC
 	`
 
-	rows, err := r.db.Query(ctx, query, planID)
+	return r.list(ctx, query, planID)
+}
+
+func (r *PlanPriceRepository) GetManualByCurrency(ctx context.Context, currencyID int64) ([]*domain.PlanPrice, error) {
+	query := `
+		SELECT ` + planPriceColumns + `
+		FROM plan_prices
+		WHERE currency_id = $1 AND derived_from_price_id IS NULL
+		ORDER BY plan_id, created_at DESC
+	`
+
+	return r.list(ctx, query, currencyID)
+}
+
+func (r *PlanPriceRepository) list(ctx context.Context, query string, arg interface{}) ([]*domain.PlanPrice, error) {
+	rows, err := r.db.Query(ctx, query, arg)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get plan prices by plan: %w", err)
+		return nil, fmt.Errorf("failed to get plan prices: %w", err)
 	}
 	defer rows.Close()
 
 	planPrices := make([]*domain.PlanPrice, 0)
 	for rows.Next() {
 		planPrice := &domain.PlanPrice{}
-		var tiersJSON []byte
-
-		err := rows.Scan(
-			&planPrice.ID,
-			&planPrice.PlanID,
-			&planPrice.CurrencyID,
-			&planPrice.Price,
-			&planPrice.PricePerUnit,
-			&planPrice.Type,
-			&tiersJSON,
-			&planPrice.CreatedAt,
-			&planPrice.UpdatedAt,
-		)
-		if err != nil {
+		if err := scanPlanPrice(rows, planPrice); err != nil {
 			return nil, fmt.Errorf("failed to scan plan price: %w", err)
 		}
-
-		// Parse JSON tiers
-		if len(tiersJSON) > 0 {
-			if err := json.Unmarshal(tiersJSON, &planPrice.Tiers); err != nil {
-				return nil, fmt.Errorf("failed to unmarshal tiers: %w", err)
-			}
-		}
-
 		planPrices = append(planPrices, planPrice)
 	}
 
@@ -104,14 +105,15 @@ func (r *PlanPriceRepository) GetByPlan(ctx context.Context, planID int64) ([]*d
 }
 
 func (r *PlanPriceRepository) Create(ctx context.Context, planPrice *domain.PlanPrice) error {
-	tiersJSON, err := json.Marshal(planPrice.Tiers)
+	tiersJSON, err := marshalTiers(planPrice.Tiers)
 	if err != nil {
-		return fmt.Errorf("failed to marshal tiers: %w", err)
+		return err
 	}
 
 	query := `
-		INSERT INTO plan_prices (plan_id, currency_id, price, price_per_unit, type, tiers, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, NOW(), NOW())
+		INSERT INTO plan_prices (plan_id, currency_id, price, price_per_unit, type, tiers, derived_from_price_id,
+		                         created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, NOW(), NOW())
 		RETURNING id, created_at, updated_at
 	`
 
@@ -124,6 +126,7 @@ func (r *PlanPriceRepository) Create(ctx context.Context, planPrice *domain.Plan
 		planPrice.PricePerUnit,
 		planPrice.Type,
 		tiersJSON,
+		planPrice.DerivedFromID,
 	).Scan(&planPrice.ID, &planPrice.CreatedAt, &planPrice.UpdatedAt)
 	if err != nil {
 		return fmt.Errorf("failed to create plan price: %w", err)
@@ -133,16 +136,16 @@ func (r *PlanPriceRepository) Create(ctx context.Context, planPrice *domain.Plan
 }
 
 func (r *PlanPriceRepository) Update(ctx context.Context, planPrice *domain.PlanPrice) error {
-	tiersJSON, err := json.Marshal(planPrice.Tiers)
+	tiersJSON, err := marshalTiers(planPrice.Tiers)
 	if err != nil {
-		return fmt.Errorf("failed to marshal tiers: %w", err)
+		return err
 	}
 
 	query := `
 		UPDATE plan_prices 
 		SET plan_id = $1, currency_id = $2, price = $3, price_per_unit = $4, 
-		    type = $5, tiers = $6, updated_at = NOW()
-		WHERE id = $7
+		    type = $5, tiers = $6, derived_from_price_id = $7, updated_at = NOW()
+		WHERE id = $8
 		RETURNING updated_at
 	`
 
@@ -155,6 +158,7 @@ func (r *PlanPriceRepository) Update(ctx context.Context, planPrice *domain.Plan
 		planPrice.PricePerUnit,
 		planPrice.Type,
 		tiersJSON,
+		planPrice.DerivedFromID,
 		planPrice.ID,
 	).Scan(&planPrice.UpdatedAt)
 	if err != nil {
@@ -178,3 +182,15 @@ func (r *PlanPriceRepository) Delete(ctx context.Context, id int64) error {
 
 	return nil
 }
+
+// marshalTiers stores prices without tiers as NULL
+func marshalTiers(tiers *pricing.Tiers) ([]byte, error) {
+	if tiers == nil {
+		return nil, nil
+	}
+	tiersJSON, err := json.Marshal(tiers)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal tiers: %w", err)
+	}
+	return tiersJSON, nil
+}
@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/damarteplok/damar-admin-cms/services/product-service/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ProductPriceRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewProductPriceRepository(db *pgxpool.Pool) domain.ProductPriceRepository {
+	return &ProductPriceRepository{db: db}
+}
+
+// productPriceColumns matches the scan order of scanProductPrice
+const productPriceColumns = `id, product_id, currency_id, price, created_at, updated_at`
+
+func scanProductPrice(row pgx.Row, price *domain.ProductPrice) error {
+	return row.Scan(
+		&price.ID,
+		&price.ProductID,
+		&price.CurrencyID,
+		&price.Price,
+		&price.CreatedAt,
+		&price.UpdatedAt,
+	)
+}
+
+func (r *ProductPriceRepository) GetByID(ctx context.Context, id int64) (*domain.ProductPrice, error) {
+	query := `SELECT ` + productPriceColumns + ` FROM product_prices WHERE id = $1`
+
+	price := &domain.ProductPrice{}
+	if err := scanProductPrice(r.db.QueryRow(ctx, query, id), price); err != nil {
+		return nil, fmt.Errorf("failed to get product price by ID: %w", err)
+	}
+
+	return price, nil
+}
+
+func (r *ProductPriceRepository) GetByProduct(ctx context.Context, productID int64) ([]*domain.ProductPrice, error) {
+	query := `
+		SELECT ` + productPriceColumns + `
+		FROM product_prices
+		WHERE product_id = $1
+		ORDER BY currency_id
+	`
+
+	rows, err := r.db.Query(ctx, query, productID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get product prices: %w", err)
+	}
+	defer rows.Close()
+
+	prices := make([]*domain.ProductPrice, 0)
+	for rows.Next() {
+		price := &domain.ProductPrice{}
+		if err := scanProductPrice(rows, price); err != nil {
+			return nil, fmt.Errorf("failed to scan product price: %w", err)
+		}
+		prices = append(prices, price)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating product prices: %w", err)
+	}
+
+	return prices, nil
+}
+
+func (r *ProductPriceRepository) GetByProductAndCurrency(ctx context.Context, productID, currencyID int64) (*domain.ProductPrice, error) {
+	query := `SELECT ` + productPriceColumns + ` FROM product_prices WHERE product_id = $1 AND currency_id = $2`
+
+	price := &domain.ProductPrice{}
+	if err := scanProductPrice(r.db.QueryRow(ctx, query, productID, currencyID), price); err != nil {
+		return nil, fmt.Errorf("failed to get product price: %w", err)
+	}
+
+	return price, nil
+}
+
+func (r *ProductPriceRepository) Upsert(ctx context.Context, price *domain.ProductPrice) error {
+	query := `
+		INSERT INTO product_prices (product_id, currency_id, price, created_at, updated_at)
+		VALUES ($1, $2, $3, NOW(), NOW())
+		ON CONFLICT (product_id, currency_id)
+		DO UPDATE SET price = EXCLUDED.price, updated_at = NOW()
+		RETURNING ` + productPriceColumns
+
+	err := scanProductPrice(r.db.QueryRow(ctx, query, price.ProductID, price.CurrencyID, price.Price), price)
+	if err != nil {
+		return fmt.Errorf("failed to save product price: %w", err)
+	}
+
+	return nil
+}
+
+func (r *ProductPriceRepository) Delete(ctx context.Context, id int64) error {
+	result, err := r.db.Exec(ctx, `DELETE FROM product_prices WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete product price: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("product price not found")
+	}
+
+	return nil
+}
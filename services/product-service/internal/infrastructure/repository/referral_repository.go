@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/damarteplok/damar-admin-cms/services/product-service/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ReferralRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewReferralRepository(db *pgxpool.Pool) domain.ReferralRepository {
+	return &ReferralRepository{db: db}
+}
+
+// referralColumns matches the scan order of scanReferral
+const referralColumns = `id, discount_code_id, discount_code_redemption_id, referrer_user_id, referred_user_id,
+	subscription_id, status, reward_bonus_days, converted_at, created_at, updated_at`
+
+func scanReferral(row pgx.Row, referral *domain.Referral) error {
+	return row.Scan(
+		&referral.ID,
+		&referral.DiscountCodeID,
+		&referral.DiscountCodeRedemptionID,
+		&referral.ReferrerUserID,
+		&referral.ReferredUserID,
+		&referral.SubscriptionID,
+		&referral.Status,
+		&referral.RewardBonusDays,
+		&referral.ConvertedAt,
+		&referral.CreatedAt,
+		&referral.UpdatedAt,
+	)
+}
+
+func (r *ReferralRepository) GetByReferrer(ctx context.Context, referrerUserID int64, page, perPage int) ([]*domain.Referral, int, error) {
+	offset := (page - 1) * perPage
+
+	var total int
+	countQuery := `SELECT COUNT(*) FROM referrals WHERE referrer_user_id = $1`
+	if err := r.db.QueryRow(ctx, countQuery, referrerUserID).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count referrals: %w", err)
+	}
+
+	query := `
+		SELECT ` + referralColumns + `
+		FROM referrals
+		WHERE referrer_user_id = $1
+		ORDER BY created_at DESC
+		LIMIT $2 OFFSET $3
+	`
+
+	rows, err := r.db.Query(ctx, query, referrerUserID, perPage, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get referrals: %w", err)
+	}
+	defer rows.Close()
+
+	referrals := make([]*domain.Referral, 0)
+	for rows.Next() {
+		referral := &domain.Referral{}
+		if err := scanReferral(rows, referral); err != nil {
+			return nil, 0, fmt.Errorf("failed to scan referral: %w", err)
+		}
+		referrals = append(referrals, referral)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating referrals: %w", err)
+	}
+
+	return referrals, total, nil
+}
+
+func (r *ReferralRepository) GetStats(ctx context.Context, referrerUserID int64) (*domain.ReferralStats, error) {
+	query := `
+		SELECT
+			COUNT(*),
+			COUNT(*) FILTER (WHERE status = 'pending'),
+			COUNT(*) FILTER (WHERE status = 'converted'),
+			COALESCE(SUM(reward_bonus_days) FILTER (WHERE status = 'converted'), 0)
+		FROM referrals
+		WHERE referrer_user_id = $1
+	`
+
+	stats := &domain.ReferralStats{ReferrerUserID: referrerUserID}
+	err := r.db.QueryRow(ctx, query, referrerUserID).Scan(
+		&stats.TotalReferrals,
+		&stats.PendingReferrals,
+		&stats.ConvertedReferrals,
+		&stats.BonusDaysEarned,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get referral stats: %w", err)
+	}
+
+	return stats, nil
+}
+
+func (r *ReferralRepository) Convert(ctx context.Context, referredUserID int64, subscriptionID *int64, rewardBonusDays int32, convertedAt time.Time) (*domain.Referral, error) {
+	// The status guard makes conversion happen once, however many paid
+	// invoices arrive for the user
+	query := `
+		UPDATE referrals
+		SET status = 'converted', subscription_id = COALESCE(subscription_id, $2),
+		    reward_bonus_days = $3, converted_at = $4, updated_at = NOW()
+		WHERE referred_user_id = $1 AND status = 'pending'
+		RETURNING ` + referralColumns
+
+	referral := &domain.Referral{}
+	err := scanReferral(r.db.QueryRow(ctx, query, referredUserID, subscriptionID, rewardBonusDays, convertedAt), referral)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to convert referral: %w", err)
+	}
+
+	return referral, nil
+}
@@ -0,0 +1,286 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/damarteplok/damar-admin-cms/services/product-service/internal/domain"
+	"github.com/damarteplok/damar-admin-cms/shared/pricing"
+)
+
+// maxDiscountCycles bounds the cycle count of duration-limited discounts, so
+// a daily plan with a multi-year discount cannot loop for long
+const maxDiscountCycles = 10000
+
+type checkoutService struct {
+	planPriceRepo    domain.PlanPriceRepository
+	planRepo         domain.PlanRepository
+	intervalRepo     domain.IntervalRepository
+	discountRepo     domain.DiscountRepository
+	codeRepo         domain.DiscountCodeRepository
+	discountPlanRepo domain.DiscountPlanRepository
+}
+
+func NewCheckoutService(
+	planPriceRepo domain.PlanPriceRepository,
+	planRepo domain.PlanRepository,
+	intervalRepo domain.IntervalRepository,
+	discountRepo domain.DiscountRepository,
+	codeRepo domain.DiscountCodeRepository,
+	discountPlanRepo domain.DiscountPlanRepository,
+) domain.CheckoutService {
+	return &checkoutService{
+		planPriceRepo:    planPriceRepo,
+		planRepo:         planRepo,
+		intervalRepo:     intervalRepo,
+		discountRepo:     discountRepo,
+		codeRepo:         codeRepo,
+		discountPlanRepo: discountPlanRepo,
+	}
+}
+
+func (s *checkoutService) PreviewCheckout(ctx context.Context, planPriceID, quantity int64, discountCode string) (*domain.CheckoutPreview, error) {
+	if planPriceID <= 0 {
+		return nil, errors.New("invalid plan price ID")
+	}
+	if quantity < 0 {
+		return nil, errors.New("quantity cannot be negative")
+	}
+
+	planPrice, err := s.planPriceRepo.GetByID(ctx, planPriceID)
+	if err != nil {
+		return nil, err
+	}
+	plan, err := s.planRepo.GetByID(ctx, planPrice.PlanID)
+	if err != nil {
+		return nil, err
+	}
+	if !plan.IsActive {
+		return nil, errors.New("plan is not active")
+	}
+
+	quote, err := toPricingPrice(planPrice).Quote(quantity)
+	if err != nil {
+		return nil, err
+	}
+
+	preview := &domain.CheckoutPreview{
+		Plan:      plan,
+		PlanPrice: planPrice,
+		Quantity:  quote.Quantity,
+		Subtotal:  quote.Amount,
+	}
+	for _, line := range quote.Lines {
+		preview.Lines = append(preview.Lines, planCheckoutLines(plan, line)...)
+	}
+
+	now := time.Now()
+	if code := strings.TrimSpace(discountCode); code != "" {
+		if err := s.applyDiscount(ctx, preview, code, now); err != nil {
+			return nil, err
+		}
+	}
+
+	preview.Total = preview.Subtotal - preview.DiscountAmount
+	preview.RecurringTotal = preview.Subtotal
+
+	if err := s.applyTrial(ctx, preview, now); err != nil {
+		return nil, err
+	}
+
+	return preview, nil
+}
+
+// applyDiscount checks that the code can be used on the plan and adds the
+// discount line and the number of cycles it covers
+func (s *checkoutService) applyDiscount(ctx context.Context, preview *domain.CheckoutPreview, code string, now time.Time) error {
+	discountCode, err := s.codeRepo.GetByCode(ctx, code)
+	if err != nil {
+		return errors.New("discount code not found")
+	}
+	discount, err := s.discountRepo.GetByID(ctx, discountCode.DiscountID)
+	if err != nil {
+		return errors.New("discount not found")
+	}
+
+	if err := checkDiscountUsable(discount, discountCode, now); err != nil {
+		return err
+	}
+	if !discount.IsEnabledForAllPlans {
+		eligible, err := s.discountAppliesToPlan(ctx, discount.ID, preview.Plan.ID)
+		if err != nil {
+			return err
+		}
+		if !eligible {
+			return errors.New("discount code does not apply to this plan")
+		}
+	}
+
+	amount, err := pricing.DiscountAmount(discount.Type, discount.Amount, preview.Subtotal)
+	if err != nil {
+		return err
+	}
+
+	preview.Discount = discount
+	preview.DiscountCode = discountCode
+	preview.DiscountAmount = amount
+	if amount > 0 {
+		preview.Lines = append(preview.Lines, domain.CheckoutLine{
+			Type:        domain.CheckoutLineTypeDiscount,
+			Description: fmt.Sprintf("Discount: %s (%s)", discount.Name, discountCode.Code),
+			Quantity:    1,
+			UnitAmount:  -amount,
+			Amount:      -amount,
+		})
+	}
+	if discount.BonusDays != nil && *discount.BonusDays > 0 {
+		preview.BonusDays = *discount.BonusDays
+	}
+
+	interval, err := s.intervalRepo.GetByID(ctx, preview.Plan.IntervalID)
+	if err != nil {
+		return err
+	}
+	preview.DiscountCycles, preview.DiscountForever, err = discountCycles(discount, interval.Slug, preview.Plan.IntervalCount, now)
+	return err
+}
+
+func (s *checkoutService) discountAppliesToPlan(ctx context.Context, discountID, planID int64) (bool, error) {
+	discountPlans, err := s.discountPlanRepo.GetByDiscount(ctx, discountID)
+	if err != nil {
+		return false, err
+	}
+	for _, discountPlan := range discountPlans {
+		if discountPlan.PlanID == planID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// applyTrial adds the plan's trial and the discount's bonus days, which
+// extend the trial, or start a free period on plans without one
+func (s *checkoutService) applyTrial(ctx context.Context, preview *domain.CheckoutPreview, now time.Time) error {
+	plan := preview.Plan
+	trialEnd := now
+	if plan.HasTrial && plan.TrialIntervalID != nil && plan.TrialIntervalCount > 0 {
+		interval, err := s.intervalRepo.GetByID(ctx, *plan.TrialIntervalID)
+		if err != nil {
+			return err
+		}
+		trialEnd, err = pricing.NextPeriodEnd(now, interval.Slug, plan.TrialIntervalCount)
+		if err != nil {
+			return err
+		}
+		preview.TrialDays = int32(trialEnd.Sub(now).Round(24*time.Hour) / (24 * time.Hour))
+	}
+
+	trialEnd = trialEnd.AddDate(0, 0, int(preview.BonusDays))
+	if trialEnd.After(now) {
+		preview.TrialEndsAt = &trialEnd
+	}
+	return nil
+}
+
+// checkDiscountUsable checks a code and its discount against their active
+// flags, validity windows and global usage limits
+func checkDiscountUsable(discount *domain.Discount, code *domain.DiscountCode, now time.Time) error {
+	if !code.IsActive {
+		return errors.New("discount code is not active")
+	}
+	if code.ValidFrom != nil && now.Before(*code.ValidFrom) {
+		return errors.New("discount code is not yet valid")
+	}
+	if code.ValidUntil != nil && now.After(*code.ValidUntil) {
+		return errors.New("discount code has expired")
+	}
+	if code.MaxUses != nil && code.TimesUsed >= *code.MaxUses {
+		return errors.New("discount code has reached maximum uses")
+	}
+	if !discount.IsActive {
+		return errors.New("discount is not active")
+	}
+	if discount.ValidUntil != nil && now.After(*discount.ValidUntil) {
+		return errors.New("discount has expired")
+	}
+	if discount.MaxRedemptions != nil && discount.Redemptions >= *discount.MaxRedemptions {
+		return errors.New("discount has reached maximum redemptions")
+	}
+	return nil
+}
+
+// discountCycles returns how many billing cycles starting at start a
+// discount covers. One-off discounts cover the first cycle only. Recurring
+// discounts are bounded by MaximumRecurringIntervals and by the cycles that
+// start within DurationInMonths, whichever is smaller, and run forever when
+// neither is set.
+func discountCycles(discount *domain.Discount, intervalSlug string, intervalCount int32, start time.Time) (int32, bool, error) {
+	if !discount.IsRecurring {
+		return 1, false, nil
+	}
+
+	var cycles int32
+	if discount.MaximumRecurringIntervals != nil && *discount.MaximumRecurringIntervals > 0 {
+		cycles = *discount.MaximumRecurringIntervals
+	}
+
+	if discount.DurationInMonths != nil && *discount.DurationInMonths > 0 {
+		end, err := pricing.PeriodEnd(start, pricing.IntervalMonthly, *discount.DurationInMonths, 1)
+		if err != nil {
+			return 0, false, err
+		}
+
+		// Cycles are counted from start the way subscription-service bills
+		// them, so a cycle starting on the 31st is not pulled back for good
+		// by a short month
+		var inDuration int32
+		for inDuration < maxDiscountCycles {
+			periodStart, err := pricing.PeriodEnd(start, intervalSlug, intervalCount, int(inDuration))
+			if err != nil {
+				return 0, false, err
+			}
+			if !periodStart.Before(end) {
+				break
+			}
+			inDuration++
+		}
+		if cycles == 0 || inDuration < cycles {
+			cycles = inDuration
+		}
+	}
+
+	if cycles == 0 {
+		return 0, true, nil
+	}
+	return cycles, false, nil
+}
+
+// planCheckoutLines turns a quote line into a unit line and, for tiers with
+// a flat fee, a separate fee line
+func planCheckoutLines(plan *domain.Plan, line pricing.QuoteLine) []domain.CheckoutLine {
+	description := plan.Name
+	if line.Tier > 0 {
+		description = fmt.Sprintf("%s (tier %d)", plan.Name, line.Tier)
+	}
+
+	lines := []domain.CheckoutLine{{
+		Type:        domain.CheckoutLineTypePlan,
+		Description: description,
+		Quantity:    line.Quantity,
+		UnitAmount:  line.UnitPrice,
+		Amount:      line.Amount - line.FlatPrice,
+	}}
+	if line.FlatPrice > 0 {
+		lines = append(lines, domain.CheckoutLine{
+			Type:        domain.CheckoutLineTypePlan,
+			Description: description + " flat fee",
+			Quantity:    1,
+			UnitAmount:  line.FlatPrice,
+			Amount:      line.FlatPrice,
+		})
+	}
+	return lines
+}
@@ -0,0 +1,147 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/damarteplok/damar-admin-cms/services/product-service/internal/domain"
+	"github.com/damarteplok/damar-admin-cms/shared/pricing"
+)
+
+type currencyService struct {
+	repo    domain.CurrencyRepository
+	deriver domain.PriceDerivationService
+}
+
+// NewCurrencyService creates the currency service. deriver is nil unless
+// derived plan prices are refreshed automatically; a change to a currency's
+// rounding rules then re-derives every plan.
+func NewCurrencyService(repo domain.CurrencyRepository, deriver domain.PriceDerivationService) domain.CurrencyService {
+	return &currencyService{
+		repo:    repo,
+		deriver: deriver,
+	}
+}
+
+func (s *currencyService) GetByID(ctx context.Context, id int64) (*domain.Currency, error) {
+	if id <= 0 {
+		return nil, errors.New("invalid currency ID")
+	}
+	return s.repo.GetByID(ctx, id)
+}
+
+func (s *currencyService) GetAll(ctx context.Context) ([]*domain.Currency, error) {
+	return s.repo.GetAll(ctx)
+}
+
+func (s *currencyService) Create(ctx context.Context, currency *domain.Currency) error {
+	if currency == nil {
+		return errors.New("currency cannot be nil")
+	}
+
+	currency.Code = strings.ToUpper(strings.TrimSpace(currency.Code))
+	if !isCurrencyCode(currency.Code) {
+		return errors.New("currency code must be three letters (ISO 4217)")
+	}
+	if strings.TrimSpace(currency.Name) == "" {
+		return errors.New("currency name is required")
+	}
+	if strings.TrimSpace(currency.Symbol) == "" {
+		return errors.New("currency symbol is required")
+	}
+	if currency.RoundingIncrement == 0 {
+		currency.RoundingIncrement = 1
+	}
+	if currency.RoundingMode == "" {
+		currency.RoundingMode = pricing.RoundNearest
+	}
+	if err := currency.Rounding().Validate(); err != nil {
+		return err
+	}
+
+	if _, err := s.repo.GetByCode(ctx, currency.Code); err == nil {
+		return errors.New("currency code already exists")
+	}
+
+	return s.repo.Create(ctx, currency)
+}
+
+func (s *currencyService) Update(ctx context.Context, params domain.UpdateCurrencyParams) (*domain.Currency, error) {
+	if params.ID <= 0 {
+		return nil, errors.New("invalid currency ID")
+	}
+
+	currency, err := s.repo.GetByID(ctx, params.ID)
+	if err != nil {
+		return nil, errors.New("currency not found")
+	}
+	previous := currency.Rounding()
+
+	if params.Name != nil {
+		if strings.TrimSpace(*params.Name) == "" {
+			return nil, errors.New("currency name is required")
+		}
+		currency.Name = *params.Name
+	}
+	if params.Symbol != nil {
+		if strings.TrimSpace(*params.Symbol) == "" {
+			return nil, errors.New("currency symbol is required")
+		}
+		currency.Symbol = *params.Symbol
+	}
+	if params.DecimalPlaces != nil {
+		currency.DecimalPlaces = *params.DecimalPlaces
+	}
+	if params.RoundingIncrement != nil {
+		currency.RoundingIncrement = *params.RoundingIncrement
+	}
+	if params.RoundingMode != nil {
+		currency.RoundingMode = *params.RoundingMode
+	}
+	if err := currency.Rounding().Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Update(ctx, currency); err != nil {
+		return nil, err
+	}
+
+	if currency.Rounding() != previous {
+		autoDeriveAll(ctx, s.deriver)
+	}
+
+	return currency, nil
+}
+
+func (s *currencyService) Delete(ctx context.Context, id int64) error {
+	if id <= 0 {
+		return errors.New("invalid currency ID")
+	}
+
+	if _, err := s.repo.GetByID(ctx, id); err != nil {
+		return errors.New("currency not found")
+	}
+
+	inUse, err := s.repo.IsInUse(ctx, id)
+	if err != nil {
+		return err
+	}
+	if inUse {
+		return errors.New("currency is in use by prices, subscriptions, invoices or orders")
+	}
+
+	return s.repo.Delete(ctx, id)
+}
+
+func isCurrencyCode(code string) bool {
+	if len(code) != 3 {
+		return false
+	}
+	for _, r := range code {
+		if r < 'A' || r > 'Z' {
+			return false
+		}
+	}
+	return true
+}
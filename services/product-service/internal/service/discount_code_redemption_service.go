@@ -47,49 +47,54 @@ func (s *discountCodeRedemptionService) GetByDiscountCode(ctx context.Context, c
 	return s.repo.GetByDiscountCode(ctx, codeID)
 }
 
-func (s *discountCodeRedemptionService) RedeemCode(ctx context.Context, discountCodeID, userID int64, subscriptionID, orderID *int64) error {
+func (s *discountCodeRedemptionService) RedeemCode(ctx context.Context, discountCodeID, userID int64, subscriptionID, orderID *int64) (*domain.DiscountCodeRedemption, error) {
 	if userID <= 0 {
-		return errors.New("user ID is required")
+		return nil, errors.New("user ID is required")
 	}
 	if discountCodeID <= 0 {
-		return errors.New("discount code ID is required")
-	}
-
-	// Verify discount code exists and get details
-	code, err := s.codeRepo.GetByID(ctx, discountCodeID)
-	if err != nil {
-		return errors.New("discount code not found")
-	}
-
-	// Check if code is still active
-	if !code.IsActive {
-		return errors.New("discount code is not active")
-	}
-
-	// Check max uses
-	if code.MaxUses != nil && code.TimesUsed >= *code.MaxUses {
-		return errors.New("discount code has reached maximum uses")
+		return nil, errors.New("discount code ID is required")
 	}
 
-	// Create redemption
+	now := time.Now()
 	redemption := &domain.DiscountCodeRedemption{
 		DiscountCodeID: discountCodeID,
 		UserID:         userID,
 		SubscriptionID: subscriptionID,
 		OrderID:        orderID,
-		RedeemedAt:     time.Now(),
+		RedeemedAt:     now,
 	}
 
-	if err := s.repo.Create(ctx, redemption); err != nil {
-		return err
+	// The limits are checked against rows locked by the repository, so two
+	// concurrent checkouts cannot both take the last redemption
+	_, err := s.repo.Redeem(ctx, redemption, func(code *domain.DiscountCode, discount *domain.Discount, userRedemptions int32) error {
+		if err := checkDiscountUsable(discount, code, now); err != nil {
+			return err
+		}
+		if code.ReferrerUserID != nil && *code.ReferrerUserID == userID {
+			return errors.New("cannot redeem your own referral code")
+		}
+		if discount.MaxRedemptionsPerUser != nil && userRedemptions >= *discount.MaxRedemptionsPerUser {
+			return errors.New("discount has reached maximum redemptions for this user")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	// Increment code usage
-	if err := s.codeRepo.IncrementUsage(ctx, discountCodeID); err != nil {
-		return err
+	return redemption, nil
+}
+
+func (s *discountCodeRedemptionService) ReleaseOrderCode(ctx context.Context, discountCodeID, orderID int64) error {
+	if discountCodeID <= 0 {
+		return errors.New("discount code ID is required")
+	}
+	if orderID <= 0 {
+		return errors.New("order ID is required")
 	}
 
-	return nil
+	_, err := s.repo.ReleaseOrder(ctx, discountCodeID, orderID)
+	return err
 }
 
 func (s *discountCodeRedemptionService) GetAll(ctx context.Context, page, perPage int) ([]*domain.DiscountCodeRedemption, int, error) {
@@ -0,0 +1,147 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/damarteplok/damar-admin-cms/services/product-service/internal/domain"
+)
+
+// fakeRedemptions holds one code and its discount and redeems like the
+// repository: check runs against the current counters, which only move when
+// it passes
+type fakeRedemptions struct {
+	domain.DiscountCodeRedemptionRepository
+	code        *domain.DiscountCode
+	discount    *domain.Discount
+	redemptions []*domain.DiscountCodeRedemption
+}
+
+func (f *fakeRedemptions) Redeem(ctx context.Context, redemption *domain.DiscountCodeRedemption, check domain.RedemptionCheck) (bool, error) {
+	var userRedemptions int32
+	for _, existing := range f.redemptions {
+		if existing.OrderID != nil && redemption.OrderID != nil && *existing.OrderID == *redemption.OrderID {
+			*redemption = *existing
+			return false, nil
+		}
+		if existing.UserID == redemption.UserID {
+			userRedemptions++
+		}
+	}
+
+	if err := check(f.code, f.discount, userRedemptions); err != nil {
+		return false, err
+	}
+
+	redemption.ID = int64(len(f.redemptions) + 1)
+	f.redemptions = append(f.redemptions, redemption)
+	f.code.TimesUsed++
+	f.discount.Redemptions++
+	return true, nil
+}
+
+func (f *fakeRedemptions) ReleaseOrder(ctx context.Context, discountCodeID, orderID int64) (bool, error) {
+	for i, existing := range f.redemptions {
+		if existing.OrderID != nil && *existing.OrderID == orderID {
+			f.redemptions = append(f.redemptions[:i], f.redemptions[i+1:]...)
+			f.code.TimesUsed--
+			f.discount.Redemptions--
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func newFakeRedemptions() *fakeRedemptions {
+	return &fakeRedemptions{
+		code:     &domain.DiscountCode{ID: 1, DiscountID: 2, Code: "SPRING", IsActive: true},
+		discount: &domain.Discount{ID: 2, Name: "Spring", IsActive: true},
+	}
+}
+
+func int32Ptr(i int32) *int32 {
+	return &i
+}
+
+func TestRedeemCodeLimits(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+	future := time.Now().Add(time.Hour)
+	referrer := int64(10)
+
+	tests := []struct {
+		name    string
+		setup   func(f *fakeRedemptions)
+		wantErr bool
+	}{
+		{"usable", func(f *fakeRedemptions) {}, false},
+		{"inactive code", func(f *fakeRedemptions) { f.code.IsActive = false }, true},
+		{"code not yet valid", func(f *fakeRedemptions) { f.code.ValidFrom = &future }, true},
+		{"code expired", func(f *fakeRedemptions) { f.code.ValidUntil = &past }, true},
+		{"code max uses reached", func(f *fakeRedemptions) { f.code.MaxUses, f.code.TimesUsed = int32Ptr(5), 5 }, true},
+		{"code below max uses", func(f *fakeRedemptions) { f.code.MaxUses, f.code.TimesUsed = int32Ptr(5), 4 }, false},
+		{"inactive discount", func(f *fakeRedemptions) { f.discount.IsActive = false }, true},
+		{"discount expired", func(f *fakeRedemptions) { f.discount.ValidUntil = &past }, true},
+		{"discount max redemptions reached", func(f *fakeRedemptions) {
+			f.discount.MaxRedemptions, f.discount.Redemptions = int32Ptr(3), 3
+		}, true},
+		{"per user limit reached", func(f *fakeRedemptions) {
+			f.discount.MaxRedemptionsPerUser = int32Ptr(1)
+			f.redemptions = append(f.redemptions, &domain.DiscountCodeRedemption{ID: 1, UserID: 10})
+		}, true},
+		{"per user limit of another user", func(f *fakeRedemptions) {
+			f.discount.MaxRedemptionsPerUser = int32Ptr(1)
+			f.redemptions = append(f.redemptions, &domain.DiscountCodeRedemption{ID: 1, UserID: 11})
+		}, false},
+		{"own referral code", func(f *fakeRedemptions) { f.code.ReferrerUserID = &referrer }, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakeRedemptions()
+			tt.setup(repo)
+			timesUsed, redemptions := repo.code.TimesUsed, repo.discount.Redemptions
+			service := &discountCodeRedemptionService{repo: repo}
+
+			orderID := int64(100)
+			_, err := service.RedeemCode(context.Background(), 1, 10, nil, &orderID)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("RedeemCode() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			wantUsed, wantRedemptions := timesUsed+1, redemptions+1
+			if tt.wantErr {
+				wantUsed, wantRedemptions = timesUsed, redemptions
+			}
+			if repo.code.TimesUsed != wantUsed || repo.discount.Redemptions != wantRedemptions {
+				t.Errorf("counters = %d, %d, want %d, %d", repo.code.TimesUsed, repo.discount.Redemptions, wantUsed, wantRedemptions)
+			}
+		})
+	}
+}
+
+func TestRedeemCodeLastRedemption(t *testing.T) {
+	repo := newFakeRedemptions()
+	repo.discount.MaxRedemptions = int32Ptr(1)
+	service := &discountCodeRedemptionService{repo: repo}
+	ctx := context.Background()
+
+	firstOrder, secondOrder := int64(100), int64(101)
+	first, err := service.RedeemCode(ctx, 1, 10, nil, &firstOrder)
+	if err != nil {
+		t.Fatalf("RedeemCode() error = %v", err)
+	}
+
+	// A retry for the same order gets the first redemption back
+	retried, err := service.RedeemCode(ctx, 1, 10, nil, &firstOrder)
+	if err != nil || retried.ID != first.ID {
+		t.Errorf("RedeemCode() retry = %v, %v, want redemption %d", retried, err, first.ID)
+	}
+
+	if _, err := service.RedeemCode(ctx, 1, 11, nil, &secondOrder); err == nil {
+		t.Error("RedeemCode() took a redemption past the discount limit")
+	}
+	if repo.discount.Redemptions != 1 || len(repo.redemptions) != 1 {
+		t.Errorf("redemptions = %d (%d rows), want 1", repo.discount.Redemptions, len(repo.redemptions))
+	}
+}
@@ -2,12 +2,33 @@ package service
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/csv"
 	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/damarteplok/damar-admin-cms/services/product-service/internal/domain"
 )
 
+const (
+	// defaultCodeAlphabet leaves out 0/O and 1/I so printed codes are easy to type
+	defaultCodeAlphabet  = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+	defaultCodeLength    = 10
+	minCodeLength        = 4
+	maxCodeLength        = 64
+	maxCodeLen           = 255 // discount_codes.code is VARCHAR(255)
+	maxGeneratedCodes    = 50000
+	codeBatchSize        = 1000
+	maxCollisionAttempts = 5
+)
+
 type discountCodeService struct {
 	repo         domain.DiscountCodeRepository
 	discountRepo domain.DiscountRepository
@@ -121,3 +142,189 @@ func (s *discountCodeService) ValidateCode(ctx context.Context, code string) (bo
 
 	return true, "", nil
 }
+
+func (s *discountCodeService) Generate(ctx context.Context, params domain.GenerateDiscountCodesParams, emit func([]*domain.DiscountCode) error) (int, error) {
+	if params.DiscountID <= 0 {
+		return 0, errors.New("discount ID is required")
+	}
+	if params.Count <= 0 {
+		return 0, errors.New("count must be greater than 0")
+	}
+	if params.Count > maxGeneratedCodes {
+		return 0, fmt.Errorf("count cannot exceed %d", maxGeneratedCodes)
+	}
+	if params.Length == 0 {
+		params.Length = defaultCodeLength
+	}
+	if params.Length < minCodeLength || params.Length > maxCodeLength {
+		return 0, fmt.Errorf("length must be between %d and %d", minCodeLength, maxCodeLength)
+	}
+	if utf8.RuneCountInString(params.Prefix)+params.Length > maxCodeLen {
+		return 0, fmt.Errorf("prefix and length cannot exceed %d characters", maxCodeLen)
+	}
+	if params.MaxUses != nil && *params.MaxUses <= 0 {
+		return 0, errors.New("max uses must be greater than 0")
+	}
+
+	alphabet, err := codeAlphabet(params.Alphabet)
+	if err != nil {
+		return 0, err
+	}
+
+	// Keep the code space at least 100x the requested count, otherwise the
+	// collision retries below stop converging.
+	space := float64(params.Length) * math.Log(float64(len(alphabet)))
+	if space < math.Log(float64(params.Count)*100) {
+		return 0, errors.New("alphabet and length are too small for the requested count")
+	}
+
+	if _, err := s.discountRepo.GetByID(ctx, params.DiscountID); err != nil {
+		return 0, errors.New("discount not found")
+	}
+
+	template := &domain.DiscountCode{
+		DiscountID: params.DiscountID,
+		MaxUses:    params.MaxUses,
+		IsActive:   true,
+	}
+
+	generated := 0
+	for generated < params.Count {
+		size := min(codeBatchSize, params.Count-generated)
+
+		batch := make([]*domain.DiscountCode, 0, size)
+		for attempt := 0; len(batch) < size; attempt++ {
+			if attempt == maxCollisionAttempts {
+				return generated, fmt.Errorf("could not generate unique codes after %d attempts", maxCollisionAttempts)
+			}
+
+			candidates, err := randomCodes(size-len(batch), params.Prefix, params.Length, alphabet)
+			if err != nil {
+				return generated, err
+			}
+
+			created, err := s.repo.CreateBatch(ctx, template, candidates)
+			if err != nil {
+				return generated, err
+			}
+			batch = append(batch, created...)
+		}
+
+		if err := emit(batch); err != nil {
+			return generated, err
+		}
+		generated += len(batch)
+	}
+
+	return generated, nil
+}
+
+func (s *discountCodeService) ExportCSV(ctx context.Context, discountID int64, w io.Writer) (int, error) {
+	if discountID <= 0 {
+		return 0, errors.New("invalid discount ID")
+	}
+
+	codes, err := s.repo.GetByDiscount(ctx, discountID)
+	if err != nil {
+		return 0, err
+	}
+
+	writer := csv.NewWriter(w)
+	header := []string{"code", "discount_id", "max_uses", "times_used", "is_active", "valid_from", "valid_until", "created_at"}
+	if err := writer.Write(header); err != nil {
+		return 0, fmt.Errorf("failed to write csv header: %w", err)
+	}
+
+	for _, code := range codes {
+		maxUses := ""
+		if code.MaxUses != nil {
+			maxUses = strconv.Itoa(int(*code.MaxUses))
+		}
+		record := []string{
+			csvText(code.Code),
+			strconv.FormatInt(code.DiscountID, 10),
+			maxUses,
+			strconv.Itoa(int(code.TimesUsed)),
+			strconv.FormatBool(code.IsActive),
+			csvTime(code.ValidFrom),
+			csvTime(code.ValidUntil),
+			csvTime(&code.CreatedAt),
+		}
+		if err := writer.Write(record); err != nil {
+			return 0, fmt.Errorf("failed to write csv record: %w", err)
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return 0, fmt.Errorf("failed to write csv: %w", err)
+	}
+
+	return len(codes), nil
+}
+
+// codeAlphabet returns the de-duplicated alphabet, or the default when empty
+func codeAlphabet(alphabet string) ([]rune, error) {
+	if alphabet == "" {
+		alphabet = defaultCodeAlphabet
+	}
+
+	seen := make(map[rune]bool)
+	runes := make([]rune, 0, len(alphabet))
+	for _, r := range alphabet {
+		if r == ',' || r == '"' || r <= ' ' {
+			return nil, errors.New("alphabet cannot contain whitespace, commas or quotes")
+		}
+		if !seen[r] {
+			seen[r] = true
+			runes = append(runes, r)
+		}
+	}
+	if len(runes) < 2 {
+		return nil, errors.New("alphabet must have at least 2 distinct characters")
+	}
+	return runes, nil
+}
+
+// randomCodes returns n distinct codes drawn from crypto/rand
+func randomCodes(n int, prefix string, length int, alphabet []rune) ([]string, error) {
+	base := big.NewInt(int64(len(alphabet)))
+	seen := make(map[string]bool, n)
+	codes := make([]string, 0, n)
+
+	var sb strings.Builder
+	for len(codes) < n {
+		sb.Reset()
+		sb.WriteString(prefix)
+		for i := 0; i < length; i++ {
+			idx, err := rand.Int(rand.Reader, base)
+			if err != nil {
+				return nil, fmt.Errorf("failed to generate code: %w", err)
+			}
+			sb.WriteRune(alphabet[idx.Int64()])
+		}
+
+		code := sb.String()
+		if !seen[code] {
+			seen[code] = true
+			codes = append(codes, code)
+		}
+	}
+	return codes, nil
+}
+
+// csvText quotes a value spreadsheets would read as a formula, so an
+// exported code like =HYPERLINK(...) stays text
+func csvText(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
+
+func csvTime(t *time.Time) string {
+	if t == nil || t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,173 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/damarteplok/damar-admin-cms/services/product-service/internal/domain"
+)
+
+// fakeDiscountCodes keeps codes by value; CreateBatch skips codes that
+// exist, like the unique index does
+type fakeDiscountCodes struct {
+	domain.DiscountCodeRepository
+	codes map[string]*domain.DiscountCode
+	list  []*domain.DiscountCode
+}
+
+func (f *fakeDiscountCodes) CreateBatch(ctx context.Context, template *domain.DiscountCode, codes []string) ([]*domain.DiscountCode, error) {
+	created := make([]*domain.DiscountCode, 0, len(codes))
+	for _, value := range codes {
+		if _, ok := f.codes[value]; ok {
+			continue
+		}
+		code := *template
+		code.ID = int64(len(f.codes) + 1)
+		code.Code = value
+		f.codes[value] = &code
+		created = append(created, &code)
+	}
+	return created, nil
+}
+
+func (f *fakeDiscountCodes) GetByDiscount(ctx context.Context, discountID int64) ([]*domain.DiscountCode, error) {
+	return f.list, nil
+}
+
+type fakeDiscounts struct {
+	domain.DiscountRepository
+}
+
+func (fakeDiscounts) GetByID(ctx context.Context, id int64) (*domain.Discount, error) {
+	return &domain.Discount{ID: id, IsActive: true}, nil
+}
+
+func TestCSVText(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{"SPRING24", "SPRING24"},
+		{"", ""},
+		{"=HYPERLINK(\"http://x\")", "'=HYPERLINK(\"http://x\")"},
+		{"+1", "'+1"},
+		{"-1", "'-1"},
+		{"@SUM(A1)", "'@SUM(A1)"},
+		{"\tTAB", "'\tTAB"},
+		{"\rCR", "'\rCR"},
+		{"A=B", "A=B"},
+	}
+
+	for _, tt := range tests {
+		if got := csvText(tt.value); got != tt.want {
+			t.Errorf("csvText(%q) = %q, want %q", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestExportCSV(t *testing.T) {
+	maxUses := int32(5)
+	createdAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	repo := &fakeDiscountCodes{list: []*domain.DiscountCode{
+		{DiscountID: 2, Code: "SPRING24", MaxUses: &maxUses, TimesUsed: 1, IsActive: true, CreatedAt: createdAt},
+		{DiscountID: 2, Code: "=cmd|'/c calc'!A1", CreatedAt: createdAt},
+	}}
+	service := &discountCodeService{repo: repo}
+
+	var buf bytes.Buffer
+	exported, err := service.ExportCSV(context.Background(), 2, &buf)
+	if err != nil || exported != 2 {
+		t.Fatalf("ExportCSV() = %d, %v, want 2, nil", exported, err)
+	}
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("exported CSV does not parse: %v", err)
+	}
+	if len(records) != 3 || records[0][0] != "code" {
+		t.Fatalf("records = %v, want a header and 2 codes", records)
+	}
+	want := []string{"SPRING24", "2", "5", "1", "true", "", "", "2026-03-01T12:00:00Z"}
+	if strings.Join(records[1], ",") != strings.Join(want, ",") {
+		t.Errorf("record = %v, want %v", records[1], want)
+	}
+	if records[2][0] != "'=cmd|'/c calc'!A1" || records[2][2] != "" {
+		t.Errorf("record = %v, want the formula quoted and no max uses", records[2])
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	repo := &fakeDiscountCodes{codes: make(map[string]*domain.DiscountCode)}
+	// A sixth of the code space is taken; those codes are skipped and
+	// replaced by new ones
+	for _, b := range "ABCDEF" {
+		for _, c := range "ABCDEF" {
+			for _, d := range "ABCDEF" {
+				taken := "PR-A" + string([]rune{b, c, d})
+				repo.codes[taken] = &domain.DiscountCode{Code: taken}
+			}
+		}
+	}
+	service := &discountCodeService{repo: repo, discountRepo: fakeDiscounts{}}
+
+	var emitted []*domain.DiscountCode
+	generated, err := service.Generate(context.Background(), domain.GenerateDiscountCodesParams{
+		DiscountID: 2,
+		Count:      10,
+		Prefix:     "PR-",
+		Length:     4,
+		Alphabet:   "ABCDEF",
+	}, func(batch []*domain.DiscountCode) error {
+		emitted = append(emitted, batch...)
+		return nil
+	})
+	if err != nil || generated != 10 {
+		t.Fatalf("Generate() = %d, %v, want 10, nil", generated, err)
+	}
+
+	seen := make(map[string]bool)
+	for _, code := range emitted {
+		if seen[code.Code] || !strings.HasPrefix(code.Code, "PR-") || strings.HasPrefix(code.Code, "PR-A") || len(code.Code) != 7 || strings.Trim(code.Code[3:], "ABCDEF") != "" {
+			t.Errorf("unexpected code %q", code.Code)
+		}
+		seen[code.Code] = true
+		if code.DiscountID != 2 || !code.IsActive {
+			t.Errorf("code %q: discount %d, active %v, want 2, true", code.Code, code.DiscountID, code.IsActive)
+		}
+	}
+	if len(emitted) != 10 {
+		t.Errorf("emitted %d codes, want 10", len(emitted))
+	}
+}
+
+func TestGenerateValidation(t *testing.T) {
+	tests := []struct {
+		name   string
+		params domain.GenerateDiscountCodesParams
+	}{
+		{"no count", domain.GenerateDiscountCodesParams{DiscountID: 2}},
+		{"too many", domain.GenerateDiscountCodesParams{DiscountID: 2, Count: maxGeneratedCodes + 1}},
+		{"too short", domain.GenerateDiscountCodesParams{DiscountID: 2, Count: 1, Length: minCodeLength - 1}},
+		{"alphabet with comma", domain.GenerateDiscountCodesParams{DiscountID: 2, Count: 1, Alphabet: "AB,"}},
+		{"code space too small", domain.GenerateDiscountCodesParams{DiscountID: 2, Count: 1000, Length: 4, Alphabet: "AB"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeDiscountCodes{codes: make(map[string]*domain.DiscountCode)}
+			service := &discountCodeService{repo: repo, discountRepo: fakeDiscounts{}}
+
+			_, err := service.Generate(context.Background(), tt.params, func([]*domain.DiscountCode) error { return nil })
+			if err == nil {
+				t.Error("Generate() error = nil, want a validation error")
+			}
+			if len(repo.codes) != 0 {
+				t.Errorf("created %d codes", len(repo.codes))
+			}
+		})
+	}
+}
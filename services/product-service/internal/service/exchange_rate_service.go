@@ -0,0 +1,244 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/damarteplok/damar-admin-cms/services/product-service/internal/domain"
+	"github.com/damarteplok/damar-admin-cms/shared/pricing"
+)
+
+const (
+	// maxImportRates bounds the rows of one exchange rate import
+	maxImportRates = 1000
+	// rateScale and maxRateDigits match exchange_rates.rate NUMERIC(24, 10)
+	rateScale     = 10
+	maxRateDigits = 14
+)
+
+type exchangeRateService struct {
+	repo         domain.ExchangeRateRepository
+	currencyRepo domain.CurrencyRepository
+	deriver      domain.PriceDerivationService
+}
+
+// NewExchangeRateService creates the exchange rate service. deriver is nil
+// unless derived plan prices are refreshed automatically whenever rates
+// change.
+func NewExchangeRateService(
+	repo domain.ExchangeRateRepository,
+	currencyRepo domain.CurrencyRepository,
+	deriver domain.PriceDerivationService,
+) domain.ExchangeRateService {
+	return &exchangeRateService{
+		repo:         repo,
+		currencyRepo: currencyRepo,
+		deriver:      deriver,
+	}
+}
+
+func (s *exchangeRateService) GetAll(ctx context.Context, baseCurrencyID, quoteCurrencyID int64, page, perPage int) ([]*domain.ExchangeRate, int, error) {
+	if page <= 0 {
+		page = 1
+	}
+	if perPage <= 0 {
+		perPage = 10
+	}
+	return s.repo.GetAll(ctx, baseCurrencyID, quoteCurrencyID, page, perPage)
+}
+
+func (s *exchangeRateService) SetRate(ctx context.Context, baseCurrencyID, quoteCurrencyID int64, rate string, effectiveAt *time.Time) (*domain.ExchangeRate, error) {
+	if baseCurrencyID <= 0 || quoteCurrencyID <= 0 {
+		return nil, errors.New("base and quote currency are required")
+	}
+	if baseCurrencyID == quoteCurrencyID {
+		return nil, errors.New("base and quote currency must differ")
+	}
+	if _, err := s.currencyRepo.GetByID(ctx, baseCurrencyID); err != nil {
+		return nil, errors.New("base currency not found")
+	}
+	if _, err := s.currencyRepo.GetByID(ctx, quoteCurrencyID); err != nil {
+		return nil, errors.New("quote currency not found")
+	}
+
+	normalized, err := normalizeRate(rate)
+	if err != nil {
+		return nil, err
+	}
+
+	exchangeRate := &domain.ExchangeRate{
+		BaseCurrencyID:  baseCurrencyID,
+		QuoteCurrencyID: quoteCurrencyID,
+		Rate:            normalized,
+		Source:          domain.ExchangeRateSourceManual,
+		EffectiveAt:     time.Now(),
+	}
+	if effectiveAt != nil {
+		exchangeRate.EffectiveAt = *effectiveAt
+	}
+
+	if err := s.repo.CreateBatch(ctx, []*domain.ExchangeRate{exchangeRate}); err != nil {
+		return nil, err
+	}
+
+	autoDeriveAll(ctx, s.deriver)
+	return exchangeRate, nil
+}
+
+func (s *exchangeRateService) ImportRates(ctx context.Context, data []byte) ([]*domain.ExchangeRate, error) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	currencies := make(map[string]int64)
+	resolve := func(code string) (int64, error) {
+		code = strings.ToUpper(strings.TrimSpace(code))
+		if id, ok := currencies[code]; ok {
+			return id, nil
+		}
+		currency, err := s.currencyRepo.GetByCode(ctx, code)
+		if err != nil {
+			return 0, fmt.Errorf("unknown currency %q", code)
+		}
+		currencies[code] = currency.ID
+		return currency.ID, nil
+	}
+
+	now := time.Now()
+	rates := make([]*domain.ExchangeRate, 0)
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		if line == 1 && strings.EqualFold(strings.TrimSpace(record[0]), "base") {
+			continue
+		}
+		if len(record) != 3 && len(record) != 4 {
+			return nil, fmt.Errorf("line %d: expected base,quote,rate[,effective_at]", line)
+		}
+		if len(rates) == maxImportRates {
+			return nil, fmt.Errorf("an import holds at most %d rates", maxImportRates)
+		}
+
+		baseID, err := resolve(record[0])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		quoteID, err := resolve(record[1])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		if baseID == quoteID {
+			return nil, fmt.Errorf("line %d: base and quote currency must differ", line)
+		}
+		rate, err := normalizeRate(record[2])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		effectiveAt := now
+		if len(record) == 4 && strings.TrimSpace(record[3]) != "" {
+			effectiveAt, err = parseEffectiveAt(record[3])
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", line, err)
+			}
+		}
+
+		rates = append(rates, &domain.ExchangeRate{
+			BaseCurrencyID:  baseID,
+			QuoteCurrencyID: quoteID,
+			Rate:            rate,
+			Source:          domain.ExchangeRateSourceImport,
+			EffectiveAt:     effectiveAt,
+		})
+	}
+
+	if len(rates) == 0 {
+		return nil, errors.New("the file holds no exchange rates")
+	}
+
+	if err := s.repo.CreateBatch(ctx, rates); err != nil {
+		return nil, err
+	}
+
+	autoDeriveAll(ctx, s.deriver)
+	return rates, nil
+}
+
+func (s *exchangeRateService) Delete(ctx context.Context, id int64) error {
+	if id <= 0 {
+		return errors.New("invalid exchange rate ID")
+	}
+	if err := s.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	autoDeriveAll(ctx, s.deriver)
+	return nil
+}
+
+// currentRate returns the rate converting base into quote now. Without a
+// direct rate it falls back to the inverse of the quote to base rate. nil
+// means no rate is known.
+func currentRate(ctx context.Context, repo domain.ExchangeRateRepository, baseCurrencyID, quoteCurrencyID int64) (*big.Rat, error) {
+	now := time.Now()
+
+	direct, err := repo.GetLatest(ctx, baseCurrencyID, quoteCurrencyID, now)
+	if err != nil {
+		return nil, err
+	}
+	if direct != nil {
+		return pricing.ParseRate(direct.Rate)
+	}
+
+	inverse, err := repo.GetLatest(ctx, quoteCurrencyID, baseCurrencyID, now)
+	if err != nil || inverse == nil {
+		return nil, err
+	}
+	rate, err := pricing.ParseRate(inverse.Rate)
+	if err != nil {
+		return nil, err
+	}
+	return rate.Inv(rate), nil
+}
+
+// normalizeRate validates a rate and rounds it to the stored scale
+func normalizeRate(s string) (string, error) {
+	rate, err := pricing.ParseRate(strings.TrimSpace(s))
+	if err != nil {
+		return "", err
+	}
+
+	normalized := rate.FloatString(rateScale)
+	if strings.Trim(normalized, "0.") == "" {
+		return "", fmt.Errorf("exchange rate is too small")
+	}
+	if len(strings.SplitN(normalized, ".", 2)[0]) > maxRateDigits {
+		return "", fmt.Errorf("exchange rate is too large")
+	}
+	normalized = strings.TrimRight(strings.TrimRight(normalized, "0"), ".")
+	return normalized, nil
+}
+
+// parseEffectiveAt accepts RFC 3339 timestamps and plain dates
+func parseEffectiveAt(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse("2006-01-02", s); err == nil {
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("invalid effective_at %q, use RFC 3339 or YYYY-MM-DD", s)
+}
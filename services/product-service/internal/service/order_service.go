@@ -0,0 +1,570 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/damarteplok/damar-admin-cms/services/product-service/internal/domain"
+	"github.com/damarteplok/damar-admin-cms/shared/amqp"
+	"github.com/damarteplok/damar-admin-cms/shared/contracts"
+	"github.com/damarteplok/damar-admin-cms/shared/logger"
+	"github.com/damarteplok/damar-admin-cms/shared/payment"
+	"github.com/damarteplok/damar-admin-cms/shared/pricing"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	maxOrderItems    = 100
+	maxOrderQuantity = 10000
+)
+
+type orderService struct {
+	repo               domain.OrderRepository
+	priceRepo          domain.ProductPriceRepository
+	productRepo        domain.ProductRepository
+	currencyRepo       domain.CurrencyRepository
+	providerRepo       domain.PaymentProviderRepository
+	discountRepo       domain.DiscountRepository
+	codeRepo           domain.DiscountCodeRepository
+	oneTimeProductRepo domain.DiscountOneTimeProductRepository
+	redemptionService  domain.DiscountCodeRedemptionService
+	providers          *payment.Registry
+	publisher          *amqp.Publisher
+}
+
+func NewOrderService(
+	repo domain.OrderRepository,
+	priceRepo domain.ProductPriceRepository,
+	productRepo domain.ProductRepository,
+	currencyRepo domain.CurrencyRepository,
+	providerRepo domain.PaymentProviderRepository,
+	discountRepo domain.DiscountRepository,
+	codeRepo domain.DiscountCodeRepository,
+	oneTimeProductRepo domain.DiscountOneTimeProductRepository,
+	redemptionService domain.DiscountCodeRedemptionService,
+	providers *payment.Registry,
+	publisher *amqp.Publisher,
+) domain.OrderService {
+	return &orderService{
+		repo:               repo,
+		priceRepo:          priceRepo,
+		productRepo:        productRepo,
+		currencyRepo:       currencyRepo,
+		providerRepo:       providerRepo,
+		discountRepo:       discountRepo,
+		codeRepo:           codeRepo,
+		oneTimeProductRepo: oneTimeProductRepo,
+		redemptionService:  redemptionService,
+		providers:          providers,
+		publisher:          publisher,
+	}
+}
+
+func (s *orderService) Create(ctx context.Context, params domain.CreateOrderParams) (*domain.Order, error) {
+	if params.UserID <= 0 {
+		return nil, errors.New("user ID is required")
+	}
+	if params.CurrencyID <= 0 {
+		return nil, errors.New("currency ID is required")
+	}
+	if len(params.Items) == 0 {
+		return nil, errors.New("order needs at least one item")
+	}
+	if len(params.Items) > maxOrderItems {
+		return nil, fmt.Errorf("order cannot have more than %d items", maxOrderItems)
+	}
+
+	currency, err := s.currencyRepo.GetByID(ctx, params.CurrencyID)
+	if err != nil {
+		return nil, errors.New("currency not found")
+	}
+
+	order := &domain.Order{
+		UUID:       uuid.New().String(),
+		UserID:     params.UserID,
+		TenantID:   params.TenantID,
+		CurrencyID: currency.ID,
+		Status:     domain.OrderStatusPending,
+	}
+	if err := s.buildItems(ctx, order, currency, params.Items); err != nil {
+		return nil, err
+	}
+
+	if code := strings.TrimSpace(params.DiscountCode); code != "" {
+		if err := s.applyDiscount(ctx, order, code, time.Now()); err != nil {
+			return nil, err
+		}
+	}
+	order.Total = order.Subtotal - order.DiscountTotal
+
+	// Nothing to collect once the discount covers the whole order
+	if order.Total <= 0 {
+		if err := s.store(ctx, order); err != nil {
+			return nil, err
+		}
+		return s.MarkPaid(ctx, order.ID)
+	}
+
+	// Resolve the provider before storing anything so a bad slug leaves no
+	// order behind
+	provider, implementation, err := s.resolveProvider(ctx, params.PaymentProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.store(ctx, order); err != nil {
+		return nil, err
+	}
+
+	session, err := implementation.CreateCheckoutSession(ctx, payment.CheckoutRequest{
+		Currency:   currency.Code,
+		Mode:       payment.CheckoutModePayment,
+		LineItems:  checkoutLineItems(order),
+		SuccessURL: params.SuccessURL,
+		CancelURL:  params.CancelURL,
+		Metadata: map[string]string{
+			"order_uuid": order.UUID,
+			"user_id":    strconv.FormatInt(order.UserID, 10),
+		},
+	})
+	if err != nil {
+		if cancelErr := s.transition(ctx, order, domain.OrderStatusCancelled); cancelErr != nil {
+			logger.Error("Failed to cancel order after checkout failure",
+				zap.Int64("order_id", order.ID),
+				zap.Error(cancelErr))
+		} else {
+			s.releaseDiscount(ctx, order)
+		}
+		return nil, fmt.Errorf("failed to create checkout session: %w", err)
+	}
+
+	order.PaymentProviderID = &provider.ID
+	order.PaymentProviderCheckoutID = &session.ID
+	if session.URL != "" {
+		order.CheckoutURL = &session.URL
+	}
+	if err := s.repo.SetCheckout(ctx, order); err != nil {
+		return nil, err
+	}
+
+	logger.Info("Opened order checkout",
+		zap.Int64("order_id", order.ID),
+		zap.String("provider", provider.Slug),
+		zap.String("checkout_id", session.ID),
+		zap.Int64("total", order.Total))
+
+	return order, nil
+}
+
+// store saves order and redeems its discount code under the code's row
+// locks, so every limit of the code holds even for concurrent orders. An
+// order whose code can no longer be redeemed is cancelled and not returned.
+func (s *orderService) store(ctx context.Context, order *domain.Order) error {
+	if err := s.repo.Create(ctx, order); err != nil {
+		return err
+	}
+
+	if order.DiscountCodeID != nil {
+		_, err := s.redemptionService.RedeemCode(ctx, *order.DiscountCodeID, order.UserID, nil, &order.ID)
+		if err != nil {
+			if cancelErr := s.transition(ctx, order, domain.OrderStatusCancelled); cancelErr != nil {
+				logger.Error("Failed to cancel order after redemption failure",
+					zap.Int64("order_id", order.ID),
+					zap.Error(cancelErr))
+			}
+			return err
+		}
+	}
+
+	s.publishEvent(ctx, contracts.OrderEventCreated, order)
+	return nil
+}
+
+// buildItems prices each product in the order currency. Repeated products
+// are merged into one item.
+func (s *orderService) buildItems(ctx context.Context, order *domain.Order, currency *domain.Currency, items []domain.OrderItemParams) error {
+	quantities := make(map[int64]int64, len(items))
+	productIDs := make([]int64, 0, len(items))
+	for _, item := range items {
+		if item.ProductID <= 0 {
+			return errors.New("invalid product ID")
+		}
+		if item.Quantity <= 0 {
+			return errors.New("quantity must be greater than 0")
+		}
+		if _, ok := quantities[item.ProductID]; !ok {
+			productIDs = append(productIDs, item.ProductID)
+		}
+		quantities[item.ProductID] += item.Quantity
+		if quantities[item.ProductID] > maxOrderQuantity {
+			return fmt.Errorf("quantity cannot exceed %d per product", maxOrderQuantity)
+		}
+	}
+
+	for _, productID := range productIDs {
+		product, err := s.productRepo.GetByID(ctx, productID)
+		if err != nil {
+			return fmt.Errorf("product %d not found", productID)
+		}
+		price, err := s.priceRepo.GetByProductAndCurrency(ctx, productID, currency.ID)
+		if err != nil {
+			return fmt.Errorf("product %s has no price in %s", product.Name, currency.Code)
+		}
+
+		id := product.ID
+		quantity := quantities[productID]
+		item := &domain.OrderItem{
+			ProductID:   &id,
+			Description: product.Name,
+			Quantity:    quantity,
+			UnitAmount:  price.Price,
+			Amount:      quantity * price.Price,
+		}
+		order.Items = append(order.Items, item)
+		order.Subtotal += item.Amount
+	}
+
+	return nil
+}
+
+// applyDiscount checks the code and takes the discount off the items its
+// discount covers, either every one-time product or those linked through
+// discount_one_time_product. The code is redeemed when the order is stored.
+func (s *orderService) applyDiscount(ctx context.Context, order *domain.Order, code string, now time.Time) error {
+	discountCode, err := s.codeRepo.GetByCode(ctx, code)
+	if err != nil {
+		return errors.New("discount code not found")
+	}
+	discount, err := s.discountRepo.GetByID(ctx, discountCode.DiscountID)
+	if err != nil {
+		return errors.New("discount not found")
+	}
+
+	if err := checkDiscountUsable(discount, discountCode, now); err != nil {
+		return err
+	}
+	if discountCode.ReferrerUserID != nil && *discountCode.ReferrerUserID == order.UserID {
+		return errors.New("cannot redeem your own referral code")
+	}
+
+	eligible := order.Items
+	if !discount.IsEnabledForAllOneTimeProducts {
+		links, err := s.oneTimeProductRepo.GetByDiscount(ctx, discount.ID)
+		if err != nil {
+			return err
+		}
+		linked := make(map[int64]bool, len(links))
+		for _, link := range links {
+			linked[link.ProductID] = true
+		}
+
+		eligible = nil
+		for _, item := range order.Items {
+			if linked[*item.ProductID] {
+				eligible = append(eligible, item)
+			}
+		}
+	}
+	if len(eligible) == 0 {
+		return errors.New("discount code does not apply to these products")
+	}
+
+	var eligibleSubtotal int64
+	for _, item := range eligible {
+		eligibleSubtotal += item.Amount
+	}
+	amount, err := pricing.DiscountAmount(discount.Type, discount.Amount, eligibleSubtotal)
+	if err != nil {
+		return err
+	}
+
+	allocateDiscount(eligible, eligibleSubtotal, amount)
+	order.DiscountCodeID = &discountCode.ID
+	order.DiscountTotal = amount
+	return nil
+}
+
+// allocateDiscount spreads amount over items in proportion to their amounts.
+// The last item takes the rounding remainder.
+func allocateDiscount(items []*domain.OrderItem, subtotal, amount int64) {
+	remaining := amount
+	for i, item := range items {
+		share := remaining
+		if i < len(items)-1 {
+			share = int64(math.Floor(float64(amount) * float64(item.Amount) / float64(subtotal)))
+		}
+		if share > item.Amount {
+			share = item.Amount
+		}
+		item.DiscountAmount = share
+		item.Amount -= share
+		remaining -= share
+	}
+}
+
+// checkoutLineItems sends undiscounted items at their unit price and
+// discounted items as a single line for their net amount, so the provider
+// charges exactly the order total
+func checkoutLineItems(order *domain.Order) []payment.CheckoutLineItem {
+	lines := make([]payment.CheckoutLineItem, 0, len(order.Items))
+	for _, item := range order.Items {
+		switch {
+		case item.Amount <= 0:
+			continue
+		case item.DiscountAmount == 0:
+			lines = append(lines, payment.CheckoutLineItem{
+				Description: item.Description,
+				Quantity:    item.Quantity,
+				UnitAmount:  item.UnitAmount,
+			})
+		default:
+			lines = append(lines, payment.CheckoutLineItem{
+				Description: fmt.Sprintf("%s x %d (discounted)", item.Description, item.Quantity),
+				Quantity:    1,
+				UnitAmount:  item.Amount,
+			})
+		}
+	}
+	return lines
+}
+
+// resolveProvider picks the requested provider, else manual
+func (s *orderService) resolveProvider(ctx context.Context, slug string) (*domain.PaymentProvider, payment.PaymentProvider, error) {
+	if s.providers == nil {
+		return nil, nil, errors.New("no payment providers configured")
+	}
+	if slug == "" {
+		slug = payment.SlugManual
+	}
+
+	provider, err := s.providerRepo.GetBySlug(ctx, slug)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !provider.IsActive {
+		return nil, nil, fmt.Errorf("payment provider %s is not active", provider.Slug)
+	}
+
+	implementation, err := s.providers.Get(provider.Slug)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return provider, implementation, nil
+}
+
+func (s *orderService) GetByID(ctx context.Context, id int64) (*domain.Order, error) {
+	if id <= 0 {
+		return nil, errors.New("invalid order ID")
+	}
+	return s.repo.GetByID(ctx, id)
+}
+
+func (s *orderService) GetByUser(ctx context.Context, userID int64, page, perPage int) ([]*domain.Order, int, error) {
+	if userID <= 0 {
+		return nil, 0, errors.New("invalid user ID")
+	}
+	if page <= 0 {
+		page = 1
+	}
+	if perPage <= 0 {
+		perPage = 10
+	}
+	return s.repo.GetByUser(ctx, userID, page, perPage)
+}
+
+func (s *orderService) GetAll(ctx context.Context, status string, page, perPage int) ([]*domain.Order, int, error) {
+	if status != "" && !domain.IsValidOrderStatus(status) {
+		return nil, 0, fmt.Errorf("invalid order status: %s", status)
+	}
+	if page <= 0 {
+		page = 1
+	}
+	if perPage <= 0 {
+		perPage = 10
+	}
+	return s.repo.GetAll(ctx, status, page, perPage)
+}
+
+func (s *orderService) MarkPaid(ctx context.Context, id int64) (*domain.Order, error) {
+	order, err := s.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if order.Status == domain.OrderStatusPaid {
+		return order, nil
+	}
+
+	if err := s.transition(ctx, order, domain.OrderStatusPaid); err != nil {
+		return nil, err
+	}
+
+	s.publishEvent(ctx, contracts.OrderEventPaid, order)
+	return order, nil
+}
+
+// releaseDiscount gives the discount code of a cancelled order back. The
+// order stays cancelled when this fails.
+func (s *orderService) releaseDiscount(ctx context.Context, order *domain.Order) {
+	if order.DiscountCodeID == nil {
+		return
+	}
+
+	if err := s.redemptionService.ReleaseOrderCode(ctx, *order.DiscountCodeID, order.ID); err != nil {
+		logger.Error("Failed to release discount code of cancelled order",
+			zap.Int64("order_id", order.ID),
+			zap.Int64("discount_code_id", *order.DiscountCodeID),
+			zap.Error(err))
+	}
+}
+
+func (s *orderService) MarkCheckoutPaid(ctx context.Context, providerSlug, checkoutID string) (*domain.Order, error) {
+	order, err := s.getByCheckout(ctx, providerSlug, checkoutID)
+	if err != nil || order == nil {
+		return nil, err
+	}
+	// The customer paid after the order was cancelled; the payment has to
+	// be refunded by hand
+	if order.Status == domain.OrderStatusCancelled {
+		logger.Error("Payment received for cancelled order",
+			zap.Int64("order_id", order.ID),
+			zap.String("provider", providerSlug),
+			zap.String("checkout_id", checkoutID))
+		return order, nil
+	}
+	return s.MarkPaid(ctx, order.ID)
+}
+
+func (s *orderService) CancelCheckout(ctx context.Context, providerSlug, checkoutID string) (*domain.Order, error) {
+	order, err := s.getByCheckout(ctx, providerSlug, checkoutID)
+	if err != nil || order == nil {
+		return nil, err
+	}
+	// A late cancellation never undoes a payment
+	if order.Status != domain.OrderStatusPending {
+		return order, nil
+	}
+	return s.Cancel(ctx, order.ID)
+}
+
+func (s *orderService) getByCheckout(ctx context.Context, providerSlug, checkoutID string) (*domain.Order, error) {
+	if providerSlug == "" || checkoutID == "" {
+		return nil, errors.New("provider and checkout ID are required")
+	}
+
+	provider, err := s.providerRepo.GetBySlug(ctx, providerSlug)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.GetByCheckout(ctx, provider.ID, checkoutID)
+}
+
+func (s *orderService) Cancel(ctx context.Context, id int64) (*domain.Order, error) {
+	order, err := s.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.transition(ctx, order, domain.OrderStatusCancelled); err != nil {
+		return nil, err
+	}
+
+	s.releaseDiscount(ctx, order)
+	s.publishEvent(ctx, contracts.OrderEventCancelled, order)
+	return order, nil
+}
+
+func (s *orderService) Refund(ctx context.Context, id int64) (*domain.Order, error) {
+	order, err := s.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.transition(ctx, order, domain.OrderStatusRefunded); err != nil {
+		return nil, err
+	}
+
+	s.publishEvent(ctx, contracts.OrderEventRefunded, order)
+	return order, nil
+}
+
+// transition moves order to status to and stamps the matching timestamp.
+// The update is guarded by the current status, so of two concurrent
+// transitions only one wins.
+func (s *orderService) transition(ctx context.Context, order *domain.Order, to string) error {
+	if err := domain.ValidateOrderTransition(order.Status, to); err != nil {
+		return err
+	}
+
+	from := order.Status
+	now := time.Now()
+	order.Status = to
+	switch to {
+	case domain.OrderStatusPaid:
+		order.PaidAt = &now
+	case domain.OrderStatusCancelled:
+		order.CancelledAt = &now
+	case domain.OrderStatusRefunded:
+		order.RefundedAt = &now
+	}
+
+	updated, err := s.repo.UpdateStatus(ctx, order, from)
+	if err != nil {
+		return err
+	}
+	if !updated {
+		return fmt.Errorf("order %d is no longer %s", order.ID, from)
+	}
+	return nil
+}
+
+func (s *orderService) publishEvent(ctx context.Context, routingKey string, order *domain.Order) {
+	if s.publisher == nil {
+		return
+	}
+
+	productIDs := make([]int64, 0, len(order.Items))
+	for _, item := range order.Items {
+		if item.ProductID != nil {
+			productIDs = append(productIDs, *item.ProductID)
+		}
+	}
+
+	eventData := map[string]interface{}{
+		"order_id":         order.ID,
+		"uuid":             order.UUID,
+		"user_id":          order.UserID,
+		"tenant_id":        order.TenantID,
+		"currency_id":      order.CurrencyID,
+		"status":           order.Status,
+		"subtotal":         order.Subtotal,
+		"discount_total":   order.DiscountTotal,
+		"total":            order.Total,
+		"discount_code_id": order.DiscountCodeID,
+		"product_ids":      productIDs,
+	}
+	dataBytes, _ := json.Marshal(eventData)
+	message := contracts.AmqpMessage{
+		OwnerID: fmt.Sprintf("%d", order.UserID),
+		Data:    dataBytes,
+	}
+
+	if err := s.publisher.Publish(ctx, routingKey, message); err != nil {
+		logger.Error("Failed to publish order event",
+			zap.String("routing_key", routingKey),
+			zap.Int64("order_id", order.ID),
+			zap.Error(err))
+	} else {
+		logger.Info("Published order event",
+			zap.String("routing_key", routingKey),
+			zap.Int64("order_id", order.ID),
+			zap.String("status", order.Status))
+	}
+}
@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/damarteplok/damar-admin-cms/services/product-service/internal/domain"
+)
+
+// fakeOrders updates an order only while its status is still the expected one
+type fakeOrders struct {
+	domain.OrderRepository
+	orders map[int64]*domain.Order
+}
+
+func (f *fakeOrders) GetByID(ctx context.Context, id int64) (*domain.Order, error) {
+	order := *f.orders[id]
+	return &order, nil
+}
+
+func (f *fakeOrders) UpdateStatus(ctx context.Context, order *domain.Order, from string) (bool, error) {
+	if f.orders[order.ID].Status != from {
+		return false, nil
+	}
+	saved := *order
+	f.orders[order.ID] = &saved
+	return true, nil
+}
+
+// newDiscountedOrder returns an order service holding one order that
+// redeemed the code of redemptions
+func newDiscountedOrder(t *testing.T, status string) (*orderService, *fakeOrders, *fakeRedemptions) {
+	redemptions := newFakeRedemptions()
+	redemptionService := &discountCodeRedemptionService{repo: redemptions}
+	orderID := int64(100)
+	if _, err := redemptionService.RedeemCode(context.Background(), redemptions.code.ID, 10, nil, &orderID); err != nil {
+		t.Fatalf("RedeemCode() error = %v", err)
+	}
+
+	orders := &fakeOrders{orders: map[int64]*domain.Order{
+		orderID: {ID: orderID, UserID: 10, Status: status, DiscountCodeID: &redemptions.code.ID},
+	}}
+	return &orderService{repo: orders, redemptionService: redemptionService}, orders, redemptions
+}
+
+func TestCancelOrderReleasesDiscount(t *testing.T) {
+	service, orders, redemptions := newDiscountedOrder(t, domain.OrderStatusPending)
+	ctx := context.Background()
+
+	cancelled, err := service.Cancel(ctx, 100)
+	if err != nil {
+		t.Fatalf("Cancel() error = %v", err)
+	}
+	if cancelled.Status != domain.OrderStatusCancelled || cancelled.CancelledAt == nil {
+		t.Errorf("status %s, cancelled at %v, want cancelled with a timestamp", cancelled.Status, cancelled.CancelledAt)
+	}
+	if len(redemptions.redemptions) != 0 || redemptions.code.TimesUsed != 0 || redemptions.discount.Redemptions != 0 {
+		t.Errorf("redemption not released: %d rows, counters %d, %d", len(redemptions.redemptions), redemptions.code.TimesUsed, redemptions.discount.Redemptions)
+	}
+
+	// Cancelling again is refused and gives nothing back twice
+	if _, err := service.Cancel(ctx, 100); err == nil {
+		t.Error("Cancel() of a cancelled order succeeded")
+	}
+	if redemptions.code.TimesUsed != 0 || orders.orders[100].Status != domain.OrderStatusCancelled {
+		t.Errorf("times used %d, status %s after the second cancel", redemptions.code.TimesUsed, orders.orders[100].Status)
+	}
+}
+
+func TestCancelPaidOrderKeepsDiscount(t *testing.T) {
+	service, orders, redemptions := newDiscountedOrder(t, domain.OrderStatusPaid)
+
+	if _, err := service.Cancel(context.Background(), 100); err == nil {
+		t.Error("Cancel() of a paid order succeeded")
+	}
+	if len(redemptions.redemptions) != 1 || redemptions.code.TimesUsed != 1 {
+		t.Errorf("redemption released for a paid order: %d rows, times used %d", len(redemptions.redemptions), redemptions.code.TimesUsed)
+	}
+	if orders.orders[100].Status != domain.OrderStatusPaid {
+		t.Errorf("status = %s, want paid", orders.orders[100].Status)
+	}
+}
+
+func TestAllocateDiscount(t *testing.T) {
+	tests := []struct {
+		name    string
+		amounts []int64
+		amount  int64
+		want    []int64
+	}{
+		{"proportional", []int64{600, 400}, 100, []int64{60, 40}},
+		{"remainder on the last item", []int64{100, 100, 100}, 100, []int64{33, 33, 34}},
+		{"whole order", []int64{250, 750}, 1000, []int64{250, 750}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			items := make([]*domain.OrderItem, len(tt.amounts))
+			var subtotal int64
+			for i, amount := range tt.amounts {
+				items[i] = &domain.OrderItem{Amount: amount}
+				subtotal += amount
+			}
+
+			allocateDiscount(items, subtotal, tt.amount)
+
+			var total int64
+			for i, item := range items {
+				if item.DiscountAmount != tt.want[i] || item.Amount != tt.amounts[i]-tt.want[i] {
+					t.Errorf("item %d: discount %d, amount %d, want %d, %d", i, item.DiscountAmount, item.Amount, tt.want[i], tt.amounts[i]-tt.want[i])
+				}
+				total += item.DiscountAmount
+			}
+			if total != tt.amount {
+				t.Errorf("allocated %d, want %d", total, tt.amount)
+			}
+		})
+	}
+}
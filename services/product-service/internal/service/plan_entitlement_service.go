@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/damarteplok/damar-admin-cms/services/product-service/internal/domain"
+	"github.com/damarteplok/damar-admin-cms/shared/entitlement"
+)
+
+type planEntitlementService struct {
+	repo      domain.PlanEntitlementRepository
+	planRepo  domain.PlanRepository
+	meterRepo domain.PlanMeterRepository
+}
+
+func NewPlanEntitlementService(
+	repo domain.PlanEntitlementRepository,
+	planRepo domain.PlanRepository,
+	meterRepo domain.PlanMeterRepository,
+) domain.PlanEntitlementService {
+	return &planEntitlementService{
+		repo:      repo,
+		planRepo:  planRepo,
+		meterRepo: meterRepo,
+	}
+}
+
+func (s *planEntitlementService) GetByPlan(ctx context.Context, planID int64) ([]*domain.PlanEntitlement, error) {
+	if planID <= 0 {
+		return nil, errors.New("invalid plan ID")
+	}
+	return s.repo.GetByPlan(ctx, planID)
+}
+
+func (s *planEntitlementService) Set(ctx context.Context, e *domain.PlanEntitlement) error {
+	if e == nil {
+		return errors.New("plan entitlement cannot be nil")
+	}
+	if e.PlanID <= 0 {
+		return errors.New("plan ID is required")
+	}
+
+	e.FeatureKey = strings.TrimSpace(e.FeatureKey)
+	if err := entitlement.ValidateKey(e.FeatureKey); err != nil {
+		return err
+	}
+	if !entitlement.IsValidType(e.Type) {
+		return errors.New("type must be one of boolean, limit, quota")
+	}
+
+	plan, err := s.planRepo.GetByID(ctx, e.PlanID)
+	if err != nil || plan == nil {
+		return errors.New("plan not found")
+	}
+
+	switch e.Type {
+	case entitlement.TypeBoolean:
+		e.LimitValue = nil
+		e.MeterID = nil
+	case entitlement.TypeLimit:
+		e.MeterID = nil
+	case entitlement.TypeQuota:
+		if e.MeterID == nil && plan.MeterID == nil {
+			return errors.New("quota needs a meter, and the plan has none")
+		}
+	}
+	if e.LimitValue != nil && *e.LimitValue < 0 {
+		return errors.New("limit cannot be negative")
+	}
+	if e.MeterID != nil {
+		if _, err := s.meterRepo.GetByID(ctx, *e.MeterID); err != nil {
+			return errors.New("plan meter not found")
+		}
+	}
+
+	return s.repo.Upsert(ctx, e)
+}
+
+func (s *planEntitlementService) Delete(ctx context.Context, id int64) error {
+	if id <= 0 {
+		return errors.New("invalid plan entitlement ID")
+	}
+	return s.repo.Delete(ctx, id)
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 
 	"github.com/damarteplok/damar-admin-cms/services/product-service/internal/domain"
+	"github.com/damarteplok/damar-admin-cms/shared/pricing"
 )
 
 type planMeterService struct {
@@ -29,6 +30,12 @@ func (s *planMeterService) Create(ctx context.Context, meter *domain.PlanMeter)
 	if meter.Name == "" {
 		return errors.New("meter name is required")
 	}
+	if meter.Aggregation == "" {
+		meter.Aggregation = pricing.AggregationSum
+	}
+	if !pricing.IsValidAggregation(meter.Aggregation) {
+		return errors.New("aggregation must be one of sum, max, last")
+	}
 	return s.repo.Create(ctx, meter)
 }
 
@@ -48,6 +55,14 @@ func (s *planMeterService) Update(ctx context.Context, meter *domain.PlanMeter)
 		return errors.New("plan meter not found")
 	}
 
+	// An empty aggregation keeps the current one
+	if meter.Aggregation == "" {
+		meter.Aggregation = existing.Aggregation
+	}
+	if !pricing.IsValidAggregation(meter.Aggregation) {
+		return errors.New("aggregation must be one of sum, max, last")
+	}
+
 	return s.repo.Update(ctx, meter)
 }
 
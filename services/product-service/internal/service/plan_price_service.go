@@ -5,17 +5,22 @@ import (
 	"errors"
 
 	"github.com/damarteplok/damar-admin-cms/services/product-service/internal/domain"
+	"github.com/damarteplok/damar-admin-cms/shared/pricing"
 )
 
 type planPriceService struct {
 	repo     domain.PlanPriceRepository
 	planRepo domain.PlanRepository
+	deriver  domain.PriceDerivationService
 }
 
-func NewPlanPriceService(repo domain.PlanPriceRepository, planRepo domain.PlanRepository) domain.PlanPriceService {
+// NewPlanPriceService creates the plan price service. deriver is nil unless
+// derived prices are refreshed automatically whenever a plan's prices change.
+func NewPlanPriceService(repo domain.PlanPriceRepository, planRepo domain.PlanRepository, deriver domain.PriceDerivationService) domain.PlanPriceService {
 	return &planPriceService{
 		repo:     repo,
 		planRepo: planRepo,
+		deriver:  deriver,
 	}
 }
 
@@ -61,7 +66,20 @@ func (s *planPriceService) Create(ctx context.Context, planPrice *domain.PlanPri
 		return errors.New("plan not found")
 	}
 
-	return s.repo.Create(ctx, planPrice)
+	if planPrice.Type == "" {
+		planPrice.Type = pricing.TypeFlatRate
+	}
+	if err := toPricingPrice(planPrice).Validate(); err != nil {
+		return err
+	}
+
+	planPrice.DerivedFromID = nil
+	if err := s.repo.Create(ctx, planPrice); err != nil {
+		return err
+	}
+
+	autoDerivePlan(ctx, s.deriver, planPrice.PlanID)
+	return nil
 }
 
 func (s *planPriceService) Update(ctx context.Context, planPrice *domain.PlanPrice) error {
@@ -80,7 +98,27 @@ func (s *planPriceService) Update(ctx context.Context, planPrice *domain.PlanPri
 		return errors.New("plan price not found")
 	}
 
-	return s.repo.Update(ctx, planPrice)
+	// The plan and currency of a price never change
+	planPrice.PlanID = existing.PlanID
+	planPrice.CurrencyID = existing.CurrencyID
+	if planPrice.Type == "" {
+		planPrice.Type = existing.Type
+	}
+	if planPrice.Type == pricing.TypeTiered && planPrice.Tiers == nil {
+		planPrice.Tiers = existing.Tiers
+	}
+	if err := toPricingPrice(planPrice).Validate(); err != nil {
+		return err
+	}
+
+	// A price edited by hand is no longer derived
+	planPrice.DerivedFromID = nil
+	if err := s.repo.Update(ctx, planPrice); err != nil {
+		return err
+	}
+
+	autoDerivePlan(ctx, s.deriver, planPrice.PlanID)
+	return nil
 }
 
 func (s *planPriceService) Delete(ctx context.Context, id int64) error {
@@ -96,5 +134,52 @@ func (s *planPriceService) Delete(ctx context.Context, id int64) error {
 		return errors.New("plan price not found")
 	}
 
-	return s.repo.Delete(ctx, id)
+	if err := s.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	autoDerivePlan(ctx, s.deriver, existing.PlanID)
+	return nil
+}
+
+func (s *planPriceService) Quote(ctx context.Context, id int64, quantity int64) (*domain.PriceQuote, error) {
+	if id <= 0 {
+		return nil, errors.New("invalid plan price ID")
+	}
+	if quantity < 0 {
+		return nil, errors.New("quantity cannot be negative")
+	}
+
+	planPrice, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if planPrice == nil {
+		return nil, errors.New("plan price not found")
+	}
+
+	quote, err := toPricingPrice(planPrice).Quote(quantity)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.PriceQuote{
+		PlanPrice: planPrice,
+		Quote:     quote,
+	}, nil
+}
+
+// toPricingPrice maps a plan price onto shared/pricing. A zero
+// price_per_unit falls back to price, as nothing sets it for flat prices.
+func toPricingPrice(planPrice *domain.PlanPrice) pricing.Price {
+	price := pricing.Price{
+		Type:  planPrice.Type,
+		Price: int64(planPrice.Price),
+		Tiers: planPrice.Tiers,
+	}
+	if planPrice.PricePerUnit != 0 {
+		pricePerUnit := int64(planPrice.PricePerUnit)
+		price.PricePerUnit = &pricePerUnit
+	}
+	return price
 }
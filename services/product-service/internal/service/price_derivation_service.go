@@ -0,0 +1,265 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+
+	"github.com/damarteplok/damar-admin-cms/services/product-service/internal/domain"
+	"github.com/damarteplok/damar-admin-cms/shared/logger"
+	"github.com/damarteplok/damar-admin-cms/shared/pricing"
+	"go.uber.org/zap"
+)
+
+type priceDerivationService struct {
+	planPriceRepo    domain.PlanPriceRepository
+	currencyRepo     domain.CurrencyRepository
+	rateRepo         domain.ExchangeRateRepository
+	baseCurrencyCode string
+}
+
+// NewPriceDerivationService creates the price derivation service. Plan
+// prices in baseCurrencyCode are the source every other currency is
+// converted from.
+func NewPriceDerivationService(
+	planPriceRepo domain.PlanPriceRepository,
+	currencyRepo domain.CurrencyRepository,
+	rateRepo domain.ExchangeRateRepository,
+	baseCurrencyCode string,
+) domain.PriceDerivationService {
+	return &priceDerivationService{
+		planPriceRepo:    planPriceRepo,
+		currencyRepo:     currencyRepo,
+		rateRepo:         rateRepo,
+		baseCurrencyCode: strings.ToUpper(strings.TrimSpace(baseCurrencyCode)),
+	}
+}
+
+func (s *priceDerivationService) BaseCurrency(ctx context.Context) (*domain.Currency, error) {
+	if s.baseCurrencyCode == "" {
+		return nil, errors.New("base currency is not configured")
+	}
+
+	currency, err := s.currencyRepo.GetByCode(ctx, s.baseCurrencyCode)
+	if err != nil {
+		return nil, fmt.Errorf("base currency %s not found", s.baseCurrencyCode)
+	}
+	return currency, nil
+}
+
+func (s *priceDerivationService) DerivePlan(ctx context.Context, planID int64) (*domain.PriceDerivation, error) {
+	if planID <= 0 {
+		return nil, errors.New("invalid plan ID")
+	}
+
+	base, err := s.BaseCurrency(ctx)
+	if err != nil {
+		return nil, err
+	}
+	currencies, err := s.currencyRepo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.derive(ctx, planID, base, currencies)
+}
+
+func (s *priceDerivationService) DeriveAll(ctx context.Context) (int, error) {
+	base, err := s.BaseCurrency(ctx)
+	if err != nil {
+		return 0, err
+	}
+	currencies, err := s.currencyRepo.GetAll(ctx)
+	if err != nil {
+		return 0, err
+	}
+	basePrices, err := s.planPriceRepo.GetManualByCurrency(ctx, base.ID)
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	seen := make(map[int64]bool)
+	for _, basePrice := range basePrices {
+		if seen[basePrice.PlanID] {
+			continue
+		}
+		seen[basePrice.PlanID] = true
+
+		derivation, err := s.derive(ctx, basePrice.PlanID, base, currencies)
+		if err != nil {
+			// One bad plan must not block the rest
+			logger.Warn("Failed to derive plan prices",
+				zap.Int64("plan_id", basePrice.PlanID),
+				zap.Error(err))
+			continue
+		}
+		count += len(derivation.Derived)
+	}
+
+	return count, nil
+}
+
+// derive converts the plan's newest manual base price into every other
+// currency that has no manual price, creating or refreshing derived prices
+func (s *priceDerivationService) derive(ctx context.Context, planID int64, base *domain.Currency, currencies []*domain.Currency) (*domain.PriceDerivation, error) {
+	prices, err := s.planPriceRepo.GetByPlan(ctx, planID)
+	if err != nil {
+		return nil, err
+	}
+
+	derivation := &domain.PriceDerivation{
+		Derived: make([]*domain.PlanPrice, 0),
+		Skipped: make([]string, 0),
+	}
+	byCurrency := make(map[int64][]*domain.PlanPrice)
+	for _, price := range prices {
+		if derivation.BasePrice == nil && price.CurrencyID == base.ID && price.DerivedFromID == nil {
+			derivation.BasePrice = price
+		}
+		byCurrency[price.CurrencyID] = append(byCurrency[price.CurrencyID], price)
+	}
+	if derivation.BasePrice == nil {
+		return nil, domain.ErrNoBasePrice
+	}
+
+	for _, currency := range currencies {
+		if currency.ID == base.ID {
+			continue
+		}
+
+		var target *domain.PlanPrice
+		manual := false
+		for _, existing := range byCurrency[currency.ID] {
+			if existing.DerivedFromID == nil {
+				manual = true
+			} else if target == nil {
+				target = existing
+			}
+		}
+		if manual {
+			derivation.Skipped = append(derivation.Skipped, currency.Code)
+			continue
+		}
+
+		rate, err := currentRate(ctx, s.rateRepo, base.ID, currency.ID)
+		if err != nil {
+			return nil, err
+		}
+		if rate == nil {
+			derivation.Skipped = append(derivation.Skipped, currency.Code)
+			continue
+		}
+
+		price, err := convertPlanPrice(derivation.BasePrice, base, currency, rate)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", currency.Code, err)
+		}
+
+		if target != nil {
+			price.ID = target.ID
+			err = s.planPriceRepo.Update(ctx, price)
+		} else {
+			err = s.planPriceRepo.Create(ctx, price)
+		}
+		if err != nil {
+			return nil, err
+		}
+		derivation.Derived = append(derivation.Derived, price)
+	}
+
+	return derivation, nil
+}
+
+// convertPlanPrice converts every amount of basePrice into currency
+func convertPlanPrice(basePrice *domain.PlanPrice, base, currency *domain.Currency, rate *big.Rat) (*domain.PlanPrice, error) {
+	convert := func(amount int64) (int64, error) {
+		return pricing.Convert(amount, base.Rounding(), currency.Rounding(), rate)
+	}
+
+	price := &domain.PlanPrice{
+		PlanID:        basePrice.PlanID,
+		CurrencyID:    currency.ID,
+		Type:          basePrice.Type,
+		DerivedFromID: &basePrice.ID,
+	}
+
+	amount, err := convert(int64(basePrice.Price))
+	if err != nil {
+		return nil, err
+	}
+	if price.Price, err = toInt32Amount(amount); err != nil {
+		return nil, err
+	}
+
+	amount, err = convert(int64(basePrice.PricePerUnit))
+	if err != nil {
+		return nil, err
+	}
+	if price.PricePerUnit, err = toInt32Amount(amount); err != nil {
+		return nil, err
+	}
+
+	if basePrice.Tiers != nil {
+		price.Tiers = &pricing.Tiers{
+			Mode:  basePrice.Tiers.Mode,
+			Tiers: make([]pricing.Tier, len(basePrice.Tiers.Tiers)),
+		}
+		for i, tier := range basePrice.Tiers.Tiers {
+			converted := pricing.Tier{UpTo: tier.UpTo}
+			if converted.UnitPrice, err = convert(tier.UnitPrice); err != nil {
+				return nil, err
+			}
+			if converted.FlatPrice, err = convert(tier.FlatPrice); err != nil {
+				return nil, err
+			}
+			price.Tiers.Tiers[i] = converted
+		}
+	}
+
+	if err := toPricingPrice(price).Validate(); err != nil {
+		return nil, err
+	}
+	return price, nil
+}
+
+// toInt32Amount guards plan_prices.price and price_per_unit, which are
+// INTEGER columns
+func toInt32Amount(amount int64) (int32, error) {
+	if amount > math.MaxInt32 {
+		return 0, errors.New("converted price is too large for a plan price")
+	}
+	return int32(amount), nil
+}
+
+// autoDerivePlan re-derives a plan after one of its prices changed. Plans
+// without a base price are left alone.
+func autoDerivePlan(ctx context.Context, deriver domain.PriceDerivationService, planID int64) {
+	if deriver == nil {
+		return
+	}
+
+	if _, err := deriver.DerivePlan(ctx, planID); err != nil && !errors.Is(err, domain.ErrNoBasePrice) {
+		logger.Warn("Failed to derive plan prices",
+			zap.Int64("plan_id", planID),
+			zap.Error(err))
+	}
+}
+
+// autoDeriveAll re-derives every plan after exchange rates or rounding rules
+// changed
+func autoDeriveAll(ctx context.Context, deriver domain.PriceDerivationService) {
+	if deriver == nil {
+		return
+	}
+
+	count, err := deriver.DeriveAll(ctx)
+	if err != nil {
+		logger.Warn("Failed to derive plan prices", zap.Error(err))
+		return
+	}
+	logger.Info("Derived plan prices", zap.Int("count", count))
+}
@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/damarteplok/damar-admin-cms/services/product-service/internal/domain"
+	"github.com/damarteplok/damar-admin-cms/shared/amqp"
+	"github.com/damarteplok/damar-admin-cms/shared/contracts"
+	"github.com/damarteplok/damar-admin-cms/shared/logger"
+	"go.uber.org/zap"
+)
+
+type productPriceService struct {
+	repo         domain.ProductPriceRepository
+	productRepo  domain.ProductRepository
+	currencyRepo domain.CurrencyRepository
+	publisher    *amqp.Publisher
+}
+
+func NewProductPriceService(
+	repo domain.ProductPriceRepository,
+	productRepo domain.ProductRepository,
+	currencyRepo domain.CurrencyRepository,
+	publisher *amqp.Publisher,
+) domain.ProductPriceService {
+	return &productPriceService{
+		repo:         repo,
+		productRepo:  productRepo,
+		currencyRepo: currencyRepo,
+		publisher:    publisher,
+	}
+}
+
+func (s *productPriceService) GetByProduct(ctx context.Context, productID int64) ([]*domain.ProductPrice, error) {
+	if productID <= 0 {
+		return nil, errors.New("invalid product ID")
+	}
+	return s.repo.GetByProduct(ctx, productID)
+}
+
+func (s *productPriceService) SetPrice(ctx context.Context, productID, currencyID, price int64) (*domain.ProductPrice, error) {
+	if productID <= 0 {
+		return nil, errors.New("product ID is required")
+	}
+	if currencyID <= 0 {
+		return nil, errors.New("currency ID is required")
+	}
+	if price < 0 {
+		return nil, errors.New("price cannot be negative")
+	}
+
+	if _, err := s.productRepo.GetByID(ctx, productID); err != nil {
+		return nil, errors.New("product not found")
+	}
+	if _, err := s.currencyRepo.GetByID(ctx, currencyID); err != nil {
+		return nil, errors.New("currency not found")
+	}
+
+	productPrice := &domain.ProductPrice{
+		ProductID:  productID,
+		CurrencyID: currencyID,
+		Price:      price,
+	}
+	if err := s.repo.Upsert(ctx, productPrice); err != nil {
+		return nil, err
+	}
+
+	s.publishPriceChanged(ctx, productPrice)
+	return productPrice, nil
+}
+
+func (s *productPriceService) Delete(ctx context.Context, id int64) error {
+	if id <= 0 {
+		return errors.New("invalid product price ID")
+	}
+	return s.repo.Delete(ctx, id)
+}
+
+func (s *productPriceService) publishPriceChanged(ctx context.Context, price *domain.ProductPrice) {
+	if s.publisher == nil {
+		return
+	}
+
+	eventData := map[string]interface{}{
+		"product_price_id": price.ID,
+		"product_id":       price.ProductID,
+		"currency_id":      price.CurrencyID,
+		"price":            price.Price,
+	}
+	dataBytes, _ := json.Marshal(eventData)
+	message := contracts.AmqpMessage{
+		OwnerID: fmt.Sprintf("%d", price.ProductID),
+		Data:    dataBytes,
+	}
+
+	if err := s.publisher.Publish(ctx, contracts.ProductEventPriceChanged, message); err != nil {
+		logger.Error("Failed to publish product.price_changed event",
+			zap.Int64("product_id", price.ProductID),
+			zap.Error(err))
+	} else {
+		logger.Info("Published product.price_changed event",
+			zap.Int64("product_id", price.ProductID),
+			zap.Int64("currency_id", price.CurrencyID))
+	}
+}
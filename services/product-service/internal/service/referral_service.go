@@ -0,0 +1,177 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/damarteplok/damar-admin-cms/services/product-service/internal/domain"
+	"github.com/damarteplok/damar-admin-cms/shared/amqp"
+	"github.com/damarteplok/damar-admin-cms/shared/contracts"
+	"github.com/damarteplok/damar-admin-cms/shared/logger"
+	"github.com/damarteplok/damar-admin-cms/shared/util"
+	"go.uber.org/zap"
+)
+
+const (
+	referralCodePrefix = "REF-"
+	referralCodeLength = 8
+)
+
+type referralService struct {
+	repo            domain.ReferralRepository
+	codeRepo        domain.DiscountCodeRepository
+	discountRepo    domain.DiscountRepository
+	publisher       *amqp.Publisher
+	discountID      int64
+	rewardBonusDays int32
+}
+
+// NewReferralService creates the referral service. Personal codes are created
+// on discountID, which decides what the referred user gets. The referrer is
+// credited rewardBonusDays for every referral that converts.
+func NewReferralService(
+	repo domain.ReferralRepository,
+	codeRepo domain.DiscountCodeRepository,
+	discountRepo domain.DiscountRepository,
+	publisher *amqp.Publisher,
+	discountID int64,
+	rewardBonusDays int32,
+) domain.ReferralService {
+	return &referralService{
+		repo:            repo,
+		codeRepo:        codeRepo,
+		discountRepo:    discountRepo,
+		publisher:       publisher,
+		discountID:      discountID,
+		rewardBonusDays: rewardBonusDays,
+	}
+}
+
+func (s *referralService) GetOrCreateCode(ctx context.Context, referrerUserID int64) (*domain.DiscountCode, error) {
+	if referrerUserID <= 0 {
+		return nil, errors.New("invalid user ID")
+	}
+	if s.discountID <= 0 {
+		return nil, errors.New("referral program is not configured")
+	}
+
+	if code, err := s.codeRepo.GetByReferrer(ctx, referrerUserID, s.discountID); err == nil {
+		return code, nil
+	}
+
+	if _, err := s.discountRepo.GetByID(ctx, s.discountID); err != nil {
+		return nil, errors.New("referral discount not found")
+	}
+
+	alphabet := []rune(defaultCodeAlphabet)
+	for attempt := 0; attempt < maxCollisionAttempts; attempt++ {
+		candidates, err := randomCodes(1, referralCodePrefix, referralCodeLength, alphabet)
+		if err != nil {
+			return nil, err
+		}
+
+		code := &domain.DiscountCode{
+			DiscountID:     s.discountID,
+			Code:           candidates[0],
+			IsActive:       true,
+			ReferrerUserID: &referrerUserID,
+		}
+		if err := s.codeRepo.Create(ctx, code); err == nil {
+			return code, nil
+		}
+
+		// Either the code collided or a concurrent request already created
+		// the user's code
+		if existing, err := s.codeRepo.GetByReferrer(ctx, referrerUserID, s.discountID); err == nil {
+			return existing, nil
+		}
+	}
+
+	return nil, fmt.Errorf("could not create referral code after %d attempts", maxCollisionAttempts)
+}
+
+func (s *referralService) GetByReferrer(ctx context.Context, referrerUserID int64, page, perPage int) ([]*domain.Referral, int, error) {
+	if referrerUserID <= 0 {
+		return nil, 0, errors.New("invalid user ID")
+	}
+	if page <= 0 {
+		page = 1
+	}
+	if perPage <= 0 {
+		perPage = 10
+	}
+	return s.repo.GetByReferrer(ctx, referrerUserID, page, perPage)
+}
+
+func (s *referralService) GetStats(ctx context.Context, referrerUserID int64) (*domain.ReferralStats, error) {
+	if referrerUserID <= 0 {
+		return nil, errors.New("invalid user ID")
+	}
+
+	stats, err := s.repo.GetStats(ctx, referrerUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.discountID > 0 {
+		if code, err := s.codeRepo.GetByReferrer(ctx, referrerUserID, s.discountID); err == nil {
+			stats.Code = code
+		}
+	}
+
+	return stats, nil
+}
+
+func (s *referralService) ConvertOnPayment(ctx context.Context, referredUserID int64, subscriptionID *int64) (*domain.Referral, error) {
+	if referredUserID <= 0 {
+		return nil, errors.New("invalid user ID")
+	}
+
+	referral, err := s.repo.Convert(ctx, referredUserID, subscriptionID, s.rewardBonusDays, time.Now())
+	if err != nil || referral == nil {
+		return nil, err
+	}
+
+	s.publishConverted(ctx, referral)
+	return referral, nil
+}
+
+func (s *referralService) publishConverted(ctx context.Context, referral *domain.Referral) {
+	if s.publisher == nil {
+		return
+	}
+
+	var subscriptionID int64
+	if referral.SubscriptionID != nil {
+		subscriptionID = *referral.SubscriptionID
+	}
+
+	eventData := map[string]interface{}{
+		"referral_id":       referral.ID,
+		"referrer_user_id":  referral.ReferrerUserID,
+		"referred_user_id":  referral.ReferredUserID,
+		"subscription_id":   subscriptionID,
+		"discount_code_id":  referral.DiscountCodeID,
+		"reward_bonus_days": referral.RewardBonusDays,
+		"converted_at":      util.TimeToUnix(referral.ConvertedAt),
+	}
+	dataBytes, _ := json.Marshal(eventData)
+	message := contracts.AmqpMessage{
+		OwnerID: fmt.Sprintf("%d", referral.ReferrerUserID),
+		Data:    dataBytes,
+	}
+
+	if err := s.publisher.Publish(ctx, contracts.ReferralEventConverted, message); err != nil {
+		logger.Error("Failed to publish referral.converted event",
+			zap.Int64("referral_id", referral.ID),
+			zap.Error(err))
+	} else {
+		logger.Info("Published referral.converted event",
+			zap.Int64("referral_id", referral.ID),
+			zap.Int64("referrer_user_id", referral.ReferrerUserID),
+			zap.Int32("reward_bonus_days", referral.RewardBonusDays))
+	}
+}
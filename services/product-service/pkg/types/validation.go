@@ -43,10 +43,12 @@ type UpdatePlanPriceValidation struct {
 
 // Plan Meter validation
 type CreatePlanMeterValidation struct {
-	Name string `validate:"required,min=3,max=255"`
+	Name        string `validate:"required,min=3,max=255"`
+	Aggregation string `validate:"omitempty,oneof=sum max last"`
 }
 
 type UpdatePlanMeterValidation struct {
-	ID   int64  `validate:"required,min=1"`
-	Name string `validate:"required,min=3,max=255"`
+	ID          int64  `validate:"required,min=1"`
+	Name        string `validate:"required,min=3,max=255"`
+	Aggregation string `validate:"omitempty,oneof=sum max last"`
 }
@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/damarteplok/damar-admin-cms/shared/entitlement"
+)
+
+// PlanEntitlement is a product-service plan entitlement. LimitValue nil is
+// unlimited; MeterID nil on a quota means the plan's meter.
+type PlanEntitlement struct {
+	ID         int64
+	PlanID     int64
+	FeatureKey string
+	Type       string // boolean, limit, quota
+	IsEnabled  bool
+	LimitValue *int64 // nullable
+	MeterID    *int64 // nullable
+}
+
+// EntitlementCheck is the answer to whether a tenant may use a feature.
+// Reason explains a denial.
+type EntitlementCheck struct {
+	TenantID     int64
+	FeatureKey   string
+	Type         string        // empty when the plan does not include the feature
+	Subscription *Subscription // nil when the tenant has no active subscription
+	Reason       string
+	entitlement.Result
+}
+
+type EntitlementService interface {
+	// Check resolves the tenant's active subscription and evaluates the
+	// feature on its plan. usage is the caller's current count for limits;
+	// quotas read the meter's usage in the current billing period.
+	Check(ctx context.Context, tenantID int64, featureKey string, usage int64) (*EntitlementCheck, error)
+}
@@ -0,0 +1,18 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type Interval struct {
+	ID        int64
+	Name      string
+	Slug      string // daily, weekly, monthly, yearly (see shared/pricing)
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type IntervalRepository interface {
+	GetByID(ctx context.Context, id int64) (*Interval, error)
+}
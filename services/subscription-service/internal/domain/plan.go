@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// Plan change modes accepted by ChangePlan
+const (
+	PlanChangeModeNow       = "now"
+	PlanChangeModePeriodEnd = "period_end"
+)
+
+// Plan is the subset of a product-service plan that subscriptions need
+type Plan struct {
+	ID            int64
+	Name          string
+	IntervalID    int64
+	IntervalCount int32
+	MeterID       *int64 // nullable, set for metered plans
+	IsActive      bool
+	// MaxUsersPerTenant is the seat limit of flat and tiered prices; 0 is
+	// unlimited
+	MaxUsersPerTenant int32
+}
+
+// PlanPrice is a plan's price in one currency
+type PlanPrice struct {
+	ID           int64
+	PlanID       int64
+	CurrencyID   int64
+	Price        int32
+	PricePerUnit *int32          // nullable
+	Type         string          // flat_rate, per_unit, tiered
+	Tiers        json.RawMessage // nullable JSON
+}
+
+// Proration is the money side of a plan change, in the currency's minor unit.
+// Periods are invoiced once they end, so billing-service invoices an
+// immediate change as the period it falls in: UsedAmount of the old plan
+// from PreviousPeriodStart and ChargeAmount of the new one from EffectiveAt.
+type Proration struct {
+	CreditAmount   int64
+	ChargeAmount   int64
+	NetAmount      int64
+	RemainingRatio float64
+	Mode           string
+	EffectiveAt    time.Time
+	// UsedAmount is the old plan's price for the part of the period used
+	// before the change, the counterpart of CreditAmount
+	UsedAmount int64
+	// PreviousPeriodStart is the start of the period the change interrupted;
+	// nil when no paid period was running
+	PreviousPeriodStart *time.Time
+	// PeriodStart and PeriodEnd bound the subscription's period once the
+	// change is applied
+	PeriodStart *time.Time
+	PeriodEnd   *time.Time
+}
+
+// PlanRepository reads plans and plan_prices, which are owned by product-service
+type PlanRepository interface {
+	GetByID(ctx context.Context, id int64) (*Plan, error)
+	GetPrice(ctx context.Context, planID, currencyID int64) (*PlanPrice, error)
+	// GetEntitlement returns the plan's entitlement for the feature key, or
+	// nil if the plan has none
+	GetEntitlement(ctx context.Context, planID int64, featureKey string) (*PlanEntitlement, error)
+}
@@ -0,0 +1,127 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// ErrBonusAlreadyGranted is returned when a bonus days grant with the same key
+// has already been applied
+var ErrBonusAlreadyGranted = errors.New("bonus days have already been granted")
+
+const (
+	SubscriptionStatusActive    = "active"
+	SubscriptionStatusTrialing  = "trialing"
+	SubscriptionStatusPastDue   = "past_due"
+	SubscriptionStatusPaused    = "paused"
+	SubscriptionStatusCancelled = "cancelled"
+	SubscriptionStatusExpired   = "expired"
+)
+
+// Kinds of due work picked up by the subscription scheduler
+const (
+	DueTrialEnd = "trial_end"
+	DueExpiry   = "expiry"
+	DueRenewal  = "renewal"
+)
+
+type Subscription struct {
+	ID                            int64
+	UUID                          string
+	UserID                        int64
+	PlanID                        int64
+	Price                         int32
+	CurrencyID                    int64
+	EndsAt                        *time.Time // nullable
+	CancelledAt                   *time.Time // nullable
+	PaymentProviderSubscriptionID *string    // nullable
+	PaymentProviderStatus         *string    // nullable
+	PaymentProviderID             *int64     // nullable
+	TrialEndsAt                   *time.Time // nullable
+	Status                        string     // see subscription_state.go for allowed transitions
+	IntervalID                    int64
+	IntervalCount                 int32
+	IsCanceledAtEndOfCycle        bool
+	CancellationReason            *string // nullable
+	CancellationAdditionalInfo    *string // nullable
+	Quantity                      int32
+	TenantID                      int64
+	PriceType                     string          // flat_rate, per_unit, tiered
+	PriceTiers                    json.RawMessage // nullable JSON
+	PricePerUnit                  *string         // nullable
+	ExtraPaymentProviderData      json.RawMessage // nullable JSON
+	Type                          string          // payment_provider_managed, manual
+	Comments                      *string         // nullable
+	Metadata                      json.RawMessage // nullable JSON
+	ScheduledPlanID               *int64          // nullable, applied at the next renewal
+	ScheduledQuantity             *int32          // nullable
+	BillingAnchor                 *time.Time      // nullable, the period boundary period ends are counted from
+	CreatedAt                     time.Time
+	UpdatedAt                     time.Time
+}
+
+// SubscriptionChange is applied to a locked subscription. It returns the
+// reason of the subscription_versions entry that is written with the change.
+type SubscriptionChange func(subscription *Subscription) (string, error)
+
+type SubscriptionRepository interface {
+	GetByID(ctx context.Context, id int64) (*Subscription, error)
+	GetByUUID(ctx context.Context, uuid string) (*Subscription, error)
+	// GetByUser lists the user's subscriptions in tenantID, or in every
+	// tenant when tenantID is 0
+	GetByUser(ctx context.Context, userID, tenantID int64, page, perPage int) ([]*Subscription, int, error)
+	GetByTenant(ctx context.Context, tenantID int64, page, perPage int) ([]*Subscription, int, error)
+	// GetActiveByTenant returns the tenant's newest subscription that grants
+	// access at now, or nil if there is none
+	GetActiveByTenant(ctx context.Context, tenantID int64, now time.Time) (*Subscription, error)
+	// Create inserts the subscription with a "created" version
+	Create(ctx context.Context, subscription *Subscription) error
+	// Update locks the subscription with SELECT ... FOR UPDATE, passes it to
+	// fn and saves the result with its version in the same transaction, so a
+	// concurrent change or scheduler run is never overwritten and no change
+	// goes unrecorded. An error from fn leaves the row unchanged and is
+	// returned as is.
+	Update(ctx context.Context, id int64, fn SubscriptionChange) (*Subscription, error)
+	// GrantBonus works like Update and also records key in
+	// subscription_bonus_grants in the same transaction. It returns
+	// ErrBonusAlreadyGranted, leaving the row unchanged, when key was
+	// recorded before.
+	GrantBonus(ctx context.Context, id int64, key string, days int32, fn SubscriptionChange) (*Subscription, error)
+	// GetAll lists the subscriptions of tenantID, or of every tenant when
+	// tenantID is 0
+	GetAll(ctx context.Context, tenantID int64, page, perPage int, status string) ([]*Subscription, int, error)
+	// ProcessDue locks up to limit subscriptions of the given due kind with
+	// FOR UPDATE SKIP LOCKED and persists those for which fn returns nil,
+	// with their versions, all inside one transaction. Rows fn rejects are
+	// backed off and left out of later calls until their retry time, and
+	// subscriptions of soft-deleted tenants are skipped. It returns the
+	// subscriptions it updated.
+	ProcessDue(ctx context.Context, kind string, now time.Time, limit int, fn SubscriptionChange) ([]*Subscription, error)
+	// DeleteTenantVersions deletes the version history of every
+	// subscription of tenantID
+	DeleteTenantVersions(ctx context.Context, tenantID int64) error
+}
+
+type SubscriptionService interface {
+	GetByID(ctx context.Context, id int64) (*Subscription, error)
+	GetByUUID(ctx context.Context, uuid string) (*Subscription, error)
+	GetByUser(ctx context.Context, userID, tenantID int64, page, perPage int) ([]*Subscription, int, error)
+	GetByTenant(ctx context.Context, tenantID int64, page, perPage int) ([]*Subscription, int, error)
+	Create(ctx context.Context, subscription *Subscription) error
+	// Update applies change to the current subscription while it is locked
+	Update(ctx context.Context, id int64, change func(subscription *Subscription) error) (*Subscription, error)
+	Cancel(ctx context.Context, id int64, atEndOfCycle bool, reason, additionalInfo string) (*Subscription, error)
+	Pause(ctx context.Context, id int64, reason string) (*Subscription, error)
+	Resume(ctx context.Context, id int64) (*Subscription, error)
+	Renew(ctx context.Context, id int64, endsAt time.Time) (*Subscription, error)
+	GetAll(ctx context.Context, tenantID int64, page, perPage int, status string) ([]*Subscription, int, error)
+	TransitionStatus(ctx context.Context, id int64, status, reason string) (*Subscription, error)
+	ChangePlan(ctx context.Context, id, planID int64, quantity int32, mode string) (*Subscription, *Proration, error)
+	// GrantBonusDays applies a grant with a non-empty key at most once
+	GrantBonusDays(ctx context.Context, userID int64, days int32, key, reason string) (*Subscription, error)
+	// ReleaseTenant cancels the subscriptions of a tenant that is being
+	// purged and deletes their version history. Running it again is harmless.
+	ReleaseTenant(ctx context.Context, tenantID int64) error
+}
@@ -0,0 +1,32 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type SubscriptionDiscount struct {
+	ID             int64
+	SubscriptionID int64
+	DiscountID     int64
+	Type           string // percentage, fixed
+	Amount         float64
+	ValidUntil     *time.Time // nullable
+	IsRecurring    bool
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+type SubscriptionDiscountRepository interface {
+	GetByID(ctx context.Context, id int64) (*SubscriptionDiscount, error)
+	GetBySubscription(ctx context.Context, subscriptionID int64) ([]*SubscriptionDiscount, error)
+	Create(ctx context.Context, discount *SubscriptionDiscount) error
+	Delete(ctx context.Context, id int64) error
+}
+
+type SubscriptionDiscountService interface {
+	GetByID(ctx context.Context, id int64) (*SubscriptionDiscount, error)
+	AddDiscount(ctx context.Context, discount *SubscriptionDiscount) error
+	RemoveDiscount(ctx context.Context, id int64) error
+	GetBySubscription(ctx context.Context, subscriptionID int64) ([]*SubscriptionDiscount, error)
+}
@@ -0,0 +1,66 @@
+package domain
+
+import "fmt"
+
+// subscriptionTransitions lists, for each status, the statuses it may move to.
+// cancelled and expired are terminal except for resuming or renewing within
+// a paid period. The period depends on the subscription, so the service
+// checks it on every move from cancelled or expired to active.
+var subscriptionTransitions = map[string][]string{
+	SubscriptionStatusTrialing: {
+		SubscriptionStatusActive,
+		SubscriptionStatusPastDue,
+		SubscriptionStatusCancelled,
+		SubscriptionStatusExpired,
+	},
+	SubscriptionStatusActive: {
+		SubscriptionStatusPastDue,
+		SubscriptionStatusPaused,
+		SubscriptionStatusCancelled,
+		SubscriptionStatusExpired,
+	},
+	SubscriptionStatusPastDue: {
+		SubscriptionStatusActive,
+		SubscriptionStatusCancelled,
+		SubscriptionStatusExpired,
+	},
+	SubscriptionStatusPaused: {
+		SubscriptionStatusActive,
+		SubscriptionStatusCancelled,
+		SubscriptionStatusExpired,
+	},
+	SubscriptionStatusCancelled: {
+		SubscriptionStatusActive,
+		SubscriptionStatusExpired,
+	},
+	SubscriptionStatusExpired: {
+		SubscriptionStatusActive,
+	},
+}
+
+// IsValidSubscriptionStatus reports whether status is a known lifecycle state
+func IsValidSubscriptionStatus(status string) bool {
+	_, ok := subscriptionTransitions[status]
+	return ok
+}
+
+// CanTransition reports whether a subscription may move from one status to another
+func CanTransition(from, to string) bool {
+	for _, allowed := range subscriptionTransitions[from] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateTransition returns an error describing an illegal status change
+func ValidateTransition(from, to string) error {
+	if !IsValidSubscriptionStatus(to) {
+		return fmt.Errorf("invalid status: %s", to)
+	}
+	if !CanTransition(from, to) {
+		return fmt.Errorf("invalid status transition from %s to %s", from, to)
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package domain
+
+import "testing"
+
+func TestValidateTransition(t *testing.T) {
+	tests := []struct {
+		name    string
+		from    string
+		to      string
+		wantErr bool
+	}{
+		{"trial ends", SubscriptionStatusTrialing, SubscriptionStatusActive, false},
+		{"trial cancelled", SubscriptionStatusTrialing, SubscriptionStatusCancelled, false},
+		{"payment fails", SubscriptionStatusActive, SubscriptionStatusPastDue, false},
+		{"paused", SubscriptionStatusActive, SubscriptionStatusPaused, false},
+		{"payment recovers", SubscriptionStatusPastDue, SubscriptionStatusActive, false},
+		{"resumed from pause", SubscriptionStatusPaused, SubscriptionStatusActive, false},
+		{"resumed after cancelling", SubscriptionStatusCancelled, SubscriptionStatusActive, false},
+		{"cancelled period ends", SubscriptionStatusCancelled, SubscriptionStatusExpired, false},
+		{"renewed after expiry", SubscriptionStatusExpired, SubscriptionStatusActive, false},
+		{"back to trial", SubscriptionStatusActive, SubscriptionStatusTrialing, true},
+		{"paused while trialing", SubscriptionStatusTrialing, SubscriptionStatusPaused, true},
+		{"paused while past due", SubscriptionStatusPastDue, SubscriptionStatusPaused, true},
+		{"cancelled to paused", SubscriptionStatusCancelled, SubscriptionStatusPaused, true},
+		{"expired to cancelled", SubscriptionStatusExpired, SubscriptionStatusCancelled, true},
+		{"same status", SubscriptionStatusActive, SubscriptionStatusActive, true},
+		{"unknown target", SubscriptionStatusActive, "suspended", true},
+		{"unknown source", "suspended", SubscriptionStatusActive, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateTransition(tt.from, tt.to)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateTransition(%q, %q) error = %v, wantErr %v", tt.from, tt.to, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestIsValidSubscriptionStatus(t *testing.T) {
+	for _, status := range []string{
+		SubscriptionStatusTrialing,
+		SubscriptionStatusActive,
+		SubscriptionStatusPastDue,
+		SubscriptionStatusPaused,
+		SubscriptionStatusCancelled,
+		SubscriptionStatusExpired,
+	} {
+		if !IsValidSubscriptionStatus(status) {
+			t.Errorf("IsValidSubscriptionStatus(%q) = false, want true", status)
+		}
+	}
+	if IsValidSubscriptionStatus("") {
+		t.Error(`IsValidSubscriptionStatus("") = true, want false`)
+	}
+}
@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type SubscriptionUsage struct {
+	ID             int64
+	SubscriptionID int64
+	MeterID        *int64 // nullable, the plan meter at the time of recording
+	UnitCount      int32
+	IdempotencyKey *string   // nullable, unique per subscription
+	RecordedAt     time.Time // when the usage happened, as reported by the client
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// PlanMeter is the subset of a product-service plan meter that usage needs
+type PlanMeter struct {
+	ID          int64
+	Name        string
+	Aggregation string // sum, max, last (see shared/pricing)
+}
+
+// UsageSummary is the usage of one meter over a billing period
+type UsageSummary struct {
+	MeterID        int64
+	MeterName      string
+	Aggregation    string
+	Quantity       int64
+	RecordCount    int64
+	LastRecordedAt *time.Time // nullable, nil when nothing was recorded
+}
+
+type SubscriptionUsageRepository interface {
+	// Create inserts usage unless a row with the same subscription and
+	// idempotency key exists, in which case usage is filled from that row
+	// and false is returned
+	Create(ctx context.Context, usage *SubscriptionUsage) (bool, error)
+	GetBySubscription(ctx context.Context, subscriptionID int64, page, perPage int) ([]*SubscriptionUsage, int, error)
+	// Summarize aggregates the usage recorded in [from, to) per meter, using
+	// each meter's aggregation
+	Summarize(ctx context.Context, subscriptionID int64, from, to time.Time) ([]*UsageSummary, error)
+	GetMeter(ctx context.Context, id int64) (*PlanMeter, error)
+}
+
+type SubscriptionUsageService interface {
+	// RecordUsage stores usage at recordedAt (now when nil). Retrying with the
+	// same idempotency key returns the first record and false.
+	RecordUsage(ctx context.Context, subscriptionID int64, unitCount int32, idempotencyKey string, recordedAt *time.Time) (*SubscriptionUsage, bool, error)
+	GetBySubscription(ctx context.Context, subscriptionID int64, page, perPage int) ([]*SubscriptionUsage, int, error)
+	// GetUsageSummary aggregates usage per meter over [from, to), defaulting
+	// to the subscription's current billing period when both are nil
+	GetUsageSummary(ctx context.Context, subscriptionID int64, from, to *time.Time) ([]*UsageSummary, time.Time, time.Time, error)
+}
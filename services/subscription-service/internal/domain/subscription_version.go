@@ -0,0 +1,92 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+	"unicode/utf8"
+)
+
+// VersionableTypeSubscription is stored in subscription_versions.versionable_type
+const VersionableTypeSubscription = "subscription"
+
+type SubscriptionVersion struct {
+	VersionID       int32
+	VersionableID   string
+	VersionableType string
+	UserID          *string // nullable, actor that caused the change
+	ModelData       string  // JSON snapshot of the subscription
+	Reason          *string // nullable
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+type SubscriptionVersionRepository interface {
+	Create(ctx context.Context, version *SubscriptionVersion) error
+	GetByVersionable(ctx context.Context, versionableType, versionableID string, page, perPage int) ([]*SubscriptionVersion, int, error)
+}
+
+// SubscriptionVersionService reads the audit trail. Entries are written by
+// SubscriptionRepository in the same transaction as the change they record.
+type SubscriptionVersionService interface {
+	GetHistory(ctx context.Context, subscriptionID int64, page, perPage int) ([]*SubscriptionVersion, int, error)
+}
+
+// NewSubscriptionVersion snapshots the subscription as an audit entry. The
+// reason is cut to the 100 characters subscription_versions.reason holds.
+func NewSubscriptionVersion(subscription *Subscription, reason string) (*SubscriptionVersion, error) {
+	modelData, err := json.Marshal(subscriptionSnapshot(subscription))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal subscription snapshot: %w", err)
+	}
+
+	if utf8.RuneCountInString(reason) > 100 {
+		reason = string([]rune(reason)[:100])
+	}
+
+	return &SubscriptionVersion{
+		VersionableID:   fmt.Sprintf("%d", subscription.ID),
+		VersionableType: VersionableTypeSubscription,
+		ModelData:       string(modelData),
+		Reason:          &reason,
+	}, nil
+}
+
+// subscriptionSnapshot mirrors the subscriptions row so model_data can be diffed
+func subscriptionSnapshot(subscription *Subscription) map[string]interface{} {
+	return map[string]interface{}{
+		"id":                               subscription.ID,
+		"uuid":                             subscription.UUID,
+		"user_id":                          subscription.UserID,
+		"plan_id":                          subscription.PlanID,
+		"price":                            subscription.Price,
+		"currency_id":                      subscription.CurrencyID,
+		"ends_at":                          subscription.EndsAt,
+		"cancelled_at":                     subscription.CancelledAt,
+		"payment_provider_subscription_id": subscription.PaymentProviderSubscriptionID,
+		"payment_provider_status":          subscription.PaymentProviderStatus,
+		"payment_provider_id":              subscription.PaymentProviderID,
+		"trial_ends_at":                    subscription.TrialEndsAt,
+		"status":                           subscription.Status,
+		"interval_id":                      subscription.IntervalID,
+		"interval_count":                   subscription.IntervalCount,
+		"is_canceled_at_end_of_cycle":      subscription.IsCanceledAtEndOfCycle,
+		"cancellation_reason":              subscription.CancellationReason,
+		"cancellation_additional_info":     subscription.CancellationAdditionalInfo,
+		"quantity":                         subscription.Quantity,
+		"tenant_id":                        subscription.TenantID,
+		"price_type":                       subscription.PriceType,
+		"price_tiers":                      subscription.PriceTiers,
+		"price_per_unit":                   subscription.PricePerUnit,
+		"extra_payment_provider_data":      subscription.ExtraPaymentProviderData,
+		"type":                             subscription.Type,
+		"comments":                         subscription.Comments,
+		"metadata":                         subscription.Metadata,
+		"scheduled_plan_id":                subscription.ScheduledPlanID,
+		"scheduled_quantity":               subscription.ScheduledQuantity,
+		"billing_anchor":                   subscription.BillingAnchor,
+		"created_at":                       subscription.CreatedAt,
+		"updated_at":                       subscription.UpdatedAt,
+	}
+}
@@ -0,0 +1,139 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/damarteplok/damar-admin-cms/services/subscription-service/internal/domain"
+	"github.com/damarteplok/damar-admin-cms/shared/amqp"
+	"github.com/damarteplok/damar-admin-cms/shared/contracts"
+	"github.com/damarteplok/damar-admin-cms/shared/logger"
+	"go.uber.org/zap"
+)
+
+type EventConsumer struct {
+	conn                *amqp.Connection
+	subscriptionService domain.SubscriptionService
+}
+
+func NewEventConsumer(conn *amqp.Connection, subscriptionService domain.SubscriptionService) *EventConsumer {
+	return &EventConsumer{
+		conn:                conn,
+		subscriptionService: subscriptionService,
+	}
+}
+
+// ConsumeReferralConverted credits referrers with the bonus days of every
+// referral that converted. product-service publishes on damar.exchange.
+func (ec *EventConsumer) ConsumeReferralConverted(ctx context.Context) error {
+	consumer, err := amqp.NewConsumer(
+		ec.conn,
+		"subscription.referral.converted",
+		"damar.exchange",
+		contracts.ReferralEventConverted,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create consumer: %w", err)
+	}
+
+	logger.Info("Started consuming referral.converted events")
+
+	return consumer.Consume(func(body []byte) error {
+		var message contracts.AmqpMessage
+		if err := json.Unmarshal(body, &message); err != nil {
+			logger.Error("Failed to unmarshal referral.converted message", zap.Error(err))
+			return err
+		}
+
+		var eventData struct {
+			ReferralID      int64 `json:"referral_id"`
+			ReferrerUserID  int64 `json:"referrer_user_id"`
+			RewardBonusDays int32 `json:"reward_bonus_days"`
+		}
+		if err := json.Unmarshal(message.Data, &eventData); err != nil {
+			logger.Error("Failed to unmarshal event data", zap.Error(err))
+			return err
+		}
+
+		if eventData.RewardBonusDays <= 0 {
+			return nil
+		}
+
+		// The key makes a redelivered event a no-op
+		key := fmt.Sprintf("referral:%d", eventData.ReferralID)
+		reason := fmt.Sprintf("referral %d", eventData.ReferralID)
+		subscription, err := ec.subscriptionService.GrantBonusDays(ctx, eventData.ReferrerUserID, eventData.RewardBonusDays, key, reason)
+		if errors.Is(err, domain.ErrBonusAlreadyGranted) {
+			logger.Info("Referral bonus days already granted",
+				zap.Int64("referral_id", eventData.ReferralID))
+			return nil
+		}
+		if err != nil {
+			// Retrying would fail the same way, so the reward is logged and
+			// can be granted by hand
+			logger.Error("Failed to grant referral bonus days",
+				zap.Int64("referral_id", eventData.ReferralID),
+				zap.Int64("referrer_user_id", eventData.ReferrerUserID),
+				zap.Error(err))
+			return nil
+		}
+		if subscription == nil {
+			logger.Warn("Referrer has no subscription to extend",
+				zap.Int64("referral_id", eventData.ReferralID),
+				zap.Int64("referrer_user_id", eventData.ReferrerUserID))
+			return nil
+		}
+
+		logger.Info("Granted referral bonus days",
+			zap.Int64("referral_id", eventData.ReferralID),
+			zap.Int64("subscription_id", subscription.ID),
+			zap.Int32("bonus_days", eventData.RewardBonusDays))
+		return nil
+	})
+}
+
+// ConsumeTenantPurgeRequested cancels the subscriptions of tenants whose
+// grace period has ended, so tenant-service can purge them
+func (ec *EventConsumer) ConsumeTenantPurgeRequested(ctx context.Context) error {
+	consumer, err := amqp.NewConsumer(
+		ec.conn,
+		"subscription.tenant.purge_requested",
+		"damar.events",
+		contracts.TenantEventPurgeRequested,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create consumer: %w", err)
+	}
+
+	logger.Info("Started consuming tenant.purge_requested events")
+
+	return consumer.Consume(func(body []byte) error {
+		var message contracts.AmqpMessage
+		if err := json.Unmarshal(body, &message); err != nil {
+			logger.Error("Failed to unmarshal tenant.purge_requested message", zap.Error(err))
+			return err
+		}
+
+		var eventData struct {
+			TenantID int64 `json:"tenant_id"`
+		}
+		if err := json.Unmarshal(message.Data, &eventData); err != nil {
+			logger.Error("Failed to unmarshal event data", zap.Error(err))
+			return err
+		}
+
+		if err := ec.subscriptionService.ReleaseTenant(ctx, eventData.TenantID); err != nil {
+			// The purge worker requests it again on its next run
+			logger.Error("Failed to release tenant subscriptions",
+				zap.Int64("tenant_id", eventData.TenantID),
+				zap.Error(err))
+			return nil
+		}
+
+		logger.Info("Released tenant subscriptions",
+			zap.Int64("tenant_id", eventData.TenantID))
+		return nil
+	})
+}
@@ -0,0 +1,54 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/damarteplok/damar-admin-cms/services/subscription-service/internal/domain"
+	pb "github.com/damarteplok/damar-admin-cms/shared/proto/subscription"
+	"github.com/damarteplok/damar-admin-cms/shared/tenancy"
+)
+
+// Entitlement operations
+
+func (h *SubscriptionHandler) CheckEntitlement(ctx context.Context, req *pb.CheckEntitlementRequest) (*pb.CheckEntitlementResponse, error) {
+	ctx, tenantID, err := tenancy.Scope(ctx, req.TenantId)
+	if err != nil {
+		return &pb.CheckEntitlementResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	check, err := h.entitlementService.Check(ctx, tenantID, req.FeatureKey, req.Usage)
+	if err != nil {
+		return &pb.CheckEntitlementResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &pb.CheckEntitlementResponse{
+		Success: true,
+		Message: "Entitlement checked successfully",
+		Data:    domainEntitlementCheckToPb(check),
+	}, nil
+}
+
+func domainEntitlementCheckToPb(check *domain.EntitlementCheck) *pb.Entitlement {
+	result := &pb.Entitlement{
+		TenantId:   check.TenantID,
+		FeatureKey: check.FeatureKey,
+		Type:       check.Type,
+		Allowed:    check.Allowed,
+		Unlimited:  check.Unlimited,
+		Limit:      check.Limit,
+		Used:       check.Used,
+		Remaining:  check.Remaining,
+		Reason:     check.Reason,
+	}
+	if check.Subscription != nil {
+		result.SubscriptionId = check.Subscription.ID
+		result.PlanId = check.Subscription.PlanID
+	}
+	return result
+}
@@ -0,0 +1,1004 @@
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/damarteplok/damar-admin-cms/services/subscription-service/internal/domain"
+	"github.com/damarteplok/damar-admin-cms/services/subscription-service/pkg/types"
+	pb "github.com/damarteplok/damar-admin-cms/shared/proto/subscription"
+	"github.com/damarteplok/damar-admin-cms/shared/tenancy"
+	"github.com/damarteplok/damar-admin-cms/shared/util"
+	"github.com/damarteplok/damar-admin-cms/shared/validation"
+)
+
+type SubscriptionHandler struct {
+	pb.UnimplementedSubscriptionServiceServer
+	subscriptionService         domain.SubscriptionService
+	subscriptionUsageService    domain.SubscriptionUsageService
+	subscriptionDiscountService domain.SubscriptionDiscountService
+	subscriptionVersionService  domain.SubscriptionVersionService
+	entitlementService          domain.EntitlementService
+}
+
+func NewSubscriptionHandler(
+	subscriptionService domain.SubscriptionService,
+	subscriptionUsageService domain.SubscriptionUsageService,
+	subscriptionDiscountService domain.SubscriptionDiscountService,
+	subscriptionVersionService domain.SubscriptionVersionService,
+	entitlementService domain.EntitlementService,
+) *SubscriptionHandler {
+	return &SubscriptionHandler{
+		subscriptionService:         subscriptionService,
+		subscriptionUsageService:    subscriptionUsageService,
+		subscriptionDiscountService: subscriptionDiscountService,
+		subscriptionVersionService:  subscriptionVersionService,
+		entitlementService:          entitlementService,
+	}
+}
+
+// Subscription operations
+
+func (h *SubscriptionHandler) GetSubscriptionByID(ctx context.Context, req *pb.GetSubscriptionByIDRequest) (*pb.GetSubscriptionByIDResponse, error) {
+	if err := validation.ValidateStruct(&types.IDValidation{ID: req.Id}); err != nil {
+		return &pb.GetSubscriptionByIDResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	ctx, err := h.scopeSubscription(ctx, req.Id)
+	if err != nil {
+		return &pb.GetSubscriptionByIDResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	subscription, err := h.subscriptionService.GetByID(ctx, req.Id)
+	if err != nil {
+		return &pb.GetSubscriptionByIDResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &pb.GetSubscriptionByIDResponse{
+		Success: true,
+		Message: "Subscription retrieved successfully",
+		Data:    domainSubscriptionToPb(subscription),
+	}, nil
+}
+
+func (h *SubscriptionHandler) GetSubscriptionByUUID(ctx context.Context, req *pb.GetSubscriptionByUUIDRequest) (*pb.GetSubscriptionByUUIDResponse, error) {
+	if err := validation.ValidateStruct(&types.UUIDValidation{UUID: req.Uuid}); err != nil {
+		return &pb.GetSubscriptionByUUIDResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	// The subscription's tenant is checked once it is found
+	subscription, err := h.subscriptionService.GetByUUID(tenancy.WithoutTenant(ctx), req.Uuid)
+	if err != nil {
+		return &pb.GetSubscriptionByUUIDResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+	if _, err := tenancy.ResolveTenantID(ctx, subscription.TenantID); err != nil {
+		return &pb.GetSubscriptionByUUIDResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &pb.GetSubscriptionByUUIDResponse{
+		Success: true,
+		Message: "Subscription retrieved successfully",
+		Data:    domainSubscriptionToPb(subscription),
+	}, nil
+}
+
+func (h *SubscriptionHandler) GetSubscriptionsByUser(ctx context.Context, req *pb.GetSubscriptionsByUserRequest) (*pb.GetSubscriptionsByUserResponse, error) {
+	if err := validation.ValidateStruct(&types.IDValidation{ID: req.UserId}); err != nil {
+		return &pb.GetSubscriptionsByUserResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	// Calls made for a user see the active tenant only; other services may
+	// list the user's subscriptions in every tenant
+	ctx, tenantID, err := tenancy.Scope(ctx, 0)
+	if err != nil {
+		return &pb.GetSubscriptionsByUserResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	page, perPage := paginationFromRequest(req.Page, req.PerPage)
+	subscriptions, total, err := h.subscriptionService.GetByUser(ctx, req.UserId, tenantID, page, perPage)
+	if err != nil {
+		return &pb.GetSubscriptionsByUserResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &pb.GetSubscriptionsByUserResponse{
+		Success: true,
+		Message: "Subscriptions retrieved successfully",
+		Data:    subscriptionsToPbData(subscriptions, total, page, perPage),
+	}, nil
+}
+
+func (h *SubscriptionHandler) GetSubscriptionsByTenant(ctx context.Context, req *pb.GetSubscriptionsByTenantRequest) (*pb.GetSubscriptionsByTenantResponse, error) {
+	ctx, tenantID, err := tenancy.Scope(ctx, req.TenantId)
+	if err != nil {
+		return &pb.GetSubscriptionsByTenantResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+	req.TenantId = tenantID
+
+	if err := validation.ValidateStruct(&types.IDValidation{ID: req.TenantId}); err != nil {
+		return &pb.GetSubscriptionsByTenantResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	page, perPage := paginationFromRequest(req.Page, req.PerPage)
+	subscriptions, total, err := h.subscriptionService.GetByTenant(ctx, req.TenantId, page, perPage)
+	if err != nil {
+		return &pb.GetSubscriptionsByTenantResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &pb.GetSubscriptionsByTenantResponse{
+		Success: true,
+		Message: "Subscriptions retrieved successfully",
+		Data:    subscriptionsToPbData(subscriptions, total, page, perPage),
+	}, nil
+}
+
+func (h *SubscriptionHandler) CreateSubscription(ctx context.Context, req *pb.CreateSubscriptionRequest) (*pb.CreateSubscriptionResponse, error) {
+	ctx, tenantID, err := tenancy.Scope(ctx, req.TenantId)
+	if err != nil {
+		return &pb.CreateSubscriptionResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+	req.TenantId = tenantID
+
+	if err := validation.ValidateStruct(&types.CreateSubscriptionValidation{
+		UserID:        req.UserId,
+		PlanID:        req.PlanId,
+		TenantID:      req.TenantId,
+		CurrencyID:    req.CurrencyId,
+		IntervalID:    req.IntervalId,
+		IntervalCount: req.IntervalCount,
+		Price:         req.Price,
+		Quantity:      req.Quantity,
+		PriceType:     req.PriceType,
+		Type:          req.Type,
+	}); err != nil {
+		return &pb.CreateSubscriptionResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	priceTiers, err := jsonFromString("price_tiers", req.PriceTiers)
+	if err != nil {
+		return &pb.CreateSubscriptionResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+	metadata, err := jsonFromString("metadata", req.Metadata)
+	if err != nil {
+		return &pb.CreateSubscriptionResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	subscription := &domain.Subscription{
+		UserID:                        req.UserId,
+		PlanID:                        req.PlanId,
+		Price:                         req.Price,
+		CurrencyID:                    req.CurrencyId,
+		TenantID:                      req.TenantId,
+		TrialEndsAt:                   unixToTime(req.TrialEndsAt),
+		PaymentProviderSubscriptionID: util.StringPtr(req.PaymentProviderSubscriptionId),
+		PaymentProviderID:             int64Ptr(req.PaymentProviderId),
+		IntervalID:                    req.IntervalId,
+		IntervalCount:                 req.IntervalCount,
+		Quantity:                      req.Quantity,
+		PriceType:                     req.PriceType,
+		PriceTiers:                    priceTiers,
+		PricePerUnit:                  util.StringPtr(req.PricePerUnit),
+		Type:                          req.Type,
+		Comments:                      util.StringPtr(req.Comments),
+		Metadata:                      metadata,
+	}
+
+	if err := h.subscriptionService.Create(ctx, subscription); err != nil {
+		return &pb.CreateSubscriptionResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &pb.CreateSubscriptionResponse{
+		Success: true,
+		Message: "Subscription created successfully",
+		Data:    domainSubscriptionToPb(subscription),
+	}, nil
+}
+
+func (h *SubscriptionHandler) UpdateSubscription(ctx context.Context, req *pb.UpdateSubscriptionRequest) (*pb.UpdateSubscriptionResponse, error) {
+	if err := validation.ValidateStruct(&types.UpdateSubscriptionValidation{
+		ID:       req.Id,
+		Price:    req.Price,
+		Quantity: req.Quantity,
+		Status:   req.Status,
+	}); err != nil {
+		return &pb.UpdateSubscriptionResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	ctx, err := h.scopeSubscription(ctx, req.Id)
+	if err != nil {
+		return &pb.UpdateSubscriptionResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	metadata, err := jsonFromString("metadata", req.Metadata)
+	if err != nil {
+		return &pb.UpdateSubscriptionResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+	extraPaymentProviderData, err := jsonFromString("extra_payment_provider_data", req.ExtraPaymentProviderData)
+	if err != nil {
+		return &pb.UpdateSubscriptionResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	// Omitted fields keep the values of the subscription as it is stored
+	subscription, err := h.subscriptionService.Update(ctx, req.Id, func(subscription *domain.Subscription) error {
+		if req.Price > 0 {
+			subscription.Price = req.Price
+		}
+		if req.EndsAt > 0 {
+			// A period end set by hand is where later periods are counted from
+			subscription.EndsAt = unixToTime(req.EndsAt)
+			subscription.BillingAnchor = unixToTime(req.EndsAt)
+		}
+		if req.PaymentProviderStatus != "" {
+			subscription.PaymentProviderStatus = util.StringPtr(req.PaymentProviderStatus)
+		}
+		if req.Status != "" {
+			subscription.Status = req.Status
+		}
+		if req.Quantity > 0 {
+			subscription.Quantity = req.Quantity
+		}
+		if req.Comments != "" {
+			subscription.Comments = util.StringPtr(req.Comments)
+		}
+		if metadata != nil {
+			subscription.Metadata = metadata
+		}
+		if extraPaymentProviderData != nil {
+			subscription.ExtraPaymentProviderData = extraPaymentProviderData
+		}
+		return nil
+	})
+	if err != nil {
+		return &pb.UpdateSubscriptionResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &pb.UpdateSubscriptionResponse{
+		Success: true,
+		Message: "Subscription updated successfully",
+		Data:    domainSubscriptionToPb(subscription),
+	}, nil
+}
+
+func (h *SubscriptionHandler) CancelSubscription(ctx context.Context, req *pb.CancelSubscriptionRequest) (*pb.CancelSubscriptionResponse, error) {
+	if err := validation.ValidateStruct(&types.CancelSubscriptionValidation{
+		ID:                 req.Id,
+		CancellationReason: req.CancellationReason,
+	}); err != nil {
+		return &pb.CancelSubscriptionResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	ctx, err := h.scopeSubscription(ctx, req.Id)
+	if err != nil {
+		return &pb.CancelSubscriptionResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	subscription, err := h.subscriptionService.Cancel(ctx, req.Id, req.CancelAtEndOfCycle, req.CancellationReason, req.CancellationAdditionalInfo)
+	if err != nil {
+		return &pb.CancelSubscriptionResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &pb.CancelSubscriptionResponse{
+		Success: true,
+		Message: "Subscription cancelled successfully",
+		Data:    domainSubscriptionToPb(subscription),
+	}, nil
+}
+
+func (h *SubscriptionHandler) PauseSubscription(ctx context.Context, req *pb.PauseSubscriptionRequest) (*pb.PauseSubscriptionResponse, error) {
+	if err := validation.ValidateStruct(&types.PauseSubscriptionValidation{
+		ID:     req.Id,
+		Reason: req.Reason,
+	}); err != nil {
+		return &pb.PauseSubscriptionResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	ctx, err := h.scopeSubscription(ctx, req.Id)
+	if err != nil {
+		return &pb.PauseSubscriptionResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	subscription, err := h.subscriptionService.Pause(ctx, req.Id, req.Reason)
+	if err != nil {
+		return &pb.PauseSubscriptionResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &pb.PauseSubscriptionResponse{
+		Success: true,
+		Message: "Subscription paused successfully",
+		Data:    domainSubscriptionToPb(subscription),
+	}, nil
+}
+
+func (h *SubscriptionHandler) ResumeSubscription(ctx context.Context, req *pb.ResumeSubscriptionRequest) (*pb.ResumeSubscriptionResponse, error) {
+	if err := validation.ValidateStruct(&types.IDValidation{ID: req.Id}); err != nil {
+		return &pb.ResumeSubscriptionResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	ctx, err := h.scopeSubscription(ctx, req.Id)
+	if err != nil {
+		return &pb.ResumeSubscriptionResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	subscription, err := h.subscriptionService.Resume(ctx, req.Id)
+	if err != nil {
+		return &pb.ResumeSubscriptionResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &pb.ResumeSubscriptionResponse{
+		Success: true,
+		Message: "Subscription resumed successfully",
+		Data:    domainSubscriptionToPb(subscription),
+	}, nil
+}
+
+func (h *SubscriptionHandler) RenewSubscription(ctx context.Context, req *pb.RenewSubscriptionRequest) (*pb.RenewSubscriptionResponse, error) {
+	if err := validation.ValidateStruct(&types.RenewSubscriptionValidation{
+		ID:     req.Id,
+		EndsAt: req.EndsAt,
+	}); err != nil {
+		return &pb.RenewSubscriptionResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	ctx, err := h.scopeSubscription(ctx, req.Id)
+	if err != nil {
+		return &pb.RenewSubscriptionResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	subscription, err := h.subscriptionService.Renew(ctx, req.Id, time.Unix(req.EndsAt, 0))
+	if err != nil {
+		return &pb.RenewSubscriptionResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &pb.RenewSubscriptionResponse{
+		Success: true,
+		Message: "Subscription renewed successfully",
+		Data:    domainSubscriptionToPb(subscription),
+	}, nil
+}
+
+func (h *SubscriptionHandler) GetAllSubscriptions(ctx context.Context, req *pb.GetAllSubscriptionsRequest) (*pb.GetAllSubscriptionsResponse, error) {
+	// Like GetSubscriptionsByUser, only other services list every tenant
+	ctx, tenantID, err := tenancy.Scope(ctx, 0)
+	if err != nil {
+		return &pb.GetAllSubscriptionsResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	page, perPage := paginationFromRequest(req.Page, req.PerPage)
+	subscriptions, total, err := h.subscriptionService.GetAll(ctx, tenantID, page, perPage, req.Status)
+	if err != nil {
+		return &pb.GetAllSubscriptionsResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &pb.GetAllSubscriptionsResponse{
+		Success: true,
+		Message: "Subscriptions retrieved successfully",
+		Data:    subscriptionsToPbData(subscriptions, total, page, perPage),
+	}, nil
+}
+
+func (h *SubscriptionHandler) ChangePlan(ctx context.Context, req *pb.ChangePlanRequest) (*pb.ChangePlanResponse, error) {
+	if err := validation.ValidateStruct(&types.ChangePlanValidation{
+		ID:       req.Id,
+		PlanID:   req.PlanId,
+		Quantity: req.Quantity,
+		Mode:     req.Mode,
+	}); err != nil {
+		return &pb.ChangePlanResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	ctx, err := h.scopeSubscription(ctx, req.Id)
+	if err != nil {
+		return &pb.ChangePlanResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	subscription, proration, err := h.subscriptionService.ChangePlan(ctx, req.Id, req.PlanId, req.Quantity, req.Mode)
+	if err != nil {
+		return &pb.ChangePlanResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	message := "Subscription plan changed successfully"
+	if proration.Mode == domain.PlanChangeModePeriodEnd {
+		message = "Subscription plan change scheduled successfully"
+	}
+
+	return &pb.ChangePlanResponse{
+		Success:   true,
+		Message:   message,
+		Data:      domainSubscriptionToPb(subscription),
+		Proration: domainProrationToPb(proration),
+	}, nil
+}
+
+// Subscription Usage operations
+
+func (h *SubscriptionHandler) RecordUsage(ctx context.Context, req *pb.RecordUsageRequest) (*pb.RecordUsageResponse, error) {
+	if err := validation.ValidateStruct(&types.RecordUsageValidation{
+		SubscriptionID: req.SubscriptionId,
+		UnitCount:      req.UnitCount,
+		IdempotencyKey: req.IdempotencyKey,
+		Timestamp:      req.Timestamp,
+	}); err != nil {
+		return &pb.RecordUsageResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	ctx, err := h.scopeSubscription(ctx, req.SubscriptionId)
+	if err != nil {
+		return &pb.RecordUsageResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	usage, created, err := h.subscriptionUsageService.RecordUsage(ctx, req.SubscriptionId, req.UnitCount, req.IdempotencyKey, unixToTime(req.Timestamp))
+	if err != nil {
+		return &pb.RecordUsageResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	message := "Usage recorded successfully"
+	if !created {
+		message = "Usage already recorded"
+	}
+
+	return &pb.RecordUsageResponse{
+		Success:   true,
+		Message:   message,
+		Data:      domainSubscriptionUsageToPb(usage),
+		Duplicate: !created,
+	}, nil
+}
+
+func (h *SubscriptionHandler) GetUsageBySubscription(ctx context.Context, req *pb.GetUsageBySubscriptionRequest) (*pb.GetUsageBySubscriptionResponse, error) {
+	if err := validation.ValidateStruct(&types.IDValidation{ID: req.SubscriptionId}); err != nil {
+		return &pb.GetUsageBySubscriptionResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	ctx, err := h.scopeSubscription(ctx, req.SubscriptionId)
+	if err != nil {
+		return &pb.GetUsageBySubscriptionResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	page, perPage := paginationFromRequest(req.Page, req.PerPage)
+	usages, total, err := h.subscriptionUsageService.GetBySubscription(ctx, req.SubscriptionId, page, perPage)
+	if err != nil {
+		return &pb.GetUsageBySubscriptionResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	pbUsages := make([]*pb.SubscriptionUsage, len(usages))
+	for i, usage := range usages {
+		pbUsages[i] = domainSubscriptionUsageToPb(usage)
+	}
+
+	return &pb.GetUsageBySubscriptionResponse{
+		Success: true,
+		Message: "Usage retrieved successfully",
+		Data: &pb.GetUsageBySubscriptionData{
+			Usages:  pbUsages,
+			Total:   int32(total),
+			Page:    int32(page),
+			PerPage: int32(perPage),
+		},
+	}, nil
+}
+
+func (h *SubscriptionHandler) GetUsageSummary(ctx context.Context, req *pb.GetUsageSummaryRequest) (*pb.GetUsageSummaryResponse, error) {
+	if err := validation.ValidateStruct(&types.GetUsageSummaryValidation{
+		SubscriptionID: req.SubscriptionId,
+		PeriodStart:    req.PeriodStart,
+		PeriodEnd:      req.PeriodEnd,
+	}); err != nil {
+		return &pb.GetUsageSummaryResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	ctx, err := h.scopeSubscription(ctx, req.SubscriptionId)
+	if err != nil {
+		return &pb.GetUsageSummaryResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	summaries, periodStart, periodEnd, err := h.subscriptionUsageService.GetUsageSummary(ctx, req.SubscriptionId, unixToTime(req.PeriodStart), unixToTime(req.PeriodEnd))
+	if err != nil {
+		return &pb.GetUsageSummaryResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	pbSummaries := make([]*pb.UsageSummary, len(summaries))
+	for i, summary := range summaries {
+		pbSummaries[i] = domainUsageSummaryToPb(summary)
+	}
+
+	return &pb.GetUsageSummaryResponse{
+		Success: true,
+		Message: "Usage summary retrieved successfully",
+		Data: &pb.GetUsageSummaryData{
+			Meters:      pbSummaries,
+			PeriodStart: periodStart.Unix(),
+			PeriodEnd:   periodEnd.Unix(),
+		},
+	}, nil
+}
+
+// Subscription Discount operations
+
+func (h *SubscriptionHandler) AddDiscount(ctx context.Context, req *pb.AddDiscountRequest) (*pb.AddDiscountResponse, error) {
+	if err := validation.ValidateStruct(&types.AddDiscountValidation{
+		SubscriptionID: req.SubscriptionId,
+		DiscountID:     req.DiscountId,
+		Type:           req.Type,
+		Amount:         req.Amount,
+	}); err != nil {
+		return &pb.AddDiscountResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	ctx, err := h.scopeSubscription(ctx, req.SubscriptionId)
+	if err != nil {
+		return &pb.AddDiscountResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	discount := &domain.SubscriptionDiscount{
+		SubscriptionID: req.SubscriptionId,
+		DiscountID:     req.DiscountId,
+		Type:           req.Type,
+		Amount:         req.Amount,
+		ValidUntil:     unixToTime(req.ValidUntil),
+		IsRecurring:    req.IsRecurring,
+	}
+
+	if err := h.subscriptionDiscountService.AddDiscount(ctx, discount); err != nil {
+		return &pb.AddDiscountResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &pb.AddDiscountResponse{
+		Success: true,
+		Message: "Discount added successfully",
+		Data:    domainSubscriptionDiscountToPb(discount),
+	}, nil
+}
+
+func (h *SubscriptionHandler) RemoveDiscount(ctx context.Context, req *pb.RemoveDiscountRequest) (*pb.RemoveDiscountResponse, error) {
+	if err := validation.ValidateStruct(&types.IDValidation{ID: req.Id}); err != nil {
+		return &pb.RemoveDiscountResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	discount, err := h.subscriptionDiscountService.GetByID(ctx, req.Id)
+	if err != nil {
+		return &pb.RemoveDiscountResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+	ctx, err = h.scopeSubscription(ctx, discount.SubscriptionID)
+	if err != nil {
+		return &pb.RemoveDiscountResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	if err := h.subscriptionDiscountService.RemoveDiscount(ctx, req.Id); err != nil {
+		return &pb.RemoveDiscountResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &pb.RemoveDiscountResponse{
+		Success: true,
+		Message: "Discount removed successfully",
+	}, nil
+}
+
+func (h *SubscriptionHandler) GetDiscountsBySubscription(ctx context.Context, req *pb.GetDiscountsBySubscriptionRequest) (*pb.GetDiscountsBySubscriptionResponse, error) {
+	if err := validation.ValidateStruct(&types.IDValidation{ID: req.SubscriptionId}); err != nil {
+		return &pb.GetDiscountsBySubscriptionResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	ctx, err := h.scopeSubscription(ctx, req.SubscriptionId)
+	if err != nil {
+		return &pb.GetDiscountsBySubscriptionResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	discounts, err := h.subscriptionDiscountService.GetBySubscription(ctx, req.SubscriptionId)
+	if err != nil {
+		return &pb.GetDiscountsBySubscriptionResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	pbDiscounts := make([]*pb.SubscriptionDiscount, len(discounts))
+	for i, discount := range discounts {
+		pbDiscounts[i] = domainSubscriptionDiscountToPb(discount)
+	}
+
+	return &pb.GetDiscountsBySubscriptionResponse{
+		Success: true,
+		Message: "Discounts retrieved successfully",
+		Data:    pbDiscounts,
+	}, nil
+}
+
+// Subscription Version operations
+
+func (h *SubscriptionHandler) GetVersionHistory(ctx context.Context, req *pb.GetVersionHistoryRequest) (*pb.GetVersionHistoryResponse, error) {
+	if err := validation.ValidateStruct(&types.IDValidation{ID: req.SubscriptionId}); err != nil {
+		return &pb.GetVersionHistoryResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	ctx, err := h.scopeSubscription(ctx, req.SubscriptionId)
+	if err != nil {
+		return &pb.GetVersionHistoryResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	page, perPage := paginationFromRequest(req.Page, req.PerPage)
+	versions, total, err := h.subscriptionVersionService.GetHistory(ctx, req.SubscriptionId, page, perPage)
+	if err != nil {
+		return &pb.GetVersionHistoryResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	pbVersions := make([]*pb.SubscriptionVersion, len(versions))
+	for i, version := range versions {
+		pbVersions[i] = domainSubscriptionVersionToPb(version)
+	}
+
+	return &pb.GetVersionHistoryResponse{
+		Success: true,
+		Message: "Version history retrieved successfully",
+		Data: &pb.GetVersionHistoryData{
+			Versions: pbVersions,
+			Total:    int32(total),
+			Page:     int32(page),
+			PerPage:  int32(perPage),
+		},
+	}, nil
+}
+
+// Helper functions
+
+// scopeSubscription loads the subscription a call names and checks it
+// belongs to the caller's active tenant, returning ctx scoped to that tenant
+func (h *SubscriptionHandler) scopeSubscription(ctx context.Context, id int64) (context.Context, error) {
+	// The lookup spans tenants; its tenant is checked below
+	subscription, err := h.subscriptionService.GetByID(tenancy.WithoutTenant(ctx), id)
+	if err != nil {
+		return ctx, err
+	}
+	ctx, _, err = tenancy.Scope(ctx, subscription.TenantID)
+	return ctx, err
+}
+
+func paginationFromRequest(page, perPage int32) (int, int) {
+	p := int(page)
+	pp := int(perPage)
+	if p < 1 {
+		p = 1
+	}
+	if pp < 1 {
+		pp = 10
+	}
+	if pp > 100 {
+		pp = 100
+	}
+	return p, pp
+}
+
+// jsonFromString validates an optional JSON string field from the request
+func jsonFromString(field, value string) (json.RawMessage, error) {
+	if value == "" {
+		return nil, nil
+	}
+	if !json.Valid([]byte(value)) {
+		return nil, fmt.Errorf("%s must be valid JSON", field)
+	}
+	return json.RawMessage(value), nil
+}
+
+func unixToTime(ts int64) *time.Time {
+	if ts <= 0 {
+		return nil
+	}
+	t := time.Unix(ts, 0)
+	return &t
+}
+
+func int64Ptr(v int64) *int64 {
+	if v == 0 {
+		return nil
+	}
+	return &v
+}
+
+func int64Value(v *int64) int64 {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
+
+func int32Value(v *int32) int32 {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
+
+func subscriptionsToPbData(subscriptions []*domain.Subscription, total, page, perPage int) *pb.GetAllSubscriptionsData {
+	pbSubscriptions := make([]*pb.Subscription, len(subscriptions))
+	for i, subscription := range subscriptions {
+		pbSubscriptions[i] = domainSubscriptionToPb(subscription)
+	}
+
+	return &pb.GetAllSubscriptionsData{
+		Subscriptions: pbSubscriptions,
+		Total:         int32(total),
+		Page:          int32(page),
+		PerPage:       int32(perPage),
+	}
+}
+
+func domainSubscriptionToPb(subscription *domain.Subscription) *pb.Subscription {
+	return &pb.Subscription{
+		Id:                            subscription.ID,
+		Uuid:                          subscription.UUID,
+		UserId:                        subscription.UserID,
+		PlanId:                        subscription.PlanID,
+		Price:                         subscription.Price,
+		CurrencyId:                    subscription.CurrencyID,
+		EndsAt:                        util.TimeToUnix(subscription.EndsAt),
+		CancelledAt:                   util.TimeToUnix(subscription.CancelledAt),
+		PaymentProviderSubscriptionId: util.StringValue(subscription.PaymentProviderSubscriptionID),
+		PaymentProviderStatus:         util.StringValue(subscription.PaymentProviderStatus),
+		PaymentProviderId:             int64Value(subscription.PaymentProviderID),
+		TrialEndsAt:                   util.TimeToUnix(subscription.TrialEndsAt),
+		Status:                        subscription.Status,
+		IntervalId:                    subscription.IntervalID,
+		IntervalCount:                 subscription.IntervalCount,
+		IsCanceledAtEndOfCycle:        subscription.IsCanceledAtEndOfCycle,
+		CancellationReason:            util.StringValue(subscription.CancellationReason),
+		CancellationAdditionalInfo:    util.StringValue(subscription.CancellationAdditionalInfo),
+		Quantity:                      subscription.Quantity,
+		TenantId:                      subscription.TenantID,
+		PriceType:                     subscription.PriceType,
+		PriceTiers:                    string(subscription.PriceTiers),
+		PricePerUnit:                  util.StringValue(subscription.PricePerUnit),
+		ExtraPaymentProviderData:      string(subscription.ExtraPaymentProviderData),
+		Type:                          subscription.Type,
+		Comments:                      util.StringValue(subscription.Comments),
+		Metadata:                      string(subscription.Metadata),
+		ScheduledPlanId:               int64Value(subscription.ScheduledPlanID),
+		ScheduledQuantity:             int32Value(subscription.ScheduledQuantity),
+		CreatedAt:                     subscription.CreatedAt.Unix(),
+		UpdatedAt:                     subscription.UpdatedAt.Unix(),
+	}
+}
+
+func domainProrationToPb(proration *domain.Proration) *pb.Proration {
+	if proration == nil {
+		return nil
+	}
+	return &pb.Proration{
+		CreditAmount:   proration.CreditAmount,
+		ChargeAmount:   proration.ChargeAmount,
+		NetAmount:      proration.NetAmount,
+		RemainingRatio: proration.RemainingRatio,
+		Mode:           proration.Mode,
+		EffectiveAt:    proration.EffectiveAt.Unix(),
+	}
+}
+
+func domainSubscriptionUsageToPb(usage *domain.SubscriptionUsage) *pb.SubscriptionUsage {
+	return &pb.SubscriptionUsage{
+		Id:             usage.ID,
+		SubscriptionId: usage.SubscriptionID,
+		UnitCount:      usage.UnitCount,
+		CreatedAt:      usage.CreatedAt.Unix(),
+		UpdatedAt:      usage.UpdatedAt.Unix(),
+		MeterId:        int64Value(usage.MeterID),
+		IdempotencyKey: util.StringValue(usage.IdempotencyKey),
+		RecordedAt:     usage.RecordedAt.Unix(),
+	}
+}
+
+func domainUsageSummaryToPb(summary *domain.UsageSummary) *pb.UsageSummary {
+	return &pb.UsageSummary{
+		MeterId:        summary.MeterID,
+		MeterName:      summary.MeterName,
+		Aggregation:    summary.Aggregation,
+		Quantity:       summary.Quantity,
+		RecordCount:    summary.RecordCount,
+		LastRecordedAt: util.TimeToUnix(summary.LastRecordedAt),
+	}
+}
+
+func domainSubscriptionDiscountToPb(discount *domain.SubscriptionDiscount) *pb.SubscriptionDiscount {
+	return &pb.SubscriptionDiscount{
+		Id:             discount.ID,
+		SubscriptionId: discount.SubscriptionID,
+		DiscountId:     discount.DiscountID,
+		Type:           discount.Type,
+		Amount:         discount.Amount,
+		ValidUntil:     util.TimeToUnix(discount.ValidUntil),
+		IsRecurring:    discount.IsRecurring,
+		CreatedAt:      discount.CreatedAt.Unix(),
+		UpdatedAt:      discount.UpdatedAt.Unix(),
+	}
+}
+
+func domainSubscriptionVersionToPb(version *domain.SubscriptionVersion) *pb.SubscriptionVersion {
+	return &pb.SubscriptionVersion{
+		VersionId:       version.VersionID,
+		VersionableId:   version.VersionableID,
+		VersionableType: version.VersionableType,
+		UserId:          util.StringValue(version.UserID),
+		ModelData:       version.ModelData,
+		Reason:          util.StringValue(version.Reason),
+		CreatedAt:       version.CreatedAt.Unix(),
+		UpdatedAt:       version.UpdatedAt.Unix(),
+	}
+}
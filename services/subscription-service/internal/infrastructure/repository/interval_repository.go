@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/damarteplok/damar-admin-cms/services/subscription-service/internal/domain"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type IntervalRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewIntervalRepository(db *pgxpool.Pool) domain.IntervalRepository {
+	return &IntervalRepository{db: db}
+}
+
+func (r *IntervalRepository) GetByID(ctx context.Context, id int64) (*domain.Interval, error) {
+	query := `
+		SELECT id, name, slug, COALESCE(created_at, NOW()), COALESCE(updated_at, NOW())
+		FROM intervals
+		WHERE id = $1
+	`
+
+	interval := &domain.Interval{}
+	err := r.db.QueryRow(ctx, query, id).Scan(
+		&interval.ID,
+		&interval.Name,
+		&interval.Slug,
+		&interval.CreatedAt,
+		&interval.UpdatedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get interval by ID: %w", err)
+	}
+
+	return interval, nil
+}
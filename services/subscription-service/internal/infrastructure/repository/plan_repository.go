@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/damarteplok/damar-admin-cms/services/subscription-service/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PlanRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewPlanRepository(db *pgxpool.Pool) domain.PlanRepository {
+	return &PlanRepository{db: db}
+}
+
+func (r *PlanRepository) GetByID(ctx context.Context, id int64) (*domain.Plan, error) {
+	query := `
+		SELECT id, name, interval_id, interval_count, meter_id, is_active, max_users_per_tenant
+		FROM plans
+		WHERE id = $1
+	`
+
+	plan := &domain.Plan{}
+	err := r.db.QueryRow(ctx, query, id).Scan(
+		&plan.ID,
+		&plan.Name,
+		&plan.IntervalID,
+		&plan.IntervalCount,
+		&plan.MeterID,
+		&plan.IsActive,
+		&plan.MaxUsersPerTenant,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get plan by ID: %w", err)
+	}
+
+	return plan, nil
+}
+
+func (r *PlanRepository) GetPrice(ctx context.Context, planID, currencyID int64) (*domain.PlanPrice, error) {
+	query := `
+		SELECT id, plan_id, currency_id, price, price_per_unit, type, tiers
+		FROM plan_prices
+		WHERE plan_id = $1 AND currency_id = $2
+		ORDER BY id
+		LIMIT 1
+	`
+
+	price := &domain.PlanPrice{}
+	err := r.db.QueryRow(ctx, query, planID, currencyID).Scan(
+		&price.ID,
+		&price.PlanID,
+		&price.CurrencyID,
+		&price.Price,
+		&price.PricePerUnit,
+		&price.Type,
+		&price.Tiers,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get plan price: %w", err)
+	}
+
+	return price, nil
+}
+
+func (r *PlanRepository) GetEntitlement(ctx context.Context, planID int64, featureKey string) (*domain.PlanEntitlement, error) {
+	query := `
+		SELECT id, plan_id, feature_key, type, is_enabled, limit_value, meter_id
+		FROM plan_entitlements
+		WHERE plan_id = $1 AND feature_key = $2
+	`
+
+	entitlement := &domain.PlanEntitlement{}
+	err := r.db.QueryRow(ctx, query, planID, featureKey).Scan(
+		&entitlement.ID,
+		&entitlement.PlanID,
+		&entitlement.FeatureKey,
+		&entitlement.Type,
+		&entitlement.IsEnabled,
+		&entitlement.LimitValue,
+		&entitlement.MeterID,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get plan entitlement: %w", err)
+	}
+
+	return entitlement, nil
+}
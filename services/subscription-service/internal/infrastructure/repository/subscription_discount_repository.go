@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/damarteplok/damar-admin-cms/services/subscription-service/internal/domain"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type SubscriptionDiscountRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewSubscriptionDiscountRepository(db *pgxpool.Pool) domain.SubscriptionDiscountRepository {
+	return &SubscriptionDiscountRepository{db: db}
+}
+
+func (r *SubscriptionDiscountRepository) GetByID(ctx context.Context, id int64) (*domain.SubscriptionDiscount, error) {
+	query := `
+		SELECT id, subscription_id, discount_id, type, amount, valid_until, is_recurring,
+		       created_at, updated_at
+		FROM subscription_discounts
+		WHERE id = $1
+	`
+
+	discount := &domain.SubscriptionDiscount{}
+	err := r.db.QueryRow(ctx, query, id).Scan(
+		&discount.ID,
+		&discount.SubscriptionID,
+		&discount.DiscountID,
+		&discount.Type,
+		&discount.Amount,
+		&discount.ValidUntil,
+		&discount.IsRecurring,
+		&discount.CreatedAt,
+		&discount.UpdatedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get subscription discount by ID: %w", err)
+	}
+
+	return discount, nil
+}
+
+func (r *SubscriptionDiscountRepository) GetBySubscription(ctx context.Context, subscriptionID int64) ([]*domain.SubscriptionDiscount, error) {
+	query := `
+		SELECT id, subscription_id, discount_id, type, amount, valid_until, is_recurring,
+		       created_at, updated_at
+		FROM subscription_discounts
+		WHERE subscription_id = $1
+		ORDER BY created_at DESC
+	`
+
+	rows, err := r.db.Query(ctx, query, subscriptionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get subscription discounts: %w", err)
+	}
+	defer rows.Close()
+
+	discounts := make([]*domain.SubscriptionDiscount, 0)
+	for rows.Next() {
+		discount := &domain.SubscriptionDiscount{}
+		err := rows.Scan(
+			&discount.ID,
+			&discount.SubscriptionID,
+			&discount.DiscountID,
+			&discount.Type,
+			&discount.Amount,
+			&discount.ValidUntil,
+			&discount.IsRecurring,
+			&discount.CreatedAt,
+			&discount.UpdatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan subscription discount: %w", err)
+		}
+		discounts = append(discounts, discount)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating subscription discounts: %w", err)
+	}
+
+	return discounts, nil
+}
+
+func (r *SubscriptionDiscountRepository) Create(ctx context.Context, discount *domain.SubscriptionDiscount) error {
+	query := `
+		INSERT INTO subscription_discounts (subscription_id, discount_id, type, amount,
+		                                    valid_until, is_recurring, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, NOW(), NOW())
+		RETURNING id, created_at, updated_at
+	`
+
+	err := r.db.QueryRow(
+		ctx,
+		query,
+		discount.SubscriptionID,
+		discount.DiscountID,
+		discount.Type,
+		discount.Amount,
+		discount.ValidUntil,
+		discount.IsRecurring,
+	).Scan(&discount.ID, &discount.CreatedAt, &discount.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create subscription discount: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SubscriptionDiscountRepository) Delete(ctx context.Context, id int64) error {
+	query := `DELETE FROM subscription_discounts WHERE id = $1`
+
+	result, err := r.db.Exec(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete subscription discount: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("subscription discount not found")
+	}
+
+	return nil
+}
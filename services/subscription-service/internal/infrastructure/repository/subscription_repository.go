@@ -0,0 +1,484 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/damarteplok/damar-admin-cms/services/subscription-service/internal/domain"
+	"github.com/damarteplok/damar-admin-cms/shared/database"
+	"github.com/damarteplok/damar-admin-cms/shared/tenancy"
+	"github.com/jackc/pgx/v5"
+)
+
+// subscriptionColumns is shared by every SELECT so scanSubscription stays in sync
+const subscriptionColumns = `
+	id, uuid, user_id, plan_id, price, currency_id, ends_at, cancelled_at,
+	payment_provider_subscription_id, payment_provider_status, payment_provider_id,
+	trial_ends_at, status, interval_id, interval_count, is_canceled_at_end_of_cycle,
+	cancellation_reason, cancellation_additional_info, quantity, tenant_id,
+	price_type, price_tiers, price_per_unit, extra_payment_provider_data, type,
+	comments, metadata, scheduled_plan_id, scheduled_quantity, billing_anchor,
+	created_at, updated_at
+`
+
+// SubscriptionRepository runs every query through tenantDB, so row-level
+// security applies to them. Queries that span tenants say so with
+// tenancy.WithoutTenant; each such use says why.
+type SubscriptionRepository struct {
+	tenantDB *database.TenantDB
+}
+
+func NewSubscriptionRepository(tenantDB *database.TenantDB) domain.SubscriptionRepository {
+	return &SubscriptionRepository{tenantDB: tenantDB}
+}
+
+func scanSubscription(row pgx.Row, subscription *domain.Subscription) error {
+	return row.Scan(
+		&subscription.ID,
+		&subscription.UUID,
+		&subscription.UserID,
+		&subscription.PlanID,
+		&subscription.Price,
+		&subscription.CurrencyID,
+		&subscription.EndsAt,
+		&subscription.CancelledAt,
+		&subscription.PaymentProviderSubscriptionID,
+		&subscription.PaymentProviderStatus,
+		&subscription.PaymentProviderID,
+		&subscription.TrialEndsAt,
+		&subscription.Status,
+		&subscription.IntervalID,
+		&subscription.IntervalCount,
+		&subscription.IsCanceledAtEndOfCycle,
+		&subscription.CancellationReason,
+		&subscription.CancellationAdditionalInfo,
+		&subscription.Quantity,
+		&subscription.TenantID,
+		&subscription.PriceType,
+		&subscription.PriceTiers,
+		&subscription.PricePerUnit,
+		&subscription.ExtraPaymentProviderData,
+		&subscription.Type,
+		&subscription.Comments,
+		&subscription.Metadata,
+		&subscription.ScheduledPlanID,
+		&subscription.ScheduledQuantity,
+		&subscription.BillingAnchor,
+		&subscription.CreatedAt,
+		&subscription.UpdatedAt,
+	)
+}
+
+func (r *SubscriptionRepository) GetByID(ctx context.Context, id int64) (*domain.Subscription, error) {
+	query := `SELECT ` + subscriptionColumns + ` FROM subscriptions WHERE id = $1`
+
+	subscription := &domain.Subscription{}
+	if err := scanSubscription(r.tenantDB.QueryRow(ctx, query, id), subscription); err != nil {
+		return nil, fmt.Errorf("failed to get subscription by ID: %w", err)
+	}
+
+	return subscription, nil
+}
+
+func (r *SubscriptionRepository) GetByUUID(ctx context.Context, uuid string) (*domain.Subscription, error) {
+	query := `SELECT ` + subscriptionColumns + ` FROM subscriptions WHERE uuid = $1`
+
+	subscription := &domain.Subscription{}
+	if err := scanSubscription(r.tenantDB.QueryRow(ctx, query, uuid), subscription); err != nil {
+		return nil, fmt.Errorf("failed to get subscription by UUID: %w", err)
+	}
+
+	return subscription, nil
+}
+
+func (r *SubscriptionRepository) GetByUser(ctx context.Context, userID, tenantID int64, page, perPage int) ([]*domain.Subscription, int, error) {
+	if tenantID > 0 {
+		return r.list(ctx, "user_id = $1 AND tenant_id = $2", []interface{}{userID, tenantID}, page, perPage)
+	}
+	// A user can subscribe in several tenants, so this must not be scoped
+	return r.list(tenancy.WithoutTenant(ctx), "user_id = $1", []interface{}{userID}, page, perPage)
+}
+
+func (r *SubscriptionRepository) GetByTenant(ctx context.Context, tenantID int64, page, perPage int) ([]*domain.Subscription, int, error) {
+	return r.list(ctx, "tenant_id = $1", []interface{}{tenantID}, page, perPage)
+}
+
+// GetActiveByTenant counts trialing, active and past_due subscriptions, and
+// cancelled ones whose paid period has not ended yet
+func (r *SubscriptionRepository) GetActiveByTenant(ctx context.Context, tenantID int64, now time.Time) (*domain.Subscription, error) {
+	query := `
+		SELECT ` + subscriptionColumns + `
+		FROM subscriptions
+		WHERE tenant_id = $1
+		  AND (status IN ('trialing', 'active', 'past_due')
+		       OR (status = 'cancelled' AND ends_at > $2))
+		ORDER BY created_at DESC, id DESC
+		LIMIT 1
+	`
+
+	subscription := &domain.Subscription{}
+	if err := scanSubscription(r.tenantDB.QueryRow(ctx, query, tenantID, now), subscription); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get active subscription by tenant: %w", err)
+	}
+
+	return subscription, nil
+}
+
+func (r *SubscriptionRepository) GetAll(ctx context.Context, tenantID int64, page, perPage int, status string) ([]*domain.Subscription, int, error) {
+	if tenantID > 0 {
+		if status != "" {
+			return r.list(ctx, "tenant_id = $1 AND status = $2", []interface{}{tenantID, status}, page, perPage)
+		}
+		return r.list(ctx, "tenant_id = $1", []interface{}{tenantID}, page, perPage)
+	}
+
+	// Listing every subscription is an internal query across tenants
+	ctx = tenancy.WithoutTenant(ctx)
+	if status != "" {
+		return r.list(ctx, "status = $1", []interface{}{status}, page, perPage)
+	}
+	return r.list(ctx, "1=1", []interface{}{}, page, perPage)
+}
+
+// list runs a paginated query against subscriptions with the given WHERE clause
+func (r *SubscriptionRepository) list(ctx context.Context, where string, args []interface{}, page, perPage int) ([]*domain.Subscription, int, error) {
+	offset := (page - 1) * perPage
+
+	var total int
+	countQuery := `SELECT COUNT(*) FROM subscriptions WHERE ` + where
+	if err := r.tenantDB.QueryRow(ctx, countQuery, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count subscriptions: %w", err)
+	}
+
+	argCounter := len(args) + 1
+	query := `SELECT ` + subscriptionColumns + ` FROM subscriptions WHERE ` + where +
+		fmt.Sprintf(` ORDER BY created_at DESC LIMIT $%d OFFSET $%d`, argCounter, argCounter+1)
+	args = append(args, perPage, offset)
+
+	rows, err := r.tenantDB.Query(ctx, query, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get subscriptions: %w", err)
+	}
+	defer rows.Close()
+
+	subscriptions := make([]*domain.Subscription, 0)
+	for rows.Next() {
+		subscription := &domain.Subscription{}
+		if err := scanSubscription(rows, subscription); err != nil {
+			return nil, 0, fmt.Errorf("failed to scan subscription: %w", err)
+		}
+		subscriptions = append(subscriptions, subscription)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating subscriptions: %w", err)
+	}
+
+	return subscriptions, total, nil
+}
+
+func (r *SubscriptionRepository) Create(ctx context.Context, subscription *domain.Subscription) error {
+	query := `
+		INSERT INTO subscriptions (uuid, user_id, plan_id, price, currency_id, ends_at,
+		                           payment_provider_subscription_id, payment_provider_status,
+		                           payment_provider_id, trial_ends_at, status, interval_id,
+		                           interval_count, quantity, tenant_id, price_type, price_tiers,
+		                           price_per_unit, extra_payment_provider_data, type, comments,
+		                           metadata, billing_anchor, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17,
+		        $18, $19, $20, $21, $22, $23, NOW(), NOW())
+		RETURNING id, created_at, updated_at
+	`
+
+	tx, err := r.tenantDB.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	err = tx.QueryRow(
+		ctx,
+		query,
+		subscription.UUID,
+		subscription.UserID,
+		subscription.PlanID,
+		subscription.Price,
+		subscription.CurrencyID,
+		subscription.EndsAt,
+		subscription.PaymentProviderSubscriptionID,
+		subscription.PaymentProviderStatus,
+		subscription.PaymentProviderID,
+		subscription.TrialEndsAt,
+		subscription.Status,
+		subscription.IntervalID,
+		subscription.IntervalCount,
+		subscription.Quantity,
+		subscription.TenantID,
+		subscription.PriceType,
+		subscription.PriceTiers,
+		subscription.PricePerUnit,
+		subscription.ExtraPaymentProviderData,
+		subscription.Type,
+		subscription.Comments,
+		subscription.Metadata,
+		subscription.BillingAnchor,
+	).Scan(&subscription.ID, &subscription.CreatedAt, &subscription.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create subscription: %w", err)
+	}
+
+	if err := recordVersion(ctx, tx, subscription, "created"); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SubscriptionRepository) Update(ctx context.Context, id int64, fn domain.SubscriptionChange) (*domain.Subscription, error) {
+	tx, err := r.tenantDB.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	subscription, err := updateLocked(ctx, tx, id, fn)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return subscription, nil
+}
+
+func (r *SubscriptionRepository) GrantBonus(ctx context.Context, id int64, key string, days int32, fn domain.SubscriptionChange) (*domain.Subscription, error) {
+	tx, err := r.tenantDB.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	subscription, err := updateLocked(ctx, tx, id, fn)
+	if err != nil {
+		return nil, err
+	}
+
+	// A concurrent grant with the same key waits on the unique index and
+	// then inserts nothing
+	query := `
+		INSERT INTO subscription_bonus_grants (grant_key, subscription_id, days, created_at)
+		VALUES ($1, $2, $3, NOW())
+		ON CONFLICT (grant_key) DO NOTHING
+	`
+	result, err := tx.Exec(ctx, query, key, id, days)
+	if err != nil {
+		return nil, fmt.Errorf("failed to record bonus grant: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return nil, domain.ErrBonusAlreadyGranted
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return subscription, nil
+}
+
+// updateLocked loads the subscription with FOR UPDATE, applies fn and saves
+// it with its version inside tx
+func updateLocked(ctx context.Context, tx pgx.Tx, id int64, fn domain.SubscriptionChange) (*domain.Subscription, error) {
+	query := `SELECT ` + subscriptionColumns + ` FROM subscriptions WHERE id = $1 FOR UPDATE`
+
+	subscription := &domain.Subscription{}
+	if err := scanSubscription(tx.QueryRow(ctx, query, id), subscription); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.New("subscription not found")
+		}
+		return nil, fmt.Errorf("failed to lock subscription: %w", err)
+	}
+
+	reason, err := fn(subscription)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.QueryRow(ctx, updateSubscriptionQuery, updateSubscriptionArgs(subscription)...).
+		Scan(&subscription.UpdatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update subscription: %w", err)
+	}
+
+	if err := recordVersion(ctx, tx, subscription, reason); err != nil {
+		return nil, err
+	}
+
+	return subscription, nil
+}
+
+func (r *SubscriptionRepository) ProcessDue(ctx context.Context, kind string, now time.Time, limit int, fn domain.SubscriptionChange) ([]*domain.Subscription, error) {
+	condition, ok := dueConditions[kind]
+	if !ok {
+		return nil, fmt.Errorf("unknown due kind: %s", kind)
+	}
+
+	// The scheduler works through every tenant's subscriptions
+	tx, err := r.tenantDB.Begin(tenancy.WithoutTenant(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// SKIP LOCKED lets several scheduler replicas work on disjoint batches.
+	// Rows that are backed off after a failure wait for their due_retry_at.
+	// Subscriptions of a deleted tenant are left as they are during its
+	// grace period and catch up if it is restored.
+	query := `SELECT ` + subscriptionColumns + ` FROM subscriptions WHERE (` + condition + `)
+		AND (due_retry_at IS NULL OR due_retry_at <= $1)
+		AND NOT EXISTS (
+			SELECT 1 FROM tenants t
+			WHERE t.id = subscriptions.tenant_id AND t.deleted_at IS NOT NULL
+		)
+		ORDER BY id
+		LIMIT $2
+		FOR UPDATE SKIP LOCKED`
+
+	rows, err := tx.Query(ctx, query, now, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock due subscriptions: %w", err)
+	}
+
+	due := make([]*domain.Subscription, 0)
+	for rows.Next() {
+		subscription := &domain.Subscription{}
+		if err := scanSubscription(rows, subscription); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan subscription: %w", err)
+		}
+		due = append(due, subscription)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating due subscriptions: %w", err)
+	}
+
+	processed := make([]*domain.Subscription, 0, len(due))
+	for _, subscription := range due {
+		// Rows rejected by fn keep their data and are backed off, so they
+		// do not take up the next batch
+		reason, err := fn(subscription)
+		if err != nil {
+			if _, err := tx.Exec(ctx, backOffDueQuery, subscription.ID, now); err != nil {
+				return nil, fmt.Errorf("failed to back off due subscription: %w", err)
+			}
+			continue
+		}
+
+		err = tx.QueryRow(ctx, updateSubscriptionQuery, updateSubscriptionArgs(subscription)...).
+			Scan(&subscription.UpdatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update due subscription: %w", err)
+		}
+		if err := recordVersion(ctx, tx, subscription, reason); err != nil {
+			return nil, err
+		}
+		processed = append(processed, subscription)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit due subscriptions: %w", err)
+	}
+
+	return processed, nil
+}
+
+func (r *SubscriptionRepository) DeleteTenantVersions(ctx context.Context, tenantID int64) error {
+	// subscription_versions has no foreign key, so the purge of the tenant
+	// would leave the history of its subscriptions pointing at nothing
+	query := `
+		DELETE FROM subscription_versions
+		WHERE versionable_type = $1
+		  AND versionable_id IN (SELECT id::text FROM subscriptions WHERE tenant_id = $2)
+	`
+	if _, err := r.tenantDB.Exec(ctx, query, domain.VersionableTypeSubscription, tenantID); err != nil {
+		return fmt.Errorf("failed to delete subscription versions: %w", err)
+	}
+
+	return nil
+}
+
+// dueConditions select the rows each scheduler job works on; $1 is "now".
+// Each job moves a row out of its own condition, which keeps reruns idempotent.
+var dueConditions = map[string]string{
+	domain.DueTrialEnd: `status = 'trialing' AND trial_ends_at IS NOT NULL AND trial_ends_at <= $1`,
+	domain.DueExpiry: `ends_at IS NOT NULL AND ends_at <= $1 AND (
+		status = 'cancelled' OR
+		(is_canceled_at_end_of_cycle = TRUE AND status IN ('active', 'past_due', 'paused'))
+	)`,
+	domain.DueRenewal: `status = 'active' AND is_canceled_at_end_of_cycle = FALSE
+		AND ends_at IS NOT NULL AND ends_at <= $1`,
+}
+
+// backOffDueQuery delays the next scheduler attempt on a failing row ($1) by
+// a minute after "now" ($2), doubling with each failure up to a day
+const backOffDueQuery = `
+	UPDATE subscriptions
+	SET due_failures = due_failures + 1,
+	    due_retry_at = $2::timestamp + LEAST(INTERVAL '1 minute' * POWER(2, LEAST(due_failures, 11)), INTERVAL '1 day')
+	WHERE id = $1
+`
+
+const updateSubscriptionQuery = `
+	UPDATE subscriptions
+	SET price = $1, ends_at = $2, cancelled_at = $3, payment_provider_subscription_id = $4,
+	    payment_provider_status = $5, payment_provider_id = $6, trial_ends_at = $7,
+	    status = $8, is_canceled_at_end_of_cycle = $9, cancellation_reason = $10,
+	    cancellation_additional_info = $11, quantity = $12, price_type = $13,
+	    price_tiers = $14, price_per_unit = $15, extra_payment_provider_data = $16,
+	    comments = $17, metadata = $18, plan_id = $19, interval_id = $20,
+	    interval_count = $21, scheduled_plan_id = $22, scheduled_quantity = $23,
+	    billing_anchor = $24, due_failures = 0, due_retry_at = NULL, updated_at = NOW()
+	WHERE id = $25
+	RETURNING updated_at
+`
+
+func updateSubscriptionArgs(subscription *domain.Subscription) []interface{} {
+	return []interface{}{
+		subscription.Price,
+		subscription.EndsAt,
+		subscription.CancelledAt,
+		subscription.PaymentProviderSubscriptionID,
+		subscription.PaymentProviderStatus,
+		subscription.PaymentProviderID,
+		subscription.TrialEndsAt,
+		subscription.Status,
+		subscription.IsCanceledAtEndOfCycle,
+		subscription.CancellationReason,
+		subscription.CancellationAdditionalInfo,
+		subscription.Quantity,
+		subscription.PriceType,
+		subscription.PriceTiers,
+		subscription.PricePerUnit,
+		subscription.ExtraPaymentProviderData,
+		subscription.Comments,
+		subscription.Metadata,
+		subscription.PlanID,
+		subscription.IntervalID,
+		subscription.IntervalCount,
+		subscription.ScheduledPlanID,
+		subscription.ScheduledQuantity,
+		subscription.BillingAnchor,
+		subscription.ID,
+	}
+}
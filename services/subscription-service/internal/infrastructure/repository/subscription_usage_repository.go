@@ -0,0 +1,164 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/damarteplok/damar-admin-cms/services/subscription-service/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type SubscriptionUsageRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewSubscriptionUsageRepository(db *pgxpool.Pool) domain.SubscriptionUsageRepository {
+	return &SubscriptionUsageRepository{db: db}
+}
+
+func (r *SubscriptionUsageRepository) Create(ctx context.Context, usage *domain.SubscriptionUsage) (bool, error) {
+	query := `
+		INSERT INTO subscription_usages (subscription_id, plan_meter_id, unit_count, idempotency_key, recorded_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, NOW(), NOW())
+		ON CONFLICT (subscription_id, idempotency_key) WHERE idempotency_key IS NOT NULL DO NOTHING
+		RETURNING id, created_at, updated_at
+	`
+
+	err := r.db.QueryRow(ctx, query,
+		usage.SubscriptionID,
+		usage.MeterID,
+		usage.UnitCount,
+		usage.IdempotencyKey,
+		usage.RecordedAt,
+	).Scan(&usage.ID, &usage.CreatedAt, &usage.UpdatedAt)
+	if err == nil {
+		return true, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return false, fmt.Errorf("failed to record subscription usage: %w", err)
+	}
+
+	// The idempotency key was already used, return the original record
+	existingQuery := `
+		SELECT id, subscription_id, plan_meter_id, unit_count, idempotency_key, recorded_at, created_at, updated_at
+		FROM subscription_usages
+		WHERE subscription_id = $1 AND idempotency_key = $2
+	`
+	if err := scanSubscriptionUsage(r.db.QueryRow(ctx, existingQuery, usage.SubscriptionID, usage.IdempotencyKey), usage); err != nil {
+		return false, fmt.Errorf("failed to get subscription usage by idempotency key: %w", err)
+	}
+
+	return false, nil
+}
+
+func (r *SubscriptionUsageRepository) GetBySubscription(ctx context.Context, subscriptionID int64, page, perPage int) ([]*domain.SubscriptionUsage, int, error) {
+	offset := (page - 1) * perPage
+
+	var total int
+	countQuery := `SELECT COUNT(*) FROM subscription_usages WHERE subscription_id = $1`
+	if err := r.db.QueryRow(ctx, countQuery, subscriptionID).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count subscription usages: %w", err)
+	}
+
+	query := `
+		SELECT id, subscription_id, plan_meter_id, unit_count, idempotency_key, recorded_at, created_at, updated_at
+		FROM subscription_usages
+		WHERE subscription_id = $1
+		ORDER BY recorded_at DESC, id DESC
+		LIMIT $2 OFFSET $3
+	`
+
+	rows, err := r.db.Query(ctx, query, subscriptionID, perPage, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get subscription usages: %w", err)
+	}
+	defer rows.Close()
+
+	usages := make([]*domain.SubscriptionUsage, 0)
+	for rows.Next() {
+		usage := &domain.SubscriptionUsage{}
+		if err := scanSubscriptionUsage(rows, usage); err != nil {
+			return nil, 0, fmt.Errorf("failed to scan subscription usage: %w", err)
+		}
+		usages = append(usages, usage)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating subscription usages: %w", err)
+	}
+
+	return usages, total, nil
+}
+
+func (r *SubscriptionUsageRepository) Summarize(ctx context.Context, subscriptionID int64, from, to time.Time) ([]*domain.UsageSummary, error) {
+	query := `
+		SELECT m.id, m.name, m.aggregation,
+		       CASE m.aggregation
+		           WHEN 'max' THEN MAX(u.unit_count)
+		           WHEN 'last' THEN (ARRAY_AGG(u.unit_count ORDER BY u.recorded_at DESC, u.id DESC))[1]
+		           ELSE SUM(u.unit_count)
+		       END,
+		       COUNT(u.id), MAX(u.recorded_at)
+		FROM subscription_usages u
+		JOIN plan_meters m ON m.id = u.plan_meter_id
+		WHERE u.subscription_id = $1 AND u.recorded_at >= $2 AND u.recorded_at < $3
+		GROUP BY m.id, m.name, m.aggregation
+		ORDER BY m.id
+	`
+
+	rows, err := r.db.Query(ctx, query, subscriptionID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to summarize subscription usage: %w", err)
+	}
+	defer rows.Close()
+
+	summaries := make([]*domain.UsageSummary, 0)
+	for rows.Next() {
+		summary := &domain.UsageSummary{}
+		err := rows.Scan(
+			&summary.MeterID,
+			&summary.MeterName,
+			&summary.Aggregation,
+			&summary.Quantity,
+			&summary.RecordCount,
+			&summary.LastRecordedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan usage summary: %w", err)
+		}
+		summaries = append(summaries, summary)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating usage summaries: %w", err)
+	}
+
+	return summaries, nil
+}
+
+func (r *SubscriptionUsageRepository) GetMeter(ctx context.Context, id int64) (*domain.PlanMeter, error) {
+	query := `SELECT id, name, aggregation FROM plan_meters WHERE id = $1`
+
+	meter := &domain.PlanMeter{}
+	if err := r.db.QueryRow(ctx, query, id).Scan(&meter.ID, &meter.Name, &meter.Aggregation); err != nil {
+		return nil, fmt.Errorf("failed to get plan meter by ID: %w", err)
+	}
+
+	return meter, nil
+}
+
+func scanSubscriptionUsage(row pgx.Row, usage *domain.SubscriptionUsage) error {
+	return row.Scan(
+		&usage.ID,
+		&usage.SubscriptionID,
+		&usage.MeterID,
+		&usage.UnitCount,
+		&usage.IdempotencyKey,
+		&usage.RecordedAt,
+		&usage.CreatedAt,
+		&usage.UpdatedAt,
+	)
+}
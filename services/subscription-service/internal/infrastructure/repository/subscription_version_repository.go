@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/damarteplok/damar-admin-cms/services/subscription-service/internal/domain"
+	"github.com/damarteplok/damar-admin-cms/shared/database"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type SubscriptionVersionRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewSubscriptionVersionRepository(db *pgxpool.Pool) domain.SubscriptionVersionRepository {
+	return &SubscriptionVersionRepository{db: db}
+}
+
+func (r *SubscriptionVersionRepository) Create(ctx context.Context, version *domain.SubscriptionVersion) error {
+	return insertVersion(ctx, r.db, version)
+}
+
+// insertVersion writes an audit entry with q, which is the transaction of the
+// change it records when called from SubscriptionRepository
+func insertVersion(ctx context.Context, q database.Querier, version *domain.SubscriptionVersion) error {
+	query := `
+		INSERT INTO subscription_versions (versionable_id, versionable_type, user_id, model_data,
+		                                   reason, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, NOW(), NOW())
+		RETURNING version_id, created_at, updated_at
+	`
+
+	err := q.QueryRow(
+		ctx,
+		query,
+		version.VersionableID,
+		version.VersionableType,
+		version.UserID,
+		version.ModelData,
+		version.Reason,
+	).Scan(&version.VersionID, &version.CreatedAt, &version.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create subscription version: %w", err)
+	}
+
+	return nil
+}
+
+// recordVersion snapshots the saved subscription into subscription_versions
+// inside tx
+func recordVersion(ctx context.Context, tx pgx.Tx, subscription *domain.Subscription, reason string) error {
+	version, err := domain.NewSubscriptionVersion(subscription, reason)
+	if err != nil {
+		return err
+	}
+	return insertVersion(ctx, tx, version)
+}
+
+func (r *SubscriptionVersionRepository) GetByVersionable(ctx context.Context, versionableType, versionableID string, page, perPage int) ([]*domain.SubscriptionVersion, int, error) {
+	offset := (page - 1) * perPage
+
+	var total int
+	countQuery := `
+		SELECT COUNT(*) FROM subscription_versions
+		WHERE versionable_type = $1 AND versionable_id = $2
+	`
+	if err := r.db.QueryRow(ctx, countQuery, versionableType, versionableID).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count subscription versions: %w", err)
+	}
+
+	query := `
+		SELECT version_id, versionable_id, versionable_type, user_id, model_data, reason,
+		       created_at, updated_at
+		FROM subscription_versions
+		WHERE versionable_type = $1 AND versionable_id = $2
+		ORDER BY version_id DESC
+		LIMIT $3 OFFSET $4
+	`
+
+	rows, err := r.db.Query(ctx, query, versionableType, versionableID, perPage, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get subscription versions: %w", err)
+	}
+	defer rows.Close()
+
+	versions := make([]*domain.SubscriptionVersion, 0)
+	for rows.Next() {
+		version := &domain.SubscriptionVersion{}
+		err := rows.Scan(
+			&version.VersionID,
+			&version.VersionableID,
+			&version.VersionableType,
+			&version.UserID,
+			&version.ModelData,
+			&version.Reason,
+			&version.CreatedAt,
+			&version.UpdatedAt,
+		)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan subscription version: %w", err)
+		}
+		versions = append(versions, version)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating subscription versions: %w", err)
+	}
+
+	return versions, total, nil
+}
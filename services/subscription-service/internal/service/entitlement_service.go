@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/damarteplok/damar-admin-cms/services/subscription-service/internal/domain"
+	"github.com/damarteplok/damar-admin-cms/shared/entitlement"
+	"github.com/damarteplok/damar-admin-cms/shared/pricing"
+)
+
+type entitlementService struct {
+	subscriptionRepo domain.SubscriptionRepository
+	planRepo         domain.PlanRepository
+	usageService     domain.SubscriptionUsageService
+}
+
+func NewEntitlementService(
+	subscriptionRepo domain.SubscriptionRepository,
+	planRepo domain.PlanRepository,
+	usageService domain.SubscriptionUsageService,
+) domain.EntitlementService {
+	return &entitlementService{
+		subscriptionRepo: subscriptionRepo,
+		planRepo:         planRepo,
+		usageService:     usageService,
+	}
+}
+
+func (s *entitlementService) Check(ctx context.Context, tenantID int64, featureKey string, usage int64) (*domain.EntitlementCheck, error) {
+	if tenantID <= 0 {
+		return nil, errors.New("invalid tenant ID")
+	}
+	featureKey = strings.TrimSpace(featureKey)
+	if err := entitlement.ValidateKey(featureKey); err != nil {
+		return nil, err
+	}
+	if usage < 0 {
+		return nil, errors.New("usage cannot be negative")
+	}
+
+	check := &domain.EntitlementCheck{
+		TenantID:   tenantID,
+		FeatureKey: featureKey,
+	}
+
+	subscription, err := s.subscriptionRepo.GetActiveByTenant(ctx, tenantID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if subscription == nil {
+		check.Reason = "tenant has no active subscription"
+		return check, nil
+	}
+	check.Subscription = subscription
+
+	plan, err := s.planRepo.GetByID(ctx, subscription.PlanID)
+	if err != nil {
+		return nil, err
+	}
+
+	planEntitlement, err := s.planRepo.GetEntitlement(ctx, plan.ID, featureKey)
+	if err != nil {
+		return nil, err
+	}
+	if planEntitlement == nil && featureKey == entitlement.KeySeats {
+		planEntitlement = seatEntitlement(plan, subscription)
+	}
+	if planEntitlement == nil {
+		check.Reason = "feature is not included in the plan"
+		return check, nil
+	}
+	check.Type = planEntitlement.Type
+
+	used := usage
+	if planEntitlement.Type == entitlement.TypeQuota {
+		used, err = s.quotaUsage(ctx, subscription, plan, planEntitlement)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	check.Result = entitlement.Evaluate(planEntitlement.Type, planEntitlement.IsEnabled, planEntitlement.LimitValue, used)
+	if !check.Allowed {
+		switch {
+		case !planEntitlement.IsEnabled:
+			check.Reason = "feature is disabled on the plan"
+		case planEntitlement.Type == entitlement.TypeQuota:
+			check.Reason = "quota for the current billing period is used up"
+		default:
+			check.Reason = "limit reached"
+		}
+	}
+
+	return check, nil
+}
+
+// quotaUsage returns the usage of the quota's meter in the current billing
+// period
+func (s *entitlementService) quotaUsage(ctx context.Context, subscription *domain.Subscription, plan *domain.Plan, planEntitlement *domain.PlanEntitlement) (int64, error) {
+	meterID := planEntitlement.MeterID
+	if meterID == nil {
+		meterID = plan.MeterID
+	}
+	if meterID == nil {
+		return 0, errors.New("quota has no meter")
+	}
+
+	summaries, _, _, err := s.usageService.GetUsageSummary(ctx, subscription.ID, nil, nil)
+	if err != nil {
+		return 0, err
+	}
+	for _, summary := range summaries {
+		if summary.MeterID == *meterID {
+			return summary.Quantity, nil
+		}
+	}
+	return 0, nil
+}
+
+// seatEntitlement is the built-in seat limit for plans that do not define
+// one: the quantity bought for per-unit prices, otherwise the plan's
+// max_users_per_tenant, where 0 is unlimited
+func seatEntitlement(plan *domain.Plan, subscription *domain.Subscription) *domain.PlanEntitlement {
+	seats := &domain.PlanEntitlement{
+		PlanID:     plan.ID,
+		FeatureKey: entitlement.KeySeats,
+		Type:       entitlement.TypeLimit,
+		IsEnabled:  true,
+	}
+
+	var limit int64
+	if subscription.PriceType == pricing.TypePerUnit {
+		limit = int64(subscription.Quantity)
+	} else {
+		limit = int64(plan.MaxUsersPerTenant)
+	}
+	if limit > 0 {
+		seats.LimitValue = &limit
+	}
+	return seats
+}
@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/damarteplok/damar-admin-cms/services/subscription-service/internal/domain"
+	"github.com/damarteplok/damar-admin-cms/shared/contracts"
+	"github.com/damarteplok/damar-admin-cms/shared/tenancy"
+)
+
+// GrantBonusDays pushes back the end of the user's current period by days.
+// Trialing subscriptions get a longer trial instead. It returns nil when the
+// user has no active or trialing subscription to extend, and
+// domain.ErrBonusAlreadyGranted when key has been granted before.
+func (s *subscriptionService) GrantBonusDays(ctx context.Context, userID int64, days int32, key, reason string) (*domain.Subscription, error) {
+	if userID <= 0 {
+		return nil, errors.New("invalid user ID")
+	}
+	if days <= 0 {
+		return nil, errors.New("bonus days must be greater than 0")
+	}
+
+	subscriptions, _, err := s.repo.GetByUser(ctx, userID, 0, 1, 100)
+	if err != nil {
+		return nil, err
+	}
+
+	var candidateID int64
+	for _, candidate := range subscriptions {
+		if isExtendable(candidate) {
+			candidateID = candidate.ID
+			// The user's subscriptions span tenants; the grant is made in
+			// the candidate's
+			ctx = tenancy.WithTenantID(ctx, candidate.TenantID)
+			break
+		}
+	}
+	if candidateID == 0 {
+		return nil, nil
+	}
+
+	bonus := time.Duration(days) * 24 * time.Hour
+	note := fmt.Sprintf("bonus_days: %d", days)
+	if reason != "" {
+		note = fmt.Sprintf("%s (%s)", note, reason)
+	}
+	extend := func(subscription *domain.Subscription) (string, error) {
+		// The subscription may have changed since it was listed
+		if !isExtendable(subscription) {
+			return "", errors.New("subscription is no longer active")
+		}
+
+		if subscription.Status == domain.SubscriptionStatusTrialing && subscription.TrialEndsAt != nil {
+			trialEndsAt := subscription.TrialEndsAt.Add(bonus)
+			subscription.TrialEndsAt = &trialEndsAt
+		}
+		if subscription.EndsAt != nil {
+			endsAt := subscription.EndsAt.Add(bonus)
+			subscription.EndsAt = &endsAt
+		}
+		// Later periods are counted from the extended end as well
+		if subscription.BillingAnchor != nil {
+			anchor := subscription.BillingAnchor.Add(bonus)
+			subscription.BillingAnchor = &anchor
+		}
+		return note, nil
+	}
+
+	var subscription *domain.Subscription
+	if key == "" {
+		subscription, err = s.repo.Update(ctx, candidateID, extend)
+	} else {
+		subscription, err = s.repo.GrantBonus(ctx, candidateID, key, days, extend)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishEvent(ctx, contracts.SubscriptionEventUpdated, subscription, subscription.Status, note)
+	return subscription, nil
+}
+
+func isExtendable(subscription *domain.Subscription) bool {
+	return subscription.Status == domain.SubscriptionStatusActive || subscription.Status == domain.SubscriptionStatusTrialing
+}
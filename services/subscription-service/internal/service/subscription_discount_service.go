@@ -0,0 +1,77 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/damarteplok/damar-admin-cms/services/subscription-service/internal/domain"
+)
+
+type subscriptionDiscountService struct {
+	repo             domain.SubscriptionDiscountRepository
+	subscriptionRepo domain.SubscriptionRepository
+}
+
+func NewSubscriptionDiscountService(repo domain.SubscriptionDiscountRepository, subscriptionRepo domain.SubscriptionRepository) domain.SubscriptionDiscountService {
+	return &subscriptionDiscountService{
+		repo:             repo,
+		subscriptionRepo: subscriptionRepo,
+	}
+}
+
+func (s *subscriptionDiscountService) AddDiscount(ctx context.Context, discount *domain.SubscriptionDiscount) error {
+	if discount == nil {
+		return errors.New("subscription discount cannot be nil")
+	}
+	if discount.SubscriptionID <= 0 {
+		return errors.New("invalid subscription ID")
+	}
+	if discount.DiscountID <= 0 {
+		return errors.New("invalid discount ID")
+	}
+	if discount.Type != "percentage" && discount.Type != "fixed" {
+		return fmt.Errorf("invalid discount type: %s", discount.Type)
+	}
+	if discount.Amount <= 0 {
+		return errors.New("discount amount must be greater than 0")
+	}
+	if discount.Type == "percentage" && discount.Amount > 100 {
+		return errors.New("percentage discount cannot exceed 100")
+	}
+	if discount.ValidUntil != nil && discount.ValidUntil.Before(time.Now()) {
+		return errors.New("valid_until must be in the future")
+	}
+
+	subscription, err := s.subscriptionRepo.GetByID(ctx, discount.SubscriptionID)
+	if err != nil {
+		return err
+	}
+	if subscription == nil {
+		return errors.New("subscription not found")
+	}
+
+	return s.repo.Create(ctx, discount)
+}
+
+func (s *subscriptionDiscountService) GetByID(ctx context.Context, id int64) (*domain.SubscriptionDiscount, error) {
+	if id <= 0 {
+		return nil, errors.New("invalid subscription discount ID")
+	}
+	return s.repo.GetByID(ctx, id)
+}
+
+func (s *subscriptionDiscountService) RemoveDiscount(ctx context.Context, id int64) error {
+	if id <= 0 {
+		return errors.New("invalid subscription discount ID")
+	}
+	return s.repo.Delete(ctx, id)
+}
+
+func (s *subscriptionDiscountService) GetBySubscription(ctx context.Context, subscriptionID int64) ([]*domain.SubscriptionDiscount, error) {
+	if subscriptionID <= 0 {
+		return nil, errors.New("invalid subscription ID")
+	}
+	return s.repo.GetBySubscription(ctx, subscriptionID)
+}
@@ -0,0 +1,362 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/damarteplok/damar-admin-cms/services/subscription-service/internal/domain"
+	"github.com/damarteplok/damar-admin-cms/shared/contracts"
+	"github.com/damarteplok/damar-admin-cms/shared/pricing"
+	"github.com/damarteplok/damar-admin-cms/shared/util"
+)
+
+// planChange is the outcome of pricing a move to another plan
+type planChange struct {
+	plan      *domain.Plan
+	price     *domain.PlanPrice
+	quantity  int32
+	proration domain.Proration
+	endsAt    *time.Time // period end once the change is applied immediately
+	anchor    *time.Time // billing anchor once the change is applied immediately
+	upgrade   bool
+}
+
+// ChangePlan moves a subscription to another plan. Upgrades apply right away
+// and are prorated against the rest of the current period; downgrades wait
+// for the next renewal unless mode says otherwise.
+func (s *subscriptionService) ChangePlan(ctx context.Context, id, planID int64, quantity int32, mode string) (*domain.Subscription, *domain.Proration, error) {
+	if id <= 0 {
+		return nil, nil, errors.New("invalid subscription ID")
+	}
+	if planID <= 0 {
+		return nil, nil, errors.New("invalid plan ID")
+	}
+	if mode != "" && mode != domain.PlanChangeModeNow && mode != domain.PlanChangeModePeriodEnd {
+		return nil, nil, fmt.Errorf("invalid plan change mode: %s", mode)
+	}
+
+	// The outcome is decided while the subscription is locked and announced
+	// once it has been saved
+	var (
+		previousPlanID int64
+		proration      *domain.Proration
+		routingKey     string
+		reason         string
+	)
+	now := time.Now()
+	subscription, err := s.repo.Update(ctx, id, func(subscription *domain.Subscription) (string, error) {
+		switch subscription.Status {
+		case domain.SubscriptionStatusActive, domain.SubscriptionStatusTrialing:
+		default:
+			return "", fmt.Errorf("subscription cannot change plan from status %s", subscription.Status)
+		}
+		if subscription.IsCanceledAtEndOfCycle {
+			return "", errors.New("subscription is set to cancel at the end of the cycle")
+		}
+
+		if quantity < 1 {
+			quantity = subscription.Quantity
+		}
+
+		if planID == subscription.PlanID && quantity == subscription.Quantity {
+			if subscription.ScheduledPlanID == nil {
+				return "", errors.New("subscription is already on this plan")
+			}
+
+			// Choosing the current plan again drops the pending change
+			previousPlanID = *subscription.ScheduledPlanID
+			subscription.ScheduledPlanID = nil
+			subscription.ScheduledQuantity = nil
+
+			proration = &domain.Proration{Mode: domain.PlanChangeModeNow, EffectiveAt: now}
+			routingKey, reason = contracts.SubscriptionEventUpdated, "plan change cancelled"
+			return reason, nil
+		}
+
+		change, err := s.quotePlanChange(ctx, subscription, planID, quantity, now)
+		if err != nil {
+			return "", err
+		}
+
+		if mode == "" {
+			mode = domain.PlanChangeModePeriodEnd
+			if change.upgrade {
+				mode = domain.PlanChangeModeNow
+			}
+		}
+
+		previousPlanID = subscription.PlanID
+		if mode == domain.PlanChangeModePeriodEnd {
+			if subscription.EndsAt == nil {
+				return "", errors.New("subscription has no period end to schedule the plan change for")
+			}
+
+			subscription.ScheduledPlanID = &change.plan.ID
+			subscription.ScheduledQuantity = &change.quantity
+
+			// Nothing is owed until the new plan starts with a full period
+			proration = &domain.Proration{Mode: mode, EffectiveAt: *subscription.EndsAt}
+			routingKey, reason = contracts.SubscriptionEventPlanChangeScheduled, "plan change scheduled"
+			return reason, nil
+		}
+
+		if err := applyPlan(subscription, change.plan, change.price, change.quantity); err != nil {
+			return "", err
+		}
+		subscription.EndsAt = change.endsAt
+		subscription.BillingAnchor = change.anchor
+
+		proration = &change.proration
+		proration.Mode = mode
+		proration.EffectiveAt = now
+		proration.PeriodEnd = subscription.EndsAt
+		routingKey, reason = contracts.SubscriptionEventPlanChanged, "plan changed"
+		return reason, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	s.publishPlanChange(ctx, routingKey, subscription, previousPlanID, proration, reason)
+	return subscription, proration, nil
+}
+
+// quotePlanChange prices a move to planID at now. The credit is the unused
+// share of the current period at the old price; the charge is the same share
+// at the new price, or a full new period when the billing interval changes.
+func (s *subscriptionService) quotePlanChange(ctx context.Context, subscription *domain.Subscription, planID int64, quantity int32, now time.Time) (*planChange, error) {
+	plan, err := s.planRepo.GetByID(ctx, planID)
+	if err != nil {
+		return nil, err
+	}
+	if !plan.IsActive {
+		return nil, errors.New("plan is not active")
+	}
+
+	price, err := s.planRepo.GetPrice(ctx, plan.ID, subscription.CurrencyID)
+	if err != nil {
+		return nil, fmt.Errorf("plan has no price in the subscription currency: %w", err)
+	}
+
+	currentPrice, err := subscriptionPrice(subscription)
+	if err != nil {
+		return nil, err
+	}
+	oldAmount, err := currentPrice.Amount(int64(subscription.Quantity))
+	if err != nil {
+		return nil, err
+	}
+	newPrice, err := planPrice(price)
+	if err != nil {
+		return nil, err
+	}
+	newAmount, err := newPrice.Amount(int64(quantity))
+	if err != nil {
+		return nil, err
+	}
+
+	oldInterval, err := s.intervalRepo.GetByID(ctx, subscription.IntervalID)
+	if err != nil {
+		return nil, err
+	}
+	newInterval, err := s.intervalRepo.GetByID(ctx, plan.IntervalID)
+	if err != nil {
+		return nil, err
+	}
+
+	oldPeriodEnd, err := pricing.NextPeriodEnd(now, oldInterval.Slug, subscription.IntervalCount)
+	if err != nil {
+		return nil, err
+	}
+	newPeriodEnd, err := pricing.NextPeriodEnd(now, newInterval.Slug, plan.IntervalCount)
+	if err != nil {
+		return nil, err
+	}
+
+	// Compare cost per unit of time so a yearly plan is not mistaken for an
+	// upgrade over a monthly one just because its period price is higher
+	oldRate := float64(oldAmount) / float64(oldPeriodEnd.Sub(now))
+	newRate := float64(newAmount) / float64(newPeriodEnd.Sub(now))
+
+	change := &planChange{
+		plan:     plan,
+		price:    price,
+		quantity: quantity,
+		endsAt:   subscription.EndsAt,
+		anchor:   subscription.BillingAnchor,
+		upgrade:  newRate > oldRate,
+	}
+	samePeriod := plan.IntervalID == subscription.IntervalID && plan.IntervalCount == subscription.IntervalCount
+
+	switch {
+	case subscription.Status == domain.SubscriptionStatusTrialing:
+		// Nothing has been paid yet; only the first paid period moves
+		if !samePeriod && subscription.TrialEndsAt != nil {
+			endsAt, err := pricing.NextPeriodEnd(*subscription.TrialEndsAt, newInterval.Slug, plan.IntervalCount)
+			if err != nil {
+				return nil, err
+			}
+			change.endsAt = &endsAt
+			change.anchor = subscription.TrialEndsAt
+		}
+
+	case subscription.EndsAt == nil || !subscription.EndsAt.After(now):
+		change.proration.ChargeAmount = newAmount
+		change.proration.PeriodStart = &now
+		change.endsAt = &newPeriodEnd
+		change.anchor = &now
+
+	default:
+		periodStart, err := pricing.PeriodStart(billingAnchor(subscription), oldInterval.Slug, subscription.IntervalCount, *subscription.EndsAt)
+		if err != nil {
+			return nil, err
+		}
+		period := subscription.EndsAt.Sub(periodStart)
+		remaining := subscription.EndsAt.Sub(now)
+		if remaining > period {
+			remaining = period
+		}
+
+		change.proration.RemainingRatio = float64(remaining) / float64(period)
+		change.proration.CreditAmount = pricing.Prorate(oldAmount, remaining, period)
+		change.proration.UsedAmount = oldAmount - change.proration.CreditAmount
+		change.proration.PreviousPeriodStart = &periodStart
+		if samePeriod {
+			change.proration.ChargeAmount = pricing.Prorate(newAmount, remaining, period)
+			change.proration.PeriodStart = &periodStart
+		} else {
+			change.proration.ChargeAmount = newAmount
+			change.proration.PeriodStart = &now
+			change.endsAt = &newPeriodEnd
+			change.anchor = &now
+		}
+	}
+	change.proration.NetAmount = change.proration.ChargeAmount - change.proration.CreditAmount
+
+	return change, nil
+}
+
+// applyScheduledPlan switches a subscription to the plan change stored by
+// ChangePlan, returning the plan it was on before
+func (s *subscriptionService) applyScheduledPlan(ctx context.Context, subscription *domain.Subscription) (int64, error) {
+	previousPlanID := subscription.PlanID
+
+	plan, err := s.planRepo.GetByID(ctx, *subscription.ScheduledPlanID)
+	if err != nil {
+		return 0, err
+	}
+	price, err := s.planRepo.GetPrice(ctx, plan.ID, subscription.CurrencyID)
+	if err != nil {
+		return 0, fmt.Errorf("plan has no price in the subscription currency: %w", err)
+	}
+
+	quantity := subscription.Quantity
+	if subscription.ScheduledQuantity != nil {
+		quantity = *subscription.ScheduledQuantity
+	}
+	samePeriod := plan.IntervalID == subscription.IntervalID && plan.IntervalCount == subscription.IntervalCount
+	if err := applyPlan(subscription, plan, price, quantity); err != nil {
+		return 0, err
+	}
+
+	// The new plan's periods are counted from the end of the last old one
+	if !samePeriod && subscription.EndsAt != nil {
+		anchor := *subscription.EndsAt
+		subscription.BillingAnchor = &anchor
+	}
+
+	return previousPlanID, nil
+}
+
+func (s *subscriptionService) publishPlanChange(ctx context.Context, routingKey string, subscription *domain.Subscription, previousPlanID int64, proration *domain.Proration, reason string) {
+	eventData := subscriptionEventData(subscription, subscription.Status, reason)
+	eventData["previous_plan_id"] = previousPlanID
+	eventData["scheduled_plan_id"] = subscription.ScheduledPlanID
+	eventData["quantity"] = subscription.Quantity
+	if proration != nil {
+		eventData["proration"] = map[string]interface{}{
+			"credit_amount":         proration.CreditAmount,
+			"charge_amount":         proration.ChargeAmount,
+			"net_amount":            proration.NetAmount,
+			"remaining_ratio":       proration.RemainingRatio,
+			"mode":                  proration.Mode,
+			"effective_at":          util.TimeToUnix(&proration.EffectiveAt),
+			"used_amount":           proration.UsedAmount,
+			"previous_period_start": util.TimeToUnix(proration.PreviousPeriodStart),
+			"period_start":          util.TimeToUnix(proration.PeriodStart),
+			"period_end":            util.TimeToUnix(proration.PeriodEnd),
+		}
+	}
+	s.publish(ctx, routingKey, subscription, eventData)
+}
+
+// applyPlan copies the plan's billing terms onto the subscription and clears
+// any pending change
+func applyPlan(subscription *domain.Subscription, plan *domain.Plan, price *domain.PlanPrice, quantity int32) error {
+	if !isValidPriceType(price.Type) {
+		return fmt.Errorf("invalid price type: %s", price.Type)
+	}
+
+	subscription.PlanID = plan.ID
+	subscription.IntervalID = plan.IntervalID
+	subscription.IntervalCount = plan.IntervalCount
+	subscription.Quantity = quantity
+	subscription.Price = price.Price
+	subscription.PriceType = price.Type
+	subscription.PriceTiers = price.Tiers
+	subscription.PricePerUnit = nil
+	if price.PricePerUnit != nil {
+		pricePerUnit := strconv.Itoa(int(*price.PricePerUnit))
+		subscription.PricePerUnit = &pricePerUnit
+	}
+	subscription.ScheduledPlanID = nil
+	subscription.ScheduledQuantity = nil
+	return nil
+}
+
+// subscriptionPrice reads the billing terms stored on the subscription
+func subscriptionPrice(subscription *domain.Subscription) (pricing.Price, error) {
+	price := pricing.Price{
+		Type:  subscription.PriceType,
+		Price: int64(subscription.Price),
+	}
+
+	if subscription.PricePerUnit != nil && *subscription.PricePerUnit != "" {
+		pricePerUnit, err := strconv.ParseInt(*subscription.PricePerUnit, 10, 64)
+		if err != nil {
+			return pricing.Price{}, fmt.Errorf("invalid price per unit: %s", *subscription.PricePerUnit)
+		}
+		price.PricePerUnit = &pricePerUnit
+	}
+
+	tiers, err := pricing.ParseTiers(subscription.PriceTiers)
+	if err != nil {
+		return pricing.Price{}, err
+	}
+	price.Tiers = tiers
+
+	return price, nil
+}
+
+func planPrice(price *domain.PlanPrice) (pricing.Price, error) {
+	result := pricing.Price{
+		Type:  price.Type,
+		Price: int64(price.Price),
+	}
+
+	if price.PricePerUnit != nil {
+		pricePerUnit := int64(*price.PricePerUnit)
+		result.PricePerUnit = &pricePerUnit
+	}
+
+	tiers, err := pricing.ParseTiers(price.Tiers)
+	if err != nil {
+		return pricing.Price{}, err
+	}
+	result.Tiers = tiers
+
+	return result, nil
+}
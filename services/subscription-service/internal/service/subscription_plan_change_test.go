@@ -0,0 +1,84 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/damarteplok/damar-admin-cms/services/subscription-service/internal/domain"
+	"github.com/damarteplok/damar-admin-cms/shared/pricing"
+)
+
+func TestChangePlanNowProration(t *testing.T) {
+	anchor := time.Now().AddDate(0, 0, -10).Truncate(time.Second)
+	endsAt, err := pricing.PeriodEnd(anchor, "monthly", 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name         string
+		planID       int64
+		wantNewStart bool // the change starts a new period
+	}{
+		{"same interval", 3, false},
+		{"yearly plan", 4, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeSubscriptions{rows: map[int64]*domain.Subscription{
+				1: {
+					ID:            1,
+					PlanID:        2,
+					IntervalID:    5,
+					IntervalCount: 1,
+					Quantity:      1,
+					Price:         1000,
+					PriceType:     "flat_rate",
+					Status:        domain.SubscriptionStatusActive,
+					EndsAt:        &endsAt,
+					BillingAnchor: &anchor,
+				},
+			}}
+			service := &subscriptionService{
+				repo:         repo,
+				intervalRepo: fakeIntervalSlugs{5: "monthly", 6: "yearly"},
+				planRepo: fakePlanCatalog{
+					plans: map[int64]*domain.Plan{
+						3: {ID: 3, IntervalID: 5, IntervalCount: 1, IsActive: true},
+						4: {ID: 4, IntervalID: 6, IntervalCount: 1, IsActive: true},
+					},
+					prices: map[int64]*domain.PlanPrice{
+						3: {PlanID: 3, Price: 2000, Type: "flat_rate"},
+						4: {PlanID: 4, Price: 20000, Type: "flat_rate"},
+					},
+				},
+			}
+
+			subscription, proration, err := service.ChangePlan(context.Background(), 1, tt.planID, 0, domain.PlanChangeModeNow)
+			if err != nil {
+				t.Fatalf("ChangePlan() error = %v", err)
+			}
+
+			// The old plan's price is split between the used part and the credit
+			if proration.UsedAmount+proration.CreditAmount != 1000 || proration.UsedAmount <= 0 {
+				t.Errorf("used %d + credit %d, want the old price 1000 split", proration.UsedAmount, proration.CreditAmount)
+			}
+			if proration.PreviousPeriodStart == nil || !proration.PreviousPeriodStart.Equal(anchor) {
+				t.Errorf("previous period start = %v, want %v", proration.PreviousPeriodStart, anchor)
+			}
+
+			wantStart := anchor
+			if tt.wantNewStart {
+				wantStart = proration.EffectiveAt
+			}
+			if proration.PeriodStart == nil || !proration.PeriodStart.Equal(wantStart) {
+				t.Errorf("period start = %v, want %v", proration.PeriodStart, wantStart)
+			}
+			if proration.PeriodEnd == nil || !proration.PeriodEnd.Equal(*subscription.EndsAt) {
+				t.Errorf("period end = %v, want the new ends_at %v", proration.PeriodEnd, subscription.EndsAt)
+			}
+		})
+	}
+}
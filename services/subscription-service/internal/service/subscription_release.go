@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/damarteplok/damar-admin-cms/services/subscription-service/internal/domain"
+	"github.com/damarteplok/damar-admin-cms/shared/contracts"
+	"github.com/damarteplok/damar-admin-cms/shared/logger"
+	"github.com/damarteplok/damar-admin-cms/shared/tenancy"
+	"go.uber.org/zap"
+)
+
+// releasePageSize is how many subscriptions ReleaseTenant cancels per page
+const releasePageSize = 100
+
+// ReleaseTenant cancels every subscription of a tenant that tenant-service is
+// about to purge, through Cancel so each one publishes
+// subscription.event.cancelled, and then deletes their version history.
+// tenant-service purges the tenant once subscription.event.tenant_released
+// arrives. A repeated request finds the subscriptions cancelled already.
+func (s *subscriptionService) ReleaseTenant(ctx context.Context, tenantID int64) error {
+	if tenantID <= 0 {
+		return errors.New("invalid tenant ID")
+	}
+	ctx = tenancy.WithTenantID(ctx, tenantID)
+
+	for page := 1; ; page++ {
+		subscriptions, total, err := s.repo.GetAll(ctx, tenantID, page, releasePageSize, "")
+		if err != nil {
+			return err
+		}
+		for _, subscription := range subscriptions {
+			if !domain.CanTransition(subscription.Status, domain.SubscriptionStatusCancelled) {
+				continue
+			}
+			if _, err := s.Cancel(ctx, subscription.ID, false, "tenant purged", ""); err != nil {
+				return fmt.Errorf("failed to cancel subscription %d: %w", subscription.ID, err)
+			}
+		}
+		if page*releasePageSize >= total {
+			break
+		}
+	}
+
+	// Cancelling records versions too, so the history goes last
+	if err := s.repo.DeleteTenantVersions(ctx, tenantID); err != nil {
+		return err
+	}
+
+	s.publishTenantReleased(ctx, tenantID)
+	return nil
+}
+
+// publishTenantReleased tells tenant-service the tenant's subscriptions are
+// gone. When it is lost, the next purge request releases the tenant again.
+func (s *subscriptionService) publishTenantReleased(ctx context.Context, tenantID int64) {
+	if s.publisher == nil {
+		return
+	}
+
+	dataBytes, _ := json.Marshal(map[string]interface{}{"tenant_id": tenantID})
+	message := contracts.AmqpMessage{
+		OwnerID: fmt.Sprintf("%d", tenantID),
+		Data:    dataBytes,
+	}
+
+	if err := s.publisher.Publish(ctx, contracts.SubscriptionEventTenantReleased, message); err != nil {
+		logger.Error("Failed to publish tenant released event",
+			zap.Int64("tenant_id", tenantID),
+			zap.Error(err))
+	} else {
+		logger.Info("Published tenant released event",
+			zap.Int64("tenant_id", tenantID))
+	}
+}
@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/damarteplok/damar-admin-cms/services/subscription-service/internal/domain"
+)
+
+// fakeTenantSubscriptions lists subscriptions by tenant and records whose
+// version history was deleted
+type fakeTenantSubscriptions struct {
+	*fakeSubscriptions
+	versionsDeleted []int64
+}
+
+func (f *fakeTenantSubscriptions) GetAll(ctx context.Context, tenantID int64, page, perPage int, status string) ([]*domain.Subscription, int, error) {
+	subscriptions := make([]*domain.Subscription, 0)
+	for _, subscription := range f.rows {
+		if subscription.TenantID == tenantID {
+			subscriptions = append(subscriptions, subscription)
+		}
+	}
+	return subscriptions, len(subscriptions), nil
+}
+
+func (f *fakeTenantSubscriptions) DeleteTenantVersions(ctx context.Context, tenantID int64) error {
+	f.versionsDeleted = append(f.versionsDeleted, tenantID)
+	return nil
+}
+
+func TestReleaseTenant(t *testing.T) {
+	repo := &fakeTenantSubscriptions{fakeSubscriptions: &fakeSubscriptions{rows: map[int64]*domain.Subscription{
+		1: {ID: 1, TenantID: 3, Status: domain.SubscriptionStatusActive},
+		2: {ID: 2, TenantID: 3, Status: domain.SubscriptionStatusPastDue},
+		3: {ID: 3, TenantID: 3, Status: domain.SubscriptionStatusExpired},
+		4: {ID: 4, TenantID: 4, Status: domain.SubscriptionStatusActive},
+	}}}
+	service := &subscriptionService{repo: repo}
+
+	if err := service.ReleaseTenant(context.Background(), 3); err != nil {
+		t.Fatalf("ReleaseTenant() error = %v", err)
+	}
+
+	want := map[int64]string{
+		1: domain.SubscriptionStatusCancelled,
+		2: domain.SubscriptionStatusCancelled,
+		3: domain.SubscriptionStatusExpired,
+		4: domain.SubscriptionStatusActive, // another tenant
+	}
+	for id, status := range want {
+		if repo.rows[id].Status != status {
+			t.Errorf("subscription %d status = %s, want %s", id, repo.rows[id].Status, status)
+		}
+	}
+	if len(repo.versionsDeleted) != 1 || repo.versionsDeleted[0] != 3 {
+		t.Errorf("versions deleted for %v, want [3]", repo.versionsDeleted)
+	}
+
+	// A repeated request cancels nothing more
+	cancelled := len(repo.reasons)
+	if err := service.ReleaseTenant(context.Background(), 3); err != nil {
+		t.Fatalf("ReleaseTenant() again error = %v", err)
+	}
+	if len(repo.reasons) != cancelled {
+		t.Errorf("saved %d more changes, want none", len(repo.reasons)-cancelled)
+	}
+}
@@ -0,0 +1,290 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/damarteplok/damar-admin-cms/services/subscription-service/internal/domain"
+	"github.com/damarteplok/damar-admin-cms/shared/amqp"
+	"github.com/damarteplok/damar-admin-cms/shared/contracts"
+	"github.com/damarteplok/damar-admin-cms/shared/logger"
+	"github.com/damarteplok/damar-admin-cms/shared/pricing"
+	"github.com/damarteplok/damar-admin-cms/shared/tenancy"
+	"go.uber.org/zap"
+)
+
+// SubscriptionScheduler periodically ends trials, expires cancelled
+// subscriptions and renews subscriptions whose period has ended.
+type SubscriptionScheduler struct {
+	subscriptions *subscriptionService
+	interval      time.Duration
+	batchSize     int
+}
+
+func NewSubscriptionScheduler(
+	repo domain.SubscriptionRepository,
+	intervalRepo domain.IntervalRepository,
+	planRepo domain.PlanRepository,
+	publisher *amqp.Publisher,
+	interval time.Duration,
+	batchSize int,
+) *SubscriptionScheduler {
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	if batchSize < 1 {
+		batchSize = 100
+	}
+
+	return &SubscriptionScheduler{
+		subscriptions: &subscriptionService{
+			repo:         repo,
+			intervalRepo: intervalRepo,
+			planRepo:     planRepo,
+			publisher:    publisher,
+		},
+		interval:  interval,
+		batchSize: batchSize,
+	}
+}
+
+// Run processes due subscriptions every interval until ctx is cancelled
+func (s *SubscriptionScheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	s.RunOnce(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.RunOnce(ctx)
+		}
+	}
+}
+
+// dueBatch carries what the job callbacks learn about each subscription over
+// to afterProcessed, which runs once the batch has been committed
+type dueBatch struct {
+	previousStatuses map[int64]string
+	previousPlans    map[int64]int64 // set when a scheduled plan change was applied
+	endedPeriods     map[int64]*endedPeriod
+}
+
+// endedPeriod is the period a renewal closed and the billing terms it ran on.
+// A scheduled plan change replaces the terms on the subscription, so the
+// renewed event carries them for billing.
+type endedPeriod struct {
+	start        time.Time
+	end          time.Time
+	planID       int64
+	quantity     int32
+	price        int32
+	priceType    string
+	pricePerUnit *string
+	priceTiers   json.RawMessage
+}
+
+// RunOnce drains every kind of due work. Trials are handled first so a trial
+// that ended long ago is activated before its first period is considered for
+// renewal.
+func (s *SubscriptionScheduler) RunOnce(ctx context.Context) {
+	// The scheduler works through every tenant's subscriptions
+	ctx = tenancy.WithoutTenant(ctx)
+
+	jobs := []struct {
+		kind string
+		fn   func(ctx context.Context, now time.Time, batch *dueBatch) domain.SubscriptionChange
+	}{
+		{domain.DueTrialEnd, s.endTrial},
+		{domain.DueExpiry, s.expire},
+		{domain.DueRenewal, s.renew},
+	}
+
+	for _, job := range jobs {
+		for {
+			if ctx.Err() != nil {
+				return
+			}
+
+			now := time.Now()
+			batch := &dueBatch{
+				previousStatuses: make(map[int64]string),
+				previousPlans:    make(map[int64]int64),
+				endedPeriods:     make(map[int64]*endedPeriod),
+			}
+			process := job.fn(ctx, now, batch)
+			claimed := 0
+			processed, err := s.subscriptions.repo.ProcessDue(ctx, job.kind, now, s.batchSize, func(subscription *domain.Subscription) (string, error) {
+				claimed++
+				reason, err := process(subscription)
+				if err != nil {
+					logger.Warn("Skipping due subscription",
+						zap.String("kind", job.kind),
+						zap.Int64("subscription_id", subscription.ID),
+						zap.Error(err))
+					return "", err
+				}
+				return reason, nil
+			})
+			if err != nil {
+				logger.Error("Failed to process due subscriptions",
+					zap.String("kind", job.kind),
+					zap.Error(err))
+				break
+			}
+
+			for _, subscription := range processed {
+				s.afterProcessed(ctx, job.kind, subscription, batch)
+			}
+
+			if len(processed) > 0 {
+				logger.Info("Processed due subscriptions",
+					zap.String("kind", job.kind),
+					zap.Int("count", len(processed)))
+			}
+
+			// A short batch means nothing else is due (or the rest is locked
+			// by another replica). Failed rows are backed off, so a full
+			// batch of them moves on to the rows behind.
+			if claimed < s.batchSize {
+				break
+			}
+		}
+	}
+}
+
+// endTrial activates a subscription whose trial has ended, or expires it when
+// the customer asked to cancel during the trial
+func (s *SubscriptionScheduler) endTrial(ctx context.Context, now time.Time, batch *dueBatch) domain.SubscriptionChange {
+	return func(subscription *domain.Subscription) (string, error) {
+		previousStatus := subscription.Status
+		batch.previousStatuses[subscription.ID] = previousStatus
+
+		if subscription.IsCanceledAtEndOfCycle {
+			if err := applyTransition(subscription, domain.SubscriptionStatusExpired); err != nil {
+				return "", err
+			}
+			return versionReason(previousStatus, subscription.Status, "trial ended"), nil
+		}
+
+		if err := applyTransition(subscription, domain.SubscriptionStatusActive); err != nil {
+			return "", err
+		}
+		if subscription.EndsAt == nil || !subscription.EndsAt.After(*subscription.TrialEndsAt) {
+			endsAt, err := s.subscriptions.periodEnd(ctx, subscription, *subscription.TrialEndsAt)
+			if err != nil {
+				return "", err
+			}
+			subscription.EndsAt = &endsAt
+			anchor := *subscription.TrialEndsAt
+			subscription.BillingAnchor = &anchor
+		}
+		return versionReason(previousStatus, subscription.Status, "trial ended"), nil
+	}
+}
+
+// expire ends subscriptions that were cancelled and whose paid period is over
+func (s *SubscriptionScheduler) expire(ctx context.Context, now time.Time, batch *dueBatch) domain.SubscriptionChange {
+	return func(subscription *domain.Subscription) (string, error) {
+		previousStatus := subscription.Status
+		batch.previousStatuses[subscription.ID] = previousStatus
+		if err := applyTransition(subscription, domain.SubscriptionStatusExpired); err != nil {
+			return "", err
+		}
+		return versionReason(previousStatus, subscription.Status, "period ended"), nil
+	}
+}
+
+// renew moves ends_at to the end of the period, counted from the billing
+// anchor, that contains now, so a subscription that missed several runs
+// catches up in one step and keeps its billing day after short months. A plan
+// change scheduled by ChangePlan takes effect here, before the new period is
+// computed and after the ended period has been recorded with the old terms.
+func (s *SubscriptionScheduler) renew(ctx context.Context, now time.Time, batch *dueBatch) domain.SubscriptionChange {
+	return func(subscription *domain.Subscription) (string, error) {
+		batch.previousStatuses[subscription.ID] = subscription.Status
+
+		ended, err := s.endedPeriod(ctx, subscription, now)
+		if err != nil {
+			return "", err
+		}
+		batch.endedPeriods[subscription.ID] = ended
+
+		reason := "renewed"
+		if subscription.ScheduledPlanID != nil {
+			previousPlanID, err := s.subscriptions.applyScheduledPlan(ctx, subscription)
+			if err != nil {
+				return "", err
+			}
+			batch.previousPlans[subscription.ID] = previousPlanID
+			reason = "renewed with scheduled plan change"
+		}
+
+		endsAt, err := s.subscriptions.anchoredPeriodEnd(ctx, subscription, now)
+		if err != nil {
+			return "", err
+		}
+		subscription.EndsAt = &endsAt
+		return reason, nil
+	}
+}
+
+func (s *SubscriptionScheduler) afterProcessed(ctx context.Context, kind string, subscription *domain.Subscription, batch *dueBatch) {
+	previousStatus := batch.previousStatuses[subscription.ID]
+
+	switch kind {
+	case domain.DueRenewal:
+		if previousPlanID, ok := batch.previousPlans[subscription.ID]; ok {
+			s.subscriptions.publishPlanChange(ctx, contracts.SubscriptionEventPlanChanged, subscription, previousPlanID, nil, "scheduled plan change")
+		}
+		s.subscriptions.publishRenewal(ctx, subscription, previousStatus, batch.endedPeriods[subscription.ID])
+	case domain.DueTrialEnd:
+		s.subscriptions.publishEvent(ctx, transitionEvent(previousStatus, subscription.Status), subscription, previousStatus, "trial ended")
+	case domain.DueExpiry:
+		s.subscriptions.publishEvent(ctx, transitionEvent(previousStatus, subscription.Status), subscription, previousStatus, "period ended")
+	}
+}
+
+// endedPeriod returns the last period, counted from the subscription's
+// billing anchor, that ended at or before now. When a scheduled plan change
+// is due, the old terms ran only until ends_at, so that is the period billed.
+func (s *SubscriptionScheduler) endedPeriod(ctx context.Context, subscription *domain.Subscription, now time.Time) (*endedPeriod, error) {
+	interval, err := s.subscriptions.intervalRepo.GetByID(ctx, subscription.IntervalID)
+	if err != nil {
+		return nil, err
+	}
+
+	t := now
+	if subscription.ScheduledPlanID != nil && subscription.EndsAt.Before(now) {
+		t = *subscription.EndsAt
+	}
+
+	// Period n contains t, so n-1 is the last one that has ended
+	anchor := billingAnchor(subscription)
+	n, err := pricing.PeriodIndex(anchor, interval.Slug, subscription.IntervalCount, t)
+	if err != nil {
+		return nil, err
+	}
+	start, err := pricing.PeriodEnd(anchor, interval.Slug, subscription.IntervalCount, n-2)
+	if err != nil {
+		return nil, err
+	}
+	end, err := pricing.PeriodEnd(anchor, interval.Slug, subscription.IntervalCount, n-1)
+	if err != nil {
+		return nil, err
+	}
+
+	return &endedPeriod{
+		start:        start,
+		end:          end,
+		planID:       subscription.PlanID,
+		quantity:     subscription.Quantity,
+		price:        subscription.Price,
+		priceType:    subscription.PriceType,
+		pricePerUnit: subscription.PricePerUnit,
+		priceTiers:   subscription.PriceTiers,
+	}, nil
+}
@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/damarteplok/damar-admin-cms/services/subscription-service/internal/domain"
+)
+
+type fakeIntervalSlugs map[int64]string
+
+func (f fakeIntervalSlugs) GetByID(ctx context.Context, id int64) (*domain.Interval, error) {
+	return &domain.Interval{ID: id, Slug: f[id]}, nil
+}
+
+// fakePlanCatalog holds plans and their price in every currency
+type fakePlanCatalog struct {
+	domain.PlanRepository
+	plans  map[int64]*domain.Plan
+	prices map[int64]*domain.PlanPrice
+}
+
+func (f fakePlanCatalog) GetByID(ctx context.Context, id int64) (*domain.Plan, error) {
+	return f.plans[id], nil
+}
+
+func (f fakePlanCatalog) GetPrice(ctx context.Context, planID, currencyID int64) (*domain.PlanPrice, error) {
+	return f.prices[planID], nil
+}
+
+func TestRenewRecordsEndedPeriod(t *testing.T) {
+	anchor := time.Date(2025, 11, 1, 0, 0, 0, 0, time.UTC)
+	endsAt := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	yearlyPlan := int64(3)
+
+	tests := []struct {
+		name          string
+		scheduledPlan *int64
+		now           time.Time
+		wantStart     time.Time
+		wantEnd       time.Time
+		wantEndsAt    time.Time
+		wantPlanID    int64
+	}{
+		{
+			name:       "renewal",
+			now:        endsAt.Add(time.Hour),
+			wantStart:  time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+			wantEnd:    endsAt,
+			wantEndsAt: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+			wantPlanID: 2,
+		},
+		{
+			name:       "missed runs",
+			now:        time.Date(2026, 4, 15, 0, 0, 0, 0, time.UTC),
+			wantStart:  time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+			wantEnd:    time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC),
+			wantEndsAt: time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC),
+			wantPlanID: 2,
+		},
+		{
+			name:          "scheduled change to a yearly plan",
+			scheduledPlan: &yearlyPlan,
+			now:           endsAt.Add(time.Hour),
+			wantStart:     time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+			wantEnd:       endsAt,
+			wantEndsAt:    time.Date(2027, 2, 1, 0, 0, 0, 0, time.UTC),
+			wantPlanID:    3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scheduler := &SubscriptionScheduler{subscriptions: &subscriptionService{
+				intervalRepo: fakeIntervalSlugs{5: "monthly", 6: "yearly"},
+				planRepo: fakePlanCatalog{
+					plans:  map[int64]*domain.Plan{3: {ID: 3, IntervalID: 6, IntervalCount: 1}},
+					prices: map[int64]*domain.PlanPrice{3: {PlanID: 3, Price: 9000, Type: "flat_rate"}},
+				},
+			}}
+			currentAnchor, currentEnd := anchor, endsAt
+			subscription := &domain.Subscription{
+				ID:              1,
+				PlanID:          2,
+				IntervalID:      5,
+				IntervalCount:   1,
+				Quantity:        1,
+				Price:           1000,
+				PriceType:       "flat_rate",
+				Status:          domain.SubscriptionStatusActive,
+				EndsAt:          &currentEnd,
+				BillingAnchor:   &currentAnchor,
+				ScheduledPlanID: tt.scheduledPlan,
+			}
+			batch := &dueBatch{
+				previousStatuses: make(map[int64]string),
+				previousPlans:    make(map[int64]int64),
+				endedPeriods:     make(map[int64]*endedPeriod),
+			}
+
+			if _, err := scheduler.renew(context.Background(), tt.now, batch)(subscription); err != nil {
+				t.Fatalf("renew() error = %v", err)
+			}
+
+			ended := batch.endedPeriods[1]
+			if ended == nil {
+				t.Fatal("no ended period recorded")
+			}
+			if !ended.start.Equal(tt.wantStart) || !ended.end.Equal(tt.wantEnd) {
+				t.Errorf("ended period = %v - %v, want %v - %v", ended.start, ended.end, tt.wantStart, tt.wantEnd)
+			}
+			// The ended period is billed on the terms it ran on
+			if ended.planID != 2 || ended.price != 1000 {
+				t.Errorf("ended period terms = plan %d at %d, want plan 2 at 1000", ended.planID, ended.price)
+			}
+			if subscription.PlanID != tt.wantPlanID || !subscription.EndsAt.Equal(tt.wantEndsAt) {
+				t.Errorf("subscription = plan %d ending %v, want plan %d ending %v", subscription.PlanID, subscription.EndsAt, tt.wantPlanID, tt.wantEndsAt)
+			}
+		})
+	}
+}
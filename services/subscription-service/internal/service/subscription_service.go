@@ -0,0 +1,568 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/damarteplok/damar-admin-cms/services/subscription-service/internal/domain"
+	"github.com/damarteplok/damar-admin-cms/shared/amqp"
+	"github.com/damarteplok/damar-admin-cms/shared/contracts"
+	"github.com/damarteplok/damar-admin-cms/shared/logger"
+	"github.com/damarteplok/damar-admin-cms/shared/pricing"
+	"github.com/damarteplok/damar-admin-cms/shared/util"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type subscriptionService struct {
+	repo         domain.SubscriptionRepository
+	intervalRepo domain.IntervalRepository
+	planRepo     domain.PlanRepository
+	publisher    *amqp.Publisher
+}
+
+func NewSubscriptionService(
+	repo domain.SubscriptionRepository,
+	intervalRepo domain.IntervalRepository,
+	planRepo domain.PlanRepository,
+	publisher *amqp.Publisher,
+) domain.SubscriptionService {
+	return &subscriptionService{
+		repo:         repo,
+		intervalRepo: intervalRepo,
+		planRepo:     planRepo,
+		publisher:    publisher,
+	}
+}
+
+func (s *subscriptionService) GetByID(ctx context.Context, id int64) (*domain.Subscription, error) {
+	if id <= 0 {
+		return nil, errors.New("invalid subscription ID")
+	}
+	return s.repo.GetByID(ctx, id)
+}
+
+func (s *subscriptionService) GetByUUID(ctx context.Context, uuidStr string) (*domain.Subscription, error) {
+	if uuidStr == "" {
+		return nil, errors.New("uuid cannot be empty")
+	}
+	return s.repo.GetByUUID(ctx, uuidStr)
+}
+
+func (s *subscriptionService) GetByUser(ctx context.Context, userID, tenantID int64, page, perPage int) ([]*domain.Subscription, int, error) {
+	if userID <= 0 {
+		return nil, 0, errors.New("invalid user ID")
+	}
+	page, perPage = normalizePagination(page, perPage)
+	return s.repo.GetByUser(ctx, userID, tenantID, page, perPage)
+}
+
+func (s *subscriptionService) GetByTenant(ctx context.Context, tenantID int64, page, perPage int) ([]*domain.Subscription, int, error) {
+	if tenantID <= 0 {
+		return nil, 0, errors.New("invalid tenant ID")
+	}
+	page, perPage = normalizePagination(page, perPage)
+	return s.repo.GetByTenant(ctx, tenantID, page, perPage)
+}
+
+func (s *subscriptionService) Create(ctx context.Context, subscription *domain.Subscription) error {
+	if subscription == nil {
+		return errors.New("subscription cannot be nil")
+	}
+	if subscription.UserID <= 0 {
+		return errors.New("user ID is required")
+	}
+	if subscription.PlanID <= 0 {
+		return errors.New("plan ID is required")
+	}
+	if subscription.TenantID <= 0 {
+		return errors.New("tenant ID is required")
+	}
+	if subscription.CurrencyID <= 0 {
+		return errors.New("currency ID is required")
+	}
+	if subscription.IntervalID <= 0 {
+		return errors.New("interval ID is required")
+	}
+	if subscription.Price < 0 {
+		return errors.New("price cannot be negative")
+	}
+
+	// Apply the same defaults as the subscriptions table
+	if subscription.IntervalCount < 1 {
+		subscription.IntervalCount = 1
+	}
+	if subscription.Quantity < 1 {
+		subscription.Quantity = 1
+	}
+	if subscription.PriceType == "" {
+		subscription.PriceType = "flat_rate"
+	}
+	if subscription.Type == "" {
+		subscription.Type = "payment_provider_managed"
+	}
+	if !isValidPriceType(subscription.PriceType) {
+		return fmt.Errorf("invalid price type: %s", subscription.PriceType)
+	}
+	if !isValidSubscriptionType(subscription.Type) {
+		return fmt.Errorf("invalid subscription type: %s", subscription.Type)
+	}
+
+	if subscription.UUID == "" {
+		subscription.UUID = uuid.New().String()
+	}
+
+	// A subscription with a trial in the future starts out trialing; the
+	// first paid period then begins when the trial ends
+	now := time.Now()
+	subscription.Status = domain.SubscriptionStatusActive
+	periodStart := now
+	if subscription.TrialEndsAt != nil && subscription.TrialEndsAt.After(now) {
+		subscription.Status = domain.SubscriptionStatusTrialing
+		periodStart = *subscription.TrialEndsAt
+	}
+	if subscription.EndsAt == nil {
+		endsAt, err := s.periodEnd(ctx, subscription, periodStart)
+		if err != nil {
+			return err
+		}
+		subscription.EndsAt = &endsAt
+		subscription.BillingAnchor = &periodStart
+	} else {
+		// A given period end is a period boundary like any other
+		anchor := *subscription.EndsAt
+		subscription.BillingAnchor = &anchor
+	}
+
+	if err := s.repo.Create(ctx, subscription); err != nil {
+		return err
+	}
+
+	s.publishEvent(ctx, contracts.SubscriptionEventCreated, subscription, "", "created")
+	return nil
+}
+
+func (s *subscriptionService) Update(ctx context.Context, id int64, change func(subscription *domain.Subscription) error) (*domain.Subscription, error) {
+	if id <= 0 {
+		return nil, errors.New("invalid subscription ID")
+	}
+	if change == nil {
+		return nil, errors.New("change cannot be nil")
+	}
+
+	var previousStatus string
+	subscription, err := s.repo.Update(ctx, id, func(subscription *domain.Subscription) (string, error) {
+		previousStatus = subscription.Status
+		if err := change(subscription); err != nil {
+			return "", err
+		}
+		subscription.ID = id
+
+		if subscription.Price < 0 {
+			return "", errors.New("price cannot be negative")
+		}
+		if subscription.Quantity < 1 {
+			return "", errors.New("quantity must be at least 1")
+		}
+
+		// A status change through Update is held to the same rules as the
+		// dedicated lifecycle operations
+		if subscription.Status != previousStatus {
+			target := subscription.Status
+			subscription.Status = previousStatus
+			if err := applyTransition(subscription, target); err != nil {
+				return "", err
+			}
+		}
+		return versionReason(previousStatus, subscription.Status, "updated"), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if subscription.Status != previousStatus {
+		s.publishTransition(ctx, subscription, previousStatus, "updated")
+		return subscription, nil
+	}
+
+	s.publishEvent(ctx, contracts.SubscriptionEventUpdated, subscription, previousStatus, "updated")
+	return subscription, nil
+}
+
+func (s *subscriptionService) Cancel(ctx context.Context, id int64, atEndOfCycle bool, reason, additionalInfo string) (*domain.Subscription, error) {
+	if id <= 0 {
+		return nil, errors.New("invalid subscription ID")
+	}
+
+	var previousStatus string
+	scheduled := false
+	subscription, err := s.repo.Update(ctx, id, func(subscription *domain.Subscription) (string, error) {
+		if err := domain.ValidateTransition(subscription.Status, domain.SubscriptionStatusCancelled); err != nil {
+			return "", err
+		}
+		if subscription.IsCanceledAtEndOfCycle && atEndOfCycle {
+			return "", errors.New("subscription is already set to cancel at the end of the cycle")
+		}
+
+		if reason != "" {
+			subscription.CancellationReason = &reason
+		}
+		if additionalInfo != "" {
+			subscription.CancellationAdditionalInfo = &additionalInfo
+		}
+
+		// Cancelling at the end of the cycle keeps access until ends_at; without
+		// a known period end there is nothing to wait for, so cancel right away
+		previousStatus = subscription.Status
+		if atEndOfCycle && subscription.EndsAt != nil && subscription.EndsAt.After(time.Now()) {
+			subscription.IsCanceledAtEndOfCycle = true
+			scheduled = true
+			return "cancellation_scheduled", nil
+		}
+
+		if err := applyTransition(subscription, domain.SubscriptionStatusCancelled); err != nil {
+			return "", err
+		}
+		return versionReason(previousStatus, subscription.Status, reason), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if scheduled {
+		s.publishEvent(ctx, contracts.SubscriptionEventCancellationScheduled, subscription, subscription.Status, reason)
+		return subscription, nil
+	}
+
+	s.publishTransition(ctx, subscription, previousStatus, reason)
+	return subscription, nil
+}
+
+func (s *subscriptionService) Pause(ctx context.Context, id int64, reason string) (*domain.Subscription, error) {
+	return s.TransitionStatus(ctx, id, domain.SubscriptionStatusPaused, reason)
+}
+
+func (s *subscriptionService) Resume(ctx context.Context, id int64) (*domain.Subscription, error) {
+	if id <= 0 {
+		return nil, errors.New("invalid subscription ID")
+	}
+
+	var previousStatus string
+	revoked := false
+	subscription, err := s.repo.Update(ctx, id, func(subscription *domain.Subscription) (string, error) {
+		switch {
+		case subscription.Status == domain.SubscriptionStatusPaused:
+			// Paused subscriptions simply become active again
+
+		case subscription.Status == domain.SubscriptionStatusCancelled:
+			// applyTransition checks that the paid period has not ended
+
+		case subscription.IsCanceledAtEndOfCycle:
+			// Revoke a scheduled cancellation without changing the status
+			clearCancellation(subscription)
+			revoked = true
+			return "cancellation_revoked", nil
+
+		default:
+			return "", fmt.Errorf("subscription cannot be resumed from status %s", subscription.Status)
+		}
+
+		previousStatus = subscription.Status
+		if err := applyTransition(subscription, domain.SubscriptionStatusActive); err != nil {
+			return "", err
+		}
+		return versionReason(previousStatus, subscription.Status, "resumed"), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if revoked {
+		s.publishEvent(ctx, contracts.SubscriptionEventResumed, subscription, subscription.Status, "cancellation_revoked")
+		return subscription, nil
+	}
+
+	s.publishTransition(ctx, subscription, previousStatus, "resumed")
+	return subscription, nil
+}
+
+func (s *subscriptionService) Renew(ctx context.Context, id int64, endsAt time.Time) (*domain.Subscription, error) {
+	if id <= 0 {
+		return nil, errors.New("invalid subscription ID")
+	}
+	if !endsAt.After(time.Now()) {
+		return nil, errors.New("ends_at must be in the future")
+	}
+
+	var previousStatus string
+	subscription, err := s.repo.Update(ctx, id, func(subscription *domain.Subscription) (string, error) {
+		switch subscription.Status {
+		case domain.SubscriptionStatusCancelled:
+			return "", errors.New("cancelled subscription cannot be renewed")
+		case domain.SubscriptionStatusPaused:
+			return "", errors.New("paused subscription must be resumed before it can be renewed")
+		}
+		if subscription.IsCanceledAtEndOfCycle {
+			return "", errors.New("subscription is set to cancel at the end of the cycle")
+		}
+		if subscription.EndsAt != nil && !endsAt.After(*subscription.EndsAt) {
+			return "", errors.New("ends_at must be after the current period end")
+		}
+
+		// Later periods are counted from the new end. It is set before the
+		// transition so that an expired subscription reactivates into the
+		// period it has just paid for.
+		previousStatus = subscription.Status
+		subscription.EndsAt = &endsAt
+		anchor := endsAt
+		subscription.BillingAnchor = &anchor
+		if previousStatus != domain.SubscriptionStatusActive {
+			if err := applyTransition(subscription, domain.SubscriptionStatusActive); err != nil {
+				return "", err
+			}
+		}
+		return versionReason(previousStatus, subscription.Status, "renewed"), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishEvent(ctx, contracts.SubscriptionEventRenewed, subscription, previousStatus, "renewed")
+	return subscription, nil
+}
+
+func (s *subscriptionService) GetAll(ctx context.Context, tenantID int64, page, perPage int, status string) ([]*domain.Subscription, int, error) {
+	if status != "" && !domain.IsValidSubscriptionStatus(status) {
+		return nil, 0, fmt.Errorf("invalid status: %s", status)
+	}
+	page, perPage = normalizePagination(page, perPage)
+	return s.repo.GetAll(ctx, tenantID, page, perPage, status)
+}
+
+func (s *subscriptionService) TransitionStatus(ctx context.Context, id int64, status, reason string) (*domain.Subscription, error) {
+	if id <= 0 {
+		return nil, errors.New("invalid subscription ID")
+	}
+
+	var previousStatus string
+	subscription, err := s.repo.Update(ctx, id, func(subscription *domain.Subscription) (string, error) {
+		previousStatus = subscription.Status
+		if err := applyTransition(subscription, status); err != nil {
+			return "", err
+		}
+		return versionReason(previousStatus, subscription.Status, reason), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishTransition(ctx, subscription, previousStatus, reason)
+	return subscription, nil
+}
+
+// periodEnd computes the end of the billing period starting at from, using the
+// subscription's interval and interval_count
+func (s *subscriptionService) periodEnd(ctx context.Context, subscription *domain.Subscription, from time.Time) (time.Time, error) {
+	interval, err := s.intervalRepo.GetByID(ctx, subscription.IntervalID)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return pricing.NextPeriodEnd(from, interval.Slug, subscription.IntervalCount)
+}
+
+// anchoredPeriodEnd returns the end of the period counted from the
+// subscription's billing anchor that contains t
+func (s *subscriptionService) anchoredPeriodEnd(ctx context.Context, subscription *domain.Subscription, t time.Time) (time.Time, error) {
+	interval, err := s.intervalRepo.GetByID(ctx, subscription.IntervalID)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	anchor := billingAnchor(subscription)
+	n, err := pricing.PeriodIndex(anchor, interval.Slug, subscription.IntervalCount, t)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return pricing.PeriodEnd(anchor, interval.Slug, subscription.IntervalCount, n)
+}
+
+// billingAnchor returns the boundary the subscription's periods are counted
+// from. Rows without one are anchored on their current period end; the
+// caller must check that EndsAt is set.
+func billingAnchor(subscription *domain.Subscription) time.Time {
+	if subscription.BillingAnchor != nil {
+		return *subscription.BillingAnchor
+	}
+	return *subscription.EndsAt
+}
+
+// publishTransition publishes the subscription.event.* message matching a
+// saved status change
+func (s *subscriptionService) publishTransition(ctx context.Context, subscription *domain.Subscription, previousStatus, reason string) {
+	s.publishEvent(ctx, transitionEvent(previousStatus, subscription.Status), subscription, previousStatus, reason)
+}
+
+func (s *subscriptionService) publishEvent(ctx context.Context, routingKey string, subscription *domain.Subscription, previousStatus, reason string) {
+	s.publish(ctx, routingKey, subscription, subscriptionEventData(subscription, previousStatus, reason))
+}
+
+// publishRenewal publishes subscription.event.renewed with the period that
+// ended and the terms it is billed on
+func (s *subscriptionService) publishRenewal(ctx context.Context, subscription *domain.Subscription, previousStatus string, ended *endedPeriod) {
+	eventData := subscriptionEventData(subscription, previousStatus, "renewed")
+	if ended != nil {
+		eventData["billed_period"] = map[string]interface{}{
+			"period_start":   ended.start.Unix(),
+			"period_end":     ended.end.Unix(),
+			"plan_id":        ended.planID,
+			"quantity":       ended.quantity,
+			"price":          ended.price,
+			"price_type":     ended.priceType,
+			"price_per_unit": ended.pricePerUnit,
+			"price_tiers":    ended.priceTiers,
+		}
+	}
+	s.publish(ctx, contracts.SubscriptionEventRenewed, subscription, eventData)
+}
+
+// subscriptionEventData is the payload shared by every subscription.event.* message
+func subscriptionEventData(subscription *domain.Subscription, previousStatus, reason string) map[string]interface{} {
+	return map[string]interface{}{
+		"subscription_id":             subscription.ID,
+		"uuid":                        subscription.UUID,
+		"tenant_id":                   subscription.TenantID,
+		"user_id":                     subscription.UserID,
+		"plan_id":                     subscription.PlanID,
+		"status":                      subscription.Status,
+		"previous_status":             previousStatus,
+		"is_canceled_at_end_of_cycle": subscription.IsCanceledAtEndOfCycle,
+		"ends_at":                     util.TimeToUnix(subscription.EndsAt),
+		"reason":                      reason,
+	}
+}
+
+func (s *subscriptionService) publish(ctx context.Context, routingKey string, subscription *domain.Subscription, eventData map[string]interface{}) {
+	if s.publisher == nil {
+		return
+	}
+
+	dataBytes, _ := json.Marshal(eventData)
+	message := contracts.AmqpMessage{
+		OwnerID: fmt.Sprintf("%d", subscription.ID),
+		Data:    dataBytes,
+	}
+
+	if err := s.publisher.Publish(ctx, routingKey, message); err != nil {
+		logger.Error("Failed to publish subscription event",
+			zap.String("routing_key", routingKey),
+			zap.Int64("subscription_id", subscription.ID),
+			zap.Error(err))
+	} else {
+		logger.Info("Published subscription event",
+			zap.String("routing_key", routingKey),
+			zap.Int64("subscription_id", subscription.ID),
+			zap.String("status", subscription.Status))
+	}
+}
+
+// Helper functions
+
+func normalizePagination(page, perPage int) (int, int) {
+	if page < 1 {
+		page = 1
+	}
+	if perPage < 1 {
+		perPage = 10
+	}
+	if perPage > 100 {
+		perPage = 100
+	}
+	return page, perPage
+}
+
+// applyTransition moves the subscription to status and updates the fields
+// that belong to that state
+func applyTransition(subscription *domain.Subscription, status string) error {
+	if err := domain.ValidateTransition(subscription.Status, status); err != nil {
+		return err
+	}
+
+	switch status {
+	case domain.SubscriptionStatusCancelled:
+		now := time.Now()
+		subscription.CancelledAt = &now
+		subscription.IsCanceledAtEndOfCycle = false
+	case domain.SubscriptionStatusActive:
+		if subscription.Status == domain.SubscriptionStatusCancelled || subscription.Status == domain.SubscriptionStatusExpired {
+			// Whatever the path, only a subscription that is inside a paid
+			// period can become active again
+			if subscription.EndsAt == nil || !subscription.EndsAt.After(time.Now()) {
+				return errors.New("subscription cannot be reactivated after its period has ended")
+			}
+			clearCancellation(subscription)
+		}
+	}
+
+	subscription.Status = status
+	return nil
+}
+
+func clearCancellation(subscription *domain.Subscription) {
+	subscription.IsCanceledAtEndOfCycle = false
+	subscription.CancelledAt = nil
+	subscription.CancellationReason = nil
+	subscription.CancellationAdditionalInfo = nil
+}
+
+// transitionEvent maps a status change to its routing key
+func transitionEvent(from, to string) string {
+	switch to {
+	case domain.SubscriptionStatusActive:
+		if from == domain.SubscriptionStatusPaused || from == domain.SubscriptionStatusCancelled {
+			return contracts.SubscriptionEventResumed
+		}
+		return contracts.SubscriptionEventActivated
+	case domain.SubscriptionStatusPastDue:
+		return contracts.SubscriptionEventPastDue
+	case domain.SubscriptionStatusPaused:
+		return contracts.SubscriptionEventPaused
+	case domain.SubscriptionStatusCancelled:
+		return contracts.SubscriptionEventCancelled
+	case domain.SubscriptionStatusExpired:
+		return contracts.SubscriptionEventExpired
+	default:
+		return contracts.SubscriptionEventUpdated
+	}
+}
+
+// versionReason describes a change for subscription_versions.reason
+func versionReason(from, to, reason string) string {
+	if from == to {
+		return reason
+	}
+	result := fmt.Sprintf("%s -> %s", from, to)
+	if reason != "" {
+		result += ": " + reason
+	}
+	return result
+}
+
+func isValidPriceType(priceType string) bool {
+	validPriceTypes := map[string]bool{
+		"flat_rate": true,
+		"per_unit":  true,
+		"tiered":    true,
+	}
+	return validPriceTypes[priceType]
+}
+
+func isValidSubscriptionType(subscriptionType string) bool {
+	validTypes := map[string]bool{
+		"payment_provider_managed": true,
+		"manual":                   true,
+	}
+	return validTypes[subscriptionType]
+}
@@ -0,0 +1,188 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/damarteplok/damar-admin-cms/services/subscription-service/internal/domain"
+	"github.com/damarteplok/damar-admin-cms/shared/pricing"
+)
+
+// fakeSubscriptions keeps subscriptions in memory and records the version
+// reason of every saved change
+type fakeSubscriptions struct {
+	domain.SubscriptionRepository
+	rows    map[int64]*domain.Subscription
+	reasons []string
+}
+
+func (f *fakeSubscriptions) Create(ctx context.Context, subscription *domain.Subscription) error {
+	subscription.ID = int64(len(f.rows) + 1)
+	saved := *subscription
+	f.rows[subscription.ID] = &saved
+	f.reasons = append(f.reasons, "created")
+	return nil
+}
+
+func (f *fakeSubscriptions) Update(ctx context.Context, id int64, fn domain.SubscriptionChange) (*domain.Subscription, error) {
+	subscription := *f.rows[id]
+	reason, err := fn(&subscription)
+	if err != nil {
+		return nil, err
+	}
+	f.rows[id] = &subscription
+	f.reasons = append(f.reasons, reason)
+	return &subscription, nil
+}
+
+type fakeIntervals struct {
+	slug string
+}
+
+func (f fakeIntervals) GetByID(ctx context.Context, id int64) (*domain.Interval, error) {
+	return &domain.Interval{ID: id, Slug: f.slug}, nil
+}
+
+func newTestSubscriptionService() (*subscriptionService, *fakeSubscriptions) {
+	repo := &fakeSubscriptions{rows: make(map[int64]*domain.Subscription)}
+	return &subscriptionService{repo: repo, intervalRepo: fakeIntervals{slug: "monthly"}}, repo
+}
+
+func newTestSubscription(trialEndsAt *time.Time) *domain.Subscription {
+	return &domain.Subscription{
+		UserID:      1,
+		PlanID:      2,
+		TenantID:    3,
+		CurrencyID:  4,
+		IntervalID:  5,
+		Price:       1000,
+		TrialEndsAt: trialEndsAt,
+	}
+}
+
+func TestCreateSubscription(t *testing.T) {
+	trialEndsAt := time.Now().AddDate(0, 0, 14)
+
+	tests := []struct {
+		name        string
+		trialEndsAt *time.Time
+		wantStatus  string
+	}{
+		{"without trial", nil, domain.SubscriptionStatusActive},
+		{"with trial", &trialEndsAt, domain.SubscriptionStatusTrialing},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, repo := newTestSubscriptionService()
+			subscription := newTestSubscription(tt.trialEndsAt)
+
+			if err := service.Create(context.Background(), subscription); err != nil {
+				t.Fatalf("Create() error = %v", err)
+			}
+			saved := repo.rows[subscription.ID]
+			if saved.Status != tt.wantStatus {
+				t.Errorf("status = %s, want %s", saved.Status, tt.wantStatus)
+			}
+
+			// The first paid period starts when the trial ends
+			periodStart := time.Now()
+			if tt.trialEndsAt != nil {
+				periodStart = *tt.trialEndsAt
+			}
+			wantEnd, err := pricing.NextPeriodEnd(periodStart, "monthly", 1)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if saved.EndsAt == nil || saved.EndsAt.Sub(wantEnd).Abs() > time.Minute {
+				t.Errorf("ends_at = %v, want about %v", saved.EndsAt, wantEnd)
+			}
+			if saved.BillingAnchor == nil || saved.BillingAnchor.Sub(periodStart).Abs() > time.Minute {
+				t.Errorf("billing_anchor = %v, want about %v", saved.BillingAnchor, periodStart)
+			}
+			if saved.Quantity != 1 || saved.PriceType != "flat_rate" || saved.UUID == "" {
+				t.Errorf("defaults not applied: quantity %d, price type %q, uuid %q", saved.Quantity, saved.PriceType, saved.UUID)
+			}
+		})
+	}
+}
+
+func TestCancelAtEndOfCycleAndResume(t *testing.T) {
+	service, repo := newTestSubscriptionService()
+	ctx := context.Background()
+	subscription := newTestSubscription(nil)
+	if err := service.Create(ctx, subscription); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	cancelled, err := service.Cancel(ctx, subscription.ID, true, "too expensive", "")
+	if err != nil {
+		t.Fatalf("Cancel() error = %v", err)
+	}
+	if cancelled.Status != domain.SubscriptionStatusActive || !cancelled.IsCanceledAtEndOfCycle {
+		t.Errorf("after Cancel: status %s, at end of cycle %v, want active, true", cancelled.Status, cancelled.IsCanceledAtEndOfCycle)
+	}
+
+	resumed, err := service.Resume(ctx, subscription.ID)
+	if err != nil {
+		t.Fatalf("Resume() error = %v", err)
+	}
+	if resumed.IsCanceledAtEndOfCycle || resumed.CancellationReason != nil {
+		t.Errorf("after Resume: at end of cycle %v, reason %v, want the cancellation revoked", resumed.IsCanceledAtEndOfCycle, resumed.CancellationReason)
+	}
+
+	want := []string{"created", "cancellation_scheduled", "cancellation_revoked"}
+	if len(repo.reasons) != len(want) {
+		t.Fatalf("versions = %v, want %v", repo.reasons, want)
+	}
+	for i := range want {
+		if repo.reasons[i] != want[i] {
+			t.Errorf("versions = %v, want %v", repo.reasons, want)
+			break
+		}
+	}
+}
+
+func TestResumeCancelledSubscription(t *testing.T) {
+	tests := []struct {
+		name    string
+		endsAt  time.Time
+		wantErr bool
+	}{
+		{"within paid period", time.Now().Add(24 * time.Hour), false},
+		{"after period end", time.Now().Add(-24 * time.Hour), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, repo := newTestSubscriptionService()
+			reason := "no longer needed"
+			cancelledAt := time.Now().Add(-time.Hour)
+			repo.rows[1] = &domain.Subscription{
+				ID:                 1,
+				Status:             domain.SubscriptionStatusCancelled,
+				EndsAt:             &tt.endsAt,
+				CancelledAt:        &cancelledAt,
+				CancellationReason: &reason,
+			}
+
+			resumed, err := service.Resume(context.Background(), 1)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Resume() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if repo.rows[1].Status != domain.SubscriptionStatusCancelled {
+					t.Errorf("status = %s, want the subscription left cancelled", repo.rows[1].Status)
+				}
+				return
+			}
+			if resumed.Status != domain.SubscriptionStatusActive || resumed.CancelledAt != nil {
+				t.Errorf("status %s, cancelled at %v, want active, nil", resumed.Status, resumed.CancelledAt)
+			}
+			if repo.reasons[0] != "cancelled -> active: resumed" {
+				t.Errorf("version reason = %q", repo.reasons[0])
+			}
+		})
+	}
+}
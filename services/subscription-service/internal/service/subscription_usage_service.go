@@ -0,0 +1,192 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/damarteplok/damar-admin-cms/services/subscription-service/internal/domain"
+	"github.com/damarteplok/damar-admin-cms/shared/pricing"
+	"github.com/damarteplok/damar-admin-cms/shared/util"
+)
+
+// usageClockSkew is how far in the future a client-supplied usage timestamp
+// may be before it is rejected
+const usageClockSkew = 5 * time.Minute
+
+type subscriptionUsageService struct {
+	repo             domain.SubscriptionUsageRepository
+	subscriptionRepo domain.SubscriptionRepository
+	planRepo         domain.PlanRepository
+	intervalRepo     domain.IntervalRepository
+}
+
+func NewSubscriptionUsageService(
+	repo domain.SubscriptionUsageRepository,
+	subscriptionRepo domain.SubscriptionRepository,
+	planRepo domain.PlanRepository,
+	intervalRepo domain.IntervalRepository,
+) domain.SubscriptionUsageService {
+	return &subscriptionUsageService{
+		repo:             repo,
+		subscriptionRepo: subscriptionRepo,
+		planRepo:         planRepo,
+		intervalRepo:     intervalRepo,
+	}
+}
+
+func (s *subscriptionUsageService) RecordUsage(ctx context.Context, subscriptionID int64, unitCount int32, idempotencyKey string, recordedAt *time.Time) (*domain.SubscriptionUsage, bool, error) {
+	if subscriptionID <= 0 {
+		return nil, false, errors.New("invalid subscription ID")
+	}
+	if unitCount <= 0 {
+		return nil, false, errors.New("unit count must be greater than 0")
+	}
+
+	subscription, err := s.subscriptionRepo.GetByID(ctx, subscriptionID)
+	if err != nil {
+		return nil, false, err
+	}
+	if subscription == nil {
+		return nil, false, errors.New("subscription not found")
+	}
+	if subscription.Status == domain.SubscriptionStatusCancelled || subscription.Status == domain.SubscriptionStatusExpired {
+		return nil, false, errors.New("cannot record usage for an inactive subscription")
+	}
+
+	plan, err := s.planRepo.GetByID(ctx, subscription.PlanID)
+	if err != nil {
+		return nil, false, err
+	}
+	if plan.MeterID == nil {
+		return nil, false, errors.New("subscription plan is not metered")
+	}
+
+	now := time.Now()
+	at := now
+	if recordedAt != nil {
+		at = *recordedAt
+	}
+	if at.After(now.Add(usageClockSkew)) {
+		return nil, false, errors.New("usage timestamp is in the future")
+	}
+	if at.Before(subscription.CreatedAt) {
+		return nil, false, errors.New("usage timestamp is before the subscription started")
+	}
+	// Earlier periods may already be invoiced, so late usage would never be billed
+	if subscription.EndsAt != nil {
+		periodStart, _, err := s.currentPeriod(ctx, subscription)
+		if err != nil {
+			return nil, false, err
+		}
+		if at.Before(periodStart) {
+			return nil, false, errors.New("usage timestamp is before the current billing period")
+		}
+	}
+
+	usage := &domain.SubscriptionUsage{
+		SubscriptionID: subscriptionID,
+		MeterID:        plan.MeterID,
+		UnitCount:      unitCount,
+		IdempotencyKey: util.StringPtr(idempotencyKey),
+		RecordedAt:     at,
+	}
+	created, err := s.repo.Create(ctx, usage)
+	if err != nil {
+		return nil, false, err
+	}
+	if !created && usage.UnitCount != unitCount {
+		return nil, false, errors.New("idempotency key was already used with a different unit count")
+	}
+
+	return usage, created, nil
+}
+
+func (s *subscriptionUsageService) GetBySubscription(ctx context.Context, subscriptionID int64, page, perPage int) ([]*domain.SubscriptionUsage, int, error) {
+	if subscriptionID <= 0 {
+		return nil, 0, errors.New("invalid subscription ID")
+	}
+	page, perPage = normalizePagination(page, perPage)
+	return s.repo.GetBySubscription(ctx, subscriptionID, page, perPage)
+}
+
+func (s *subscriptionUsageService) GetUsageSummary(ctx context.Context, subscriptionID int64, from, to *time.Time) ([]*domain.UsageSummary, time.Time, time.Time, error) {
+	if subscriptionID <= 0 {
+		return nil, time.Time{}, time.Time{}, errors.New("invalid subscription ID")
+	}
+	if (from == nil) != (to == nil) {
+		return nil, time.Time{}, time.Time{}, errors.New("period start and end must be given together")
+	}
+
+	subscription, err := s.subscriptionRepo.GetByID(ctx, subscriptionID)
+	if err != nil {
+		return nil, time.Time{}, time.Time{}, err
+	}
+	if subscription == nil {
+		return nil, time.Time{}, time.Time{}, errors.New("subscription not found")
+	}
+
+	var periodStart, periodEnd time.Time
+	if from != nil {
+		periodStart, periodEnd = *from, *to
+		if !periodEnd.After(periodStart) {
+			return nil, time.Time{}, time.Time{}, errors.New("period end must be after period start")
+		}
+	} else {
+		if subscription.EndsAt == nil {
+			return nil, time.Time{}, time.Time{}, errors.New("subscription has no current billing period")
+		}
+		periodStart, periodEnd, err = s.currentPeriod(ctx, subscription)
+		if err != nil {
+			return nil, time.Time{}, time.Time{}, err
+		}
+	}
+
+	summaries, err := s.repo.Summarize(ctx, subscriptionID, periodStart, periodEnd)
+	if err != nil {
+		return nil, time.Time{}, time.Time{}, err
+	}
+
+	// The plan's meter is always listed, so customers see a zero rather than
+	// nothing at the start of a period
+	plan, err := s.planRepo.GetByID(ctx, subscription.PlanID)
+	if err != nil {
+		return nil, time.Time{}, time.Time{}, err
+	}
+	if plan.MeterID != nil && !hasMeterSummary(summaries, *plan.MeterID) {
+		meter, err := s.repo.GetMeter(ctx, *plan.MeterID)
+		if err != nil {
+			return nil, time.Time{}, time.Time{}, err
+		}
+		summaries = append(summaries, &domain.UsageSummary{
+			MeterID:     meter.ID,
+			MeterName:   meter.Name,
+			Aggregation: meter.Aggregation,
+		})
+	}
+
+	return summaries, periodStart, periodEnd, nil
+}
+
+// currentPeriod returns the billing period ending at the subscription's
+// ends_at. The caller must check that EndsAt is set.
+func (s *subscriptionUsageService) currentPeriod(ctx context.Context, subscription *domain.Subscription) (time.Time, time.Time, error) {
+	interval, err := s.intervalRepo.GetByID(ctx, subscription.IntervalID)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	start, err := pricing.PeriodStart(billingAnchor(subscription), interval.Slug, subscription.IntervalCount, *subscription.EndsAt)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	return start, *subscription.EndsAt, nil
+}
+
+func hasMeterSummary(summaries []*domain.UsageSummary, meterID int64) bool {
+	for _, summary := range summaries {
+		if summary.MeterID == meterID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/damarteplok/damar-admin-cms/services/subscription-service/internal/domain"
+	"github.com/damarteplok/damar-admin-cms/shared/pricing"
+)
+
+func (f *fakeSubscriptions) GetByID(ctx context.Context, id int64) (*domain.Subscription, error) {
+	return f.rows[id], nil
+}
+
+type fakePlans struct {
+	domain.PlanRepository
+	meterID *int64
+}
+
+func (f fakePlans) GetByID(ctx context.Context, id int64) (*domain.Plan, error) {
+	return &domain.Plan{ID: id, MeterID: f.meterID}, nil
+}
+
+// fakeUsages keeps usage by idempotency key
+type fakeUsages struct {
+	domain.SubscriptionUsageRepository
+	byKey map[string]*domain.SubscriptionUsage
+}
+
+func (f *fakeUsages) Create(ctx context.Context, usage *domain.SubscriptionUsage) (bool, error) {
+	if existing, ok := f.byKey[*usage.IdempotencyKey]; ok {
+		*usage = *existing
+		return false, nil
+	}
+	saved := *usage
+	f.byKey[*usage.IdempotencyKey] = &saved
+	return true, nil
+}
+
+func (f *fakeUsages) Summarize(ctx context.Context, subscriptionID int64, from, to time.Time) ([]*domain.UsageSummary, error) {
+	return nil, nil
+}
+
+func (f *fakeUsages) GetMeter(ctx context.Context, id int64) (*domain.PlanMeter, error) {
+	return &domain.PlanMeter{ID: id, Name: "API calls", Aggregation: pricing.AggregationSum}, nil
+}
+
+// newTestUsageService returns a usage service for a monthly subscription in
+// the middle of its current period
+func newTestUsageService(meterID *int64) (*subscriptionUsageService, *fakeUsages, time.Time) {
+	periodStart := time.Now().AddDate(0, 0, -10)
+	endsAt, _ := pricing.NextPeriodEnd(periodStart, "monthly", 1)
+	subscriptions := &fakeSubscriptions{rows: map[int64]*domain.Subscription{
+		1: {
+			ID:            1,
+			PlanID:        2,
+			IntervalID:    5,
+			IntervalCount: 1,
+			Status:        domain.SubscriptionStatusActive,
+			EndsAt:        &endsAt,
+			BillingAnchor: &periodStart,
+			CreatedAt:     periodStart.AddDate(0, -2, 0),
+		},
+	}}
+	usages := &fakeUsages{byKey: make(map[string]*domain.SubscriptionUsage)}
+
+	return &subscriptionUsageService{
+		repo:             usages,
+		subscriptionRepo: subscriptions,
+		planRepo:         fakePlans{meterID: meterID},
+		intervalRepo:     fakeIntervals{slug: "monthly"},
+	}, usages, periodStart
+}
+
+func TestRecordUsage(t *testing.T) {
+	meterID := int64(9)
+	_, _, periodStart := newTestUsageService(&meterID)
+
+	tests := []struct {
+		name       string
+		meterID    *int64
+		recordedAt *time.Time
+		wantErr    bool
+	}{
+		{"now", &meterID, nil, false},
+		{"inside the current period", &meterID, timePtr(periodStart.Add(time.Hour)), false},
+		{"plan without meter", nil, nil, true},
+		{"in the future", &meterID, timePtr(time.Now().Add(time.Hour)), true},
+		{"before the current period", &meterID, timePtr(periodStart.Add(-time.Hour)), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, usages, _ := newTestUsageService(tt.meterID)
+
+			usage, created, err := service.RecordUsage(context.Background(), 1, 3, "key-1", tt.recordedAt)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("RecordUsage() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if len(usages.byKey) != 0 {
+					t.Errorf("usage stored although it was rejected")
+				}
+				return
+			}
+			if !created || usage.MeterID == nil || *usage.MeterID != meterID {
+				t.Errorf("RecordUsage() = %+v, %v, want a new record for meter %d", usage, created, meterID)
+			}
+		})
+	}
+}
+
+func TestRecordUsageIdempotency(t *testing.T) {
+	meterID := int64(9)
+	service, _, _ := newTestUsageService(&meterID)
+	ctx := context.Background()
+
+	if _, created, err := service.RecordUsage(ctx, 1, 3, "key-1", nil); err != nil || !created {
+		t.Fatalf("RecordUsage() = %v, %v, want created", created, err)
+	}
+	if _, created, err := service.RecordUsage(ctx, 1, 3, "key-1", nil); err != nil || created {
+		t.Errorf("RecordUsage() retry = %v, %v, want the first record", created, err)
+	}
+	if _, _, err := service.RecordUsage(ctx, 1, 4, "key-1", nil); err == nil {
+		t.Error("RecordUsage() reused a key with another unit count")
+	}
+}
+
+func TestGetUsageSummaryListsPlanMeter(t *testing.T) {
+	meterID := int64(9)
+	service, _, periodStart := newTestUsageService(&meterID)
+
+	summaries, start, end, err := service.GetUsageSummary(context.Background(), 1, nil, nil)
+	if err != nil {
+		t.Fatalf("GetUsageSummary() error = %v", err)
+	}
+	if !start.Equal(periodStart) || !end.After(start) {
+		t.Errorf("period = %v - %v, want the current period from %v", start, end, periodStart)
+	}
+	if len(summaries) != 1 || summaries[0].MeterID != meterID || summaries[0].Quantity != 0 {
+		t.Errorf("summaries = %+v, want a zero summary for meter %d", summaries, meterID)
+	}
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
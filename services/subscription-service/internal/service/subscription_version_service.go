@@ -0,0 +1,25 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/damarteplok/damar-admin-cms/services/subscription-service/internal/domain"
+)
+
+type subscriptionVersionService struct {
+	repo domain.SubscriptionVersionRepository
+}
+
+func NewSubscriptionVersionService(repo domain.SubscriptionVersionRepository) domain.SubscriptionVersionService {
+	return &subscriptionVersionService{repo: repo}
+}
+
+func (s *subscriptionVersionService) GetHistory(ctx context.Context, subscriptionID int64, page, perPage int) ([]*domain.SubscriptionVersion, int, error) {
+	if subscriptionID <= 0 {
+		return nil, 0, errors.New("invalid subscription ID")
+	}
+	page, perPage = normalizePagination(page, perPage)
+	return s.repo.GetByVersionable(ctx, domain.VersionableTypeSubscription, fmt.Sprintf("%d", subscriptionID), page, perPage)
+}
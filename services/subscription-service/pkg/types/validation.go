@@ -0,0 +1,82 @@
+package types
+
+// IDValidation validates ID parameters
+type IDValidation struct {
+	ID int64 `validate:"required,gt=0"`
+}
+
+// UUIDValidation validates UUID parameters
+type UUIDValidation struct {
+	UUID string `validate:"required,uuid"`
+}
+
+// PaginationValidation validates pagination parameters
+type PaginationValidation struct {
+	Page    int32 `validate:"omitempty,gte=1"`
+	PerPage int32 `validate:"omitempty,gte=1,lte=100"`
+}
+
+// Subscription validation
+type CreateSubscriptionValidation struct {
+	UserID        int64  `validate:"required,gt=0"`
+	PlanID        int64  `validate:"required,gt=0"`
+	TenantID      int64  `validate:"required,gt=0"`
+	CurrencyID    int64  `validate:"required,gt=0"`
+	IntervalID    int64  `validate:"required,gt=0"`
+	IntervalCount int32  `validate:"gte=0"`
+	Price         int32  `validate:"gte=0"`
+	Quantity      int32  `validate:"gte=0"`
+	PriceType     string `validate:"omitempty,oneof=flat_rate per_unit tiered"`
+	Type          string `validate:"omitempty,oneof=payment_provider_managed manual"`
+}
+
+type UpdateSubscriptionValidation struct {
+	ID       int64  `validate:"required,gt=0"`
+	Price    int32  `validate:"gte=0"`
+	Quantity int32  `validate:"gte=0"`
+	Status   string `validate:"omitempty,oneof=trialing active past_due paused cancelled expired"`
+}
+
+type CancelSubscriptionValidation struct {
+	ID                 int64  `validate:"required,gt=0"`
+	CancellationReason string `validate:"max=255"`
+}
+
+type PauseSubscriptionValidation struct {
+	ID     int64  `validate:"required,gt=0"`
+	Reason string `validate:"max=255"`
+}
+
+type RenewSubscriptionValidation struct {
+	ID     int64 `validate:"required,gt=0"`
+	EndsAt int64 `validate:"required,gt=0"`
+}
+
+type ChangePlanValidation struct {
+	ID       int64  `validate:"required,gt=0"`
+	PlanID   int64  `validate:"required,gt=0"`
+	Quantity int32  `validate:"gte=0"`
+	Mode     string `validate:"omitempty,oneof=now period_end"`
+}
+
+// Subscription Usage validation
+type RecordUsageValidation struct {
+	SubscriptionID int64  `validate:"required,gt=0"`
+	UnitCount      int32  `validate:"required,gt=0"`
+	IdempotencyKey string `validate:"max=255"`
+	Timestamp      int64  `validate:"omitempty,gt=0"`
+}
+
+type GetUsageSummaryValidation struct {
+	SubscriptionID int64 `validate:"required,gt=0"`
+	PeriodStart    int64 `validate:"omitempty,gt=0"`
+	PeriodEnd      int64 `validate:"omitempty,gt=0"`
+}
+
+// Subscription Discount validation
+type AddDiscountValidation struct {
+	SubscriptionID int64   `validate:"required,gt=0"`
+	DiscountID     int64   `validate:"required,gt=0"`
+	Type           string  `validate:"required,oneof=percentage fixed"`
+	Amount         float64 `validate:"required,gt=0"`
+}
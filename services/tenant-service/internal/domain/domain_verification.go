@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// DomainVerificationPrefix is the DNS label in front of a custom domain that
+// holds its verification TXT record, and the prefix of the record's value
+const DomainVerificationPrefix = "_damar-verification"
+
+// DomainVerification proves that a tenant controls its custom domain. The
+// tenant publishes RecordValue as a TXT record at RecordName.
+type DomainVerification struct {
+	ID            int64
+	TenantID      int64
+	Domain        string
+	Token         string
+	VerifiedAt    *time.Time
+	LastCheckedAt *time.Time
+	LastError     *string
+	CreatedAt     *time.Time
+	UpdatedAt     *time.Time
+}
+
+// IsVerified reports whether the TXT record has been found
+func (v *DomainVerification) IsVerified() bool {
+	return v.VerifiedAt != nil
+}
+
+// RecordName is the DNS name the TXT record goes on
+func (v *DomainVerification) RecordName() string {
+	return DomainVerificationPrefix + "." + v.Domain
+}
+
+// RecordValue is the TXT record value that proves ownership
+func (v *DomainVerification) RecordValue() string {
+	return DomainVerificationPrefix + "=" + v.Token
+}
+
+// TXTResolver looks up DNS TXT records. *net.Resolver satisfies it; tests
+// and local setups can plug in their own.
+type TXTResolver interface {
+	LookupTXT(ctx context.Context, name string) ([]string, error)
+}
+
+// DomainVerificationRepository defines the interface for domain verification
+// data access
+type DomainVerificationRepository interface {
+	GetByTenant(ctx context.Context, tenantID int64) (*DomainVerification, error) // nil if none
+	// Upsert starts a new verification of the tenant's domain, replacing any
+	// earlier one
+	Upsert(ctx context.Context, verification *DomainVerification) (*DomainVerification, error)
+	// RecordCheck stores the outcome of a DNS check. A nil checkErr marks the
+	// domain verified; a failed check clears any earlier verification.
+	RecordCheck(ctx context.Context, tenantID int64, checkErr *string) (*DomainVerification, error)
+	// GetVerifiedTenantID returns the tenant whose current domain is domain
+	// and verified, or 0
+	GetVerifiedTenantID(ctx context.Context, domain string) (int64, error)
+}
+
+// TenantDomainService defines business logic for custom domains
+type TenantDomainService interface {
+	// GetVerification returns the verification of the tenant's current
+	// domain, starting one when there is none
+	GetVerification(ctx context.Context, tenantID int64) (*DomainVerification, error)
+	// Verify looks up the TXT record and records the outcome
+	Verify(ctx context.Context, tenantID int64) (*DomainVerification, error)
+	// ResolveHost returns the tenant that verified host, ignoring any port
+	ResolveHost(ctx context.Context, host string) (*Tenant, error)
+}
@@ -0,0 +1,97 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// Invitation statuses. An expired invitation keeps the pending status; see
+// TenantInvitation.IsExpired.
+const (
+	InvitationStatusPending  = "pending"
+	InvitationStatusAccepted = "accepted"
+	InvitationStatusDeclined = "declined"
+	InvitationStatusRevoked  = "revoked"
+)
+
+// TenantInvitation invites an email address to join a tenant. Its member row
+// in tenant_user has no user_id until the invitee accepts with an account.
+type TenantInvitation struct {
+	ID           int64
+	UUID         string
+	TenantID     int64
+	TenantUserID *int64
+	Email        string
+	Role         string
+	Status       string
+	TokenHash    string
+	InvitedBy    *int64
+	AcceptedBy   *int64 // nil after acceptance until the invitee registers
+	ExpiresAt    time.Time
+	SentCount    int32
+	LastSentAt   time.Time
+	AcceptedAt   *time.Time
+	DeclinedAt   *time.Time
+	RevokedAt    *time.Time
+	CreatedAt    *time.Time
+	UpdatedAt    *time.Time
+}
+
+// IsExpired reports whether a pending invitation can no longer be accepted
+func (i *TenantInvitation) IsExpired(now time.Time) bool {
+	return i.Status == InvitationStatusPending && !now.Before(i.ExpiresAt)
+}
+
+// InviteUserParams holds the input of TenantInvitationService.Invite
+type InviteUserParams struct {
+	TenantID  int64
+	Email     string
+	Role      string
+	InvitedBy *int64
+}
+
+// TenantInvitationRepository defines the interface for invitation data access
+type TenantInvitationRepository interface {
+	GetByID(ctx context.Context, id int64) (*TenantInvitation, error)                        // nil if none
+	GetByUUID(ctx context.Context, uuid string) (*TenantInvitation, error)                   // nil if none
+	GetPending(ctx context.Context, tenantID int64, email string) (*TenantInvitation, error) // nil if none
+	GetByTenant(ctx context.Context, tenantID int64, status string) ([]*TenantInvitation, error)
+	// GetUnlinkedByEmail returns accepted invitations for email that wait for
+	// the invitee to register
+	GetUnlinkedByEmail(ctx context.Context, email string) ([]*TenantInvitation, error)
+	// Create inserts the invitation together with its pending tenant_user
+	// row. checkSeat, if any, runs first with the tenant locked.
+	Create(ctx context.Context, invitation *TenantInvitation, checkSeat SeatCheck) (*TenantInvitation, error)
+	// Resend replaces the token hash and expiry and counts the send.
+	// checkSeat, if any, runs first with the tenant locked.
+	Resend(ctx context.Context, id int64, tokenHash string, expiresAt time.Time, checkSeat SeatCheck) (*TenantInvitation, error)
+	// Accept marks the invitation accepted. With a user ID it also links the
+	// pending tenant_user row to that user.
+	Accept(ctx context.Context, id int64, userID *int64) (*TenantInvitation, error)
+	// Link sets the user of an accepted invitation and its tenant_user row
+	Link(ctx context.Context, id, userID int64) (*TenantInvitation, error)
+	// Close marks the invitation declined or revoked and deletes its pending
+	// tenant_user row
+	Close(ctx context.Context, id int64, status string) (*TenantInvitation, error)
+}
+
+// TenantInvitationService defines business logic for tenant invitations.
+// Tokens are signed and expire; only the latest token of an invitation is
+// valid.
+type TenantInvitationService interface {
+	Invite(ctx context.Context, params InviteUserParams) (*TenantInvitation, error)
+	GetByTenant(ctx context.Context, tenantID int64, status string) ([]*TenantInvitation, error)
+	// GetByToken returns the invitation a token belongs to, for the accept page
+	GetByToken(ctx context.Context, token string) (*TenantInvitation, error)
+	// Resend and Revoke only match invitations of tenantID; zero matches any
+	Resend(ctx context.Context, tenantID, id int64) (*TenantInvitation, error)
+	Revoke(ctx context.Context, tenantID, id int64) (*TenantInvitation, error)
+	// Accept accepts an invitation. userID and email identify the signed-in
+	// invitee; with a zero userID the invitation is linked when an account
+	// with the invited email registers.
+	Accept(ctx context.Context, token string, userID int64, email string) (*TenantInvitation, error)
+	Decline(ctx context.Context, token string) (*TenantInvitation, error)
+	// LinkRegisteredUser links accepted invitations for email to a user who
+	// just registered
+	LinkRegisteredUser(ctx context.Context, userID int64, email string) ([]*TenantInvitation, error)
+}
@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrRestorePeriodExpired is returned when restoring a tenant deleted before
+// the grace period
+var ErrRestorePeriodExpired = errors.New("tenant can no longer be restored; its grace period has ended")
+
+// ErrTenantNotReleased is returned when purging a tenant whose subscriptions
+// or dunning runs have not been cleaned up by their services yet
+var ErrTenantNotReleased = errors.New("tenant has not been released by subscription-service and billing-service")
+
+// Services that clean up their own data of a tenant before it is purged.
+// Each answers tenant.event.purge_requested with a released event.
+const (
+	ReleasedBySubscriptions = "subscriptions" // subscription.event.tenant_released
+	ReleasedByBilling       = "billing"       // dunning.event.tenant_released
+)
+
+// MediaModelTenant is the media-service model_type of files attached to a tenant
+const MediaModelTenant = "tenant"
+
+// TenantMedia deletes files stored by media-service
+type TenantMedia interface {
+	DeleteModelFiles(ctx context.Context, modelType string, modelID int64) (int64, error)
+}
+
+// TenantLifecycleService soft-deletes tenants, restores them within the
+// grace period and purges them once it has passed. Purging hard-deletes the
+// tenant with its settings, members, media and subscriptions; invoices and
+// dunning runs are kept without a tenant. Media is deleted first. Then
+// subscription-service and billing-service are asked to clean up their data
+// of the tenant, and the row goes once both have released it. A tenant that
+// is not done is left for the next run.
+type TenantLifecycleService interface {
+	Delete(ctx context.Context, id int64) error
+	Restore(ctx context.Context, id int64) (*Tenant, error)
+	// PurgeExpired purges up to limit tenants and returns how many
+	PurgeExpired(ctx context.Context, limit int) (int, error)
+	// MarkReleased records that the service named by by (ReleasedBy*) has
+	// cleaned up its data of the deleted tenant
+	MarkReleased(ctx context.Context, id int64, by string) error
+}
+
+// TenantLifecycleRepository reads and changes soft-deleted tenants
+type TenantLifecycleRepository interface {
+	GetDeletedByID(ctx context.Context, id int64) (*Tenant, error) // nil if none
+	// Restore undeletes the tenant if it was deleted after deletedAfter.
+	// It returns nil when there is no such tenant.
+	Restore(ctx context.Context, id int64, deletedAfter time.Time) (*Tenant, error)
+	GetDeletedBefore(ctx context.Context, deletedBefore time.Time, limit int) ([]*Tenant, error)
+	// Purge hard-deletes the tenant if it is still deleted before
+	// deletedBefore. It returns nil when the tenant was restored, already
+	// purged or is being purged by another replica, and ErrTenantNotReleased
+	// while a service has not released it.
+	Purge(ctx context.Context, id int64, deletedBefore time.Time) (*Tenant, error)
+	// MarkReleased records the release of a deleted tenant by a service
+	MarkReleased(ctx context.Context, id int64, by string) error
+}
@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// Ownership change actions
+const (
+	OwnershipActionTransferred = "transferred"
+	OwnershipActionGranted     = "granted"
+	OwnershipActionRevoked     = "revoked"
+)
+
+// ErrLastOwner is returned when a change would leave a tenant without an owner
+var ErrLastOwner = errors.New("tenant must keep at least one owner; transfer ownership first")
+
+// OwnerCheck returns ErrLastOwner when a change would leave no owner among
+// owners. Repositories run it on the owners read with the tenant locked, so
+// two concurrent changes cannot both remove the last owner.
+type OwnerCheck func(owners []int64) error
+
+// OwnershipChange is an audit record of a change to a tenant's owners.
+// FromUserID lost ownership and ToUserID gained it; a grant has no
+// FromUserID and a revocation no ToUserID.
+type OwnershipChange struct {
+	ID          int64
+	TenantID    int64
+	Action      string
+	FromUserID  *int64
+	ToUserID    *int64
+	PerformedBy *int64
+	CreatedAt   *time.Time
+}
+
+// TransferOwnershipParams holds the input of TenantOwnershipService.Transfer
+type TransferOwnershipParams struct {
+	TenantID    int64
+	FromUserID  int64
+	ToUserID    int64
+	PerformedBy *int64
+}
+
+// UserContact is who ownership emails are sent to
+type UserContact struct {
+	UserID int64
+	Name   string
+	Email  string
+}
+
+// UserRepository reads users, owned by user-service
+type UserRepository interface {
+	GetContact(ctx context.Context, id int64) (*UserContact, error)
+}
+
+// TenantOwnershipService transfers tenant ownership and reads the audit
+// trail. Both parties of a transfer are emailed.
+type TenantOwnershipService interface {
+	Transfer(ctx context.Context, params TransferOwnershipParams) (*OwnershipChange, error)
+	GetChanges(ctx context.Context, tenantID int64) ([]*OwnershipChange, error)
+}
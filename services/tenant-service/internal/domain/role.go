@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// TenantRole is a role and the permissions it grants. Built-in roles have no
+// ID and are the same in every tenant; custom roles belong to one tenant.
+type TenantRole struct {
+	ID          int64
+	TenantID    int64
+	Name        string
+	Description *string
+	Permissions []string
+	IsBuiltin   bool
+	CreatedAt   *time.Time
+	UpdatedAt   *time.Time
+}
+
+// Authorization is the outcome of an Authorize check
+type Authorization struct {
+	Allowed bool
+	Role    string // the member's role; empty when the user is not a member
+	Reason  string // why the check was denied
+}
+
+// RoleChange is a change to roles checked by AuthorizeRoleChange. Only the
+// fields the change involves are set.
+type RoleChange struct {
+	UserID       int64 // the member making the change
+	TenantID     int64
+	Role         string   // role granted to a member or invitee
+	Permissions  []string // permissions a custom role is defined with
+	RoleID       int64    // custom role being redefined
+	TargetUserID int64    // member whose role is changed or who is removed
+}
+
+// TenantRoleRepository defines the interface for custom role data access
+type TenantRoleRepository interface {
+	GetByID(ctx context.Context, id int64) (*TenantRole, error)                      // nil if none
+	GetByName(ctx context.Context, tenantID int64, name string) (*TenantRole, error) // nil if none
+	GetByTenant(ctx context.Context, tenantID int64) ([]*TenantRole, error)
+	Create(ctx context.Context, role *TenantRole) (*TenantRole, error)
+	Update(ctx context.Context, role *TenantRole) (*TenantRole, error)
+	Delete(ctx context.Context, id int64) error
+	// CountMembers counts tenant_user rows with the role, including members
+	// invited with it
+	CountMembers(ctx context.Context, tenantID int64, name string) (int64, error)
+}
+
+// TenantRoleService defines business logic for tenant roles and permission
+// checks
+type TenantRoleService interface {
+	// GetRoles returns the built-in roles followed by the tenant's custom roles
+	GetRoles(ctx context.Context, tenantID int64) ([]*TenantRole, error)
+	CreateRole(ctx context.Context, role *TenantRole) (*TenantRole, error)
+	// UpdateRole changes the description and permissions of a custom role.
+	// Roles cannot be renamed because members reference them by name.
+	UpdateRole(ctx context.Context, role *TenantRole) (*TenantRole, error)
+	// DeleteRole deletes a custom role that no member has
+	DeleteRole(ctx context.Context, tenantID, id int64) error
+	// ValidateRole returns an error unless role is a built-in role or a
+	// custom role of the tenant
+	ValidateRole(ctx context.Context, tenantID int64, role string) error
+	// Authorize reports whether a member's role in a tenant grants permission
+	Authorize(ctx context.Context, userID, tenantID int64, permission string) (*Authorization, error)
+	// AuthorizeRoleChange denies changes that grant, redefine or take away
+	// permissions the member's own role does not grant, so nobody can
+	// escalate past their role or act on members above it
+	AuthorizeRoleChange(ctx context.Context, change RoleChange) (*Authorization, error)
+}
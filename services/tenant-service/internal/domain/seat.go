@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+)
+
+// SeatUsage is how many of a tenant's seats are taken. Every member takes
+// one seat, and so does every open or accepted invitation.
+type SeatUsage struct {
+	TenantID       int64
+	Used           int64
+	Limit          int64 // 0 when Unlimited
+	Unlimited      bool
+	Remaining      int64
+	SubscriptionID int64 // 0 when the tenant has no active subscription
+	Enforced       bool  // false when seat limits are turned off
+}
+
+// SeatLimitError is returned when a user cannot be added because the
+// tenant has no free seat
+type SeatLimitError struct {
+	TenantID int64
+	Used     int64
+	Limit    int64
+}
+
+func (e *SeatLimitError) Error() string {
+	return fmt.Sprintf("seat limit reached: %d of %d seats in use", e.Used, e.Limit)
+}
+
+// SeatLimit is the seat limit of a tenant's plan
+type SeatLimit struct {
+	Limit          int64
+	Unlimited      bool
+	SubscriptionID int64 // 0 when the tenant has no active subscription
+}
+
+// SeatCheck returns a *SeatLimitError when used seats leave no room for one
+// more. Repositories run it with the tenant row locked, on a count taken in
+// the transaction that takes the seat, so two concurrent adds cannot both
+// get the last seat.
+type SeatCheck func(used int64) error
+
+// SeatPolicy applies seat limits when members are added or invited
+type SeatPolicy interface {
+	Usage(ctx context.Context, tenantID int64) (*SeatUsage, error)
+	// Check reads the tenant's seat limit and returns the check for taking
+	// one more seat, or nil when seats are unlimited
+	Check(ctx context.Context, tenantID int64) (SeatCheck, error)
+}
+
+// SeatEntitlements reads a tenant's seat limit from its active subscription
+// through subscription-service
+type SeatEntitlements interface {
+	GetSeatLimit(ctx context.Context, tenantID, used int64) (*SeatLimit, error)
+}
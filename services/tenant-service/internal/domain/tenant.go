@@ -25,7 +25,7 @@ type TenantUser struct {
 	ID        int64
 	UserID    int64
 	TenantID  int64
-	Role      string // owner, admin, member, guest
+	Role      string // owner, admin, member, guest or a custom role
 	IsDefault bool
 	Email     *string
 	CreatedAt *time.Time
@@ -55,13 +55,23 @@ type TenantRepository interface {
 	GetAll(ctx context.Context, page, perPage int, search, sortBy, sortOrder string) ([]*Tenant, int64, error)
 
 	// TenantUser operations
-	AddUserToTenant(ctx context.Context, tenantUser *TenantUser) (*TenantUser, error)
-	RemoveUserFromTenant(ctx context.Context, userID, tenantID int64) error
+	// AddUserToTenant runs checkSeat, if any, with the tenant locked before
+	// inserting the member
+	AddUserToTenant(ctx context.Context, tenantUser *TenantUser, checkSeat SeatCheck) (*TenantUser, error)
 	GetTenantUsers(ctx context.Context, tenantID int64) ([]*TenantUser, error)
 	GetUserTenants(ctx context.Context, userID int64) ([]*TenantUser, error)
 	GetUserTenantRole(ctx context.Context, userID, tenantID int64) (*TenantUser, error)
-	UpdateUserRole(ctx context.Context, userID, tenantID int64, role string) error
 	SetDefaultTenant(ctx context.Context, userID, tenantID int64) error
+	CountTenantUsers(ctx context.Context, tenantID int64) (int64, error)
+
+	// Ownership operations. Changes to a tenant's owners are serialized and
+	// recorded as OwnershipChanges. RemoveUserFromTenant and UpdateUserRole
+	// run checkOwners before changing the member.
+	RemoveUserFromTenant(ctx context.Context, userID, tenantID int64, performedBy *int64, checkOwners OwnerCheck) error
+	UpdateUserRole(ctx context.Context, userID, tenantID int64, role string, performedBy *int64, checkOwners OwnerCheck) error
+	// TransferOwnership makes toUserID an owner and fromUserID an admin
+	TransferOwnership(ctx context.Context, tenantID, fromUserID, toUserID int64, performedBy *int64) (*OwnershipChange, error)
+	GetOwnershipChanges(ctx context.Context, tenantID int64) ([]*OwnershipChange, error)
 
 	// TenantSetting operations
 	GetSetting(ctx context.Context, tenantID int64, key string) (*TenantSetting, error)
@@ -79,17 +89,19 @@ type TenantService interface {
 	GetTenantByDomain(ctx context.Context, domain string) (*Tenant, error)
 	CreateTenant(ctx context.Context, tenant *Tenant) (*Tenant, error)
 	UpdateTenant(ctx context.Context, tenant *Tenant) (*Tenant, error)
-	DeleteTenant(ctx context.Context, id int64) error
 	GetAllTenants(ctx context.Context, page, perPage int, search, sortBy, sortOrder string) ([]*Tenant, int64, error)
 
 	// TenantUser operations
 	AddUserToTenant(ctx context.Context, tenantUser *TenantUser) (*TenantUser, error)
-	RemoveUserFromTenant(ctx context.Context, userID, tenantID int64) error
+	// RemoveUserFromTenant and UpdateUserRole return ErrLastOwner instead of
+	// removing or demoting the tenant's last owner
+	RemoveUserFromTenant(ctx context.Context, userID, tenantID int64, performedBy *int64) error
 	GetTenantUsers(ctx context.Context, tenantID int64) ([]*TenantUser, error)
 	GetUserTenants(ctx context.Context, userID int64) ([]*TenantUser, error)
 	GetUserTenantRole(ctx context.Context, userID, tenantID int64) (*TenantUser, error)
-	UpdateUserRole(ctx context.Context, userID, tenantID int64, role string) error
+	UpdateUserRole(ctx context.Context, userID, tenantID int64, role string, performedBy *int64) error
 	SetDefaultTenant(ctx context.Context, userID, tenantID int64) error
+	GetSeatUsage(ctx context.Context, tenantID int64) (*SeatUsage, error)
 
 	// TenantSetting operations
 	GetSetting(ctx context.Context, tenantID int64, key string) (*TenantSetting, error)
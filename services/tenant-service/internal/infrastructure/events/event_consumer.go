@@ -0,0 +1,133 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/damarteplok/damar-admin-cms/services/tenant-service/internal/domain"
+	"github.com/damarteplok/damar-admin-cms/shared/amqp"
+	"github.com/damarteplok/damar-admin-cms/shared/contracts"
+	"github.com/damarteplok/damar-admin-cms/shared/logger"
+	"go.uber.org/zap"
+)
+
+type EventConsumer struct {
+	conn              *amqp.Connection
+	invitationService domain.TenantInvitationService
+	lifecycleService  domain.TenantLifecycleService
+}
+
+func NewEventConsumer(conn *amqp.Connection, invitationService domain.TenantInvitationService, lifecycleService domain.TenantLifecycleService) *EventConsumer {
+	return &EventConsumer{
+		conn:              conn,
+		invitationService: invitationService,
+		lifecycleService:  lifecycleService,
+	}
+}
+
+// ConsumeUserRegistered links invitations that were accepted before the
+// invitee had an account
+func (ec *EventConsumer) ConsumeUserRegistered(ctx context.Context) error {
+	consumer, err := amqp.NewConsumer(
+		ec.conn,
+		"tenant.user.registered",
+		"damar.events",
+		contracts.UserEventRegistered,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create consumer: %w", err)
+	}
+
+	logger.Info("Started consuming user.registered events")
+
+	return consumer.Consume(func(body []byte) error {
+		var message contracts.AmqpMessage
+		if err := json.Unmarshal(body, &message); err != nil {
+			logger.Error("Failed to unmarshal user.registered message", zap.Error(err))
+			return err
+		}
+
+		var eventData struct {
+			UserID int64  `json:"user_id"`
+			Email  string `json:"email"`
+		}
+		if err := json.Unmarshal(message.Data, &eventData); err != nil {
+			logger.Error("Failed to unmarshal event data", zap.Error(err))
+			return err
+		}
+
+		if eventData.UserID <= 0 || eventData.Email == "" {
+			return nil
+		}
+
+		linked, err := ec.invitationService.LinkRegisteredUser(ctx, eventData.UserID, eventData.Email)
+		if err != nil {
+			logger.Error("Failed to link tenant invitations",
+				zap.Int64("user_id", eventData.UserID),
+				zap.Error(err))
+			return err
+		}
+		for _, invitation := range linked {
+			logger.Info("Linked accepted tenant invitation",
+				zap.Int64("invitation_id", invitation.ID),
+				zap.Int64("tenant_id", invitation.TenantID),
+				zap.Int64("user_id", eventData.UserID))
+		}
+
+		return nil
+	})
+}
+
+// ConsumeSubscriptionsReleased records that subscription-service has
+// cancelled the subscriptions of a tenant waiting to be purged
+func (ec *EventConsumer) ConsumeSubscriptionsReleased(ctx context.Context) error {
+	return ec.consumeTenantReleased(ctx, "tenant.subscription.tenant_released", contracts.SubscriptionEventTenantReleased, domain.ReleasedBySubscriptions)
+}
+
+// ConsumeDunningReleased records that billing-service has closed the dunning
+// runs of a tenant waiting to be purged
+func (ec *EventConsumer) ConsumeDunningReleased(ctx context.Context) error {
+	return ec.consumeTenantReleased(ctx, "tenant.dunning.tenant_released", contracts.DunningEventTenantReleased, domain.ReleasedByBilling)
+}
+
+func (ec *EventConsumer) consumeTenantReleased(ctx context.Context, queue, routingKey, by string) error {
+	consumer, err := amqp.NewConsumer(ec.conn, queue, "damar.events", routingKey)
+	if err != nil {
+		return fmt.Errorf("failed to create consumer: %w", err)
+	}
+
+	logger.Info("Started consuming tenant release events", zap.String("routing_key", routingKey))
+
+	return consumer.Consume(func(body []byte) error {
+		var message contracts.AmqpMessage
+		if err := json.Unmarshal(body, &message); err != nil {
+			logger.Error("Failed to unmarshal tenant release message",
+				zap.String("routing_key", routingKey),
+				zap.Error(err))
+			return err
+		}
+
+		var eventData struct {
+			TenantID int64 `json:"tenant_id"`
+		}
+		if err := json.Unmarshal(message.Data, &eventData); err != nil {
+			logger.Error("Failed to unmarshal event data", zap.Error(err))
+			return err
+		}
+
+		if err := ec.lifecycleService.MarkReleased(ctx, eventData.TenantID, by); err != nil {
+			// The purge worker asks for the release again on its next run
+			logger.Error("Failed to record tenant release",
+				zap.String("routing_key", routingKey),
+				zap.Int64("tenant_id", eventData.TenantID),
+				zap.Error(err))
+			return nil
+		}
+
+		logger.Info("Tenant released for purge",
+			zap.String("released_by", by),
+			zap.Int64("tenant_id", eventData.TenantID))
+		return nil
+	})
+}
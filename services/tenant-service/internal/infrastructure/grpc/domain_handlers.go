@@ -0,0 +1,110 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/damarteplok/damar-admin-cms/services/tenant-service/internal/domain"
+	"github.com/damarteplok/damar-admin-cms/services/tenant-service/pkg/types"
+	pb "github.com/damarteplok/damar-admin-cms/shared/proto/tenant"
+	"github.com/damarteplok/damar-admin-cms/shared/util"
+	"github.com/damarteplok/damar-admin-cms/shared/validation"
+)
+
+// Custom domain operations
+
+func (s *TenantGRPCServer) GetTenantByDomain(ctx context.Context, req *pb.GetTenantByDomainRequest) (*pb.GetTenantByDomainResponse, error) {
+	tenant, err := s.domainService.ResolveHost(ctx, req.Domain)
+	if err != nil {
+		return &pb.GetTenantByDomainResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &pb.GetTenantByDomainResponse{
+		Success: true,
+		Message: "Tenant retrieved successfully",
+		Data:    domainTenantToPb(tenant),
+	}, nil
+}
+
+func (s *TenantGRPCServer) GetDomainVerification(ctx context.Context, req *pb.GetDomainVerificationRequest) (*pb.GetDomainVerificationResponse, error) {
+	ctx, err := scopeTenant(ctx, &req.TenantId)
+	if err != nil {
+		return &pb.GetDomainVerificationResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	if err := validation.ValidateStruct(&types.TenantIDValidation{ID: req.TenantId}); err != nil {
+		return &pb.GetDomainVerificationResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	verification, err := s.domainService.GetVerification(ctx, req.TenantId)
+	if err != nil {
+		return &pb.GetDomainVerificationResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &pb.GetDomainVerificationResponse{
+		Success: true,
+		Message: "Domain verification retrieved successfully",
+		Data:    domainVerificationToPb(verification),
+	}, nil
+}
+
+func (s *TenantGRPCServer) VerifyDomain(ctx context.Context, req *pb.VerifyDomainRequest) (*pb.VerifyDomainResponse, error) {
+	ctx, err := scopeTenant(ctx, &req.TenantId)
+	if err != nil {
+		return &pb.VerifyDomainResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	if err := validation.ValidateStruct(&types.TenantIDValidation{ID: req.TenantId}); err != nil {
+		return &pb.VerifyDomainResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	verification, err := s.domainService.Verify(ctx, req.TenantId)
+	if err != nil {
+		return &pb.VerifyDomainResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	message := "Domain verified successfully"
+	if !verification.IsVerified() {
+		message = "Domain not verified: " + util.StringValue(verification.LastError)
+	}
+
+	return &pb.VerifyDomainResponse{
+		Success: true,
+		Message: message,
+		Data:    domainVerificationToPb(verification),
+	}, nil
+}
+
+func domainVerificationToPb(verification *domain.DomainVerification) *pb.DomainVerification {
+	return &pb.DomainVerification{
+		TenantId:      verification.TenantID,
+		Domain:        verification.Domain,
+		RecordType:    "TXT",
+		RecordName:    verification.RecordName(),
+		RecordValue:   verification.RecordValue(),
+		Verified:      verification.IsVerified(),
+		VerifiedAt:    util.TimeToUnix(verification.VerifiedAt),
+		LastCheckedAt: util.TimeToUnix(verification.LastCheckedAt),
+		LastError:     util.StringValue(verification.LastError),
+	}
+}
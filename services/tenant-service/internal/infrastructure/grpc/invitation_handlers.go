@@ -0,0 +1,235 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/damarteplok/damar-admin-cms/services/tenant-service/internal/domain"
+	"github.com/damarteplok/damar-admin-cms/services/tenant-service/pkg/types"
+	pb "github.com/damarteplok/damar-admin-cms/shared/proto/tenant"
+	"github.com/damarteplok/damar-admin-cms/shared/util"
+	"github.com/damarteplok/damar-admin-cms/shared/validation"
+)
+
+// Invitation operations
+
+func (s *TenantGRPCServer) InviteUserToTenant(ctx context.Context, req *pb.InviteUserToTenantRequest) (*pb.InviteUserToTenantResponse, error) {
+	ctx, err := scopeTenant(ctx, &req.TenantId)
+	if err != nil {
+		return &pb.InviteUserToTenantResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	if err := validation.ValidateStruct(&types.InviteUserValidation{
+		TenantID: req.TenantId,
+		Email:    req.Email,
+		Role:     req.Role,
+	}); err != nil {
+		return &pb.InviteUserToTenantResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	params := domain.InviteUserParams{
+		TenantID: req.TenantId,
+		Email:    req.Email,
+		Role:     req.Role,
+	}
+	if req.InvitedBy > 0 {
+		params.InvitedBy = &req.InvitedBy
+	}
+
+	invitation, err := s.invitationService.Invite(ctx, params)
+	if err != nil {
+		var seatErr *domain.SeatLimitError
+		if errors.As(err, &seatErr) {
+			return nil, seatLimitStatus(seatErr)
+		}
+		return &pb.InviteUserToTenantResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &pb.InviteUserToTenantResponse{
+		Success: true,
+		Message: "Invitation sent successfully",
+		Data:    domainInvitationToPb(invitation),
+	}, nil
+}
+
+func (s *TenantGRPCServer) GetTenantInvitations(ctx context.Context, req *pb.GetTenantInvitationsRequest) (*pb.GetTenantInvitationsResponse, error) {
+	ctx, err := scopeTenant(ctx, &req.TenantId)
+	if err != nil {
+		return &pb.GetTenantInvitationsResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	if req.TenantId <= 0 {
+		return &pb.GetTenantInvitationsResponse{
+			Success: false,
+			Message: "Invalid tenant ID",
+		}, nil
+	}
+
+	invitations, err := s.invitationService.GetByTenant(ctx, req.TenantId, req.Status)
+	if err != nil {
+		return &pb.GetTenantInvitationsResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	data := make([]*pb.TenantInvitation, len(invitations))
+	for i, invitation := range invitations {
+		data[i] = domainInvitationToPb(invitation)
+	}
+
+	return &pb.GetTenantInvitationsResponse{
+		Success: true,
+		Message: "Invitations retrieved successfully",
+		Data:    data,
+	}, nil
+}
+
+func (s *TenantGRPCServer) GetInvitationByToken(ctx context.Context, req *pb.GetInvitationByTokenRequest) (*pb.GetInvitationByTokenResponse, error) {
+	invitation, err := s.invitationService.GetByToken(ctx, req.Token)
+	if err != nil {
+		return &pb.GetInvitationByTokenResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	tenant, err := s.service.GetTenantByID(ctx, invitation.TenantID)
+	if err != nil {
+		return &pb.GetInvitationByTokenResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &pb.GetInvitationByTokenResponse{
+		Success: true,
+		Message: "Invitation retrieved successfully",
+		Data:    domainInvitationToPb(invitation),
+		Tenant:  domainTenantToPb(tenant),
+	}, nil
+}
+
+func (s *TenantGRPCServer) AcceptInvitation(ctx context.Context, req *pb.AcceptInvitationRequest) (*pb.AcceptInvitationResponse, error) {
+	invitation, err := s.invitationService.Accept(ctx, req.Token, req.UserId, req.Email)
+	if err != nil {
+		return &pb.AcceptInvitationResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	message := "Invitation accepted successfully"
+	if invitation.AcceptedBy == nil {
+		message = "Invitation accepted; membership starts when the account is registered"
+	}
+
+	return &pb.AcceptInvitationResponse{
+		Success: true,
+		Message: message,
+		Data:    domainInvitationToPb(invitation),
+	}, nil
+}
+
+func (s *TenantGRPCServer) DeclineInvitation(ctx context.Context, req *pb.DeclineInvitationRequest) (*pb.DeclineInvitationResponse, error) {
+	invitation, err := s.invitationService.Decline(ctx, req.Token)
+	if err != nil {
+		return &pb.DeclineInvitationResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &pb.DeclineInvitationResponse{
+		Success: true,
+		Message: "Invitation declined successfully",
+		Data:    domainInvitationToPb(invitation),
+	}, nil
+}
+
+func (s *TenantGRPCServer) RevokeInvitation(ctx context.Context, req *pb.RevokeInvitationRequest) (*pb.RevokeInvitationResponse, error) {
+	ctx, err := scopeTenant(ctx, &req.TenantId)
+	if err != nil {
+		return &pb.RevokeInvitationResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	invitation, err := s.invitationService.Revoke(ctx, req.TenantId, req.Id)
+	if err != nil {
+		return &pb.RevokeInvitationResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &pb.RevokeInvitationResponse{
+		Success: true,
+		Message: "Invitation revoked successfully",
+		Data:    domainInvitationToPb(invitation),
+	}, nil
+}
+
+func (s *TenantGRPCServer) ResendInvitation(ctx context.Context, req *pb.ResendInvitationRequest) (*pb.ResendInvitationResponse, error) {
+	ctx, err := scopeTenant(ctx, &req.TenantId)
+	if err != nil {
+		return &pb.ResendInvitationResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	invitation, err := s.invitationService.Resend(ctx, req.TenantId, req.Id)
+	if err != nil {
+		var seatErr *domain.SeatLimitError
+		if errors.As(err, &seatErr) {
+			return nil, seatLimitStatus(seatErr)
+		}
+		return &pb.ResendInvitationResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &pb.ResendInvitationResponse{
+		Success: true,
+		Message: "Invitation resent successfully",
+		Data:    domainInvitationToPb(invitation),
+	}, nil
+}
+
+func domainInvitationToPb(invitation *domain.TenantInvitation) *pb.TenantInvitation {
+	return &pb.TenantInvitation{
+		Id:         invitation.ID,
+		Uuid:       invitation.UUID,
+		TenantId:   invitation.TenantID,
+		Email:      invitation.Email,
+		Role:       invitation.Role,
+		Status:     invitation.Status,
+		IsExpired:  invitation.IsExpired(time.Now()),
+		InvitedBy:  int64Value(invitation.InvitedBy),
+		AcceptedBy: int64Value(invitation.AcceptedBy),
+		ExpiresAt:  invitation.ExpiresAt.Unix(),
+		SentCount:  invitation.SentCount,
+		LastSentAt: invitation.LastSentAt.Unix(),
+		AcceptedAt: util.TimeToUnix(invitation.AcceptedAt),
+		DeclinedAt: util.TimeToUnix(invitation.DeclinedAt),
+		RevokedAt:  util.TimeToUnix(invitation.RevokedAt),
+		CreatedAt:  util.TimeToUnix(invitation.CreatedAt),
+		UpdatedAt:  util.TimeToUnix(invitation.UpdatedAt),
+	}
+}
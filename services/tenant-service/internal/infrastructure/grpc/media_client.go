@@ -0,0 +1,35 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/damarteplok/damar-admin-cms/services/tenant-service/internal/domain"
+	mediaPb "github.com/damarteplok/damar-admin-cms/shared/proto/media"
+)
+
+// MediaClient implements domain.TenantMedia on top of the media-service
+// gRPC API
+type MediaClient struct {
+	client mediaPb.MediaServiceClient
+}
+
+func NewMediaClient(client mediaPb.MediaServiceClient) domain.TenantMedia {
+	return &MediaClient{client: client}
+}
+
+func (c *MediaClient) DeleteModelFiles(ctx context.Context, modelType string, modelID int64) (int64, error) {
+	resp, err := c.client.DeleteFilesByModel(ctx, &mediaPb.DeleteFilesByModelRequest{
+		ModelType: modelType,
+		ModelId:   modelID,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete files: %w", err)
+	}
+	if !resp.Success {
+		return resp.Deleted, errors.New(resp.Message)
+	}
+
+	return resp.Deleted, nil
+}
@@ -0,0 +1,101 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/damarteplok/damar-admin-cms/services/tenant-service/internal/domain"
+	"github.com/damarteplok/damar-admin-cms/services/tenant-service/pkg/types"
+	pb "github.com/damarteplok/damar-admin-cms/shared/proto/tenant"
+	"github.com/damarteplok/damar-admin-cms/shared/util"
+	"github.com/damarteplok/damar-admin-cms/shared/validation"
+)
+
+// Ownership operations
+
+func (s *TenantGRPCServer) TransferOwnership(ctx context.Context, req *pb.TransferOwnershipRequest) (*pb.TransferOwnershipResponse, error) {
+	ctx, err := scopeTenant(ctx, &req.TenantId)
+	if err != nil {
+		return &pb.TransferOwnershipResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	if err := validation.ValidateStruct(&types.TransferOwnershipValidation{
+		TenantID:   req.TenantId,
+		FromUserID: req.FromUserId,
+		ToUserID:   req.ToUserId,
+	}); err != nil {
+		return &pb.TransferOwnershipResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	change, err := s.ownershipService.Transfer(ctx, domain.TransferOwnershipParams{
+		TenantID:    req.TenantId,
+		FromUserID:  req.FromUserId,
+		ToUserID:    req.ToUserId,
+		PerformedBy: util.Int64Ptr(req.PerformedBy),
+	})
+	if err != nil {
+		return &pb.TransferOwnershipResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &pb.TransferOwnershipResponse{
+		Success: true,
+		Message: "Ownership transferred successfully",
+		Data:    domainOwnershipChangeToPb(change),
+	}, nil
+}
+
+func (s *TenantGRPCServer) GetOwnershipChanges(ctx context.Context, req *pb.GetOwnershipChangesRequest) (*pb.GetOwnershipChangesResponse, error) {
+	ctx, err := scopeTenant(ctx, &req.TenantId)
+	if err != nil {
+		return &pb.GetOwnershipChangesResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	if err := validation.ValidateStruct(&types.TenantIDValidation{ID: req.TenantId}); err != nil {
+		return &pb.GetOwnershipChangesResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	changes, err := s.ownershipService.GetChanges(ctx, req.TenantId)
+	if err != nil {
+		return &pb.GetOwnershipChangesResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	data := make([]*pb.OwnershipChange, len(changes))
+	for i, change := range changes {
+		data[i] = domainOwnershipChangeToPb(change)
+	}
+
+	return &pb.GetOwnershipChangesResponse{
+		Success: true,
+		Message: "Ownership changes retrieved successfully",
+		Data:    data,
+	}, nil
+}
+
+func domainOwnershipChangeToPb(change *domain.OwnershipChange) *pb.OwnershipChange {
+	return &pb.OwnershipChange{
+		Id:          change.ID,
+		TenantId:    change.TenantID,
+		Action:      change.Action,
+		FromUserId:  util.Int64Value(change.FromUserID),
+		ToUserId:    util.Int64Value(change.ToUserID),
+		PerformedBy: util.Int64Value(change.PerformedBy),
+		CreatedAt:   util.TimeToUnix(change.CreatedAt),
+	}
+}
@@ -0,0 +1,254 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/damarteplok/damar-admin-cms/services/tenant-service/internal/domain"
+	"github.com/damarteplok/damar-admin-cms/services/tenant-service/pkg/types"
+	pb "github.com/damarteplok/damar-admin-cms/shared/proto/tenant"
+	"github.com/damarteplok/damar-admin-cms/shared/rbac"
+	"github.com/damarteplok/damar-admin-cms/shared/util"
+	"github.com/damarteplok/damar-admin-cms/shared/validation"
+)
+
+// Role and permission operations
+
+func (s *TenantGRPCServer) Authorize(ctx context.Context, req *pb.AuthorizeRequest) (*pb.AuthorizeResponse, error) {
+	if err := validation.ValidateStruct(&types.AuthorizeValidation{
+		UserID:     req.UserId,
+		TenantID:   req.TenantId,
+		Permission: req.Permission,
+	}); err != nil {
+		return &pb.AuthorizeResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	authorization, err := s.roleService.Authorize(ctx, req.UserId, req.TenantId, req.Permission)
+	if err != nil {
+		return &pb.AuthorizeResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &pb.AuthorizeResponse{
+		Success: true,
+		Message: "Authorization checked successfully",
+		Allowed: authorization.Allowed,
+		Role:    authorization.Role,
+		Reason:  authorization.Reason,
+	}, nil
+}
+
+func (s *TenantGRPCServer) AuthorizeRoleChange(ctx context.Context, req *pb.AuthorizeRoleChangeRequest) (*pb.AuthorizeRoleChangeResponse, error) {
+	if err := validation.ValidateStruct(&types.AuthorizeRoleChangeValidation{
+		UserID:       req.UserId,
+		TenantID:     req.TenantId,
+		RoleID:       req.RoleId,
+		TargetUserID: req.TargetUserId,
+	}); err != nil {
+		return &pb.AuthorizeRoleChangeResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	authorization, err := s.roleService.AuthorizeRoleChange(ctx, domain.RoleChange{
+		UserID:       req.UserId,
+		TenantID:     req.TenantId,
+		Role:         req.Role,
+		Permissions:  req.Permissions,
+		RoleID:       req.RoleId,
+		TargetUserID: req.TargetUserId,
+	})
+	if err != nil {
+		return &pb.AuthorizeRoleChangeResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &pb.AuthorizeRoleChangeResponse{
+		Success: true,
+		Message: "Role change checked successfully",
+		Allowed: authorization.Allowed,
+		Reason:  authorization.Reason,
+	}, nil
+}
+
+func (s *TenantGRPCServer) GetPermissions(ctx context.Context, req *pb.GetPermissionsRequest) (*pb.GetPermissionsResponse, error) {
+	permissions := rbac.Permissions()
+	data := make([]*pb.Permission, len(permissions))
+	for i, permission := range permissions {
+		data[i] = &pb.Permission{
+			Key:         permission.Key,
+			Description: permission.Description,
+		}
+	}
+
+	return &pb.GetPermissionsResponse{
+		Success: true,
+		Message: "Permissions retrieved successfully",
+		Data:    data,
+	}, nil
+}
+
+func (s *TenantGRPCServer) GetTenantRoles(ctx context.Context, req *pb.GetTenantRolesRequest) (*pb.GetTenantRolesResponse, error) {
+	ctx, err := scopeTenant(ctx, &req.TenantId)
+	if err != nil {
+		return &pb.GetTenantRolesResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	if err := validation.ValidateStruct(&types.TenantIDValidation{ID: req.TenantId}); err != nil {
+		return &pb.GetTenantRolesResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	roles, err := s.roleService.GetRoles(ctx, req.TenantId)
+	if err != nil {
+		return &pb.GetTenantRolesResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	data := make([]*pb.TenantRole, len(roles))
+	for i, role := range roles {
+		data[i] = domainRoleToPb(role)
+	}
+
+	return &pb.GetTenantRolesResponse{
+		Success: true,
+		Message: "Roles retrieved successfully",
+		Data:    data,
+	}, nil
+}
+
+func (s *TenantGRPCServer) CreateTenantRole(ctx context.Context, req *pb.CreateTenantRoleRequest) (*pb.CreateTenantRoleResponse, error) {
+	ctx, err := scopeTenant(ctx, &req.TenantId)
+	if err != nil {
+		return &pb.CreateTenantRoleResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	if err := validation.ValidateStruct(&types.CreateTenantRoleValidation{
+		TenantID:    req.TenantId,
+		Name:        req.Name,
+		Description: req.Description,
+	}); err != nil {
+		return &pb.CreateTenantRoleResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	role, err := s.roleService.CreateRole(ctx, &domain.TenantRole{
+		TenantID:    req.TenantId,
+		Name:        req.Name,
+		Description: util.StringPtr(req.Description),
+		Permissions: req.Permissions,
+	})
+	if err != nil {
+		return &pb.CreateTenantRoleResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &pb.CreateTenantRoleResponse{
+		Success: true,
+		Message: "Role created successfully",
+		Data:    domainRoleToPb(role),
+	}, nil
+}
+
+func (s *TenantGRPCServer) UpdateTenantRole(ctx context.Context, req *pb.UpdateTenantRoleRequest) (*pb.UpdateTenantRoleResponse, error) {
+	ctx, err := scopeTenant(ctx, &req.TenantId)
+	if err != nil {
+		return &pb.UpdateTenantRoleResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	if err := validation.ValidateStruct(&types.UpdateTenantRoleValidation{
+		ID:          req.Id,
+		TenantID:    req.TenantId,
+		Description: req.Description,
+	}); err != nil {
+		return &pb.UpdateTenantRoleResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	role, err := s.roleService.UpdateRole(ctx, &domain.TenantRole{
+		ID:          req.Id,
+		TenantID:    req.TenantId,
+		Description: util.StringPtr(req.Description),
+		Permissions: req.Permissions,
+	})
+	if err != nil {
+		return &pb.UpdateTenantRoleResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &pb.UpdateTenantRoleResponse{
+		Success: true,
+		Message: "Role updated successfully",
+		Data:    domainRoleToPb(role),
+	}, nil
+}
+
+func (s *TenantGRPCServer) DeleteTenantRole(ctx context.Context, req *pb.DeleteTenantRoleRequest) (*pb.DeleteTenantRoleResponse, error) {
+	ctx, err := scopeTenant(ctx, &req.TenantId)
+	if err != nil {
+		return &pb.DeleteTenantRoleResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	if req.Id <= 0 || req.TenantId <= 0 {
+		return &pb.DeleteTenantRoleResponse{
+			Success: false,
+			Message: "Invalid role or tenant ID",
+		}, nil
+	}
+
+	if err := s.roleService.DeleteRole(ctx, req.TenantId, req.Id); err != nil {
+		return &pb.DeleteTenantRoleResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &pb.DeleteTenantRoleResponse{
+		Success: true,
+		Message: "Role deleted successfully",
+	}, nil
+}
+
+func domainRoleToPb(role *domain.TenantRole) *pb.TenantRole {
+	return &pb.TenantRole{
+		Id:          role.ID,
+		TenantId:    role.TenantID,
+		Name:        role.Name,
+		Description: util.StringValue(role.Description),
+		Permissions: role.Permissions,
+		IsBuiltin:   role.IsBuiltin,
+		CreatedAt:   util.TimeToUnix(role.CreatedAt),
+		UpdatedAt:   util.TimeToUnix(role.UpdatedAt),
+	}
+}
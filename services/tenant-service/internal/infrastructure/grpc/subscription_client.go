@@ -0,0 +1,41 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/damarteplok/damar-admin-cms/services/tenant-service/internal/domain"
+	"github.com/damarteplok/damar-admin-cms/shared/entitlement"
+	subscriptionPb "github.com/damarteplok/damar-admin-cms/shared/proto/subscription"
+)
+
+// SubscriptionClient implements domain.SeatEntitlements on top of the
+// subscription-service gRPC API
+type SubscriptionClient struct {
+	client subscriptionPb.SubscriptionServiceClient
+}
+
+func NewSubscriptionClient(client subscriptionPb.SubscriptionServiceClient) domain.SeatEntitlements {
+	return &SubscriptionClient{client: client}
+}
+
+func (c *SubscriptionClient) GetSeatLimit(ctx context.Context, tenantID, used int64) (*domain.SeatLimit, error) {
+	resp, err := c.client.CheckEntitlement(ctx, &subscriptionPb.CheckEntitlementRequest{
+		TenantId:   tenantID,
+		FeatureKey: entitlement.KeySeats,
+		Usage:      used,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to check seat entitlement: %w", err)
+	}
+	if !resp.Success {
+		return nil, errors.New(resp.Message)
+	}
+
+	return &domain.SeatLimit{
+		Limit:          resp.Data.Limit,
+		Unlimited:      resp.Data.Unlimited,
+		SubscriptionID: resp.Data.SubscriptionId,
+	}, nil
+}
@@ -3,21 +3,46 @@ package grpc
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"strconv"
 
 	"github.com/damarteplok/damar-admin-cms/services/tenant-service/internal/domain"
 	"github.com/damarteplok/damar-admin-cms/services/tenant-service/pkg/types"
 	pb "github.com/damarteplok/damar-admin-cms/shared/proto/tenant"
+	"github.com/damarteplok/damar-admin-cms/shared/tenancy"
 	"github.com/damarteplok/damar-admin-cms/shared/util"
 	"github.com/damarteplok/damar-admin-cms/shared/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type TenantGRPCServer struct {
-	service domain.TenantService
+	service           domain.TenantService
+	invitationService domain.TenantInvitationService
+	roleService       domain.TenantRoleService
+	domainService     domain.TenantDomainService
+	ownershipService  domain.TenantOwnershipService
+	lifecycleService  domain.TenantLifecycleService
 	pb.UnimplementedTenantServiceServer
 }
 
-func NewTenantGRPCServer(service domain.TenantService) *TenantGRPCServer {
-	return &TenantGRPCServer{service: service}
+func NewTenantGRPCServer(
+	service domain.TenantService,
+	invitationService domain.TenantInvitationService,
+	roleService domain.TenantRoleService,
+	domainService domain.TenantDomainService,
+	ownershipService domain.TenantOwnershipService,
+	lifecycleService domain.TenantLifecycleService,
+) *TenantGRPCServer {
+	return &TenantGRPCServer{
+		service:           service,
+		invitationService: invitationService,
+		roleService:       roleService,
+		domainService:     domainService,
+		ownershipService:  ownershipService,
+		lifecycleService:  lifecycleService,
+	}
 }
 
 // Tenant operations
@@ -167,7 +192,7 @@ func (s *TenantGRPCServer) DeleteTenant(ctx context.Context, req *pb.DeleteTenan
 		}, nil
 	}
 
-	err := s.service.DeleteTenant(ctx, req.Id)
+	err := s.lifecycleService.Delete(ctx, req.Id)
 	if err != nil {
 		return &pb.DeleteTenantResponse{
 			Success: false,
@@ -181,6 +206,29 @@ func (s *TenantGRPCServer) DeleteTenant(ctx context.Context, req *pb.DeleteTenan
 	}, nil
 }
 
+func (s *TenantGRPCServer) RestoreTenant(ctx context.Context, req *pb.RestoreTenantRequest) (*pb.RestoreTenantResponse, error) {
+	if err := validation.ValidateStruct(&types.TenantIDValidation{ID: req.Id}); err != nil {
+		return &pb.RestoreTenantResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	tenant, err := s.lifecycleService.Restore(ctx, req.Id)
+	if err != nil {
+		return &pb.RestoreTenantResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &pb.RestoreTenantResponse{
+		Success: true,
+		Message: "Tenant restored successfully",
+		Data:    domainTenantToPb(tenant),
+	}, nil
+}
+
 func (s *TenantGRPCServer) GetAllTenants(ctx context.Context, req *pb.GetAllTenantsRequest) (*pb.GetAllTenantsResponse, error) {
 	page := int(req.Page)
 	perPage := int(req.PerPage)
@@ -251,6 +299,14 @@ func (s *TenantGRPCServer) GetUserTenants(ctx context.Context, req *pb.GetUserTe
 // TenantUser operations
 
 func (s *TenantGRPCServer) AddUserToTenant(ctx context.Context, req *pb.AddUserToTenantRequest) (*pb.AddUserToTenantResponse, error) {
+	ctx, err := scopeTenant(ctx, &req.TenantId)
+	if err != nil {
+		return &pb.AddUserToTenantResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
 	if err := validation.ValidateStruct(&types.AddUserToTenantValidation{
 		UserID:   req.UserId,
 		TenantID: req.TenantId,
@@ -272,6 +328,10 @@ func (s *TenantGRPCServer) AddUserToTenant(ctx context.Context, req *pb.AddUserT
 
 	createdTenantUser, err := s.service.AddUserToTenant(ctx, tenantUser)
 	if err != nil {
+		var seatErr *domain.SeatLimitError
+		if errors.As(err, &seatErr) {
+			return nil, seatLimitStatus(seatErr)
+		}
 		return &pb.AddUserToTenantResponse{
 			Success: false,
 			Message: err.Error(),
@@ -286,6 +346,14 @@ func (s *TenantGRPCServer) AddUserToTenant(ctx context.Context, req *pb.AddUserT
 }
 
 func (s *TenantGRPCServer) RemoveUserFromTenant(ctx context.Context, req *pb.RemoveUserFromTenantRequest) (*pb.RemoveUserFromTenantResponse, error) {
+	ctx, err := scopeTenant(ctx, &req.TenantId)
+	if err != nil {
+		return &pb.RemoveUserFromTenantResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
 	if req.UserId <= 0 || req.TenantId <= 0 {
 		return &pb.RemoveUserFromTenantResponse{
 			Success: false,
@@ -293,7 +361,7 @@ func (s *TenantGRPCServer) RemoveUserFromTenant(ctx context.Context, req *pb.Rem
 		}, nil
 	}
 
-	err := s.service.RemoveUserFromTenant(ctx, req.UserId, req.TenantId)
+	err = s.service.RemoveUserFromTenant(ctx, req.UserId, req.TenantId, util.Int64Ptr(req.PerformedBy))
 	if err != nil {
 		return &pb.RemoveUserFromTenantResponse{
 			Success: false,
@@ -308,6 +376,14 @@ func (s *TenantGRPCServer) RemoveUserFromTenant(ctx context.Context, req *pb.Rem
 }
 
 func (s *TenantGRPCServer) UpdateUserRole(ctx context.Context, req *pb.UpdateUserRoleRequest) (*pb.UpdateUserRoleResponse, error) {
+	ctx, err := scopeTenant(ctx, &req.TenantId)
+	if err != nil {
+		return &pb.UpdateUserRoleResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
 	if err := validation.ValidateStruct(&types.UpdateUserRoleValidation{
 		UserID:   req.UserId,
 		TenantID: req.TenantId,
@@ -319,7 +395,7 @@ func (s *TenantGRPCServer) UpdateUserRole(ctx context.Context, req *pb.UpdateUse
 		}, nil
 	}
 
-	err := s.service.UpdateUserRole(ctx, req.UserId, req.TenantId, req.Role)
+	err = s.service.UpdateUserRole(ctx, req.UserId, req.TenantId, req.Role, util.Int64Ptr(req.PerformedBy))
 	if err != nil {
 		return &pb.UpdateUserRoleResponse{
 			Success: false,
@@ -334,6 +410,14 @@ func (s *TenantGRPCServer) UpdateUserRole(ctx context.Context, req *pb.UpdateUse
 }
 
 func (s *TenantGRPCServer) GetTenantUsers(ctx context.Context, req *pb.GetTenantUsersRequest) (*pb.GetTenantUsersResponse, error) {
+	ctx, err := scopeTenant(ctx, &req.TenantId)
+	if err != nil {
+		return &pb.GetTenantUsersResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
 	if req.TenantId <= 0 {
 		return &pb.GetTenantUsersResponse{
 			Success: false,
@@ -383,9 +467,48 @@ func (s *TenantGRPCServer) SetDefaultTenant(ctx context.Context, req *pb.SetDefa
 	}, nil
 }
 
+func (s *TenantGRPCServer) GetSeatUsage(ctx context.Context, req *pb.GetSeatUsageRequest) (*pb.GetSeatUsageResponse, error) {
+	ctx, err := scopeTenant(ctx, &req.TenantId)
+	if err != nil {
+		return &pb.GetSeatUsageResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	if err := validation.ValidateStruct(&types.TenantIDValidation{ID: req.TenantId}); err != nil {
+		return &pb.GetSeatUsageResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	usage, err := s.service.GetSeatUsage(ctx, req.TenantId)
+	if err != nil {
+		return &pb.GetSeatUsageResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &pb.GetSeatUsageResponse{
+		Success: true,
+		Message: "Seat usage retrieved successfully",
+		Data:    domainSeatUsageToPb(usage),
+	}, nil
+}
+
 // TenantSettings operations
 
 func (s *TenantGRPCServer) GetSetting(ctx context.Context, req *pb.GetSettingRequest) (*pb.GetSettingResponse, error) {
+	ctx, err := scopeTenant(ctx, &req.TenantId)
+	if err != nil {
+		return &pb.GetSettingResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
 	if req.TenantId <= 0 || req.Key == "" {
 		return &pb.GetSettingResponse{
 			Success: false,
@@ -409,6 +532,14 @@ func (s *TenantGRPCServer) GetSetting(ctx context.Context, req *pb.GetSettingReq
 }
 
 func (s *TenantGRPCServer) SetSetting(ctx context.Context, req *pb.SetSettingRequest) (*pb.SetSettingResponse, error) {
+	ctx, err := scopeTenant(ctx, &req.TenantId)
+	if err != nil {
+		return &pb.SetSettingResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
 	if req.TenantId <= 0 || req.Key == "" || req.Value == "" {
 		return &pb.SetSettingResponse{
 			Success: false,
@@ -448,6 +579,14 @@ func (s *TenantGRPCServer) SetSetting(ctx context.Context, req *pb.SetSettingReq
 }
 
 func (s *TenantGRPCServer) GetAllSettings(ctx context.Context, req *pb.GetAllSettingsRequest) (*pb.GetAllSettingsResponse, error) {
+	ctx, err := scopeTenant(ctx, &req.TenantId)
+	if err != nil {
+		return &pb.GetAllSettingsResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
 	if req.TenantId <= 0 {
 		return &pb.GetAllSettingsResponse{
 			Success: false,
@@ -476,6 +615,14 @@ func (s *TenantGRPCServer) GetAllSettings(ctx context.Context, req *pb.GetAllSet
 }
 
 func (s *TenantGRPCServer) DeleteSetting(ctx context.Context, req *pb.DeleteSettingRequest) (*pb.DeleteSettingResponse, error) {
+	ctx, err := scopeTenant(ctx, &req.TenantId)
+	if err != nil {
+		return &pb.DeleteSettingResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
 	if req.TenantId <= 0 || req.Key == "" {
 		return &pb.DeleteSettingResponse{
 			Success: false,
@@ -483,7 +630,7 @@ func (s *TenantGRPCServer) DeleteSetting(ctx context.Context, req *pb.DeleteSett
 		}, nil
 	}
 
-	err := s.service.DeleteSetting(ctx, req.TenantId, req.Key)
+	err = s.service.DeleteSetting(ctx, req.TenantId, req.Key)
 	if err != nil {
 		return &pb.DeleteSettingResponse{
 			Success: false,
@@ -526,6 +673,49 @@ func domainTenantUserToPb(tenantUser *domain.TenantUser) *pb.TenantUser {
 	}
 }
 
+func domainSeatUsageToPb(usage *domain.SeatUsage) *pb.SeatUsage {
+	return &pb.SeatUsage{
+		TenantId:       usage.TenantID,
+		Used:           usage.Used,
+		Limit:          usage.Limit,
+		Unlimited:      usage.Unlimited,
+		Remaining:      usage.Remaining,
+		SubscriptionId: usage.SubscriptionID,
+		Enforced:       usage.Enforced,
+	}
+}
+
+// seatLimitStatus turns a seat limit error into a RESOURCE_EXHAUSTED status
+// whose ErrorInfo carries the seat count and limit
+func seatLimitStatus(seatErr *domain.SeatLimitError) error {
+	st := status.New(codes.ResourceExhausted, seatErr.Error())
+	detailed, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: "SEAT_LIMIT_REACHED",
+		Domain: "tenant-service",
+		Metadata: map[string]string{
+			"tenant_id":  strconv.FormatInt(seatErr.TenantID, 10),
+			"seats_used": strconv.FormatInt(seatErr.Used, 10),
+			"seat_limit": strconv.FormatInt(seatErr.Limit, 10),
+		},
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// scopeTenant replaces a request's tenant ID with the one the caller's
+// active tenant allows, a zero ID becoming the active tenant, and returns
+// ctx scoped to it
+func scopeTenant(ctx context.Context, tenantID *int64) (context.Context, error) {
+	ctx, resolved, err := tenancy.Scope(ctx, *tenantID)
+	if err != nil {
+		return ctx, err
+	}
+	*tenantID = resolved
+	return ctx, nil
+}
+
 func domainTenantSettingToPb(setting *domain.TenantSetting) *pb.TenantSetting {
 	return &pb.TenantSetting{
 		Id:        setting.ID,
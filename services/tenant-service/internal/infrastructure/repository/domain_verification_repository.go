@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/damarteplok/damar-admin-cms/services/tenant-service/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const domainVerificationColumns = `id, tenant_id, domain, token, verified_at, last_checked_at, last_error,
+	created_at, updated_at`
+
+type DomainVerificationRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewDomainVerificationRepository(db *pgxpool.Pool) domain.DomainVerificationRepository {
+	return &DomainVerificationRepository{db: db}
+}
+
+func scanDomainVerification(row pgx.Row) (*domain.DomainVerification, error) {
+	verification := &domain.DomainVerification{}
+	err := row.Scan(
+		&verification.ID,
+		&verification.TenantID,
+		&verification.Domain,
+		&verification.Token,
+		&verification.VerifiedAt,
+		&verification.LastCheckedAt,
+		&verification.LastError,
+		&verification.CreatedAt,
+		&verification.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return verification, nil
+}
+
+func (r *DomainVerificationRepository) GetByTenant(ctx context.Context, tenantID int64) (*domain.DomainVerification, error) {
+	query := `SELECT ` + domainVerificationColumns + ` FROM tenant_domain_verifications WHERE tenant_id = $1`
+
+	verification, err := scanDomainVerification(r.db.QueryRow(ctx, query, tenantID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get domain verification: %w", err)
+	}
+
+	return verification, nil
+}
+
+func (r *DomainVerificationRepository) Upsert(ctx context.Context, verification *domain.DomainVerification) (*domain.DomainVerification, error) {
+	query := `
+		INSERT INTO tenant_domain_verifications (tenant_id, domain, token, created_at, updated_at)
+		VALUES ($1, $2, $3, NOW(), NOW())
+		ON CONFLICT (tenant_id) DO UPDATE
+		SET domain = EXCLUDED.domain, token = EXCLUDED.token, verified_at = NULL,
+		    last_checked_at = NULL, last_error = NULL, updated_at = NOW()
+		RETURNING ` + domainVerificationColumns
+
+	result, err := scanDomainVerification(r.db.QueryRow(ctx, query, verification.TenantID, verification.Domain, verification.Token))
+	if err != nil {
+		return nil, fmt.Errorf("failed to save domain verification: %w", err)
+	}
+
+	return result, nil
+}
+
+func (r *DomainVerificationRepository) RecordCheck(ctx context.Context, tenantID int64, checkErr *string) (*domain.DomainVerification, error) {
+	// A passing check keeps the time of the first one; a failing check
+	// withdraws the verification until the record is back
+	query := `
+		UPDATE tenant_domain_verifications
+		SET verified_at = CASE WHEN $2::text IS NULL THEN COALESCE(verified_at, NOW()) ELSE NULL END,
+		    last_checked_at = NOW(), last_error = $2::text, updated_at = NOW()
+		WHERE tenant_id = $1
+		RETURNING ` + domainVerificationColumns
+
+	result, err := scanDomainVerification(r.db.QueryRow(ctx, query, tenantID, checkErr))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, errors.New("domain verification not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to record domain check: %w", err)
+	}
+
+	return result, nil
+}
+
+func (r *DomainVerificationRepository) GetVerifiedTenantID(ctx context.Context, domainName string) (int64, error) {
+	// Both domains are stored normalized by the service
+	query := `
+		SELECT t.id
+		FROM tenant_domain_verifications v
+		JOIN tenants t ON t.id = v.tenant_id
+		WHERE LOWER(v.domain) = LOWER($1)
+		  AND t.domain = v.domain
+		  AND v.verified_at IS NOT NULL
+		  AND t.deleted_at IS NULL
+		LIMIT 1
+	`
+
+	var tenantID int64
+	err := r.db.QueryRow(ctx, query, domainName).Scan(&tenantID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to get tenant by verified domain: %w", err)
+	}
+
+	return tenantID, nil
+}
@@ -0,0 +1,363 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/damarteplok/damar-admin-cms/services/tenant-service/internal/domain"
+	"github.com/damarteplok/damar-admin-cms/shared/database"
+	"github.com/damarteplok/damar-admin-cms/shared/rbac"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const invitationColumns = `id, uuid, tenant_id, tenant_user_id, email, role, status, token_hash,
+	invited_by, accepted_by, expires_at, sent_count, last_sent_at, accepted_at, declined_at,
+	revoked_at, created_at, updated_at`
+
+// InvitationRepository runs its transactions through tenantDB because they
+// also write tenant_user, so the context must be scoped to the invitation's
+// tenant
+type InvitationRepository struct {
+	db       *pgxpool.Pool
+	tenantDB *database.TenantDB
+}
+
+func NewInvitationRepository(db *pgxpool.Pool, tenantDB *database.TenantDB) domain.TenantInvitationRepository {
+	return &InvitationRepository{db: db, tenantDB: tenantDB}
+}
+
+func scanInvitation(row pgx.Row) (*domain.TenantInvitation, error) {
+	invitation := &domain.TenantInvitation{}
+	err := row.Scan(
+		&invitation.ID,
+		&invitation.UUID,
+		&invitation.TenantID,
+		&invitation.TenantUserID,
+		&invitation.Email,
+		&invitation.Role,
+		&invitation.Status,
+		&invitation.TokenHash,
+		&invitation.InvitedBy,
+		&invitation.AcceptedBy,
+		&invitation.ExpiresAt,
+		&invitation.SentCount,
+		&invitation.LastSentAt,
+		&invitation.AcceptedAt,
+		&invitation.DeclinedAt,
+		&invitation.RevokedAt,
+		&invitation.CreatedAt,
+		&invitation.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return invitation, nil
+}
+
+func (r *InvitationRepository) getOne(ctx context.Context, query string, args ...interface{}) (*domain.TenantInvitation, error) {
+	invitation, err := scanInvitation(r.db.QueryRow(ctx, query, args...))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get invitation: %w", err)
+	}
+	return invitation, nil
+}
+
+func (r *InvitationRepository) list(ctx context.Context, query string, args ...interface{}) ([]*domain.TenantInvitation, error) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get invitations: %w", err)
+	}
+	defer rows.Close()
+
+	var invitations []*domain.TenantInvitation
+	for rows.Next() {
+		invitation, err := scanInvitation(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan invitation: %w", err)
+		}
+		invitations = append(invitations, invitation)
+	}
+
+	return invitations, rows.Err()
+}
+
+func (r *InvitationRepository) GetByID(ctx context.Context, id int64) (*domain.TenantInvitation, error) {
+	query := `SELECT ` + invitationColumns + ` FROM tenant_invitations WHERE id = $1`
+	return r.getOne(ctx, query, id)
+}
+
+func (r *InvitationRepository) GetByUUID(ctx context.Context, uuid string) (*domain.TenantInvitation, error) {
+	query := `SELECT ` + invitationColumns + ` FROM tenant_invitations WHERE uuid = $1`
+	return r.getOne(ctx, query, uuid)
+}
+
+func (r *InvitationRepository) GetPending(ctx context.Context, tenantID int64, email string) (*domain.TenantInvitation, error) {
+	query := `
+		SELECT ` + invitationColumns + `
+		FROM tenant_invitations
+		WHERE tenant_id = $1 AND LOWER(email) = LOWER($2) AND status = 'pending'
+	`
+	return r.getOne(ctx, query, tenantID, email)
+}
+
+func (r *InvitationRepository) GetByTenant(ctx context.Context, tenantID int64, status string) ([]*domain.TenantInvitation, error) {
+	query := `
+		SELECT ` + invitationColumns + `
+		FROM tenant_invitations
+		WHERE tenant_id = $1 AND ($2 = '' OR status = $2)
+		ORDER BY created_at DESC, id DESC
+	`
+	return r.list(ctx, query, tenantID, status)
+}
+
+func (r *InvitationRepository) GetUnlinkedByEmail(ctx context.Context, email string) ([]*domain.TenantInvitation, error) {
+	query := `
+		SELECT ` + invitationColumns + `
+		FROM tenant_invitations
+		WHERE LOWER(email) = LOWER($1) AND status = 'accepted' AND accepted_by IS NULL
+		ORDER BY accepted_at ASC, id ASC
+	`
+	return r.list(ctx, query, email)
+}
+
+func (r *InvitationRepository) Create(ctx context.Context, invitation *domain.TenantInvitation, checkSeat domain.SeatCheck) (*domain.TenantInvitation, error) {
+	tx, err := r.tenantDB.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := takeSeat(ctx, tx, invitation.TenantID, checkSeat); err != nil {
+		return nil, err
+	}
+
+	// The pending member takes a seat until the invitation is closed
+	var tenantUserID int64
+	err = tx.QueryRow(ctx, `
+		INSERT INTO tenant_user (user_id, tenant_id, role, is_default, email, created_at, updated_at)
+		VALUES (NULL, $1, $2, false, $3, NOW(), NOW())
+		RETURNING id
+	`, invitation.TenantID, invitation.Role, invitation.Email).Scan(&tenantUserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create pending tenant user: %w", err)
+	}
+
+	query := `
+		INSERT INTO tenant_invitations (
+			uuid, tenant_id, tenant_user_id, email, role, status, token_hash,
+			invited_by, expires_at, sent_count, last_sent_at, created_at, updated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, 'pending', $6, $7, $8, 1, NOW(), NOW(), NOW())
+		RETURNING ` + invitationColumns
+
+	created, err := scanInvitation(tx.QueryRow(
+		ctx,
+		query,
+		invitation.UUID,
+		invitation.TenantID,
+		tenantUserID,
+		invitation.Email,
+		invitation.Role,
+		invitation.TokenHash,
+		invitation.InvitedBy,
+		invitation.ExpiresAt,
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create invitation: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit invitation: %w", err)
+	}
+
+	return created, nil
+}
+
+func (r *InvitationRepository) Resend(ctx context.Context, id int64, tokenHash string, expiresAt time.Time, checkSeat domain.SeatCheck) (*domain.TenantInvitation, error) {
+	tx, err := r.tenantDB.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if checkSeat != nil {
+		var tenantID int64
+		if err := tx.QueryRow(ctx, `SELECT tenant_id FROM tenant_invitations WHERE id = $1`, id).Scan(&tenantID); err != nil {
+			return nil, fmt.Errorf("failed to get invitation tenant: %w", err)
+		}
+		if err := takeSeat(ctx, tx, tenantID, checkSeat); err != nil {
+			return nil, err
+		}
+	}
+
+	query := `
+		UPDATE tenant_invitations
+		SET token_hash = $2, expires_at = $3, sent_count = sent_count + 1,
+		    last_sent_at = NOW(), updated_at = NOW()
+		WHERE id = $1 AND status = 'pending'
+		RETURNING ` + invitationColumns
+
+	invitation, err := scanInvitation(tx.QueryRow(ctx, query, id, tokenHash, expiresAt))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, errors.New("invitation is no longer pending")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to resend invitation: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit invitation: %w", err)
+	}
+
+	return invitation, nil
+}
+
+func (r *InvitationRepository) Accept(ctx context.Context, id int64, userID *int64) (*domain.TenantInvitation, error) {
+	tx, err := r.tenantDB.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		UPDATE tenant_invitations
+		SET status = 'accepted', accepted_by = $2, accepted_at = NOW(), updated_at = NOW()
+		WHERE id = $1 AND status = 'pending'
+		RETURNING ` + invitationColumns
+
+	invitation, err := scanInvitation(tx.QueryRow(ctx, query, id, userID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, errors.New("invitation is no longer pending")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to accept invitation: %w", err)
+	}
+
+	if userID != nil {
+		if err := linkTenantUser(ctx, tx, invitation, *userID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit invitation: %w", err)
+	}
+
+	return invitation, nil
+}
+
+func (r *InvitationRepository) Link(ctx context.Context, id, userID int64) (*domain.TenantInvitation, error) {
+	tx, err := r.tenantDB.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		UPDATE tenant_invitations
+		SET accepted_by = $2, updated_at = NOW()
+		WHERE id = $1 AND status = 'accepted' AND accepted_by IS NULL
+		RETURNING ` + invitationColumns
+
+	invitation, err := scanInvitation(tx.QueryRow(ctx, query, id, userID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, errors.New("invitation is not waiting for registration")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to link invitation: %w", err)
+	}
+
+	if err := linkTenantUser(ctx, tx, invitation, userID); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit invitation: %w", err)
+	}
+
+	return invitation, nil
+}
+
+// linkTenantUser turns an invitation's pending tenant_user row into a
+// membership of userID. It becomes the user's default tenant when they have
+// none. Joining as owner is recorded as an ownership grant by the inviter.
+func linkTenantUser(ctx context.Context, tx pgx.Tx, invitation *domain.TenantInvitation, userID int64) error {
+	if invitation.TenantUserID == nil {
+		return errors.New("invitation has no pending tenant user")
+	}
+
+	query := `
+		UPDATE tenant_user
+		SET user_id = $2,
+		    is_default = NOT EXISTS (SELECT 1 FROM tenant_user WHERE user_id = $2 AND is_default),
+		    updated_at = NOW()
+		WHERE id = $1 AND user_id IS NULL
+	`
+
+	result, err := tx.Exec(ctx, query, *invitation.TenantUserID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to link tenant user: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return errors.New("pending tenant user not found")
+	}
+
+	if invitation.Role == rbac.RoleOwner {
+		if _, err := recordOwnershipChange(ctx, tx, &domain.OwnershipChange{
+			TenantID:    invitation.TenantID,
+			Action:      domain.OwnershipActionGranted,
+			ToUserID:    &userID,
+			PerformedBy: invitation.InvitedBy,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *InvitationRepository) Close(ctx context.Context, id int64, status string) (*domain.TenantInvitation, error) {
+	tx, err := r.tenantDB.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		UPDATE tenant_invitations
+		SET status = $2::varchar,
+		    declined_at = CASE WHEN $2::varchar = 'declined' THEN NOW() END,
+		    revoked_at = CASE WHEN $2::varchar = 'revoked' THEN NOW() END,
+		    updated_at = NOW()
+		WHERE id = $1 AND status = 'pending'
+		RETURNING ` + invitationColumns
+
+	invitation, err := scanInvitation(tx.QueryRow(ctx, query, id, status))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, errors.New("invitation is no longer pending")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to close invitation: %w", err)
+	}
+
+	// Free the seat held by the pending member
+	if invitation.TenantUserID != nil {
+		_, err = tx.Exec(ctx, `DELETE FROM tenant_user WHERE id = $1 AND user_id IS NULL`, *invitation.TenantUserID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to delete pending tenant user: %w", err)
+		}
+		invitation.TenantUserID = nil
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit invitation: %w", err)
+	}
+
+	return invitation, nil
+}
@@ -0,0 +1,169 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/damarteplok/damar-admin-cms/services/tenant-service/internal/domain"
+	"github.com/damarteplok/damar-admin-cms/shared/rbac"
+	"github.com/jackc/pgx/v5"
+)
+
+const ownershipChangeColumns = `id, tenant_id, action, from_user_id, to_user_id, performed_by, created_at`
+
+func scanOwnershipChange(row pgx.Row) (*domain.OwnershipChange, error) {
+	change := &domain.OwnershipChange{}
+	err := row.Scan(
+		&change.ID,
+		&change.TenantID,
+		&change.Action,
+		&change.FromUserID,
+		&change.ToUserID,
+		&change.PerformedBy,
+		&change.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return change, nil
+}
+
+// lockOwners locks the tenant row and returns the users who own the tenant.
+// Every change to a tenant's owners takes this lock first, so concurrent
+// changes cannot both pass the last-owner check.
+func lockOwners(ctx context.Context, tx pgx.Tx, tenantID int64) ([]int64, error) {
+	if _, err := tx.Exec(ctx, `SELECT id FROM tenants WHERE id = $1 FOR UPDATE`, tenantID); err != nil {
+		return nil, fmt.Errorf("failed to lock tenant: %w", err)
+	}
+
+	query := `
+		SELECT user_id
+		FROM tenant_user
+		WHERE tenant_id = $1 AND role = $2 AND user_id IS NOT NULL
+	`
+
+	rows, err := tx.Query(ctx, query, tenantID, rbac.RoleOwner)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant owners: %w", err)
+	}
+	defer rows.Close()
+
+	var owners []int64
+	for rows.Next() {
+		var userID int64
+		if err := rows.Scan(&userID); err != nil {
+			return nil, fmt.Errorf("failed to scan tenant owner: %w", err)
+		}
+		owners = append(owners, userID)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating tenant owners: %w", err)
+	}
+
+	return owners, nil
+}
+
+func recordOwnershipChange(ctx context.Context, tx pgx.Tx, change *domain.OwnershipChange) (*domain.OwnershipChange, error) {
+	query := `
+		INSERT INTO tenant_ownership_changes (tenant_id, action, from_user_id, to_user_id, performed_by, created_at)
+		VALUES ($1, $2, $3, $4, $5, NOW())
+		RETURNING ` + ownershipChangeColumns
+
+	recorded, err := scanOwnershipChange(tx.QueryRow(
+		ctx,
+		query,
+		change.TenantID,
+		change.Action,
+		change.FromUserID,
+		change.ToUserID,
+		change.PerformedBy,
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to record ownership change: %w", err)
+	}
+
+	return recorded, nil
+}
+
+func (r *TenantRepository) TransferOwnership(ctx context.Context, tenantID, fromUserID, toUserID int64, performedBy *int64) (*domain.OwnershipChange, error) {
+	tx, err := r.tenantDB.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	owners, err := lockOwners(ctx, tx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(owners, fromUserID) {
+		return nil, fmt.Errorf("user %d is not an owner of the tenant", fromUserID)
+	}
+
+	query := `
+		UPDATE tenant_user
+		SET role = $1, updated_at = NOW()
+		WHERE user_id = $2 AND tenant_id = $3
+	`
+
+	result, err := tx.Exec(ctx, query, rbac.RoleOwner, toUserID, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to promote new owner: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return nil, fmt.Errorf("user %d is not a member of the tenant", toUserID)
+	}
+
+	if _, err := tx.Exec(ctx, query, rbac.RoleAdmin, fromUserID, tenantID); err != nil {
+		return nil, fmt.Errorf("failed to demote previous owner: %w", err)
+	}
+
+	change, err := recordOwnershipChange(ctx, tx, &domain.OwnershipChange{
+		TenantID:    tenantID,
+		Action:      domain.OwnershipActionTransferred,
+		FromUserID:  &fromUserID,
+		ToUserID:    &toUserID,
+		PerformedBy: performedBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return change, nil
+}
+
+func (r *TenantRepository) GetOwnershipChanges(ctx context.Context, tenantID int64) ([]*domain.OwnershipChange, error) {
+	query := `
+		SELECT ` + ownershipChangeColumns + `
+		FROM tenant_ownership_changes
+		WHERE tenant_id = $1
+		ORDER BY created_at DESC, id DESC
+	`
+
+	rows, err := r.db.Query(ctx, query, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ownership changes: %w", err)
+	}
+	defer rows.Close()
+
+	changes := make([]*domain.OwnershipChange, 0)
+	for rows.Next() {
+		change, err := scanOwnershipChange(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan ownership change: %w", err)
+		}
+		changes = append(changes, change)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating ownership changes: %w", err)
+	}
+
+	return changes, nil
+}
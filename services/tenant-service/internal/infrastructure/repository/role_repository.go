@@ -0,0 +1,135 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/damarteplok/damar-admin-cms/services/tenant-service/internal/domain"
+	"github.com/damarteplok/damar-admin-cms/shared/database"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const roleColumns = `id, tenant_id, name, description, permissions, created_at, updated_at`
+
+// RoleRepository uses tenantDB for tenant_user, which has row-level security
+type RoleRepository struct {
+	db       *pgxpool.Pool
+	tenantDB *database.TenantDB
+}
+
+func NewRoleRepository(db *pgxpool.Pool, tenantDB *database.TenantDB) domain.TenantRoleRepository {
+	return &RoleRepository{db: db, tenantDB: tenantDB}
+}
+
+func scanRole(row pgx.Row) (*domain.TenantRole, error) {
+	role := &domain.TenantRole{}
+	err := row.Scan(
+		&role.ID,
+		&role.TenantID,
+		&role.Name,
+		&role.Description,
+		&role.Permissions,
+		&role.CreatedAt,
+		&role.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return role, nil
+}
+
+func (r *RoleRepository) getOne(ctx context.Context, query string, args ...interface{}) (*domain.TenantRole, error) {
+	role, err := scanRole(r.db.QueryRow(ctx, query, args...))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get role: %w", err)
+	}
+	return role, nil
+}
+
+func (r *RoleRepository) GetByID(ctx context.Context, id int64) (*domain.TenantRole, error) {
+	query := `SELECT ` + roleColumns + ` FROM tenant_roles WHERE id = $1`
+	return r.getOne(ctx, query, id)
+}
+
+func (r *RoleRepository) GetByName(ctx context.Context, tenantID int64, name string) (*domain.TenantRole, error) {
+	query := `SELECT ` + roleColumns + ` FROM tenant_roles WHERE tenant_id = $1 AND name = $2`
+	return r.getOne(ctx, query, tenantID, name)
+}
+
+func (r *RoleRepository) GetByTenant(ctx context.Context, tenantID int64) ([]*domain.TenantRole, error) {
+	query := `SELECT ` + roleColumns + ` FROM tenant_roles WHERE tenant_id = $1 ORDER BY name ASC`
+
+	rows, err := r.db.Query(ctx, query, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get roles: %w", err)
+	}
+	defer rows.Close()
+
+	var roles []*domain.TenantRole
+	for rows.Next() {
+		role, err := scanRole(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan role: %w", err)
+		}
+		roles = append(roles, role)
+	}
+
+	return roles, rows.Err()
+}
+
+func (r *RoleRepository) Create(ctx context.Context, role *domain.TenantRole) (*domain.TenantRole, error) {
+	query := `
+		INSERT INTO tenant_roles (tenant_id, name, description, permissions, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, NOW(), NOW())
+		RETURNING ` + roleColumns
+
+	created, err := scanRole(r.db.QueryRow(ctx, query, role.TenantID, role.Name, role.Description, role.Permissions))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create role: %w", err)
+	}
+
+	return created, nil
+}
+
+func (r *RoleRepository) Update(ctx context.Context, role *domain.TenantRole) (*domain.TenantRole, error) {
+	query := `
+		UPDATE tenant_roles
+		SET description = $2, permissions = $3, updated_at = NOW()
+		WHERE id = $1
+		RETURNING ` + roleColumns
+
+	updated, err := scanRole(r.db.QueryRow(ctx, query, role.ID, role.Description, role.Permissions))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, errors.New("role not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to update role: %w", err)
+	}
+
+	return updated, nil
+}
+
+func (r *RoleRepository) Delete(ctx context.Context, id int64) error {
+	result, err := r.db.Exec(ctx, `DELETE FROM tenant_roles WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete role: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return errors.New("role not found")
+	}
+	return nil
+}
+
+func (r *RoleRepository) CountMembers(ctx context.Context, tenantID int64, name string) (int64, error) {
+	var count int64
+	err := r.tenantDB.QueryRow(ctx, `SELECT COUNT(*) FROM tenant_user WHERE tenant_id = $1 AND role = $2`, tenantID, name).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count role members: %w", err)
+	}
+	return count, nil
+}
@@ -0,0 +1,196 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/damarteplok/damar-admin-cms/services/tenant-service/internal/domain"
+	"github.com/damarteplok/damar-admin-cms/shared/database"
+	"github.com/damarteplok/damar-admin-cms/shared/tenancy"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const tenantColumns = `id, uuid, name, slug, domain, is_name_auto_generated,
+	created_by, created_at, updated_at, deleted_at`
+
+// TenantLifecycleRepository works on soft-deleted tenants. The tenants table
+// has no row-level security, so lookups use the pool. Purging cascades to the
+// rows of every tenant-scoped table and runs through tenantDB outside any
+// tenant.
+type TenantLifecycleRepository struct {
+	db       *pgxpool.Pool
+	tenantDB *database.TenantDB
+}
+
+func NewTenantLifecycleRepository(db *pgxpool.Pool, tenantDB *database.TenantDB) domain.TenantLifecycleRepository {
+	return &TenantLifecycleRepository{db: db, tenantDB: tenantDB}
+}
+
+func scanTenant(row pgx.Row) (*domain.Tenant, error) {
+	tenant := &domain.Tenant{}
+	err := row.Scan(
+		&tenant.ID,
+		&tenant.UUID,
+		&tenant.Name,
+		&tenant.Slug,
+		&tenant.Domain,
+		&tenant.IsNameAutoGenerated,
+		&tenant.CreatedBy,
+		&tenant.CreatedAt,
+		&tenant.UpdatedAt,
+		&tenant.DeletedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return tenant, nil
+}
+
+func (r *TenantLifecycleRepository) GetDeletedByID(ctx context.Context, id int64) (*domain.Tenant, error) {
+	query := `
+		SELECT ` + tenantColumns + `
+		FROM tenants
+		WHERE id = $1 AND deleted_at IS NOT NULL
+	`
+
+	tenant, err := scanTenant(r.db.QueryRow(ctx, query, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deleted tenant: %w", err)
+	}
+
+	return tenant, nil
+}
+
+func (r *TenantLifecycleRepository) Restore(ctx context.Context, id int64, deletedAfter time.Time) (*domain.Tenant, error) {
+	// A later delete asks the services to release the tenant anew
+	query := `
+		UPDATE tenants
+		SET deleted_at = NULL, subscriptions_released_at = NULL, billing_released_at = NULL,
+		    updated_at = NOW()
+		WHERE id = $1 AND deleted_at IS NOT NULL AND deleted_at > $2
+		RETURNING ` + tenantColumns
+
+	tenant, err := scanTenant(r.db.QueryRow(ctx, query, id, deletedAfter))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore tenant: %w", err)
+	}
+
+	return tenant, nil
+}
+
+func (r *TenantLifecycleRepository) GetDeletedBefore(ctx context.Context, deletedBefore time.Time, limit int) ([]*domain.Tenant, error) {
+	query := `
+		SELECT ` + tenantColumns + `
+		FROM tenants
+		WHERE deleted_at IS NOT NULL AND deleted_at <= $1
+		ORDER BY deleted_at
+		LIMIT $2
+	`
+
+	rows, err := r.db.Query(ctx, query, deletedBefore, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deleted tenants: %w", err)
+	}
+	defer rows.Close()
+
+	tenants := make([]*domain.Tenant, 0)
+	for rows.Next() {
+		tenant, err := scanTenant(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan tenant: %w", err)
+		}
+		tenants = append(tenants, tenant)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating deleted tenants: %w", err)
+	}
+
+	return tenants, nil
+}
+
+func (r *TenantLifecycleRepository) Purge(ctx context.Context, id int64, deletedBefore time.Time) (*domain.Tenant, error) {
+	tx, err := r.tenantDB.Begin(tenancy.WithoutTenant(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+		SELECT ` + tenantColumns + `
+		FROM tenants
+		WHERE id = $1 AND deleted_at IS NOT NULL AND deleted_at <= $2
+		FOR UPDATE SKIP LOCKED
+	`
+
+	tenant, err := scanTenant(tx.QueryRow(ctx, query, id, deletedBefore))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock tenant: %w", err)
+	}
+
+	// The subscriptions and dunning runs belong to their own services, which
+	// clean them up when asked and record it on the row
+	var released bool
+	releasedQuery := `
+		SELECT subscriptions_released_at IS NOT NULL AND billing_released_at IS NOT NULL
+		FROM tenants
+		WHERE id = $1
+	`
+	if err := tx.QueryRow(ctx, releasedQuery, id).Scan(&released); err != nil {
+		return nil, fmt.Errorf("failed to check tenant release: %w", err)
+	}
+	if !released {
+		return nil, domain.ErrTenantNotReleased
+	}
+
+	// Settings, members, subscriptions and the tenant's other rows cascade;
+	// invoices, dunning runs and orders keep their row with tenant_id NULL,
+	// and discount redemptions and referrals with subscription_id NULL
+	if _, err := tx.Exec(ctx, `DELETE FROM tenants WHERE id = $1`, id); err != nil {
+		return nil, fmt.Errorf("failed to purge tenant: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return tenant, nil
+}
+
+// releaseColumns maps each ReleasedBy* service to its column
+var releaseColumns = map[string]string{
+	domain.ReleasedBySubscriptions: "subscriptions_released_at",
+	domain.ReleasedByBilling:       "billing_released_at",
+}
+
+func (r *TenantLifecycleRepository) MarkReleased(ctx context.Context, id int64, by string) error {
+	column, ok := releaseColumns[by]
+	if !ok {
+		return fmt.Errorf("unknown releasing service: %s", by)
+	}
+
+	// Only a deleted tenant can be released; a late release of a restored
+	// tenant is ignored
+	query := `
+		UPDATE tenants
+		SET ` + column + ` = COALESCE(` + column + `, NOW())
+		WHERE id = $1 AND deleted_at IS NOT NULL
+	`
+	if _, err := r.db.Exec(ctx, query, id); err != nil {
+		return fmt.Errorf("failed to mark tenant released: %w", err)
+	}
+
+	return nil
+}
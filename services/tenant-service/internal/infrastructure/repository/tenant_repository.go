@@ -4,18 +4,28 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/damarteplok/damar-admin-cms/services/tenant-service/internal/domain"
+	"github.com/damarteplok/damar-admin-cms/shared/database"
+	"github.com/damarteplok/damar-admin-cms/shared/rbac"
+	"github.com/damarteplok/damar-admin-cms/shared/tenancy"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// TenantRepository uses tenantDB for tenant_user and tenant_settings, so
+// row-level security applies to them. Queries that span tenants say so with
+// tenancy.WithoutTenant. The pool is kept for the tenants table, which has no
+// row-level security.
 type TenantRepository struct {
-	db *pgxpool.Pool
+	db       *pgxpool.Pool
+	tenantDB *database.TenantDB
 }
 
-func NewTenantRepository(db *pgxpool.Pool) domain.TenantRepository {
-	return &TenantRepository{db: db}
+func NewTenantRepository(db *pgxpool.Pool, tenantDB *database.TenantDB) domain.TenantRepository {
+	return &TenantRepository{db: db, tenantDB: tenantDB}
 }
 
 // Tenant operations
@@ -28,6 +38,7 @@ func (r *TenantRepository) GetByID(ctx context.Context, id int64) (*domain.Tenan
 		WHERE id = $1 AND deleted_at IS NULL
 	`
 
+	// tenants is not tenant-scoped; the pool sees every tenant
 	tenant := &domain.Tenant{}
 	err := r.db.QueryRow(ctx, query, id).Scan(
 		&tenant.ID,
@@ -56,6 +67,7 @@ func (r *TenantRepository) GetByUUID(ctx context.Context, uuid string) (*domain.
 		WHERE uuid = $1 AND deleted_at IS NULL
 	`
 
+	// tenants is not tenant-scoped; the pool sees every tenant
 	tenant := &domain.Tenant{}
 	err := r.db.QueryRow(ctx, query, uuid).Scan(
 		&tenant.ID,
@@ -84,6 +96,7 @@ func (r *TenantRepository) GetBySlug(ctx context.Context, slug string) (*domain.
 		WHERE slug = $1 AND deleted_at IS NULL
 	`
 
+	// Slugs are unique across tenants, so the lookup must see all of them
 	tenant := &domain.Tenant{}
 	err := r.db.QueryRow(ctx, query, slug).Scan(
 		&tenant.ID,
@@ -112,6 +125,7 @@ func (r *TenantRepository) GetByDomain(ctx context.Context, domainName string) (
 		WHERE domain = $1 AND deleted_at IS NULL
 	`
 
+	// Domains are unique across tenants, so the lookup must see all of them
 	tenant := &domain.Tenant{}
 	err := r.db.QueryRow(ctx, query, domainName).Scan(
 		&tenant.ID,
@@ -139,6 +153,7 @@ func (r *TenantRepository) Create(ctx context.Context, tenant *domain.Tenant) (*
 		RETURNING id, created_at, updated_at
 	`
 
+	// The tenant does not exist yet, so there is nothing to scope to
 	err := r.db.QueryRow(
 		ctx,
 		query,
@@ -164,6 +179,7 @@ func (r *TenantRepository) Update(ctx context.Context, tenant *domain.Tenant) (*
 		RETURNING updated_at
 	`
 
+	// tenants is not tenant-scoped
 	err := r.db.QueryRow(
 		ctx,
 		query,
@@ -185,6 +201,7 @@ func (r *TenantRepository) Delete(ctx context.Context, id int64) error {
 		WHERE id = $1 AND deleted_at IS NULL
 	`
 
+	// tenants is not tenant-scoped
 	result, err := r.db.Exec(ctx, query, id)
 	if err != nil {
 		return fmt.Errorf("failed to delete tenant: %w", err)
@@ -212,6 +229,8 @@ func (r *TenantRepository) GetAll(ctx context.Context, page, perPage int, search
 		argIndex++
 	}
 
+	// Listing every tenant is an admin query across tenants, so it uses the pool
+
 	// Get total count
 	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM tenants WHERE %s", whereClause)
 	var total int64
@@ -291,7 +310,8 @@ func (r *TenantRepository) GetUserTenants(ctx context.Context, userID int64) ([]
 		ORDER BY is_default DESC, created_at ASC
 	`
 
-	rows, err := r.db.Query(ctx, query, userID)
+	// The user's memberships span tenants, so this must not be scoped
+	rows, err := r.tenantDB.Query(tenancy.WithoutTenant(ctx), query, userID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user tenants: %w", err)
 	}
@@ -321,14 +341,24 @@ func (r *TenantRepository) GetUserTenants(ctx context.Context, userID int64) ([]
 
 // TenantUser operations
 
-func (r *TenantRepository) AddUserToTenant(ctx context.Context, tenantUser *domain.TenantUser) (*domain.TenantUser, error) {
+func (r *TenantRepository) AddUserToTenant(ctx context.Context, tenantUser *domain.TenantUser, checkSeat domain.SeatCheck) (*domain.TenantUser, error) {
+	tx, err := r.tenantDB.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := takeSeat(ctx, tx, tenantUser.TenantID, checkSeat); err != nil {
+		return nil, err
+	}
+
 	query := `
 		INSERT INTO tenant_user (user_id, tenant_id, role, is_default, email, created_at, updated_at)
 		VALUES ($1, $2, $3, $4, $5, NOW(), NOW())
 		RETURNING id, created_at, updated_at
 	`
 
-	err := r.db.QueryRow(
+	err = tx.QueryRow(
 		ctx,
 		query,
 		tenantUser.UserID,
@@ -341,13 +371,42 @@ func (r *TenantRepository) AddUserToTenant(ctx context.Context, tenantUser *doma
 		return nil, fmt.Errorf("failed to add user to tenant: %w", err)
 	}
 
+	if tenantUser.Role == rbac.RoleOwner {
+		if _, err := recordOwnershipChange(ctx, tx, &domain.OwnershipChange{
+			TenantID: tenantUser.TenantID,
+			Action:   domain.OwnershipActionGranted,
+			ToUserID: &tenantUser.UserID,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
 	return tenantUser, nil
 }
 
-func (r *TenantRepository) RemoveUserFromTenant(ctx context.Context, userID, tenantID int64) error {
+func (r *TenantRepository) RemoveUserFromTenant(ctx context.Context, userID, tenantID int64, performedBy *int64, checkOwners domain.OwnerCheck) error {
+	tx, err := r.tenantDB.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	owners, err := lockOwners(ctx, tx, tenantID)
+	if err != nil {
+		return err
+	}
+	if err := checkOwners(owners); err != nil {
+		return err
+	}
+	isOwner := slices.Contains(owners, userID)
+
 	query := `DELETE FROM tenant_user WHERE user_id = $1 AND tenant_id = $2`
 
-	result, err := r.db.Exec(ctx, query, userID, tenantID)
+	result, err := tx.Exec(ctx, query, userID, tenantID)
 	if err != nil {
 		return fmt.Errorf("failed to remove user from tenant: %w", err)
 	}
@@ -356,18 +415,29 @@ func (r *TenantRepository) RemoveUserFromTenant(ctx context.Context, userID, ten
 		return fmt.Errorf("user-tenant relationship not found")
 	}
 
-	return nil
+	if isOwner {
+		if _, err := recordOwnershipChange(ctx, tx, &domain.OwnershipChange{
+			TenantID:    tenantID,
+			Action:      domain.OwnershipActionRevoked,
+			FromUserID:  &userID,
+			PerformedBy: performedBy,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
 }
 
 func (r *TenantRepository) GetTenantUsers(ctx context.Context, tenantID int64) ([]*domain.TenantUser, error) {
 	query := `
 		SELECT id, user_id, tenant_id, role, is_default, email, created_at, updated_at
 		FROM tenant_user
-		WHERE tenant_id = $1
+		WHERE tenant_id = $1 AND user_id IS NOT NULL
 		ORDER BY created_at ASC
 	`
 
-	rows, err := r.db.Query(ctx, query, tenantID)
+	rows, err := r.tenantDB.Query(ctx, query, tenantID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get tenant users: %w", err)
 	}
@@ -395,6 +465,51 @@ func (r *TenantRepository) GetTenantUsers(ctx context.Context, tenantID int64) (
 	return users, nil
 }
 
+func (r *TenantRepository) CountTenantUsers(ctx context.Context, tenantID int64) (int64, error) {
+	var count int64
+	if err := r.tenantDB.QueryRow(ctx, countSeatsQuery, tenantID).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count tenant users: %w", err)
+	}
+
+	return count, nil
+}
+
+// countSeatsQuery counts a tenant's taken seats. Pending members of open or
+// accepted invitations hold a seat too.
+const countSeatsQuery = `
+	SELECT COUNT(*)
+	FROM tenant_user tu
+	WHERE tu.tenant_id = $1
+	  AND (
+	      tu.user_id IS NOT NULL
+	      OR EXISTS (
+	          SELECT 1 FROM tenant_invitations ti
+	          WHERE ti.tenant_user_id = tu.id
+	            AND (ti.status = 'accepted' OR (ti.status = 'pending' AND ti.expires_at > NOW()))
+	      )
+	  )
+`
+
+// takeSeat locks the tenant row, like lockOwners, and runs checkSeat on the
+// seats counted under that lock. Concurrent adds to the tenant wait here
+// until the first one commits its member.
+func takeSeat(ctx context.Context, tx pgx.Tx, tenantID int64, checkSeat domain.SeatCheck) error {
+	if checkSeat == nil {
+		return nil
+	}
+
+	if _, err := tx.Exec(ctx, `SELECT id FROM tenants WHERE id = $1 FOR UPDATE`, tenantID); err != nil {
+		return fmt.Errorf("failed to lock tenant: %w", err)
+	}
+
+	var used int64
+	if err := tx.QueryRow(ctx, countSeatsQuery, tenantID).Scan(&used); err != nil {
+		return fmt.Errorf("failed to count tenant users: %w", err)
+	}
+
+	return checkSeat(used)
+}
+
 func (r *TenantRepository) GetUserRole(ctx context.Context, userID, tenantID int64) (string, error) {
 	query := `
 		SELECT role
@@ -403,7 +518,7 @@ func (r *TenantRepository) GetUserRole(ctx context.Context, userID, tenantID int
 	`
 
 	var role string
-	err := r.db.QueryRow(ctx, query, userID, tenantID).Scan(&role)
+	err := r.tenantDB.QueryRow(ctx, query, userID, tenantID).Scan(&role)
 	if err != nil {
 		return "", fmt.Errorf("failed to get user role: %w", err)
 	}
@@ -419,7 +534,7 @@ func (r *TenantRepository) GetUserTenantRole(ctx context.Context, userID, tenant
 	`
 
 	tu := &domain.TenantUser{}
-	err := r.db.QueryRow(ctx, query, userID, tenantID).Scan(
+	err := r.tenantDB.QueryRow(ctx, query, userID, tenantID).Scan(
 		&tu.ID,
 		&tu.UserID,
 		&tu.TenantID,
@@ -436,14 +551,30 @@ func (r *TenantRepository) GetUserTenantRole(ctx context.Context, userID, tenant
 	return tu, nil
 }
 
-func (r *TenantRepository) UpdateUserRole(ctx context.Context, userID, tenantID int64, role string) error {
+func (r *TenantRepository) UpdateUserRole(ctx context.Context, userID, tenantID int64, role string, performedBy *int64, checkOwners domain.OwnerCheck) error {
+	tx, err := r.tenantDB.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	owners, err := lockOwners(ctx, tx, tenantID)
+	if err != nil {
+		return err
+	}
+	if err := checkOwners(owners); err != nil {
+		return err
+	}
+	wasOwner := slices.Contains(owners, userID)
+	isOwner := role == rbac.RoleOwner
+
 	query := `
 		UPDATE tenant_user 
 		SET role = $1, updated_at = NOW()
 		WHERE user_id = $2 AND tenant_id = $3
 	`
 
-	result, err := r.db.Exec(ctx, query, role, userID, tenantID)
+	result, err := tx.Exec(ctx, query, role, userID, tenantID)
 	if err != nil {
 		return fmt.Errorf("failed to update user role: %w", err)
 	}
@@ -452,11 +583,29 @@ func (r *TenantRepository) UpdateUserRole(ctx context.Context, userID, tenantID
 		return fmt.Errorf("user-tenant relationship not found")
 	}
 
-	return nil
+	if wasOwner != isOwner {
+		change := &domain.OwnershipChange{
+			TenantID:    tenantID,
+			Action:      domain.OwnershipActionGranted,
+			ToUserID:    &userID,
+			PerformedBy: performedBy,
+		}
+		if wasOwner {
+			change.Action = domain.OwnershipActionRevoked
+			change.FromUserID, change.ToUserID = &userID, nil
+		}
+		if _, err := recordOwnershipChange(ctx, tx, change); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
 }
 
 func (r *TenantRepository) SetDefaultTenant(ctx context.Context, userID, tenantID int64) error {
-	tx, err := r.db.Begin(ctx)
+	// The user's default is unset in every tenant they belong to, so the
+	// transaction must not be scoped to one of them
+	tx, err := r.tenantDB.Begin(tenancy.WithoutTenant(ctx))
 	if err != nil {
 		return fmt.Errorf("failed to begin transaction: %w", err)
 	}
@@ -493,7 +642,7 @@ func (r *TenantRepository) GetSetting(ctx context.Context, tenantID int64, key s
 	`
 
 	setting := &domain.TenantSetting{}
-	err := r.db.QueryRow(ctx, query, tenantID, key).Scan(
+	err := r.tenantDB.QueryRow(ctx, query, tenantID, key).Scan(
 		&setting.ID,
 		&setting.TenantID,
 		&setting.Key,
@@ -516,7 +665,7 @@ func (r *TenantRepository) GetAllSettings(ctx context.Context, tenantID int64) (
 		ORDER BY key ASC
 	`
 
-	rows, err := r.db.Query(ctx, query, tenantID)
+	rows, err := r.tenantDB.Query(ctx, query, tenantID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get settings: %w", err)
 	}
@@ -556,7 +705,7 @@ func (r *TenantRepository) SetSetting(ctx context.Context, setting *domain.Tenan
 		RETURNING id, created_at, updated_at
 	`
 
-	err := r.db.QueryRow(
+	err := r.tenantDB.QueryRow(
 		ctx,
 		query,
 		setting.TenantID,
@@ -573,7 +722,7 @@ func (r *TenantRepository) SetSetting(ctx context.Context, setting *domain.Tenan
 func (r *TenantRepository) DeleteSetting(ctx context.Context, tenantID int64, key string) error {
 	query := `DELETE FROM tenant_settings WHERE tenant_id = $1 AND key = $2`
 
-	result, err := r.db.Exec(ctx, query, tenantID, key)
+	result, err := r.tenantDB.Exec(ctx, query, tenantID, key)
 	if err != nil {
 		return fmt.Errorf("failed to delete setting: %w", err)
 	}
@@ -0,0 +1,134 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/damarteplok/damar-admin-cms/services/tenant-service/internal/domain"
+)
+
+type DomainService struct {
+	repo       domain.DomainVerificationRepository
+	tenantRepo domain.TenantRepository
+	resolver   domain.TXTResolver
+}
+
+// NewDomainService creates the custom domain service. TXT records are looked
+// up with resolver.
+func NewDomainService(
+	repo domain.DomainVerificationRepository,
+	tenantRepo domain.TenantRepository,
+	resolver domain.TXTResolver,
+) domain.TenantDomainService {
+	return &DomainService{
+		repo:       repo,
+		tenantRepo: tenantRepo,
+		resolver:   resolver,
+	}
+}
+
+func (s *DomainService) GetVerification(ctx context.Context, tenantID int64) (*domain.DomainVerification, error) {
+	tenant, err := s.tenantRepo.GetByID(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("tenant not found: %w", err)
+	}
+	if tenant.Domain == nil || *tenant.Domain == "" {
+		return nil, errors.New("tenant has no custom domain")
+	}
+	tenantDomain := normalizeHost(*tenant.Domain)
+
+	verification, err := s.repo.GetByTenant(ctx, tenant.ID)
+	if err != nil {
+		return nil, err
+	}
+	if verification != nil && verification.Domain == tenantDomain {
+		return verification, nil
+	}
+
+	// The domain is new or changed: start over with a fresh token
+	token, err := newVerificationToken()
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.Upsert(ctx, &domain.DomainVerification{
+		TenantID: tenant.ID,
+		Domain:   tenantDomain,
+		Token:    token,
+	})
+}
+
+func (s *DomainService) Verify(ctx context.Context, tenantID int64) (*domain.DomainVerification, error) {
+	verification, err := s.GetVerification(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	var checkErr *string
+	if err := s.checkRecord(ctx, verification); err != nil {
+		message := err.Error()
+		checkErr = &message
+	}
+
+	return s.repo.RecordCheck(ctx, verification.TenantID, checkErr)
+}
+
+func (s *DomainService) ResolveHost(ctx context.Context, host string) (*domain.Tenant, error) {
+	host = normalizeHost(host)
+	if host == "" {
+		return nil, errors.New("host is required")
+	}
+
+	tenantID, err := s.repo.GetVerifiedTenantID(ctx, host)
+	if err != nil {
+		return nil, err
+	}
+	if tenantID == 0 {
+		return nil, errors.New("no tenant with a verified domain for this host")
+	}
+
+	return s.tenantRepo.GetByID(ctx, tenantID)
+}
+
+// checkRecord looks for the verification value among the TXT records at the
+// record name
+func (s *DomainService) checkRecord(ctx context.Context, verification *domain.DomainVerification) error {
+	records, err := s.resolver.LookupTXT(ctx, verification.RecordName())
+	if err != nil {
+		var dnsErr *net.DNSError
+		if errors.As(err, &dnsErr) && dnsErr.IsNotFound {
+			return fmt.Errorf("no TXT record found at %s", verification.RecordName())
+		}
+		return fmt.Errorf("TXT lookup failed: %w", err)
+	}
+
+	expected := verification.RecordValue()
+	for _, record := range records {
+		if strings.TrimSpace(record) == expected {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("TXT record at %s does not contain %s", verification.RecordName(), expected)
+}
+
+// normalizeHost lowercases a host and strips its port and trailing dot
+func normalizeHost(host string) string {
+	host = strings.ToLower(strings.TrimSpace(host))
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	return strings.TrimSuffix(host, ".")
+}
+
+func newVerificationToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate verification token: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,169 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/damarteplok/damar-admin-cms/services/tenant-service/internal/domain"
+)
+
+// fakeResolver answers TXT lookups from records, or fails with err
+type fakeResolver struct {
+	records map[string][]string
+	err     error
+}
+
+func (f fakeResolver) LookupTXT(ctx context.Context, name string) ([]string, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	records, ok := f.records[name]
+	if !ok {
+		return nil, &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+	}
+	return records, nil
+}
+
+// fakeVerifications keeps one verification per tenant
+type fakeVerifications struct {
+	domain.DomainVerificationRepository
+	rows map[int64]*domain.DomainVerification
+}
+
+func (f *fakeVerifications) GetByTenant(ctx context.Context, tenantID int64) (*domain.DomainVerification, error) {
+	return f.rows[tenantID], nil
+}
+
+func (f *fakeVerifications) Upsert(ctx context.Context, verification *domain.DomainVerification) (*domain.DomainVerification, error) {
+	f.rows[verification.TenantID] = verification
+	return verification, nil
+}
+
+func (f *fakeVerifications) RecordCheck(ctx context.Context, tenantID int64, checkErr *string) (*domain.DomainVerification, error) {
+	verification := f.rows[tenantID]
+	now := time.Now()
+	if checkErr != nil {
+		verification.VerifiedAt = nil
+	} else if verification.VerifiedAt == nil {
+		verification.VerifiedAt = &now
+	}
+	verification.LastCheckedAt = &now
+	verification.LastError = checkErr
+	return verification, nil
+}
+
+// fakeDomainTenants returns a tenant with the given custom domain
+type fakeDomainTenants struct {
+	domain.TenantRepository
+	domain string
+}
+
+func (f fakeDomainTenants) GetByID(ctx context.Context, id int64) (*domain.Tenant, error) {
+	return &domain.Tenant{ID: id, Domain: &f.domain}, nil
+}
+
+func TestVerify(t *testing.T) {
+	const (
+		token      = "0123456789abcdef"
+		recordName = domain.DomainVerificationPrefix + ".shop.example.com"
+	)
+
+	tests := []struct {
+		name         string
+		resolver     fakeResolver
+		wantVerified bool
+		wantError    string // part of the recorded error
+	}{
+		{
+			name: "token found",
+			resolver: fakeResolver{records: map[string][]string{
+				recordName: {"v=spf1 -all", " " + domain.DomainVerificationPrefix + "=" + token + " "},
+			}},
+			wantVerified: true,
+		},
+		{
+			name:      "record missing",
+			resolver:  fakeResolver{records: map[string][]string{}},
+			wantError: "no TXT record found",
+		},
+		{
+			name: "wrong token",
+			resolver: fakeResolver{records: map[string][]string{
+				recordName: {domain.DomainVerificationPrefix + "=fedcba9876543210"},
+			}},
+			wantError: "does not contain",
+		},
+		{
+			name:      "lookup error",
+			resolver:  fakeResolver{err: &net.DNSError{Err: "server misbehaving", Name: recordName, IsTemporary: true}},
+			wantError: "TXT lookup failed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeVerifications{rows: map[int64]*domain.DomainVerification{
+				3: {TenantID: 3, Domain: "shop.example.com", Token: token},
+			}}
+			// The tenant's domain is compared after normalizing it
+			service := NewDomainService(repo, fakeDomainTenants{domain: "Shop.Example.com."}, tt.resolver)
+
+			verification, err := service.Verify(context.Background(), 3)
+			if err != nil {
+				t.Fatalf("Verify() error = %v", err)
+			}
+			if verification.Token != token {
+				t.Fatalf("token = %s, want the existing %s", verification.Token, token)
+			}
+			if verification.IsVerified() != tt.wantVerified {
+				t.Errorf("verified = %v, want %v", verification.IsVerified(), tt.wantVerified)
+			}
+			if tt.wantError == "" {
+				if verification.LastError != nil {
+					t.Errorf("last error = %s, want none", *verification.LastError)
+				}
+				return
+			}
+			if verification.LastError == nil || !strings.Contains(*verification.LastError, tt.wantError) {
+				t.Errorf("last error = %v, want it to contain %q", verification.LastError, tt.wantError)
+			}
+		})
+	}
+}
+
+func TestVerifyFailedRecheckWithdrawsVerification(t *testing.T) {
+	verifiedAt := time.Now().Add(-time.Hour)
+	repo := &fakeVerifications{rows: map[int64]*domain.DomainVerification{
+		3: {TenantID: 3, Domain: "shop.example.com", Token: "0123456789abcdef", VerifiedAt: &verifiedAt},
+	}}
+	// The record was removed after the domain was verified
+	service := NewDomainService(repo, fakeDomainTenants{domain: "shop.example.com"}, fakeResolver{records: map[string][]string{}})
+
+	verification, err := service.Verify(context.Background(), 3)
+	if err != nil {
+		t.Fatalf("Verify() error = %v", err)
+	}
+	if verification.IsVerified() || verification.LastError == nil {
+		t.Errorf("verification = %+v, want it unverified with the error", verification)
+	}
+}
+
+func TestVerifyStartsOverWhenDomainChanges(t *testing.T) {
+	verifiedAt := time.Now().Add(-time.Hour)
+	repo := &fakeVerifications{rows: map[int64]*domain.DomainVerification{
+		3: {TenantID: 3, Domain: "old.example.com", Token: "0123456789abcdef", VerifiedAt: &verifiedAt},
+	}}
+	service := NewDomainService(repo, fakeDomainTenants{domain: "new.example.com"}, fakeResolver{err: errors.New("unreachable")})
+
+	verification, err := service.GetVerification(context.Background(), 3)
+	if err != nil {
+		t.Fatalf("GetVerification() error = %v", err)
+	}
+	if verification.Domain != "new.example.com" || verification.Token == "0123456789abcdef" || verification.IsVerified() {
+		t.Errorf("verification = %+v, want a fresh one for new.example.com", verification)
+	}
+}
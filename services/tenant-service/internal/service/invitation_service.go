@@ -0,0 +1,327 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/damarteplok/damar-admin-cms/services/tenant-service/internal/domain"
+	"github.com/damarteplok/damar-admin-cms/shared/amqp"
+	"github.com/damarteplok/damar-admin-cms/shared/contracts"
+	"github.com/damarteplok/damar-admin-cms/shared/logger"
+	"github.com/damarteplok/damar-admin-cms/shared/tenancy"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type InvitationService struct {
+	repo       domain.TenantInvitationRepository
+	tenantRepo domain.TenantRepository
+	seats      domain.SeatPolicy
+	roles      domain.TenantRoleService
+	publisher  *amqp.Publisher
+	secret     []byte
+	ttl        time.Duration
+}
+
+// NewInvitationService creates the invitation service. Tokens are signed
+// with secret and expire after ttl.
+func NewInvitationService(
+	repo domain.TenantInvitationRepository,
+	tenantRepo domain.TenantRepository,
+	seats domain.SeatPolicy,
+	roles domain.TenantRoleService,
+	publisher *amqp.Publisher,
+	secret string,
+	ttl time.Duration,
+) domain.TenantInvitationService {
+	return &InvitationService{
+		repo:       repo,
+		tenantRepo: tenantRepo,
+		seats:      seats,
+		roles:      roles,
+		publisher:  publisher,
+		secret:     []byte(secret),
+		ttl:        ttl,
+	}
+}
+
+func (s *InvitationService) Invite(ctx context.Context, params domain.InviteUserParams) (*domain.TenantInvitation, error) {
+	email := strings.ToLower(strings.TrimSpace(params.Email))
+	if email == "" {
+		return nil, errors.New("email is required")
+	}
+
+	// Business validation: Check if tenant exists
+	tenant, err := s.tenantRepo.GetByID(ctx, params.TenantID)
+	if err != nil {
+		return nil, fmt.Errorf("tenant not found: %w", err)
+	}
+
+	// Members, seats and the new membership belong to the invited tenant
+	ctx = tenancy.WithTenantID(ctx, tenant.ID)
+
+	// Business validation: Validate role
+	if err := s.roles.ValidateRole(ctx, tenant.ID, params.Role); err != nil {
+		return nil, err
+	}
+
+	// Business validation: Check if the email is already a member
+	members, err := s.tenantRepo.GetTenantUsers(ctx, tenant.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, member := range members {
+		if member.Email != nil && strings.EqualFold(*member.Email, email) {
+			return nil, errors.New("user already in tenant")
+		}
+	}
+
+	// Business validation: One open invitation per email. An expired one is
+	// revoked so the email can be invited again.
+	now := time.Now()
+	pending, err := s.repo.GetPending(ctx, tenant.ID, email)
+	if err != nil {
+		return nil, err
+	}
+	if pending != nil {
+		if !pending.IsExpired(now) {
+			return nil, errors.New("an invitation is already pending for this email, resend it instead")
+		}
+		if _, err := s.repo.Close(ctx, pending.ID, domain.InvitationStatusRevoked); err != nil {
+			return nil, err
+		}
+	}
+
+	// Business validation: The invitee needs a free seat
+	checkSeat, err := s.seats.Check(ctx, tenant.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	invitation := &domain.TenantInvitation{
+		UUID:      uuid.New().String(),
+		TenantID:  tenant.ID,
+		Email:     email,
+		Role:      params.Role,
+		InvitedBy: params.InvitedBy,
+		ExpiresAt: now.Add(s.ttl).Truncate(time.Second),
+	}
+	token := signInvitationToken(s.secret, invitation.UUID, invitation.ExpiresAt)
+	invitation.TokenHash = hashInvitationToken(token)
+
+	created, err := s.repo.Create(ctx, invitation, checkSeat)
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishInvitation(ctx, tenant, created, token)
+
+	return created, nil
+}
+
+func (s *InvitationService) GetByTenant(ctx context.Context, tenantID int64, status string) ([]*domain.TenantInvitation, error) {
+	switch status {
+	case "", domain.InvitationStatusPending, domain.InvitationStatusAccepted,
+		domain.InvitationStatusDeclined, domain.InvitationStatusRevoked:
+	default:
+		return nil, fmt.Errorf("invalid invitation status: %s", status)
+	}
+
+	return s.repo.GetByTenant(ctx, tenantID, status)
+}
+
+func (s *InvitationService) GetByToken(ctx context.Context, token string) (*domain.TenantInvitation, error) {
+	invitationUUID, err := parseInvitationToken(s.secret, token, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	invitation, err := s.repo.GetByUUID(ctx, invitationUUID)
+	if err != nil {
+		return nil, err
+	}
+	// Only the latest token of an invitation is valid
+	if invitation == nil || invitation.TokenHash != hashInvitationToken(token) {
+		return nil, errInvalidInvitationToken
+	}
+
+	return invitation, nil
+}
+
+func (s *InvitationService) Resend(ctx context.Context, tenantID, id int64) (*domain.TenantInvitation, error) {
+	invitation, err := s.getPending(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	ctx = tenancy.WithTenantID(ctx, invitation.TenantID)
+
+	// An expired invitation gave up its seat
+	now := time.Now()
+	var checkSeat domain.SeatCheck
+	if invitation.IsExpired(now) {
+		checkSeat, err = s.seats.Check(ctx, invitation.TenantID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	tenant, err := s.tenantRepo.GetByID(ctx, invitation.TenantID)
+	if err != nil {
+		return nil, fmt.Errorf("tenant not found: %w", err)
+	}
+
+	expiresAt := now.Add(s.ttl).Truncate(time.Second)
+	token := signInvitationToken(s.secret, invitation.UUID, expiresAt)
+
+	resent, err := s.repo.Resend(ctx, invitation.ID, hashInvitationToken(token), expiresAt, checkSeat)
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishInvitation(ctx, tenant, resent, token)
+
+	return resent, nil
+}
+
+func (s *InvitationService) Revoke(ctx context.Context, tenantID, id int64) (*domain.TenantInvitation, error) {
+	invitation, err := s.getPending(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = tenancy.WithTenantID(ctx, invitation.TenantID)
+	return s.repo.Close(ctx, invitation.ID, domain.InvitationStatusRevoked)
+}
+
+func (s *InvitationService) Accept(ctx context.Context, token string, userID int64, email string) (*domain.TenantInvitation, error) {
+	invitation, err := s.getOpenByToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	// The invitee's active tenant is usually another one
+	ctx = tenancy.WithTenantID(ctx, invitation.TenantID)
+
+	// Not registered yet: the membership is linked on registration
+	if userID <= 0 {
+		return s.repo.Accept(ctx, invitation.ID, nil)
+	}
+
+	if !strings.EqualFold(strings.TrimSpace(email), invitation.Email) {
+		return nil, errors.New("invitation was sent to a different email address")
+	}
+
+	existing, _ := s.tenantRepo.GetUserTenantRole(ctx, userID, invitation.TenantID)
+	if existing != nil {
+		return nil, errors.New("user already in tenant")
+	}
+
+	return s.repo.Accept(ctx, invitation.ID, &userID)
+}
+
+func (s *InvitationService) Decline(ctx context.Context, token string) (*domain.TenantInvitation, error) {
+	invitation, err := s.getOpenByToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = tenancy.WithTenantID(ctx, invitation.TenantID)
+	return s.repo.Close(ctx, invitation.ID, domain.InvitationStatusDeclined)
+}
+
+func (s *InvitationService) LinkRegisteredUser(ctx context.Context, userID int64, email string) ([]*domain.TenantInvitation, error) {
+	if userID <= 0 || strings.TrimSpace(email) == "" {
+		return nil, errors.New("user ID and email are required")
+	}
+
+	invitations, err := s.repo.GetUnlinkedByEmail(ctx, strings.TrimSpace(email))
+	if err != nil {
+		return nil, err
+	}
+
+	var linked []*domain.TenantInvitation
+	for _, invitation := range invitations {
+		tenantCtx := tenancy.WithTenantID(ctx, invitation.TenantID)
+		existing, _ := s.tenantRepo.GetUserTenantRole(tenantCtx, userID, invitation.TenantID)
+		if existing != nil {
+			continue
+		}
+
+		result, err := s.repo.Link(tenantCtx, invitation.ID, userID)
+		if err != nil {
+			return linked, err
+		}
+		linked = append(linked, result)
+	}
+
+	return linked, nil
+}
+
+// getPending returns an invitation of the tenant that has not been accepted,
+// declined or revoked; it may have expired. A zero tenantID matches any
+// tenant.
+func (s *InvitationService) getPending(ctx context.Context, tenantID, id int64) (*domain.TenantInvitation, error) {
+	invitation, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if invitation == nil || (tenantID > 0 && invitation.TenantID != tenantID) {
+		return nil, errors.New("invitation not found")
+	}
+	if invitation.Status != domain.InvitationStatusPending {
+		return nil, fmt.Errorf("invitation is already %s", invitation.Status)
+	}
+	return invitation, nil
+}
+
+// getOpenByToken returns the pending, unexpired invitation of a token
+func (s *InvitationService) getOpenByToken(ctx context.Context, token string) (*domain.TenantInvitation, error) {
+	invitation, err := s.GetByToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if invitation.Status != domain.InvitationStatusPending {
+		return nil, fmt.Errorf("invitation is already %s", invitation.Status)
+	}
+	if invitation.IsExpired(time.Now()) {
+		return nil, errors.New("invitation has expired")
+	}
+	return invitation, nil
+}
+
+// publishInvitation asks notification-service to email the token. The token
+// is never stored, so this event is the only place it appears.
+func (s *InvitationService) publishInvitation(ctx context.Context, tenant *domain.Tenant, invitation *domain.TenantInvitation, token string) {
+	if s.publisher == nil {
+		return
+	}
+
+	eventData := map[string]interface{}{
+		"invitation_id": invitation.ID,
+		"tenant_id":     tenant.ID,
+		"tenant_name":   tenant.Name,
+		"email":         invitation.Email,
+		"role":          invitation.Role,
+		"token":         token,
+		"expires_at":    invitation.ExpiresAt.Unix(),
+	}
+	dataBytes, _ := json.Marshal(eventData)
+	message := contracts.AmqpMessage{
+		OwnerID: invitation.UUID,
+		Data:    dataBytes,
+	}
+
+	if err := s.publisher.Publish(ctx, contracts.TenantEventInvitationSent, message); err != nil {
+		logger.Error("Failed to publish tenant invitation event",
+			zap.Int64("invitation_id", invitation.ID),
+			zap.Error(err))
+	} else {
+		logger.Info("Published tenant invitation event",
+			zap.Int64("invitation_id", invitation.ID),
+			zap.String("email", invitation.Email))
+	}
+}
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var errInvalidInvitationToken = errors.New("invalid invitation token")
+
+// signInvitationToken returns "<uuid>.<expires unix>.<signature>", where the
+// signature is the base64url HMAC-SHA256 of "<uuid>.<expires unix>"
+func signInvitationToken(secret []byte, uuid string, expiresAt time.Time) string {
+	payload := fmt.Sprintf("%s.%d", uuid, expiresAt.Unix())
+	return payload + "." + invitationSignature(secret, payload)
+}
+
+// parseInvitationToken checks the signature and expiry of a token and
+// returns the invitation UUID it was issued for
+func parseInvitationToken(secret []byte, token string, now time.Time) (string, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 || parts[0] == "" {
+		return "", errInvalidInvitationToken
+	}
+
+	payload := parts[0] + "." + parts[1]
+	expected := invitationSignature(secret, payload)
+	if !hmac.Equal([]byte(parts[2]), []byte(expected)) {
+		return "", errInvalidInvitationToken
+	}
+
+	expiresAt, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return "", errInvalidInvitationToken
+	}
+	if !now.Before(time.Unix(expiresAt, 0)) {
+		return "", errors.New("invitation has expired")
+	}
+
+	return parts[0], nil
+}
+
+// hashInvitationToken is the hex SHA-256 stored in
+// tenant_invitations.token_hash
+func hashInvitationToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func invitationSignature(secret []byte, payload string) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,70 @@
+package service
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseInvitationToken(t *testing.T) {
+	secret := []byte("invitation-secret")
+	uuid := "0b5f7c4e-3c52-4b0e-9a51-7f0e6d2c1a90"
+	now := time.Unix(1_700_000_000, 0)
+	token := signInvitationToken(secret, uuid, now.Add(time.Hour))
+	parts := strings.Split(token, ".")
+
+	tests := []struct {
+		name    string
+		secret  []byte
+		token   string
+		now     time.Time
+		wantErr bool
+	}{
+		{"valid", secret, token, now, false},
+		{"just before expiry", secret, token, now.Add(time.Hour - time.Second), false},
+		{"at expiry", secret, token, now.Add(time.Hour), true},
+		{"after expiry", secret, token, now.Add(2 * time.Hour), true},
+		{"wrong secret", []byte("other-secret"), token, now, true},
+		{"extended expiry", secret, parts[0] + ".1900000000." + parts[2], now, true},
+		{"other uuid", secret, "other." + parts[1] + "." + parts[2], now, true},
+		{"tampered signature", secret, parts[0] + "." + parts[1] + ".AAAA", now, true},
+		{"missing part", secret, parts[0] + "." + parts[1], now, true},
+		{"extra part", secret, token + ".x", now, true},
+		{"empty uuid", secret, signInvitationToken(secret, "", now.Add(time.Hour)), now, true},
+		{"empty token", secret, "", now, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseInvitationToken(tt.secret, tt.token, tt.now)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseInvitationToken() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got != uuid {
+				t.Errorf("parseInvitationToken() = %q, want %q", got, uuid)
+			}
+		})
+	}
+}
+
+func TestParseInvitationTokenExpiredIsNotInvalid(t *testing.T) {
+	secret := []byte("invitation-secret")
+	now := time.Unix(1_700_000_000, 0)
+	token := signInvitationToken(secret, "uuid", now.Add(-time.Minute))
+
+	_, err := parseInvitationToken(secret, token, now)
+	if err == nil || errors.Is(err, errInvalidInvitationToken) {
+		t.Errorf("parseInvitationToken() error = %v, want an expiry error", err)
+	}
+}
+
+func TestHashInvitationToken(t *testing.T) {
+	hash := hashInvitationToken("token")
+	if len(hash) != 64 {
+		t.Errorf("hashInvitationToken() length = %d, want 64", len(hash))
+	}
+	if hash == hashInvitationToken("token2") {
+		t.Error("hashInvitationToken() returned the same hash for different tokens")
+	}
+}
@@ -0,0 +1,210 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/damarteplok/damar-admin-cms/services/tenant-service/internal/domain"
+	"github.com/damarteplok/damar-admin-cms/shared/amqp"
+	"github.com/damarteplok/damar-admin-cms/shared/contracts"
+	"github.com/damarteplok/damar-admin-cms/shared/logger"
+	"go.uber.org/zap"
+)
+
+type LifecycleService struct {
+	tenantRepo    domain.TenantRepository
+	lifecycleRepo domain.TenantLifecycleRepository
+	media         domain.TenantMedia
+	publisher     *amqp.Publisher
+	gracePeriod   time.Duration
+}
+
+func NewLifecycleService(
+	tenantRepo domain.TenantRepository,
+	lifecycleRepo domain.TenantLifecycleRepository,
+	media domain.TenantMedia,
+	publisher *amqp.Publisher,
+	gracePeriod time.Duration,
+) domain.TenantLifecycleService {
+	if gracePeriod < 0 {
+		gracePeriod = 0
+	}
+
+	return &LifecycleService{
+		tenantRepo:    tenantRepo,
+		lifecycleRepo: lifecycleRepo,
+		media:         media,
+		publisher:     publisher,
+		gracePeriod:   gracePeriod,
+	}
+}
+
+func (s *LifecycleService) Delete(ctx context.Context, id int64) error {
+	// Business validation: Check if tenant exists
+	tenant, err := s.tenantRepo.GetByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("tenant not found: %w", err)
+	}
+
+	if err := s.tenantRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	tenant.DeletedAt = &now
+	s.publish(ctx, contracts.TenantEventDeleted, tenant)
+
+	return nil
+}
+
+func (s *LifecycleService) Restore(ctx context.Context, id int64) (*domain.Tenant, error) {
+	tenant, err := s.lifecycleRepo.GetDeletedByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if tenant == nil {
+		return nil, errors.New("deleted tenant not found")
+	}
+
+	deletedAfter := time.Now().Add(-s.gracePeriod)
+	if !tenant.DeletedAt.After(deletedAfter) {
+		return nil, domain.ErrRestorePeriodExpired
+	}
+
+	// Business validation: The slug and domain may have been reused while
+	// the tenant was deleted
+	if existing, _ := s.tenantRepo.GetBySlug(ctx, tenant.Slug); existing != nil {
+		return nil, fmt.Errorf("slug %q is now used by another tenant", tenant.Slug)
+	}
+	if tenant.Domain != nil && *tenant.Domain != "" {
+		if existing, _ := s.tenantRepo.GetByDomain(ctx, *tenant.Domain); existing != nil {
+			return nil, fmt.Errorf("domain %q is now used by another tenant", *tenant.Domain)
+		}
+	}
+
+	restored, err := s.lifecycleRepo.Restore(ctx, id, deletedAfter)
+	if err != nil {
+		return nil, err
+	}
+	if restored == nil {
+		// Purged or past the grace period since it was read
+		return nil, domain.ErrRestorePeriodExpired
+	}
+
+	s.publish(ctx, contracts.TenantEventRestored, restored)
+
+	return restored, nil
+}
+
+func (s *LifecycleService) PurgeExpired(ctx context.Context, limit int) (int, error) {
+	deletedBefore := time.Now().Add(-s.gracePeriod)
+
+	tenants, err := s.lifecycleRepo.GetDeletedBefore(ctx, deletedBefore, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	purged := 0
+	for _, tenant := range tenants {
+		if err := ctx.Err(); err != nil {
+			return purged, err
+		}
+
+		// Files go before the row, so the tenant stays due and a failed
+		// delete is retried on the next run. The tenant is past its grace
+		// period and can no longer be restored, and deleting the files again
+		// is harmless.
+		if err := s.deleteMedia(ctx, tenant); err != nil {
+			logger.Error("Skipping tenant purge, failed to delete its media",
+				zap.Int64("tenant_id", tenant.ID),
+				zap.Error(err))
+			continue
+		}
+
+		// subscription-service and billing-service clean up their own data
+		// and release the tenant. The request is repeated on every run until
+		// both have, which also covers a lost request or release.
+		deleted, err := s.lifecycleRepo.Purge(ctx, tenant.ID, deletedBefore)
+		if errors.Is(err, domain.ErrTenantNotReleased) {
+			s.publish(ctx, contracts.TenantEventPurgeRequested, tenant)
+			continue
+		}
+		if err != nil {
+			logger.Warn("Skipping tenant purge",
+				zap.Int64("tenant_id", tenant.ID),
+				zap.Error(err))
+			continue
+		}
+		if deleted == nil {
+			continue
+		}
+
+		purged++
+		s.publish(ctx, contracts.TenantEventPurged, deleted)
+	}
+
+	return purged, nil
+}
+
+func (s *LifecycleService) MarkReleased(ctx context.Context, id int64, by string) error {
+	if id <= 0 {
+		return errors.New("invalid tenant ID")
+	}
+	return s.lifecycleRepo.MarkReleased(ctx, id, by)
+}
+
+func (s *LifecycleService) deleteMedia(ctx context.Context, tenant *domain.Tenant) error {
+	if s.media == nil {
+		return nil
+	}
+
+	deleted, err := s.media.DeleteModelFiles(ctx, domain.MediaModelTenant, tenant.ID)
+	if err != nil {
+		return fmt.Errorf("failed to delete tenant media: %w", err)
+	}
+	if deleted > 0 {
+		logger.Info("Deleted tenant media",
+			zap.Int64("tenant_id", tenant.ID),
+			zap.Int64("count", deleted))
+	}
+
+	return nil
+}
+
+// publish announces a lifecycle change. The change stands even when this
+// fails.
+func (s *LifecycleService) publish(ctx context.Context, routingKey string, tenant *domain.Tenant) {
+	if s.publisher == nil {
+		return
+	}
+
+	eventData := map[string]interface{}{
+		"tenant_id":   tenant.ID,
+		"tenant_uuid": tenant.UUID,
+		"tenant_name": tenant.Name,
+		"slug":        tenant.Slug,
+	}
+	if routingKey == contracts.TenantEventDeleted {
+		eventData["deleted_at"] = tenant.DeletedAt.Unix()
+		eventData["purge_after"] = tenant.DeletedAt.Add(s.gracePeriod).Unix()
+	}
+	dataBytes, _ := json.Marshal(eventData)
+	message := contracts.AmqpMessage{
+		OwnerID: tenant.UUID,
+		Data:    dataBytes,
+	}
+
+	if err := s.publisher.Publish(ctx, routingKey, message); err != nil {
+		logger.Error("Failed to publish tenant lifecycle event",
+			zap.String("routing_key", routingKey),
+			zap.Int64("tenant_id", tenant.ID),
+			zap.Error(err))
+	} else {
+		logger.Info("Published tenant lifecycle event",
+			zap.String("routing_key", routingKey),
+			zap.Int64("tenant_id", tenant.ID))
+	}
+}
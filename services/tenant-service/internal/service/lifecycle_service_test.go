@@ -0,0 +1,207 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/damarteplok/damar-admin-cms/services/tenant-service/internal/domain"
+)
+
+type fakeLifecycleRepo struct {
+	domain.TenantLifecycleRepository
+	deleted  map[int64]*domain.Tenant
+	released map[int64]map[string]bool
+	purged   []int64
+	restored []int64
+}
+
+func (f *fakeLifecycleRepo) GetDeletedByID(ctx context.Context, id int64) (*domain.Tenant, error) {
+	return f.deleted[id], nil
+}
+
+func (f *fakeLifecycleRepo) GetDeletedBefore(ctx context.Context, deletedBefore time.Time, limit int) ([]*domain.Tenant, error) {
+	tenants := make([]*domain.Tenant, 0)
+	for _, tenant := range f.deleted {
+		if !tenant.DeletedAt.After(deletedBefore) {
+			tenants = append(tenants, tenant)
+		}
+	}
+	return tenants, nil
+}
+
+func (f *fakeLifecycleRepo) Purge(ctx context.Context, id int64, deletedBefore time.Time) (*domain.Tenant, error) {
+	tenant := f.deleted[id]
+	if tenant == nil || tenant.DeletedAt.After(deletedBefore) {
+		return nil, nil
+	}
+	if !f.released[id][domain.ReleasedBySubscriptions] || !f.released[id][domain.ReleasedByBilling] {
+		return nil, domain.ErrTenantNotReleased
+	}
+	delete(f.deleted, id)
+	f.purged = append(f.purged, id)
+	return tenant, nil
+}
+
+func (f *fakeLifecycleRepo) MarkReleased(ctx context.Context, id int64, by string) error {
+	if f.deleted[id] == nil {
+		return nil
+	}
+	if f.released == nil {
+		f.released = make(map[int64]map[string]bool)
+	}
+	if f.released[id] == nil {
+		f.released[id] = make(map[string]bool)
+	}
+	f.released[id][by] = true
+	return nil
+}
+
+func (f *fakeLifecycleRepo) Restore(ctx context.Context, id int64, deletedAfter time.Time) (*domain.Tenant, error) {
+	tenant := f.deleted[id]
+	if tenant == nil || !tenant.DeletedAt.After(deletedAfter) {
+		return nil, nil
+	}
+	delete(f.deleted, id)
+	f.restored = append(f.restored, id)
+	tenant.DeletedAt = nil
+	return tenant, nil
+}
+
+type fakeMedia struct {
+	err     error
+	deleted []int64
+}
+
+func (f *fakeMedia) DeleteModelFiles(ctx context.Context, modelType string, modelID int64) (int64, error) {
+	if f.err != nil {
+		return 0, f.err
+	}
+	f.deleted = append(f.deleted, modelID)
+	return 1, nil
+}
+
+// fakeTenants holds live tenants by slug
+type fakeTenants struct {
+	domain.TenantRepository
+	bySlug map[string]*domain.Tenant
+}
+
+func (f *fakeTenants) GetBySlug(ctx context.Context, slug string) (*domain.Tenant, error) {
+	if tenant := f.bySlug[slug]; tenant != nil {
+		return tenant, nil
+	}
+	return nil, errors.New("tenant not found")
+}
+
+func (f *fakeTenants) GetByDomain(ctx context.Context, domainName string) (*domain.Tenant, error) {
+	return nil, errors.New("tenant not found")
+}
+
+func deletedTenant(id int64, slug string, deletedAt time.Time) *domain.Tenant {
+	return &domain.Tenant{ID: id, UUID: slug, Slug: slug, DeletedAt: &deletedAt}
+}
+
+func TestPurgeExpiredKeepsTenantWhenMediaFails(t *testing.T) {
+	grace := 30 * 24 * time.Hour
+	expired := deletedTenant(1, "expired", time.Now().Add(-grace-time.Hour))
+	recent := deletedTenant(2, "recent", time.Now().Add(-time.Hour))
+	repo := &fakeLifecycleRepo{deleted: map[int64]*domain.Tenant{1: expired, 2: recent}}
+	media := &fakeMedia{err: errors.New("media-service unavailable")}
+	service := NewLifecycleService(&fakeTenants{}, repo, media, nil, grace)
+	for _, by := range []string{domain.ReleasedBySubscriptions, domain.ReleasedByBilling} {
+		if err := service.MarkReleased(context.Background(), 1, by); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	purged, err := service.PurgeExpired(context.Background(), 10)
+	if err != nil || purged != 0 {
+		t.Fatalf("PurgeExpired() = %d, %v, want 0, nil", purged, err)
+	}
+	if len(repo.purged) != 0 {
+		t.Fatalf("purged %v although its media was not deleted", repo.purged)
+	}
+
+	media.err = nil
+	purged, err = service.PurgeExpired(context.Background(), 10)
+	if err != nil || purged != 1 {
+		t.Fatalf("PurgeExpired() retry = %d, %v, want 1, nil", purged, err)
+	}
+	if len(repo.purged) != 1 || repo.purged[0] != 1 {
+		t.Errorf("purged %v, want [1]", repo.purged)
+	}
+	if len(media.deleted) != 1 || media.deleted[0] != 1 {
+		t.Errorf("media deleted for %v, want [1]", media.deleted)
+	}
+}
+
+func TestPurgeExpiredWaitsForRelease(t *testing.T) {
+	grace := 30 * 24 * time.Hour
+	repo := &fakeLifecycleRepo{deleted: map[int64]*domain.Tenant{1: deletedTenant(1, "expired", time.Now().Add(-grace-time.Hour))}}
+	service := NewLifecycleService(&fakeTenants{}, repo, &fakeMedia{}, nil, grace)
+	ctx := context.Background()
+
+	for _, by := range []string{"", domain.ReleasedBySubscriptions, domain.ReleasedByBilling} {
+		if by != "" {
+			if err := service.MarkReleased(ctx, 1, by); err != nil {
+				t.Fatalf("MarkReleased(%s) error = %v", by, err)
+			}
+		}
+
+		purged, err := service.PurgeExpired(ctx, 10)
+		if err != nil {
+			t.Fatalf("PurgeExpired() error = %v", err)
+		}
+		// Only the release by the last service lets the tenant go
+		want := 0
+		if by == domain.ReleasedByBilling {
+			want = 1
+		}
+		if purged != want || len(repo.purged) != want {
+			t.Errorf("after release by %q purged %d (%v), want %d", by, purged, repo.purged, want)
+		}
+	}
+}
+
+func TestRestore(t *testing.T) {
+	grace := 30 * 24 * time.Hour
+
+	tests := []struct {
+		name        string
+		deletedAt   time.Time
+		slugTaken   bool
+		wantErr     bool
+		wantExpired bool
+	}{
+		{"within grace period", time.Now().Add(-time.Hour), false, false, false},
+		{"grace period ended", time.Now().Add(-grace - time.Hour), false, true, true},
+		{"slug reused", time.Now().Add(-time.Hour), true, true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeLifecycleRepo{deleted: map[int64]*domain.Tenant{1: deletedTenant(1, "acme", tt.deletedAt)}}
+			tenants := &fakeTenants{bySlug: map[string]*domain.Tenant{}}
+			if tt.slugTaken {
+				tenants.bySlug["acme"] = &domain.Tenant{ID: 2, Slug: "acme"}
+			}
+			service := NewLifecycleService(tenants, repo, &fakeMedia{}, nil, grace)
+
+			restored, err := service.Restore(context.Background(), 1)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Restore() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if errors.Is(err, domain.ErrRestorePeriodExpired) != tt.wantExpired {
+				t.Errorf("Restore() error = %v, want ErrRestorePeriodExpired %v", err, tt.wantExpired)
+			}
+			if !tt.wantErr && (restored == nil || restored.DeletedAt != nil) {
+				t.Errorf("Restore() = %v, want the restored tenant", restored)
+			}
+			if (len(repo.restored) == 1) == tt.wantErr {
+				t.Errorf("restored %v, wantErr %v", repo.restored, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/damarteplok/damar-admin-cms/services/tenant-service/internal/domain"
+	"github.com/damarteplok/damar-admin-cms/shared/amqp"
+	"github.com/damarteplok/damar-admin-cms/shared/contracts"
+	"github.com/damarteplok/damar-admin-cms/shared/logger"
+	"go.uber.org/zap"
+)
+
+type OwnershipService struct {
+	tenantRepo domain.TenantRepository
+	users      domain.UserRepository
+	publisher  *amqp.Publisher
+}
+
+func NewOwnershipService(tenantRepo domain.TenantRepository, users domain.UserRepository, publisher *amqp.Publisher) domain.TenantOwnershipService {
+	return &OwnershipService{
+		tenantRepo: tenantRepo,
+		users:      users,
+		publisher:  publisher,
+	}
+}
+
+func (s *OwnershipService) Transfer(ctx context.Context, params domain.TransferOwnershipParams) (*domain.OwnershipChange, error) {
+	if params.FromUserID == params.ToUserID {
+		return nil, errors.New("cannot transfer ownership to the current owner")
+	}
+
+	// Business validation: Check if tenant exists
+	tenant, err := s.tenantRepo.GetByID(ctx, params.TenantID)
+	if err != nil {
+		return nil, fmt.Errorf("tenant not found: %w", err)
+	}
+
+	change, err := s.tenantRepo.TransferOwnership(ctx, params.TenantID, params.FromUserID, params.ToUserID, params.PerformedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishTransfer(ctx, tenant, change)
+
+	return change, nil
+}
+
+func (s *OwnershipService) GetChanges(ctx context.Context, tenantID int64) ([]*domain.OwnershipChange, error) {
+	// Business validation: Check if tenant exists
+	_, err := s.tenantRepo.GetByID(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("tenant not found: %w", err)
+	}
+
+	return s.tenantRepo.GetOwnershipChanges(ctx, tenantID)
+}
+
+// publishTransfer asks notification-service to email the previous and the
+// new owner. The transfer stands even when this fails.
+func (s *OwnershipService) publishTransfer(ctx context.Context, tenant *domain.Tenant, change *domain.OwnershipChange) {
+	if s.publisher == nil {
+		return
+	}
+
+	from, err := s.users.GetContact(ctx, *change.FromUserID)
+	if err != nil {
+		logger.Error("Failed to get previous owner contact", zap.Int64("tenant_id", tenant.ID), zap.Error(err))
+		return
+	}
+	to, err := s.users.GetContact(ctx, *change.ToUserID)
+	if err != nil {
+		logger.Error("Failed to get new owner contact", zap.Int64("tenant_id", tenant.ID), zap.Error(err))
+		return
+	}
+
+	eventData := map[string]interface{}{
+		"change_id":    change.ID,
+		"tenant_id":    tenant.ID,
+		"tenant_name":  tenant.Name,
+		"from_user_id": from.UserID,
+		"from_name":    from.Name,
+		"from_email":   from.Email,
+		"to_user_id":   to.UserID,
+		"to_name":      to.Name,
+		"to_email":     to.Email,
+	}
+	dataBytes, _ := json.Marshal(eventData)
+	message := contracts.AmqpMessage{
+		OwnerID: tenant.UUID,
+		Data:    dataBytes,
+	}
+
+	if err := s.publisher.Publish(ctx, contracts.TenantEventOwnershipTransferred, message); err != nil {
+		logger.Error("Failed to publish tenant ownership event",
+			zap.Int64("tenant_id", tenant.ID),
+			zap.Error(err))
+	} else {
+		logger.Info("Published tenant ownership event",
+			zap.Int64("tenant_id", tenant.ID),
+			zap.Int64("to_user_id", to.UserID))
+	}
+}
+
+// keepOwner returns the check for a change to userID's membership. It fails
+// when userID is the only owner and stays an owner only if staysOwner.
+func keepOwner(userID int64, staysOwner bool) domain.OwnerCheck {
+	return func(owners []int64) error {
+		if !staysOwner && len(owners) == 1 && owners[0] == userID {
+			return domain.ErrLastOwner
+		}
+		return nil
+	}
+}
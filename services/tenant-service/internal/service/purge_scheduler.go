@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/damarteplok/damar-admin-cms/services/tenant-service/internal/domain"
+	"github.com/damarteplok/damar-admin-cms/shared/logger"
+	"github.com/damarteplok/damar-admin-cms/shared/tenancy"
+	"go.uber.org/zap"
+)
+
+// PurgeScheduler purges tenants whose grace period has ended every interval.
+// Tenants are locked with FOR UPDATE SKIP LOCKED while purged, so every
+// replica can run it.
+type PurgeScheduler struct {
+	lifecycle domain.TenantLifecycleService
+	interval  time.Duration
+	batchSize int
+}
+
+func NewPurgeScheduler(lifecycle domain.TenantLifecycleService, interval time.Duration, batchSize int) *PurgeScheduler {
+	if interval <= 0 {
+		interval = time.Hour
+	}
+	if batchSize < 1 {
+		batchSize = 50
+	}
+
+	return &PurgeScheduler{
+		lifecycle: lifecycle,
+		interval:  interval,
+		batchSize: batchSize,
+	}
+}
+
+// Run purges expired tenants every interval until ctx is cancelled
+func (s *PurgeScheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	s.RunOnce(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.RunOnce(ctx)
+		}
+	}
+}
+
+func (s *PurgeScheduler) RunOnce(ctx context.Context) {
+	// Purging spans tenants
+	ctx = tenancy.WithoutTenant(ctx)
+	purged, err := s.lifecycle.PurgeExpired(ctx, s.batchSize)
+	if err != nil && ctx.Err() == nil {
+		logger.Error("Failed to purge deleted tenants", zap.Error(err))
+	}
+	if purged > 0 {
+		logger.Info("Purged deleted tenants", zap.Int("count", purged))
+	}
+}
@@ -0,0 +1,271 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/damarteplok/damar-admin-cms/services/tenant-service/internal/domain"
+	"github.com/damarteplok/damar-admin-cms/shared/rbac"
+	"github.com/damarteplok/damar-admin-cms/shared/tenancy"
+)
+
+type RoleService struct {
+	repo       domain.TenantRoleRepository
+	tenantRepo domain.TenantRepository
+}
+
+func NewRoleService(repo domain.TenantRoleRepository, tenantRepo domain.TenantRepository) domain.TenantRoleService {
+	return &RoleService{
+		repo:       repo,
+		tenantRepo: tenantRepo,
+	}
+}
+
+func (s *RoleService) GetRoles(ctx context.Context, tenantID int64) ([]*domain.TenantRole, error) {
+	// Business validation: Check if tenant exists
+	_, err := s.tenantRepo.GetByID(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("tenant not found: %w", err)
+	}
+
+	var roles []*domain.TenantRole
+	for _, name := range rbac.BuiltinRoles() {
+		permissions, _ := rbac.BuiltinPermissions(name)
+		roles = append(roles, &domain.TenantRole{
+			TenantID:    tenantID,
+			Name:        name,
+			Permissions: permissions,
+			IsBuiltin:   true,
+		})
+	}
+
+	custom, err := s.repo.GetByTenant(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(roles, custom...), nil
+}
+
+func (s *RoleService) CreateRole(ctx context.Context, role *domain.TenantRole) (*domain.TenantRole, error) {
+	// Business validation: Check if tenant exists
+	_, err := s.tenantRepo.GetByID(ctx, role.TenantID)
+	if err != nil {
+		return nil, fmt.Errorf("tenant not found: %w", err)
+	}
+
+	role.Name = strings.ToLower(strings.TrimSpace(role.Name))
+	if err := rbac.ValidateRoleName(role.Name); err != nil {
+		return nil, err
+	}
+	if rbac.IsBuiltinRole(role.Name) {
+		return nil, fmt.Errorf("%s is a built-in role", role.Name)
+	}
+
+	existing, err := s.repo.GetByName(ctx, role.TenantID, role.Name)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, errors.New("role already exists")
+	}
+
+	permissions, err := normalizePermissions(role.Permissions)
+	if err != nil {
+		return nil, err
+	}
+	role.Permissions = permissions
+
+	return s.repo.Create(ctx, role)
+}
+
+func (s *RoleService) UpdateRole(ctx context.Context, role *domain.TenantRole) (*domain.TenantRole, error) {
+	existing, err := s.getCustom(ctx, role.TenantID, role.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	permissions, err := normalizePermissions(role.Permissions)
+	if err != nil {
+		return nil, err
+	}
+	existing.Description = role.Description
+	existing.Permissions = permissions
+
+	return s.repo.Update(ctx, existing)
+}
+
+func (s *RoleService) DeleteRole(ctx context.Context, tenantID, id int64) error {
+	existing, err := s.getCustom(ctx, tenantID, id)
+	if err != nil {
+		return err
+	}
+
+	// Business validation: Members must be moved to another role first
+	count, err := s.repo.CountMembers(ctx, existing.TenantID, existing.Name)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return fmt.Errorf("role is assigned to %d member(s)", count)
+	}
+
+	return s.repo.Delete(ctx, existing.ID)
+}
+
+func (s *RoleService) ValidateRole(ctx context.Context, tenantID int64, role string) error {
+	if rbac.IsBuiltinRole(role) {
+		return nil
+	}
+
+	custom, err := s.repo.GetByName(ctx, tenantID, role)
+	if err != nil {
+		return err
+	}
+	if custom == nil {
+		return fmt.Errorf("invalid role: %s", role)
+	}
+	return nil
+}
+
+func (s *RoleService) Authorize(ctx context.Context, userID, tenantID int64, permission string) (*domain.Authorization, error) {
+	if !rbac.IsValidPermission(permission) {
+		return nil, fmt.Errorf("unknown permission: %s", permission)
+	}
+
+	// Callers may check a tenant other than their active one, so the
+	// membership lookup is scoped to the tenant being checked
+	ctx = tenancy.WithTenantID(ctx, tenantID)
+	member, err := s.tenantRepo.GetUserTenantRole(ctx, userID, tenantID)
+	if err != nil || member == nil {
+		return &domain.Authorization{Reason: "user is not a member of the tenant"}, nil
+	}
+
+	permissions, err := s.rolePermissions(ctx, tenantID, member.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range permissions {
+		if p == permission {
+			return &domain.Authorization{Allowed: true, Role: member.Role}, nil
+		}
+	}
+
+	return &domain.Authorization{
+		Role:   member.Role,
+		Reason: fmt.Sprintf("role %s does not grant %s", member.Role, permission),
+	}, nil
+}
+
+func (s *RoleService) AuthorizeRoleChange(ctx context.Context, change domain.RoleChange) (*domain.Authorization, error) {
+	// Like Authorize, the lookups are scoped to the tenant being checked
+	ctx = tenancy.WithTenantID(ctx, change.TenantID)
+	member, err := s.tenantRepo.GetUserTenantRole(ctx, change.UserID, change.TenantID)
+	if err != nil || member == nil {
+		return &domain.Authorization{Reason: "user is not a member of the tenant"}, nil
+	}
+
+	held, err := s.rolePermissions(ctx, change.TenantID, member.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	// exceeds returns a reason when permissions include one the member lacks
+	exceeds := func(subject string, permissions []string) string {
+		for _, p := range permissions {
+			if !slices.Contains(held, p) {
+				return fmt.Sprintf("%s grants %s, which role %s does not", subject, p, member.Role)
+			}
+		}
+		return ""
+	}
+
+	var reasons []string
+	if change.Role != "" {
+		permissions, err := s.rolePermissions(ctx, change.TenantID, change.Role)
+		if err != nil {
+			return nil, err
+		}
+		reasons = append(reasons, exceeds("role "+change.Role, permissions))
+	}
+	if len(change.Permissions) > 0 {
+		reasons = append(reasons, exceeds("the role definition", change.Permissions))
+	}
+	if change.RoleID > 0 {
+		role, err := s.getCustom(ctx, change.TenantID, change.RoleID)
+		if err != nil {
+			return nil, err
+		}
+		reasons = append(reasons, exceeds("role "+role.Name, role.Permissions))
+	}
+	if change.TargetUserID > 0 {
+		// A target who is not a member fails in the change itself
+		target, err := s.tenantRepo.GetUserTenantRole(ctx, change.TargetUserID, change.TenantID)
+		if err == nil && target != nil {
+			permissions, err := s.rolePermissions(ctx, change.TenantID, target.Role)
+			if err != nil {
+				return nil, err
+			}
+			reasons = append(reasons, exceeds("the member's role "+target.Role, permissions))
+		}
+	}
+
+	for _, reason := range reasons {
+		if reason != "" {
+			return &domain.Authorization{Role: member.Role, Reason: reason}, nil
+		}
+	}
+
+	return &domain.Authorization{Allowed: true, Role: member.Role}, nil
+}
+
+// rolePermissions returns the permissions of a built-in or custom role. A
+// custom role that no longer exists grants nothing.
+func (s *RoleService) rolePermissions(ctx context.Context, tenantID int64, role string) ([]string, error) {
+	if permissions, ok := rbac.BuiltinPermissions(role); ok {
+		return permissions, nil
+	}
+
+	custom, err := s.repo.GetByName(ctx, tenantID, role)
+	if err != nil {
+		return nil, err
+	}
+	if custom == nil {
+		return nil, nil
+	}
+	return custom.Permissions, nil
+}
+
+// getCustom returns a custom role of the tenant
+func (s *RoleService) getCustom(ctx context.Context, tenantID, id int64) (*domain.TenantRole, error) {
+	role, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if role == nil || role.TenantID != tenantID {
+		return nil, errors.New("role not found")
+	}
+	return role, nil
+}
+
+// normalizePermissions rejects unknown permissions and drops duplicates
+func normalizePermissions(permissions []string) ([]string, error) {
+	result := make([]string, 0, len(permissions))
+	seen := make(map[string]bool, len(permissions))
+	for _, p := range permissions {
+		p = strings.TrimSpace(p)
+		if !rbac.IsValidPermission(p) {
+			return nil, fmt.Errorf("unknown permission: %s", p)
+		}
+		if seen[p] {
+			continue
+		}
+		seen[p] = true
+		result = append(result, p)
+	}
+	return result, nil
+}
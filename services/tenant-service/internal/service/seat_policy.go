@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/damarteplok/damar-admin-cms/services/tenant-service/internal/domain"
+)
+
+type SeatPolicy struct {
+	repo      domain.TenantRepository
+	seats     domain.SeatEntitlements
+	freeSeats int64
+}
+
+// NewSeatPolicy creates the seat policy. seats may be nil to turn seat
+// limits off; freeSeats is the limit for tenants without an active
+// subscription.
+func NewSeatPolicy(repo domain.TenantRepository, seats domain.SeatEntitlements, freeSeats int64) domain.SeatPolicy {
+	return &SeatPolicy{
+		repo:      repo,
+		seats:     seats,
+		freeSeats: freeSeats,
+	}
+}
+
+// Usage counts a tenant's seats and reads its limit. The limit comes from
+// the active subscription, or freeSeats when there is none.
+func (p *SeatPolicy) Usage(ctx context.Context, tenantID int64) (*domain.SeatUsage, error) {
+	used, err := p.repo.CountTenantUsers(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	usage := &domain.SeatUsage{
+		TenantID: tenantID,
+		Used:     used,
+	}
+	if p.seats == nil {
+		usage.Unlimited = true
+		return usage, nil
+	}
+	usage.Enforced = true
+
+	limit, err := p.seats.GetSeatLimit(ctx, tenantID, used)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get seat limit: %w", err)
+	}
+	usage.SubscriptionID = limit.SubscriptionID
+
+	switch {
+	case limit.SubscriptionID == 0:
+		usage.Limit = p.freeSeats
+	case limit.Unlimited:
+		usage.Unlimited = true
+		return usage, nil
+	default:
+		usage.Limit = limit.Limit
+	}
+
+	if used < usage.Limit {
+		usage.Remaining = usage.Limit - used
+	}
+	return usage, nil
+}
+
+func (p *SeatPolicy) Check(ctx context.Context, tenantID int64) (domain.SeatCheck, error) {
+	if p.seats == nil {
+		return nil, nil
+	}
+
+	usage, err := p.Usage(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	if usage.Unlimited {
+		return nil, nil
+	}
+
+	// The count is taken again by the caller's transaction; this one only
+	// served to read the limit
+	return func(used int64) error {
+		if used >= usage.Limit {
+			return &domain.SeatLimitError{
+				TenantID: tenantID,
+				Used:     used,
+				Limit:    usage.Limit,
+			}
+		}
+		return nil
+	}, nil
+}
@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/damarteplok/damar-admin-cms/services/tenant-service/internal/domain"
+)
+
+type fakeSeatCount struct {
+	domain.TenantRepository
+	used int64
+}
+
+func (f fakeSeatCount) CountTenantUsers(ctx context.Context, tenantID int64) (int64, error) {
+	return f.used, nil
+}
+
+type fakeSeatEntitlements struct {
+	limit *domain.SeatLimit
+	err   error
+}
+
+func (f fakeSeatEntitlements) GetSeatLimit(ctx context.Context, tenantID, used int64) (*domain.SeatLimit, error) {
+	return f.limit, f.err
+}
+
+func TestSeatPolicyCheck(t *testing.T) {
+	tests := []struct {
+		name      string
+		limit     *domain.SeatLimit
+		freeSeats int64
+		used      int64
+		wantNil   bool
+		wantLimit int64
+	}{
+		{"unlimited plan", &domain.SeatLimit{SubscriptionID: 1, Unlimited: true}, 2, 50, true, 0},
+		{"plan limit", &domain.SeatLimit{SubscriptionID: 1, Limit: 5}, 2, 3, false, 5},
+		{"no subscription uses free seats", &domain.SeatLimit{}, 2, 1, false, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := NewSeatPolicy(fakeSeatCount{used: tt.used}, fakeSeatEntitlements{limit: tt.limit}, tt.freeSeats)
+
+			check, err := policy.Check(context.Background(), 7)
+			if err != nil {
+				t.Fatalf("Check() error = %v", err)
+			}
+			if tt.wantNil {
+				if check != nil {
+					t.Error("Check() returned a check for unlimited seats")
+				}
+				return
+			}
+			if check == nil {
+				t.Fatal("Check() = nil, want a check")
+			}
+
+			// The check uses the count it is given, not the one read above
+			if err := check(tt.wantLimit - 1); err != nil {
+				t.Errorf("check(%d) = %v, want nil", tt.wantLimit-1, err)
+			}
+			var seatErr *domain.SeatLimitError
+			if err := check(tt.wantLimit); !errors.As(err, &seatErr) {
+				t.Fatalf("check(%d) = %v, want a SeatLimitError", tt.wantLimit, err)
+			}
+			if seatErr.TenantID != 7 || seatErr.Used != tt.wantLimit || seatErr.Limit != tt.wantLimit {
+				t.Errorf("SeatLimitError = %+v", seatErr)
+			}
+		})
+	}
+}
+
+func TestSeatPolicyCheckDisabled(t *testing.T) {
+	policy := NewSeatPolicy(fakeSeatCount{used: 100}, nil, 2)
+
+	check, err := policy.Check(context.Background(), 7)
+	if err != nil || check != nil {
+		t.Errorf("Check() = %v, %v, want no check when seat limits are off", check, err)
+	}
+}
+
+func TestSeatPolicyCheckEntitlementError(t *testing.T) {
+	policy := NewSeatPolicy(fakeSeatCount{}, fakeSeatEntitlements{err: errors.New("subscription-service unavailable")}, 2)
+
+	if check, err := policy.Check(context.Background(), 7); err == nil || check != nil {
+		t.Errorf("Check() = %v, %v, want the error and no check", check, err)
+	}
+}
+
+func TestSeatUsage(t *testing.T) {
+	policy := NewSeatPolicy(fakeSeatCount{used: 7}, fakeSeatEntitlements{limit: &domain.SeatLimit{SubscriptionID: 1, Limit: 5}}, 2)
+
+	usage, err := policy.Usage(context.Background(), 7)
+	if err != nil {
+		t.Fatalf("Usage() error = %v", err)
+	}
+	if !usage.Enforced || usage.Used != 7 || usage.Limit != 5 || usage.Remaining != 0 || usage.SubscriptionID != 1 {
+		t.Errorf("Usage() = %+v, want 7 of 5 seats used and none remaining", usage)
+	}
+}
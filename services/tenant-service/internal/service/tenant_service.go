@@ -2,20 +2,35 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
 
 	"github.com/damarteplok/damar-admin-cms/services/tenant-service/internal/domain"
+	"github.com/damarteplok/damar-admin-cms/shared/amqp"
+	"github.com/damarteplok/damar-admin-cms/shared/contracts"
+	"github.com/damarteplok/damar-admin-cms/shared/logger"
+	"github.com/damarteplok/damar-admin-cms/shared/rbac"
+	"github.com/damarteplok/damar-admin-cms/shared/tenancy"
 	"github.com/google/uuid"
+	"go.uber.org/zap"
 )
 
 type TenantService struct {
-	repo domain.TenantRepository
+	repo      domain.TenantRepository
+	seats     domain.SeatPolicy
+	roles     domain.TenantRoleService
+	publisher *amqp.Publisher
 }
 
-func NewTenantService(repo domain.TenantRepository) domain.TenantService {
-	return &TenantService{repo: repo}
+func NewTenantService(repo domain.TenantRepository, seats domain.SeatPolicy, roles domain.TenantRoleService, publisher *amqp.Publisher) domain.TenantService {
+	return &TenantService{
+		repo:      repo,
+		seats:     seats,
+		roles:     roles,
+		publisher: publisher,
+	}
 }
 
 // Tenant operations
@@ -33,7 +48,7 @@ func (s *TenantService) GetTenantBySlug(ctx context.Context, slug string) (*doma
 }
 
 func (s *TenantService) GetTenantByDomain(ctx context.Context, domainStr string) (*domain.Tenant, error) {
-	return s.repo.GetByDomain(ctx, domainStr)
+	return s.repo.GetByDomain(ctx, normalizeHost(domainStr))
 }
 
 func (s *TenantService) CreateTenant(ctx context.Context, tenant *domain.Tenant) (*domain.Tenant, error) {
@@ -56,6 +71,7 @@ func (s *TenantService) CreateTenant(ctx context.Context, tenant *domain.Tenant)
 	}
 
 	// Business validation: Check if domain already exists (if provided)
+	normalizeTenantDomain(tenant)
 	if tenant.Domain != nil && *tenant.Domain != "" {
 		existing, _ := s.repo.GetByDomain(ctx, *tenant.Domain)
 		if existing != nil {
@@ -77,6 +93,7 @@ func (s *TenantService) UpdateTenant(ctx context.Context, tenant *domain.Tenant)
 	}
 
 	// Business validation: Check if domain already exists (if changed and provided)
+	normalizeTenantDomain(tenant)
 	if tenant.Domain != nil && *tenant.Domain != "" {
 		if existing.Domain == nil || *existing.Domain != *tenant.Domain {
 			domainExists, _ := s.repo.GetByDomain(ctx, *tenant.Domain)
@@ -89,17 +106,14 @@ func (s *TenantService) UpdateTenant(ctx context.Context, tenant *domain.Tenant)
 	return s.repo.Update(ctx, tenant)
 }
 
-func (s *TenantService) DeleteTenant(ctx context.Context, id int64) error {
-	// Business validation: Check if tenant exists
-	existing, err := s.repo.GetByID(ctx, id)
-	if err != nil {
-		return fmt.Errorf("tenant not found: %w", err)
+// normalizeTenantDomain stores the domain the way domain verification and
+// host lookups compare it
+func normalizeTenantDomain(tenant *domain.Tenant) {
+	if tenant.Domain == nil {
+		return
 	}
-	if existing == nil {
-		return errors.New("tenant not found")
-	}
-
-	return s.repo.Delete(ctx, id)
+	normalized := normalizeHost(*tenant.Domain)
+	tenant.Domain = &normalized
 }
 
 func (s *TenantService) GetAllTenants(ctx context.Context, page, perPage int, search, sortBy, sortOrder string) ([]*domain.Tenant, int64, error) {
@@ -128,16 +142,22 @@ func (s *TenantService) AddUserToTenant(ctx context.Context, tenantUser *domain.
 	}
 
 	// Business validation: Validate role
-	if !isValidRole(tenantUser.Role) {
-		return nil, fmt.Errorf("invalid role: %s", tenantUser.Role)
+	if err := s.roles.ValidateRole(ctx, tenantUser.TenantID, tenantUser.Role); err != nil {
+		return nil, err
 	}
 
-	return s.repo.AddUserToTenant(ctx, tenantUser)
+	// Business validation: The new member needs a free seat
+	checkSeat, err := s.seats.Check(ctx, tenantUser.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.AddUserToTenant(ctx, tenantUser, checkSeat)
 }
 
-func (s *TenantService) RemoveUserFromTenant(ctx context.Context, userID, tenantID int64) error {
+func (s *TenantService) RemoveUserFromTenant(ctx context.Context, userID, tenantID int64, performedBy *int64) error {
 	// Business validation: Check if tenant exists and is active
-	_, err := s.repo.GetByID(ctx, tenantID)
+	tenant, err := s.repo.GetByID(ctx, tenantID)
 	if err != nil {
 		return fmt.Errorf("tenant not found: %w", err)
 	}
@@ -148,7 +168,36 @@ func (s *TenantService) RemoveUserFromTenant(ctx context.Context, userID, tenant
 		return fmt.Errorf("user not in tenant: %w", err)
 	}
 
-	return s.repo.RemoveUserFromTenant(ctx, userID, tenantID)
+	if err := s.repo.RemoveUserFromTenant(ctx, userID, tenantID, performedBy, keepOwner(userID, false)); err != nil {
+		return err
+	}
+
+	s.publishMemberRemoved(ctx, tenant, userID)
+	return nil
+}
+
+// publishMemberRemoved lets the gateway drop the membership it has cached.
+// The removal stands even when this fails.
+func (s *TenantService) publishMemberRemoved(ctx context.Context, tenant *domain.Tenant, userID int64) {
+	if s.publisher == nil {
+		return
+	}
+
+	dataBytes, _ := json.Marshal(map[string]interface{}{
+		"tenant_id": tenant.ID,
+		"user_id":   userID,
+	})
+	message := contracts.AmqpMessage{
+		OwnerID: tenant.UUID,
+		Data:    dataBytes,
+	}
+
+	if err := s.publisher.Publish(ctx, contracts.TenantEventMemberRemoved, message); err != nil {
+		logger.Error("Failed to publish tenant member event",
+			zap.Int64("tenant_id", tenant.ID),
+			zap.Int64("user_id", userID),
+			zap.Error(err))
+	}
 }
 
 func (s *TenantService) GetTenantUsers(ctx context.Context, tenantID int64) ([]*domain.TenantUser, error) {
@@ -169,7 +218,7 @@ func (s *TenantService) GetUserTenantRole(ctx context.Context, userID, tenantID
 	return s.repo.GetUserTenantRole(ctx, userID, tenantID)
 }
 
-func (s *TenantService) UpdateUserRole(ctx context.Context, userID, tenantID int64, role string) error {
+func (s *TenantService) UpdateUserRole(ctx context.Context, userID, tenantID int64, role string, performedBy *int64) error {
 	// Business validation: Check if tenant exists and is active
 	_, err := s.repo.GetByID(ctx, tenantID)
 	if err != nil {
@@ -183,11 +232,11 @@ func (s *TenantService) UpdateUserRole(ctx context.Context, userID, tenantID int
 	}
 
 	// Business validation: Validate role
-	if !isValidRole(role) {
-		return fmt.Errorf("invalid role: %s", role)
+	if err := s.roles.ValidateRole(ctx, tenantID, role); err != nil {
+		return err
 	}
 
-	return s.repo.UpdateUserRole(ctx, userID, tenantID, role)
+	return s.repo.UpdateUserRole(ctx, userID, tenantID, role, performedBy, keepOwner(userID, role == rbac.RoleOwner))
 }
 
 func (s *TenantService) SetDefaultTenant(ctx context.Context, userID, tenantID int64) error {
@@ -197,8 +246,9 @@ func (s *TenantService) SetDefaultTenant(ctx context.Context, userID, tenantID i
 		return fmt.Errorf("tenant not found: %w", err)
 	}
 
-	// Business validation: Check if relationship exists
-	_, err = s.repo.GetUserTenantRole(ctx, userID, tenantID)
+	// Business validation: Check if relationship exists. The new default
+	// need not be the active tenant, so the lookup is scoped to it.
+	_, err = s.repo.GetUserTenantRole(tenancy.WithTenantID(ctx, tenantID), userID, tenantID)
 	if err != nil {
 		return fmt.Errorf("user not in tenant: %w", err)
 	}
@@ -206,6 +256,16 @@ func (s *TenantService) SetDefaultTenant(ctx context.Context, userID, tenantID i
 	return s.repo.SetDefaultTenant(ctx, userID, tenantID)
 }
 
+func (s *TenantService) GetSeatUsage(ctx context.Context, tenantID int64) (*domain.SeatUsage, error) {
+	// Business validation: Check if tenant exists
+	_, err := s.repo.GetByID(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("tenant not found: %w", err)
+	}
+
+	return s.seats.Usage(ctx, tenantID)
+}
+
 // TenantSetting operations
 
 func (s *TenantService) GetSetting(ctx context.Context, tenantID int64, key string) (*domain.TenantSetting, error) {
@@ -268,13 +328,3 @@ func generateSlug(name string) string {
 	}
 	return result.String()
 }
-
-func isValidRole(role string) bool {
-	validRoles := map[string]bool{
-		"owner":  true,
-		"admin":  true,
-		"member": true,
-		"guest":  true,
-	}
-	return validRoles[role]
-}
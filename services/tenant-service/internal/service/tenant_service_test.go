@@ -0,0 +1,186 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/damarteplok/damar-admin-cms/services/tenant-service/internal/domain"
+	"github.com/damarteplok/damar-admin-cms/shared/rbac"
+)
+
+// fakeMembers holds the roles of one tenant's members and runs the owner
+// check on its owners, like the repository does with the tenant locked
+type fakeMembers struct {
+	domain.TenantRepository
+	roles map[int64]string
+}
+
+func (f *fakeMembers) GetByID(ctx context.Context, id int64) (*domain.Tenant, error) {
+	return &domain.Tenant{ID: id}, nil
+}
+
+func (f *fakeMembers) GetUserTenantRole(ctx context.Context, userID, tenantID int64) (*domain.TenantUser, error) {
+	role, ok := f.roles[userID]
+	if !ok {
+		return nil, errors.New("user not in tenant")
+	}
+	return &domain.TenantUser{UserID: userID, TenantID: tenantID, Role: role}, nil
+}
+
+func (f *fakeMembers) owners() []int64 {
+	var owners []int64
+	for userID, role := range f.roles {
+		if role == rbac.RoleOwner {
+			owners = append(owners, userID)
+		}
+	}
+	return owners
+}
+
+func (f *fakeMembers) RemoveUserFromTenant(ctx context.Context, userID, tenantID int64, performedBy *int64, checkOwners domain.OwnerCheck) error {
+	if err := checkOwners(f.owners()); err != nil {
+		return err
+	}
+	delete(f.roles, userID)
+	return nil
+}
+
+func (f *fakeMembers) UpdateUserRole(ctx context.Context, userID, tenantID int64, role string, performedBy *int64, checkOwners domain.OwnerCheck) error {
+	if err := checkOwners(f.owners()); err != nil {
+		return err
+	}
+	f.roles[userID] = role
+	return nil
+}
+
+type fakeRoles struct {
+	domain.TenantRoleService
+}
+
+func (fakeRoles) ValidateRole(ctx context.Context, tenantID int64, role string) error {
+	return nil
+}
+
+func newTestTenantService(roles map[int64]string) (domain.TenantService, *fakeMembers) {
+	repo := &fakeMembers{roles: roles}
+	return NewTenantService(repo, nil, fakeRoles{}, nil), repo
+}
+
+func TestRemoveUserFromTenantKeepsAnOwner(t *testing.T) {
+	tests := []struct {
+		name    string
+		roles   map[int64]string
+		userID  int64
+		wantErr error
+	}{
+		{"last owner", map[int64]string{1: rbac.RoleOwner, 2: rbac.RoleAdmin}, 1, domain.ErrLastOwner},
+		{"one of two owners", map[int64]string{1: rbac.RoleOwner, 2: rbac.RoleOwner}, 1, nil},
+		{"member of a tenant with one owner", map[int64]string{1: rbac.RoleOwner, 2: rbac.RoleMember}, 2, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, repo := newTestTenantService(tt.roles)
+
+			err := service.RemoveUserFromTenant(context.Background(), tt.userID, 3, nil)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("RemoveUserFromTenant() error = %v, want %v", err, tt.wantErr)
+			}
+			_, stillMember := repo.roles[tt.userID]
+			if stillMember != (tt.wantErr != nil) {
+				t.Errorf("member kept = %v, want %v", stillMember, tt.wantErr != nil)
+			}
+		})
+	}
+}
+
+func TestUpdateUserRoleKeepsAnOwner(t *testing.T) {
+	tests := []struct {
+		name    string
+		roles   map[int64]string
+		userID  int64
+		role    string
+		wantErr error
+	}{
+		{"demote last owner", map[int64]string{1: rbac.RoleOwner, 2: rbac.RoleAdmin}, 1, rbac.RoleAdmin, domain.ErrLastOwner},
+		{"last owner stays owner", map[int64]string{1: rbac.RoleOwner}, 1, rbac.RoleOwner, nil},
+		{"demote one of two owners", map[int64]string{1: rbac.RoleOwner, 2: rbac.RoleOwner}, 1, rbac.RoleMember, nil},
+		{"promote member", map[int64]string{1: rbac.RoleOwner, 2: rbac.RoleMember}, 2, rbac.RoleOwner, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, repo := newTestTenantService(tt.roles)
+			before := repo.roles[tt.userID]
+
+			err := service.UpdateUserRole(context.Background(), tt.userID, 3, tt.role, nil)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("UpdateUserRole() error = %v, want %v", err, tt.wantErr)
+			}
+			want := tt.role
+			if tt.wantErr != nil {
+				want = before
+			}
+			if repo.roles[tt.userID] != want {
+				t.Errorf("role = %s, want %s", repo.roles[tt.userID], want)
+			}
+		})
+	}
+}
+
+// fakeDomains finds live tenants by their stored domain and keeps the
+// tenant it was last asked to save
+type fakeDomains struct {
+	domain.TenantRepository
+	byDomain map[string]*domain.Tenant
+	saved    *domain.Tenant
+}
+
+func (f *fakeDomains) GetBySlug(ctx context.Context, slug string) (*domain.Tenant, error) {
+	return nil, errors.New("tenant not found")
+}
+
+func (f *fakeDomains) GetByDomain(ctx context.Context, domainName string) (*domain.Tenant, error) {
+	if tenant := f.byDomain[domainName]; tenant != nil {
+		return tenant, nil
+	}
+	return nil, errors.New("tenant not found")
+}
+
+func (f *fakeDomains) Create(ctx context.Context, tenant *domain.Tenant) (*domain.Tenant, error) {
+	f.saved = tenant
+	return tenant, nil
+}
+
+func TestCreateTenantNormalizesDomain(t *testing.T) {
+	tests := []struct {
+		name       string
+		domain     string
+		wantDomain string
+		wantErr    bool
+	}{
+		{"normalized", "Shop.Example.com.", "shop.example.com", false},
+		{"with port", "shop.example.com:8443", "shop.example.com", false},
+		{"taken in another spelling", "Taken.Example.com", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeDomains{byDomain: map[string]*domain.Tenant{"taken.example.com": {ID: 9}}}
+			service := NewTenantService(repo, nil, fakeRoles{}, nil)
+			domainName := tt.domain
+
+			_, err := service.CreateTenant(context.Background(), &domain.Tenant{Name: "Shop", Slug: "shop", Domain: &domainName})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CreateTenant() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if repo.saved.Domain == nil || *repo.saved.Domain != tt.wantDomain {
+				t.Errorf("saved domain = %v, want %s", repo.saved.Domain, tt.wantDomain)
+			}
+		})
+	}
+}
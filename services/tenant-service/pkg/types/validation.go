@@ -28,14 +28,51 @@ type TenantSlugValidation struct {
 type AddUserToTenantValidation struct {
 	UserID   int64  `validate:"required,gt=0"`
 	TenantID int64  `validate:"required,gt=0"`
-	Role     string `validate:"required,oneof=owner admin member guest"`
+	Role     string `validate:"required,min=1,max=50"`
 	Email    string `validate:"omitempty,email"`
 }
 
+type InviteUserValidation struct {
+	TenantID int64  `validate:"required,gt=0"`
+	Email    string `validate:"required,email,max=255"`
+	Role     string `validate:"required,min=1,max=50"`
+}
+
 type UpdateUserRoleValidation struct {
 	UserID   int64  `validate:"required,gt=0"`
 	TenantID int64  `validate:"required,gt=0"`
-	Role     string `validate:"required,oneof=owner admin member guest"`
+	Role     string `validate:"required,min=1,max=50"`
+}
+
+type CreateTenantRoleValidation struct {
+	TenantID    int64  `validate:"required,gt=0"`
+	Name        string `validate:"required,min=1,max=50"`
+	Description string `validate:"omitempty,max=255"`
+}
+
+type UpdateTenantRoleValidation struct {
+	ID          int64  `validate:"required,gt=0"`
+	TenantID    int64  `validate:"required,gt=0"`
+	Description string `validate:"omitempty,max=255"`
+}
+
+type AuthorizeValidation struct {
+	UserID     int64  `validate:"required,gt=0"`
+	TenantID   int64  `validate:"required,gt=0"`
+	Permission string `validate:"required"`
+}
+
+type AuthorizeRoleChangeValidation struct {
+	UserID       int64 `validate:"required,gt=0"`
+	TenantID     int64 `validate:"required,gt=0"`
+	RoleID       int64 `validate:"omitempty,gt=0"`
+	TargetUserID int64 `validate:"omitempty,gt=0"`
+}
+
+type TransferOwnershipValidation struct {
+	TenantID   int64 `validate:"required,gt=0"`
+	FromUserID int64 `validate:"required,gt=0"`
+	ToUserID   int64 `validate:"required,gt=0"`
 }
 
 type TenantSettingValidation struct {
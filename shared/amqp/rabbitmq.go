@@ -175,6 +175,44 @@ func NewConsumer(conn *Connection, queueName, exchangeName, routingKey string) (
 	}, nil
 }
 
+// NewBroadcastConsumer creates a consumer on a queue of its own, named by
+// the broker and deleted when the connection closes, bound to each routing
+// key. Every instance of a service sees every message, which suits work
+// local to the instance such as evicting a cache.
+func NewBroadcastConsumer(conn *Connection, exchangeName string, routingKeys ...string) (*Consumer, error) {
+	if err := conn.DeclareExchange(exchangeName, "topic"); err != nil {
+		return nil, fmt.Errorf("failed to declare exchange: %w", err)
+	}
+
+	queue, err := conn.channel.QueueDeclare(
+		"",    // name, chosen by the broker
+		false, // durable
+		true,  // delete when unused
+		true,  // exclusive
+		false, // no-wait
+		nil,   // arguments
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to declare queue: %w", err)
+	}
+
+	for _, routingKey := range routingKeys {
+		if err := conn.channel.QueueBind(queue.Name, routingKey, exchangeName, false, nil); err != nil {
+			return nil, fmt.Errorf("failed to bind queue: %w", err)
+		}
+	}
+
+	logger.Info("Broadcast queue declared and bound",
+		zap.String("queue", queue.Name),
+		zap.String("exchange", exchangeName),
+		zap.Strings("routingKeys", routingKeys))
+
+	return &Consumer{
+		conn:      conn,
+		queueName: queue.Name,
+	}, nil
+}
+
 // Consume starts consuming messages
 func (c *Consumer) Consume(handler func([]byte) error) error {
 	msgs, err := c.conn.channel.Consume(
@@ -55,6 +55,52 @@ const (
 	ProductEventDeleted      = "product.event.deleted"
 	ProductEventPriceChanged = "product.event.price_changed"
 
+	// Subscription events (subscription.event.*)
+	SubscriptionEventCreated               = "subscription.event.created"
+	SubscriptionEventUpdated               = "subscription.event.updated"
+	SubscriptionEventActivated             = "subscription.event.activated"
+	SubscriptionEventPastDue               = "subscription.event.past_due"
+	SubscriptionEventPaused                = "subscription.event.paused"
+	SubscriptionEventResumed               = "subscription.event.resumed"
+	SubscriptionEventCancellationScheduled = "subscription.event.cancellation_scheduled"
+	SubscriptionEventCancelled             = "subscription.event.cancelled"
+	SubscriptionEventExpired               = "subscription.event.expired"
+	SubscriptionEventRenewed               = "subscription.event.renewed"
+	SubscriptionEventPlanChanged           = "subscription.event.plan_changed"
+	SubscriptionEventPlanChangeScheduled   = "subscription.event.plan_change_scheduled"
+	SubscriptionEventTenantReleased        = "subscription.event.tenant_released"
+
+	// Invoice events (invoice.event.*)
+	InvoiceEventCreated       = "invoice.event.created"
+	InvoiceEventFinalized     = "invoice.event.finalized"
+	InvoiceEventPaid          = "invoice.event.paid"
+	InvoiceEventVoided        = "invoice.event.voided"
+	InvoiceEventPaymentFailed = "invoice.event.payment_failed"
+
+	// Dunning events (dunning.event.*)
+	DunningEventAttemptFailed  = "dunning.event.attempt_failed"
+	DunningEventRecovered      = "dunning.event.recovered"
+	DunningEventExhausted      = "dunning.event.exhausted"
+	DunningEventTenantReleased = "dunning.event.tenant_released"
+
+	// Order events (order.event.*)
+	OrderEventCreated   = "order.event.created"
+	OrderEventPaid      = "order.event.paid"
+	OrderEventCancelled = "order.event.cancelled"
+	OrderEventRefunded  = "order.event.refunded"
+
+	// Referral events (referral.event.*)
+	ReferralEventConverted = "referral.event.converted"
+
+	// Tenant events (tenant.event.*)
+	TenantEventInvitationSent       = "tenant.event.invitation_sent"
+	TenantEventOwnershipTransferred = "tenant.event.ownership_transferred"
+	TenantEventMemberRemoved        = "tenant.event.member_removed"
+	TenantEventDeleted              = "tenant.event.deleted"
+	TenantEventRestored             = "tenant.event.restored"
+	TenantEventPurgeRequested       = "tenant.event.purge_requested"
+	TenantEventPurged               = "tenant.event.purged"
+
 	// Media events (media.event.*)
 	RoutingKeyMediaEventUploaded = "media.event.uploaded"
 	RoutingKeyMediaEventDeleted  = "media.event.deleted"
@@ -0,0 +1,4 @@
+-- Revert scheduled plan change columns
+ALTER TABLE subscriptions DROP CONSTRAINT IF EXISTS subscriptions_scheduled_plan_id_foreign;
+ALTER TABLE subscriptions DROP COLUMN IF EXISTS scheduled_quantity;
+ALTER TABLE subscriptions DROP COLUMN IF EXISTS scheduled_plan_id;
@@ -0,0 +1,9 @@
+-- Add columns holding a plan change that takes effect at the next renewal
+ALTER TABLE subscriptions ADD COLUMN IF NOT EXISTS scheduled_plan_id BIGINT NULL;
+ALTER TABLE subscriptions ADD COLUMN IF NOT EXISTS scheduled_quantity INTEGER NULL;
+
+ALTER TABLE subscriptions ADD CONSTRAINT subscriptions_scheduled_plan_id_foreign
+    FOREIGN KEY (scheduled_plan_id)
+    REFERENCES plans(id)
+    ON DELETE SET NULL
+    ON UPDATE CASCADE;
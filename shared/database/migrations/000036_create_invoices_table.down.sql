@@ -0,0 +1,9 @@
+-- Drop invoices table
+DROP INDEX IF EXISTS idx_invoices_subscription_id_period_start;
+DROP INDEX IF EXISTS idx_invoices_tenant_id_number;
+DROP INDEX IF EXISTS idx_invoices_tenant_id_sequence;
+DROP INDEX IF EXISTS idx_invoices_status;
+DROP INDEX IF EXISTS idx_invoices_subscription_id;
+DROP INDEX IF EXISTS idx_invoices_tenant_id;
+DROP INDEX IF EXISTS idx_invoices_uuid;
+DROP TABLE IF EXISTS invoices;
@@ -0,0 +1,41 @@
+-- Create invoices table
+CREATE TABLE IF NOT EXISTS invoices (
+    id BIGSERIAL PRIMARY KEY,
+    uuid VARCHAR(255) NOT NULL UNIQUE,
+    tenant_id BIGINT NOT NULL,
+    subscription_id BIGINT NULL,
+    user_id BIGINT NULL,
+    currency_id BIGINT NOT NULL,
+    number VARCHAR(255) NULL,
+    sequence BIGINT NULL,
+    status VARCHAR(255) NOT NULL DEFAULT 'draft',
+    period_start TIMESTAMP(0) NULL,
+    period_end TIMESTAMP(0) NULL,
+    subtotal BIGINT NOT NULL DEFAULT 0,
+    discount_total BIGINT NOT NULL DEFAULT 0,
+    total BIGINT NOT NULL DEFAULT 0,
+    due_at TIMESTAMP(0) NULL,
+    finalized_at TIMESTAMP(0) NULL,
+    paid_at TIMESTAMP(0) NULL,
+    voided_at TIMESTAMP(0) NULL,
+    void_reason VARCHAR(255) NULL,
+    metadata JSON,
+    created_at TIMESTAMP(0) NULL,
+    updated_at TIMESTAMP(0) NULL,
+    CONSTRAINT invoices_tenant_id_foreign FOREIGN KEY (tenant_id) REFERENCES tenants(id) ON DELETE CASCADE,
+    CONSTRAINT invoices_subscription_id_foreign FOREIGN KEY (subscription_id) REFERENCES subscriptions(id) ON DELETE SET NULL,
+    CONSTRAINT invoices_user_id_foreign FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE SET NULL,
+    CONSTRAINT invoices_currency_id_foreign FOREIGN KEY (currency_id) REFERENCES currencies(id)
+);
+
+CREATE INDEX idx_invoices_uuid ON invoices(uuid);
+CREATE INDEX idx_invoices_tenant_id ON invoices(tenant_id);
+CREATE INDEX idx_invoices_subscription_id ON invoices(subscription_id);
+CREATE INDEX idx_invoices_status ON invoices(status);
+
+-- Invoice numbers are sequential per tenant
+CREATE UNIQUE INDEX idx_invoices_tenant_id_sequence ON invoices(tenant_id, sequence);
+CREATE UNIQUE INDEX idx_invoices_tenant_id_number ON invoices(tenant_id, number);
+
+-- At most one live invoice per subscription period; a voided one can be regenerated
+CREATE UNIQUE INDEX idx_invoices_subscription_id_period_start ON invoices(subscription_id, period_start) WHERE status <> 'void';
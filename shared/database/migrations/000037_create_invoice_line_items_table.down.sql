@@ -0,0 +1,4 @@
+-- Drop invoice_line_items table
+DROP INDEX IF EXISTS idx_invoice_line_items_subscription_discount_id;
+DROP INDEX IF EXISTS idx_invoice_line_items_invoice_id;
+DROP TABLE IF EXISTS invoice_line_items;
@@ -0,0 +1,18 @@
+-- Create invoice_line_items table
+CREATE TABLE IF NOT EXISTS invoice_line_items (
+    id BIGSERIAL PRIMARY KEY,
+    invoice_id BIGINT NOT NULL,
+    type VARCHAR(255) NOT NULL,
+    description VARCHAR(255) NOT NULL,
+    quantity BIGINT NOT NULL DEFAULT 1,
+    unit_amount BIGINT NOT NULL DEFAULT 0,
+    amount BIGINT NOT NULL,
+    subscription_discount_id BIGINT NULL,
+    created_at TIMESTAMP(0) NULL,
+    updated_at TIMESTAMP(0) NULL,
+    CONSTRAINT invoice_line_items_invoice_id_foreign FOREIGN KEY (invoice_id) REFERENCES invoices(id) ON DELETE CASCADE,
+    CONSTRAINT invoice_line_items_subscription_discount_id_foreign FOREIGN KEY (subscription_discount_id) REFERENCES subscription_discounts(id) ON DELETE SET NULL
+);
+
+CREATE INDEX idx_invoice_line_items_invoice_id ON invoice_line_items(invoice_id);
+CREATE INDEX idx_invoice_line_items_subscription_discount_id ON invoice_line_items(subscription_discount_id);
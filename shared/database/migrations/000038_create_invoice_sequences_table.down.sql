@@ -0,0 +1,2 @@
+-- Drop invoice_sequences table
+DROP TABLE IF EXISTS invoice_sequences;
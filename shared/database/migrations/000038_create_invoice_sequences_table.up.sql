@@ -0,0 +1,8 @@
+-- Create invoice_sequences table holding the last invoice number per tenant
+CREATE TABLE IF NOT EXISTS invoice_sequences (
+    tenant_id BIGINT PRIMARY KEY,
+    last_sequence BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP(0) NULL,
+    updated_at TIMESTAMP(0) NULL,
+    CONSTRAINT invoice_sequences_tenant_id_foreign FOREIGN KEY (tenant_id) REFERENCES tenants(id) ON DELETE CASCADE
+);
@@ -0,0 +1,2 @@
+-- Remove the fake payment provider
+DELETE FROM payment_providers WHERE slug = 'fake';
@@ -0,0 +1,5 @@
+-- Add the in-process fake payment provider used for offline development.
+-- billing-service only registers it when PAYMENT_FAKE_PROVIDER_ENABLED is set.
+INSERT INTO payment_providers (id, name, slug, is_active, created_at, updated_at) VALUES
+(5, 'Fake', 'fake', TRUE, NOW(), NOW())
+ON CONFLICT (id) DO NOTHING;
@@ -0,0 +1,5 @@
+-- Revert invoice payment columns
+DROP INDEX IF EXISTS idx_invoices_payment_provider_charge_id;
+ALTER TABLE invoices DROP CONSTRAINT IF EXISTS invoices_payment_provider_id_foreign;
+ALTER TABLE invoices DROP COLUMN IF EXISTS payment_provider_charge_id;
+ALTER TABLE invoices DROP COLUMN IF EXISTS payment_provider_id;
@@ -0,0 +1,11 @@
+-- Add columns linking an invoice to the provider charge that pays it
+ALTER TABLE invoices ADD COLUMN IF NOT EXISTS payment_provider_id BIGINT NULL;
+ALTER TABLE invoices ADD COLUMN IF NOT EXISTS payment_provider_charge_id VARCHAR(255) NULL;
+
+ALTER TABLE invoices ADD CONSTRAINT invoices_payment_provider_id_foreign
+    FOREIGN KEY (payment_provider_id)
+    REFERENCES payment_providers(id)
+    ON DELETE SET NULL
+    ON UPDATE CASCADE;
+
+CREATE INDEX idx_invoices_payment_provider_charge_id ON invoices(payment_provider_charge_id);
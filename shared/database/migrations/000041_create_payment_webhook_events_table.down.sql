@@ -0,0 +1,4 @@
+-- Drop payment_webhook_events table
+DROP INDEX IF EXISTS idx_payment_webhook_events_status;
+DROP INDEX IF EXISTS idx_payment_webhook_events_provider_event;
+DROP TABLE IF EXISTS payment_webhook_events;
@@ -0,0 +1,19 @@
+-- Create payment_webhook_events table
+CREATE TABLE IF NOT EXISTS payment_webhook_events (
+    id BIGSERIAL PRIMARY KEY,
+    payment_provider_id BIGINT NOT NULL,
+    event_id VARCHAR(255) NOT NULL,
+    type VARCHAR(255) NOT NULL,
+    status VARCHAR(255) NOT NULL DEFAULT 'received',
+    payload JSON NOT NULL,
+    error TEXT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    processed_at TIMESTAMP(0) NULL,
+    created_at TIMESTAMP(0) NULL,
+    updated_at TIMESTAMP(0) NULL,
+    CONSTRAINT payment_webhook_events_payment_provider_id_foreign FOREIGN KEY (payment_provider_id) REFERENCES payment_providers(id)
+);
+
+-- Providers retry deliveries, so each event is stored once per provider
+CREATE UNIQUE INDEX idx_payment_webhook_events_provider_event ON payment_webhook_events(payment_provider_id, event_id);
+CREATE INDEX idx_payment_webhook_events_status ON payment_webhook_events(status);
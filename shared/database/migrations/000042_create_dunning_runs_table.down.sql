@@ -0,0 +1,6 @@
+-- Drop dunning_runs table
+DROP INDEX IF EXISTS idx_dunning_runs_invoice_id_active;
+DROP INDEX IF EXISTS idx_dunning_runs_status_next_attempt_at;
+DROP INDEX IF EXISTS idx_dunning_runs_tenant_id;
+DROP INDEX IF EXISTS idx_dunning_runs_subscription_id;
+DROP TABLE IF EXISTS dunning_runs;
@@ -0,0 +1,27 @@
+-- Create dunning_runs table
+CREATE TABLE IF NOT EXISTS dunning_runs (
+    id BIGSERIAL PRIMARY KEY,
+    invoice_id BIGINT NOT NULL,
+    subscription_id BIGINT NULL,
+    tenant_id BIGINT NOT NULL,
+    status VARCHAR(255) NOT NULL DEFAULT 'active',
+    retry_days JSON NOT NULL,
+    attempt_count INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP(0) NULL,
+    last_attempt_at TIMESTAMP(0) NULL,
+    last_failure_message TEXT NULL,
+    started_at TIMESTAMP(0) NOT NULL,
+    closed_at TIMESTAMP(0) NULL,
+    created_at TIMESTAMP(0) NULL,
+    updated_at TIMESTAMP(0) NULL,
+    CONSTRAINT dunning_runs_invoice_id_foreign FOREIGN KEY (invoice_id) REFERENCES invoices(id) ON DELETE CASCADE,
+    CONSTRAINT dunning_runs_subscription_id_foreign FOREIGN KEY (subscription_id) REFERENCES subscriptions(id) ON DELETE SET NULL,
+    CONSTRAINT dunning_runs_tenant_id_foreign FOREIGN KEY (tenant_id) REFERENCES tenants(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_dunning_runs_subscription_id ON dunning_runs(subscription_id);
+CREATE INDEX idx_dunning_runs_tenant_id ON dunning_runs(tenant_id);
+CREATE INDEX idx_dunning_runs_status_next_attempt_at ON dunning_runs(status, next_attempt_at);
+
+-- An invoice is dunned by at most one run at a time
+CREATE UNIQUE INDEX idx_dunning_runs_invoice_id_active ON dunning_runs(invoice_id) WHERE status = 'active';
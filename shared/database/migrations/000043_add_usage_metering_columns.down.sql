@@ -0,0 +1,8 @@
+-- Revert usage metering columns
+DROP INDEX IF EXISTS idx_subscription_usages_subscription_recorded_at;
+DROP INDEX IF EXISTS idx_subscription_usages_subscription_idempotency_key;
+ALTER TABLE subscription_usages DROP CONSTRAINT IF EXISTS subscription_usages_plan_meter_id_foreign;
+ALTER TABLE subscription_usages DROP COLUMN IF EXISTS recorded_at;
+ALTER TABLE subscription_usages DROP COLUMN IF EXISTS idempotency_key;
+ALTER TABLE subscription_usages DROP COLUMN IF EXISTS plan_meter_id;
+ALTER TABLE plan_meters DROP COLUMN IF EXISTS aggregation;
@@ -0,0 +1,27 @@
+-- Add how each meter rolls its usage up over a billing period (sum, max, last)
+ALTER TABLE plan_meters ADD COLUMN IF NOT EXISTS aggregation VARCHAR(255) NOT NULL DEFAULT 'sum';
+
+-- Add metering columns to subscription usages
+ALTER TABLE subscription_usages ADD COLUMN IF NOT EXISTS plan_meter_id BIGINT NULL;
+ALTER TABLE subscription_usages ADD COLUMN IF NOT EXISTS idempotency_key VARCHAR(255) NULL;
+ALTER TABLE subscription_usages ADD COLUMN IF NOT EXISTS recorded_at TIMESTAMP(0) NULL;
+
+ALTER TABLE subscription_usages ADD CONSTRAINT subscription_usages_plan_meter_id_foreign
+    FOREIGN KEY (plan_meter_id)
+    REFERENCES plan_meters(id)
+    ON DELETE SET NULL
+    ON UPDATE CASCADE;
+
+-- Existing rows were recorded at insert time against the current plan's meter
+UPDATE subscription_usages SET recorded_at = created_at WHERE recorded_at IS NULL;
+
+UPDATE subscription_usages u
+SET plan_meter_id = p.meter_id
+FROM subscriptions s
+JOIN plans p ON p.id = s.plan_id
+WHERE s.id = u.subscription_id AND u.plan_meter_id IS NULL;
+
+CREATE UNIQUE INDEX idx_subscription_usages_subscription_idempotency_key
+    ON subscription_usages(subscription_id, idempotency_key)
+    WHERE idempotency_key IS NOT NULL;
+CREATE INDEX idx_subscription_usages_subscription_recorded_at ON subscription_usages(subscription_id, recorded_at);
@@ -0,0 +1,7 @@
+-- Revert discount_codes limit columns
+DROP INDEX IF EXISTS idx_discount_codes_is_active;
+ALTER TABLE discount_codes DROP COLUMN IF EXISTS is_active;
+ALTER TABLE discount_codes DROP COLUMN IF EXISTS times_used;
+ALTER TABLE discount_codes DROP COLUMN IF EXISTS max_uses;
+ALTER TABLE discount_codes DROP COLUMN IF EXISTS valid_until;
+ALTER TABLE discount_codes DROP COLUMN IF EXISTS valid_from;
@@ -0,0 +1,8 @@
+-- Add the validity window and usage limit columns read by product-service
+ALTER TABLE discount_codes ADD COLUMN IF NOT EXISTS valid_from TIMESTAMP(0) NULL;
+ALTER TABLE discount_codes ADD COLUMN IF NOT EXISTS valid_until TIMESTAMP(0) NULL;
+ALTER TABLE discount_codes ADD COLUMN IF NOT EXISTS max_uses INTEGER NULL;
+ALTER TABLE discount_codes ADD COLUMN IF NOT EXISTS times_used INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE discount_codes ADD COLUMN IF NOT EXISTS is_active BOOLEAN NOT NULL DEFAULT TRUE;
+
+CREATE INDEX idx_discount_codes_is_active ON discount_codes(is_active);
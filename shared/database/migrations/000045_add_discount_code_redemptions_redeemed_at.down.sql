@@ -0,0 +1,4 @@
+-- Revert discount_code_redemptions redeemed_at
+DROP INDEX IF EXISTS idx_discount_code_redemptions_code_order;
+DROP INDEX IF EXISTS idx_discount_code_redemptions_code_subscription;
+ALTER TABLE discount_code_redemptions DROP COLUMN IF EXISTS redeemed_at;
@@ -0,0 +1,12 @@
+-- Add redeemed_at, read by product-service, and make redemptions unique per
+-- subscription and per order so a retried checkout cannot redeem twice
+ALTER TABLE discount_code_redemptions ADD COLUMN IF NOT EXISTS redeemed_at TIMESTAMP(0) NULL;
+
+UPDATE discount_code_redemptions SET redeemed_at = created_at WHERE redeemed_at IS NULL;
+
+CREATE UNIQUE INDEX idx_discount_code_redemptions_code_subscription
+    ON discount_code_redemptions(discount_code_id, subscription_id)
+    WHERE subscription_id IS NOT NULL;
+CREATE UNIQUE INDEX idx_discount_code_redemptions_code_order
+    ON discount_code_redemptions(discount_code_id, order_id)
+    WHERE order_id IS NOT NULL;
@@ -0,0 +1,6 @@
+-- Drop referrals and referral codes
+DROP TABLE IF EXISTS referrals;
+
+DROP INDEX IF EXISTS idx_discount_codes_referrer_discount;
+ALTER TABLE discount_codes DROP CONSTRAINT IF EXISTS discount_codes_referrer_user_id_foreign;
+ALTER TABLE discount_codes DROP COLUMN IF EXISTS referrer_user_id;
@@ -0,0 +1,47 @@
+-- Personal referral codes are discount codes owned by a referrer
+ALTER TABLE discount_codes ADD COLUMN IF NOT EXISTS referrer_user_id BIGINT NULL;
+
+ALTER TABLE discount_codes
+    ADD CONSTRAINT discount_codes_referrer_user_id_foreign
+        FOREIGN KEY (referrer_user_id)
+        REFERENCES users(id)
+        ON DELETE CASCADE;
+
+CREATE UNIQUE INDEX idx_discount_codes_referrer_discount
+    ON discount_codes(referrer_user_id, discount_id)
+    WHERE referrer_user_id IS NOT NULL;
+
+-- One row per referred user, created when they redeem a referral code
+CREATE TABLE IF NOT EXISTS referrals (
+    id BIGSERIAL PRIMARY KEY,
+    discount_code_id BIGINT NOT NULL,
+    discount_code_redemption_id BIGINT NOT NULL,
+    referrer_user_id BIGINT NOT NULL,
+    referred_user_id BIGINT NOT NULL,
+    subscription_id BIGINT NULL,
+    status VARCHAR(32) NOT NULL DEFAULT 'pending', -- pending, converted
+    reward_bonus_days INTEGER NOT NULL DEFAULT 0,
+    converted_at TIMESTAMP(0) NULL,
+    created_at TIMESTAMP(0) NULL,
+    updated_at TIMESTAMP(0) NULL,
+    CONSTRAINT referrals_discount_code_id_foreign
+        FOREIGN KEY (discount_code_id)
+        REFERENCES discount_codes(id)
+        ON DELETE CASCADE,
+    CONSTRAINT referrals_discount_code_redemption_id_foreign
+        FOREIGN KEY (discount_code_redemption_id)
+        REFERENCES discount_code_redemptions(id)
+        ON DELETE CASCADE,
+    CONSTRAINT referrals_referrer_user_id_foreign
+        FOREIGN KEY (referrer_user_id)
+        REFERENCES users(id)
+        ON DELETE CASCADE,
+    CONSTRAINT referrals_referred_user_id_foreign
+        FOREIGN KEY (referred_user_id)
+        REFERENCES users(id)
+        ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX idx_referrals_referred_user_id ON referrals(referred_user_id);
+CREATE INDEX idx_referrals_referrer_user_id ON referrals(referrer_user_id);
+CREATE INDEX idx_referrals_subscription_id ON referrals(subscription_id);
@@ -0,0 +1,6 @@
+-- Drop orders, order items and product prices
+ALTER TABLE discount_code_redemptions DROP CONSTRAINT IF EXISTS discount_code_redemptions_order_id_foreign;
+
+DROP TABLE IF EXISTS order_items;
+DROP TABLE IF EXISTS orders;
+DROP TABLE IF EXISTS product_prices;
@@ -0,0 +1,75 @@
+-- One-time purchase price of a product, per currency
+CREATE TABLE IF NOT EXISTS product_prices (
+    id BIGSERIAL PRIMARY KEY,
+    product_id BIGINT NOT NULL,
+    currency_id BIGINT NOT NULL,
+    price BIGINT NOT NULL,
+    created_at TIMESTAMP(0) NULL,
+    updated_at TIMESTAMP(0) NULL,
+    CONSTRAINT product_prices_product_id_foreign FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE ON UPDATE CASCADE,
+    CONSTRAINT product_prices_currency_id_foreign FOREIGN KEY (currency_id) REFERENCES currencies(id) ON DELETE CASCADE ON UPDATE CASCADE,
+    CONSTRAINT product_prices_product_currency_unique UNIQUE (product_id, currency_id)
+);
+
+CREATE INDEX idx_product_prices_product_id ON product_prices(product_id);
+
+-- Orders are one-time purchases of products, paid through a checkout session
+CREATE TABLE IF NOT EXISTS orders (
+    id BIGSERIAL PRIMARY KEY,
+    uuid VARCHAR(255) NOT NULL UNIQUE,
+    user_id BIGINT NOT NULL,
+    tenant_id BIGINT NULL,
+    currency_id BIGINT NOT NULL,
+    status VARCHAR(32) NOT NULL DEFAULT 'pending', -- pending, paid, cancelled, refunded
+    subtotal BIGINT NOT NULL DEFAULT 0,
+    discount_total BIGINT NOT NULL DEFAULT 0,
+    total BIGINT NOT NULL DEFAULT 0,
+    discount_code_id BIGINT NULL,
+    payment_provider_id BIGINT NULL,
+    payment_provider_checkout_id VARCHAR(255) NULL,
+    checkout_url TEXT NULL,
+    paid_at TIMESTAMP(0) NULL,
+    cancelled_at TIMESTAMP(0) NULL,
+    refunded_at TIMESTAMP(0) NULL,
+    created_at TIMESTAMP(0) NULL,
+    updated_at TIMESTAMP(0) NULL,
+    CONSTRAINT orders_user_id_foreign FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT orders_tenant_id_foreign FOREIGN KEY (tenant_id) REFERENCES tenants(id) ON DELETE SET NULL,
+    CONSTRAINT orders_currency_id_foreign FOREIGN KEY (currency_id) REFERENCES currencies(id),
+    CONSTRAINT orders_discount_code_id_foreign FOREIGN KEY (discount_code_id) REFERENCES discount_codes(id) ON DELETE SET NULL,
+    CONSTRAINT orders_payment_provider_id_foreign FOREIGN KEY (payment_provider_id) REFERENCES payment_providers(id) ON DELETE SET NULL ON UPDATE CASCADE
+);
+
+CREATE INDEX idx_orders_user_id ON orders(user_id);
+CREATE INDEX idx_orders_tenant_id ON orders(tenant_id);
+CREATE INDEX idx_orders_status ON orders(status);
+CREATE UNIQUE INDEX idx_orders_payment_provider_checkout
+    ON orders(payment_provider_id, payment_provider_checkout_id)
+    WHERE payment_provider_checkout_id IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS order_items (
+    id BIGSERIAL PRIMARY KEY,
+    order_id BIGINT NOT NULL,
+    product_id BIGINT NULL,
+    description VARCHAR(255) NOT NULL,
+    quantity BIGINT NOT NULL,
+    unit_amount BIGINT NOT NULL,
+    discount_amount BIGINT NOT NULL DEFAULT 0,
+    amount BIGINT NOT NULL, -- quantity * unit_amount - discount_amount
+    created_at TIMESTAMP(0) NULL,
+    updated_at TIMESTAMP(0) NULL,
+    CONSTRAINT order_items_order_id_foreign FOREIGN KEY (order_id) REFERENCES orders(id) ON DELETE CASCADE,
+    CONSTRAINT order_items_product_id_foreign FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE SET NULL
+);
+
+CREATE INDEX idx_order_items_order_id ON order_items(order_id);
+CREATE INDEX idx_order_items_product_id ON order_items(product_id);
+
+-- Redemptions made for an order now point at it. Rows written before orders
+-- existed are left unchecked.
+ALTER TABLE discount_code_redemptions
+    ADD CONSTRAINT discount_code_redemptions_order_id_foreign
+        FOREIGN KEY (order_id)
+        REFERENCES orders(id)
+        ON DELETE CASCADE
+        NOT VALID;
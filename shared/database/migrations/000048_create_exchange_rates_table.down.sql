@@ -0,0 +1,11 @@
+-- Drop exchange rates and the currency rounding rules
+DROP INDEX IF EXISTS idx_plan_prices_derived_from_price_id;
+ALTER TABLE plan_prices DROP CONSTRAINT IF EXISTS plan_prices_derived_from_price_id_foreign;
+ALTER TABLE plan_prices DROP COLUMN IF EXISTS derived_from_price_id;
+
+DROP TABLE IF EXISTS exchange_rates;
+
+ALTER TABLE currencies
+    DROP COLUMN IF EXISTS rounding_mode,
+    DROP COLUMN IF EXISTS rounding_increment,
+    DROP COLUMN IF EXISTS decimal_places;
@@ -0,0 +1,33 @@
+-- Minor units and rounding rules used when converting prices into a currency
+ALTER TABLE currencies
+    ADD COLUMN IF NOT EXISTS decimal_places SMALLINT NOT NULL DEFAULT 2,
+    ADD COLUMN IF NOT EXISTS rounding_increment BIGINT NOT NULL DEFAULT 1,
+    ADD COLUMN IF NOT EXISTS rounding_mode VARCHAR(20) NOT NULL DEFAULT 'nearest'; -- nearest, up, down
+
+-- IDR has no minor units in practice
+UPDATE currencies SET decimal_places = 0 WHERE code = 'IDR';
+
+-- Exchange rates: 1 unit of base_currency = rate units of quote_currency.
+-- Rows are kept as history; the newest row effective at a given time wins.
+CREATE TABLE IF NOT EXISTS exchange_rates (
+    id BIGSERIAL PRIMARY KEY,
+    base_currency_id BIGINT NOT NULL,
+    quote_currency_id BIGINT NOT NULL,
+    rate NUMERIC(24, 10) NOT NULL,
+    source VARCHAR(20) NOT NULL DEFAULT 'manual', -- manual, import
+    effective_at TIMESTAMP(0) NOT NULL,
+    created_at TIMESTAMP(0) NULL,
+    updated_at TIMESTAMP(0) NULL,
+    CONSTRAINT exchange_rates_base_currency_id_foreign FOREIGN KEY (base_currency_id) REFERENCES currencies(id) ON DELETE CASCADE ON UPDATE CASCADE,
+    CONSTRAINT exchange_rates_quote_currency_id_foreign FOREIGN KEY (quote_currency_id) REFERENCES currencies(id) ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE INDEX idx_exchange_rates_pair ON exchange_rates(base_currency_id, quote_currency_id, effective_at DESC);
+
+-- Plan prices derived from a base currency price point at their source.
+-- A manual edit clears the link, and manual prices are never overwritten.
+ALTER TABLE plan_prices
+    ADD COLUMN IF NOT EXISTS derived_from_price_id BIGINT NULL,
+    ADD CONSTRAINT plan_prices_derived_from_price_id_foreign FOREIGN KEY (derived_from_price_id) REFERENCES plan_prices(id) ON DELETE SET NULL;
+
+CREATE INDEX idx_plan_prices_derived_from_price_id ON plan_prices(derived_from_price_id);
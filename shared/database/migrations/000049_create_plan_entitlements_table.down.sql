@@ -0,0 +1,2 @@
+-- Drop plan entitlements
+DROP TABLE IF EXISTS plan_entitlements;
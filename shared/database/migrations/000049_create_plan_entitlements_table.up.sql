@@ -0,0 +1,18 @@
+-- Typed plan entitlements: boolean features, numeric limits and metered
+-- quotas per billing period
+CREATE TABLE IF NOT EXISTS plan_entitlements (
+    id BIGSERIAL PRIMARY KEY,
+    plan_id BIGINT NOT NULL,
+    feature_key VARCHAR(100) NOT NULL,
+    type VARCHAR(20) NOT NULL, -- boolean, limit, quota
+    is_enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    limit_value BIGINT NULL, -- limit and quota; NULL means unlimited
+    meter_id BIGINT NULL, -- quota; NULL means the plan's meter
+    created_at TIMESTAMP(0) NULL,
+    updated_at TIMESTAMP(0) NULL,
+    CONSTRAINT plan_entitlements_plan_id_foreign FOREIGN KEY (plan_id) REFERENCES plans(id) ON DELETE CASCADE ON UPDATE CASCADE,
+    CONSTRAINT plan_entitlements_meter_id_foreign FOREIGN KEY (meter_id) REFERENCES plan_meters(id) ON DELETE SET NULL,
+    CONSTRAINT plan_entitlements_plan_feature_unique UNIQUE (plan_id, feature_key)
+);
+
+CREATE INDEX idx_plan_entitlements_feature_key ON plan_entitlements(feature_key);
@@ -0,0 +1,4 @@
+-- Pending members only exist through their invitation
+DELETE FROM tenant_user WHERE user_id IS NULL AND id IN (SELECT tenant_user_id FROM tenant_invitations);
+
+DROP TABLE IF EXISTS tenant_invitations;
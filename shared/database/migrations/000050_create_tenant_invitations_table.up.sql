@@ -0,0 +1,34 @@
+-- Email invitations to join a tenant. Each invitation holds a pending
+-- tenant_user row without a user_id until the invitee accepts.
+CREATE TABLE IF NOT EXISTS tenant_invitations (
+    id BIGSERIAL PRIMARY KEY,
+    uuid VARCHAR(255) NOT NULL UNIQUE,
+    tenant_id BIGINT NOT NULL,
+    tenant_user_id BIGINT NULL,
+    email VARCHAR(255) NOT NULL,
+    role VARCHAR(50) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending', -- pending, accepted, declined, revoked
+    token_hash VARCHAR(64) NOT NULL, -- SHA-256 of the latest token; resending replaces it
+    invited_by BIGINT NULL,
+    accepted_by BIGINT NULL, -- NULL after acceptance until the invitee registers
+    expires_at TIMESTAMP(0) NOT NULL,
+    sent_count INT NOT NULL DEFAULT 1,
+    last_sent_at TIMESTAMP(0) NOT NULL,
+    accepted_at TIMESTAMP(0) NULL,
+    declined_at TIMESTAMP(0) NULL,
+    revoked_at TIMESTAMP(0) NULL,
+    created_at TIMESTAMP(0) NULL,
+    updated_at TIMESTAMP(0) NULL,
+    CONSTRAINT tenant_invitations_tenant_id_foreign FOREIGN KEY (tenant_id) REFERENCES tenants(id) ON DELETE CASCADE,
+    CONSTRAINT tenant_invitations_tenant_user_id_foreign FOREIGN KEY (tenant_user_id) REFERENCES tenant_user(id) ON DELETE SET NULL,
+    CONSTRAINT tenant_invitations_invited_by_foreign FOREIGN KEY (invited_by) REFERENCES users(id) ON DELETE SET NULL,
+    CONSTRAINT tenant_invitations_accepted_by_foreign FOREIGN KEY (accepted_by) REFERENCES users(id) ON DELETE SET NULL
+);
+
+CREATE INDEX idx_tenant_invitations_tenant_id ON tenant_invitations(tenant_id);
+CREATE INDEX idx_tenant_invitations_email ON tenant_invitations(LOWER(email));
+
+-- One open invitation per email and tenant
+CREATE UNIQUE INDEX idx_tenant_invitations_pending_email
+    ON tenant_invitations(tenant_id, LOWER(email))
+    WHERE status = 'pending';
@@ -0,0 +1,5 @@
+-- Members with a custom role fall back to the member role
+UPDATE tenant_user SET role = 'member'
+WHERE role IN (SELECT name FROM tenant_roles WHERE tenant_roles.tenant_id = tenant_user.tenant_id);
+
+DROP TABLE IF EXISTS tenant_roles;
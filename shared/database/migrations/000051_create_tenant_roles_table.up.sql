@@ -0,0 +1,15 @@
+-- Custom tenant roles. The built-in roles (owner, admin, member, guest) are
+-- defined in code; tenant_user.role holds either a built-in or a custom name.
+CREATE TABLE IF NOT EXISTS tenant_roles (
+    id BIGSERIAL PRIMARY KEY,
+    tenant_id BIGINT NOT NULL,
+    name VARCHAR(50) NOT NULL,
+    description VARCHAR(255) NULL,
+    permissions JSONB NOT NULL DEFAULT '[]', -- permission keys, e.g. ["settings.read"]
+    created_at TIMESTAMP(0) NULL,
+    updated_at TIMESTAMP(0) NULL,
+    CONSTRAINT tenant_roles_tenant_id_foreign FOREIGN KEY (tenant_id) REFERENCES tenants(id) ON DELETE CASCADE,
+    CONSTRAINT tenant_roles_tenant_id_name_unique UNIQUE (tenant_id, name)
+);
+
+CREATE INDEX idx_tenant_roles_tenant_id ON tenant_roles(tenant_id);
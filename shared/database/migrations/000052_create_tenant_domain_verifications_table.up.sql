@@ -0,0 +1,17 @@
+-- DNS TXT verification of a tenant's custom domain. A verification only
+-- counts while its domain matches tenants.domain; changing the domain starts
+-- a new one.
+CREATE TABLE IF NOT EXISTS tenant_domain_verifications (
+    id BIGSERIAL PRIMARY KEY,
+    tenant_id BIGINT NOT NULL UNIQUE,
+    domain VARCHAR(255) NOT NULL,
+    token VARCHAR(64) NOT NULL,
+    verified_at TIMESTAMP(0) NULL,
+    last_checked_at TIMESTAMP(0) NULL,
+    last_error TEXT NULL,
+    created_at TIMESTAMP(0) NULL,
+    updated_at TIMESTAMP(0) NULL,
+    CONSTRAINT tenant_domain_verifications_tenant_id_foreign FOREIGN KEY (tenant_id) REFERENCES tenants(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_tenant_domain_verifications_domain ON tenant_domain_verifications(LOWER(domain));
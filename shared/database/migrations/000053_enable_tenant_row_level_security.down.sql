@@ -0,0 +1,13 @@
+DROP POLICY IF EXISTS subscriptions_tenant_isolation ON subscriptions;
+ALTER TABLE subscriptions NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE subscriptions DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS tenant_user_tenant_isolation ON tenant_user;
+ALTER TABLE tenant_user NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE tenant_user DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS tenant_settings_tenant_isolation ON tenant_settings;
+ALTER TABLE tenant_settings NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE tenant_settings DISABLE ROW LEVEL SECURITY;
+
+DROP FUNCTION IF EXISTS app_current_tenant_id();
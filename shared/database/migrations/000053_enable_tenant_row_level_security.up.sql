@@ -0,0 +1,23 @@
+-- Row-level security on tenant-scoped tables. A session that sets
+-- app.current_tenant (see database.TenantDB) only sees and writes rows of
+-- that tenant; without it every row is visible, so callers that work across
+-- tenants keep working. FORCE applies the policies to the table owner too.
+-- Superusers and BYPASSRLS roles are never restricted.
+CREATE OR REPLACE FUNCTION app_current_tenant_id() RETURNS BIGINT AS $$
+    SELECT NULLIF(current_setting('app.current_tenant', true), '')::BIGINT
+$$ LANGUAGE SQL STABLE;
+
+ALTER TABLE tenant_settings ENABLE ROW LEVEL SECURITY;
+ALTER TABLE tenant_settings FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_settings_tenant_isolation ON tenant_settings
+    USING (app_current_tenant_id() IS NULL OR tenant_id = app_current_tenant_id());
+
+ALTER TABLE tenant_user ENABLE ROW LEVEL SECURITY;
+ALTER TABLE tenant_user FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_user_tenant_isolation ON tenant_user
+    USING (app_current_tenant_id() IS NULL OR tenant_id = app_current_tenant_id());
+
+ALTER TABLE subscriptions ENABLE ROW LEVEL SECURITY;
+ALTER TABLE subscriptions FORCE ROW LEVEL SECURITY;
+CREATE POLICY subscriptions_tenant_isolation ON subscriptions
+    USING (app_current_tenant_id() IS NULL OR tenant_id = app_current_tenant_id());
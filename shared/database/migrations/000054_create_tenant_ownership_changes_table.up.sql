@@ -0,0 +1,15 @@
+-- Audit trail of changes to a tenant's owners. action is transferred,
+-- granted or revoked; from_user_id lost ownership and to_user_id gained it.
+-- User columns have no foreign key so the trail outlives deleted users.
+CREATE TABLE IF NOT EXISTS tenant_ownership_changes (
+    id BIGSERIAL PRIMARY KEY,
+    tenant_id BIGINT NOT NULL,
+    action VARCHAR(20) NOT NULL,
+    from_user_id BIGINT NULL,
+    to_user_id BIGINT NULL,
+    performed_by BIGINT NULL,
+    created_at TIMESTAMP(0) NULL,
+    CONSTRAINT tenant_ownership_changes_tenant_id_foreign FOREIGN KEY (tenant_id) REFERENCES tenants(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_tenant_ownership_changes_tenant_id ON tenant_ownership_changes(tenant_id, created_at);
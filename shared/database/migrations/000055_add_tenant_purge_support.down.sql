@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_tenants_deleted_at;
+
+ALTER TABLE subscriptions DROP CONSTRAINT IF EXISTS subscriptions_tenant_id_foreign;
+ALTER TABLE subscriptions ADD CONSTRAINT subscriptions_tenant_id_foreign
+    FOREIGN KEY (tenant_id) REFERENCES tenants(id);
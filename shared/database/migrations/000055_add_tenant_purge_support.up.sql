@@ -0,0 +1,8 @@
+-- Purging a soft-deleted tenant hard-deletes its row. Subscriptions go with
+-- it, like the tenant's other tables.
+ALTER TABLE subscriptions DROP CONSTRAINT IF EXISTS subscriptions_tenant_id_foreign;
+ALTER TABLE subscriptions ADD CONSTRAINT subscriptions_tenant_id_foreign
+    FOREIGN KEY (tenant_id) REFERENCES tenants(id) ON DELETE CASCADE;
+
+-- The purge worker looks up tenants deleted before the grace period
+CREATE INDEX idx_tenants_deleted_at ON tenants(deleted_at) WHERE deleted_at IS NOT NULL;
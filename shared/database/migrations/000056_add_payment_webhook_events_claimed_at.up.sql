@@ -0,0 +1,3 @@
+-- The processing lease of a webhook event starts at claimed_at. updated_at
+-- changes on every redelivery, so it cannot tell a crashed claim apart.
+ALTER TABLE payment_webhook_events ADD COLUMN IF NOT EXISTS claimed_at TIMESTAMP(0) NULL;
@@ -0,0 +1,13 @@
+-- Records of purged tenants cannot satisfy NOT NULL again
+DELETE FROM dunning_runs WHERE tenant_id IS NULL;
+DELETE FROM invoices WHERE tenant_id IS NULL;
+
+ALTER TABLE dunning_runs DROP CONSTRAINT IF EXISTS dunning_runs_tenant_id_foreign;
+ALTER TABLE dunning_runs ADD CONSTRAINT dunning_runs_tenant_id_foreign
+    FOREIGN KEY (tenant_id) REFERENCES tenants(id) ON DELETE CASCADE;
+ALTER TABLE dunning_runs ALTER COLUMN tenant_id SET NOT NULL;
+
+ALTER TABLE invoices DROP CONSTRAINT IF EXISTS invoices_tenant_id_foreign;
+ALTER TABLE invoices ADD CONSTRAINT invoices_tenant_id_foreign
+    FOREIGN KEY (tenant_id) REFERENCES tenants(id) ON DELETE CASCADE;
+ALTER TABLE invoices ALTER COLUMN tenant_id SET NOT NULL;
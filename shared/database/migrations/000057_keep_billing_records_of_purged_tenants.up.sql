@@ -0,0 +1,12 @@
+-- Invoices and dunning runs are financial records and outlive a purged
+-- tenant, like orders do: purging sets their tenant_id to NULL instead of
+-- deleting them.
+ALTER TABLE invoices ALTER COLUMN tenant_id DROP NOT NULL;
+ALTER TABLE invoices DROP CONSTRAINT IF EXISTS invoices_tenant_id_foreign;
+ALTER TABLE invoices ADD CONSTRAINT invoices_tenant_id_foreign
+    FOREIGN KEY (tenant_id) REFERENCES tenants(id) ON DELETE SET NULL;
+
+ALTER TABLE dunning_runs ALTER COLUMN tenant_id DROP NOT NULL;
+ALTER TABLE dunning_runs DROP CONSTRAINT IF EXISTS dunning_runs_tenant_id_foreign;
+ALTER TABLE dunning_runs ADD CONSTRAINT dunning_runs_tenant_id_foreign
+    FOREIGN KEY (tenant_id) REFERENCES tenants(id) ON DELETE SET NULL;
@@ -0,0 +1,2 @@
+-- Drop subscription_bonus_grants table
+DROP TABLE IF EXISTS subscription_bonus_grants;
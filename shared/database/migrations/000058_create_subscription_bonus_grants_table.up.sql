@@ -0,0 +1,13 @@
+-- One row per bonus days grant that has a key, such as "referral:<id>". The
+-- unique key keeps a redelivered event from extending a subscription twice.
+CREATE TABLE IF NOT EXISTS subscription_bonus_grants (
+    id BIGSERIAL PRIMARY KEY,
+    grant_key VARCHAR(255) NOT NULL UNIQUE,
+    subscription_id BIGINT NULL,
+    days INTEGER NOT NULL,
+    created_at TIMESTAMP(0) NULL,
+    CONSTRAINT subscription_bonus_grants_subscription_id_foreign
+        FOREIGN KEY (subscription_id)
+        REFERENCES subscriptions(id)
+        ON DELETE SET NULL
+);
@@ -0,0 +1,2 @@
+-- Drop billing_anchor from subscriptions
+ALTER TABLE subscriptions DROP COLUMN IF EXISTS billing_anchor;
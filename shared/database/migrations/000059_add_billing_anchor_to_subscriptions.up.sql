@@ -0,0 +1,7 @@
+-- Period ends are counted from billing_anchor instead of chained from the
+-- previous ends_at, so a subscription started on the 31st is not moved to the
+-- 28th for good after February. Existing rows are anchored on their current
+-- period end.
+ALTER TABLE subscriptions ADD COLUMN IF NOT EXISTS billing_anchor TIMESTAMP(0) NULL;
+
+UPDATE subscriptions SET billing_anchor = ends_at WHERE billing_anchor IS NULL;
@@ -0,0 +1,14 @@
+-- Drop the bypass and let sessions without a tenant see every row again
+DROP POLICY IF EXISTS subscriptions_tenant_isolation ON subscriptions;
+CREATE POLICY subscriptions_tenant_isolation ON subscriptions
+    USING (app_current_tenant_id() IS NULL OR tenant_id = app_current_tenant_id());
+
+DROP POLICY IF EXISTS tenant_user_tenant_isolation ON tenant_user;
+CREATE POLICY tenant_user_tenant_isolation ON tenant_user
+    USING (app_current_tenant_id() IS NULL OR tenant_id = app_current_tenant_id());
+
+DROP POLICY IF EXISTS tenant_settings_tenant_isolation ON tenant_settings;
+CREATE POLICY tenant_settings_tenant_isolation ON tenant_settings
+    USING (app_current_tenant_id() IS NULL OR tenant_id = app_current_tenant_id());
+
+DROP FUNCTION IF EXISTS app_rls_bypassed();
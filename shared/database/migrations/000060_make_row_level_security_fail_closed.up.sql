@@ -0,0 +1,21 @@
+-- Make row-level security fail closed. A session without app.current_tenant
+-- now sees no rows instead of every row. Work that spans tenants must say so
+-- by setting app.bypass_rls to 'on' (see database.TenantDB and
+-- tenancy.WithoutTenant); NewPostgresPool sets it on every connection when
+-- DB_RLS_ENABLED is off. Data migrations that touch these tables must set it
+-- too.
+CREATE OR REPLACE FUNCTION app_rls_bypassed() RETURNS BOOLEAN AS $$
+    SELECT COALESCE(current_setting('app.bypass_rls', true), '') = 'on'
+$$ LANGUAGE SQL STABLE;
+
+DROP POLICY IF EXISTS tenant_settings_tenant_isolation ON tenant_settings;
+CREATE POLICY tenant_settings_tenant_isolation ON tenant_settings
+    USING (app_rls_bypassed() OR tenant_id = app_current_tenant_id());
+
+DROP POLICY IF EXISTS tenant_user_tenant_isolation ON tenant_user;
+CREATE POLICY tenant_user_tenant_isolation ON tenant_user
+    USING (app_rls_bypassed() OR tenant_id = app_current_tenant_id());
+
+DROP POLICY IF EXISTS subscriptions_tenant_isolation ON subscriptions;
+CREATE POLICY subscriptions_tenant_isolation ON subscriptions
+    USING (app_rls_bypassed() OR tenant_id = app_current_tenant_id());
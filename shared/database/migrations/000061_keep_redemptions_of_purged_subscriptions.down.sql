@@ -0,0 +1,6 @@
+ALTER TABLE referrals DROP CONSTRAINT IF EXISTS referrals_subscription_id_foreign;
+
+-- Redemptions of deleted subscriptions cannot be tied to one again
+ALTER TABLE discount_code_redemptions DROP CONSTRAINT IF EXISTS discount_code_redemptions_subscription_id_foreign;
+ALTER TABLE discount_code_redemptions ADD CONSTRAINT discount_code_redemptions_subscription_id_foreign
+    FOREIGN KEY (subscription_id) REFERENCES subscriptions(id) ON DELETE CASCADE;
@@ -0,0 +1,16 @@
+-- Redemptions are the history that per-user and global redemption limits
+-- count, and referrals hang off them. Deleting a subscription, as purging
+-- its tenant does, now keeps them with subscription_id set to NULL instead
+-- of deleting them.
+ALTER TABLE discount_code_redemptions DROP CONSTRAINT IF EXISTS discount_code_redemptions_subscription_id_foreign;
+ALTER TABLE discount_code_redemptions ADD CONSTRAINT discount_code_redemptions_subscription_id_foreign
+    FOREIGN KEY (subscription_id) REFERENCES subscriptions(id) ON DELETE SET NULL;
+
+-- referrals.subscription_id had no foreign key and could point at a deleted
+-- subscription
+UPDATE referrals SET subscription_id = NULL
+WHERE subscription_id IS NOT NULL
+  AND NOT EXISTS (SELECT 1 FROM subscriptions WHERE subscriptions.id = referrals.subscription_id);
+
+ALTER TABLE referrals ADD CONSTRAINT referrals_subscription_id_foreign
+    FOREIGN KEY (subscription_id) REFERENCES subscriptions(id) ON DELETE SET NULL;
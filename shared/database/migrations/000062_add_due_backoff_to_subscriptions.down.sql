@@ -0,0 +1,3 @@
+-- Drop the scheduler backoff columns from subscriptions
+ALTER TABLE subscriptions DROP COLUMN IF EXISTS due_retry_at;
+ALTER TABLE subscriptions DROP COLUMN IF EXISTS due_failures;
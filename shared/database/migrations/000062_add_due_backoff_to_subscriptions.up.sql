@@ -0,0 +1,5 @@
+-- The scheduler backs off a subscription whose due work fails, so a row that
+-- keeps failing does not hold up the rows behind it. due_retry_at is cleared
+-- by the next successful save.
+ALTER TABLE subscriptions ADD COLUMN IF NOT EXISTS due_failures INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE subscriptions ADD COLUMN IF NOT EXISTS due_retry_at TIMESTAMP(0) NULL;
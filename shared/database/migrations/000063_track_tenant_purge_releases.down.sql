@@ -0,0 +1,3 @@
+-- Drop the purge release columns from tenants
+ALTER TABLE tenants DROP COLUMN IF EXISTS billing_released_at;
+ALTER TABLE tenants DROP COLUMN IF EXISTS subscriptions_released_at;
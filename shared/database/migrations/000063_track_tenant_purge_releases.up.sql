@@ -0,0 +1,5 @@
+-- A tenant past its grace period is purged only once subscription-service
+-- has cancelled its subscriptions and billing-service has closed its dunning
+-- runs. Each records here when it answered the purge request.
+ALTER TABLE tenants ADD COLUMN IF NOT EXISTS subscriptions_released_at TIMESTAMP(0) NULL;
+ALTER TABLE tenants ADD COLUMN IF NOT EXISTS billing_released_at TIMESTAMP(0) NULL;
@@ -0,0 +1,3 @@
+-- The original spelling of normalized domains is not kept, so there is
+-- nothing to undo
+SELECT 1;
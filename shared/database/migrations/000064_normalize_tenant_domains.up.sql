@@ -0,0 +1,16 @@
+-- Tenant domains are stored the way domain verification stores them:
+-- lowercased, without a port or a trailing dot. A domain whose normalized
+-- form is taken by another live tenant is left as it is.
+UPDATE tenants t
+SET domain = n.domain, updated_at = NOW()
+FROM (
+    SELECT id, regexp_replace(regexp_replace(LOWER(TRIM(domain)), ':[0-9]+$', ''), '\.$', '') AS domain
+    FROM tenants
+    WHERE domain IS NOT NULL
+) n
+WHERE t.id = n.id
+  AND t.domain <> n.domain
+  AND NOT EXISTS (
+      SELECT 1 FROM tenants o
+      WHERE o.id <> t.id AND o.domain = n.domain AND o.deleted_at IS NULL
+  );
@@ -5,12 +5,14 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 // NewPostgresPool creates a new PostgreSQL connection pool from environment variables
 // Required env vars: DB_HOST, DB_PORT, DB_USER, DB_PASSWORD, DB_NAME
-// Optional: DB_MAX_CONNS (default: 10), DB_MIN_CONNS (default: 2)
+// Optional: DB_MAX_CONNS (default: 10), DB_MIN_CONNS (default: 2),
+// DB_RLS_ENABLED (default: false, see TenantDB)
 func NewPostgresPool(ctx context.Context) (*pgxpool.Pool, error) {
 	dbHost := os.Getenv("DB_HOST")
 	if dbHost == "" {
@@ -56,6 +58,16 @@ func NewPostgresPool(ctx context.Context) (*pgxpool.Pool, error) {
 		}
 	}
 
+	// Without row-level security mode nothing sets the active tenant, so
+	// every connection bypasses the policies. With it, only
+	// database.TenantDB sessions get past them.
+	if !rlsEnabled() {
+		config.AfterConnect = func(ctx context.Context, conn *pgx.Conn) error {
+			_, err := conn.Exec(ctx, "SELECT set_config($1, 'on', false)", BypassSetting)
+			return err
+		}
+	}
+
 	pool, err := pgxpool.NewWithConfig(ctx, config)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create connection pool: %w", err)
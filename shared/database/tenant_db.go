@@ -0,0 +1,195 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/damarteplok/damar-admin-cms/shared/tenancy"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// CurrentTenantSetting is the Postgres setting the row-level security
+// policies on tenant-scoped tables compare tenant_id with
+const CurrentTenantSetting = "app.current_tenant"
+
+// BypassSetting is the Postgres setting that lets a session past the
+// row-level security policies when it is "on"
+const BypassSetting = "app.bypass_rls"
+
+// ErrNoTenant is returned for a tenant-scoped query whose context has
+// neither an active tenant nor tenancy.WithoutTenant
+var ErrNoTenant = errors.New("no active tenant for a tenant-scoped query")
+
+// Querier is the query API shared by *pgxpool.Pool, pgx.Tx and TenantDB
+type Querier interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// TenantDB runs queries under row-level security for the context's active
+// tenant (see tenancy.TenantID). When enabled, every statement and every
+// transaction from Begin runs in a transaction whose app.current_tenant is
+// that tenant. A context made with tenancy.WithoutTenant runs with
+// app.bypass_rls on instead, and any other context is refused with
+// ErrNoTenant, so a forgotten tenant fails closed. When disabled, queries go
+// straight to the pool, whose connections bypass the policies.
+type TenantDB struct {
+	pool    *pgxpool.Pool
+	enabled bool
+}
+
+// NewTenantDB wraps pool for tenant-scoped repositories.
+// Optional env var: DB_RLS_ENABLED (default: false)
+func NewTenantDB(pool *pgxpool.Pool) *TenantDB {
+	return &TenantDB{pool: pool, enabled: rlsEnabled()}
+}
+
+// rlsEnabled reports whether DB_RLS_ENABLED turns row-level security mode on
+func rlsEnabled() bool {
+	enabled, _ := strconv.ParseBool(os.Getenv("DB_RLS_ENABLED"))
+	return enabled
+}
+
+// Enabled reports whether row-level security mode is on
+func (db *TenantDB) Enabled() bool {
+	return db.enabled
+}
+
+// Begin starts a transaction scoped to the context's active tenant
+func (db *TenantDB) Begin(ctx context.Context) (pgx.Tx, error) {
+	if !db.enabled {
+		return db.pool.Begin(ctx)
+	}
+
+	setting, value := CurrentTenantSetting, strconv.FormatInt(tenancy.TenantID(ctx), 10)
+	switch {
+	case tenancy.AllTenants(ctx):
+		setting, value = BypassSetting, "on"
+	case tenancy.TenantID(ctx) == 0:
+		return nil, ErrNoTenant
+	}
+
+	tx, err := db.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := tx.Exec(ctx, "SELECT set_config($1, $2, true)", setting, value); err != nil {
+		tx.Rollback(ctx)
+		return nil, fmt.Errorf("failed to set %s: %w", setting, err)
+	}
+
+	return tx, nil
+}
+
+func (db *TenantDB) Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error) {
+	if !db.enabled {
+		return db.pool.Exec(ctx, sql, args...)
+	}
+
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		return pgconn.CommandTag{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := tx.Exec(ctx, sql, args...)
+	if err != nil {
+		return tag, err
+	}
+	return tag, tx.Commit(ctx)
+}
+
+// Query keeps its transaction open until the rows are read or closed
+func (db *TenantDB) Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error) {
+	if !db.enabled {
+		return db.pool.Query(ctx, sql, args...)
+	}
+
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.Query(ctx, sql, args...)
+	if err != nil {
+		tx.Rollback(ctx)
+		return nil, err
+	}
+	return &tenantRows{Rows: rows, ctx: ctx, tx: tx}, nil
+}
+
+func (db *TenantDB) QueryRow(ctx context.Context, sql string, args ...any) pgx.Row {
+	if !db.enabled {
+		return db.pool.QueryRow(ctx, sql, args...)
+	}
+	return &tenantRow{db: db, ctx: ctx, sql: sql, args: args}
+}
+
+// tenantRows commits its transaction once the rows are exhausted
+type tenantRows struct {
+	pgx.Rows
+	ctx  context.Context
+	tx   pgx.Tx
+	done bool
+	err  error
+}
+
+func (r *tenantRows) Next() bool {
+	if r.Rows.Next() {
+		return true
+	}
+	r.finish()
+	return false
+}
+
+func (r *tenantRows) Err() error {
+	if err := r.Rows.Err(); err != nil {
+		return err
+	}
+	return r.err
+}
+
+func (r *tenantRows) Close() {
+	r.finish()
+}
+
+func (r *tenantRows) finish() {
+	if r.done {
+		return
+	}
+	r.done = true
+
+	r.Rows.Close()
+	if r.Rows.Err() != nil {
+		r.tx.Rollback(r.ctx)
+		return
+	}
+	r.err = r.tx.Commit(r.ctx)
+}
+
+// tenantRow runs its query in a scoped transaction when scanned
+type tenantRow struct {
+	db   *TenantDB
+	ctx  context.Context
+	sql  string
+	args []any
+}
+
+func (r *tenantRow) Scan(dest ...any) error {
+	tx, err := r.db.Begin(r.ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(r.ctx)
+
+	if err := tx.QueryRow(r.ctx, r.sql, r.args...).Scan(dest...); err != nil {
+		return err
+	}
+	return tx.Commit(r.ctx)
+}
@@ -0,0 +1,83 @@
+package entitlement
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// Entitlement types stored in plan_entitlements.type. product-service
+// manages them and subscription-service checks them.
+const (
+	// TypeBoolean turns a feature on or off
+	TypeBoolean = "boolean"
+	// TypeLimit caps a count the caller keeps, such as projects
+	TypeLimit = "limit"
+	// TypeQuota caps the usage of a meter per billing period
+	TypeQuota = "quota"
+)
+
+// KeySeats is the built-in limit on tenant members. Unless a plan defines it
+// in plan_entitlements, the limit comes from plans.max_users_per_tenant, or
+// the subscription quantity for per-unit prices.
+const KeySeats = "seats"
+
+var keyPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_.-]{0,99}$`)
+
+// IsValidType reports whether t is a known entitlement type
+func IsValidType(t string) bool {
+	switch t {
+	case TypeBoolean, TypeLimit, TypeQuota:
+		return true
+	default:
+		return false
+	}
+}
+
+// ValidateKey checks a feature key: lowercase letters, digits, '_', '.' and
+// '-', up to 100 characters
+func ValidateKey(key string) error {
+	if !keyPattern.MatchString(key) {
+		return fmt.Errorf("invalid feature key %q: use lowercase letters, digits, '_', '.' or '-'", key)
+	}
+	return nil
+}
+
+// Result answers whether a feature may be used. Limit and Remaining are
+// only meaningful for limits and quotas that are not Unlimited.
+type Result struct {
+	Allowed   bool
+	Unlimited bool
+	Limit     int64
+	Used      int64
+	Remaining int64
+}
+
+// Evaluate applies an entitlement to the current usage. A nil limit is
+// unlimited. Limits and quotas allow use while used is below the limit.
+func Evaluate(entitlementType string, enabled bool, limit *int64, used int64) Result {
+	result := Result{Used: used}
+
+	switch entitlementType {
+	case TypeBoolean:
+		result.Allowed = enabled
+		return result
+	case TypeLimit, TypeQuota:
+		if !enabled {
+			return result
+		}
+		if limit == nil {
+			result.Allowed = true
+			result.Unlimited = true
+			return result
+		}
+		result.Limit = *limit
+		result.Remaining = *limit - used
+		if result.Remaining < 0 {
+			result.Remaining = 0
+		}
+		result.Allowed = used < *limit
+		return result
+	default:
+		return result
+	}
+}
@@ -0,0 +1,208 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// Payment methods that make FakeProvider charges fail, for exercising the
+// failed-payment paths offline
+const (
+	FakePaymentMethodDeclined = "pm_fake_declined"
+	FakePaymentMethodPending  = "pm_fake_pending"
+)
+
+// FakeProvider is an in-memory provider for development and tests. Charges
+// succeed unless they use one of the FakePaymentMethod* values, and checkout
+// sessions stay open until CompleteCheckout is called.
+type FakeProvider struct {
+	mu              sync.Mutex
+	nextID          int
+	baseURL         string
+	customers       map[string]*Customer
+	sessions        map[string]*CheckoutSession
+	charges         map[string]*Charge
+	chargesByKey    map[string]*Charge
+	refundedAmounts map[string]int64
+	synced          map[string]string
+}
+
+// NewFakeProvider creates a fake provider whose checkout URLs start with baseURL
+func NewFakeProvider(baseURL string) *FakeProvider {
+	return &FakeProvider{
+		baseURL:         baseURL,
+		customers:       make(map[string]*Customer),
+		sessions:        make(map[string]*CheckoutSession),
+		charges:         make(map[string]*Charge),
+		chargesByKey:    make(map[string]*Charge),
+		refundedAmounts: make(map[string]int64),
+		synced:          make(map[string]string),
+	}
+}
+
+func (p *FakeProvider) Slug() string {
+	return SlugFake
+}
+
+// newID must be called with p.mu held
+func (p *FakeProvider) newID(prefix string) string {
+	p.nextID++
+	return fmt.Sprintf("fake_%s_%d", prefix, p.nextID)
+}
+
+func (p *FakeProvider) CreateCustomer(ctx context.Context, req CustomerRequest) (*Customer, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	customer := &Customer{
+		ID:    p.newID("cus"),
+		Email: req.Email,
+		Name:  req.Name,
+	}
+	p.customers[customer.ID] = customer
+
+	result := *customer
+	return &result, nil
+}
+
+func (p *FakeProvider) CreateCheckoutSession(ctx context.Context, req CheckoutRequest) (*CheckoutSession, error) {
+	if len(req.LineItems) == 0 {
+		return nil, fmt.Errorf("checkout session needs at least one line item")
+	}
+
+	var amount int64
+	for _, item := range req.LineItems {
+		amount += item.UnitAmount * item.Quantity
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	session := &CheckoutSession{
+		ID:       p.newID("cs"),
+		Status:   StatusOpen,
+		Amount:   amount,
+		Currency: req.Currency,
+	}
+	session.URL = fmt.Sprintf("%s/fake-checkout/%s", p.baseURL, session.ID)
+	p.sessions[session.ID] = session
+
+	result := *session
+	return &result, nil
+}
+
+// CompleteCheckout simulates the customer paying a checkout session
+func (p *FakeProvider) CompleteCheckout(sessionID string) (*CheckoutSession, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	session, ok := p.sessions[sessionID]
+	if !ok {
+		return nil, ErrSessionNotFound
+	}
+	session.Status = StatusCompleted
+
+	result := *session
+	return &result, nil
+}
+
+func (p *FakeProvider) Charge(ctx context.Context, req ChargeRequest) (*Charge, error) {
+	if req.Amount <= 0 {
+		return nil, fmt.Errorf("charge amount must be greater than 0")
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if req.IdempotencyKey != "" {
+		if charge, ok := p.chargesByKey[req.IdempotencyKey]; ok {
+			result := *charge
+			return &result, nil
+		}
+	}
+
+	charge := &Charge{
+		ID:       p.newID("ch"),
+		Status:   StatusSucceeded,
+		Amount:   req.Amount,
+		Currency: req.Currency,
+	}
+	switch req.PaymentMethodID {
+	case FakePaymentMethodDeclined:
+		charge.Status = StatusFailed
+		charge.FailureMessage = ErrPaymentDeclined.Error()
+	case FakePaymentMethodPending:
+		charge.Status = StatusPending
+	}
+
+	p.charges[charge.ID] = charge
+	// Declined charges are not remembered so a retry can succeed
+	if req.IdempotencyKey != "" && charge.Status != StatusFailed {
+		p.chargesByKey[req.IdempotencyKey] = charge
+	}
+
+	result := *charge
+	return &result, nil
+}
+
+func (p *FakeProvider) Refund(ctx context.Context, req RefundRequest) (*Refund, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	charge, ok := p.charges[req.ChargeID]
+	if !ok {
+		return nil, ErrChargeNotFound
+	}
+	if charge.Status != StatusSucceeded {
+		return nil, fmt.Errorf("charge %s cannot be refunded in status %s", charge.ID, charge.Status)
+	}
+
+	refundable := charge.Amount - p.refundedAmounts[charge.ID]
+	amount := req.Amount
+	if amount == 0 {
+		amount = refundable
+	}
+	if amount <= 0 || amount > refundable {
+		return nil, ErrRefundTooLarge
+	}
+	p.refundedAmounts[charge.ID] += amount
+
+	return &Refund{
+		ID:       p.newID("re"),
+		ChargeID: charge.ID,
+		Amount:   amount,
+		Status:   StatusSucceeded,
+	}, nil
+}
+
+func (p *FakeProvider) SyncPlan(ctx context.Context, plan PlanData) (string, error) {
+	return p.sync("prod", plan.ID, plan.ExternalID), nil
+}
+
+func (p *FakeProvider) SyncPrice(ctx context.Context, price PriceData) (string, error) {
+	return p.sync("price", price.ID, price.ExternalID), nil
+}
+
+func (p *FakeProvider) SyncDiscount(ctx context.Context, discount DiscountData) (string, error) {
+	return p.sync("coupon", discount.ID, discount.ExternalID), nil
+}
+
+// sync returns the existing external ID of a local object, creating one on
+// first sync
+func (p *FakeProvider) sync(kind string, localID int64, externalID string) string {
+	if externalID != "" {
+		return externalID
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	key := fmt.Sprintf("%s:%d", kind, localID)
+	if id, ok := p.synced[key]; ok {
+		return id
+	}
+	id := p.newID(kind)
+	p.synced[key] = id
+	return id
+}
@@ -0,0 +1,77 @@
+package payment
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// FakeSignatureHeader carries the FakeProvider webhook signature
+const FakeSignatureHeader = "Fake-Signature"
+
+// FakeWebhookPayload is the body FakeProvider webhooks use. Type is one of
+// charge.succeeded, charge.failed, charge.cancelled, checkout.completed or
+// checkout.expired.
+type FakeWebhookPayload struct {
+	ID      string `json:"id"`
+	Type    string `json:"type"`
+	Created int64  `json:"created"`
+	Data    struct {
+		ChargeID           string `json:"charge_id"`
+		CheckoutSessionID  string `json:"checkout_session_id,omitempty"`
+		SubscriptionID     string `json:"subscription_id,omitempty"`
+		SubscriptionStatus string `json:"subscription_status,omitempty"`
+		Amount             int64  `json:"amount"`
+		Currency           string `json:"currency"`
+		FailureMessage     string `json:"failure_message,omitempty"`
+	} `json:"data"`
+}
+
+var fakeWebhookTypes = map[string]string{
+	"charge.succeeded":   WebhookPaymentSucceeded,
+	"charge.failed":      WebhookPaymentFailed,
+	"charge.cancelled":   WebhookPaymentCancelled,
+	"checkout.completed": WebhookPaymentSucceeded,
+	"checkout.expired":   WebhookPaymentCancelled,
+}
+
+func (p *FakeProvider) VerifyWebhook(payload []byte, header http.Header, secret string, tolerance time.Duration) error {
+	return VerifyHMAC(payload, header.Get(FakeSignatureHeader), secret, tolerance, time.Now())
+}
+
+func (p *FakeProvider) ParseWebhook(ctx context.Context, payload []byte) (*WebhookEvent, error) {
+	var body FakeWebhookPayload
+	if err := json.Unmarshal(payload, &body); err != nil {
+		return nil, fmt.Errorf("invalid webhook payload: %w", err)
+	}
+	if body.ID == "" {
+		return nil, fmt.Errorf("webhook payload has no event ID")
+	}
+
+	eventType, ok := fakeWebhookTypes[body.Type]
+	if !ok {
+		return nil, fmt.Errorf("unsupported webhook event type: %s", body.Type)
+	}
+
+	return &WebhookEvent{
+		ID:                 body.ID,
+		Type:               eventType,
+		ProviderType:       body.Type,
+		ChargeID:           body.Data.ChargeID,
+		CheckoutSessionID:  body.Data.CheckoutSessionID,
+		SubscriptionID:     body.Data.SubscriptionID,
+		SubscriptionStatus: body.Data.SubscriptionStatus,
+		Amount:             body.Data.Amount,
+		Currency:           body.Data.Currency,
+		FailureMessage:     body.Data.FailureMessage,
+		OccurredAt:         time.Unix(body.Created, 0),
+	}, nil
+}
+
+// SignWebhook returns the FakeSignatureHeader value for payload, for sending
+// fake webhooks by hand during development
+func (p *FakeProvider) SignWebhook(payload []byte, secret string) string {
+	return SignHMAC(payload, secret, time.Now())
+}
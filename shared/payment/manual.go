@@ -0,0 +1,82 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// ManualProvider covers payments collected outside the system, such as bank
+// transfers. Nothing is charged: charges and checkouts stay pending until an
+// admin marks the invoice as paid.
+type ManualProvider struct{}
+
+func NewManualProvider() *ManualProvider {
+	return &ManualProvider{}
+}
+
+func (p *ManualProvider) Slug() string {
+	return SlugManual
+}
+
+func (p *ManualProvider) CreateCustomer(ctx context.Context, req CustomerRequest) (*Customer, error) {
+	return &Customer{
+		ID:    "manual_cus_" + uuid.New().String(),
+		Email: req.Email,
+		Name:  req.Name,
+	}, nil
+}
+
+func (p *ManualProvider) CreateCheckoutSession(ctx context.Context, req CheckoutRequest) (*CheckoutSession, error) {
+	var amount int64
+	for _, item := range req.LineItems {
+		amount += item.UnitAmount * item.Quantity
+	}
+
+	return &CheckoutSession{
+		ID:       "manual_cs_" + uuid.New().String(),
+		Status:   StatusOpen,
+		Amount:   amount,
+		Currency: req.Currency,
+	}, nil
+}
+
+func (p *ManualProvider) Charge(ctx context.Context, req ChargeRequest) (*Charge, error) {
+	if req.Amount <= 0 {
+		return nil, fmt.Errorf("charge amount must be greater than 0")
+	}
+
+	id := "manual_ch_" + uuid.New().String()
+	if req.IdempotencyKey != "" {
+		id = "manual_ch_" + req.IdempotencyKey
+	}
+
+	return &Charge{
+		ID:       id,
+		Status:   StatusPending,
+		Amount:   req.Amount,
+		Currency: req.Currency,
+	}, nil
+}
+
+func (p *ManualProvider) Refund(ctx context.Context, req RefundRequest) (*Refund, error) {
+	return &Refund{
+		ID:       "manual_re_" + uuid.New().String(),
+		ChargeID: req.ChargeID,
+		Amount:   req.Amount,
+		Status:   StatusPending,
+	}, nil
+}
+
+func (p *ManualProvider) SyncPlan(ctx context.Context, plan PlanData) (string, error) {
+	return fmt.Sprintf("manual_plan_%d", plan.ID), nil
+}
+
+func (p *ManualProvider) SyncPrice(ctx context.Context, price PriceData) (string, error) {
+	return fmt.Sprintf("manual_price_%d", price.ID), nil
+}
+
+func (p *ManualProvider) SyncDiscount(ctx context.Context, discount DiscountData) (string, error) {
+	return fmt.Sprintf("manual_discount_%d", discount.ID), nil
+}
@@ -0,0 +1,155 @@
+package payment
+
+import (
+	"context"
+	"errors"
+)
+
+// Provider slugs, matching payment_providers.slug
+const (
+	SlugStripe = "stripe"
+	SlugPaddle = "paddle"
+	SlugPayPal = "paypal"
+	SlugManual = "manual"
+	SlugFake   = "fake"
+)
+
+// Charge, checkout and refund statuses
+const (
+	StatusSucceeded = "succeeded"
+	StatusPending   = "pending"
+	StatusFailed    = "failed"
+	StatusOpen      = "open"
+	StatusCompleted = "completed"
+	StatusExpired   = "expired"
+)
+
+// Checkout modes
+const (
+	CheckoutModePayment      = "payment"
+	CheckoutModeSubscription = "subscription"
+)
+
+var (
+	ErrProviderNotFound = errors.New("payment provider not found")
+	ErrPaymentDeclined  = errors.New("payment declined")
+	ErrChargeNotFound   = errors.New("charge not found")
+	ErrSessionNotFound  = errors.New("checkout session not found")
+	ErrRefundTooLarge   = errors.New("refund exceeds the refundable amount")
+)
+
+// PaymentProvider is implemented once per payment provider. Amounts are in
+// the currency's minor unit and currencies are ISO 4217 codes.
+type PaymentProvider interface {
+	Slug() string
+	CreateCustomer(ctx context.Context, req CustomerRequest) (*Customer, error)
+	CreateCheckoutSession(ctx context.Context, req CheckoutRequest) (*CheckoutSession, error)
+	Charge(ctx context.Context, req ChargeRequest) (*Charge, error)
+	Refund(ctx context.Context, req RefundRequest) (*Refund, error)
+	// Sync* create or update the provider-side object and return its ID, to
+	// be stored in the matching *_payment_provider_data table
+	SyncPlan(ctx context.Context, plan PlanData) (string, error)
+	SyncPrice(ctx context.Context, price PriceData) (string, error)
+	SyncDiscount(ctx context.Context, discount DiscountData) (string, error)
+}
+
+type CustomerRequest struct {
+	Email    string
+	Name     string
+	TenantID int64
+	UserID   int64
+	Metadata map[string]string
+}
+
+type Customer struct {
+	ID    string
+	Email string
+	Name  string
+}
+
+type CheckoutLineItem struct {
+	Description     string
+	Quantity        int64
+	UnitAmount      int64
+	ExternalPriceID string // provider price ID when the item is a synced plan price
+}
+
+type CheckoutRequest struct {
+	CustomerID         string
+	Currency           string
+	Mode               string // payment, subscription
+	LineItems          []CheckoutLineItem
+	ExternalDiscountID string
+	SuccessURL         string
+	CancelURL          string
+	Metadata           map[string]string
+}
+
+type CheckoutSession struct {
+	ID       string
+	URL      string // empty when the customer pays outside the provider
+	Status   string // open, completed, expired
+	Amount   int64
+	Currency string
+}
+
+type ChargeRequest struct {
+	CustomerID      string
+	Amount          int64
+	Currency        string
+	Description     string
+	PaymentMethodID string
+	// IdempotencyKey makes retries of the same charge return the first result
+	IdempotencyKey string
+	Metadata       map[string]string
+}
+
+type Charge struct {
+	ID             string
+	Status         string // succeeded, pending, failed
+	Amount         int64
+	Currency       string
+	FailureMessage string
+}
+
+type RefundRequest struct {
+	ChargeID string
+	Amount   int64 // zero refunds the remaining amount
+	Reason   string
+}
+
+type Refund struct {
+	ID       string
+	ChargeID string
+	Amount   int64
+	Status   string // succeeded, pending, failed
+}
+
+type PlanData struct {
+	ID          int64
+	Name        string
+	Slug        string
+	Description string
+	ExternalID  string // set when the plan was synced before
+}
+
+type PriceData struct {
+	ID             int64
+	PlanID         int64
+	ExternalPlanID string
+	Currency       string
+	Amount         int64
+	Type           string // flat_rate, per_unit, tiered
+	IntervalSlug   string
+	IntervalCount  int32
+	ExternalID     string
+}
+
+type DiscountData struct {
+	ID         int64
+	Name       string
+	Type       string // percentage, fixed
+	Amount     float64
+	Currency   string // for fixed discounts
+	ExternalID string
+}
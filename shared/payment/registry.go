@@ -0,0 +1,52 @@
+package payment
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// Registry looks up payment providers by slug
+type Registry struct {
+	mu        sync.RWMutex
+	providers map[string]PaymentProvider
+}
+
+func NewRegistry(providers ...PaymentProvider) *Registry {
+	r := &Registry{providers: make(map[string]PaymentProvider)}
+	for _, provider := range providers {
+		r.Register(provider)
+	}
+	return r
+}
+
+// Register adds provider, replacing any provider with the same slug
+func (r *Registry) Register(provider PaymentProvider) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.providers[provider.Slug()] = provider
+}
+
+func (r *Registry) Get(slug string) (PaymentProvider, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	provider, ok := r.providers[slug]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrProviderNotFound, slug)
+	}
+	return provider, nil
+}
+
+// Slugs returns the registered slugs in alphabetical order
+func (r *Registry) Slugs() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	slugs := make([]string, 0, len(r.providers))
+	for slug := range r.providers {
+		slugs = append(slugs, slug)
+	}
+	sort.Strings(slugs)
+	return slugs
+}
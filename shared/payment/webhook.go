@@ -0,0 +1,116 @@
+package payment
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Normalized webhook event types
+const (
+	WebhookPaymentSucceeded = "payment.succeeded"
+	WebhookPaymentFailed    = "payment.failed"
+	WebhookPaymentCancelled = "payment.cancelled"
+)
+
+// DefaultWebhookTolerance is how old a signed webhook may be before it is
+// rejected as a replay
+const DefaultWebhookTolerance = 5 * time.Minute
+
+var (
+	ErrWebhookNotSupported = errors.New("payment provider does not support webhooks")
+	ErrInvalidSignature    = errors.New("invalid webhook signature")
+	ErrWebhookExpired      = errors.New("webhook timestamp outside the tolerance")
+)
+
+// WebhookProvider is implemented by providers that notify us over webhooks
+type WebhookProvider interface {
+	// VerifyWebhook checks the request signature against the provider's
+	// signing secret and rejects signatures older than tolerance
+	VerifyWebhook(payload []byte, header http.Header, secret string, tolerance time.Duration) error
+	// ParseWebhook translates a verified payload into a WebhookEvent
+	ParseWebhook(ctx context.Context, payload []byte) (*WebhookEvent, error)
+}
+
+// WebhookEvent is a provider notification reduced to what billing acts on
+type WebhookEvent struct {
+	ID                 string // provider event ID, unique per provider
+	Type               string // payment.succeeded, payment.failed, payment.cancelled
+	ProviderType       string // event type as the provider names it
+	ChargeID           string
+	CheckoutSessionID  string // set when the event settles a checkout session
+	SubscriptionID     string // provider subscription ID
+	SubscriptionStatus string // provider subscription status, when reported
+	Amount             int64
+	Currency           string
+	FailureMessage     string
+	OccurredAt         time.Time
+}
+
+// SignHMAC builds a "t=<unix>,v1=<hex>" signature header value over
+// "<unix>.<payload>", the scheme VerifyHMAC checks
+func SignHMAC(payload []byte, secret string, timestamp time.Time) string {
+	t := strconv.FormatInt(timestamp.Unix(), 10)
+	return "t=" + t + ",v1=" + computeHMAC(t, payload, secret)
+}
+
+// VerifyHMAC checks a "t=<unix>,v1=<hex>" signature header. Several v1
+// entries are accepted so providers can sign with old and new secrets while
+// rotating.
+func VerifyHMAC(payload []byte, signature, secret string, tolerance time.Duration, now time.Time) error {
+	if secret == "" {
+		return fmt.Errorf("%w: no signing secret configured", ErrInvalidSignature)
+	}
+
+	var timestamp string
+	var signatures []string
+	for _, part := range strings.Split(signature, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			continue
+		}
+		switch key {
+		case "t":
+			timestamp = value
+		case "v1":
+			signatures = append(signatures, value)
+		}
+	}
+	if timestamp == "" || len(signatures) == 0 {
+		return ErrInvalidSignature
+	}
+
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+	if tolerance > 0 {
+		age := now.Sub(time.Unix(unix, 0))
+		if age > tolerance || age < -tolerance {
+			return ErrWebhookExpired
+		}
+	}
+
+	expected := computeHMAC(timestamp, payload, secret)
+	for _, candidate := range signatures {
+		if hmac.Equal([]byte(candidate), []byte(expected)) {
+			return nil
+		}
+	}
+	return ErrInvalidSignature
+}
+
+func computeHMAC(timestamp string, payload []byte, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
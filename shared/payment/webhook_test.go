@@ -0,0 +1,56 @@
+package payment
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestVerifyHMAC(t *testing.T) {
+	payload := []byte(`{"id":"evt_1","type":"payment.succeeded"}`)
+	secret := "whsec_test"
+	now := time.Unix(1_700_000_000, 0)
+	signed := SignHMAC(payload, secret, now)
+	t1 := "t=" + strconv.FormatInt(now.Unix(), 10)
+
+	tests := []struct {
+		name      string
+		payload   []byte
+		signature string
+		secret    string
+		tolerance time.Duration
+		now       time.Time
+		wantErr   error
+	}{
+		{"valid", payload, signed, secret, DefaultWebhookTolerance, now, nil},
+		{"within tolerance", payload, signed, secret, DefaultWebhookTolerance, now.Add(4 * time.Minute), nil},
+		{"no tolerance accepts any age", payload, signed, secret, 0, now.Add(24 * time.Hour), nil},
+		{"rotated secret", payload, signed + ",v1=" + computeHMAC(strconv.FormatInt(now.Unix(), 10), payload, "whsec_old"), secret, DefaultWebhookTolerance, now, nil},
+		{"spaces between parts", payload, t1 + ", v1=" + computeHMAC(strconv.FormatInt(now.Unix(), 10), payload, secret), secret, DefaultWebhookTolerance, now, nil},
+		{"too old", payload, signed, secret, DefaultWebhookTolerance, now.Add(6 * time.Minute), ErrWebhookExpired},
+		{"from the future", payload, signed, secret, DefaultWebhookTolerance, now.Add(-6 * time.Minute), ErrWebhookExpired},
+		{"tampered payload", []byte(`{"id":"evt_2"}`), signed, secret, DefaultWebhookTolerance, now, ErrInvalidSignature},
+		{"wrong secret", payload, signed, "whsec_other", DefaultWebhookTolerance, now, ErrInvalidSignature},
+		{"no secret", payload, signed, "", DefaultWebhookTolerance, now, ErrInvalidSignature},
+		{"missing timestamp", payload, "v1=" + computeHMAC(strconv.FormatInt(now.Unix(), 10), payload, secret), secret, DefaultWebhookTolerance, now, ErrInvalidSignature},
+		{"missing signature", payload, t1, secret, DefaultWebhookTolerance, now, ErrInvalidSignature},
+		{"invalid timestamp", payload, "t=abc,v1=00", secret, DefaultWebhookTolerance, now, ErrInvalidSignature},
+		{"empty header", payload, "", secret, DefaultWebhookTolerance, now, ErrInvalidSignature},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := VerifyHMAC(tt.payload, tt.signature, tt.secret, tt.tolerance, tt.now)
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Errorf("VerifyHMAC() error = %v, want nil", err)
+				}
+				return
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("VerifyHMAC() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
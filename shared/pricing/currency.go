@@ -0,0 +1,107 @@
+package pricing
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// Rounding modes stored in currencies.rounding_mode
+const (
+	RoundNearest = "nearest"
+	RoundUp      = "up"
+	RoundDown    = "down"
+)
+
+// maxDecimalPlaces bounds currencies.decimal_places; ISO 4217 uses at most 4
+const maxDecimalPlaces = 4
+
+// Rounding describes a currency's minor unit and how converted amounts are
+// rounded. DecimalPlaces is the number of minor-unit digits (2 for USD, 0
+// for IDR). Increment is the step, in minor units, that converted amounts
+// snap to, so an IDR increment of 1000 prices in whole thousands.
+type Rounding struct {
+	DecimalPlaces int32
+	Increment     int64
+	Mode          string
+}
+
+// Validate checks the decimal places, increment and mode
+func (r Rounding) Validate() error {
+	if r.DecimalPlaces < 0 || r.DecimalPlaces > maxDecimalPlaces {
+		return fmt.Errorf("decimal places must be between 0 and %d", maxDecimalPlaces)
+	}
+	if r.Increment < 1 {
+		return fmt.Errorf("rounding increment must be at least 1")
+	}
+	switch r.Mode {
+	case RoundNearest, RoundUp, RoundDown:
+		return nil
+	default:
+		return fmt.Errorf("unsupported rounding mode: %s", r.Mode)
+	}
+}
+
+// ParseRate parses a positive decimal exchange rate such as "15850.25"
+func ParseRate(s string) (*big.Rat, error) {
+	rate, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return nil, fmt.Errorf("invalid exchange rate: %q", s)
+	}
+	if rate.Sign() <= 0 {
+		return nil, fmt.Errorf("exchange rate must be positive")
+	}
+	return rate, nil
+}
+
+// Convert converts amount, in from's minor unit, to to's minor unit at rate
+// units of to per unit of from, then rounds it to to's increment. Zero stays
+// zero so free tiers remain free.
+func Convert(amount int64, from, to Rounding, rate *big.Rat) (int64, error) {
+	if amount == 0 {
+		return 0, nil
+	}
+	if amount < 0 {
+		return 0, fmt.Errorf("cannot convert a negative amount")
+	}
+	if err := to.Validate(); err != nil {
+		return 0, err
+	}
+
+	// amount / 10^from * rate * 10^to, in increments of to.Increment
+	value := new(big.Rat).SetInt64(amount)
+	value.Mul(value, rate)
+	value.Mul(value, new(big.Rat).SetFrac(pow10(to.DecimalPlaces), pow10(from.DecimalPlaces)))
+	value.Quo(value, new(big.Rat).SetInt64(to.Increment))
+
+	steps := roundRat(value, to.Mode)
+	steps.Mul(steps, big.NewInt(to.Increment))
+	if !steps.IsInt64() {
+		return 0, fmt.Errorf("converted amount is out of range")
+	}
+	return steps.Int64(), nil
+}
+
+// roundRat rounds a non-negative rational to an integer; nearest rounds
+// halves up
+func roundRat(value *big.Rat, mode string) *big.Int {
+	quo, rem := new(big.Int).QuoRem(value.Num(), value.Denom(), new(big.Int))
+	if rem.Sign() == 0 {
+		return quo
+	}
+
+	switch mode {
+	case RoundUp:
+		return quo.Add(quo, big.NewInt(1))
+	case RoundDown:
+		return quo
+	default:
+		if new(big.Int).Lsh(rem, 1).Cmp(value.Denom()) >= 0 {
+			quo.Add(quo, big.NewInt(1))
+		}
+		return quo
+	}
+}
+
+func pow10(n int32) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
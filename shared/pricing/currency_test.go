@@ -0,0 +1,119 @@
+package pricing
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestConvert(t *testing.T) {
+	usd := Rounding{DecimalPlaces: 2, Increment: 1, Mode: RoundNearest}
+	eur := Rounding{DecimalPlaces: 2, Increment: 1, Mode: RoundNearest}
+
+	tests := []struct {
+		name   string
+		amount int64
+		from   Rounding
+		to     Rounding
+		rate   string
+		want   int64
+	}{
+		{"same unit", 1000, usd, eur, "0.92", 920},
+		{"rounds to nearest", 999, usd, eur, "0.925", 924},
+		{"rounds half up", 2, usd, eur, "0.25", 1},
+		{"zero stays zero", 0, usd, eur, "0.92", 0},
+		{"to zero decimals", 1999, usd, Rounding{DecimalPlaces: 0, Increment: 1, Mode: RoundNearest}, "15850.25", 316846},
+		{"from zero decimals", 100000, Rounding{DecimalPlaces: 0, Increment: 1}, usd, "0.000063", 630},
+		{"increment nearest", 1999, usd, Rounding{DecimalPlaces: 0, Increment: 1000, Mode: RoundNearest}, "15850.25", 317000},
+		{"increment up", 1000, usd, Rounding{DecimalPlaces: 0, Increment: 1000, Mode: RoundUp}, "15850.25", 159000},
+		{"increment down", 1000, usd, Rounding{DecimalPlaces: 0, Increment: 1000, Mode: RoundDown}, "15850.25", 158000},
+		{"exact increment is not rounded up", 1000, usd, Rounding{DecimalPlaces: 0, Increment: 1000, Mode: RoundUp}, "15000", 150000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rate, err := ParseRate(tt.rate)
+			if err != nil {
+				t.Fatalf("ParseRate() error = %v", err)
+			}
+			got, err := Convert(tt.amount, tt.from, tt.to, rate)
+			if err != nil {
+				t.Fatalf("Convert() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Convert() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertErrors(t *testing.T) {
+	usd := Rounding{DecimalPlaces: 2, Increment: 1, Mode: RoundNearest}
+	rate := big.NewRat(1, 1)
+
+	tests := []struct {
+		name   string
+		amount int64
+		to     Rounding
+	}{
+		{"negative amount", -1, usd},
+		{"invalid target increment", 100, Rounding{DecimalPlaces: 2, Mode: RoundNearest}},
+		{"invalid target mode", 100, Rounding{DecimalPlaces: 2, Increment: 1, Mode: "bankers"}},
+		{"out of range", 1 << 62, Rounding{DecimalPlaces: 4, Increment: 1, Mode: RoundNearest}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Convert(tt.amount, usd, tt.to, rate); err == nil {
+				t.Error("Convert() error = nil, want an error")
+			}
+		})
+	}
+}
+
+func TestRoundRat(t *testing.T) {
+	tests := []struct {
+		name  string
+		value *big.Rat
+		mode  string
+		want  int64
+	}{
+		{"integer", big.NewRat(4, 1), RoundUp, 4},
+		{"nearest below half", big.NewRat(9, 4), RoundNearest, 2},
+		{"nearest at half", big.NewRat(5, 2), RoundNearest, 3},
+		{"nearest above half", big.NewRat(11, 4), RoundNearest, 3},
+		{"up", big.NewRat(9, 4), RoundUp, 3},
+		{"down", big.NewRat(11, 4), RoundDown, 2},
+		{"zero", big.NewRat(0, 1), RoundUp, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := roundRat(tt.value, tt.mode); got.Int64() != tt.want {
+				t.Errorf("roundRat() = %d, want %d", got.Int64(), tt.want)
+			}
+		})
+	}
+}
+
+func TestParseRate(t *testing.T) {
+	tests := []struct {
+		rate    string
+		wantErr bool
+	}{
+		{"15850.25", false},
+		{"1", false},
+		{"0", true},
+		{"-1.5", true},
+		{"abc", true},
+		{"", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.rate, func(t *testing.T) {
+			_, err := ParseRate(tt.rate)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseRate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
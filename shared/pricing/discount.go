@@ -0,0 +1,39 @@
+package pricing
+
+import (
+	"fmt"
+	"math"
+)
+
+// Discount types stored in discounts.type and subscription_discounts.type
+const (
+	DiscountTypePercentage = "percentage"
+	DiscountTypeFixed      = "fixed"
+)
+
+// DiscountAmount returns what a discount takes off subtotal, rounded to the
+// nearest minor unit. value is a percentage (0-100) or a fixed amount in the
+// minor unit. The result never exceeds subtotal.
+func DiscountAmount(discountType string, value float64, subtotal int64) (int64, error) {
+	if subtotal <= 0 {
+		return 0, nil
+	}
+
+	var amount int64
+	switch discountType {
+	case DiscountTypePercentage:
+		amount = int64(math.Round(float64(subtotal) * value / 100))
+	case DiscountTypeFixed:
+		amount = int64(math.Round(value))
+	default:
+		return 0, fmt.Errorf("unsupported discount type: %s", discountType)
+	}
+
+	if amount < 0 {
+		return 0, nil
+	}
+	if amount > subtotal {
+		return subtotal, nil
+	}
+	return amount, nil
+}
@@ -0,0 +1,132 @@
+package pricing
+
+import (
+	"fmt"
+	"time"
+)
+
+// Interval slugs seeded by the intervals migration
+const (
+	IntervalDaily   = "daily"
+	IntervalWeekly  = "weekly"
+	IntervalMonthly = "monthly"
+	IntervalYearly  = "yearly"
+)
+
+// NextPeriodEnd returns the end of a billing period of count intervals that
+// starts at from. Monthly and yearly periods are clamped to the last day of
+// the target month, so Jan 31 + 1 month is Feb 28/29 rather than Mar 3.
+func NextPeriodEnd(from time.Time, intervalSlug string, count int32) (time.Time, error) {
+	return shiftPeriod(from, intervalSlug, count, 1)
+}
+
+// PreviousPeriodStart is the inverse of NextPeriodEnd: the start of the
+// period of count intervals that ends at end
+func PreviousPeriodStart(end time.Time, intervalSlug string, count int32) (time.Time, error) {
+	return shiftPeriod(end, intervalSlug, count, -1)
+}
+
+// PeriodEnd returns the end of the n-th billing period of count intervals
+// counted from anchor. Period 1 starts at anchor, so n = 0 yields anchor
+// itself and negative n the periods before it. Every end is computed from
+// the anchor rather than from the previous end, so periods anchored on
+// Jan 31 end on Feb 28/29, Mar 31 and Apr 30 instead of staying on the 28th
+// after February.
+func PeriodEnd(anchor time.Time, intervalSlug string, count int32, n int) (time.Time, error) {
+	if count < 1 {
+		count = 1
+	}
+
+	direction := 1
+	if n < 0 {
+		direction, n = -1, -n
+	}
+	if n == 0 {
+		// Still reject unknown intervals
+		if _, err := shiftPeriod(anchor, intervalSlug, count, direction); err != nil {
+			return time.Time{}, err
+		}
+		return anchor, nil
+	}
+	return shiftPeriod(anchor, intervalSlug, count*int32(n), direction)
+}
+
+// PeriodIndex returns the index, as used by PeriodEnd, of the period counted
+// from anchor that contains t: the smallest n whose end is after t
+func PeriodIndex(anchor time.Time, intervalSlug string, count int32, t time.Time) (int, error) {
+	if t.Before(anchor) {
+		n := 0
+		for {
+			start, err := PeriodEnd(anchor, intervalSlug, count, n-1)
+			if err != nil {
+				return 0, err
+			}
+			if !start.After(t) {
+				return n, nil
+			}
+			n--
+		}
+	}
+
+	n := 1
+	for {
+		end, err := PeriodEnd(anchor, intervalSlug, count, n)
+		if err != nil {
+			return 0, err
+		}
+		if end.After(t) {
+			return n, nil
+		}
+		n++
+	}
+}
+
+// PeriodStart returns the start of the period counted from anchor that ends
+// at end. When end is not one of the anchor's period ends, for example after
+// a manual change, it falls back to PreviousPeriodStart.
+func PeriodStart(anchor time.Time, intervalSlug string, count int32, end time.Time) (time.Time, error) {
+	n, err := PeriodIndex(anchor, intervalSlug, count, end)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	// Period n ends after end, so the period ending at end is n-1
+	previousEnd, err := PeriodEnd(anchor, intervalSlug, count, n-1)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if !previousEnd.Equal(end) {
+		return PreviousPeriodStart(end, intervalSlug, count)
+	}
+	return PeriodEnd(anchor, intervalSlug, count, n-2)
+}
+
+func shiftPeriod(t time.Time, intervalSlug string, count int32, direction int) (time.Time, error) {
+	if count < 1 {
+		count = 1
+	}
+	n := int(count) * direction
+
+	switch intervalSlug {
+	case IntervalDaily:
+		return t.AddDate(0, 0, n), nil
+	case IntervalWeekly:
+		return t.AddDate(0, 0, 7*n), nil
+	case IntervalMonthly:
+		return addMonths(t, n), nil
+	case IntervalYearly:
+		return addMonths(t, 12*n), nil
+	default:
+		return time.Time{}, fmt.Errorf("unsupported interval: %s", intervalSlug)
+	}
+}
+
+func addMonths(t time.Time, months int) time.Time {
+	year, month, day := t.Date()
+	firstOfTarget := time.Date(year, month+time.Month(months), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	lastDay := firstOfTarget.AddDate(0, 1, -1).Day()
+	if day > lastDay {
+		day = lastDay
+	}
+	return firstOfTarget.AddDate(0, 0, day-1)
+}
@@ -0,0 +1,181 @@
+package pricing
+
+import (
+	"testing"
+	"time"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 10, 30, 0, 0, time.UTC)
+}
+
+func TestNextPeriodEnd(t *testing.T) {
+	tests := []struct {
+		name     string
+		from     time.Time
+		interval string
+		count    int32
+		want     time.Time
+	}{
+		{"daily", date(2026, 1, 31), IntervalDaily, 1, date(2026, 2, 1)},
+		{"weekly", date(2026, 1, 28), IntervalWeekly, 2, date(2026, 2, 11)},
+		{"monthly", date(2026, 1, 15), IntervalMonthly, 1, date(2026, 2, 15)},
+		{"monthly clamped to february", date(2026, 1, 31), IntervalMonthly, 1, date(2026, 2, 28)},
+		{"monthly clamped to leap february", date(2028, 1, 31), IntervalMonthly, 1, date(2028, 2, 29)},
+		{"monthly clamped to 30 days", date(2026, 3, 31), IntervalMonthly, 1, date(2026, 4, 30)},
+		{"quarterly across the year", date(2026, 11, 30), IntervalMonthly, 3, date(2027, 2, 28)},
+		{"yearly from leap day", date(2028, 2, 29), IntervalYearly, 1, date(2029, 2, 28)},
+		{"count below one is one", date(2026, 1, 15), IntervalMonthly, 0, date(2026, 2, 15)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NextPeriodEnd(tt.from, tt.interval, tt.count)
+			if err != nil {
+				t.Fatalf("NextPeriodEnd() error = %v", err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("NextPeriodEnd() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNextPeriodEndUnknownInterval(t *testing.T) {
+	if _, err := NextPeriodEnd(date(2026, 1, 1), "fortnightly", 1); err == nil {
+		t.Error("NextPeriodEnd() error = nil, want an error")
+	}
+}
+
+func TestPreviousPeriodStart(t *testing.T) {
+	tests := []struct {
+		name     string
+		end      time.Time
+		interval string
+		count    int32
+		want     time.Time
+	}{
+		{"weekly", date(2026, 2, 11), IntervalWeekly, 2, date(2026, 1, 28)},
+		{"monthly", date(2026, 2, 15), IntervalMonthly, 1, date(2026, 1, 15)},
+		{"monthly clamped", date(2026, 3, 31), IntervalMonthly, 1, date(2026, 2, 28)},
+		{"yearly", date(2027, 6, 1), IntervalYearly, 1, date(2026, 6, 1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := PreviousPeriodStart(tt.end, tt.interval, tt.count)
+			if err != nil {
+				t.Fatalf("PreviousPeriodStart() error = %v", err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("PreviousPeriodStart() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPeriodEnd(t *testing.T) {
+	anchor := date(2026, 1, 31)
+
+	tests := []struct {
+		name     string
+		interval string
+		count    int32
+		n        int
+		want     time.Time
+	}{
+		{"anchor", IntervalMonthly, 1, 0, anchor},
+		{"first period", IntervalMonthly, 1, 1, date(2026, 2, 28)},
+		{"keeps the anchor day after february", IntervalMonthly, 1, 2, date(2026, 3, 31)},
+		{"clamped again", IntervalMonthly, 1, 3, date(2026, 4, 30)},
+		{"leap year", IntervalMonthly, 1, 25, date(2028, 2, 29)},
+		{"before the anchor", IntervalMonthly, 1, -1, date(2025, 12, 31)},
+		{"before the anchor clamped", IntervalMonthly, 1, -2, date(2025, 11, 30)},
+		{"quarterly", IntervalMonthly, 3, 2, date(2026, 7, 31)},
+		{"weekly", IntervalWeekly, 1, 3, date(2026, 2, 21)},
+		{"yearly", IntervalYearly, 1, 2, date(2028, 1, 31)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := PeriodEnd(anchor, tt.interval, tt.count, tt.n)
+			if err != nil {
+				t.Fatalf("PeriodEnd() error = %v", err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("PeriodEnd() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPeriodEndUnknownInterval(t *testing.T) {
+	for _, n := range []int{-1, 0, 1} {
+		if _, err := PeriodEnd(date(2026, 1, 31), "fortnightly", 1, n); err == nil {
+			t.Errorf("PeriodEnd(n = %d) error = nil, want an error", n)
+		}
+	}
+}
+
+func TestPeriodIndex(t *testing.T) {
+	anchor := date(2026, 1, 31)
+
+	tests := []struct {
+		name string
+		at   time.Time
+		want int
+	}{
+		{"at the anchor", anchor, 1},
+		{"inside the first period", date(2026, 2, 10), 1},
+		{"at the first period end", date(2026, 2, 28), 2},
+		{"several periods later", date(2026, 4, 30), 4},
+		{"just before the anchor", anchor.Add(-time.Second), 0},
+		{"two periods before the anchor", date(2025, 11, 30), -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := PeriodIndex(anchor, IntervalMonthly, 1, tt.at)
+			if err != nil {
+				t.Fatalf("PeriodIndex() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("PeriodIndex() = %d, want %d", got, tt.want)
+			}
+
+			// The index must name the period that contains the time
+			start, _ := PeriodEnd(anchor, IntervalMonthly, 1, got-1)
+			end, _ := PeriodEnd(anchor, IntervalMonthly, 1, got)
+			if tt.at.Before(start) || !end.After(tt.at) {
+				t.Errorf("period %d [%v, %v) does not contain %v", got, start, end, tt.at)
+			}
+		})
+	}
+}
+
+func TestPeriodStart(t *testing.T) {
+	anchor := date(2026, 1, 31)
+
+	tests := []struct {
+		name string
+		end  time.Time
+		want time.Time
+	}{
+		{"first period", date(2026, 2, 28), anchor},
+		{"after a clamped end", date(2026, 3, 31), date(2026, 2, 28)},
+		{"at the anchor", anchor, date(2025, 12, 31)},
+		{"end off the anchor falls back", date(2026, 3, 15), date(2026, 2, 15)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := PeriodStart(anchor, IntervalMonthly, 1, tt.end)
+			if err != nil {
+				t.Fatalf("PeriodStart() error = %v", err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("PeriodStart() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
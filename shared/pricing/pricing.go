@@ -0,0 +1,250 @@
+package pricing
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+)
+
+// Price types stored in plan_prices.type and subscriptions.price_type
+const (
+	TypeFlatRate = "flat_rate"
+	TypePerUnit  = "per_unit"
+	TypeTiered   = "tiered"
+)
+
+// Tier modes of a tiered price
+const (
+	// TierModeGraduated charges each unit at the price of the tier it falls
+	// in, plus the flat price of every tier reached
+	TierModeGraduated = "graduated"
+	// TierModeVolume charges every unit at the price of the tier the total
+	// quantity falls in, plus that tier's flat price
+	TierModeVolume = "volume"
+)
+
+// Tier is one band of a tiered price. UpTo is the inclusive upper bound of
+// the band in units; nil marks the last, unbounded tier. Amounts are in the
+// currency's minor unit, like plan_prices.price.
+type Tier struct {
+	UpTo      *int64 `json:"up_to"`
+	UnitPrice int64  `json:"unit_price"`
+	FlatPrice int64  `json:"flat_price"`
+}
+
+// Tiers is the JSON document stored in plan_prices.tiers and
+// subscriptions.price_tiers. An empty Mode means graduated.
+type Tiers struct {
+	Mode  string `json:"mode,omitempty"`
+	Tiers []Tier `json:"tiers"`
+}
+
+// ParseTiers decodes a tiers JSON document. Empty input yields nil.
+func ParseTiers(raw []byte) (*Tiers, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+
+	tiers := &Tiers{}
+	if err := json.Unmarshal(raw, tiers); err != nil {
+		return nil, fmt.Errorf("invalid tiers: %w", err)
+	}
+	return tiers, nil
+}
+
+// Validate checks that the tiers cover every quantity exactly once: bounds
+// are positive and increasing, and only the last tier is unbounded
+func (t *Tiers) Validate() error {
+	if t.Mode != "" && t.Mode != TierModeGraduated && t.Mode != TierModeVolume {
+		return fmt.Errorf("unsupported tier mode: %s", t.Mode)
+	}
+	if len(t.Tiers) == 0 {
+		return fmt.Errorf("tiered price needs at least one tier")
+	}
+
+	var lower int64
+	for i, tier := range t.Tiers {
+		if tier.UnitPrice < 0 || tier.FlatPrice < 0 {
+			return fmt.Errorf("tier %d: prices cannot be negative", i+1)
+		}
+
+		last := i == len(t.Tiers)-1
+		if tier.UpTo == nil {
+			if !last {
+				return fmt.Errorf("tier %d: only the last tier can be unbounded", i+1)
+			}
+			continue
+		}
+		if last {
+			return fmt.Errorf("tier %d: the last tier must be unbounded (up_to null)", i+1)
+		}
+		if *tier.UpTo <= lower {
+			return fmt.Errorf("tier %d: up_to must be greater than %d", i+1, lower)
+		}
+		lower = *tier.UpTo
+	}
+	return nil
+}
+
+// Amount returns the total of Lines for quantity
+func (t *Tiers) Amount(quantity int64) int64 {
+	var total int64
+	for _, line := range t.Lines(quantity) {
+		total += line.Amount
+	}
+	return total
+}
+
+// Lines breaks the price of quantity down per tier, following Mode
+func (t *Tiers) Lines(quantity int64) []QuoteLine {
+	if t.Mode == TierModeVolume {
+		return t.volumeLines(quantity)
+	}
+	return t.graduatedLines(quantity)
+}
+
+func (t *Tiers) graduatedLines(quantity int64) []QuoteLine {
+	lines := make([]QuoteLine, 0, len(t.Tiers))
+	var lower int64
+	for i, tier := range t.Tiers {
+		if quantity <= lower {
+			break
+		}
+
+		upper := quantity
+		if tier.UpTo != nil && *tier.UpTo < quantity {
+			upper = *tier.UpTo
+		}
+
+		lines = append(lines, newQuoteLine(i+1, upper-lower, tier))
+		if tier.UpTo == nil {
+			break
+		}
+		lower = *tier.UpTo
+	}
+	return lines
+}
+
+func (t *Tiers) volumeLines(quantity int64) []QuoteLine {
+	for i, tier := range t.Tiers {
+		if tier.UpTo == nil || quantity <= *tier.UpTo {
+			return []QuoteLine{newQuoteLine(i+1, quantity, tier)}
+		}
+	}
+	// Quantities above a bounded last tier stay in that tier
+	last := len(t.Tiers) - 1
+	if last < 0 {
+		return nil
+	}
+	return []QuoteLine{newQuoteLine(last+1, quantity, t.Tiers[last])}
+}
+
+func newQuoteLine(index int, quantity int64, tier Tier) QuoteLine {
+	return QuoteLine{
+		Tier:      index,
+		Quantity:  quantity,
+		UnitPrice: tier.UnitPrice,
+		FlatPrice: tier.FlatPrice,
+		Amount:    tier.FlatPrice + quantity*tier.UnitPrice,
+	}
+}
+
+// Price describes how a plan price or subscription is charged per period
+type Price struct {
+	Type         string
+	Price        int64
+	PricePerUnit *int64
+	Tiers        *Tiers
+}
+
+// QuoteLine is one part of a quote. Tier is the 1-based tier index, or 0
+// for flat_rate and per_unit prices.
+type QuoteLine struct {
+	Tier      int
+	Quantity  int64
+	UnitPrice int64
+	FlatPrice int64
+	Amount    int64
+}
+
+// Quote is the amount due for one period at a quantity, with its breakdown
+type Quote struct {
+	Quantity int64
+	Amount   int64
+	Lines    []QuoteLine
+}
+
+// Validate checks that the price has what its type needs to be charged
+func (p Price) Validate() error {
+	switch p.Type {
+	case TypeFlatRate, TypePerUnit:
+		if p.Price < 0 || (p.PricePerUnit != nil && *p.PricePerUnit < 0) {
+			return fmt.Errorf("price cannot be negative")
+		}
+		if p.Tiers != nil && len(p.Tiers.Tiers) > 0 {
+			return fmt.Errorf("tiers are only allowed on tiered prices")
+		}
+		return nil
+	case TypeTiered:
+		if p.Tiers == nil {
+			return fmt.Errorf("tiered price needs at least one tier")
+		}
+		return p.Tiers.Validate()
+	default:
+		return fmt.Errorf("unsupported price type: %s", p.Type)
+	}
+}
+
+// Amount returns the amount due for one period at the given quantity
+func (p Price) Amount(quantity int64) (int64, error) {
+	quote, err := p.Quote(quantity)
+	if err != nil {
+		return 0, err
+	}
+	return quote.Amount, nil
+}
+
+// Quote prices one period at the given quantity. Quantities below 1 are
+// charged as 1.
+func (p Price) Quote(quantity int64) (*Quote, error) {
+	if quantity < 1 {
+		quantity = 1
+	}
+
+	quote := &Quote{Quantity: quantity}
+	switch p.Type {
+	case TypeFlatRate, "":
+		quote.Lines = []QuoteLine{{Quantity: 1, UnitPrice: p.Price, Amount: p.Price}}
+	case TypePerUnit:
+		unitPrice := p.Price
+		if p.PricePerUnit != nil {
+			unitPrice = *p.PricePerUnit
+		}
+		quote.Lines = []QuoteLine{{Quantity: quantity, UnitPrice: unitPrice, Amount: unitPrice * quantity}}
+	case TypeTiered:
+		if p.Tiers == nil || len(p.Tiers.Tiers) == 0 {
+			return nil, fmt.Errorf("tiered price has no tiers")
+		}
+		quote.Lines = p.Tiers.Lines(quantity)
+	default:
+		return nil, fmt.Errorf("unsupported price type: %s", p.Type)
+	}
+
+	for _, line := range quote.Lines {
+		quote.Amount += line.Amount
+	}
+	return quote, nil
+}
+
+// Prorate scales amount by the share of period that is still remaining,
+// rounding to the nearest minor unit
+func Prorate(amount int64, remaining, period time.Duration) int64 {
+	if period <= 0 || remaining <= 0 {
+		return 0
+	}
+	if remaining >= period {
+		return amount
+	}
+	return int64(math.Round(float64(amount) * float64(remaining) / float64(period)))
+}
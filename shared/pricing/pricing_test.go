@@ -0,0 +1,127 @@
+package pricing
+
+import (
+	"testing"
+	"time"
+)
+
+func int64Ptr(v int64) *int64 {
+	return &v
+}
+
+func TestPriceAmount(t *testing.T) {
+	graduated := &Tiers{Tiers: []Tier{
+		{UpTo: int64Ptr(10), UnitPrice: 100},
+		{UpTo: int64Ptr(20), UnitPrice: 80, FlatPrice: 500},
+		{UnitPrice: 50},
+	}}
+	volume := &Tiers{Mode: TierModeVolume, Tiers: graduated.Tiers}
+
+	tests := []struct {
+		name     string
+		price    Price
+		quantity int64
+		want     int64
+	}{
+		{"flat rate ignores quantity", Price{Type: TypeFlatRate, Price: 1000}, 5, 1000},
+		{"empty type is flat rate", Price{Price: 1000}, 5, 1000},
+		{"per unit", Price{Type: TypePerUnit, Price: 300}, 4, 1200},
+		{"per unit price overrides price", Price{Type: TypePerUnit, Price: 300, PricePerUnit: int64Ptr(250)}, 4, 1000},
+		{"quantity below one is one", Price{Type: TypePerUnit, Price: 300}, 0, 300},
+		{"graduated first tier", Price{Type: TypeTiered, Tiers: graduated}, 10, 1000},
+		{"graduated second tier", Price{Type: TypeTiered, Tiers: graduated}, 15, 1000 + 500 + 5*80},
+		{"graduated last tier", Price{Type: TypeTiered, Tiers: graduated}, 25, 1000 + 500 + 10*80 + 5*50},
+		{"volume first tier", Price{Type: TypeTiered, Tiers: volume}, 10, 1000},
+		{"volume second tier", Price{Type: TypeTiered, Tiers: volume}, 15, 500 + 15*80},
+		{"volume last tier", Price{Type: TypeTiered, Tiers: volume}, 25, 25 * 50},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.price.Amount(tt.quantity)
+			if err != nil {
+				t.Fatalf("Amount() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Amount() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPriceAmountErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		price Price
+	}{
+		{"tiered without tiers", Price{Type: TypeTiered}},
+		{"unknown type", Price{Type: "metered"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tt.price.Amount(1); err == nil {
+				t.Error("Amount() error = nil, want an error")
+			}
+		})
+	}
+}
+
+func TestTiersValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		tiers   Tiers
+		wantErr bool
+	}{
+		{"valid", Tiers{Tiers: []Tier{{UpTo: int64Ptr(10), UnitPrice: 100}, {UnitPrice: 50}}}, false},
+		{"single unbounded tier", Tiers{Mode: TierModeVolume, Tiers: []Tier{{UnitPrice: 50}}}, false},
+		{"no tiers", Tiers{}, true},
+		{"unknown mode", Tiers{Mode: "stairstep", Tiers: []Tier{{UnitPrice: 50}}}, true},
+		{"negative price", Tiers{Tiers: []Tier{{UnitPrice: -1}}}, true},
+		{"bounded last tier", Tiers{Tiers: []Tier{{UpTo: int64Ptr(10), UnitPrice: 100}}}, true},
+		{"unbounded middle tier", Tiers{Tiers: []Tier{{UnitPrice: 100}, {UnitPrice: 50}}}, true},
+		{"bounds not increasing", Tiers{Tiers: []Tier{
+			{UpTo: int64Ptr(10), UnitPrice: 100},
+			{UpTo: int64Ptr(10), UnitPrice: 80},
+			{UnitPrice: 50},
+		}}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.tiers.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestProrate(t *testing.T) {
+	month := 30 * 24 * time.Hour
+
+	tests := []struct {
+		name      string
+		amount    int64
+		remaining time.Duration
+		period    time.Duration
+		want      int64
+	}{
+		{"half", 1000, month / 2, month, 500},
+		{"rounds to nearest", 1000, month / 3, month, 333},
+		{"rounds half up", 1, month / 2, month, 1},
+		{"full period", 1000, month, month, 1000},
+		{"more than the period", 1000, 2 * month, month, 1000},
+		{"nothing remaining", 1000, 0, month, 0},
+		{"negative remaining", 1000, -time.Hour, month, 0},
+		{"empty period", 1000, time.Hour, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Prorate(tt.amount, tt.remaining, tt.period); got != tt.want {
+				t.Errorf("Prorate() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
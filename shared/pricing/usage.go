@@ -0,0 +1,18 @@
+package pricing
+
+// Usage aggregations stored in plan_meters.aggregation. They decide how the
+// usage records of one billing period become the billed quantity.
+const (
+	AggregationSum  = "sum"  // total of all records, e.g. API calls
+	AggregationMax  = "max"  // peak value, e.g. seats or storage high-water mark
+	AggregationLast = "last" // most recent record by timestamp, e.g. a gauge
+)
+
+// IsValidAggregation reports whether aggregation is one of the Aggregation* values
+func IsValidAggregation(aggregation string) bool {
+	switch aggregation {
+	case AggregationSum, AggregationMax, AggregationLast:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,1709 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.33.1
+// source: billing.proto
+
+package billing
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Invoice struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Id                      int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Uuid                    string                 `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	TenantId                int64                  `protobuf:"varint,3,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	SubscriptionId          int64                  `protobuf:"varint,4,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	UserId                  int64                  `protobuf:"varint,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CurrencyId              int64                  `protobuf:"varint,6,opt,name=currency_id,json=currencyId,proto3" json:"currency_id,omitempty"`
+	Number                  string                 `protobuf:"bytes,7,opt,name=number,proto3" json:"number,omitempty"` // assigned when the invoice is finalized
+	Status                  string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"` // draft, open, paid, void
+	PeriodStart             int64                  `protobuf:"varint,9,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	PeriodEnd               int64                  `protobuf:"varint,10,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`
+	Subtotal                int64                  `protobuf:"varint,11,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
+	DiscountTotal           int64                  `protobuf:"varint,12,opt,name=discount_total,json=discountTotal,proto3" json:"discount_total,omitempty"`
+	Total                   int64                  `protobuf:"varint,13,opt,name=total,proto3" json:"total,omitempty"`
+	DueAt                   int64                  `protobuf:"varint,14,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`
+	FinalizedAt             int64                  `protobuf:"varint,15,opt,name=finalized_at,json=finalizedAt,proto3" json:"finalized_at,omitempty"`
+	PaidAt                  int64                  `protobuf:"varint,16,opt,name=paid_at,json=paidAt,proto3" json:"paid_at,omitempty"`
+	VoidedAt                int64                  `protobuf:"varint,17,opt,name=voided_at,json=voidedAt,proto3" json:"voided_at,omitempty"`
+	VoidReason              string                 `protobuf:"bytes,18,opt,name=void_reason,json=voidReason,proto3" json:"void_reason,omitempty"`
+	Metadata                string                 `protobuf:"bytes,19,opt,name=metadata,proto3" json:"metadata,omitempty"` // JSON string
+	LineItems               []*InvoiceLineItem     `protobuf:"bytes,20,rep,name=line_items,json=lineItems,proto3" json:"line_items,omitempty"`
+	CreatedAt               int64                  `protobuf:"varint,21,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt               int64                  `protobuf:"varint,22,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	PaymentProviderId       int64                  `protobuf:"varint,23,opt,name=payment_provider_id,json=paymentProviderId,proto3" json:"payment_provider_id,omitempty"`
+	PaymentProviderChargeId string                 `protobuf:"bytes,24,opt,name=payment_provider_charge_id,json=paymentProviderChargeId,proto3" json:"payment_provider_charge_id,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *Invoice) Reset() {
+	*x = Invoice{}
+	mi := &file_billing_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Invoice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invoice) ProtoMessage() {}
+
+func (x *Invoice) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invoice.ProtoReflect.Descriptor instead.
+func (*Invoice) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Invoice) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Invoice) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *Invoice) GetTenantId() int64 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *Invoice) GetSubscriptionId() int64 {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return 0
+}
+
+func (x *Invoice) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Invoice) GetCurrencyId() int64 {
+	if x != nil {
+		return x.CurrencyId
+	}
+	return 0
+}
+
+func (x *Invoice) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *Invoice) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Invoice) GetPeriodStart() int64 {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return 0
+}
+
+func (x *Invoice) GetPeriodEnd() int64 {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return 0
+}
+
+func (x *Invoice) GetSubtotal() int64 {
+	if x != nil {
+		return x.Subtotal
+	}
+	return 0
+}
+
+func (x *Invoice) GetDiscountTotal() int64 {
+	if x != nil {
+		return x.DiscountTotal
+	}
+	return 0
+}
+
+func (x *Invoice) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *Invoice) GetDueAt() int64 {
+	if x != nil {
+		return x.DueAt
+	}
+	return 0
+}
+
+func (x *Invoice) GetFinalizedAt() int64 {
+	if x != nil {
+		return x.FinalizedAt
+	}
+	return 0
+}
+
+func (x *Invoice) GetPaidAt() int64 {
+	if x != nil {
+		return x.PaidAt
+	}
+	return 0
+}
+
+func (x *Invoice) GetVoidedAt() int64 {
+	if x != nil {
+		return x.VoidedAt
+	}
+	return 0
+}
+
+func (x *Invoice) GetVoidReason() string {
+	if x != nil {
+		return x.VoidReason
+	}
+	return ""
+}
+
+func (x *Invoice) GetMetadata() string {
+	if x != nil {
+		return x.Metadata
+	}
+	return ""
+}
+
+func (x *Invoice) GetLineItems() []*InvoiceLineItem {
+	if x != nil {
+		return x.LineItems
+	}
+	return nil
+}
+
+func (x *Invoice) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Invoice) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *Invoice) GetPaymentProviderId() int64 {
+	if x != nil {
+		return x.PaymentProviderId
+	}
+	return 0
+}
+
+func (x *Invoice) GetPaymentProviderChargeId() string {
+	if x != nil {
+		return x.PaymentProviderChargeId
+	}
+	return ""
+}
+
+type InvoiceLineItem struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Id                     int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	InvoiceId              int64                  `protobuf:"varint,2,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	Type                   string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"` // subscription, usage, discount
+	Description            string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Quantity               int64                  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UnitAmount             int64                  `protobuf:"varint,6,opt,name=unit_amount,json=unitAmount,proto3" json:"unit_amount,omitempty"`
+	Amount                 int64                  `protobuf:"varint,7,opt,name=amount,proto3" json:"amount,omitempty"` // negative for discounts
+	SubscriptionDiscountId int64                  `protobuf:"varint,8,opt,name=subscription_discount_id,json=subscriptionDiscountId,proto3" json:"subscription_discount_id,omitempty"`
+	CreatedAt              int64                  `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt              int64                  `protobuf:"varint,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *InvoiceLineItem) Reset() {
+	*x = InvoiceLineItem{}
+	mi := &file_billing_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvoiceLineItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvoiceLineItem) ProtoMessage() {}
+
+func (x *InvoiceLineItem) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvoiceLineItem.ProtoReflect.Descriptor instead.
+func (*InvoiceLineItem) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *InvoiceLineItem) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *InvoiceLineItem) GetInvoiceId() int64 {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return 0
+}
+
+func (x *InvoiceLineItem) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *InvoiceLineItem) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *InvoiceLineItem) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *InvoiceLineItem) GetUnitAmount() int64 {
+	if x != nil {
+		return x.UnitAmount
+	}
+	return 0
+}
+
+func (x *InvoiceLineItem) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *InvoiceLineItem) GetSubscriptionDiscountId() int64 {
+	if x != nil {
+		return x.SubscriptionDiscountId
+	}
+	return 0
+}
+
+func (x *InvoiceLineItem) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *InvoiceLineItem) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type GenerateInvoiceRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SubscriptionId int64                  `protobuf:"varint,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	PeriodStart    int64                  `protobuf:"varint,2,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"` // optional, defaults to the last completed period
+	PeriodEnd      int64                  `protobuf:"varint,3,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GenerateInvoiceRequest) Reset() {
+	*x = GenerateInvoiceRequest{}
+	mi := &file_billing_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateInvoiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateInvoiceRequest) ProtoMessage() {}
+
+func (x *GenerateInvoiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateInvoiceRequest.ProtoReflect.Descriptor instead.
+func (*GenerateInvoiceRequest) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GenerateInvoiceRequest) GetSubscriptionId() int64 {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return 0
+}
+
+func (x *GenerateInvoiceRequest) GetPeriodStart() int64 {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return 0
+}
+
+func (x *GenerateInvoiceRequest) GetPeriodEnd() int64 {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return 0
+}
+
+type GenerateInvoiceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *Invoice               `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateInvoiceResponse) Reset() {
+	*x = GenerateInvoiceResponse{}
+	mi := &file_billing_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateInvoiceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateInvoiceResponse) ProtoMessage() {}
+
+func (x *GenerateInvoiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateInvoiceResponse.ProtoReflect.Descriptor instead.
+func (*GenerateInvoiceResponse) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GenerateInvoiceResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GenerateInvoiceResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GenerateInvoiceResponse) GetData() *Invoice {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GetInvoiceByIDRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInvoiceByIDRequest) Reset() {
+	*x = GetInvoiceByIDRequest{}
+	mi := &file_billing_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInvoiceByIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvoiceByIDRequest) ProtoMessage() {}
+
+func (x *GetInvoiceByIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvoiceByIDRequest.ProtoReflect.Descriptor instead.
+func (*GetInvoiceByIDRequest) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetInvoiceByIDRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetInvoiceByIDResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *Invoice               `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInvoiceByIDResponse) Reset() {
+	*x = GetInvoiceByIDResponse{}
+	mi := &file_billing_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInvoiceByIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvoiceByIDResponse) ProtoMessage() {}
+
+func (x *GetInvoiceByIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvoiceByIDResponse.ProtoReflect.Descriptor instead.
+func (*GetInvoiceByIDResponse) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetInvoiceByIDResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetInvoiceByIDResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetInvoiceByIDResponse) GetData() *Invoice {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GetInvoiceByNumberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      int64                  `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Number        string                 `protobuf:"bytes,2,opt,name=number,proto3" json:"number,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInvoiceByNumberRequest) Reset() {
+	*x = GetInvoiceByNumberRequest{}
+	mi := &file_billing_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInvoiceByNumberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvoiceByNumberRequest) ProtoMessage() {}
+
+func (x *GetInvoiceByNumberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvoiceByNumberRequest.ProtoReflect.Descriptor instead.
+func (*GetInvoiceByNumberRequest) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetInvoiceByNumberRequest) GetTenantId() int64 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *GetInvoiceByNumberRequest) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+type GetInvoiceByNumberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *Invoice               `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInvoiceByNumberResponse) Reset() {
+	*x = GetInvoiceByNumberResponse{}
+	mi := &file_billing_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInvoiceByNumberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvoiceByNumberResponse) ProtoMessage() {}
+
+func (x *GetInvoiceByNumberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvoiceByNumberResponse.ProtoReflect.Descriptor instead.
+func (*GetInvoiceByNumberResponse) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetInvoiceByNumberResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetInvoiceByNumberResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetInvoiceByNumberResponse) GetData() *Invoice {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GetInvoicesByTenantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      int64                  `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PerPage       int32                  `protobuf:"varint,3,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"` // filter by status
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInvoicesByTenantRequest) Reset() {
+	*x = GetInvoicesByTenantRequest{}
+	mi := &file_billing_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInvoicesByTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvoicesByTenantRequest) ProtoMessage() {}
+
+func (x *GetInvoicesByTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvoicesByTenantRequest.ProtoReflect.Descriptor instead.
+func (*GetInvoicesByTenantRequest) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetInvoicesByTenantRequest) GetTenantId() int64 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *GetInvoicesByTenantRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetInvoicesByTenantRequest) GetPerPage() int32 {
+	if x != nil {
+		return x.PerPage
+	}
+	return 0
+}
+
+func (x *GetInvoicesByTenantRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type GetInvoicesData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invoices      []*Invoice             `protobuf:"bytes,1,rep,name=invoices,proto3" json:"invoices,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PerPage       int32                  `protobuf:"varint,4,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInvoicesData) Reset() {
+	*x = GetInvoicesData{}
+	mi := &file_billing_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInvoicesData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvoicesData) ProtoMessage() {}
+
+func (x *GetInvoicesData) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvoicesData.ProtoReflect.Descriptor instead.
+func (*GetInvoicesData) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetInvoicesData) GetInvoices() []*Invoice {
+	if x != nil {
+		return x.Invoices
+	}
+	return nil
+}
+
+func (x *GetInvoicesData) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetInvoicesData) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetInvoicesData) GetPerPage() int32 {
+	if x != nil {
+		return x.PerPage
+	}
+	return 0
+}
+
+type GetInvoicesByTenantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *GetInvoicesData       `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInvoicesByTenantResponse) Reset() {
+	*x = GetInvoicesByTenantResponse{}
+	mi := &file_billing_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInvoicesByTenantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvoicesByTenantResponse) ProtoMessage() {}
+
+func (x *GetInvoicesByTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvoicesByTenantResponse.ProtoReflect.Descriptor instead.
+func (*GetInvoicesByTenantResponse) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetInvoicesByTenantResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetInvoicesByTenantResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetInvoicesByTenantResponse) GetData() *GetInvoicesData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GetInvoicesBySubscriptionRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SubscriptionId int64                  `protobuf:"varint,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	Page           int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PerPage        int32                  `protobuf:"varint,3,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetInvoicesBySubscriptionRequest) Reset() {
+	*x = GetInvoicesBySubscriptionRequest{}
+	mi := &file_billing_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInvoicesBySubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvoicesBySubscriptionRequest) ProtoMessage() {}
+
+func (x *GetInvoicesBySubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvoicesBySubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*GetInvoicesBySubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetInvoicesBySubscriptionRequest) GetSubscriptionId() int64 {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return 0
+}
+
+func (x *GetInvoicesBySubscriptionRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetInvoicesBySubscriptionRequest) GetPerPage() int32 {
+	if x != nil {
+		return x.PerPage
+	}
+	return 0
+}
+
+type GetInvoicesBySubscriptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *GetInvoicesData       `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInvoicesBySubscriptionResponse) Reset() {
+	*x = GetInvoicesBySubscriptionResponse{}
+	mi := &file_billing_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInvoicesBySubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvoicesBySubscriptionResponse) ProtoMessage() {}
+
+func (x *GetInvoicesBySubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvoicesBySubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*GetInvoicesBySubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetInvoicesBySubscriptionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetInvoicesBySubscriptionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetInvoicesBySubscriptionResponse) GetData() *GetInvoicesData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type FinalizeInvoiceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FinalizeInvoiceRequest) Reset() {
+	*x = FinalizeInvoiceRequest{}
+	mi := &file_billing_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FinalizeInvoiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinalizeInvoiceRequest) ProtoMessage() {}
+
+func (x *FinalizeInvoiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinalizeInvoiceRequest.ProtoReflect.Descriptor instead.
+func (*FinalizeInvoiceRequest) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *FinalizeInvoiceRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type FinalizeInvoiceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *Invoice               `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FinalizeInvoiceResponse) Reset() {
+	*x = FinalizeInvoiceResponse{}
+	mi := &file_billing_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FinalizeInvoiceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinalizeInvoiceResponse) ProtoMessage() {}
+
+func (x *FinalizeInvoiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinalizeInvoiceResponse.ProtoReflect.Descriptor instead.
+func (*FinalizeInvoiceResponse) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *FinalizeInvoiceResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *FinalizeInvoiceResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *FinalizeInvoiceResponse) GetData() *Invoice {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type MarkInvoicePaidRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkInvoicePaidRequest) Reset() {
+	*x = MarkInvoicePaidRequest{}
+	mi := &file_billing_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkInvoicePaidRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkInvoicePaidRequest) ProtoMessage() {}
+
+func (x *MarkInvoicePaidRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkInvoicePaidRequest.ProtoReflect.Descriptor instead.
+func (*MarkInvoicePaidRequest) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *MarkInvoicePaidRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type MarkInvoicePaidResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *Invoice               `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkInvoicePaidResponse) Reset() {
+	*x = MarkInvoicePaidResponse{}
+	mi := &file_billing_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkInvoicePaidResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkInvoicePaidResponse) ProtoMessage() {}
+
+func (x *MarkInvoicePaidResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkInvoicePaidResponse.ProtoReflect.Descriptor instead.
+func (*MarkInvoicePaidResponse) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *MarkInvoicePaidResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *MarkInvoicePaidResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *MarkInvoicePaidResponse) GetData() *Invoice {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type VoidInvoiceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VoidInvoiceRequest) Reset() {
+	*x = VoidInvoiceRequest{}
+	mi := &file_billing_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VoidInvoiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoidInvoiceRequest) ProtoMessage() {}
+
+func (x *VoidInvoiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoidInvoiceRequest.ProtoReflect.Descriptor instead.
+func (*VoidInvoiceRequest) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *VoidInvoiceRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *VoidInvoiceRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type VoidInvoiceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *Invoice               `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VoidInvoiceResponse) Reset() {
+	*x = VoidInvoiceResponse{}
+	mi := &file_billing_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VoidInvoiceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoidInvoiceResponse) ProtoMessage() {}
+
+func (x *VoidInvoiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoidInvoiceResponse.ProtoReflect.Descriptor instead.
+func (*VoidInvoiceResponse) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *VoidInvoiceResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *VoidInvoiceResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *VoidInvoiceResponse) GetData() *Invoice {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type PayInvoiceRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PaymentProvider string                 `protobuf:"bytes,2,opt,name=payment_provider,json=paymentProvider,proto3" json:"payment_provider,omitempty"` // provider slug, defaults to the subscription's provider
+	PaymentMethodId string                 `protobuf:"bytes,3,opt,name=payment_method_id,json=paymentMethodId,proto3" json:"payment_method_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PayInvoiceRequest) Reset() {
+	*x = PayInvoiceRequest{}
+	mi := &file_billing_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PayInvoiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayInvoiceRequest) ProtoMessage() {}
+
+func (x *PayInvoiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayInvoiceRequest.ProtoReflect.Descriptor instead.
+func (*PayInvoiceRequest) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PayInvoiceRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PayInvoiceRequest) GetPaymentProvider() string {
+	if x != nil {
+		return x.PaymentProvider
+	}
+	return ""
+}
+
+func (x *PayInvoiceRequest) GetPaymentMethodId() string {
+	if x != nil {
+		return x.PaymentMethodId
+	}
+	return ""
+}
+
+type PayInvoiceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *Invoice               `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	ChargeId      string                 `protobuf:"bytes,4,opt,name=charge_id,json=chargeId,proto3" json:"charge_id,omitempty"`
+	ChargeStatus  string                 `protobuf:"bytes,5,opt,name=charge_status,json=chargeStatus,proto3" json:"charge_status,omitempty"` // succeeded, pending
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PayInvoiceResponse) Reset() {
+	*x = PayInvoiceResponse{}
+	mi := &file_billing_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PayInvoiceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayInvoiceResponse) ProtoMessage() {}
+
+func (x *PayInvoiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayInvoiceResponse.ProtoReflect.Descriptor instead.
+func (*PayInvoiceResponse) Descriptor() ([]byte, []int) {
+	return file_billing_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *PayInvoiceResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *PayInvoiceResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PayInvoiceResponse) GetData() *Invoice {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *PayInvoiceResponse) GetChargeId() string {
+	if x != nil {
+		return x.ChargeId
+	}
+	return ""
+}
+
+func (x *PayInvoiceResponse) GetChargeStatus() string {
+	if x != nil {
+		return x.ChargeStatus
+	}
+	return ""
+}
+
+var File_billing_proto protoreflect.FileDescriptor
+
+const file_billing_proto_rawDesc = "" +
+	"\n" +
+	"\rbilling.proto\x12\abilling\"\x89\x06\n" +
+	"\aInvoice\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04uuid\x18\x02 \x01(\tR\x04uuid\x12\x1b\n" +
+	"\ttenant_id\x18\x03 \x01(\x03R\btenantId\x12'\n" +
+	"\x0fsubscription_id\x18\x04 \x01(\x03R\x0esubscriptionId\x12\x17\n" +
+	"\auser_id\x18\x05 \x01(\x03R\x06userId\x12\x1f\n" +
+	"\vcurrency_id\x18\x06 \x01(\x03R\n" +
+	"currencyId\x12\x16\n" +
+	"\x06number\x18\a \x01(\tR\x06number\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12!\n" +
+	"\fperiod_start\x18\t \x01(\x03R\vperiodStart\x12\x1d\n" +
+	"\n" +
+	"period_end\x18\n" +
+	" \x01(\x03R\tperiodEnd\x12\x1a\n" +
+	"\bsubtotal\x18\v \x01(\x03R\bsubtotal\x12%\n" +
+	"\x0ediscount_total\x18\f \x01(\x03R\rdiscountTotal\x12\x14\n" +
+	"\x05total\x18\r \x01(\x03R\x05total\x12\x15\n" +
+	"\x06due_at\x18\x0e \x01(\x03R\x05dueAt\x12!\n" +
+	"\ffinalized_at\x18\x0f \x01(\x03R\vfinalizedAt\x12\x17\n" +
+	"\apaid_at\x18\x10 \x01(\x03R\x06paidAt\x12\x1b\n" +
+	"\tvoided_at\x18\x11 \x01(\x03R\bvoidedAt\x12\x1f\n" +
+	"\vvoid_reason\x18\x12 \x01(\tR\n" +
+	"voidReason\x12\x1a\n" +
+	"\bmetadata\x18\x13 \x01(\tR\bmetadata\x127\n" +
+	"\n" +
+	"line_items\x18\x14 \x03(\v2\x18.billing.InvoiceLineItemR\tlineItems\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x15 \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x16 \x01(\x03R\tupdatedAt\x12.\n" +
+	"\x13payment_provider_id\x18\x17 \x01(\x03R\x11paymentProviderId\x12;\n" +
+	"\x1apayment_provider_charge_id\x18\x18 \x01(\tR\x17paymentProviderChargeId\"\xc3\x02\n" +
+	"\x0fInvoiceLineItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"invoice_id\x18\x02 \x01(\x03R\tinvoiceId\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x03R\bquantity\x12\x1f\n" +
+	"\vunit_amount\x18\x06 \x01(\x03R\n" +
+	"unitAmount\x12\x16\n" +
+	"\x06amount\x18\a \x01(\x03R\x06amount\x128\n" +
+	"\x18subscription_discount_id\x18\b \x01(\x03R\x16subscriptionDiscountId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\x03R\tupdatedAt\"\x83\x01\n" +
+	"\x16GenerateInvoiceRequest\x12'\n" +
+	"\x0fsubscription_id\x18\x01 \x01(\x03R\x0esubscriptionId\x12!\n" +
+	"\fperiod_start\x18\x02 \x01(\x03R\vperiodStart\x12\x1d\n" +
+	"\n" +
+	"period_end\x18\x03 \x01(\x03R\tperiodEnd\"s\n" +
+	"\x17GenerateInvoiceResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12$\n" +
+	"\x04data\x18\x03 \x01(\v2\x10.billing.InvoiceR\x04data\"'\n" +
+	"\x15GetInvoiceByIDRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"r\n" +
+	"\x16GetInvoiceByIDResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12$\n" +
+	"\x04data\x18\x03 \x01(\v2\x10.billing.InvoiceR\x04data\"P\n" +
+	"\x19GetInvoiceByNumberRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\x03R\btenantId\x12\x16\n" +
+	"\x06number\x18\x02 \x01(\tR\x06number\"v\n" +
+	"\x1aGetInvoiceByNumberResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12$\n" +
+	"\x04data\x18\x03 \x01(\v2\x10.billing.InvoiceR\x04data\"\x80\x01\n" +
+	"\x1aGetInvoicesByTenantRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\x03R\btenantId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x19\n" +
+	"\bper_page\x18\x03 \x01(\x05R\aperPage\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\"\x84\x01\n" +
+	"\x0fGetInvoicesData\x12,\n" +
+	"\binvoices\x18\x01 \x03(\v2\x10.billing.InvoiceR\binvoices\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x19\n" +
+	"\bper_page\x18\x04 \x01(\x05R\aperPage\"\x7f\n" +
+	"\x1bGetInvoicesByTenantResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12,\n" +
+	"\x04data\x18\x03 \x01(\v2\x18.billing.GetInvoicesDataR\x04data\"z\n" +
+	" GetInvoicesBySubscriptionRequest\x12'\n" +
+	"\x0fsubscription_id\x18\x01 \x01(\x03R\x0esubscriptionId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x19\n" +
+	"\bper_page\x18\x03 \x01(\x05R\aperPage\"\x85\x01\n" +
+	"!GetInvoicesBySubscriptionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12,\n" +
+	"\x04data\x18\x03 \x01(\v2\x18.billing.GetInvoicesDataR\x04data\"(\n" +
+	"\x16FinalizeInvoiceRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"s\n" +
+	"\x17FinalizeInvoiceResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12$\n" +
+	"\x04data\x18\x03 \x01(\v2\x10.billing.InvoiceR\x04data\"(\n" +
+	"\x16MarkInvoicePaidRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"s\n" +
+	"\x17MarkInvoicePaidResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12$\n" +
+	"\x04data\x18\x03 \x01(\v2\x10.billing.InvoiceR\x04data\"<\n" +
+	"\x12VoidInvoiceRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"o\n" +
+	"\x13VoidInvoiceResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12$\n" +
+	"\x04data\x18\x03 \x01(\v2\x10.billing.InvoiceR\x04data\"z\n" +
+	"\x11PayInvoiceRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12)\n" +
+	"\x10payment_provider\x18\x02 \x01(\tR\x0fpaymentProvider\x12*\n" +
+	"\x11payment_method_id\x18\x03 \x01(\tR\x0fpaymentMethodId\"\xb0\x01\n" +
+	"\x12PayInvoiceResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12$\n" +
+	"\x04data\x18\x03 \x01(\v2\x10.billing.InvoiceR\x04data\x12\x1b\n" +
+	"\tcharge_id\x18\x04 \x01(\tR\bchargeId\x12#\n" +
+	"\rcharge_status\x18\x05 \x01(\tR\fchargeStatus2\xbd\x06\n" +
+	"\x0eBillingService\x12V\n" +
+	"\x0fGenerateInvoice\x12\x1f.billing.GenerateInvoiceRequest\x1a .billing.GenerateInvoiceResponse\"\x00\x12S\n" +
+	"\x0eGetInvoiceByID\x12\x1e.billing.GetInvoiceByIDRequest\x1a\x1f.billing.GetInvoiceByIDResponse\"\x00\x12_\n" +
+	"\x12GetInvoiceByNumber\x12\".billing.GetInvoiceByNumberRequest\x1a#.billing.GetInvoiceByNumberResponse\"\x00\x12b\n" +
+	"\x13GetInvoicesByTenant\x12#.billing.GetInvoicesByTenantRequest\x1a$.billing.GetInvoicesByTenantResponse\"\x00\x12t\n" +
+	"\x19GetInvoicesBySubscription\x12).billing.GetInvoicesBySubscriptionRequest\x1a*.billing.GetInvoicesBySubscriptionResponse\"\x00\x12V\n" +
+	"\x0fFinalizeInvoice\x12\x1f.billing.FinalizeInvoiceRequest\x1a .billing.FinalizeInvoiceResponse\"\x00\x12V\n" +
+	"\x0fMarkInvoicePaid\x12\x1f.billing.MarkInvoicePaidRequest\x1a .billing.MarkInvoicePaidResponse\"\x00\x12J\n" +
+	"\vVoidInvoice\x12\x1b.billing.VoidInvoiceRequest\x1a\x1c.billing.VoidInvoiceResponse\"\x00\x12G\n" +
+	"\n" +
+	"PayInvoice\x12\x1a.billing.PayInvoiceRequest\x1a\x1b.billing.PayInvoiceResponse\"\x00B\x1eZ\x1cshared/proto/billing;billingb\x06proto3"
+
+var (
+	file_billing_proto_rawDescOnce sync.Once
+	file_billing_proto_rawDescData []byte
+)
+
+func file_billing_proto_rawDescGZIP() []byte {
+	file_billing_proto_rawDescOnce.Do(func() {
+		file_billing_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_billing_proto_rawDesc), len(file_billing_proto_rawDesc)))
+	})
+	return file_billing_proto_rawDescData
+}
+
+var file_billing_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_billing_proto_goTypes = []any{
+	(*Invoice)(nil),                           // 0: billing.Invoice
+	(*InvoiceLineItem)(nil),                   // 1: billing.InvoiceLineItem
+	(*GenerateInvoiceRequest)(nil),            // 2: billing.GenerateInvoiceRequest
+	(*GenerateInvoiceResponse)(nil),           // 3: billing.GenerateInvoiceResponse
+	(*GetInvoiceByIDRequest)(nil),             // 4: billing.GetInvoiceByIDRequest
+	(*GetInvoiceByIDResponse)(nil),            // 5: billing.GetInvoiceByIDResponse
+	(*GetInvoiceByNumberRequest)(nil),         // 6: billing.GetInvoiceByNumberRequest
+	(*GetInvoiceByNumberResponse)(nil),        // 7: billing.GetInvoiceByNumberResponse
+	(*GetInvoicesByTenantRequest)(nil),        // 8: billing.GetInvoicesByTenantRequest
+	(*GetInvoicesData)(nil),                   // 9: billing.GetInvoicesData
+	(*GetInvoicesByTenantResponse)(nil),       // 10: billing.GetInvoicesByTenantResponse
+	(*GetInvoicesBySubscriptionRequest)(nil),  // 11: billing.GetInvoicesBySubscriptionRequest
+	(*GetInvoicesBySubscriptionResponse)(nil), // 12: billing.GetInvoicesBySubscriptionResponse
+	(*FinalizeInvoiceRequest)(nil),            // 13: billing.FinalizeInvoiceRequest
+	(*FinalizeInvoiceResponse)(nil),           // 14: billing.FinalizeInvoiceResponse
+	(*MarkInvoicePaidRequest)(nil),            // 15: billing.MarkInvoicePaidRequest
+	(*MarkInvoicePaidResponse)(nil),           // 16: billing.MarkInvoicePaidResponse
+	(*VoidInvoiceRequest)(nil),                // 17: billing.VoidInvoiceRequest
+	(*VoidInvoiceResponse)(nil),               // 18: billing.VoidInvoiceResponse
+	(*PayInvoiceRequest)(nil),                 // 19: billing.PayInvoiceRequest
+	(*PayInvoiceResponse)(nil),                // 20: billing.PayInvoiceResponse
+}
+var file_billing_proto_depIdxs = []int32{
+	1,  // 0: billing.Invoice.line_items:type_name -> billing.InvoiceLineItem
+	0,  // 1: billing.GenerateInvoiceResponse.data:type_name -> billing.Invoice
+	0,  // 2: billing.GetInvoiceByIDResponse.data:type_name -> billing.Invoice
+	0,  // 3: billing.GetInvoiceByNumberResponse.data:type_name -> billing.Invoice
+	0,  // 4: billing.GetInvoicesData.invoices:type_name -> billing.Invoice
+	9,  // 5: billing.GetInvoicesByTenantResponse.data:type_name -> billing.GetInvoicesData
+	9,  // 6: billing.GetInvoicesBySubscriptionResponse.data:type_name -> billing.GetInvoicesData
+	0,  // 7: billing.FinalizeInvoiceResponse.data:type_name -> billing.Invoice
+	0,  // 8: billing.MarkInvoicePaidResponse.data:type_name -> billing.Invoice
+	0,  // 9: billing.VoidInvoiceResponse.data:type_name -> billing.Invoice
+	0,  // 10: billing.PayInvoiceResponse.data:type_name -> billing.Invoice
+	2,  // 11: billing.BillingService.GenerateInvoice:input_type -> billing.GenerateInvoiceRequest
+	4,  // 12: billing.BillingService.GetInvoiceByID:input_type -> billing.GetInvoiceByIDRequest
+	6,  // 13: billing.BillingService.GetInvoiceByNumber:input_type -> billing.GetInvoiceByNumberRequest
+	8,  // 14: billing.BillingService.GetInvoicesByTenant:input_type -> billing.GetInvoicesByTenantRequest
+	11, // 15: billing.BillingService.GetInvoicesBySubscription:input_type -> billing.GetInvoicesBySubscriptionRequest
+	13, // 16: billing.BillingService.FinalizeInvoice:input_type -> billing.FinalizeInvoiceRequest
+	15, // 17: billing.BillingService.MarkInvoicePaid:input_type -> billing.MarkInvoicePaidRequest
+	17, // 18: billing.BillingService.VoidInvoice:input_type -> billing.VoidInvoiceRequest
+	19, // 19: billing.BillingService.PayInvoice:input_type -> billing.PayInvoiceRequest
+	3,  // 20: billing.BillingService.GenerateInvoice:output_type -> billing.GenerateInvoiceResponse
+	5,  // 21: billing.BillingService.GetInvoiceByID:output_type -> billing.GetInvoiceByIDResponse
+	7,  // 22: billing.BillingService.GetInvoiceByNumber:output_type -> billing.GetInvoiceByNumberResponse
+	10, // 23: billing.BillingService.GetInvoicesByTenant:output_type -> billing.GetInvoicesByTenantResponse
+	12, // 24: billing.BillingService.GetInvoicesBySubscription:output_type -> billing.GetInvoicesBySubscriptionResponse
+	14, // 25: billing.BillingService.FinalizeInvoice:output_type -> billing.FinalizeInvoiceResponse
+	16, // 26: billing.BillingService.MarkInvoicePaid:output_type -> billing.MarkInvoicePaidResponse
+	18, // 27: billing.BillingService.VoidInvoice:output_type -> billing.VoidInvoiceResponse
+	20, // 28: billing.BillingService.PayInvoice:output_type -> billing.PayInvoiceResponse
+	20, // [20:29] is the sub-list for method output_type
+	11, // [11:20] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_billing_proto_init() }
+func file_billing_proto_init() {
+	if File_billing_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_billing_proto_rawDesc), len(file_billing_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   21,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_billing_proto_goTypes,
+		DependencyIndexes: file_billing_proto_depIdxs,
+		MessageInfos:      file_billing_proto_msgTypes,
+	}.Build()
+	File_billing_proto = out.File
+	file_billing_proto_goTypes = nil
+	file_billing_proto_depIdxs = nil
+}
@@ -0,0 +1,427 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v6.33.1
+// source: billing.proto
+
+package billing
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	BillingService_GenerateInvoice_FullMethodName           = "/billing.BillingService/GenerateInvoice"
+	BillingService_GetInvoiceByID_FullMethodName            = "/billing.BillingService/GetInvoiceByID"
+	BillingService_GetInvoiceByNumber_FullMethodName        = "/billing.BillingService/GetInvoiceByNumber"
+	BillingService_GetInvoicesByTenant_FullMethodName       = "/billing.BillingService/GetInvoicesByTenant"
+	BillingService_GetInvoicesBySubscription_FullMethodName = "/billing.BillingService/GetInvoicesBySubscription"
+	BillingService_FinalizeInvoice_FullMethodName           = "/billing.BillingService/FinalizeInvoice"
+	BillingService_MarkInvoicePaid_FullMethodName           = "/billing.BillingService/MarkInvoicePaid"
+	BillingService_VoidInvoice_FullMethodName               = "/billing.BillingService/VoidInvoice"
+	BillingService_PayInvoice_FullMethodName                = "/billing.BillingService/PayInvoice"
+)
+
+// BillingServiceClient is the client API for BillingService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BillingServiceClient interface {
+	// Invoice operations
+	GenerateInvoice(ctx context.Context, in *GenerateInvoiceRequest, opts ...grpc.CallOption) (*GenerateInvoiceResponse, error)
+	GetInvoiceByID(ctx context.Context, in *GetInvoiceByIDRequest, opts ...grpc.CallOption) (*GetInvoiceByIDResponse, error)
+	GetInvoiceByNumber(ctx context.Context, in *GetInvoiceByNumberRequest, opts ...grpc.CallOption) (*GetInvoiceByNumberResponse, error)
+	GetInvoicesByTenant(ctx context.Context, in *GetInvoicesByTenantRequest, opts ...grpc.CallOption) (*GetInvoicesByTenantResponse, error)
+	GetInvoicesBySubscription(ctx context.Context, in *GetInvoicesBySubscriptionRequest, opts ...grpc.CallOption) (*GetInvoicesBySubscriptionResponse, error)
+	FinalizeInvoice(ctx context.Context, in *FinalizeInvoiceRequest, opts ...grpc.CallOption) (*FinalizeInvoiceResponse, error)
+	MarkInvoicePaid(ctx context.Context, in *MarkInvoicePaidRequest, opts ...grpc.CallOption) (*MarkInvoicePaidResponse, error)
+	VoidInvoice(ctx context.Context, in *VoidInvoiceRequest, opts ...grpc.CallOption) (*VoidInvoiceResponse, error)
+	PayInvoice(ctx context.Context, in *PayInvoiceRequest, opts ...grpc.CallOption) (*PayInvoiceResponse, error)
+}
+
+type billingServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBillingServiceClient(cc grpc.ClientConnInterface) BillingServiceClient {
+	return &billingServiceClient{cc}
+}
+
+func (c *billingServiceClient) GenerateInvoice(ctx context.Context, in *GenerateInvoiceRequest, opts ...grpc.CallOption) (*GenerateInvoiceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenerateInvoiceResponse)
+	err := c.cc.Invoke(ctx, BillingService_GenerateInvoice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingServiceClient) GetInvoiceByID(ctx context.Context, in *GetInvoiceByIDRequest, opts ...grpc.CallOption) (*GetInvoiceByIDResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetInvoiceByIDResponse)
+	err := c.cc.Invoke(ctx, BillingService_GetInvoiceByID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingServiceClient) GetInvoiceByNumber(ctx context.Context, in *GetInvoiceByNumberRequest, opts ...grpc.CallOption) (*GetInvoiceByNumberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetInvoiceByNumberResponse)
+	err := c.cc.Invoke(ctx, BillingService_GetInvoiceByNumber_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingServiceClient) GetInvoicesByTenant(ctx context.Context, in *GetInvoicesByTenantRequest, opts ...grpc.CallOption) (*GetInvoicesByTenantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetInvoicesByTenantResponse)
+	err := c.cc.Invoke(ctx, BillingService_GetInvoicesByTenant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingServiceClient) GetInvoicesBySubscription(ctx context.Context, in *GetInvoicesBySubscriptionRequest, opts ...grpc.CallOption) (*GetInvoicesBySubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetInvoicesBySubscriptionResponse)
+	err := c.cc.Invoke(ctx, BillingService_GetInvoicesBySubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingServiceClient) FinalizeInvoice(ctx context.Context, in *FinalizeInvoiceRequest, opts ...grpc.CallOption) (*FinalizeInvoiceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FinalizeInvoiceResponse)
+	err := c.cc.Invoke(ctx, BillingService_FinalizeInvoice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingServiceClient) MarkInvoicePaid(ctx context.Context, in *MarkInvoicePaidRequest, opts ...grpc.CallOption) (*MarkInvoicePaidResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarkInvoicePaidResponse)
+	err := c.cc.Invoke(ctx, BillingService_MarkInvoicePaid_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingServiceClient) VoidInvoice(ctx context.Context, in *VoidInvoiceRequest, opts ...grpc.CallOption) (*VoidInvoiceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VoidInvoiceResponse)
+	err := c.cc.Invoke(ctx, BillingService_VoidInvoice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingServiceClient) PayInvoice(ctx context.Context, in *PayInvoiceRequest, opts ...grpc.CallOption) (*PayInvoiceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PayInvoiceResponse)
+	err := c.cc.Invoke(ctx, BillingService_PayInvoice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BillingServiceServer is the server API for BillingService service.
+// All implementations must embed UnimplementedBillingServiceServer
+// for forward compatibility.
+type BillingServiceServer interface {
+	// Invoice operations
+	GenerateInvoice(context.Context, *GenerateInvoiceRequest) (*GenerateInvoiceResponse, error)
+	GetInvoiceByID(context.Context, *GetInvoiceByIDRequest) (*GetInvoiceByIDResponse, error)
+	GetInvoiceByNumber(context.Context, *GetInvoiceByNumberRequest) (*GetInvoiceByNumberResponse, error)
+	GetInvoicesByTenant(context.Context, *GetInvoicesByTenantRequest) (*GetInvoicesByTenantResponse, error)
+	GetInvoicesBySubscription(context.Context, *GetInvoicesBySubscriptionRequest) (*GetInvoicesBySubscriptionResponse, error)
+	FinalizeInvoice(context.Context, *FinalizeInvoiceRequest) (*FinalizeInvoiceResponse, error)
+	MarkInvoicePaid(context.Context, *MarkInvoicePaidRequest) (*MarkInvoicePaidResponse, error)
+	VoidInvoice(context.Context, *VoidInvoiceRequest) (*VoidInvoiceResponse, error)
+	PayInvoice(context.Context, *PayInvoiceRequest) (*PayInvoiceResponse, error)
+	mustEmbedUnimplementedBillingServiceServer()
+}
+
+// UnimplementedBillingServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedBillingServiceServer struct{}
+
+func (UnimplementedBillingServiceServer) GenerateInvoice(context.Context, *GenerateInvoiceRequest) (*GenerateInvoiceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GenerateInvoice not implemented")
+}
+func (UnimplementedBillingServiceServer) GetInvoiceByID(context.Context, *GetInvoiceByIDRequest) (*GetInvoiceByIDResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetInvoiceByID not implemented")
+}
+func (UnimplementedBillingServiceServer) GetInvoiceByNumber(context.Context, *GetInvoiceByNumberRequest) (*GetInvoiceByNumberResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetInvoiceByNumber not implemented")
+}
+func (UnimplementedBillingServiceServer) GetInvoicesByTenant(context.Context, *GetInvoicesByTenantRequest) (*GetInvoicesByTenantResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetInvoicesByTenant not implemented")
+}
+func (UnimplementedBillingServiceServer) GetInvoicesBySubscription(context.Context, *GetInvoicesBySubscriptionRequest) (*GetInvoicesBySubscriptionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetInvoicesBySubscription not implemented")
+}
+func (UnimplementedBillingServiceServer) FinalizeInvoice(context.Context, *FinalizeInvoiceRequest) (*FinalizeInvoiceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method FinalizeInvoice not implemented")
+}
+func (UnimplementedBillingServiceServer) MarkInvoicePaid(context.Context, *MarkInvoicePaidRequest) (*MarkInvoicePaidResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MarkInvoicePaid not implemented")
+}
+func (UnimplementedBillingServiceServer) VoidInvoice(context.Context, *VoidInvoiceRequest) (*VoidInvoiceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method VoidInvoice not implemented")
+}
+func (UnimplementedBillingServiceServer) PayInvoice(context.Context, *PayInvoiceRequest) (*PayInvoiceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PayInvoice not implemented")
+}
+func (UnimplementedBillingServiceServer) mustEmbedUnimplementedBillingServiceServer() {}
+func (UnimplementedBillingServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeBillingServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BillingServiceServer will
+// result in compilation errors.
+type UnsafeBillingServiceServer interface {
+	mustEmbedUnimplementedBillingServiceServer()
+}
+
+func RegisterBillingServiceServer(s grpc.ServiceRegistrar, srv BillingServiceServer) {
+	// If the following call panics, it indicates UnimplementedBillingServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&BillingService_ServiceDesc, srv)
+}
+
+func _BillingService_GenerateInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillingServiceServer).GenerateInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BillingService_GenerateInvoice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillingServiceServer).GenerateInvoice(ctx, req.(*GenerateInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BillingService_GetInvoiceByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInvoiceByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillingServiceServer).GetInvoiceByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BillingService_GetInvoiceByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillingServiceServer).GetInvoiceByID(ctx, req.(*GetInvoiceByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BillingService_GetInvoiceByNumber_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInvoiceByNumberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillingServiceServer).GetInvoiceByNumber(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BillingService_GetInvoiceByNumber_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillingServiceServer).GetInvoiceByNumber(ctx, req.(*GetInvoiceByNumberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BillingService_GetInvoicesByTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInvoicesByTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillingServiceServer).GetInvoicesByTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BillingService_GetInvoicesByTenant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillingServiceServer).GetInvoicesByTenant(ctx, req.(*GetInvoicesByTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BillingService_GetInvoicesBySubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInvoicesBySubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillingServiceServer).GetInvoicesBySubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BillingService_GetInvoicesBySubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillingServiceServer).GetInvoicesBySubscription(ctx, req.(*GetInvoicesBySubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BillingService_FinalizeInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinalizeInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillingServiceServer).FinalizeInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BillingService_FinalizeInvoice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillingServiceServer).FinalizeInvoice(ctx, req.(*FinalizeInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BillingService_MarkInvoicePaid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkInvoicePaidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillingServiceServer).MarkInvoicePaid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BillingService_MarkInvoicePaid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillingServiceServer).MarkInvoicePaid(ctx, req.(*MarkInvoicePaidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BillingService_VoidInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoidInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillingServiceServer).VoidInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BillingService_VoidInvoice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillingServiceServer).VoidInvoice(ctx, req.(*VoidInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BillingService_PayInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PayInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillingServiceServer).PayInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BillingService_PayInvoice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillingServiceServer).PayInvoice(ctx, req.(*PayInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BillingService_ServiceDesc is the grpc.ServiceDesc for BillingService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BillingService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "billing.BillingService",
+	HandlerType: (*BillingServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GenerateInvoice",
+			Handler:    _BillingService_GenerateInvoice_Handler,
+		},
+		{
+			MethodName: "GetInvoiceByID",
+			Handler:    _BillingService_GetInvoiceByID_Handler,
+		},
+		{
+			MethodName: "GetInvoiceByNumber",
+			Handler:    _BillingService_GetInvoiceByNumber_Handler,
+		},
+		{
+			MethodName: "GetInvoicesByTenant",
+			Handler:    _BillingService_GetInvoicesByTenant_Handler,
+		},
+		{
+			MethodName: "GetInvoicesBySubscription",
+			Handler:    _BillingService_GetInvoicesBySubscription_Handler,
+		},
+		{
+			MethodName: "FinalizeInvoice",
+			Handler:    _BillingService_FinalizeInvoice_Handler,
+		},
+		{
+			MethodName: "MarkInvoicePaid",
+			Handler:    _BillingService_MarkInvoicePaid_Handler,
+		},
+		{
+			MethodName: "VoidInvoice",
+			Handler:    _BillingService_VoidInvoice_Handler,
+		},
+		{
+			MethodName: "PayInvoice",
+			Handler:    _BillingService_PayInvoice_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "billing.proto",
+}
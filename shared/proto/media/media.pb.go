@@ -885,6 +885,119 @@ func (x *DeleteFileResponse) GetMessage() string {
 	return ""
 }
 
+// DeleteFilesByModel deletes every file attached to a model, in all collections
+type DeleteFilesByModelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ModelType     string                 `protobuf:"bytes,1,opt,name=model_type,json=modelType,proto3" json:"model_type,omitempty"`
+	ModelId       int64                  `protobuf:"varint,2,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFilesByModelRequest) Reset() {
+	*x = DeleteFilesByModelRequest{}
+	mi := &file_media_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFilesByModelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFilesByModelRequest) ProtoMessage() {}
+
+func (x *DeleteFilesByModelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_media_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFilesByModelRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFilesByModelRequest) Descriptor() ([]byte, []int) {
+	return file_media_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeleteFilesByModelRequest) GetModelType() string {
+	if x != nil {
+		return x.ModelType
+	}
+	return ""
+}
+
+func (x *DeleteFilesByModelRequest) GetModelId() int64 {
+	if x != nil {
+		return x.ModelId
+	}
+	return 0
+}
+
+type DeleteFilesByModelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Deleted       int64                  `protobuf:"varint,3,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFilesByModelResponse) Reset() {
+	*x = DeleteFilesByModelResponse{}
+	mi := &file_media_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFilesByModelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFilesByModelResponse) ProtoMessage() {}
+
+func (x *DeleteFilesByModelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_media_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFilesByModelResponse.ProtoReflect.Descriptor instead.
+func (*DeleteFilesByModelResponse) Descriptor() ([]byte, []int) {
+	return file_media_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeleteFilesByModelResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteFilesByModelResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DeleteFilesByModelResponse) GetDeleted() int64 {
+	if x != nil {
+		return x.Deleted
+	}
+	return 0
+}
+
 type GetFileURLRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -895,7 +1008,7 @@ type GetFileURLRequest struct {
 
 func (x *GetFileURLRequest) Reset() {
 	*x = GetFileURLRequest{}
-	mi := &file_media_proto_msgTypes[12]
+	mi := &file_media_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -907,7 +1020,7 @@ func (x *GetFileURLRequest) String() string {
 func (*GetFileURLRequest) ProtoMessage() {}
 
 func (x *GetFileURLRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_media_proto_msgTypes[12]
+	mi := &file_media_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -920,7 +1033,7 @@ func (x *GetFileURLRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFileURLRequest.ProtoReflect.Descriptor instead.
 func (*GetFileURLRequest) Descriptor() ([]byte, []int) {
-	return file_media_proto_rawDescGZIP(), []int{12}
+	return file_media_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetFileURLRequest) GetId() int64 {
@@ -948,7 +1061,7 @@ type GetFileURLResponse struct {
 
 func (x *GetFileURLResponse) Reset() {
 	*x = GetFileURLResponse{}
-	mi := &file_media_proto_msgTypes[13]
+	mi := &file_media_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -960,7 +1073,7 @@ func (x *GetFileURLResponse) String() string {
 func (*GetFileURLResponse) ProtoMessage() {}
 
 func (x *GetFileURLResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_media_proto_msgTypes[13]
+	mi := &file_media_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -973,7 +1086,7 @@ func (x *GetFileURLResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFileURLResponse.ProtoReflect.Descriptor instead.
 func (*GetFileURLResponse) Descriptor() ([]byte, []int) {
-	return file_media_proto_rawDescGZIP(), []int{13}
+	return file_media_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GetFileURLResponse) GetSuccess() bool {
@@ -1007,7 +1120,7 @@ type GetFileURLData struct {
 
 func (x *GetFileURLData) Reset() {
 	*x = GetFileURLData{}
-	mi := &file_media_proto_msgTypes[14]
+	mi := &file_media_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1019,7 +1132,7 @@ func (x *GetFileURLData) String() string {
 func (*GetFileURLData) ProtoMessage() {}
 
 func (x *GetFileURLData) ProtoReflect() protoreflect.Message {
-	mi := &file_media_proto_msgTypes[14]
+	mi := &file_media_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1032,7 +1145,7 @@ func (x *GetFileURLData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFileURLData.ProtoReflect.Descriptor instead.
 func (*GetFileURLData) Descriptor() ([]byte, []int) {
-	return file_media_proto_rawDescGZIP(), []int{14}
+	return file_media_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GetFileURLData) GetUrl() string {
@@ -1061,7 +1174,7 @@ type GetAllMediaRequest struct {
 
 func (x *GetAllMediaRequest) Reset() {
 	*x = GetAllMediaRequest{}
-	mi := &file_media_proto_msgTypes[15]
+	mi := &file_media_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1073,7 +1186,7 @@ func (x *GetAllMediaRequest) String() string {
 func (*GetAllMediaRequest) ProtoMessage() {}
 
 func (x *GetAllMediaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_media_proto_msgTypes[15]
+	mi := &file_media_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1086,7 +1199,7 @@ func (x *GetAllMediaRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllMediaRequest.ProtoReflect.Descriptor instead.
 func (*GetAllMediaRequest) Descriptor() ([]byte, []int) {
-	return file_media_proto_rawDescGZIP(), []int{15}
+	return file_media_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *GetAllMediaRequest) GetPage() int32 {
@@ -1128,7 +1241,7 @@ type GetAllMediaResponse struct {
 
 func (x *GetAllMediaResponse) Reset() {
 	*x = GetAllMediaResponse{}
-	mi := &file_media_proto_msgTypes[16]
+	mi := &file_media_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1140,7 +1253,7 @@ func (x *GetAllMediaResponse) String() string {
 func (*GetAllMediaResponse) ProtoMessage() {}
 
 func (x *GetAllMediaResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_media_proto_msgTypes[16]
+	mi := &file_media_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1153,7 +1266,7 @@ func (x *GetAllMediaResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllMediaResponse.ProtoReflect.Descriptor instead.
 func (*GetAllMediaResponse) Descriptor() ([]byte, []int) {
-	return file_media_proto_rawDescGZIP(), []int{16}
+	return file_media_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *GetAllMediaResponse) GetSuccess() bool {
@@ -1189,7 +1302,7 @@ type GetAllMediaData struct {
 
 func (x *GetAllMediaData) Reset() {
 	*x = GetAllMediaData{}
-	mi := &file_media_proto_msgTypes[17]
+	mi := &file_media_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1201,7 +1314,7 @@ func (x *GetAllMediaData) String() string {
 func (*GetAllMediaData) ProtoMessage() {}
 
 func (x *GetAllMediaData) ProtoReflect() protoreflect.Message {
-	mi := &file_media_proto_msgTypes[17]
+	mi := &file_media_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1214,7 +1327,7 @@ func (x *GetAllMediaData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllMediaData.ProtoReflect.Descriptor instead.
 func (*GetAllMediaData) Descriptor() ([]byte, []int) {
-	return file_media_proto_rawDescGZIP(), []int{17}
+	return file_media_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *GetAllMediaData) GetMedia() []*Media {
@@ -1321,7 +1434,15 @@ const file_media_proto_rawDesc = "" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\"H\n" +
 	"\x12DeleteFileResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"J\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"U\n" +
+	"\x19DeleteFilesByModelRequest\x12\x1d\n" +
+	"\n" +
+	"model_type\x18\x01 \x01(\tR\tmodelType\x12\x19\n" +
+	"\bmodel_id\x18\x02 \x01(\x03R\amodelId\"j\n" +
+	"\x1aDeleteFilesByModelResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\adeleted\x18\x03 \x01(\x03R\adeleted\"J\n" +
 	"\x11GetFileURLRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12%\n" +
 	"\x0eexpiry_seconds\x18\x02 \x01(\x05R\rexpirySeconds\"s\n" +
@@ -1347,7 +1468,7 @@ const file_media_proto_rawDesc = "" +
 	"\x05media\x18\x01 \x03(\v2\f.media.MediaR\x05media\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x19\n" +
-	"\bper_page\x18\x04 \x01(\x05R\aperPage2\x8f\x04\n" +
+	"\bper_page\x18\x04 \x01(\x05R\aperPage2\xec\x04\n" +
 	"\fMediaService\x12C\n" +
 	"\n" +
 	"UploadFile\x12\x18.media.UploadFileRequest\x1a\x19.media.UploadFileResponse\"\x00\x12F\n" +
@@ -1355,7 +1476,8 @@ const file_media_proto_rawDesc = "" +
 	"\rGetFileByUUID\x12\x1b.media.GetFileByUUIDRequest\x1a\x1c.media.GetFileByUUIDResponse\"\x00\x12R\n" +
 	"\x0fGetFilesByModel\x12\x1d.media.GetFilesByModelRequest\x1a\x1e.media.GetFilesByModelResponse\"\x00\x12C\n" +
 	"\n" +
-	"DeleteFile\x12\x18.media.DeleteFileRequest\x1a\x19.media.DeleteFileResponse\"\x00\x12C\n" +
+	"DeleteFile\x12\x18.media.DeleteFileRequest\x1a\x19.media.DeleteFileResponse\"\x00\x12[\n" +
+	"\x12DeleteFilesByModel\x12 .media.DeleteFilesByModelRequest\x1a!.media.DeleteFilesByModelResponse\"\x00\x12C\n" +
 	"\n" +
 	"GetFileURL\x12\x18.media.GetFileURLRequest\x1a\x19.media.GetFileURLResponse\"\x00\x12F\n" +
 	"\vGetAllMedia\x12\x19.media.GetAllMediaRequest\x1a\x1a.media.GetAllMediaResponse\"\x00B\x1aZ\x18shared/proto/media;mediab\x06proto3"
@@ -1372,26 +1494,28 @@ func file_media_proto_rawDescGZIP() []byte {
 	return file_media_proto_rawDescData
 }
 
-var file_media_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_media_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_media_proto_goTypes = []any{
-	(*Media)(nil),                   // 0: media.Media
-	(*UploadFileRequest)(nil),       // 1: media.UploadFileRequest
-	(*UploadFileResponse)(nil),      // 2: media.UploadFileResponse
-	(*GetFileByIDRequest)(nil),      // 3: media.GetFileByIDRequest
-	(*GetFileByIDResponse)(nil),     // 4: media.GetFileByIDResponse
-	(*GetFileByUUIDRequest)(nil),    // 5: media.GetFileByUUIDRequest
-	(*GetFileByUUIDResponse)(nil),   // 6: media.GetFileByUUIDResponse
-	(*GetFilesByModelRequest)(nil),  // 7: media.GetFilesByModelRequest
-	(*GetFilesByModelResponse)(nil), // 8: media.GetFilesByModelResponse
-	(*GetFilesByModelData)(nil),     // 9: media.GetFilesByModelData
-	(*DeleteFileRequest)(nil),       // 10: media.DeleteFileRequest
-	(*DeleteFileResponse)(nil),      // 11: media.DeleteFileResponse
-	(*GetFileURLRequest)(nil),       // 12: media.GetFileURLRequest
-	(*GetFileURLResponse)(nil),      // 13: media.GetFileURLResponse
-	(*GetFileURLData)(nil),          // 14: media.GetFileURLData
-	(*GetAllMediaRequest)(nil),      // 15: media.GetAllMediaRequest
-	(*GetAllMediaResponse)(nil),     // 16: media.GetAllMediaResponse
-	(*GetAllMediaData)(nil),         // 17: media.GetAllMediaData
+	(*Media)(nil),                      // 0: media.Media
+	(*UploadFileRequest)(nil),          // 1: media.UploadFileRequest
+	(*UploadFileResponse)(nil),         // 2: media.UploadFileResponse
+	(*GetFileByIDRequest)(nil),         // 3: media.GetFileByIDRequest
+	(*GetFileByIDResponse)(nil),        // 4: media.GetFileByIDResponse
+	(*GetFileByUUIDRequest)(nil),       // 5: media.GetFileByUUIDRequest
+	(*GetFileByUUIDResponse)(nil),      // 6: media.GetFileByUUIDResponse
+	(*GetFilesByModelRequest)(nil),     // 7: media.GetFilesByModelRequest
+	(*GetFilesByModelResponse)(nil),    // 8: media.GetFilesByModelResponse
+	(*GetFilesByModelData)(nil),        // 9: media.GetFilesByModelData
+	(*DeleteFileRequest)(nil),          // 10: media.DeleteFileRequest
+	(*DeleteFileResponse)(nil),         // 11: media.DeleteFileResponse
+	(*DeleteFilesByModelRequest)(nil),  // 12: media.DeleteFilesByModelRequest
+	(*DeleteFilesByModelResponse)(nil), // 13: media.DeleteFilesByModelResponse
+	(*GetFileURLRequest)(nil),          // 14: media.GetFileURLRequest
+	(*GetFileURLResponse)(nil),         // 15: media.GetFileURLResponse
+	(*GetFileURLData)(nil),             // 16: media.GetFileURLData
+	(*GetAllMediaRequest)(nil),         // 17: media.GetAllMediaRequest
+	(*GetAllMediaResponse)(nil),        // 18: media.GetAllMediaResponse
+	(*GetAllMediaData)(nil),            // 19: media.GetAllMediaData
 }
 var file_media_proto_depIdxs = []int32{
 	0,  // 0: media.UploadFileResponse.data:type_name -> media.Media
@@ -1399,25 +1523,27 @@ var file_media_proto_depIdxs = []int32{
 	0,  // 2: media.GetFileByUUIDResponse.data:type_name -> media.Media
 	9,  // 3: media.GetFilesByModelResponse.data:type_name -> media.GetFilesByModelData
 	0,  // 4: media.GetFilesByModelData.media:type_name -> media.Media
-	14, // 5: media.GetFileURLResponse.data:type_name -> media.GetFileURLData
-	17, // 6: media.GetAllMediaResponse.data:type_name -> media.GetAllMediaData
+	16, // 5: media.GetFileURLResponse.data:type_name -> media.GetFileURLData
+	19, // 6: media.GetAllMediaResponse.data:type_name -> media.GetAllMediaData
 	0,  // 7: media.GetAllMediaData.media:type_name -> media.Media
 	1,  // 8: media.MediaService.UploadFile:input_type -> media.UploadFileRequest
 	3,  // 9: media.MediaService.GetFileByID:input_type -> media.GetFileByIDRequest
 	5,  // 10: media.MediaService.GetFileByUUID:input_type -> media.GetFileByUUIDRequest
 	7,  // 11: media.MediaService.GetFilesByModel:input_type -> media.GetFilesByModelRequest
 	10, // 12: media.MediaService.DeleteFile:input_type -> media.DeleteFileRequest
-	12, // 13: media.MediaService.GetFileURL:input_type -> media.GetFileURLRequest
-	15, // 14: media.MediaService.GetAllMedia:input_type -> media.GetAllMediaRequest
-	2,  // 15: media.MediaService.UploadFile:output_type -> media.UploadFileResponse
-	4,  // 16: media.MediaService.GetFileByID:output_type -> media.GetFileByIDResponse
-	6,  // 17: media.MediaService.GetFileByUUID:output_type -> media.GetFileByUUIDResponse
-	8,  // 18: media.MediaService.GetFilesByModel:output_type -> media.GetFilesByModelResponse
-	11, // 19: media.MediaService.DeleteFile:output_type -> media.DeleteFileResponse
-	13, // 20: media.MediaService.GetFileURL:output_type -> media.GetFileURLResponse
-	16, // 21: media.MediaService.GetAllMedia:output_type -> media.GetAllMediaResponse
-	15, // [15:22] is the sub-list for method output_type
-	8,  // [8:15] is the sub-list for method input_type
+	12, // 13: media.MediaService.DeleteFilesByModel:input_type -> media.DeleteFilesByModelRequest
+	14, // 14: media.MediaService.GetFileURL:input_type -> media.GetFileURLRequest
+	17, // 15: media.MediaService.GetAllMedia:input_type -> media.GetAllMediaRequest
+	2,  // 16: media.MediaService.UploadFile:output_type -> media.UploadFileResponse
+	4,  // 17: media.MediaService.GetFileByID:output_type -> media.GetFileByIDResponse
+	6,  // 18: media.MediaService.GetFileByUUID:output_type -> media.GetFileByUUIDResponse
+	8,  // 19: media.MediaService.GetFilesByModel:output_type -> media.GetFilesByModelResponse
+	11, // 20: media.MediaService.DeleteFile:output_type -> media.DeleteFileResponse
+	13, // 21: media.MediaService.DeleteFilesByModel:output_type -> media.DeleteFilesByModelResponse
+	15, // 22: media.MediaService.GetFileURL:output_type -> media.GetFileURLResponse
+	18, // 23: media.MediaService.GetAllMedia:output_type -> media.GetAllMediaResponse
+	16, // [16:24] is the sub-list for method output_type
+	8,  // [8:16] is the sub-list for method input_type
 	8,  // [8:8] is the sub-list for extension type_name
 	8,  // [8:8] is the sub-list for extension extendee
 	0,  // [0:8] is the sub-list for field type_name
@@ -1434,7 +1560,7 @@ func file_media_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_media_proto_rawDesc), len(file_media_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   18,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,13 +19,14 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	MediaService_UploadFile_FullMethodName      = "/media.MediaService/UploadFile"
-	MediaService_GetFileByID_FullMethodName     = "/media.MediaService/GetFileByID"
-	MediaService_GetFileByUUID_FullMethodName   = "/media.MediaService/GetFileByUUID"
-	MediaService_GetFilesByModel_FullMethodName = "/media.MediaService/GetFilesByModel"
-	MediaService_DeleteFile_FullMethodName      = "/media.MediaService/DeleteFile"
-	MediaService_GetFileURL_FullMethodName      = "/media.MediaService/GetFileURL"
-	MediaService_GetAllMedia_FullMethodName     = "/media.MediaService/GetAllMedia"
+	MediaService_UploadFile_FullMethodName         = "/media.MediaService/UploadFile"
+	MediaService_GetFileByID_FullMethodName        = "/media.MediaService/GetFileByID"
+	MediaService_GetFileByUUID_FullMethodName      = "/media.MediaService/GetFileByUUID"
+	MediaService_GetFilesByModel_FullMethodName    = "/media.MediaService/GetFilesByModel"
+	MediaService_DeleteFile_FullMethodName         = "/media.MediaService/DeleteFile"
+	MediaService_DeleteFilesByModel_FullMethodName = "/media.MediaService/DeleteFilesByModel"
+	MediaService_GetFileURL_FullMethodName         = "/media.MediaService/GetFileURL"
+	MediaService_GetAllMedia_FullMethodName        = "/media.MediaService/GetAllMedia"
 )
 
 // MediaServiceClient is the client API for MediaService service.
@@ -37,6 +38,7 @@ type MediaServiceClient interface {
 	GetFileByUUID(ctx context.Context, in *GetFileByUUIDRequest, opts ...grpc.CallOption) (*GetFileByUUIDResponse, error)
 	GetFilesByModel(ctx context.Context, in *GetFilesByModelRequest, opts ...grpc.CallOption) (*GetFilesByModelResponse, error)
 	DeleteFile(ctx context.Context, in *DeleteFileRequest, opts ...grpc.CallOption) (*DeleteFileResponse, error)
+	DeleteFilesByModel(ctx context.Context, in *DeleteFilesByModelRequest, opts ...grpc.CallOption) (*DeleteFilesByModelResponse, error)
 	GetFileURL(ctx context.Context, in *GetFileURLRequest, opts ...grpc.CallOption) (*GetFileURLResponse, error)
 	GetAllMedia(ctx context.Context, in *GetAllMediaRequest, opts ...grpc.CallOption) (*GetAllMediaResponse, error)
 }
@@ -99,6 +101,16 @@ func (c *mediaServiceClient) DeleteFile(ctx context.Context, in *DeleteFileReque
 	return out, nil
 }
 
+func (c *mediaServiceClient) DeleteFilesByModel(ctx context.Context, in *DeleteFilesByModelRequest, opts ...grpc.CallOption) (*DeleteFilesByModelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteFilesByModelResponse)
+	err := c.cc.Invoke(ctx, MediaService_DeleteFilesByModel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mediaServiceClient) GetFileURL(ctx context.Context, in *GetFileURLRequest, opts ...grpc.CallOption) (*GetFileURLResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetFileURLResponse)
@@ -128,6 +140,7 @@ type MediaServiceServer interface {
 	GetFileByUUID(context.Context, *GetFileByUUIDRequest) (*GetFileByUUIDResponse, error)
 	GetFilesByModel(context.Context, *GetFilesByModelRequest) (*GetFilesByModelResponse, error)
 	DeleteFile(context.Context, *DeleteFileRequest) (*DeleteFileResponse, error)
+	DeleteFilesByModel(context.Context, *DeleteFilesByModelRequest) (*DeleteFilesByModelResponse, error)
 	GetFileURL(context.Context, *GetFileURLRequest) (*GetFileURLResponse, error)
 	GetAllMedia(context.Context, *GetAllMediaRequest) (*GetAllMediaResponse, error)
 	mustEmbedUnimplementedMediaServiceServer()
@@ -155,6 +168,9 @@ func (UnimplementedMediaServiceServer) GetFilesByModel(context.Context, *GetFile
 func (UnimplementedMediaServiceServer) DeleteFile(context.Context, *DeleteFileRequest) (*DeleteFileResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteFile not implemented")
 }
+func (UnimplementedMediaServiceServer) DeleteFilesByModel(context.Context, *DeleteFilesByModelRequest) (*DeleteFilesByModelResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteFilesByModel not implemented")
+}
 func (UnimplementedMediaServiceServer) GetFileURL(context.Context, *GetFileURLRequest) (*GetFileURLResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetFileURL not implemented")
 }
@@ -272,6 +288,24 @@ func _MediaService_DeleteFile_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MediaService_DeleteFilesByModel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFilesByModelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MediaServiceServer).DeleteFilesByModel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MediaService_DeleteFilesByModel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MediaServiceServer).DeleteFilesByModel(ctx, req.(*DeleteFilesByModelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MediaService_GetFileURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetFileURLRequest)
 	if err := dec(in); err != nil {
@@ -335,6 +369,10 @@ var MediaService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteFile",
 			Handler:    _MediaService_DeleteFile_Handler,
 		},
+		{
+			MethodName: "DeleteFilesByModel",
+			Handler:    _MediaService_DeleteFilesByModel_Handler,
+		},
 		{
 			MethodName: "GetFileURL",
 			Handler:    _MediaService_GetFileURL_Handler,
@@ -2174,18 +2174,19 @@ func (x *GetAllPlansResponse) GetData() *GetAllPlansData {
 }
 
 type PlanPrice struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	PlanId        int64                  `protobuf:"varint,2,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
-	CurrencyId    int64                  `protobuf:"varint,3,opt,name=currency_id,json=currencyId,proto3" json:"currency_id,omitempty"`
-	Price         int32                  `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
-	PricePerUnit  int32                  `protobuf:"varint,5,opt,name=price_per_unit,json=pricePerUnit,proto3" json:"price_per_unit,omitempty"`
-	Type          string                 `protobuf:"bytes,6,opt,name=type,proto3" json:"type,omitempty"`   // flat_rate, per_unit, tiered
-	Tiers         string                 `protobuf:"bytes,7,opt,name=tiers,proto3" json:"tiers,omitempty"` // JSON string
-	CreatedAt     int64                  `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     int64                  `protobuf:"varint,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PlanId             int64                  `protobuf:"varint,2,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	CurrencyId         int64                  `protobuf:"varint,3,opt,name=currency_id,json=currencyId,proto3" json:"currency_id,omitempty"`
+	Price              int32                  `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
+	PricePerUnit       int32                  `protobuf:"varint,5,opt,name=price_per_unit,json=pricePerUnit,proto3" json:"price_per_unit,omitempty"`
+	Type               string                 `protobuf:"bytes,6,opt,name=type,proto3" json:"type,omitempty"`   // flat_rate, per_unit, tiered
+	Tiers              string                 `protobuf:"bytes,7,opt,name=tiers,proto3" json:"tiers,omitempty"` // JSON string: {"mode": "graduated|volume", "tiers": [{"up_to": 10, "unit_price": 100, "flat_price": 0}, {"up_to": null, ...}]}
+	CreatedAt          int64                  `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt          int64                  `protobuf:"varint,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	DerivedFromPriceId int64                  `protobuf:"varint,10,opt,name=derived_from_price_id,json=derivedFromPriceId,proto3" json:"derived_from_price_id,omitempty"` // 0 for prices set by hand
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *PlanPrice) Reset() {
@@ -2281,6 +2282,13 @@ func (x *PlanPrice) GetUpdatedAt() int64 {
 	return 0
 }
 
+func (x *PlanPrice) GetDerivedFromPriceId() int64 {
+	if x != nil {
+		return x.DerivedFromPriceId
+	}
+	return 0
+}
+
 type GetPlanPriceRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -2865,19 +2873,300 @@ func (x *DeletePlanPriceResponse) GetMessage() string {
 	return ""
 }
 
+type QuotePriceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlanPriceId   int64                  `protobuf:"varint,1,opt,name=plan_price_id,json=planPriceId,proto3" json:"plan_price_id,omitempty"`
+	Quantity      int64                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"` // quantities below 1 are quoted as 1
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuotePriceRequest) Reset() {
+	*x = QuotePriceRequest{}
+	mi := &file_product_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuotePriceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotePriceRequest) ProtoMessage() {}
+
+func (x *QuotePriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuotePriceRequest.ProtoReflect.Descriptor instead.
+func (*QuotePriceRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *QuotePriceRequest) GetPlanPriceId() int64 {
+	if x != nil {
+		return x.PlanPriceId
+	}
+	return 0
+}
+
+func (x *QuotePriceRequest) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type PriceQuoteLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tier          int32                  `protobuf:"varint,1,opt,name=tier,proto3" json:"tier,omitempty"` // 1-based tier index, 0 for flat_rate and per_unit prices
+	Quantity      int64                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UnitPrice     int64                  `protobuf:"varint,3,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+	FlatPrice     int64                  `protobuf:"varint,4,opt,name=flat_price,json=flatPrice,proto3" json:"flat_price,omitempty"`
+	Amount        int64                  `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceQuoteLine) Reset() {
+	*x = PriceQuoteLine{}
+	mi := &file_product_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceQuoteLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceQuoteLine) ProtoMessage() {}
+
+func (x *PriceQuoteLine) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceQuoteLine.ProtoReflect.Descriptor instead.
+func (*PriceQuoteLine) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *PriceQuoteLine) GetTier() int32 {
+	if x != nil {
+		return x.Tier
+	}
+	return 0
+}
+
+func (x *PriceQuoteLine) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *PriceQuoteLine) GetUnitPrice() int64 {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return 0
+}
+
+func (x *PriceQuoteLine) GetFlatPrice() int64 {
+	if x != nil {
+		return x.FlatPrice
+	}
+	return 0
+}
+
+func (x *PriceQuoteLine) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type PriceQuote struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlanPriceId   int64                  `protobuf:"varint,1,opt,name=plan_price_id,json=planPriceId,proto3" json:"plan_price_id,omitempty"`
+	CurrencyId    int64                  `protobuf:"varint,2,opt,name=currency_id,json=currencyId,proto3" json:"currency_id,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	TierMode      string                 `protobuf:"bytes,4,opt,name=tier_mode,json=tierMode,proto3" json:"tier_mode,omitempty"` // graduated, volume; empty unless tiered
+	Quantity      int64                  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Amount        int64                  `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"` // in the currency's minor unit
+	Lines         []*PriceQuoteLine      `protobuf:"bytes,7,rep,name=lines,proto3" json:"lines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceQuote) Reset() {
+	*x = PriceQuote{}
+	mi := &file_product_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceQuote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceQuote) ProtoMessage() {}
+
+func (x *PriceQuote) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceQuote.ProtoReflect.Descriptor instead.
+func (*PriceQuote) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *PriceQuote) GetPlanPriceId() int64 {
+	if x != nil {
+		return x.PlanPriceId
+	}
+	return 0
+}
+
+func (x *PriceQuote) GetCurrencyId() int64 {
+	if x != nil {
+		return x.CurrencyId
+	}
+	return 0
+}
+
+func (x *PriceQuote) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PriceQuote) GetTierMode() string {
+	if x != nil {
+		return x.TierMode
+	}
+	return ""
+}
+
+func (x *PriceQuote) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *PriceQuote) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *PriceQuote) GetLines() []*PriceQuoteLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+type QuotePriceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *PriceQuote            `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuotePriceResponse) Reset() {
+	*x = QuotePriceResponse{}
+	mi := &file_product_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuotePriceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotePriceResponse) ProtoMessage() {}
+
+func (x *QuotePriceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuotePriceResponse.ProtoReflect.Descriptor instead.
+func (*QuotePriceResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *QuotePriceResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *QuotePriceResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *QuotePriceResponse) GetData() *PriceQuote {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
 type PlanMeter struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	CreatedAt     int64                  `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     int64                  `protobuf:"varint,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Aggregation   string                 `protobuf:"bytes,5,opt,name=aggregation,proto3" json:"aggregation,omitempty"` // sum, max, last
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PlanMeter) Reset() {
 	*x = PlanMeter{}
-	mi := &file_product_proto_msgTypes[41]
+	mi := &file_product_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2889,7 +3178,7 @@ func (x *PlanMeter) String() string {
 func (*PlanMeter) ProtoMessage() {}
 
 func (x *PlanMeter) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[41]
+	mi := &file_product_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2902,7 +3191,7 @@ func (x *PlanMeter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlanMeter.ProtoReflect.Descriptor instead.
 func (*PlanMeter) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{41}
+	return file_product_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *PlanMeter) GetId() int64 {
@@ -2933,6 +3222,13 @@ func (x *PlanMeter) GetUpdatedAt() int64 {
 	return 0
 }
 
+func (x *PlanMeter) GetAggregation() string {
+	if x != nil {
+		return x.Aggregation
+	}
+	return ""
+}
+
 type GetPlanMeterByIDRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -2942,7 +3238,7 @@ type GetPlanMeterByIDRequest struct {
 
 func (x *GetPlanMeterByIDRequest) Reset() {
 	*x = GetPlanMeterByIDRequest{}
-	mi := &file_product_proto_msgTypes[42]
+	mi := &file_product_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2954,7 +3250,7 @@ func (x *GetPlanMeterByIDRequest) String() string {
 func (*GetPlanMeterByIDRequest) ProtoMessage() {}
 
 func (x *GetPlanMeterByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[42]
+	mi := &file_product_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2967,7 +3263,7 @@ func (x *GetPlanMeterByIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPlanMeterByIDRequest.ProtoReflect.Descriptor instead.
 func (*GetPlanMeterByIDRequest) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{42}
+	return file_product_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *GetPlanMeterByIDRequest) GetId() int64 {
@@ -2988,7 +3284,7 @@ type GetPlanMeterByIDResponse struct {
 
 func (x *GetPlanMeterByIDResponse) Reset() {
 	*x = GetPlanMeterByIDResponse{}
-	mi := &file_product_proto_msgTypes[43]
+	mi := &file_product_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3000,7 +3296,7 @@ func (x *GetPlanMeterByIDResponse) String() string {
 func (*GetPlanMeterByIDResponse) ProtoMessage() {}
 
 func (x *GetPlanMeterByIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[43]
+	mi := &file_product_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3013,7 +3309,7 @@ func (x *GetPlanMeterByIDResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPlanMeterByIDResponse.ProtoReflect.Descriptor instead.
 func (*GetPlanMeterByIDResponse) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{43}
+	return file_product_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *GetPlanMeterByIDResponse) GetSuccess() bool {
@@ -3052,7 +3348,7 @@ type GetAllPlanMetersRequest struct {
 
 func (x *GetAllPlanMetersRequest) Reset() {
 	*x = GetAllPlanMetersRequest{}
-	mi := &file_product_proto_msgTypes[44]
+	mi := &file_product_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3064,7 +3360,7 @@ func (x *GetAllPlanMetersRequest) String() string {
 func (*GetAllPlanMetersRequest) ProtoMessage() {}
 
 func (x *GetAllPlanMetersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[44]
+	mi := &file_product_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3077,7 +3373,7 @@ func (x *GetAllPlanMetersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllPlanMetersRequest.ProtoReflect.Descriptor instead.
 func (*GetAllPlanMetersRequest) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{44}
+	return file_product_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *GetAllPlanMetersRequest) GetPage() int32 {
@@ -3141,7 +3437,7 @@ type GetAllPlanMetersData struct {
 
 func (x *GetAllPlanMetersData) Reset() {
 	*x = GetAllPlanMetersData{}
-	mi := &file_product_proto_msgTypes[45]
+	mi := &file_product_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3153,7 +3449,7 @@ func (x *GetAllPlanMetersData) String() string {
 func (*GetAllPlanMetersData) ProtoMessage() {}
 
 func (x *GetAllPlanMetersData) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[45]
+	mi := &file_product_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3166,7 +3462,7 @@ func (x *GetAllPlanMetersData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllPlanMetersData.ProtoReflect.Descriptor instead.
 func (*GetAllPlanMetersData) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{45}
+	return file_product_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *GetAllPlanMetersData) GetMeters() []*PlanMeter {
@@ -3208,7 +3504,7 @@ type GetAllPlanMetersResponse struct {
 
 func (x *GetAllPlanMetersResponse) Reset() {
 	*x = GetAllPlanMetersResponse{}
-	mi := &file_product_proto_msgTypes[46]
+	mi := &file_product_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3220,7 +3516,7 @@ func (x *GetAllPlanMetersResponse) String() string {
 func (*GetAllPlanMetersResponse) ProtoMessage() {}
 
 func (x *GetAllPlanMetersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[46]
+	mi := &file_product_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3233,7 +3529,7 @@ func (x *GetAllPlanMetersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllPlanMetersResponse.ProtoReflect.Descriptor instead.
 func (*GetAllPlanMetersResponse) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{46}
+	return file_product_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *GetAllPlanMetersResponse) GetSuccess() bool {
@@ -3260,13 +3556,14 @@ func (x *GetAllPlanMetersResponse) GetData() *GetAllPlanMetersData {
 type CreatePlanMeterRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Aggregation   string                 `protobuf:"bytes,2,opt,name=aggregation,proto3" json:"aggregation,omitempty"` // sum (default), max, last
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreatePlanMeterRequest) Reset() {
 	*x = CreatePlanMeterRequest{}
-	mi := &file_product_proto_msgTypes[47]
+	mi := &file_product_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3278,7 +3575,7 @@ func (x *CreatePlanMeterRequest) String() string {
 func (*CreatePlanMeterRequest) ProtoMessage() {}
 
 func (x *CreatePlanMeterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[47]
+	mi := &file_product_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3291,7 +3588,7 @@ func (x *CreatePlanMeterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatePlanMeterRequest.ProtoReflect.Descriptor instead.
 func (*CreatePlanMeterRequest) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{47}
+	return file_product_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *CreatePlanMeterRequest) GetName() string {
@@ -3301,6 +3598,13 @@ func (x *CreatePlanMeterRequest) GetName() string {
 	return ""
 }
 
+func (x *CreatePlanMeterRequest) GetAggregation() string {
+	if x != nil {
+		return x.Aggregation
+	}
+	return ""
+}
+
 type CreatePlanMeterResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -3312,7 +3616,7 @@ type CreatePlanMeterResponse struct {
 
 func (x *CreatePlanMeterResponse) Reset() {
 	*x = CreatePlanMeterResponse{}
-	mi := &file_product_proto_msgTypes[48]
+	mi := &file_product_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3324,7 +3628,7 @@ func (x *CreatePlanMeterResponse) String() string {
 func (*CreatePlanMeterResponse) ProtoMessage() {}
 
 func (x *CreatePlanMeterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[48]
+	mi := &file_product_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3337,7 +3641,7 @@ func (x *CreatePlanMeterResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatePlanMeterResponse.ProtoReflect.Descriptor instead.
 func (*CreatePlanMeterResponse) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{48}
+	return file_product_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *CreatePlanMeterResponse) GetSuccess() bool {
@@ -3365,13 +3669,14 @@ type UpdatePlanMeterRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Aggregation   string                 `protobuf:"bytes,3,opt,name=aggregation,proto3" json:"aggregation,omitempty"` // empty keeps the current aggregation
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdatePlanMeterRequest) Reset() {
 	*x = UpdatePlanMeterRequest{}
-	mi := &file_product_proto_msgTypes[49]
+	mi := &file_product_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3383,7 +3688,7 @@ func (x *UpdatePlanMeterRequest) String() string {
 func (*UpdatePlanMeterRequest) ProtoMessage() {}
 
 func (x *UpdatePlanMeterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[49]
+	mi := &file_product_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3396,7 +3701,7 @@ func (x *UpdatePlanMeterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatePlanMeterRequest.ProtoReflect.Descriptor instead.
 func (*UpdatePlanMeterRequest) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{49}
+	return file_product_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *UpdatePlanMeterRequest) GetId() int64 {
@@ -3413,6 +3718,13 @@ func (x *UpdatePlanMeterRequest) GetName() string {
 	return ""
 }
 
+func (x *UpdatePlanMeterRequest) GetAggregation() string {
+	if x != nil {
+		return x.Aggregation
+	}
+	return ""
+}
+
 type UpdatePlanMeterResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -3424,7 +3736,7 @@ type UpdatePlanMeterResponse struct {
 
 func (x *UpdatePlanMeterResponse) Reset() {
 	*x = UpdatePlanMeterResponse{}
-	mi := &file_product_proto_msgTypes[50]
+	mi := &file_product_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3436,7 +3748,7 @@ func (x *UpdatePlanMeterResponse) String() string {
 func (*UpdatePlanMeterResponse) ProtoMessage() {}
 
 func (x *UpdatePlanMeterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[50]
+	mi := &file_product_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3449,7 +3761,7 @@ func (x *UpdatePlanMeterResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatePlanMeterResponse.ProtoReflect.Descriptor instead.
 func (*UpdatePlanMeterResponse) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{50}
+	return file_product_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *UpdatePlanMeterResponse) GetSuccess() bool {
@@ -3482,7 +3794,7 @@ type DeletePlanMeterRequest struct {
 
 func (x *DeletePlanMeterRequest) Reset() {
 	*x = DeletePlanMeterRequest{}
-	mi := &file_product_proto_msgTypes[51]
+	mi := &file_product_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3494,7 +3806,7 @@ func (x *DeletePlanMeterRequest) String() string {
 func (*DeletePlanMeterRequest) ProtoMessage() {}
 
 func (x *DeletePlanMeterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[51]
+	mi := &file_product_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3507,7 +3819,7 @@ func (x *DeletePlanMeterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeletePlanMeterRequest.ProtoReflect.Descriptor instead.
 func (*DeletePlanMeterRequest) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{51}
+	return file_product_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *DeletePlanMeterRequest) GetId() int64 {
@@ -3527,7 +3839,7 @@ type DeletePlanMeterResponse struct {
 
 func (x *DeletePlanMeterResponse) Reset() {
 	*x = DeletePlanMeterResponse{}
-	mi := &file_product_proto_msgTypes[52]
+	mi := &file_product_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3539,7 +3851,7 @@ func (x *DeletePlanMeterResponse) String() string {
 func (*DeletePlanMeterResponse) ProtoMessage() {}
 
 func (x *DeletePlanMeterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[52]
+	mi := &file_product_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3552,7 +3864,7 @@ func (x *DeletePlanMeterResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeletePlanMeterResponse.ProtoReflect.Descriptor instead.
 func (*DeletePlanMeterResponse) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{52}
+	return file_product_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *DeletePlanMeterResponse) GetSuccess() bool {
@@ -3597,7 +3909,7 @@ type Discount struct {
 
 func (x *Discount) Reset() {
 	*x = Discount{}
-	mi := &file_product_proto_msgTypes[53]
+	mi := &file_product_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3609,7 +3921,7 @@ func (x *Discount) String() string {
 func (*Discount) ProtoMessage() {}
 
 func (x *Discount) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[53]
+	mi := &file_product_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3622,7 +3934,7 @@ func (x *Discount) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Discount.ProtoReflect.Descriptor instead.
 func (*Discount) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{53}
+	return file_product_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *Discount) GetId() int64 {
@@ -3774,7 +4086,7 @@ type GetDiscountByIDRequest struct {
 
 func (x *GetDiscountByIDRequest) Reset() {
 	*x = GetDiscountByIDRequest{}
-	mi := &file_product_proto_msgTypes[54]
+	mi := &file_product_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3786,7 +4098,7 @@ func (x *GetDiscountByIDRequest) String() string {
 func (*GetDiscountByIDRequest) ProtoMessage() {}
 
 func (x *GetDiscountByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[54]
+	mi := &file_product_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3799,7 +4111,7 @@ func (x *GetDiscountByIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDiscountByIDRequest.ProtoReflect.Descriptor instead.
 func (*GetDiscountByIDRequest) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{54}
+	return file_product_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *GetDiscountByIDRequest) GetId() int64 {
@@ -3820,7 +4132,7 @@ type GetDiscountByIDResponse struct {
 
 func (x *GetDiscountByIDResponse) Reset() {
 	*x = GetDiscountByIDResponse{}
-	mi := &file_product_proto_msgTypes[55]
+	mi := &file_product_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3832,7 +4144,7 @@ func (x *GetDiscountByIDResponse) String() string {
 func (*GetDiscountByIDResponse) ProtoMessage() {}
 
 func (x *GetDiscountByIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[55]
+	mi := &file_product_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3845,7 +4157,7 @@ func (x *GetDiscountByIDResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDiscountByIDResponse.ProtoReflect.Descriptor instead.
 func (*GetDiscountByIDResponse) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{55}
+	return file_product_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *GetDiscountByIDResponse) GetSuccess() bool {
@@ -3878,7 +4190,7 @@ type GetDiscountByCodeRequest struct {
 
 func (x *GetDiscountByCodeRequest) Reset() {
 	*x = GetDiscountByCodeRequest{}
-	mi := &file_product_proto_msgTypes[56]
+	mi := &file_product_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3890,7 +4202,7 @@ func (x *GetDiscountByCodeRequest) String() string {
 func (*GetDiscountByCodeRequest) ProtoMessage() {}
 
 func (x *GetDiscountByCodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[56]
+	mi := &file_product_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3903,7 +4215,7 @@ func (x *GetDiscountByCodeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDiscountByCodeRequest.ProtoReflect.Descriptor instead.
 func (*GetDiscountByCodeRequest) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{56}
+	return file_product_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *GetDiscountByCodeRequest) GetCode() string {
@@ -3924,7 +4236,7 @@ type GetDiscountByCodeResponse struct {
 
 func (x *GetDiscountByCodeResponse) Reset() {
 	*x = GetDiscountByCodeResponse{}
-	mi := &file_product_proto_msgTypes[57]
+	mi := &file_product_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3936,7 +4248,7 @@ func (x *GetDiscountByCodeResponse) String() string {
 func (*GetDiscountByCodeResponse) ProtoMessage() {}
 
 func (x *GetDiscountByCodeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[57]
+	mi := &file_product_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3949,7 +4261,7 @@ func (x *GetDiscountByCodeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDiscountByCodeResponse.ProtoReflect.Descriptor instead.
 func (*GetDiscountByCodeResponse) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{57}
+	return file_product_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *GetDiscountByCodeResponse) GetSuccess() bool {
@@ -3997,7 +4309,7 @@ type CreateDiscountRequest struct {
 
 func (x *CreateDiscountRequest) Reset() {
 	*x = CreateDiscountRequest{}
-	mi := &file_product_proto_msgTypes[58]
+	mi := &file_product_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4009,7 +4321,7 @@ func (x *CreateDiscountRequest) String() string {
 func (*CreateDiscountRequest) ProtoMessage() {}
 
 func (x *CreateDiscountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[58]
+	mi := &file_product_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4022,7 +4334,7 @@ func (x *CreateDiscountRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateDiscountRequest.ProtoReflect.Descriptor instead.
 func (*CreateDiscountRequest) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{58}
+	return file_product_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *CreateDiscountRequest) GetName() string {
@@ -4148,7 +4460,7 @@ type CreateDiscountResponse struct {
 
 func (x *CreateDiscountResponse) Reset() {
 	*x = CreateDiscountResponse{}
-	mi := &file_product_proto_msgTypes[59]
+	mi := &file_product_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4160,7 +4472,7 @@ func (x *CreateDiscountResponse) String() string {
 func (*CreateDiscountResponse) ProtoMessage() {}
 
 func (x *CreateDiscountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[59]
+	mi := &file_product_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4173,7 +4485,7 @@ func (x *CreateDiscountResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateDiscountResponse.ProtoReflect.Descriptor instead.
 func (*CreateDiscountResponse) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{59}
+	return file_product_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *CreateDiscountResponse) GetSuccess() bool {
@@ -4222,7 +4534,7 @@ type UpdateDiscountRequest struct {
 
 func (x *UpdateDiscountRequest) Reset() {
 	*x = UpdateDiscountRequest{}
-	mi := &file_product_proto_msgTypes[60]
+	mi := &file_product_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4234,7 +4546,7 @@ func (x *UpdateDiscountRequest) String() string {
 func (*UpdateDiscountRequest) ProtoMessage() {}
 
 func (x *UpdateDiscountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[60]
+	mi := &file_product_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4247,7 +4559,7 @@ func (x *UpdateDiscountRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateDiscountRequest.ProtoReflect.Descriptor instead.
 func (*UpdateDiscountRequest) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{60}
+	return file_product_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *UpdateDiscountRequest) GetId() int64 {
@@ -4380,7 +4692,7 @@ type UpdateDiscountResponse struct {
 
 func (x *UpdateDiscountResponse) Reset() {
 	*x = UpdateDiscountResponse{}
-	mi := &file_product_proto_msgTypes[61]
+	mi := &file_product_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4392,7 +4704,7 @@ func (x *UpdateDiscountResponse) String() string {
 func (*UpdateDiscountResponse) ProtoMessage() {}
 
 func (x *UpdateDiscountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[61]
+	mi := &file_product_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4405,7 +4717,7 @@ func (x *UpdateDiscountResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateDiscountResponse.ProtoReflect.Descriptor instead.
 func (*UpdateDiscountResponse) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{61}
+	return file_product_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *UpdateDiscountResponse) GetSuccess() bool {
@@ -4438,7 +4750,7 @@ type DeleteDiscountRequest struct {
 
 func (x *DeleteDiscountRequest) Reset() {
 	*x = DeleteDiscountRequest{}
-	mi := &file_product_proto_msgTypes[62]
+	mi := &file_product_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4450,7 +4762,7 @@ func (x *DeleteDiscountRequest) String() string {
 func (*DeleteDiscountRequest) ProtoMessage() {}
 
 func (x *DeleteDiscountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[62]
+	mi := &file_product_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4463,7 +4775,7 @@ func (x *DeleteDiscountRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteDiscountRequest.ProtoReflect.Descriptor instead.
 func (*DeleteDiscountRequest) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{62}
+	return file_product_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *DeleteDiscountRequest) GetId() int64 {
@@ -4483,7 +4795,7 @@ type DeleteDiscountResponse struct {
 
 func (x *DeleteDiscountResponse) Reset() {
 	*x = DeleteDiscountResponse{}
-	mi := &file_product_proto_msgTypes[63]
+	mi := &file_product_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4495,7 +4807,7 @@ func (x *DeleteDiscountResponse) String() string {
 func (*DeleteDiscountResponse) ProtoMessage() {}
 
 func (x *DeleteDiscountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[63]
+	mi := &file_product_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4508,7 +4820,7 @@ func (x *DeleteDiscountResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteDiscountResponse.ProtoReflect.Descriptor instead.
 func (*DeleteDiscountResponse) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{63}
+	return file_product_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *DeleteDiscountResponse) GetSuccess() bool {
@@ -4539,7 +4851,7 @@ type GetAllDiscountsRequest struct {
 
 func (x *GetAllDiscountsRequest) Reset() {
 	*x = GetAllDiscountsRequest{}
-	mi := &file_product_proto_msgTypes[64]
+	mi := &file_product_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4551,7 +4863,7 @@ func (x *GetAllDiscountsRequest) String() string {
 func (*GetAllDiscountsRequest) ProtoMessage() {}
 
 func (x *GetAllDiscountsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[64]
+	mi := &file_product_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4564,7 +4876,7 @@ func (x *GetAllDiscountsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllDiscountsRequest.ProtoReflect.Descriptor instead.
 func (*GetAllDiscountsRequest) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{64}
+	return file_product_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *GetAllDiscountsRequest) GetPage() int32 {
@@ -4621,7 +4933,7 @@ type GetAllDiscountsData struct {
 
 func (x *GetAllDiscountsData) Reset() {
 	*x = GetAllDiscountsData{}
-	mi := &file_product_proto_msgTypes[65]
+	mi := &file_product_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4633,7 +4945,7 @@ func (x *GetAllDiscountsData) String() string {
 func (*GetAllDiscountsData) ProtoMessage() {}
 
 func (x *GetAllDiscountsData) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[65]
+	mi := &file_product_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4646,7 +4958,7 @@ func (x *GetAllDiscountsData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllDiscountsData.ProtoReflect.Descriptor instead.
 func (*GetAllDiscountsData) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{65}
+	return file_product_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *GetAllDiscountsData) GetDiscounts() []*Discount {
@@ -4688,7 +5000,7 @@ type GetAllDiscountsResponse struct {
 
 func (x *GetAllDiscountsResponse) Reset() {
 	*x = GetAllDiscountsResponse{}
-	mi := &file_product_proto_msgTypes[66]
+	mi := &file_product_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4700,7 +5012,7 @@ func (x *GetAllDiscountsResponse) String() string {
 func (*GetAllDiscountsResponse) ProtoMessage() {}
 
 func (x *GetAllDiscountsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[66]
+	mi := &file_product_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4713,7 +5025,7 @@ func (x *GetAllDiscountsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllDiscountsResponse.ProtoReflect.Descriptor instead.
 func (*GetAllDiscountsResponse) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{66}
+	return file_product_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *GetAllDiscountsResponse) GetSuccess() bool {
@@ -4738,19 +5050,20 @@ func (x *GetAllDiscountsResponse) GetData() *GetAllDiscountsData {
 }
 
 type DiscountCode struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
-	DiscountId    int64                  `protobuf:"varint,3,opt,name=discount_id,json=discountId,proto3" json:"discount_id,omitempty"`
-	CreatedAt     int64                  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     int64                  `protobuf:"varint,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code           string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	DiscountId     int64                  `protobuf:"varint,3,opt,name=discount_id,json=discountId,proto3" json:"discount_id,omitempty"`
+	CreatedAt      int64                  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      int64                  `protobuf:"varint,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ReferrerUserId int64                  `protobuf:"varint,6,opt,name=referrer_user_id,json=referrerUserId,proto3" json:"referrer_user_id,omitempty"` // 0 unless this is a personal referral code
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *DiscountCode) Reset() {
 	*x = DiscountCode{}
-	mi := &file_product_proto_msgTypes[67]
+	mi := &file_product_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4762,7 +5075,7 @@ func (x *DiscountCode) String() string {
 func (*DiscountCode) ProtoMessage() {}
 
 func (x *DiscountCode) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[67]
+	mi := &file_product_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4775,7 +5088,7 @@ func (x *DiscountCode) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DiscountCode.ProtoReflect.Descriptor instead.
 func (*DiscountCode) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{67}
+	return file_product_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *DiscountCode) GetId() int64 {
@@ -4813,6 +5126,13 @@ func (x *DiscountCode) GetUpdatedAt() int64 {
 	return 0
 }
 
+func (x *DiscountCode) GetReferrerUserId() int64 {
+	if x != nil {
+		return x.ReferrerUserId
+	}
+	return 0
+}
+
 type GetDiscountCodeByIDRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -4822,7 +5142,7 @@ type GetDiscountCodeByIDRequest struct {
 
 func (x *GetDiscountCodeByIDRequest) Reset() {
 	*x = GetDiscountCodeByIDRequest{}
-	mi := &file_product_proto_msgTypes[68]
+	mi := &file_product_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4834,7 +5154,7 @@ func (x *GetDiscountCodeByIDRequest) String() string {
 func (*GetDiscountCodeByIDRequest) ProtoMessage() {}
 
 func (x *GetDiscountCodeByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[68]
+	mi := &file_product_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4847,7 +5167,7 @@ func (x *GetDiscountCodeByIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDiscountCodeByIDRequest.ProtoReflect.Descriptor instead.
 func (*GetDiscountCodeByIDRequest) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{68}
+	return file_product_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *GetDiscountCodeByIDRequest) GetId() int64 {
@@ -4868,7 +5188,7 @@ type GetDiscountCodeByIDResponse struct {
 
 func (x *GetDiscountCodeByIDResponse) Reset() {
 	*x = GetDiscountCodeByIDResponse{}
-	mi := &file_product_proto_msgTypes[69]
+	mi := &file_product_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4880,7 +5200,7 @@ func (x *GetDiscountCodeByIDResponse) String() string {
 func (*GetDiscountCodeByIDResponse) ProtoMessage() {}
 
 func (x *GetDiscountCodeByIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[69]
+	mi := &file_product_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4893,7 +5213,7 @@ func (x *GetDiscountCodeByIDResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDiscountCodeByIDResponse.ProtoReflect.Descriptor instead.
 func (*GetDiscountCodeByIDResponse) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{69}
+	return file_product_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *GetDiscountCodeByIDResponse) GetSuccess() bool {
@@ -4926,7 +5246,7 @@ type GetDiscountCodeByCodeRequest struct {
 
 func (x *GetDiscountCodeByCodeRequest) Reset() {
 	*x = GetDiscountCodeByCodeRequest{}
-	mi := &file_product_proto_msgTypes[70]
+	mi := &file_product_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4938,7 +5258,7 @@ func (x *GetDiscountCodeByCodeRequest) String() string {
 func (*GetDiscountCodeByCodeRequest) ProtoMessage() {}
 
 func (x *GetDiscountCodeByCodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[70]
+	mi := &file_product_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4951,7 +5271,7 @@ func (x *GetDiscountCodeByCodeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDiscountCodeByCodeRequest.ProtoReflect.Descriptor instead.
 func (*GetDiscountCodeByCodeRequest) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{70}
+	return file_product_proto_rawDescGZIP(), []int{74}
 }
 
 func (x *GetDiscountCodeByCodeRequest) GetCode() string {
@@ -4972,7 +5292,7 @@ type GetDiscountCodeByCodeResponse struct {
 
 func (x *GetDiscountCodeByCodeResponse) Reset() {
 	*x = GetDiscountCodeByCodeResponse{}
-	mi := &file_product_proto_msgTypes[71]
+	mi := &file_product_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4984,7 +5304,7 @@ func (x *GetDiscountCodeByCodeResponse) String() string {
 func (*GetDiscountCodeByCodeResponse) ProtoMessage() {}
 
 func (x *GetDiscountCodeByCodeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[71]
+	mi := &file_product_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4997,7 +5317,7 @@ func (x *GetDiscountCodeByCodeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDiscountCodeByCodeResponse.ProtoReflect.Descriptor instead.
 func (*GetDiscountCodeByCodeResponse) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{71}
+	return file_product_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *GetDiscountCodeByCodeResponse) GetSuccess() bool {
@@ -5030,7 +5350,7 @@ type GetDiscountCodesByDiscountRequest struct {
 
 func (x *GetDiscountCodesByDiscountRequest) Reset() {
 	*x = GetDiscountCodesByDiscountRequest{}
-	mi := &file_product_proto_msgTypes[72]
+	mi := &file_product_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5042,7 +5362,7 @@ func (x *GetDiscountCodesByDiscountRequest) String() string {
 func (*GetDiscountCodesByDiscountRequest) ProtoMessage() {}
 
 func (x *GetDiscountCodesByDiscountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[72]
+	mi := &file_product_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5055,7 +5375,7 @@ func (x *GetDiscountCodesByDiscountRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetDiscountCodesByDiscountRequest.ProtoReflect.Descriptor instead.
 func (*GetDiscountCodesByDiscountRequest) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{72}
+	return file_product_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *GetDiscountCodesByDiscountRequest) GetDiscountId() int64 {
@@ -5076,7 +5396,7 @@ type GetDiscountCodesByDiscountResponse struct {
 
 func (x *GetDiscountCodesByDiscountResponse) Reset() {
 	*x = GetDiscountCodesByDiscountResponse{}
-	mi := &file_product_proto_msgTypes[73]
+	mi := &file_product_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5088,7 +5408,7 @@ func (x *GetDiscountCodesByDiscountResponse) String() string {
 func (*GetDiscountCodesByDiscountResponse) ProtoMessage() {}
 
 func (x *GetDiscountCodesByDiscountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[73]
+	mi := &file_product_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5101,7 +5421,7 @@ func (x *GetDiscountCodesByDiscountResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetDiscountCodesByDiscountResponse.ProtoReflect.Descriptor instead.
 func (*GetDiscountCodesByDiscountResponse) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{73}
+	return file_product_proto_rawDescGZIP(), []int{77}
 }
 
 func (x *GetDiscountCodesByDiscountResponse) GetSuccess() bool {
@@ -5135,7 +5455,7 @@ type CreateDiscountCodeRequest struct {
 
 func (x *CreateDiscountCodeRequest) Reset() {
 	*x = CreateDiscountCodeRequest{}
-	mi := &file_product_proto_msgTypes[74]
+	mi := &file_product_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5147,7 +5467,7 @@ func (x *CreateDiscountCodeRequest) String() string {
 func (*CreateDiscountCodeRequest) ProtoMessage() {}
 
 func (x *CreateDiscountCodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[74]
+	mi := &file_product_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5160,7 +5480,7 @@ func (x *CreateDiscountCodeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateDiscountCodeRequest.ProtoReflect.Descriptor instead.
 func (*CreateDiscountCodeRequest) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{74}
+	return file_product_proto_rawDescGZIP(), []int{78}
 }
 
 func (x *CreateDiscountCodeRequest) GetCode() string {
@@ -5188,7 +5508,7 @@ type CreateDiscountCodeResponse struct {
 
 func (x *CreateDiscountCodeResponse) Reset() {
 	*x = CreateDiscountCodeResponse{}
-	mi := &file_product_proto_msgTypes[75]
+	mi := &file_product_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5200,7 +5520,7 @@ func (x *CreateDiscountCodeResponse) String() string {
 func (*CreateDiscountCodeResponse) ProtoMessage() {}
 
 func (x *CreateDiscountCodeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[75]
+	mi := &file_product_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5213,7 +5533,7 @@ func (x *CreateDiscountCodeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateDiscountCodeResponse.ProtoReflect.Descriptor instead.
 func (*CreateDiscountCodeResponse) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{75}
+	return file_product_proto_rawDescGZIP(), []int{79}
 }
 
 func (x *CreateDiscountCodeResponse) GetSuccess() bool {
@@ -5246,7 +5566,7 @@ type DeleteDiscountCodeRequest struct {
 
 func (x *DeleteDiscountCodeRequest) Reset() {
 	*x = DeleteDiscountCodeRequest{}
-	mi := &file_product_proto_msgTypes[76]
+	mi := &file_product_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5258,7 +5578,7 @@ func (x *DeleteDiscountCodeRequest) String() string {
 func (*DeleteDiscountCodeRequest) ProtoMessage() {}
 
 func (x *DeleteDiscountCodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[76]
+	mi := &file_product_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5271,7 +5591,7 @@ func (x *DeleteDiscountCodeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteDiscountCodeRequest.ProtoReflect.Descriptor instead.
 func (*DeleteDiscountCodeRequest) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{76}
+	return file_product_proto_rawDescGZIP(), []int{80}
 }
 
 func (x *DeleteDiscountCodeRequest) GetId() int64 {
@@ -5291,7 +5611,7 @@ type DeleteDiscountCodeResponse struct {
 
 func (x *DeleteDiscountCodeResponse) Reset() {
 	*x = DeleteDiscountCodeResponse{}
-	mi := &file_product_proto_msgTypes[77]
+	mi := &file_product_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5303,7 +5623,7 @@ func (x *DeleteDiscountCodeResponse) String() string {
 func (*DeleteDiscountCodeResponse) ProtoMessage() {}
 
 func (x *DeleteDiscountCodeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[77]
+	mi := &file_product_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5316,7 +5636,7 @@ func (x *DeleteDiscountCodeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteDiscountCodeResponse.ProtoReflect.Descriptor instead.
 func (*DeleteDiscountCodeResponse) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{77}
+	return file_product_proto_rawDescGZIP(), []int{81}
 }
 
 func (x *DeleteDiscountCodeResponse) GetSuccess() bool {
@@ -5333,32 +5653,33 @@ func (x *DeleteDiscountCodeResponse) GetMessage() string {
 	return ""
 }
 
-type DiscountPlan struct {
+type GenerateDiscountCodesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	DiscountId    int64                  `protobuf:"varint,2,opt,name=discount_id,json=discountId,proto3" json:"discount_id,omitempty"`
-	PlanId        int64                  `protobuf:"varint,3,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
-	CreatedAt     int64                  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     int64                  `protobuf:"varint,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	DiscountId    int64                  `protobuf:"varint,1,opt,name=discount_id,json=discountId,proto3" json:"discount_id,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Prefix        string                 `protobuf:"bytes,3,opt,name=prefix,proto3" json:"prefix,omitempty"`                         // optional, prepended to every code
+	Length        int32                  `protobuf:"varint,4,opt,name=length,proto3" json:"length,omitempty"`                        // random part length, defaults to 10
+	Alphabet      string                 `protobuf:"bytes,5,opt,name=alphabet,proto3" json:"alphabet,omitempty"`                     // optional, defaults to uppercase letters and digits without look-alikes
+	MaxUses       *int32                 `protobuf:"varint,6,opt,name=max_uses,json=maxUses,proto3,oneof" json:"max_uses,omitempty"` // per code, unlimited when unset
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DiscountPlan) Reset() {
-	*x = DiscountPlan{}
-	mi := &file_product_proto_msgTypes[78]
+func (x *GenerateDiscountCodesRequest) Reset() {
+	*x = GenerateDiscountCodesRequest{}
+	mi := &file_product_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DiscountPlan) String() string {
+func (x *GenerateDiscountCodesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DiscountPlan) ProtoMessage() {}
+func (*GenerateDiscountCodesRequest) ProtoMessage() {}
 
-func (x *DiscountPlan) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[78]
+func (x *GenerateDiscountCodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5369,47 +5690,311 @@ func (x *DiscountPlan) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DiscountPlan.ProtoReflect.Descriptor instead.
-func (*DiscountPlan) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{78}
+// Deprecated: Use GenerateDiscountCodesRequest.ProtoReflect.Descriptor instead.
+func (*GenerateDiscountCodesRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *DiscountPlan) GetId() int64 {
+func (x *GenerateDiscountCodesRequest) GetDiscountId() int64 {
 	if x != nil {
-		return x.Id
+		return x.DiscountId
 	}
 	return 0
 }
 
-func (x *DiscountPlan) GetDiscountId() int64 {
+func (x *GenerateDiscountCodesRequest) GetCount() int32 {
 	if x != nil {
-		return x.DiscountId
+		return x.Count
 	}
 	return 0
 }
 
-func (x *DiscountPlan) GetPlanId() int64 {
+func (x *GenerateDiscountCodesRequest) GetPrefix() string {
 	if x != nil {
-		return x.PlanId
+		return x.Prefix
 	}
-	return 0
+	return ""
 }
 
-func (x *DiscountPlan) GetCreatedAt() int64 {
+func (x *GenerateDiscountCodesRequest) GetLength() int32 {
 	if x != nil {
-		return x.CreatedAt
+		return x.Length
 	}
 	return 0
 }
 
-func (x *DiscountPlan) GetUpdatedAt() int64 {
+func (x *GenerateDiscountCodesRequest) GetAlphabet() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Alphabet
 	}
-	return 0
+	return ""
 }
 
-type AddPlanToDiscountRequest struct {
+func (x *GenerateDiscountCodesRequest) GetMaxUses() int32 {
+	if x != nil && x.MaxUses != nil {
+		return *x.MaxUses
+	}
+	return 0
+}
+
+// One message per inserted batch. generated is the running total.
+type GenerateDiscountCodesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          []*DiscountCode        `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	Generated     int32                  `protobuf:"varint,4,opt,name=generated,proto3" json:"generated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateDiscountCodesResponse) Reset() {
+	*x = GenerateDiscountCodesResponse{}
+	mi := &file_product_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateDiscountCodesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateDiscountCodesResponse) ProtoMessage() {}
+
+func (x *GenerateDiscountCodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateDiscountCodesResponse.ProtoReflect.Descriptor instead.
+func (*GenerateDiscountCodesResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *GenerateDiscountCodesResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GenerateDiscountCodesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GenerateDiscountCodesResponse) GetData() []*DiscountCode {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *GenerateDiscountCodesResponse) GetGenerated() int32 {
+	if x != nil {
+		return x.Generated
+	}
+	return 0
+}
+
+type ExportDiscountCodesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DiscountId    int64                  `protobuf:"varint,1,opt,name=discount_id,json=discountId,proto3" json:"discount_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportDiscountCodesRequest) Reset() {
+	*x = ExportDiscountCodesRequest{}
+	mi := &file_product_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportDiscountCodesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportDiscountCodesRequest) ProtoMessage() {}
+
+func (x *ExportDiscountCodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportDiscountCodesRequest.ProtoReflect.Descriptor instead.
+func (*ExportDiscountCodesRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ExportDiscountCodesRequest) GetDiscountId() int64 {
+	if x != nil {
+		return x.DiscountId
+	}
+	return 0
+}
+
+type ExportDiscountCodesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Csv           string                 `protobuf:"bytes,3,opt,name=csv,proto3" json:"csv,omitempty"`
+	Total         int32                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportDiscountCodesResponse) Reset() {
+	*x = ExportDiscountCodesResponse{}
+	mi := &file_product_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportDiscountCodesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportDiscountCodesResponse) ProtoMessage() {}
+
+func (x *ExportDiscountCodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportDiscountCodesResponse.ProtoReflect.Descriptor instead.
+func (*ExportDiscountCodesResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *ExportDiscountCodesResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ExportDiscountCodesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ExportDiscountCodesResponse) GetCsv() string {
+	if x != nil {
+		return x.Csv
+	}
+	return ""
+}
+
+func (x *ExportDiscountCodesResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type DiscountPlan struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	DiscountId    int64                  `protobuf:"varint,2,opt,name=discount_id,json=discountId,proto3" json:"discount_id,omitempty"`
+	PlanId        int64                  `protobuf:"varint,3,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     int64                  `protobuf:"varint,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiscountPlan) Reset() {
+	*x = DiscountPlan{}
+	mi := &file_product_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiscountPlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscountPlan) ProtoMessage() {}
+
+func (x *DiscountPlan) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscountPlan.ProtoReflect.Descriptor instead.
+func (*DiscountPlan) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *DiscountPlan) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DiscountPlan) GetDiscountId() int64 {
+	if x != nil {
+		return x.DiscountId
+	}
+	return 0
+}
+
+func (x *DiscountPlan) GetPlanId() int64 {
+	if x != nil {
+		return x.PlanId
+	}
+	return 0
+}
+
+func (x *DiscountPlan) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *DiscountPlan) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type AddPlanToDiscountRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	DiscountId    int64                  `protobuf:"varint,1,opt,name=discount_id,json=discountId,proto3" json:"discount_id,omitempty"`
 	PlanId        int64                  `protobuf:"varint,2,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
@@ -5419,7 +6004,7 @@ type AddPlanToDiscountRequest struct {
 
 func (x *AddPlanToDiscountRequest) Reset() {
 	*x = AddPlanToDiscountRequest{}
-	mi := &file_product_proto_msgTypes[79]
+	mi := &file_product_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5431,7 +6016,7 @@ func (x *AddPlanToDiscountRequest) String() string {
 func (*AddPlanToDiscountRequest) ProtoMessage() {}
 
 func (x *AddPlanToDiscountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[79]
+	mi := &file_product_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5444,7 +6029,7 @@ func (x *AddPlanToDiscountRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddPlanToDiscountRequest.ProtoReflect.Descriptor instead.
 func (*AddPlanToDiscountRequest) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{79}
+	return file_product_proto_rawDescGZIP(), []int{87}
 }
 
 func (x *AddPlanToDiscountRequest) GetDiscountId() int64 {
@@ -5472,7 +6057,7 @@ type AddPlanToDiscountResponse struct {
 
 func (x *AddPlanToDiscountResponse) Reset() {
 	*x = AddPlanToDiscountResponse{}
-	mi := &file_product_proto_msgTypes[80]
+	mi := &file_product_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5484,7 +6069,7 @@ func (x *AddPlanToDiscountResponse) String() string {
 func (*AddPlanToDiscountResponse) ProtoMessage() {}
 
 func (x *AddPlanToDiscountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[80]
+	mi := &file_product_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5497,7 +6082,7 @@ func (x *AddPlanToDiscountResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddPlanToDiscountResponse.ProtoReflect.Descriptor instead.
 func (*AddPlanToDiscountResponse) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{80}
+	return file_product_proto_rawDescGZIP(), []int{88}
 }
 
 func (x *AddPlanToDiscountResponse) GetSuccess() bool {
@@ -5531,7 +6116,7 @@ type RemovePlanFromDiscountRequest struct {
 
 func (x *RemovePlanFromDiscountRequest) Reset() {
 	*x = RemovePlanFromDiscountRequest{}
-	mi := &file_product_proto_msgTypes[81]
+	mi := &file_product_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5543,7 +6128,7 @@ func (x *RemovePlanFromDiscountRequest) String() string {
 func (*RemovePlanFromDiscountRequest) ProtoMessage() {}
 
 func (x *RemovePlanFromDiscountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[81]
+	mi := &file_product_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5556,7 +6141,7 @@ func (x *RemovePlanFromDiscountRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemovePlanFromDiscountRequest.ProtoReflect.Descriptor instead.
 func (*RemovePlanFromDiscountRequest) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{81}
+	return file_product_proto_rawDescGZIP(), []int{89}
 }
 
 func (x *RemovePlanFromDiscountRequest) GetDiscountId() int64 {
@@ -5583,7 +6168,7 @@ type RemovePlanFromDiscountResponse struct {
 
 func (x *RemovePlanFromDiscountResponse) Reset() {
 	*x = RemovePlanFromDiscountResponse{}
-	mi := &file_product_proto_msgTypes[82]
+	mi := &file_product_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5595,7 +6180,7 @@ func (x *RemovePlanFromDiscountResponse) String() string {
 func (*RemovePlanFromDiscountResponse) ProtoMessage() {}
 
 func (x *RemovePlanFromDiscountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[82]
+	mi := &file_product_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5608,7 +6193,7 @@ func (x *RemovePlanFromDiscountResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemovePlanFromDiscountResponse.ProtoReflect.Descriptor instead.
 func (*RemovePlanFromDiscountResponse) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{82}
+	return file_product_proto_rawDescGZIP(), []int{90}
 }
 
 func (x *RemovePlanFromDiscountResponse) GetSuccess() bool {
@@ -5634,7 +6219,7 @@ type GetPlansByDiscountRequest struct {
 
 func (x *GetPlansByDiscountRequest) Reset() {
 	*x = GetPlansByDiscountRequest{}
-	mi := &file_product_proto_msgTypes[83]
+	mi := &file_product_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5646,7 +6231,7 @@ func (x *GetPlansByDiscountRequest) String() string {
 func (*GetPlansByDiscountRequest) ProtoMessage() {}
 
 func (x *GetPlansByDiscountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[83]
+	mi := &file_product_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5659,7 +6244,7 @@ func (x *GetPlansByDiscountRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPlansByDiscountRequest.ProtoReflect.Descriptor instead.
 func (*GetPlansByDiscountRequest) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{83}
+	return file_product_proto_rawDescGZIP(), []int{91}
 }
 
 func (x *GetPlansByDiscountRequest) GetDiscountId() int64 {
@@ -5680,7 +6265,7 @@ type GetPlansByDiscountResponse struct {
 
 func (x *GetPlansByDiscountResponse) Reset() {
 	*x = GetPlansByDiscountResponse{}
-	mi := &file_product_proto_msgTypes[84]
+	mi := &file_product_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5692,7 +6277,7 @@ func (x *GetPlansByDiscountResponse) String() string {
 func (*GetPlansByDiscountResponse) ProtoMessage() {}
 
 func (x *GetPlansByDiscountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[84]
+	mi := &file_product_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5705,7 +6290,7 @@ func (x *GetPlansByDiscountResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPlansByDiscountResponse.ProtoReflect.Descriptor instead.
 func (*GetPlansByDiscountResponse) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{84}
+	return file_product_proto_rawDescGZIP(), []int{92}
 }
 
 func (x *GetPlansByDiscountResponse) GetSuccess() bool {
@@ -5742,7 +6327,7 @@ type DiscountOneTimeProduct struct {
 
 func (x *DiscountOneTimeProduct) Reset() {
 	*x = DiscountOneTimeProduct{}
-	mi := &file_product_proto_msgTypes[85]
+	mi := &file_product_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5754,7 +6339,7 @@ func (x *DiscountOneTimeProduct) String() string {
 func (*DiscountOneTimeProduct) ProtoMessage() {}
 
 func (x *DiscountOneTimeProduct) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[85]
+	mi := &file_product_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5767,7 +6352,7 @@ func (x *DiscountOneTimeProduct) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DiscountOneTimeProduct.ProtoReflect.Descriptor instead.
 func (*DiscountOneTimeProduct) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{85}
+	return file_product_proto_rawDescGZIP(), []int{93}
 }
 
 func (x *DiscountOneTimeProduct) GetId() int64 {
@@ -5815,7 +6400,7 @@ type AddOneTimeProductToDiscountRequest struct {
 
 func (x *AddOneTimeProductToDiscountRequest) Reset() {
 	*x = AddOneTimeProductToDiscountRequest{}
-	mi := &file_product_proto_msgTypes[86]
+	mi := &file_product_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5827,7 +6412,7 @@ func (x *AddOneTimeProductToDiscountRequest) String() string {
 func (*AddOneTimeProductToDiscountRequest) ProtoMessage() {}
 
 func (x *AddOneTimeProductToDiscountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[86]
+	mi := &file_product_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5840,7 +6425,7 @@ func (x *AddOneTimeProductToDiscountRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use AddOneTimeProductToDiscountRequest.ProtoReflect.Descriptor instead.
 func (*AddOneTimeProductToDiscountRequest) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{86}
+	return file_product_proto_rawDescGZIP(), []int{94}
 }
 
 func (x *AddOneTimeProductToDiscountRequest) GetDiscountId() int64 {
@@ -5868,7 +6453,7 @@ type AddOneTimeProductToDiscountResponse struct {
 
 func (x *AddOneTimeProductToDiscountResponse) Reset() {
 	*x = AddOneTimeProductToDiscountResponse{}
-	mi := &file_product_proto_msgTypes[87]
+	mi := &file_product_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5880,7 +6465,7 @@ func (x *AddOneTimeProductToDiscountResponse) String() string {
 func (*AddOneTimeProductToDiscountResponse) ProtoMessage() {}
 
 func (x *AddOneTimeProductToDiscountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[87]
+	mi := &file_product_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5893,7 +6478,7 @@ func (x *AddOneTimeProductToDiscountResponse) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use AddOneTimeProductToDiscountResponse.ProtoReflect.Descriptor instead.
 func (*AddOneTimeProductToDiscountResponse) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{87}
+	return file_product_proto_rawDescGZIP(), []int{95}
 }
 
 func (x *AddOneTimeProductToDiscountResponse) GetSuccess() bool {
@@ -5927,7 +6512,7 @@ type RemoveOneTimeProductFromDiscountRequest struct {
 
 func (x *RemoveOneTimeProductFromDiscountRequest) Reset() {
 	*x = RemoveOneTimeProductFromDiscountRequest{}
-	mi := &file_product_proto_msgTypes[88]
+	mi := &file_product_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5939,7 +6524,7 @@ func (x *RemoveOneTimeProductFromDiscountRequest) String() string {
 func (*RemoveOneTimeProductFromDiscountRequest) ProtoMessage() {}
 
 func (x *RemoveOneTimeProductFromDiscountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[88]
+	mi := &file_product_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5952,7 +6537,7 @@ func (x *RemoveOneTimeProductFromDiscountRequest) ProtoReflect() protoreflect.Me
 
 // Deprecated: Use RemoveOneTimeProductFromDiscountRequest.ProtoReflect.Descriptor instead.
 func (*RemoveOneTimeProductFromDiscountRequest) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{88}
+	return file_product_proto_rawDescGZIP(), []int{96}
 }
 
 func (x *RemoveOneTimeProductFromDiscountRequest) GetDiscountId() int64 {
@@ -5979,7 +6564,7 @@ type RemoveOneTimeProductFromDiscountResponse struct {
 
 func (x *RemoveOneTimeProductFromDiscountResponse) Reset() {
 	*x = RemoveOneTimeProductFromDiscountResponse{}
-	mi := &file_product_proto_msgTypes[89]
+	mi := &file_product_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5991,7 +6576,7 @@ func (x *RemoveOneTimeProductFromDiscountResponse) String() string {
 func (*RemoveOneTimeProductFromDiscountResponse) ProtoMessage() {}
 
 func (x *RemoveOneTimeProductFromDiscountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[89]
+	mi := &file_product_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6004,7 +6589,7 @@ func (x *RemoveOneTimeProductFromDiscountResponse) ProtoReflect() protoreflect.M
 
 // Deprecated: Use RemoveOneTimeProductFromDiscountResponse.ProtoReflect.Descriptor instead.
 func (*RemoveOneTimeProductFromDiscountResponse) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{89}
+	return file_product_proto_rawDescGZIP(), []int{97}
 }
 
 func (x *RemoveOneTimeProductFromDiscountResponse) GetSuccess() bool {
@@ -6030,7 +6615,7 @@ type GetOneTimeProductsByDiscountRequest struct {
 
 func (x *GetOneTimeProductsByDiscountRequest) Reset() {
 	*x = GetOneTimeProductsByDiscountRequest{}
-	mi := &file_product_proto_msgTypes[90]
+	mi := &file_product_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6042,7 +6627,7 @@ func (x *GetOneTimeProductsByDiscountRequest) String() string {
 func (*GetOneTimeProductsByDiscountRequest) ProtoMessage() {}
 
 func (x *GetOneTimeProductsByDiscountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[90]
+	mi := &file_product_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6055,7 +6640,7 @@ func (x *GetOneTimeProductsByDiscountRequest) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use GetOneTimeProductsByDiscountRequest.ProtoReflect.Descriptor instead.
 func (*GetOneTimeProductsByDiscountRequest) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{90}
+	return file_product_proto_rawDescGZIP(), []int{98}
 }
 
 func (x *GetOneTimeProductsByDiscountRequest) GetDiscountId() int64 {
@@ -6076,7 +6661,7 @@ type GetOneTimeProductsByDiscountResponse struct {
 
 func (x *GetOneTimeProductsByDiscountResponse) Reset() {
 	*x = GetOneTimeProductsByDiscountResponse{}
-	mi := &file_product_proto_msgTypes[91]
+	mi := &file_product_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6088,7 +6673,7 @@ func (x *GetOneTimeProductsByDiscountResponse) String() string {
 func (*GetOneTimeProductsByDiscountResponse) ProtoMessage() {}
 
 func (x *GetOneTimeProductsByDiscountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[91]
+	mi := &file_product_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6101,7 +6686,7 @@ func (x *GetOneTimeProductsByDiscountResponse) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use GetOneTimeProductsByDiscountResponse.ProtoReflect.Descriptor instead.
 func (*GetOneTimeProductsByDiscountResponse) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{91}
+	return file_product_proto_rawDescGZIP(), []int{99}
 }
 
 func (x *GetOneTimeProductsByDiscountResponse) GetSuccess() bool {
@@ -6139,7 +6724,7 @@ type DiscountPaymentProviderData struct {
 
 func (x *DiscountPaymentProviderData) Reset() {
 	*x = DiscountPaymentProviderData{}
-	mi := &file_product_proto_msgTypes[92]
+	mi := &file_product_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6151,7 +6736,7 @@ func (x *DiscountPaymentProviderData) String() string {
 func (*DiscountPaymentProviderData) ProtoMessage() {}
 
 func (x *DiscountPaymentProviderData) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[92]
+	mi := &file_product_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6164,7 +6749,7 @@ func (x *DiscountPaymentProviderData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DiscountPaymentProviderData.ProtoReflect.Descriptor instead.
 func (*DiscountPaymentProviderData) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{92}
+	return file_product_proto_rawDescGZIP(), []int{100}
 }
 
 func (x *DiscountPaymentProviderData) GetId() int64 {
@@ -6219,7 +6804,7 @@ type GetDiscountPaymentProviderDataRequest struct {
 
 func (x *GetDiscountPaymentProviderDataRequest) Reset() {
 	*x = GetDiscountPaymentProviderDataRequest{}
-	mi := &file_product_proto_msgTypes[93]
+	mi := &file_product_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6231,7 +6816,7 @@ func (x *GetDiscountPaymentProviderDataRequest) String() string {
 func (*GetDiscountPaymentProviderDataRequest) ProtoMessage() {}
 
 func (x *GetDiscountPaymentProviderDataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[93]
+	mi := &file_product_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6244,7 +6829,7 @@ func (x *GetDiscountPaymentProviderDataRequest) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use GetDiscountPaymentProviderDataRequest.ProtoReflect.Descriptor instead.
 func (*GetDiscountPaymentProviderDataRequest) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{93}
+	return file_product_proto_rawDescGZIP(), []int{101}
 }
 
 func (x *GetDiscountPaymentProviderDataRequest) GetDiscountId() int64 {
@@ -6272,7 +6857,7 @@ type GetDiscountPaymentProviderDataResponse struct {
 
 func (x *GetDiscountPaymentProviderDataResponse) Reset() {
 	*x = GetDiscountPaymentProviderDataResponse{}
-	mi := &file_product_proto_msgTypes[94]
+	mi := &file_product_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6284,7 +6869,7 @@ func (x *GetDiscountPaymentProviderDataResponse) String() string {
 func (*GetDiscountPaymentProviderDataResponse) ProtoMessage() {}
 
 func (x *GetDiscountPaymentProviderDataResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[94]
+	mi := &file_product_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6297,7 +6882,7 @@ func (x *GetDiscountPaymentProviderDataResponse) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use GetDiscountPaymentProviderDataResponse.ProtoReflect.Descriptor instead.
 func (*GetDiscountPaymentProviderDataResponse) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{94}
+	return file_product_proto_rawDescGZIP(), []int{102}
 }
 
 func (x *GetDiscountPaymentProviderDataResponse) GetSuccess() bool {
@@ -6332,7 +6917,7 @@ type CreateDiscountPaymentProviderDataRequest struct {
 
 func (x *CreateDiscountPaymentProviderDataRequest) Reset() {
 	*x = CreateDiscountPaymentProviderDataRequest{}
-	mi := &file_product_proto_msgTypes[95]
+	mi := &file_product_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6344,7 +6929,7 @@ func (x *CreateDiscountPaymentProviderDataRequest) String() string {
 func (*CreateDiscountPaymentProviderDataRequest) ProtoMessage() {}
 
 func (x *CreateDiscountPaymentProviderDataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[95]
+	mi := &file_product_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6357,7 +6942,7 @@ func (x *CreateDiscountPaymentProviderDataRequest) ProtoReflect() protoreflect.M
 
 // Deprecated: Use CreateDiscountPaymentProviderDataRequest.ProtoReflect.Descriptor instead.
 func (*CreateDiscountPaymentProviderDataRequest) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{95}
+	return file_product_proto_rawDescGZIP(), []int{103}
 }
 
 func (x *CreateDiscountPaymentProviderDataRequest) GetDiscountId() int64 {
@@ -6392,7 +6977,7 @@ type CreateDiscountPaymentProviderDataResponse struct {
 
 func (x *CreateDiscountPaymentProviderDataResponse) Reset() {
 	*x = CreateDiscountPaymentProviderDataResponse{}
-	mi := &file_product_proto_msgTypes[96]
+	mi := &file_product_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6404,7 +6989,7 @@ func (x *CreateDiscountPaymentProviderDataResponse) String() string {
 func (*CreateDiscountPaymentProviderDataResponse) ProtoMessage() {}
 
 func (x *CreateDiscountPaymentProviderDataResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[96]
+	mi := &file_product_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6417,7 +7002,7 @@ func (x *CreateDiscountPaymentProviderDataResponse) ProtoReflect() protoreflect.
 
 // Deprecated: Use CreateDiscountPaymentProviderDataResponse.ProtoReflect.Descriptor instead.
 func (*CreateDiscountPaymentProviderDataResponse) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{96}
+	return file_product_proto_rawDescGZIP(), []int{104}
 }
 
 func (x *CreateDiscountPaymentProviderDataResponse) GetSuccess() bool {
@@ -6451,7 +7036,7 @@ type UpdateDiscountPaymentProviderDataRequest struct {
 
 func (x *UpdateDiscountPaymentProviderDataRequest) Reset() {
 	*x = UpdateDiscountPaymentProviderDataRequest{}
-	mi := &file_product_proto_msgTypes[97]
+	mi := &file_product_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6463,7 +7048,7 @@ func (x *UpdateDiscountPaymentProviderDataRequest) String() string {
 func (*UpdateDiscountPaymentProviderDataRequest) ProtoMessage() {}
 
 func (x *UpdateDiscountPaymentProviderDataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[97]
+	mi := &file_product_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6476,7 +7061,7 @@ func (x *UpdateDiscountPaymentProviderDataRequest) ProtoReflect() protoreflect.M
 
 // Deprecated: Use UpdateDiscountPaymentProviderDataRequest.ProtoReflect.Descriptor instead.
 func (*UpdateDiscountPaymentProviderDataRequest) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{97}
+	return file_product_proto_rawDescGZIP(), []int{105}
 }
 
 func (x *UpdateDiscountPaymentProviderDataRequest) GetId() int64 {
@@ -6504,7 +7089,7 @@ type UpdateDiscountPaymentProviderDataResponse struct {
 
 func (x *UpdateDiscountPaymentProviderDataResponse) Reset() {
 	*x = UpdateDiscountPaymentProviderDataResponse{}
-	mi := &file_product_proto_msgTypes[98]
+	mi := &file_product_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6516,7 +7101,7 @@ func (x *UpdateDiscountPaymentProviderDataResponse) String() string {
 func (*UpdateDiscountPaymentProviderDataResponse) ProtoMessage() {}
 
 func (x *UpdateDiscountPaymentProviderDataResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[98]
+	mi := &file_product_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6529,7 +7114,7 @@ func (x *UpdateDiscountPaymentProviderDataResponse) ProtoReflect() protoreflect.
 
 // Deprecated: Use UpdateDiscountPaymentProviderDataResponse.ProtoReflect.Descriptor instead.
 func (*UpdateDiscountPaymentProviderDataResponse) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{98}
+	return file_product_proto_rawDescGZIP(), []int{106}
 }
 
 func (x *UpdateDiscountPaymentProviderDataResponse) GetSuccess() bool {
@@ -6562,7 +7147,7 @@ type DeleteDiscountPaymentProviderDataRequest struct {
 
 func (x *DeleteDiscountPaymentProviderDataRequest) Reset() {
 	*x = DeleteDiscountPaymentProviderDataRequest{}
-	mi := &file_product_proto_msgTypes[99]
+	mi := &file_product_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6574,7 +7159,7 @@ func (x *DeleteDiscountPaymentProviderDataRequest) String() string {
 func (*DeleteDiscountPaymentProviderDataRequest) ProtoMessage() {}
 
 func (x *DeleteDiscountPaymentProviderDataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[99]
+	mi := &file_product_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6587,7 +7172,7 @@ func (x *DeleteDiscountPaymentProviderDataRequest) ProtoReflect() protoreflect.M
 
 // Deprecated: Use DeleteDiscountPaymentProviderDataRequest.ProtoReflect.Descriptor instead.
 func (*DeleteDiscountPaymentProviderDataRequest) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{99}
+	return file_product_proto_rawDescGZIP(), []int{107}
 }
 
 func (x *DeleteDiscountPaymentProviderDataRequest) GetId() int64 {
@@ -6607,7 +7192,7 @@ type DeleteDiscountPaymentProviderDataResponse struct {
 
 func (x *DeleteDiscountPaymentProviderDataResponse) Reset() {
 	*x = DeleteDiscountPaymentProviderDataResponse{}
-	mi := &file_product_proto_msgTypes[100]
+	mi := &file_product_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6619,7 +7204,7 @@ func (x *DeleteDiscountPaymentProviderDataResponse) String() string {
 func (*DeleteDiscountPaymentProviderDataResponse) ProtoMessage() {}
 
 func (x *DeleteDiscountPaymentProviderDataResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[100]
+	mi := &file_product_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6632,7 +7217,7 @@ func (x *DeleteDiscountPaymentProviderDataResponse) ProtoReflect() protoreflect.
 
 // Deprecated: Use DeleteDiscountPaymentProviderDataResponse.ProtoReflect.Descriptor instead.
 func (*DeleteDiscountPaymentProviderDataResponse) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{100}
+	return file_product_proto_rawDescGZIP(), []int{108}
 }
 
 func (x *DeleteDiscountPaymentProviderDataResponse) GetSuccess() bool {
@@ -6658,13 +7243,14 @@ type DiscountCodeRedemption struct {
 	OrderId        int64                  `protobuf:"varint,5,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
 	CreatedAt      int64                  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt      int64                  `protobuf:"varint,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	RedeemedAt     int64                  `protobuf:"varint,8,opt,name=redeemed_at,json=redeemedAt,proto3" json:"redeemed_at,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *DiscountCodeRedemption) Reset() {
 	*x = DiscountCodeRedemption{}
-	mi := &file_product_proto_msgTypes[101]
+	mi := &file_product_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6676,7 +7262,7 @@ func (x *DiscountCodeRedemption) String() string {
 func (*DiscountCodeRedemption) ProtoMessage() {}
 
 func (x *DiscountCodeRedemption) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[101]
+	mi := &file_product_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6689,7 +7275,7 @@ func (x *DiscountCodeRedemption) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DiscountCodeRedemption.ProtoReflect.Descriptor instead.
 func (*DiscountCodeRedemption) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{101}
+	return file_product_proto_rawDescGZIP(), []int{109}
 }
 
 func (x *DiscountCodeRedemption) GetId() int64 {
@@ -6741,6 +7327,13 @@ func (x *DiscountCodeRedemption) GetUpdatedAt() int64 {
 	return 0
 }
 
+func (x *DiscountCodeRedemption) GetRedeemedAt() int64 {
+	if x != nil {
+		return x.RedeemedAt
+	}
+	return 0
+}
+
 type RedeemDiscountCodeRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Code           string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
@@ -6753,7 +7346,7 @@ type RedeemDiscountCodeRequest struct {
 
 func (x *RedeemDiscountCodeRequest) Reset() {
 	*x = RedeemDiscountCodeRequest{}
-	mi := &file_product_proto_msgTypes[102]
+	mi := &file_product_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6765,7 +7358,7 @@ func (x *RedeemDiscountCodeRequest) String() string {
 func (*RedeemDiscountCodeRequest) ProtoMessage() {}
 
 func (x *RedeemDiscountCodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[102]
+	mi := &file_product_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6778,7 +7371,7 @@ func (x *RedeemDiscountCodeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RedeemDiscountCodeRequest.ProtoReflect.Descriptor instead.
 func (*RedeemDiscountCodeRequest) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{102}
+	return file_product_proto_rawDescGZIP(), []int{110}
 }
 
 func (x *RedeemDiscountCodeRequest) GetCode() string {
@@ -6820,7 +7413,7 @@ type RedeemDiscountCodeResponse struct {
 
 func (x *RedeemDiscountCodeResponse) Reset() {
 	*x = RedeemDiscountCodeResponse{}
-	mi := &file_product_proto_msgTypes[103]
+	mi := &file_product_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6832,7 +7425,7 @@ func (x *RedeemDiscountCodeResponse) String() string {
 func (*RedeemDiscountCodeResponse) ProtoMessage() {}
 
 func (x *RedeemDiscountCodeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[103]
+	mi := &file_product_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6845,7 +7438,7 @@ func (x *RedeemDiscountCodeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RedeemDiscountCodeResponse.ProtoReflect.Descriptor instead.
 func (*RedeemDiscountCodeResponse) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{103}
+	return file_product_proto_rawDescGZIP(), []int{111}
 }
 
 func (x *RedeemDiscountCodeResponse) GetSuccess() bool {
@@ -6880,7 +7473,7 @@ type GetRedemptionsByUserRequest struct {
 
 func (x *GetRedemptionsByUserRequest) Reset() {
 	*x = GetRedemptionsByUserRequest{}
-	mi := &file_product_proto_msgTypes[104]
+	mi := &file_product_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6892,7 +7485,7 @@ func (x *GetRedemptionsByUserRequest) String() string {
 func (*GetRedemptionsByUserRequest) ProtoMessage() {}
 
 func (x *GetRedemptionsByUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[104]
+	mi := &file_product_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6905,7 +7498,7 @@ func (x *GetRedemptionsByUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRedemptionsByUserRequest.ProtoReflect.Descriptor instead.
 func (*GetRedemptionsByUserRequest) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{104}
+	return file_product_proto_rawDescGZIP(), []int{112}
 }
 
 func (x *GetRedemptionsByUserRequest) GetUserId() int64 {
@@ -6941,7 +7534,7 @@ type GetRedemptionsByUserData struct {
 
 func (x *GetRedemptionsByUserData) Reset() {
 	*x = GetRedemptionsByUserData{}
-	mi := &file_product_proto_msgTypes[105]
+	mi := &file_product_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6953,7 +7546,7 @@ func (x *GetRedemptionsByUserData) String() string {
 func (*GetRedemptionsByUserData) ProtoMessage() {}
 
 func (x *GetRedemptionsByUserData) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[105]
+	mi := &file_product_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6966,7 +7559,7 @@ func (x *GetRedemptionsByUserData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRedemptionsByUserData.ProtoReflect.Descriptor instead.
 func (*GetRedemptionsByUserData) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{105}
+	return file_product_proto_rawDescGZIP(), []int{113}
 }
 
 func (x *GetRedemptionsByUserData) GetRedemptions() []*DiscountCodeRedemption {
@@ -7008,7 +7601,7 @@ type GetRedemptionsByUserResponse struct {
 
 func (x *GetRedemptionsByUserResponse) Reset() {
 	*x = GetRedemptionsByUserResponse{}
-	mi := &file_product_proto_msgTypes[106]
+	mi := &file_product_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7020,7 +7613,7 @@ func (x *GetRedemptionsByUserResponse) String() string {
 func (*GetRedemptionsByUserResponse) ProtoMessage() {}
 
 func (x *GetRedemptionsByUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[106]
+	mi := &file_product_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7033,7 +7626,7 @@ func (x *GetRedemptionsByUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRedemptionsByUserResponse.ProtoReflect.Descriptor instead.
 func (*GetRedemptionsByUserResponse) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{106}
+	return file_product_proto_rawDescGZIP(), []int{114}
 }
 
 func (x *GetRedemptionsByUserResponse) GetSuccess() bool {
@@ -7068,7 +7661,7 @@ type GetRedemptionsByDiscountCodeRequest struct {
 
 func (x *GetRedemptionsByDiscountCodeRequest) Reset() {
 	*x = GetRedemptionsByDiscountCodeRequest{}
-	mi := &file_product_proto_msgTypes[107]
+	mi := &file_product_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7080,7 +7673,7 @@ func (x *GetRedemptionsByDiscountCodeRequest) String() string {
 func (*GetRedemptionsByDiscountCodeRequest) ProtoMessage() {}
 
 func (x *GetRedemptionsByDiscountCodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[107]
+	mi := &file_product_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7093,7 +7686,7 @@ func (x *GetRedemptionsByDiscountCodeRequest) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use GetRedemptionsByDiscountCodeRequest.ProtoReflect.Descriptor instead.
 func (*GetRedemptionsByDiscountCodeRequest) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{107}
+	return file_product_proto_rawDescGZIP(), []int{115}
 }
 
 func (x *GetRedemptionsByDiscountCodeRequest) GetDiscountCodeId() int64 {
@@ -7127,21 +7720,4508 @@ type GetRedemptionsByDiscountCodeData struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRedemptionsByDiscountCodeData) Reset() {
-	*x = GetRedemptionsByDiscountCodeData{}
-	mi := &file_product_proto_msgTypes[108]
+func (x *GetRedemptionsByDiscountCodeData) Reset() {
+	*x = GetRedemptionsByDiscountCodeData{}
+	mi := &file_product_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRedemptionsByDiscountCodeData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRedemptionsByDiscountCodeData) ProtoMessage() {}
+
+func (x *GetRedemptionsByDiscountCodeData) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRedemptionsByDiscountCodeData.ProtoReflect.Descriptor instead.
+func (*GetRedemptionsByDiscountCodeData) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *GetRedemptionsByDiscountCodeData) GetRedemptions() []*DiscountCodeRedemption {
+	if x != nil {
+		return x.Redemptions
+	}
+	return nil
+}
+
+func (x *GetRedemptionsByDiscountCodeData) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetRedemptionsByDiscountCodeData) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetRedemptionsByDiscountCodeData) GetPerPage() int32 {
+	if x != nil {
+		return x.PerPage
+	}
+	return 0
+}
+
+type GetRedemptionsByDiscountCodeResponse struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Success       bool                              `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                            `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *GetRedemptionsByDiscountCodeData `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRedemptionsByDiscountCodeResponse) Reset() {
+	*x = GetRedemptionsByDiscountCodeResponse{}
+	mi := &file_product_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRedemptionsByDiscountCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRedemptionsByDiscountCodeResponse) ProtoMessage() {}
+
+func (x *GetRedemptionsByDiscountCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRedemptionsByDiscountCodeResponse.ProtoReflect.Descriptor instead.
+func (*GetRedemptionsByDiscountCodeResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *GetRedemptionsByDiscountCodeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetRedemptionsByDiscountCodeResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetRedemptionsByDiscountCodeResponse) GetData() *GetRedemptionsByDiscountCodeData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ValidateDiscountCodeRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Code             string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	UserId           int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PlanId           int64                  `protobuf:"varint,3,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`                                   // optional
+	OneTimeProductId int64                  `protobuf:"varint,4,opt,name=one_time_product_id,json=oneTimeProductId,proto3" json:"one_time_product_id,omitempty"` // optional
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ValidateDiscountCodeRequest) Reset() {
+	*x = ValidateDiscountCodeRequest{}
+	mi := &file_product_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateDiscountCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateDiscountCodeRequest) ProtoMessage() {}
+
+func (x *ValidateDiscountCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateDiscountCodeRequest.ProtoReflect.Descriptor instead.
+func (*ValidateDiscountCodeRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *ValidateDiscountCodeRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ValidateDiscountCodeRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ValidateDiscountCodeRequest) GetPlanId() int64 {
+	if x != nil {
+		return x.PlanId
+	}
+	return 0
+}
+
+func (x *ValidateDiscountCodeRequest) GetOneTimeProductId() int64 {
+	if x != nil {
+		return x.OneTimeProductId
+	}
+	return 0
+}
+
+type ValidateDiscountCodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	IsValid       bool                   `protobuf:"varint,3,opt,name=is_valid,json=isValid,proto3" json:"is_valid,omitempty"`
+	Discount      *Discount              `protobuf:"bytes,4,opt,name=discount,proto3" json:"discount,omitempty"`
+	ErrorReason   string                 `protobuf:"bytes,5,opt,name=error_reason,json=errorReason,proto3" json:"error_reason,omitempty"` // expired, max_redemptions_reached, not_active, etc.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateDiscountCodeResponse) Reset() {
+	*x = ValidateDiscountCodeResponse{}
+	mi := &file_product_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateDiscountCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateDiscountCodeResponse) ProtoMessage() {}
+
+func (x *ValidateDiscountCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateDiscountCodeResponse.ProtoReflect.Descriptor instead.
+func (*ValidateDiscountCodeResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *ValidateDiscountCodeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ValidateDiscountCodeResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ValidateDiscountCodeResponse) GetIsValid() bool {
+	if x != nil {
+		return x.IsValid
+	}
+	return false
+}
+
+func (x *ValidateDiscountCodeResponse) GetDiscount() *Discount {
+	if x != nil {
+		return x.Discount
+	}
+	return nil
+}
+
+func (x *ValidateDiscountCodeResponse) GetErrorReason() string {
+	if x != nil {
+		return x.ErrorReason
+	}
+	return ""
+}
+
+type PreviewCheckoutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlanPriceId   int64                  `protobuf:"varint,1,opt,name=plan_price_id,json=planPriceId,proto3" json:"plan_price_id,omitempty"`
+	Quantity      int64                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`                            // quantities below 1 are priced as 1
+	DiscountCode  string                 `protobuf:"bytes,3,opt,name=discount_code,json=discountCode,proto3" json:"discount_code,omitempty"` // optional
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewCheckoutRequest) Reset() {
+	*x = PreviewCheckoutRequest{}
+	mi := &file_product_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewCheckoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewCheckoutRequest) ProtoMessage() {}
+
+func (x *PreviewCheckoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewCheckoutRequest.ProtoReflect.Descriptor instead.
+func (*PreviewCheckoutRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *PreviewCheckoutRequest) GetPlanPriceId() int64 {
+	if x != nil {
+		return x.PlanPriceId
+	}
+	return 0
+}
+
+func (x *PreviewCheckoutRequest) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *PreviewCheckoutRequest) GetDiscountCode() string {
+	if x != nil {
+		return x.DiscountCode
+	}
+	return ""
+}
+
+type CheckoutLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"` // plan, discount
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Quantity      int64                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UnitAmount    int64                  `protobuf:"varint,4,opt,name=unit_amount,json=unitAmount,proto3" json:"unit_amount,omitempty"`
+	Amount        int64                  `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"` // negative for discounts
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckoutLine) Reset() {
+	*x = CheckoutLine{}
+	mi := &file_product_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckoutLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckoutLine) ProtoMessage() {}
+
+func (x *CheckoutLine) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckoutLine.ProtoReflect.Descriptor instead.
+func (*CheckoutLine) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *CheckoutLine) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CheckoutLine) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CheckoutLine) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *CheckoutLine) GetUnitAmount() int64 {
+	if x != nil {
+		return x.UnitAmount
+	}
+	return 0
+}
+
+func (x *CheckoutLine) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type CheckoutPreview struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PlanId          int64                  `protobuf:"varint,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	PlanPriceId     int64                  `protobuf:"varint,2,opt,name=plan_price_id,json=planPriceId,proto3" json:"plan_price_id,omitempty"`
+	CurrencyId      int64                  `protobuf:"varint,3,opt,name=currency_id,json=currencyId,proto3" json:"currency_id,omitempty"`
+	Quantity        int64                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Lines           []*CheckoutLine        `protobuf:"bytes,5,rep,name=lines,proto3" json:"lines,omitempty"`
+	Subtotal        int64                  `protobuf:"varint,6,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
+	DiscountAmount  int64                  `protobuf:"varint,7,opt,name=discount_amount,json=discountAmount,proto3" json:"discount_amount,omitempty"`
+	Total           int64                  `protobuf:"varint,8,opt,name=total,proto3" json:"total,omitempty"`                                         // due for the first billing cycle
+	RecurringTotal  int64                  `protobuf:"varint,9,opt,name=recurring_total,json=recurringTotal,proto3" json:"recurring_total,omitempty"` // per cycle once the discount no longer applies
+	Discount        *Discount              `protobuf:"bytes,10,opt,name=discount,proto3" json:"discount,omitempty"`
+	DiscountCode    *DiscountCode          `protobuf:"bytes,11,opt,name=discount_code,json=discountCode,proto3" json:"discount_code,omitempty"`
+	DiscountCycles  int32                  `protobuf:"varint,12,opt,name=discount_cycles,json=discountCycles,proto3" json:"discount_cycles,omitempty"` // cycles covered, the first included
+	DiscountForever bool                   `protobuf:"varint,13,opt,name=discount_forever,json=discountForever,proto3" json:"discount_forever,omitempty"`
+	TrialDays       int32                  `protobuf:"varint,14,opt,name=trial_days,json=trialDays,proto3" json:"trial_days,omitempty"`
+	BonusDays       int32                  `protobuf:"varint,15,opt,name=bonus_days,json=bonusDays,proto3" json:"bonus_days,omitempty"`
+	TrialEndsAt     int64                  `protobuf:"varint,16,opt,name=trial_ends_at,json=trialEndsAt,proto3" json:"trial_ends_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CheckoutPreview) Reset() {
+	*x = CheckoutPreview{}
+	mi := &file_product_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckoutPreview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckoutPreview) ProtoMessage() {}
+
+func (x *CheckoutPreview) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckoutPreview.ProtoReflect.Descriptor instead.
+func (*CheckoutPreview) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *CheckoutPreview) GetPlanId() int64 {
+	if x != nil {
+		return x.PlanId
+	}
+	return 0
+}
+
+func (x *CheckoutPreview) GetPlanPriceId() int64 {
+	if x != nil {
+		return x.PlanPriceId
+	}
+	return 0
+}
+
+func (x *CheckoutPreview) GetCurrencyId() int64 {
+	if x != nil {
+		return x.CurrencyId
+	}
+	return 0
+}
+
+func (x *CheckoutPreview) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *CheckoutPreview) GetLines() []*CheckoutLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *CheckoutPreview) GetSubtotal() int64 {
+	if x != nil {
+		return x.Subtotal
+	}
+	return 0
+}
+
+func (x *CheckoutPreview) GetDiscountAmount() int64 {
+	if x != nil {
+		return x.DiscountAmount
+	}
+	return 0
+}
+
+func (x *CheckoutPreview) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *CheckoutPreview) GetRecurringTotal() int64 {
+	if x != nil {
+		return x.RecurringTotal
+	}
+	return 0
+}
+
+func (x *CheckoutPreview) GetDiscount() *Discount {
+	if x != nil {
+		return x.Discount
+	}
+	return nil
+}
+
+func (x *CheckoutPreview) GetDiscountCode() *DiscountCode {
+	if x != nil {
+		return x.DiscountCode
+	}
+	return nil
+}
+
+func (x *CheckoutPreview) GetDiscountCycles() int32 {
+	if x != nil {
+		return x.DiscountCycles
+	}
+	return 0
+}
+
+func (x *CheckoutPreview) GetDiscountForever() bool {
+	if x != nil {
+		return x.DiscountForever
+	}
+	return false
+}
+
+func (x *CheckoutPreview) GetTrialDays() int32 {
+	if x != nil {
+		return x.TrialDays
+	}
+	return 0
+}
+
+func (x *CheckoutPreview) GetBonusDays() int32 {
+	if x != nil {
+		return x.BonusDays
+	}
+	return 0
+}
+
+func (x *CheckoutPreview) GetTrialEndsAt() int64 {
+	if x != nil {
+		return x.TrialEndsAt
+	}
+	return 0
+}
+
+type PreviewCheckoutResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *CheckoutPreview       `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewCheckoutResponse) Reset() {
+	*x = PreviewCheckoutResponse{}
+	mi := &file_product_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewCheckoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewCheckoutResponse) ProtoMessage() {}
+
+func (x *PreviewCheckoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewCheckoutResponse.ProtoReflect.Descriptor instead.
+func (*PreviewCheckoutResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *PreviewCheckoutResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *PreviewCheckoutResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PreviewCheckoutResponse) GetData() *CheckoutPreview {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type Referral struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	Id                       int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	DiscountCodeId           int64                  `protobuf:"varint,2,opt,name=discount_code_id,json=discountCodeId,proto3" json:"discount_code_id,omitempty"`
+	DiscountCodeRedemptionId int64                  `protobuf:"varint,3,opt,name=discount_code_redemption_id,json=discountCodeRedemptionId,proto3" json:"discount_code_redemption_id,omitempty"`
+	ReferrerUserId           int64                  `protobuf:"varint,4,opt,name=referrer_user_id,json=referrerUserId,proto3" json:"referrer_user_id,omitempty"`
+	ReferredUserId           int64                  `protobuf:"varint,5,opt,name=referred_user_id,json=referredUserId,proto3" json:"referred_user_id,omitempty"`
+	SubscriptionId           int64                  `protobuf:"varint,6,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	Status                   string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"` // pending, converted
+	RewardBonusDays          int32                  `protobuf:"varint,8,opt,name=reward_bonus_days,json=rewardBonusDays,proto3" json:"reward_bonus_days,omitempty"`
+	ConvertedAt              int64                  `protobuf:"varint,9,opt,name=converted_at,json=convertedAt,proto3" json:"converted_at,omitempty"`
+	CreatedAt                int64                  `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt                int64                  `protobuf:"varint,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *Referral) Reset() {
+	*x = Referral{}
+	mi := &file_product_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Referral) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Referral) ProtoMessage() {}
+
+func (x *Referral) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Referral.ProtoReflect.Descriptor instead.
+func (*Referral) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *Referral) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Referral) GetDiscountCodeId() int64 {
+	if x != nil {
+		return x.DiscountCodeId
+	}
+	return 0
+}
+
+func (x *Referral) GetDiscountCodeRedemptionId() int64 {
+	if x != nil {
+		return x.DiscountCodeRedemptionId
+	}
+	return 0
+}
+
+func (x *Referral) GetReferrerUserId() int64 {
+	if x != nil {
+		return x.ReferrerUserId
+	}
+	return 0
+}
+
+func (x *Referral) GetReferredUserId() int64 {
+	if x != nil {
+		return x.ReferredUserId
+	}
+	return 0
+}
+
+func (x *Referral) GetSubscriptionId() int64 {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return 0
+}
+
+func (x *Referral) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Referral) GetRewardBonusDays() int32 {
+	if x != nil {
+		return x.RewardBonusDays
+	}
+	return 0
+}
+
+func (x *Referral) GetConvertedAt() int64 {
+	if x != nil {
+		return x.ConvertedAt
+	}
+	return 0
+}
+
+func (x *Referral) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Referral) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type GetReferralCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReferralCodeRequest) Reset() {
+	*x = GetReferralCodeRequest{}
+	mi := &file_product_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReferralCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReferralCodeRequest) ProtoMessage() {}
+
+func (x *GetReferralCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReferralCodeRequest.ProtoReflect.Descriptor instead.
+func (*GetReferralCodeRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *GetReferralCodeRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetReferralCodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *DiscountCode          `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReferralCodeResponse) Reset() {
+	*x = GetReferralCodeResponse{}
+	mi := &file_product_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReferralCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReferralCodeResponse) ProtoMessage() {}
+
+func (x *GetReferralCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReferralCodeResponse.ProtoReflect.Descriptor instead.
+func (*GetReferralCodeResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *GetReferralCodeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetReferralCodeResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetReferralCodeResponse) GetData() *DiscountCode {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ReferralStats struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ReferrerUserId     int64                  `protobuf:"varint,1,opt,name=referrer_user_id,json=referrerUserId,proto3" json:"referrer_user_id,omitempty"`
+	Code               *DiscountCode          `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"` // unset until the user asks for a code
+	TotalReferrals     int32                  `protobuf:"varint,3,opt,name=total_referrals,json=totalReferrals,proto3" json:"total_referrals,omitempty"`
+	PendingReferrals   int32                  `protobuf:"varint,4,opt,name=pending_referrals,json=pendingReferrals,proto3" json:"pending_referrals,omitempty"`
+	ConvertedReferrals int32                  `protobuf:"varint,5,opt,name=converted_referrals,json=convertedReferrals,proto3" json:"converted_referrals,omitempty"`
+	BonusDaysEarned    int64                  `protobuf:"varint,6,opt,name=bonus_days_earned,json=bonusDaysEarned,proto3" json:"bonus_days_earned,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ReferralStats) Reset() {
+	*x = ReferralStats{}
+	mi := &file_product_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReferralStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReferralStats) ProtoMessage() {}
+
+func (x *ReferralStats) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReferralStats.ProtoReflect.Descriptor instead.
+func (*ReferralStats) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *ReferralStats) GetReferrerUserId() int64 {
+	if x != nil {
+		return x.ReferrerUserId
+	}
+	return 0
+}
+
+func (x *ReferralStats) GetCode() *DiscountCode {
+	if x != nil {
+		return x.Code
+	}
+	return nil
+}
+
+func (x *ReferralStats) GetTotalReferrals() int32 {
+	if x != nil {
+		return x.TotalReferrals
+	}
+	return 0
+}
+
+func (x *ReferralStats) GetPendingReferrals() int32 {
+	if x != nil {
+		return x.PendingReferrals
+	}
+	return 0
+}
+
+func (x *ReferralStats) GetConvertedReferrals() int32 {
+	if x != nil {
+		return x.ConvertedReferrals
+	}
+	return 0
+}
+
+func (x *ReferralStats) GetBonusDaysEarned() int64 {
+	if x != nil {
+		return x.BonusDaysEarned
+	}
+	return 0
+}
+
+type GetReferralStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReferralStatsRequest) Reset() {
+	*x = GetReferralStatsRequest{}
+	mi := &file_product_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReferralStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReferralStatsRequest) ProtoMessage() {}
+
+func (x *GetReferralStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReferralStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetReferralStatsRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *GetReferralStatsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetReferralStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *ReferralStats         `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReferralStatsResponse) Reset() {
+	*x = GetReferralStatsResponse{}
+	mi := &file_product_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReferralStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReferralStatsResponse) ProtoMessage() {}
+
+func (x *GetReferralStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReferralStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetReferralStatsResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *GetReferralStatsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetReferralStatsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetReferralStatsResponse) GetData() *ReferralStats {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GetReferralsByReferrerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PerPage       int32                  `protobuf:"varint,3,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReferralsByReferrerRequest) Reset() {
+	*x = GetReferralsByReferrerRequest{}
+	mi := &file_product_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReferralsByReferrerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReferralsByReferrerRequest) ProtoMessage() {}
+
+func (x *GetReferralsByReferrerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReferralsByReferrerRequest.ProtoReflect.Descriptor instead.
+func (*GetReferralsByReferrerRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *GetReferralsByReferrerRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetReferralsByReferrerRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetReferralsByReferrerRequest) GetPerPage() int32 {
+	if x != nil {
+		return x.PerPage
+	}
+	return 0
+}
+
+type GetReferralsByReferrerData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Referrals     []*Referral            `protobuf:"bytes,1,rep,name=referrals,proto3" json:"referrals,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PerPage       int32                  `protobuf:"varint,4,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReferralsByReferrerData) Reset() {
+	*x = GetReferralsByReferrerData{}
+	mi := &file_product_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReferralsByReferrerData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReferralsByReferrerData) ProtoMessage() {}
+
+func (x *GetReferralsByReferrerData) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReferralsByReferrerData.ProtoReflect.Descriptor instead.
+func (*GetReferralsByReferrerData) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *GetReferralsByReferrerData) GetReferrals() []*Referral {
+	if x != nil {
+		return x.Referrals
+	}
+	return nil
+}
+
+func (x *GetReferralsByReferrerData) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetReferralsByReferrerData) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetReferralsByReferrerData) GetPerPage() int32 {
+	if x != nil {
+		return x.PerPage
+	}
+	return 0
+}
+
+type GetReferralsByReferrerResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Success       bool                        `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                      `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *GetReferralsByReferrerData `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReferralsByReferrerResponse) Reset() {
+	*x = GetReferralsByReferrerResponse{}
+	mi := &file_product_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReferralsByReferrerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReferralsByReferrerResponse) ProtoMessage() {}
+
+func (x *GetReferralsByReferrerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReferralsByReferrerResponse.ProtoReflect.Descriptor instead.
+func (*GetReferralsByReferrerResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *GetReferralsByReferrerResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetReferralsByReferrerResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetReferralsByReferrerResponse) GetData() *GetReferralsByReferrerData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ProductPrice struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId     int64                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	CurrencyId    int64                  `protobuf:"varint,3,opt,name=currency_id,json=currencyId,proto3" json:"currency_id,omitempty"`
+	Price         int64                  `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     int64                  `protobuf:"varint,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductPrice) Reset() {
+	*x = ProductPrice{}
+	mi := &file_product_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductPrice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductPrice) ProtoMessage() {}
+
+func (x *ProductPrice) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductPrice.ProtoReflect.Descriptor instead.
+func (*ProductPrice) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *ProductPrice) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ProductPrice) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *ProductPrice) GetCurrencyId() int64 {
+	if x != nil {
+		return x.CurrencyId
+	}
+	return 0
+}
+
+func (x *ProductPrice) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *ProductPrice) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *ProductPrice) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type SetProductPriceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	CurrencyId    int64                  `protobuf:"varint,2,opt,name=currency_id,json=currencyId,proto3" json:"currency_id,omitempty"`
+	Price         int64                  `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetProductPriceRequest) Reset() {
+	*x = SetProductPriceRequest{}
+	mi := &file_product_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetProductPriceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetProductPriceRequest) ProtoMessage() {}
+
+func (x *SetProductPriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetProductPriceRequest.ProtoReflect.Descriptor instead.
+func (*SetProductPriceRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *SetProductPriceRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *SetProductPriceRequest) GetCurrencyId() int64 {
+	if x != nil {
+		return x.CurrencyId
+	}
+	return 0
+}
+
+func (x *SetProductPriceRequest) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+type SetProductPriceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *ProductPrice          `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetProductPriceResponse) Reset() {
+	*x = SetProductPriceResponse{}
+	mi := &file_product_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetProductPriceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetProductPriceResponse) ProtoMessage() {}
+
+func (x *SetProductPriceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetProductPriceResponse.ProtoReflect.Descriptor instead.
+func (*SetProductPriceResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *SetProductPriceResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SetProductPriceResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SetProductPriceResponse) GetData() *ProductPrice {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GetProductPricesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductPricesRequest) Reset() {
+	*x = GetProductPricesRequest{}
+	mi := &file_product_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductPricesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductPricesRequest) ProtoMessage() {}
+
+func (x *GetProductPricesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductPricesRequest.ProtoReflect.Descriptor instead.
+func (*GetProductPricesRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *GetProductPricesRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+type GetProductPricesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          []*ProductPrice        `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductPricesResponse) Reset() {
+	*x = GetProductPricesResponse{}
+	mi := &file_product_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductPricesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductPricesResponse) ProtoMessage() {}
+
+func (x *GetProductPricesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductPricesResponse.ProtoReflect.Descriptor instead.
+func (*GetProductPricesResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *GetProductPricesResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetProductPricesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetProductPricesResponse) GetData() []*ProductPrice {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type DeleteProductPriceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductPriceRequest) Reset() {
+	*x = DeleteProductPriceRequest{}
+	mi := &file_product_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductPriceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductPriceRequest) ProtoMessage() {}
+
+func (x *DeleteProductPriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductPriceRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProductPriceRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *DeleteProductPriceRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteProductPriceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductPriceResponse) Reset() {
+	*x = DeleteProductPriceResponse{}
+	mi := &file_product_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductPriceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductPriceResponse) ProtoMessage() {}
+
+func (x *DeleteProductPriceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductPriceResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProductPriceResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *DeleteProductPriceResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteProductPriceResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type Order struct {
+	state                     protoimpl.MessageState `protogen:"open.v1"`
+	Id                        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Uuid                      string                 `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	UserId                    int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TenantId                  int64                  `protobuf:"varint,4,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	CurrencyId                int64                  `protobuf:"varint,5,opt,name=currency_id,json=currencyId,proto3" json:"currency_id,omitempty"`
+	Status                    string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"` // pending, paid, cancelled, refunded
+	Subtotal                  int64                  `protobuf:"varint,7,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
+	DiscountTotal             int64                  `protobuf:"varint,8,opt,name=discount_total,json=discountTotal,proto3" json:"discount_total,omitempty"`
+	Total                     int64                  `protobuf:"varint,9,opt,name=total,proto3" json:"total,omitempty"`
+	DiscountCodeId            int64                  `protobuf:"varint,10,opt,name=discount_code_id,json=discountCodeId,proto3" json:"discount_code_id,omitempty"`
+	PaymentProviderId         int64                  `protobuf:"varint,11,opt,name=payment_provider_id,json=paymentProviderId,proto3" json:"payment_provider_id,omitempty"`
+	PaymentProviderCheckoutId string                 `protobuf:"bytes,12,opt,name=payment_provider_checkout_id,json=paymentProviderCheckoutId,proto3" json:"payment_provider_checkout_id,omitempty"`
+	CheckoutUrl               string                 `protobuf:"bytes,13,opt,name=checkout_url,json=checkoutUrl,proto3" json:"checkout_url,omitempty"`
+	PaidAt                    int64                  `protobuf:"varint,14,opt,name=paid_at,json=paidAt,proto3" json:"paid_at,omitempty"`
+	CancelledAt               int64                  `protobuf:"varint,15,opt,name=cancelled_at,json=cancelledAt,proto3" json:"cancelled_at,omitempty"`
+	RefundedAt                int64                  `protobuf:"varint,16,opt,name=refunded_at,json=refundedAt,proto3" json:"refunded_at,omitempty"`
+	Items                     []*OrderItem           `protobuf:"bytes,17,rep,name=items,proto3" json:"items,omitempty"`
+	CreatedAt                 int64                  `protobuf:"varint,18,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt                 int64                  `protobuf:"varint,19,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *Order) Reset() {
+	*x = Order{}
+	mi := &file_product_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Order) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Order) ProtoMessage() {}
+
+func (x *Order) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[140]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Order.ProtoReflect.Descriptor instead.
+func (*Order) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *Order) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Order) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *Order) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Order) GetTenantId() int64 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *Order) GetCurrencyId() int64 {
+	if x != nil {
+		return x.CurrencyId
+	}
+	return 0
+}
+
+func (x *Order) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Order) GetSubtotal() int64 {
+	if x != nil {
+		return x.Subtotal
+	}
+	return 0
+}
+
+func (x *Order) GetDiscountTotal() int64 {
+	if x != nil {
+		return x.DiscountTotal
+	}
+	return 0
+}
+
+func (x *Order) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *Order) GetDiscountCodeId() int64 {
+	if x != nil {
+		return x.DiscountCodeId
+	}
+	return 0
+}
+
+func (x *Order) GetPaymentProviderId() int64 {
+	if x != nil {
+		return x.PaymentProviderId
+	}
+	return 0
+}
+
+func (x *Order) GetPaymentProviderCheckoutId() string {
+	if x != nil {
+		return x.PaymentProviderCheckoutId
+	}
+	return ""
+}
+
+func (x *Order) GetCheckoutUrl() string {
+	if x != nil {
+		return x.CheckoutUrl
+	}
+	return ""
+}
+
+func (x *Order) GetPaidAt() int64 {
+	if x != nil {
+		return x.PaidAt
+	}
+	return 0
+}
+
+func (x *Order) GetCancelledAt() int64 {
+	if x != nil {
+		return x.CancelledAt
+	}
+	return 0
+}
+
+func (x *Order) GetRefundedAt() int64 {
+	if x != nil {
+		return x.RefundedAt
+	}
+	return 0
+}
+
+func (x *Order) GetItems() []*OrderItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Order) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Order) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type OrderItem struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderId        int64                  `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	ProductId      int64                  `protobuf:"varint,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Description    string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Quantity       int64                  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UnitAmount     int64                  `protobuf:"varint,6,opt,name=unit_amount,json=unitAmount,proto3" json:"unit_amount,omitempty"`
+	DiscountAmount int64                  `protobuf:"varint,7,opt,name=discount_amount,json=discountAmount,proto3" json:"discount_amount,omitempty"`
+	Amount         int64                  `protobuf:"varint,8,opt,name=amount,proto3" json:"amount,omitempty"` // quantity * unit_amount - discount_amount
+	CreatedAt      int64                  `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      int64                  `protobuf:"varint,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *OrderItem) Reset() {
+	*x = OrderItem{}
+	mi := &file_product_proto_msgTypes[141]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderItem) ProtoMessage() {}
+
+func (x *OrderItem) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[141]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderItem.ProtoReflect.Descriptor instead.
+func (*OrderItem) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *OrderItem) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *OrderItem) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *OrderItem) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *OrderItem) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *OrderItem) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *OrderItem) GetUnitAmount() int64 {
+	if x != nil {
+		return x.UnitAmount
+	}
+	return 0
+}
+
+func (x *OrderItem) GetDiscountAmount() int64 {
+	if x != nil {
+		return x.DiscountAmount
+	}
+	return 0
+}
+
+func (x *OrderItem) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *OrderItem) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *OrderItem) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type OrderItemInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity      int64                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderItemInput) Reset() {
+	*x = OrderItemInput{}
+	mi := &file_product_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderItemInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderItemInput) ProtoMessage() {}
+
+func (x *OrderItemInput) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[142]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderItemInput.ProtoReflect.Descriptor instead.
+func (*OrderItemInput) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *OrderItemInput) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *OrderItemInput) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type CreateOrderRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TenantId        int64                  `protobuf:"varint,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"` // optional
+	CurrencyId      int64                  `protobuf:"varint,3,opt,name=currency_id,json=currencyId,proto3" json:"currency_id,omitempty"`
+	Items           []*OrderItemInput      `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	DiscountCode    string                 `protobuf:"bytes,5,opt,name=discount_code,json=discountCode,proto3" json:"discount_code,omitempty"`          // optional
+	PaymentProvider string                 `protobuf:"bytes,6,opt,name=payment_provider,json=paymentProvider,proto3" json:"payment_provider,omitempty"` // slug, defaults to manual
+	SuccessUrl      string                 `protobuf:"bytes,7,opt,name=success_url,json=successUrl,proto3" json:"success_url,omitempty"`
+	CancelUrl       string                 `protobuf:"bytes,8,opt,name=cancel_url,json=cancelUrl,proto3" json:"cancel_url,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateOrderRequest) Reset() {
+	*x = CreateOrderRequest{}
+	mi := &file_product_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrderRequest) ProtoMessage() {}
+
+func (x *CreateOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[143]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrderRequest.ProtoReflect.Descriptor instead.
+func (*CreateOrderRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *CreateOrderRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateOrderRequest) GetTenantId() int64 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *CreateOrderRequest) GetCurrencyId() int64 {
+	if x != nil {
+		return x.CurrencyId
+	}
+	return 0
+}
+
+func (x *CreateOrderRequest) GetItems() []*OrderItemInput {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *CreateOrderRequest) GetDiscountCode() string {
+	if x != nil {
+		return x.DiscountCode
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetPaymentProvider() string {
+	if x != nil {
+		return x.PaymentProvider
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetSuccessUrl() string {
+	if x != nil {
+		return x.SuccessUrl
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetCancelUrl() string {
+	if x != nil {
+		return x.CancelUrl
+	}
+	return ""
+}
+
+type CreateOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *Order                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOrderResponse) Reset() {
+	*x = CreateOrderResponse{}
+	mi := &file_product_proto_msgTypes[144]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrderResponse) ProtoMessage() {}
+
+func (x *CreateOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[144]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrderResponse.ProtoReflect.Descriptor instead.
+func (*CreateOrderResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *CreateOrderResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CreateOrderResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreateOrderResponse) GetData() *Order {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GetOrderByIDRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderByIDRequest) Reset() {
+	*x = GetOrderByIDRequest{}
+	mi := &file_product_proto_msgTypes[145]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderByIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderByIDRequest) ProtoMessage() {}
+
+func (x *GetOrderByIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[145]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderByIDRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderByIDRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *GetOrderByIDRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetOrderByIDResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *Order                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderByIDResponse) Reset() {
+	*x = GetOrderByIDResponse{}
+	mi := &file_product_proto_msgTypes[146]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderByIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderByIDResponse) ProtoMessage() {}
+
+func (x *GetOrderByIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[146]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderByIDResponse.ProtoReflect.Descriptor instead.
+func (*GetOrderByIDResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *GetOrderByIDResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetOrderByIDResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetOrderByIDResponse) GetData() *Order {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GetOrdersByUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PerPage       int32                  `protobuf:"varint,3,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrdersByUserRequest) Reset() {
+	*x = GetOrdersByUserRequest{}
+	mi := &file_product_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrdersByUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrdersByUserRequest) ProtoMessage() {}
+
+func (x *GetOrdersByUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[147]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrdersByUserRequest.ProtoReflect.Descriptor instead.
+func (*GetOrdersByUserRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *GetOrdersByUserRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetOrdersByUserRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetOrdersByUserRequest) GetPerPage() int32 {
+	if x != nil {
+		return x.PerPage
+	}
+	return 0
+}
+
+type OrderList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Orders        []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PerPage       int32                  `protobuf:"varint,4,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderList) Reset() {
+	*x = OrderList{}
+	mi := &file_product_proto_msgTypes[148]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderList) ProtoMessage() {}
+
+func (x *OrderList) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[148]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderList.ProtoReflect.Descriptor instead.
+func (*OrderList) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *OrderList) GetOrders() []*Order {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+func (x *OrderList) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *OrderList) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *OrderList) GetPerPage() int32 {
+	if x != nil {
+		return x.PerPage
+	}
+	return 0
+}
+
+type GetOrdersByUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *OrderList             `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrdersByUserResponse) Reset() {
+	*x = GetOrdersByUserResponse{}
+	mi := &file_product_proto_msgTypes[149]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrdersByUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrdersByUserResponse) ProtoMessage() {}
+
+func (x *GetOrdersByUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[149]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrdersByUserResponse.ProtoReflect.Descriptor instead.
+func (*GetOrdersByUserResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *GetOrdersByUserResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetOrdersByUserResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetOrdersByUserResponse) GetData() *OrderList {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GetAllOrdersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // optional filter
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PerPage       int32                  `protobuf:"varint,3,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllOrdersRequest) Reset() {
+	*x = GetAllOrdersRequest{}
+	mi := &file_product_proto_msgTypes[150]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllOrdersRequest) ProtoMessage() {}
+
+func (x *GetAllOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[150]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllOrdersRequest.ProtoReflect.Descriptor instead.
+func (*GetAllOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *GetAllOrdersRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetAllOrdersRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetAllOrdersRequest) GetPerPage() int32 {
+	if x != nil {
+		return x.PerPage
+	}
+	return 0
+}
+
+type GetAllOrdersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *OrderList             `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllOrdersResponse) Reset() {
+	*x = GetAllOrdersResponse{}
+	mi := &file_product_proto_msgTypes[151]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllOrdersResponse) ProtoMessage() {}
+
+func (x *GetAllOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[151]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllOrdersResponse.ProtoReflect.Descriptor instead.
+func (*GetAllOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *GetAllOrdersResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetAllOrdersResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetAllOrdersResponse) GetData() *OrderList {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type MarkOrderPaidRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkOrderPaidRequest) Reset() {
+	*x = MarkOrderPaidRequest{}
+	mi := &file_product_proto_msgTypes[152]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkOrderPaidRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkOrderPaidRequest) ProtoMessage() {}
+
+func (x *MarkOrderPaidRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[152]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkOrderPaidRequest.ProtoReflect.Descriptor instead.
+func (*MarkOrderPaidRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *MarkOrderPaidRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type MarkOrderPaidResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *Order                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkOrderPaidResponse) Reset() {
+	*x = MarkOrderPaidResponse{}
+	mi := &file_product_proto_msgTypes[153]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkOrderPaidResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkOrderPaidResponse) ProtoMessage() {}
+
+func (x *MarkOrderPaidResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[153]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkOrderPaidResponse.ProtoReflect.Descriptor instead.
+func (*MarkOrderPaidResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *MarkOrderPaidResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *MarkOrderPaidResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *MarkOrderPaidResponse) GetData() *Order {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type CancelOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelOrderRequest) Reset() {
+	*x = CancelOrderRequest{}
+	mi := &file_product_proto_msgTypes[154]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrderRequest) ProtoMessage() {}
+
+func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[154]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrderRequest.ProtoReflect.Descriptor instead.
+func (*CancelOrderRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *CancelOrderRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type CancelOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *Order                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelOrderResponse) Reset() {
+	*x = CancelOrderResponse{}
+	mi := &file_product_proto_msgTypes[155]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrderResponse) ProtoMessage() {}
+
+func (x *CancelOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[155]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrderResponse.ProtoReflect.Descriptor instead.
+func (*CancelOrderResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *CancelOrderResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CancelOrderResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CancelOrderResponse) GetData() *Order {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type RefundOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundOrderRequest) Reset() {
+	*x = RefundOrderRequest{}
+	mi := &file_product_proto_msgTypes[156]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundOrderRequest) ProtoMessage() {}
+
+func (x *RefundOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[156]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundOrderRequest.ProtoReflect.Descriptor instead.
+func (*RefundOrderRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *RefundOrderRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RefundOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *Order                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundOrderResponse) Reset() {
+	*x = RefundOrderResponse{}
+	mi := &file_product_proto_msgTypes[157]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundOrderResponse) ProtoMessage() {}
+
+func (x *RefundOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[157]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundOrderResponse.ProtoReflect.Descriptor instead.
+func (*RefundOrderResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *RefundOrderResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RefundOrderResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RefundOrderResponse) GetData() *Order {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type Currency struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code              string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"` // ISO 4217
+	Name              string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Symbol            string                 `protobuf:"bytes,4,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	DecimalPlaces     int32                  `protobuf:"varint,5,opt,name=decimal_places,json=decimalPlaces,proto3" json:"decimal_places,omitempty"`             // minor-unit digits, 0 for IDR
+	RoundingIncrement int64                  `protobuf:"varint,6,opt,name=rounding_increment,json=roundingIncrement,proto3" json:"rounding_increment,omitempty"` // converted amounts snap to this many minor units
+	RoundingMode      string                 `protobuf:"bytes,7,opt,name=rounding_mode,json=roundingMode,proto3" json:"rounding_mode,omitempty"`                 // nearest, up, down
+	CreatedAt         int64                  `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt         int64                  `protobuf:"varint,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Currency) Reset() {
+	*x = Currency{}
+	mi := &file_product_proto_msgTypes[158]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Currency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Currency) ProtoMessage() {}
+
+func (x *Currency) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[158]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Currency.ProtoReflect.Descriptor instead.
+func (*Currency) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *Currency) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Currency) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Currency) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Currency) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *Currency) GetDecimalPlaces() int32 {
+	if x != nil {
+		return x.DecimalPlaces
+	}
+	return 0
+}
+
+func (x *Currency) GetRoundingIncrement() int64 {
+	if x != nil {
+		return x.RoundingIncrement
+	}
+	return 0
+}
+
+func (x *Currency) GetRoundingMode() string {
+	if x != nil {
+		return x.RoundingMode
+	}
+	return ""
+}
+
+func (x *Currency) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Currency) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type GetCurrenciesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCurrenciesRequest) Reset() {
+	*x = GetCurrenciesRequest{}
+	mi := &file_product_proto_msgTypes[159]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCurrenciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCurrenciesRequest) ProtoMessage() {}
+
+func (x *GetCurrenciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[159]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCurrenciesRequest.ProtoReflect.Descriptor instead.
+func (*GetCurrenciesRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{159}
+}
+
+type GetCurrenciesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          []*Currency            `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCurrenciesResponse) Reset() {
+	*x = GetCurrenciesResponse{}
+	mi := &file_product_proto_msgTypes[160]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCurrenciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCurrenciesResponse) ProtoMessage() {}
+
+func (x *GetCurrenciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[160]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCurrenciesResponse.ProtoReflect.Descriptor instead.
+func (*GetCurrenciesResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *GetCurrenciesResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetCurrenciesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetCurrenciesResponse) GetData() []*Currency {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GetCurrencyByIDRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCurrencyByIDRequest) Reset() {
+	*x = GetCurrencyByIDRequest{}
+	mi := &file_product_proto_msgTypes[161]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCurrencyByIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCurrencyByIDRequest) ProtoMessage() {}
+
+func (x *GetCurrencyByIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[161]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCurrencyByIDRequest.ProtoReflect.Descriptor instead.
+func (*GetCurrencyByIDRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{161}
+}
+
+func (x *GetCurrencyByIDRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetCurrencyByIDResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *Currency              `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCurrencyByIDResponse) Reset() {
+	*x = GetCurrencyByIDResponse{}
+	mi := &file_product_proto_msgTypes[162]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCurrencyByIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCurrencyByIDResponse) ProtoMessage() {}
+
+func (x *GetCurrencyByIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[162]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCurrencyByIDResponse.ProtoReflect.Descriptor instead.
+func (*GetCurrencyByIDResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{162}
+}
+
+func (x *GetCurrencyByIDResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetCurrencyByIDResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetCurrencyByIDResponse) GetData() *Currency {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type CreateCurrencyRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Code              string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Symbol            string                 `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	DecimalPlaces     *int32                 `protobuf:"varint,4,opt,name=decimal_places,json=decimalPlaces,proto3,oneof" json:"decimal_places,omitempty"`       // defaults to 2
+	RoundingIncrement int64                  `protobuf:"varint,5,opt,name=rounding_increment,json=roundingIncrement,proto3" json:"rounding_increment,omitempty"` // defaults to 1
+	RoundingMode      string                 `protobuf:"bytes,6,opt,name=rounding_mode,json=roundingMode,proto3" json:"rounding_mode,omitempty"`                 // defaults to nearest
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CreateCurrencyRequest) Reset() {
+	*x = CreateCurrencyRequest{}
+	mi := &file_product_proto_msgTypes[163]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCurrencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCurrencyRequest) ProtoMessage() {}
+
+func (x *CreateCurrencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[163]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCurrencyRequest.ProtoReflect.Descriptor instead.
+func (*CreateCurrencyRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{163}
+}
+
+func (x *CreateCurrencyRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreateCurrencyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCurrencyRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *CreateCurrencyRequest) GetDecimalPlaces() int32 {
+	if x != nil && x.DecimalPlaces != nil {
+		return *x.DecimalPlaces
+	}
+	return 0
+}
+
+func (x *CreateCurrencyRequest) GetRoundingIncrement() int64 {
+	if x != nil {
+		return x.RoundingIncrement
+	}
+	return 0
+}
+
+func (x *CreateCurrencyRequest) GetRoundingMode() string {
+	if x != nil {
+		return x.RoundingMode
+	}
+	return ""
+}
+
+type CreateCurrencyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *Currency              `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCurrencyResponse) Reset() {
+	*x = CreateCurrencyResponse{}
+	mi := &file_product_proto_msgTypes[164]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCurrencyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCurrencyResponse) ProtoMessage() {}
+
+func (x *CreateCurrencyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[164]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCurrencyResponse.ProtoReflect.Descriptor instead.
+func (*CreateCurrencyResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{164}
+}
+
+func (x *CreateCurrencyResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CreateCurrencyResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreateCurrencyResponse) GetData() *Currency {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type UpdateCurrencyRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name              *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Symbol            *string                `protobuf:"bytes,3,opt,name=symbol,proto3,oneof" json:"symbol,omitempty"`
+	DecimalPlaces     *int32                 `protobuf:"varint,4,opt,name=decimal_places,json=decimalPlaces,proto3,oneof" json:"decimal_places,omitempty"`
+	RoundingIncrement *int64                 `protobuf:"varint,5,opt,name=rounding_increment,json=roundingIncrement,proto3,oneof" json:"rounding_increment,omitempty"`
+	RoundingMode      *string                `protobuf:"bytes,6,opt,name=rounding_mode,json=roundingMode,proto3,oneof" json:"rounding_mode,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *UpdateCurrencyRequest) Reset() {
+	*x = UpdateCurrencyRequest{}
+	mi := &file_product_proto_msgTypes[165]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCurrencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCurrencyRequest) ProtoMessage() {}
+
+func (x *UpdateCurrencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[165]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCurrencyRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCurrencyRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{165}
+}
+
+func (x *UpdateCurrencyRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateCurrencyRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateCurrencyRequest) GetSymbol() string {
+	if x != nil && x.Symbol != nil {
+		return *x.Symbol
+	}
+	return ""
+}
+
+func (x *UpdateCurrencyRequest) GetDecimalPlaces() int32 {
+	if x != nil && x.DecimalPlaces != nil {
+		return *x.DecimalPlaces
+	}
+	return 0
+}
+
+func (x *UpdateCurrencyRequest) GetRoundingIncrement() int64 {
+	if x != nil && x.RoundingIncrement != nil {
+		return *x.RoundingIncrement
+	}
+	return 0
+}
+
+func (x *UpdateCurrencyRequest) GetRoundingMode() string {
+	if x != nil && x.RoundingMode != nil {
+		return *x.RoundingMode
+	}
+	return ""
+}
+
+type UpdateCurrencyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *Currency              `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCurrencyResponse) Reset() {
+	*x = UpdateCurrencyResponse{}
+	mi := &file_product_proto_msgTypes[166]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCurrencyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCurrencyResponse) ProtoMessage() {}
+
+func (x *UpdateCurrencyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[166]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCurrencyResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCurrencyResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{166}
+}
+
+func (x *UpdateCurrencyResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UpdateCurrencyResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UpdateCurrencyResponse) GetData() *Currency {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type DeleteCurrencyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCurrencyRequest) Reset() {
+	*x = DeleteCurrencyRequest{}
+	mi := &file_product_proto_msgTypes[167]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCurrencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCurrencyRequest) ProtoMessage() {}
+
+func (x *DeleteCurrencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[167]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCurrencyRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCurrencyRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{167}
+}
+
+func (x *DeleteCurrencyRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteCurrencyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCurrencyResponse) Reset() {
+	*x = DeleteCurrencyResponse{}
+	mi := &file_product_proto_msgTypes[168]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCurrencyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCurrencyResponse) ProtoMessage() {}
+
+func (x *DeleteCurrencyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[168]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCurrencyResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCurrencyResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{168}
+}
+
+func (x *DeleteCurrencyResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteCurrencyResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 1 unit of the base currency is worth rate units of the quote currency
+type ExchangeRate struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	BaseCurrencyId  int64                  `protobuf:"varint,2,opt,name=base_currency_id,json=baseCurrencyId,proto3" json:"base_currency_id,omitempty"`
+	QuoteCurrencyId int64                  `protobuf:"varint,3,opt,name=quote_currency_id,json=quoteCurrencyId,proto3" json:"quote_currency_id,omitempty"`
+	Rate            string                 `protobuf:"bytes,4,opt,name=rate,proto3" json:"rate,omitempty"`     // decimal string, up to 10 decimals
+	Source          string                 `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"` // manual, import
+	EffectiveAt     int64                  `protobuf:"varint,6,opt,name=effective_at,json=effectiveAt,proto3" json:"effective_at,omitempty"`
+	CreatedAt       int64                  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       int64                  `protobuf:"varint,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ExchangeRate) Reset() {
+	*x = ExchangeRate{}
+	mi := &file_product_proto_msgTypes[169]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExchangeRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExchangeRate) ProtoMessage() {}
+
+func (x *ExchangeRate) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[169]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExchangeRate.ProtoReflect.Descriptor instead.
+func (*ExchangeRate) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{169}
+}
+
+func (x *ExchangeRate) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ExchangeRate) GetBaseCurrencyId() int64 {
+	if x != nil {
+		return x.BaseCurrencyId
+	}
+	return 0
+}
+
+func (x *ExchangeRate) GetQuoteCurrencyId() int64 {
+	if x != nil {
+		return x.QuoteCurrencyId
+	}
+	return 0
+}
+
+func (x *ExchangeRate) GetRate() string {
+	if x != nil {
+		return x.Rate
+	}
+	return ""
+}
+
+func (x *ExchangeRate) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ExchangeRate) GetEffectiveAt() int64 {
+	if x != nil {
+		return x.EffectiveAt
+	}
+	return 0
+}
+
+func (x *ExchangeRate) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *ExchangeRate) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type GetExchangeRatesRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	BaseCurrencyId  int64                  `protobuf:"varint,1,opt,name=base_currency_id,json=baseCurrencyId,proto3" json:"base_currency_id,omitempty"`    // 0 for all
+	QuoteCurrencyId int64                  `protobuf:"varint,2,opt,name=quote_currency_id,json=quoteCurrencyId,proto3" json:"quote_currency_id,omitempty"` // 0 for all
+	Page            int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PerPage         int32                  `protobuf:"varint,4,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetExchangeRatesRequest) Reset() {
+	*x = GetExchangeRatesRequest{}
+	mi := &file_product_proto_msgTypes[170]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExchangeRatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExchangeRatesRequest) ProtoMessage() {}
+
+func (x *GetExchangeRatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[170]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExchangeRatesRequest.ProtoReflect.Descriptor instead.
+func (*GetExchangeRatesRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{170}
+}
+
+func (x *GetExchangeRatesRequest) GetBaseCurrencyId() int64 {
+	if x != nil {
+		return x.BaseCurrencyId
+	}
+	return 0
+}
+
+func (x *GetExchangeRatesRequest) GetQuoteCurrencyId() int64 {
+	if x != nil {
+		return x.QuoteCurrencyId
+	}
+	return 0
+}
+
+func (x *GetExchangeRatesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetExchangeRatesRequest) GetPerPage() int32 {
+	if x != nil {
+		return x.PerPage
+	}
+	return 0
+}
+
+type GetExchangeRatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          []*ExchangeRate        `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	Total         int32                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+	PerPage       int32                  `protobuf:"varint,6,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExchangeRatesResponse) Reset() {
+	*x = GetExchangeRatesResponse{}
+	mi := &file_product_proto_msgTypes[171]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExchangeRatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExchangeRatesResponse) ProtoMessage() {}
+
+func (x *GetExchangeRatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[171]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExchangeRatesResponse.ProtoReflect.Descriptor instead.
+func (*GetExchangeRatesResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{171}
+}
+
+func (x *GetExchangeRatesResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetExchangeRatesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetExchangeRatesResponse) GetData() []*ExchangeRate {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *GetExchangeRatesResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetExchangeRatesResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetExchangeRatesResponse) GetPerPage() int32 {
+	if x != nil {
+		return x.PerPage
+	}
+	return 0
+}
+
+type SetExchangeRateRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	BaseCurrencyId  int64                  `protobuf:"varint,1,opt,name=base_currency_id,json=baseCurrencyId,proto3" json:"base_currency_id,omitempty"`
+	QuoteCurrencyId int64                  `protobuf:"varint,2,opt,name=quote_currency_id,json=quoteCurrencyId,proto3" json:"quote_currency_id,omitempty"`
+	Rate            string                 `protobuf:"bytes,3,opt,name=rate,proto3" json:"rate,omitempty"`
+	EffectiveAt     int64                  `protobuf:"varint,4,opt,name=effective_at,json=effectiveAt,proto3" json:"effective_at,omitempty"` // 0 for now
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SetExchangeRateRequest) Reset() {
+	*x = SetExchangeRateRequest{}
+	mi := &file_product_proto_msgTypes[172]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetExchangeRateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetExchangeRateRequest) ProtoMessage() {}
+
+func (x *SetExchangeRateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[172]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetExchangeRateRequest.ProtoReflect.Descriptor instead.
+func (*SetExchangeRateRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{172}
+}
+
+func (x *SetExchangeRateRequest) GetBaseCurrencyId() int64 {
+	if x != nil {
+		return x.BaseCurrencyId
+	}
+	return 0
+}
+
+func (x *SetExchangeRateRequest) GetQuoteCurrencyId() int64 {
+	if x != nil {
+		return x.QuoteCurrencyId
+	}
+	return 0
+}
+
+func (x *SetExchangeRateRequest) GetRate() string {
+	if x != nil {
+		return x.Rate
+	}
+	return ""
+}
+
+func (x *SetExchangeRateRequest) GetEffectiveAt() int64 {
+	if x != nil {
+		return x.EffectiveAt
+	}
+	return 0
+}
+
+type SetExchangeRateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *ExchangeRate          `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetExchangeRateResponse) Reset() {
+	*x = SetExchangeRateResponse{}
+	mi := &file_product_proto_msgTypes[173]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetExchangeRateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetExchangeRateResponse) ProtoMessage() {}
+
+func (x *SetExchangeRateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[173]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetExchangeRateResponse.ProtoReflect.Descriptor instead.
+func (*SetExchangeRateResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{173}
+}
+
+func (x *SetExchangeRateResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SetExchangeRateResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SetExchangeRateResponse) GetData() *ExchangeRate {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ImportExchangeRatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Content       []byte                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"` // CSV: base,quote,rate[,effective_at] with ISO 4217 codes
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportExchangeRatesRequest) Reset() {
+	*x = ImportExchangeRatesRequest{}
+	mi := &file_product_proto_msgTypes[174]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportExchangeRatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportExchangeRatesRequest) ProtoMessage() {}
+
+func (x *ImportExchangeRatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[174]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportExchangeRatesRequest.ProtoReflect.Descriptor instead.
+func (*ImportExchangeRatesRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{174}
+}
+
+func (x *ImportExchangeRatesRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type ImportExchangeRatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          []*ExchangeRate        `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportExchangeRatesResponse) Reset() {
+	*x = ImportExchangeRatesResponse{}
+	mi := &file_product_proto_msgTypes[175]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportExchangeRatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportExchangeRatesResponse) ProtoMessage() {}
+
+func (x *ImportExchangeRatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[175]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportExchangeRatesResponse.ProtoReflect.Descriptor instead.
+func (*ImportExchangeRatesResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{175}
+}
+
+func (x *ImportExchangeRatesResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ImportExchangeRatesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ImportExchangeRatesResponse) GetData() []*ExchangeRate {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type DeleteExchangeRateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteExchangeRateRequest) Reset() {
+	*x = DeleteExchangeRateRequest{}
+	mi := &file_product_proto_msgTypes[176]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteExchangeRateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteExchangeRateRequest) ProtoMessage() {}
+
+func (x *DeleteExchangeRateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[176]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteExchangeRateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteExchangeRateRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{176}
+}
+
+func (x *DeleteExchangeRateRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteExchangeRateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteExchangeRateResponse) Reset() {
+	*x = DeleteExchangeRateResponse{}
+	mi := &file_product_proto_msgTypes[177]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteExchangeRateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteExchangeRateResponse) ProtoMessage() {}
+
+func (x *DeleteExchangeRateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[177]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteExchangeRateResponse.ProtoReflect.Descriptor instead.
+func (*DeleteExchangeRateResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{177}
+}
+
+func (x *DeleteExchangeRateResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteExchangeRateResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type DerivePlanPricesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlanId        int64                  `protobuf:"varint,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DerivePlanPricesRequest) Reset() {
+	*x = DerivePlanPricesRequest{}
+	mi := &file_product_proto_msgTypes[178]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DerivePlanPricesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DerivePlanPricesRequest) ProtoMessage() {}
+
+func (x *DerivePlanPricesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[178]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DerivePlanPricesRequest.ProtoReflect.Descriptor instead.
+func (*DerivePlanPricesRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{178}
+}
+
+func (x *DerivePlanPricesRequest) GetPlanId() int64 {
+	if x != nil {
+		return x.PlanId
+	}
+	return 0
+}
+
+type DerivePlanPricesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	BasePrice     *PlanPrice             `protobuf:"bytes,3,opt,name=base_price,json=basePrice,proto3" json:"base_price,omitempty"`
+	Derived       []*PlanPrice           `protobuf:"bytes,4,rep,name=derived,proto3" json:"derived,omitempty"`
+	Skipped       []string               `protobuf:"bytes,5,rep,name=skipped,proto3" json:"skipped,omitempty"` // currency codes with a manual price or no rate
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DerivePlanPricesResponse) Reset() {
+	*x = DerivePlanPricesResponse{}
+	mi := &file_product_proto_msgTypes[179]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DerivePlanPricesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DerivePlanPricesResponse) ProtoMessage() {}
+
+func (x *DerivePlanPricesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[179]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DerivePlanPricesResponse.ProtoReflect.Descriptor instead.
+func (*DerivePlanPricesResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{179}
+}
+
+func (x *DerivePlanPricesResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DerivePlanPricesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DerivePlanPricesResponse) GetBasePrice() *PlanPrice {
+	if x != nil {
+		return x.BasePrice
+	}
+	return nil
+}
+
+func (x *DerivePlanPricesResponse) GetDerived() []*PlanPrice {
+	if x != nil {
+		return x.Derived
+	}
+	return nil
+}
+
+func (x *DerivePlanPricesResponse) GetSkipped() []string {
+	if x != nil {
+		return x.Skipped
+	}
+	return nil
+}
+
+type PlanEntitlement struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PlanId        int64                  `protobuf:"varint,2,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	FeatureKey    string                 `protobuf:"bytes,3,opt,name=feature_key,json=featureKey,proto3" json:"feature_key,omitempty"`
+	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"` // boolean, limit, quota
+	IsEnabled     bool                   `protobuf:"varint,5,opt,name=is_enabled,json=isEnabled,proto3" json:"is_enabled,omitempty"`
+	LimitValue    *int64                 `protobuf:"varint,6,opt,name=limit_value,json=limitValue,proto3,oneof" json:"limit_value,omitempty"` // limit and quota; unset is unlimited
+	MeterId       *int64                 `protobuf:"varint,7,opt,name=meter_id,json=meterId,proto3,oneof" json:"meter_id,omitempty"`          // quota; unset uses the plan's meter
+	CreatedAt     int64                  `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     int64                  `protobuf:"varint,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanEntitlement) Reset() {
+	*x = PlanEntitlement{}
+	mi := &file_product_proto_msgTypes[180]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanEntitlement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanEntitlement) ProtoMessage() {}
+
+func (x *PlanEntitlement) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[180]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanEntitlement.ProtoReflect.Descriptor instead.
+func (*PlanEntitlement) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{180}
+}
+
+func (x *PlanEntitlement) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PlanEntitlement) GetPlanId() int64 {
+	if x != nil {
+		return x.PlanId
+	}
+	return 0
+}
+
+func (x *PlanEntitlement) GetFeatureKey() string {
+	if x != nil {
+		return x.FeatureKey
+	}
+	return ""
+}
+
+func (x *PlanEntitlement) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PlanEntitlement) GetIsEnabled() bool {
+	if x != nil {
+		return x.IsEnabled
+	}
+	return false
+}
+
+func (x *PlanEntitlement) GetLimitValue() int64 {
+	if x != nil && x.LimitValue != nil {
+		return *x.LimitValue
+	}
+	return 0
+}
+
+func (x *PlanEntitlement) GetMeterId() int64 {
+	if x != nil && x.MeterId != nil {
+		return *x.MeterId
+	}
+	return 0
+}
+
+func (x *PlanEntitlement) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *PlanEntitlement) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type GetPlanEntitlementsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlanId        int64                  `protobuf:"varint,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPlanEntitlementsRequest) Reset() {
+	*x = GetPlanEntitlementsRequest{}
+	mi := &file_product_proto_msgTypes[181]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlanEntitlementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlanEntitlementsRequest) ProtoMessage() {}
+
+func (x *GetPlanEntitlementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[181]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlanEntitlementsRequest.ProtoReflect.Descriptor instead.
+func (*GetPlanEntitlementsRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{181}
+}
+
+func (x *GetPlanEntitlementsRequest) GetPlanId() int64 {
+	if x != nil {
+		return x.PlanId
+	}
+	return 0
+}
+
+type GetPlanEntitlementsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          []*PlanEntitlement     `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPlanEntitlementsResponse) Reset() {
+	*x = GetPlanEntitlementsResponse{}
+	mi := &file_product_proto_msgTypes[182]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlanEntitlementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlanEntitlementsResponse) ProtoMessage() {}
+
+func (x *GetPlanEntitlementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[182]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlanEntitlementsResponse.ProtoReflect.Descriptor instead.
+func (*GetPlanEntitlementsResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{182}
+}
+
+func (x *GetPlanEntitlementsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetPlanEntitlementsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetPlanEntitlementsResponse) GetData() []*PlanEntitlement {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type SetPlanEntitlementRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlanId        int64                  `protobuf:"varint,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	FeatureKey    string                 `protobuf:"bytes,2,opt,name=feature_key,json=featureKey,proto3" json:"feature_key,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	IsEnabled     bool                   `protobuf:"varint,4,opt,name=is_enabled,json=isEnabled,proto3" json:"is_enabled,omitempty"`
+	LimitValue    *int64                 `protobuf:"varint,5,opt,name=limit_value,json=limitValue,proto3,oneof" json:"limit_value,omitempty"`
+	MeterId       *int64                 `protobuf:"varint,6,opt,name=meter_id,json=meterId,proto3,oneof" json:"meter_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPlanEntitlementRequest) Reset() {
+	*x = SetPlanEntitlementRequest{}
+	mi := &file_product_proto_msgTypes[183]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRedemptionsByDiscountCodeData) String() string {
+func (x *SetPlanEntitlementRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRedemptionsByDiscountCodeData) ProtoMessage() {}
+func (*SetPlanEntitlementRequest) ProtoMessage() {}
 
-func (x *GetRedemptionsByDiscountCodeData) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[108]
+func (x *SetPlanEntitlementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[183]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7152,63 +12232,77 @@ func (x *GetRedemptionsByDiscountCodeData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRedemptionsByDiscountCodeData.ProtoReflect.Descriptor instead.
-func (*GetRedemptionsByDiscountCodeData) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{108}
+// Deprecated: Use SetPlanEntitlementRequest.ProtoReflect.Descriptor instead.
+func (*SetPlanEntitlementRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{183}
 }
 
-func (x *GetRedemptionsByDiscountCodeData) GetRedemptions() []*DiscountCodeRedemption {
+func (x *SetPlanEntitlementRequest) GetPlanId() int64 {
 	if x != nil {
-		return x.Redemptions
+		return x.PlanId
 	}
-	return nil
+	return 0
 }
 
-func (x *GetRedemptionsByDiscountCodeData) GetTotal() int32 {
+func (x *SetPlanEntitlementRequest) GetFeatureKey() string {
 	if x != nil {
-		return x.Total
+		return x.FeatureKey
 	}
-	return 0
+	return ""
 }
 
-func (x *GetRedemptionsByDiscountCodeData) GetPage() int32 {
+func (x *SetPlanEntitlementRequest) GetType() string {
 	if x != nil {
-		return x.Page
+		return x.Type
 	}
-	return 0
+	return ""
 }
 
-func (x *GetRedemptionsByDiscountCodeData) GetPerPage() int32 {
+func (x *SetPlanEntitlementRequest) GetIsEnabled() bool {
 	if x != nil {
-		return x.PerPage
+		return x.IsEnabled
+	}
+	return false
+}
+
+func (x *SetPlanEntitlementRequest) GetLimitValue() int64 {
+	if x != nil && x.LimitValue != nil {
+		return *x.LimitValue
 	}
 	return 0
 }
 
-type GetRedemptionsByDiscountCodeResponse struct {
-	state         protoimpl.MessageState            `protogen:"open.v1"`
-	Success       bool                              `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                            `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Data          *GetRedemptionsByDiscountCodeData `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+func (x *SetPlanEntitlementRequest) GetMeterId() int64 {
+	if x != nil && x.MeterId != nil {
+		return *x.MeterId
+	}
+	return 0
+}
+
+type SetPlanEntitlementResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *PlanEntitlement       `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRedemptionsByDiscountCodeResponse) Reset() {
-	*x = GetRedemptionsByDiscountCodeResponse{}
-	mi := &file_product_proto_msgTypes[109]
+func (x *SetPlanEntitlementResponse) Reset() {
+	*x = SetPlanEntitlementResponse{}
+	mi := &file_product_proto_msgTypes[184]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRedemptionsByDiscountCodeResponse) String() string {
+func (x *SetPlanEntitlementResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRedemptionsByDiscountCodeResponse) ProtoMessage() {}
+func (*SetPlanEntitlementResponse) ProtoMessage() {}
 
-func (x *GetRedemptionsByDiscountCodeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[109]
+func (x *SetPlanEntitlementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[184]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7219,57 +12313,54 @@ func (x *GetRedemptionsByDiscountCodeResponse) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRedemptionsByDiscountCodeResponse.ProtoReflect.Descriptor instead.
-func (*GetRedemptionsByDiscountCodeResponse) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{109}
+// Deprecated: Use SetPlanEntitlementResponse.ProtoReflect.Descriptor instead.
+func (*SetPlanEntitlementResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{184}
 }
 
-func (x *GetRedemptionsByDiscountCodeResponse) GetSuccess() bool {
+func (x *SetPlanEntitlementResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *GetRedemptionsByDiscountCodeResponse) GetMessage() string {
+func (x *SetPlanEntitlementResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *GetRedemptionsByDiscountCodeResponse) GetData() *GetRedemptionsByDiscountCodeData {
+func (x *SetPlanEntitlementResponse) GetData() *PlanEntitlement {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-type ValidateDiscountCodeRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Code             string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
-	UserId           int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	PlanId           int64                  `protobuf:"varint,3,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`                                   // optional
-	OneTimeProductId int64                  `protobuf:"varint,4,opt,name=one_time_product_id,json=oneTimeProductId,proto3" json:"one_time_product_id,omitempty"` // optional
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type DeletePlanEntitlementRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ValidateDiscountCodeRequest) Reset() {
-	*x = ValidateDiscountCodeRequest{}
-	mi := &file_product_proto_msgTypes[110]
+func (x *DeletePlanEntitlementRequest) Reset() {
+	*x = DeletePlanEntitlementRequest{}
+	mi := &file_product_proto_msgTypes[185]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ValidateDiscountCodeRequest) String() string {
+func (x *DeletePlanEntitlementRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidateDiscountCodeRequest) ProtoMessage() {}
+func (*DeletePlanEntitlementRequest) ProtoMessage() {}
 
-func (x *ValidateDiscountCodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[110]
+func (x *DeletePlanEntitlementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[185]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7280,65 +12371,41 @@ func (x *ValidateDiscountCodeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ValidateDiscountCodeRequest.ProtoReflect.Descriptor instead.
-func (*ValidateDiscountCodeRequest) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{110}
-}
-
-func (x *ValidateDiscountCodeRequest) GetCode() string {
-	if x != nil {
-		return x.Code
-	}
-	return ""
-}
-
-func (x *ValidateDiscountCodeRequest) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
-}
-
-func (x *ValidateDiscountCodeRequest) GetPlanId() int64 {
-	if x != nil {
-		return x.PlanId
-	}
-	return 0
+// Deprecated: Use DeletePlanEntitlementRequest.ProtoReflect.Descriptor instead.
+func (*DeletePlanEntitlementRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{185}
 }
 
-func (x *ValidateDiscountCodeRequest) GetOneTimeProductId() int64 {
+func (x *DeletePlanEntitlementRequest) GetId() int64 {
 	if x != nil {
-		return x.OneTimeProductId
+		return x.Id
 	}
 	return 0
 }
 
-type ValidateDiscountCodeResponse struct {
+type DeletePlanEntitlementResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	IsValid       bool                   `protobuf:"varint,3,opt,name=is_valid,json=isValid,proto3" json:"is_valid,omitempty"`
-	Discount      *Discount              `protobuf:"bytes,4,opt,name=discount,proto3" json:"discount,omitempty"`
-	ErrorReason   string                 `protobuf:"bytes,5,opt,name=error_reason,json=errorReason,proto3" json:"error_reason,omitempty"` // expired, max_redemptions_reached, not_active, etc.
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ValidateDiscountCodeResponse) Reset() {
-	*x = ValidateDiscountCodeResponse{}
-	mi := &file_product_proto_msgTypes[111]
+func (x *DeletePlanEntitlementResponse) Reset() {
+	*x = DeletePlanEntitlementResponse{}
+	mi := &file_product_proto_msgTypes[186]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ValidateDiscountCodeResponse) String() string {
+func (x *DeletePlanEntitlementResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidateDiscountCodeResponse) ProtoMessage() {}
+func (*DeletePlanEntitlementResponse) ProtoMessage() {}
 
-func (x *ValidateDiscountCodeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[111]
+func (x *DeletePlanEntitlementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[186]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7349,46 +12416,25 @@ func (x *ValidateDiscountCodeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ValidateDiscountCodeResponse.ProtoReflect.Descriptor instead.
-func (*ValidateDiscountCodeResponse) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{111}
+// Deprecated: Use DeletePlanEntitlementResponse.ProtoReflect.Descriptor instead.
+func (*DeletePlanEntitlementResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{186}
 }
 
-func (x *ValidateDiscountCodeResponse) GetSuccess() bool {
+func (x *DeletePlanEntitlementResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *ValidateDiscountCodeResponse) GetMessage() string {
+func (x *DeletePlanEntitlementResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *ValidateDiscountCodeResponse) GetIsValid() bool {
-	if x != nil {
-		return x.IsValid
-	}
-	return false
-}
-
-func (x *ValidateDiscountCodeResponse) GetDiscount() *Discount {
-	if x != nil {
-		return x.Discount
-	}
-	return nil
-}
-
-func (x *ValidateDiscountCodeResponse) GetErrorReason() string {
-	if x != nil {
-		return x.ErrorReason
-	}
-	return ""
-}
-
 var File_product_proto protoreflect.FileDescriptor
 
 const file_product_proto_rawDesc = "" +
@@ -7590,7 +12636,7 @@ const file_product_proto_rawDesc = "" +
 	"\x13GetAllPlansResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12,\n" +
-	"\x04data\x18\x03 \x01(\v2\x18.product.GetAllPlansDataR\x04data\"\xf9\x01\n" +
+	"\x04data\x18\x03 \x01(\v2\x18.product.GetAllPlansDataR\x04data\"\xac\x02\n" +
 	"\tPlanPrice\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
 	"\aplan_id\x18\x02 \x01(\x03R\x06planId\x12\x1f\n" +
@@ -7603,7 +12649,9 @@ const file_product_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\b \x01(\x03R\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\t \x01(\x03R\tupdatedAt\"%\n" +
+	"updated_at\x18\t \x01(\x03R\tupdatedAt\x121\n" +
+	"\x15derived_from_price_id\x18\n" +
+	" \x01(\x03R\x12derivedFromPriceId\"%\n" +
 	"\x13GetPlanPriceRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\"r\n" +
 	"\x14GetPlanPriceResponse\x12\x18\n" +
@@ -7642,14 +12690,40 @@ const file_product_proto_rawDesc = "" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\"M\n" +
 	"\x17DeletePlanPriceResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"m\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"S\n" +
+	"\x11QuotePriceRequest\x12\"\n" +
+	"\rplan_price_id\x18\x01 \x01(\x03R\vplanPriceId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity\"\x96\x01\n" +
+	"\x0ePriceQuoteLine\x12\x12\n" +
+	"\x04tier\x18\x01 \x01(\x05R\x04tier\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity\x12\x1d\n" +
+	"\n" +
+	"unit_price\x18\x03 \x01(\x03R\tunitPrice\x12\x1d\n" +
+	"\n" +
+	"flat_price\x18\x04 \x01(\x03R\tflatPrice\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x03R\x06amount\"\xe5\x01\n" +
+	"\n" +
+	"PriceQuote\x12\"\n" +
+	"\rplan_price_id\x18\x01 \x01(\x03R\vplanPriceId\x12\x1f\n" +
+	"\vcurrency_id\x18\x02 \x01(\x03R\n" +
+	"currencyId\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x1b\n" +
+	"\ttier_mode\x18\x04 \x01(\tR\btierMode\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x03R\bquantity\x12\x16\n" +
+	"\x06amount\x18\x06 \x01(\x03R\x06amount\x12-\n" +
+	"\x05lines\x18\a \x03(\v2\x17.product.PriceQuoteLineR\x05lines\"q\n" +
+	"\x12QuotePriceResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12'\n" +
+	"\x04data\x18\x03 \x01(\v2\x13.product.PriceQuoteR\x04data\"\x8f\x01\n" +
 	"\tPlanMeter\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
 	"\n" +
 	"created_at\x18\x03 \x01(\x03R\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\x04 \x01(\x03R\tupdatedAt\")\n" +
+	"updated_at\x18\x04 \x01(\x03R\tupdatedAt\x12 \n" +
+	"\vaggregation\x18\x05 \x01(\tR\vaggregation\")\n" +
 	"\x17GetPlanMeterByIDRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\"v\n" +
 	"\x18GetPlanMeterByIDResponse\x12\x18\n" +
@@ -7674,16 +12748,18 @@ const file_product_proto_rawDesc = "" +
 	"\x18GetAllPlanMetersResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x121\n" +
-	"\x04data\x18\x03 \x01(\v2\x1d.product.GetAllPlanMetersDataR\x04data\",\n" +
+	"\x04data\x18\x03 \x01(\v2\x1d.product.GetAllPlanMetersDataR\x04data\"N\n" +
 	"\x16CreatePlanMeterRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\"u\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vaggregation\x18\x02 \x01(\tR\vaggregation\"u\n" +
 	"\x17CreatePlanMeterResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12&\n" +
-	"\x04data\x18\x03 \x01(\v2\x12.product.PlanMeterR\x04data\"<\n" +
+	"\x04data\x18\x03 \x01(\v2\x12.product.PlanMeterR\x04data\"^\n" +
 	"\x16UpdatePlanMeterRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\"u\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vaggregation\x18\x03 \x01(\tR\vaggregation\"u\n" +
 	"\x17UpdatePlanMeterResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12&\n" +
@@ -7835,7 +12911,7 @@ const file_product_proto_rawDesc = "" +
 	"\x17GetAllDiscountsResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x120\n" +
-	"\x04data\x18\x03 \x01(\v2\x1c.product.GetAllDiscountsDataR\x04data\"\x91\x01\n" +
+	"\x04data\x18\x03 \x01(\v2\x1c.product.GetAllDiscountsDataR\x04data\"\xbb\x01\n" +
 	"\fDiscountCode\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04code\x18\x02 \x01(\tR\x04code\x12\x1f\n" +
@@ -7844,7 +12920,8 @@ const file_product_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x04 \x01(\x03R\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\x05 \x01(\x03R\tupdatedAt\",\n" +
+	"updated_at\x18\x05 \x01(\x03R\tupdatedAt\x12(\n" +
+	"\x10referrer_user_id\x18\x06 \x01(\x03R\x0ereferrerUserId\",\n" +
 	"\x1aGetDiscountCodeByIDRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\"|\n" +
 	"\x1bGetDiscountCodeByIDResponse\x12\x18\n" +
@@ -7876,7 +12953,29 @@ const file_product_proto_rawDesc = "" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\"P\n" +
 	"\x1aDeleteDiscountCodeResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\x96\x01\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xce\x01\n" +
+	"\x1cGenerateDiscountCodesRequest\x12\x1f\n" +
+	"\vdiscount_id\x18\x01 \x01(\x03R\n" +
+	"discountId\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\x12\x16\n" +
+	"\x06prefix\x18\x03 \x01(\tR\x06prefix\x12\x16\n" +
+	"\x06length\x18\x04 \x01(\x05R\x06length\x12\x1a\n" +
+	"\balphabet\x18\x05 \x01(\tR\balphabet\x12\x1e\n" +
+	"\bmax_uses\x18\x06 \x01(\x05H\x00R\amaxUses\x88\x01\x01B\v\n" +
+	"\t_max_uses\"\x9c\x01\n" +
+	"\x1dGenerateDiscountCodesResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12)\n" +
+	"\x04data\x18\x03 \x03(\v2\x15.product.DiscountCodeR\x04data\x12\x1c\n" +
+	"\tgenerated\x18\x04 \x01(\x05R\tgenerated\"=\n" +
+	"\x1aExportDiscountCodesRequest\x12\x1f\n" +
+	"\vdiscount_id\x18\x01 \x01(\x03R\n" +
+	"discountId\"y\n" +
+	"\x1bExportDiscountCodesResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x10\n" +
+	"\x03csv\x18\x03 \x01(\tR\x03csv\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\x05R\x05total\"\x96\x01\n" +
 	"\fDiscountPlan\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
 	"\vdiscount_id\x18\x02 \x01(\x03R\n" +
@@ -7977,7 +13076,7 @@ const file_product_proto_rawDesc = "" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\"_\n" +
 	")DeleteDiscountPaymentProviderDataResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\xed\x01\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x8e\x02\n" +
 	"\x16DiscountCodeRedemption\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12(\n" +
 	"\x10discount_code_id\x18\x02 \x01(\x03R\x0ediscountCodeId\x12\x17\n" +
@@ -7987,7 +13086,9 @@ const file_product_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x06 \x01(\x03R\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\a \x01(\x03R\tupdatedAt\"\x8c\x01\n" +
+	"updated_at\x18\a \x01(\x03R\tupdatedAt\x12\x1f\n" +
+	"\vredeemed_at\x18\b \x01(\x03R\n" +
+	"redeemedAt\"\x8c\x01\n" +
 	"\x19RedeemDiscountCodeRequest\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\tR\x04code\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12'\n" +
@@ -8033,7 +13134,381 @@ const file_product_proto_rawDesc = "" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x19\n" +
 	"\bis_valid\x18\x03 \x01(\bR\aisValid\x12-\n" +
 	"\bdiscount\x18\x04 \x01(\v2\x11.product.DiscountR\bdiscount\x12!\n" +
-	"\ferror_reason\x18\x05 \x01(\tR\verrorReason2\xd1$\n" +
+	"\ferror_reason\x18\x05 \x01(\tR\verrorReason\"}\n" +
+	"\x16PreviewCheckoutRequest\x12\"\n" +
+	"\rplan_price_id\x18\x01 \x01(\x03R\vplanPriceId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity\x12#\n" +
+	"\rdiscount_code\x18\x03 \x01(\tR\fdiscountCode\"\x99\x01\n" +
+	"\fCheckoutLine\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x03R\bquantity\x12\x1f\n" +
+	"\vunit_amount\x18\x04 \x01(\x03R\n" +
+	"unitAmount\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x03R\x06amount\"\xdd\x04\n" +
+	"\x0fCheckoutPreview\x12\x17\n" +
+	"\aplan_id\x18\x01 \x01(\x03R\x06planId\x12\"\n" +
+	"\rplan_price_id\x18\x02 \x01(\x03R\vplanPriceId\x12\x1f\n" +
+	"\vcurrency_id\x18\x03 \x01(\x03R\n" +
+	"currencyId\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x03R\bquantity\x12+\n" +
+	"\x05lines\x18\x05 \x03(\v2\x15.product.CheckoutLineR\x05lines\x12\x1a\n" +
+	"\bsubtotal\x18\x06 \x01(\x03R\bsubtotal\x12'\n" +
+	"\x0fdiscount_amount\x18\a \x01(\x03R\x0ediscountAmount\x12\x14\n" +
+	"\x05total\x18\b \x01(\x03R\x05total\x12'\n" +
+	"\x0frecurring_total\x18\t \x01(\x03R\x0erecurringTotal\x12-\n" +
+	"\bdiscount\x18\n" +
+	" \x01(\v2\x11.product.DiscountR\bdiscount\x12:\n" +
+	"\rdiscount_code\x18\v \x01(\v2\x15.product.DiscountCodeR\fdiscountCode\x12'\n" +
+	"\x0fdiscount_cycles\x18\f \x01(\x05R\x0ediscountCycles\x12)\n" +
+	"\x10discount_forever\x18\r \x01(\bR\x0fdiscountForever\x12\x1d\n" +
+	"\n" +
+	"trial_days\x18\x0e \x01(\x05R\ttrialDays\x12\x1d\n" +
+	"\n" +
+	"bonus_days\x18\x0f \x01(\x05R\tbonusDays\x12\"\n" +
+	"\rtrial_ends_at\x18\x10 \x01(\x03R\vtrialEndsAt\"{\n" +
+	"\x17PreviewCheckoutResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12,\n" +
+	"\x04data\x18\x03 \x01(\v2\x18.product.CheckoutPreviewR\x04data\"\xa5\x03\n" +
+	"\bReferral\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12(\n" +
+	"\x10discount_code_id\x18\x02 \x01(\x03R\x0ediscountCodeId\x12=\n" +
+	"\x1bdiscount_code_redemption_id\x18\x03 \x01(\x03R\x18discountCodeRedemptionId\x12(\n" +
+	"\x10referrer_user_id\x18\x04 \x01(\x03R\x0ereferrerUserId\x12(\n" +
+	"\x10referred_user_id\x18\x05 \x01(\x03R\x0ereferredUserId\x12'\n" +
+	"\x0fsubscription_id\x18\x06 \x01(\x03R\x0esubscriptionId\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12*\n" +
+	"\x11reward_bonus_days\x18\b \x01(\x05R\x0frewardBonusDays\x12!\n" +
+	"\fconverted_at\x18\t \x01(\x03R\vconvertedAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\x03R\tupdatedAt\"1\n" +
+	"\x16GetReferralCodeRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"x\n" +
+	"\x17GetReferralCodeResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12)\n" +
+	"\x04data\x18\x03 \x01(\v2\x15.product.DiscountCodeR\x04data\"\x97\x02\n" +
+	"\rReferralStats\x12(\n" +
+	"\x10referrer_user_id\x18\x01 \x01(\x03R\x0ereferrerUserId\x12)\n" +
+	"\x04code\x18\x02 \x01(\v2\x15.product.DiscountCodeR\x04code\x12'\n" +
+	"\x0ftotal_referrals\x18\x03 \x01(\x05R\x0etotalReferrals\x12+\n" +
+	"\x11pending_referrals\x18\x04 \x01(\x05R\x10pendingReferrals\x12/\n" +
+	"\x13converted_referrals\x18\x05 \x01(\x05R\x12convertedReferrals\x12*\n" +
+	"\x11bonus_days_earned\x18\x06 \x01(\x03R\x0fbonusDaysEarned\"2\n" +
+	"\x17GetReferralStatsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"z\n" +
+	"\x18GetReferralStatsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12*\n" +
+	"\x04data\x18\x03 \x01(\v2\x16.product.ReferralStatsR\x04data\"g\n" +
+	"\x1dGetReferralsByReferrerRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x19\n" +
+	"\bper_page\x18\x03 \x01(\x05R\aperPage\"\x92\x01\n" +
+	"\x1aGetReferralsByReferrerData\x12/\n" +
+	"\treferrals\x18\x01 \x03(\v2\x11.product.ReferralR\treferrals\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x19\n" +
+	"\bper_page\x18\x04 \x01(\x05R\aperPage\"\x8d\x01\n" +
+	"\x1eGetReferralsByReferrerResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x127\n" +
+	"\x04data\x18\x03 \x01(\v2#.product.GetReferralsByReferrerDataR\x04data\"\xb2\x01\n" +
+	"\fProductPrice\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\x03R\tproductId\x12\x1f\n" +
+	"\vcurrency_id\x18\x03 \x01(\x03R\n" +
+	"currencyId\x12\x14\n" +
+	"\x05price\x18\x04 \x01(\x03R\x05price\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\x03R\tupdatedAt\"n\n" +
+	"\x16SetProductPriceRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x1f\n" +
+	"\vcurrency_id\x18\x02 \x01(\x03R\n" +
+	"currencyId\x12\x14\n" +
+	"\x05price\x18\x03 \x01(\x03R\x05price\"x\n" +
+	"\x17SetProductPriceResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12)\n" +
+	"\x04data\x18\x03 \x01(\v2\x15.product.ProductPriceR\x04data\"8\n" +
+	"\x17GetProductPricesRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\"y\n" +
+	"\x18GetProductPricesResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12)\n" +
+	"\x04data\x18\x03 \x03(\v2\x15.product.ProductPriceR\x04data\"+\n" +
+	"\x19DeleteProductPriceRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"P\n" +
+	"\x1aDeleteProductPriceResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xf6\x04\n" +
+	"\x05Order\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04uuid\x18\x02 \x01(\tR\x04uuid\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x03R\x06userId\x12\x1b\n" +
+	"\ttenant_id\x18\x04 \x01(\x03R\btenantId\x12\x1f\n" +
+	"\vcurrency_id\x18\x05 \x01(\x03R\n" +
+	"currencyId\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x1a\n" +
+	"\bsubtotal\x18\a \x01(\x03R\bsubtotal\x12%\n" +
+	"\x0ediscount_total\x18\b \x01(\x03R\rdiscountTotal\x12\x14\n" +
+	"\x05total\x18\t \x01(\x03R\x05total\x12(\n" +
+	"\x10discount_code_id\x18\n" +
+	" \x01(\x03R\x0ediscountCodeId\x12.\n" +
+	"\x13payment_provider_id\x18\v \x01(\x03R\x11paymentProviderId\x12?\n" +
+	"\x1cpayment_provider_checkout_id\x18\f \x01(\tR\x19paymentProviderCheckoutId\x12!\n" +
+	"\fcheckout_url\x18\r \x01(\tR\vcheckoutUrl\x12\x17\n" +
+	"\apaid_at\x18\x0e \x01(\x03R\x06paidAt\x12!\n" +
+	"\fcancelled_at\x18\x0f \x01(\x03R\vcancelledAt\x12\x1f\n" +
+	"\vrefunded_at\x18\x10 \x01(\x03R\n" +
+	"refundedAt\x12(\n" +
+	"\x05items\x18\x11 \x03(\v2\x12.product.OrderItemR\x05items\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x12 \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x13 \x01(\x03R\tupdatedAt\"\xb3\x02\n" +
+	"\tOrderItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\x03R\aorderId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x03 \x01(\x03R\tproductId\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x03R\bquantity\x12\x1f\n" +
+	"\vunit_amount\x18\x06 \x01(\x03R\n" +
+	"unitAmount\x12'\n" +
+	"\x0fdiscount_amount\x18\a \x01(\x03R\x0ediscountAmount\x12\x16\n" +
+	"\x06amount\x18\b \x01(\x03R\x06amount\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\x03R\tupdatedAt\"K\n" +
+	"\x0eOrderItemInput\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity\"\xaa\x02\n" +
+	"\x12CreateOrderRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\x03R\btenantId\x12\x1f\n" +
+	"\vcurrency_id\x18\x03 \x01(\x03R\n" +
+	"currencyId\x12-\n" +
+	"\x05items\x18\x04 \x03(\v2\x17.product.OrderItemInputR\x05items\x12#\n" +
+	"\rdiscount_code\x18\x05 \x01(\tR\fdiscountCode\x12)\n" +
+	"\x10payment_provider\x18\x06 \x01(\tR\x0fpaymentProvider\x12\x1f\n" +
+	"\vsuccess_url\x18\a \x01(\tR\n" +
+	"successUrl\x12\x1d\n" +
+	"\n" +
+	"cancel_url\x18\b \x01(\tR\tcancelUrl\"m\n" +
+	"\x13CreateOrderResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\"\n" +
+	"\x04data\x18\x03 \x01(\v2\x0e.product.OrderR\x04data\"%\n" +
+	"\x13GetOrderByIDRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"n\n" +
+	"\x14GetOrderByIDResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\"\n" +
+	"\x04data\x18\x03 \x01(\v2\x0e.product.OrderR\x04data\"`\n" +
+	"\x16GetOrdersByUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x19\n" +
+	"\bper_page\x18\x03 \x01(\x05R\aperPage\"x\n" +
+	"\tOrderList\x12&\n" +
+	"\x06orders\x18\x01 \x03(\v2\x0e.product.OrderR\x06orders\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x19\n" +
+	"\bper_page\x18\x04 \x01(\x05R\aperPage\"u\n" +
+	"\x17GetOrdersByUserResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12&\n" +
+	"\x04data\x18\x03 \x01(\v2\x12.product.OrderListR\x04data\"\\\n" +
+	"\x13GetAllOrdersRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x19\n" +
+	"\bper_page\x18\x03 \x01(\x05R\aperPage\"r\n" +
+	"\x14GetAllOrdersResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12&\n" +
+	"\x04data\x18\x03 \x01(\v2\x12.product.OrderListR\x04data\"&\n" +
+	"\x14MarkOrderPaidRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"o\n" +
+	"\x15MarkOrderPaidResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\"\n" +
+	"\x04data\x18\x03 \x01(\v2\x0e.product.OrderR\x04data\"$\n" +
+	"\x12CancelOrderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"m\n" +
+	"\x13CancelOrderResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\"\n" +
+	"\x04data\x18\x03 \x01(\v2\x0e.product.OrderR\x04data\"$\n" +
+	"\x12RefundOrderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"m\n" +
+	"\x13RefundOrderResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\"\n" +
+	"\x04data\x18\x03 \x01(\v2\x0e.product.OrderR\x04data\"\x93\x02\n" +
+	"\bCurrency\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x16\n" +
+	"\x06symbol\x18\x04 \x01(\tR\x06symbol\x12%\n" +
+	"\x0edecimal_places\x18\x05 \x01(\x05R\rdecimalPlaces\x12-\n" +
+	"\x12rounding_increment\x18\x06 \x01(\x03R\x11roundingIncrement\x12#\n" +
+	"\rrounding_mode\x18\a \x01(\tR\froundingMode\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\x03R\tupdatedAt\"\x16\n" +
+	"\x14GetCurrenciesRequest\"r\n" +
+	"\x15GetCurrenciesResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12%\n" +
+	"\x04data\x18\x03 \x03(\v2\x11.product.CurrencyR\x04data\"(\n" +
+	"\x16GetCurrencyByIDRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"t\n" +
+	"\x17GetCurrencyByIDResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12%\n" +
+	"\x04data\x18\x03 \x01(\v2\x11.product.CurrencyR\x04data\"\xea\x01\n" +
+	"\x15CreateCurrencyRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06symbol\x18\x03 \x01(\tR\x06symbol\x12*\n" +
+	"\x0edecimal_places\x18\x04 \x01(\x05H\x00R\rdecimalPlaces\x88\x01\x01\x12-\n" +
+	"\x12rounding_increment\x18\x05 \x01(\x03R\x11roundingIncrement\x12#\n" +
+	"\rrounding_mode\x18\x06 \x01(\tR\froundingModeB\x11\n" +
+	"\x0f_decimal_places\"s\n" +
+	"\x16CreateCurrencyResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12%\n" +
+	"\x04data\x18\x03 \x01(\v2\x11.product.CurrencyR\x04data\"\xb7\x02\n" +
+	"\x15UpdateCurrencyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x1b\n" +
+	"\x06symbol\x18\x03 \x01(\tH\x01R\x06symbol\x88\x01\x01\x12*\n" +
+	"\x0edecimal_places\x18\x04 \x01(\x05H\x02R\rdecimalPlaces\x88\x01\x01\x122\n" +
+	"\x12rounding_increment\x18\x05 \x01(\x03H\x03R\x11roundingIncrement\x88\x01\x01\x12(\n" +
+	"\rrounding_mode\x18\x06 \x01(\tH\x04R\froundingMode\x88\x01\x01B\a\n" +
+	"\x05_nameB\t\n" +
+	"\a_symbolB\x11\n" +
+	"\x0f_decimal_placesB\x15\n" +
+	"\x13_rounding_incrementB\x10\n" +
+	"\x0e_rounding_mode\"s\n" +
+	"\x16UpdateCurrencyResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12%\n" +
+	"\x04data\x18\x03 \x01(\v2\x11.product.CurrencyR\x04data\"'\n" +
+	"\x15DeleteCurrencyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"L\n" +
+	"\x16DeleteCurrencyResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x81\x02\n" +
+	"\fExchangeRate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12(\n" +
+	"\x10base_currency_id\x18\x02 \x01(\x03R\x0ebaseCurrencyId\x12*\n" +
+	"\x11quote_currency_id\x18\x03 \x01(\x03R\x0fquoteCurrencyId\x12\x12\n" +
+	"\x04rate\x18\x04 \x01(\tR\x04rate\x12\x16\n" +
+	"\x06source\x18\x05 \x01(\tR\x06source\x12!\n" +
+	"\feffective_at\x18\x06 \x01(\x03R\veffectiveAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\x03R\tupdatedAt\"\x9e\x01\n" +
+	"\x17GetExchangeRatesRequest\x12(\n" +
+	"\x10base_currency_id\x18\x01 \x01(\x03R\x0ebaseCurrencyId\x12*\n" +
+	"\x11quote_currency_id\x18\x02 \x01(\x03R\x0fquoteCurrencyId\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x19\n" +
+	"\bper_page\x18\x04 \x01(\x05R\aperPage\"\xbe\x01\n" +
+	"\x18GetExchangeRatesResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12)\n" +
+	"\x04data\x18\x03 \x03(\v2\x15.product.ExchangeRateR\x04data\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04page\x18\x05 \x01(\x05R\x04page\x12\x19\n" +
+	"\bper_page\x18\x06 \x01(\x05R\aperPage\"\xa5\x01\n" +
+	"\x16SetExchangeRateRequest\x12(\n" +
+	"\x10base_currency_id\x18\x01 \x01(\x03R\x0ebaseCurrencyId\x12*\n" +
+	"\x11quote_currency_id\x18\x02 \x01(\x03R\x0fquoteCurrencyId\x12\x12\n" +
+	"\x04rate\x18\x03 \x01(\tR\x04rate\x12!\n" +
+	"\feffective_at\x18\x04 \x01(\x03R\veffectiveAt\"x\n" +
+	"\x17SetExchangeRateResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12)\n" +
+	"\x04data\x18\x03 \x01(\v2\x15.product.ExchangeRateR\x04data\"6\n" +
+	"\x1aImportExchangeRatesRequest\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\fR\acontent\"|\n" +
+	"\x1bImportExchangeRatesResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12)\n" +
+	"\x04data\x18\x03 \x03(\v2\x15.product.ExchangeRateR\x04data\"+\n" +
+	"\x19DeleteExchangeRateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"P\n" +
+	"\x1aDeleteExchangeRateResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"2\n" +
+	"\x17DerivePlanPricesRequest\x12\x17\n" +
+	"\aplan_id\x18\x01 \x01(\x03R\x06planId\"\xc9\x01\n" +
+	"\x18DerivePlanPricesResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x121\n" +
+	"\n" +
+	"base_price\x18\x03 \x01(\v2\x12.product.PlanPriceR\tbasePrice\x12,\n" +
+	"\aderived\x18\x04 \x03(\v2\x12.product.PlanPriceR\aderived\x12\x18\n" +
+	"\askipped\x18\x05 \x03(\tR\askipped\"\xaf\x02\n" +
+	"\x0fPlanEntitlement\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\aplan_id\x18\x02 \x01(\x03R\x06planId\x12\x1f\n" +
+	"\vfeature_key\x18\x03 \x01(\tR\n" +
+	"featureKey\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12\x1d\n" +
+	"\n" +
+	"is_enabled\x18\x05 \x01(\bR\tisEnabled\x12$\n" +
+	"\vlimit_value\x18\x06 \x01(\x03H\x00R\n" +
+	"limitValue\x88\x01\x01\x12\x1e\n" +
+	"\bmeter_id\x18\a \x01(\x03H\x01R\ameterId\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\x03R\tupdatedAtB\x0e\n" +
+	"\f_limit_valueB\v\n" +
+	"\t_meter_id\"5\n" +
+	"\x1aGetPlanEntitlementsRequest\x12\x17\n" +
+	"\aplan_id\x18\x01 \x01(\x03R\x06planId\"\x7f\n" +
+	"\x1bGetPlanEntitlementsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12,\n" +
+	"\x04data\x18\x03 \x03(\v2\x18.product.PlanEntitlementR\x04data\"\xeb\x01\n" +
+	"\x19SetPlanEntitlementRequest\x12\x17\n" +
+	"\aplan_id\x18\x01 \x01(\x03R\x06planId\x12\x1f\n" +
+	"\vfeature_key\x18\x02 \x01(\tR\n" +
+	"featureKey\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x1d\n" +
+	"\n" +
+	"is_enabled\x18\x04 \x01(\bR\tisEnabled\x12$\n" +
+	"\vlimit_value\x18\x05 \x01(\x03H\x00R\n" +
+	"limitValue\x88\x01\x01\x12\x1e\n" +
+	"\bmeter_id\x18\x06 \x01(\x03H\x01R\ameterId\x88\x01\x01B\x0e\n" +
+	"\f_limit_valueB\v\n" +
+	"\t_meter_id\"~\n" +
+	"\x1aSetPlanEntitlementResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12,\n" +
+	"\x04data\x18\x03 \x01(\v2\x18.product.PlanEntitlementR\x04data\".\n" +
+	"\x1cDeletePlanEntitlementRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"S\n" +
+	"\x1dDeletePlanEntitlementResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage2\xcd9\n" +
 	"\x0eProductService\x12S\n" +
 	"\x0eGetProductByID\x12\x1e.product.GetProductByIDRequest\x1a\x1f.product.GetProductByIDResponse\"\x00\x12Y\n" +
 	"\x10GetProductBySlug\x12 .product.GetProductBySlugRequest\x1a!.product.GetProductBySlugResponse\"\x00\x12P\n" +
@@ -8055,12 +13530,17 @@ const file_product_proto_rawDesc = "" +
 	"\x13GetPlanPricesByPlan\x12#.product.GetPlanPricesByPlanRequest\x1a$.product.GetPlanPricesByPlanResponse\"\x00\x12V\n" +
 	"\x0fCreatePlanPrice\x12\x1f.product.CreatePlanPriceRequest\x1a .product.CreatePlanPriceResponse\"\x00\x12V\n" +
 	"\x0fUpdatePlanPrice\x12\x1f.product.UpdatePlanPriceRequest\x1a .product.UpdatePlanPriceResponse\"\x00\x12V\n" +
-	"\x0fDeletePlanPrice\x12\x1f.product.DeletePlanPriceRequest\x1a .product.DeletePlanPriceResponse\"\x00\x12Y\n" +
+	"\x0fDeletePlanPrice\x12\x1f.product.DeletePlanPriceRequest\x1a .product.DeletePlanPriceResponse\"\x00\x12G\n" +
+	"\n" +
+	"QuotePrice\x12\x1a.product.QuotePriceRequest\x1a\x1b.product.QuotePriceResponse\"\x00\x12Y\n" +
 	"\x10GetPlanMeterByID\x12 .product.GetPlanMeterByIDRequest\x1a!.product.GetPlanMeterByIDResponse\"\x00\x12Y\n" +
 	"\x10GetAllPlanMeters\x12 .product.GetAllPlanMetersRequest\x1a!.product.GetAllPlanMetersResponse\"\x00\x12V\n" +
 	"\x0fCreatePlanMeter\x12\x1f.product.CreatePlanMeterRequest\x1a .product.CreatePlanMeterResponse\"\x00\x12V\n" +
 	"\x0fUpdatePlanMeter\x12\x1f.product.UpdatePlanMeterRequest\x1a .product.UpdatePlanMeterResponse\"\x00\x12V\n" +
-	"\x0fDeletePlanMeter\x12\x1f.product.DeletePlanMeterRequest\x1a .product.DeletePlanMeterResponse\"\x00\x12V\n" +
+	"\x0fDeletePlanMeter\x12\x1f.product.DeletePlanMeterRequest\x1a .product.DeletePlanMeterResponse\"\x00\x12b\n" +
+	"\x13GetPlanEntitlements\x12#.product.GetPlanEntitlementsRequest\x1a$.product.GetPlanEntitlementsResponse\"\x00\x12_\n" +
+	"\x12SetPlanEntitlement\x12\".product.SetPlanEntitlementRequest\x1a#.product.SetPlanEntitlementResponse\"\x00\x12h\n" +
+	"\x15DeletePlanEntitlement\x12%.product.DeletePlanEntitlementRequest\x1a&.product.DeletePlanEntitlementResponse\"\x00\x12V\n" +
 	"\x0fGetDiscountByID\x12\x1f.product.GetDiscountByIDRequest\x1a .product.GetDiscountByIDResponse\"\x00\x12\\\n" +
 	"\x11GetDiscountByCode\x12!.product.GetDiscountByCodeRequest\x1a\".product.GetDiscountByCodeResponse\"\x00\x12S\n" +
 	"\x0eCreateDiscount\x12\x1e.product.CreateDiscountRequest\x1a\x1f.product.CreateDiscountResponse\"\x00\x12S\n" +
@@ -8071,7 +13551,9 @@ const file_product_proto_rawDesc = "" +
 	"\x15GetDiscountCodeByCode\x12%.product.GetDiscountCodeByCodeRequest\x1a&.product.GetDiscountCodeByCodeResponse\"\x00\x12w\n" +
 	"\x1aGetDiscountCodesByDiscount\x12*.product.GetDiscountCodesByDiscountRequest\x1a+.product.GetDiscountCodesByDiscountResponse\"\x00\x12_\n" +
 	"\x12CreateDiscountCode\x12\".product.CreateDiscountCodeRequest\x1a#.product.CreateDiscountCodeResponse\"\x00\x12_\n" +
-	"\x12DeleteDiscountCode\x12\".product.DeleteDiscountCodeRequest\x1a#.product.DeleteDiscountCodeResponse\"\x00\x12\\\n" +
+	"\x12DeleteDiscountCode\x12\".product.DeleteDiscountCodeRequest\x1a#.product.DeleteDiscountCodeResponse\"\x00\x12j\n" +
+	"\x15GenerateDiscountCodes\x12%.product.GenerateDiscountCodesRequest\x1a&.product.GenerateDiscountCodesResponse\"\x000\x01\x12b\n" +
+	"\x13ExportDiscountCodes\x12#.product.ExportDiscountCodesRequest\x1a$.product.ExportDiscountCodesResponse\"\x00\x12\\\n" +
 	"\x11AddPlanToDiscount\x12!.product.AddPlanToDiscountRequest\x1a\".product.AddPlanToDiscountResponse\"\x00\x12k\n" +
 	"\x16RemovePlanFromDiscount\x12&.product.RemovePlanFromDiscountRequest\x1a'.product.RemovePlanFromDiscountResponse\"\x00\x12_\n" +
 	"\x12GetPlansByDiscount\x12\".product.GetPlansByDiscountRequest\x1a#.product.GetPlansByDiscountResponse\"\x00\x12z\n" +
@@ -8085,7 +13567,31 @@ const file_product_proto_rawDesc = "" +
 	"\x12RedeemDiscountCode\x12\".product.RedeemDiscountCodeRequest\x1a#.product.RedeemDiscountCodeResponse\"\x00\x12e\n" +
 	"\x14GetRedemptionsByUser\x12$.product.GetRedemptionsByUserRequest\x1a%.product.GetRedemptionsByUserResponse\"\x00\x12}\n" +
 	"\x1cGetRedemptionsByDiscountCode\x12,.product.GetRedemptionsByDiscountCodeRequest\x1a-.product.GetRedemptionsByDiscountCodeResponse\"\x00\x12e\n" +
-	"\x14ValidateDiscountCode\x12$.product.ValidateDiscountCodeRequest\x1a%.product.ValidateDiscountCodeResponse\"\x00B\x1eZ\x1cshared/proto/product;productb\x06proto3"
+	"\x14ValidateDiscountCode\x12$.product.ValidateDiscountCodeRequest\x1a%.product.ValidateDiscountCodeResponse\"\x00\x12V\n" +
+	"\x0fPreviewCheckout\x12\x1f.product.PreviewCheckoutRequest\x1a .product.PreviewCheckoutResponse\"\x00\x12V\n" +
+	"\x0fGetReferralCode\x12\x1f.product.GetReferralCodeRequest\x1a .product.GetReferralCodeResponse\"\x00\x12Y\n" +
+	"\x10GetReferralStats\x12 .product.GetReferralStatsRequest\x1a!.product.GetReferralStatsResponse\"\x00\x12k\n" +
+	"\x16GetReferralsByReferrer\x12&.product.GetReferralsByReferrerRequest\x1a'.product.GetReferralsByReferrerResponse\"\x00\x12V\n" +
+	"\x0fSetProductPrice\x12\x1f.product.SetProductPriceRequest\x1a .product.SetProductPriceResponse\"\x00\x12Y\n" +
+	"\x10GetProductPrices\x12 .product.GetProductPricesRequest\x1a!.product.GetProductPricesResponse\"\x00\x12_\n" +
+	"\x12DeleteProductPrice\x12\".product.DeleteProductPriceRequest\x1a#.product.DeleteProductPriceResponse\"\x00\x12J\n" +
+	"\vCreateOrder\x12\x1b.product.CreateOrderRequest\x1a\x1c.product.CreateOrderResponse\"\x00\x12M\n" +
+	"\fGetOrderByID\x12\x1c.product.GetOrderByIDRequest\x1a\x1d.product.GetOrderByIDResponse\"\x00\x12V\n" +
+	"\x0fGetOrdersByUser\x12\x1f.product.GetOrdersByUserRequest\x1a .product.GetOrdersByUserResponse\"\x00\x12M\n" +
+	"\fGetAllOrders\x12\x1c.product.GetAllOrdersRequest\x1a\x1d.product.GetAllOrdersResponse\"\x00\x12P\n" +
+	"\rMarkOrderPaid\x12\x1d.product.MarkOrderPaidRequest\x1a\x1e.product.MarkOrderPaidResponse\"\x00\x12J\n" +
+	"\vCancelOrder\x12\x1b.product.CancelOrderRequest\x1a\x1c.product.CancelOrderResponse\"\x00\x12J\n" +
+	"\vRefundOrder\x12\x1b.product.RefundOrderRequest\x1a\x1c.product.RefundOrderResponse\"\x00\x12P\n" +
+	"\rGetCurrencies\x12\x1d.product.GetCurrenciesRequest\x1a\x1e.product.GetCurrenciesResponse\"\x00\x12V\n" +
+	"\x0fGetCurrencyByID\x12\x1f.product.GetCurrencyByIDRequest\x1a .product.GetCurrencyByIDResponse\"\x00\x12S\n" +
+	"\x0eCreateCurrency\x12\x1e.product.CreateCurrencyRequest\x1a\x1f.product.CreateCurrencyResponse\"\x00\x12S\n" +
+	"\x0eUpdateCurrency\x12\x1e.product.UpdateCurrencyRequest\x1a\x1f.product.UpdateCurrencyResponse\"\x00\x12S\n" +
+	"\x0eDeleteCurrency\x12\x1e.product.DeleteCurrencyRequest\x1a\x1f.product.DeleteCurrencyResponse\"\x00\x12Y\n" +
+	"\x10GetExchangeRates\x12 .product.GetExchangeRatesRequest\x1a!.product.GetExchangeRatesResponse\"\x00\x12V\n" +
+	"\x0fSetExchangeRate\x12\x1f.product.SetExchangeRateRequest\x1a .product.SetExchangeRateResponse\"\x00\x12b\n" +
+	"\x13ImportExchangeRates\x12#.product.ImportExchangeRatesRequest\x1a$.product.ImportExchangeRatesResponse\"\x00\x12_\n" +
+	"\x12DeleteExchangeRate\x12\".product.DeleteExchangeRateRequest\x1a#.product.DeleteExchangeRateResponse\"\x00\x12Y\n" +
+	"\x10DerivePlanPrices\x12 .product.DerivePlanPricesRequest\x1a!.product.DerivePlanPricesResponse\"\x00B\x1eZ\x1cshared/proto/product;productb\x06proto3"
 
 var (
 	file_product_proto_rawDescOnce sync.Once
@@ -8099,7 +13605,7 @@ func file_product_proto_rawDescGZIP() []byte {
 	return file_product_proto_rawDescData
 }
 
-var file_product_proto_msgTypes = make([]protoimpl.MessageInfo, 112)
+var file_product_proto_msgTypes = make([]protoimpl.MessageInfo, 187)
 var file_product_proto_goTypes = []any{
 	(*Product)(nil),                                   // 0: product.Product
 	(*GetProductByIDRequest)(nil),                     // 1: product.GetProductByIDRequest
@@ -8142,77 +13648,152 @@ var file_product_proto_goTypes = []any{
 	(*UpdatePlanPriceResponse)(nil),                   // 38: product.UpdatePlanPriceResponse
 	(*DeletePlanPriceRequest)(nil),                    // 39: product.DeletePlanPriceRequest
 	(*DeletePlanPriceResponse)(nil),                   // 40: product.DeletePlanPriceResponse
-	(*PlanMeter)(nil),                                 // 41: product.PlanMeter
-	(*GetPlanMeterByIDRequest)(nil),                   // 42: product.GetPlanMeterByIDRequest
-	(*GetPlanMeterByIDResponse)(nil),                  // 43: product.GetPlanMeterByIDResponse
-	(*GetAllPlanMetersRequest)(nil),                   // 44: product.GetAllPlanMetersRequest
-	(*GetAllPlanMetersData)(nil),                      // 45: product.GetAllPlanMetersData
-	(*GetAllPlanMetersResponse)(nil),                  // 46: product.GetAllPlanMetersResponse
-	(*CreatePlanMeterRequest)(nil),                    // 47: product.CreatePlanMeterRequest
-	(*CreatePlanMeterResponse)(nil),                   // 48: product.CreatePlanMeterResponse
-	(*UpdatePlanMeterRequest)(nil),                    // 49: product.UpdatePlanMeterRequest
-	(*UpdatePlanMeterResponse)(nil),                   // 50: product.UpdatePlanMeterResponse
-	(*DeletePlanMeterRequest)(nil),                    // 51: product.DeletePlanMeterRequest
-	(*DeletePlanMeterResponse)(nil),                   // 52: product.DeletePlanMeterResponse
-	(*Discount)(nil),                                  // 53: product.Discount
-	(*GetDiscountByIDRequest)(nil),                    // 54: product.GetDiscountByIDRequest
-	(*GetDiscountByIDResponse)(nil),                   // 55: product.GetDiscountByIDResponse
-	(*GetDiscountByCodeRequest)(nil),                  // 56: product.GetDiscountByCodeRequest
-	(*GetDiscountByCodeResponse)(nil),                 // 57: product.GetDiscountByCodeResponse
-	(*CreateDiscountRequest)(nil),                     // 58: product.CreateDiscountRequest
-	(*CreateDiscountResponse)(nil),                    // 59: product.CreateDiscountResponse
-	(*UpdateDiscountRequest)(nil),                     // 60: product.UpdateDiscountRequest
-	(*UpdateDiscountResponse)(nil),                    // 61: product.UpdateDiscountResponse
-	(*DeleteDiscountRequest)(nil),                     // 62: product.DeleteDiscountRequest
-	(*DeleteDiscountResponse)(nil),                    // 63: product.DeleteDiscountResponse
-	(*GetAllDiscountsRequest)(nil),                    // 64: product.GetAllDiscountsRequest
-	(*GetAllDiscountsData)(nil),                       // 65: product.GetAllDiscountsData
-	(*GetAllDiscountsResponse)(nil),                   // 66: product.GetAllDiscountsResponse
-	(*DiscountCode)(nil),                              // 67: product.DiscountCode
-	(*GetDiscountCodeByIDRequest)(nil),                // 68: product.GetDiscountCodeByIDRequest
-	(*GetDiscountCodeByIDResponse)(nil),               // 69: product.GetDiscountCodeByIDResponse
-	(*GetDiscountCodeByCodeRequest)(nil),              // 70: product.GetDiscountCodeByCodeRequest
-	(*GetDiscountCodeByCodeResponse)(nil),             // 71: product.GetDiscountCodeByCodeResponse
-	(*GetDiscountCodesByDiscountRequest)(nil),         // 72: product.GetDiscountCodesByDiscountRequest
-	(*GetDiscountCodesByDiscountResponse)(nil),        // 73: product.GetDiscountCodesByDiscountResponse
-	(*CreateDiscountCodeRequest)(nil),                 // 74: product.CreateDiscountCodeRequest
-	(*CreateDiscountCodeResponse)(nil),                // 75: product.CreateDiscountCodeResponse
-	(*DeleteDiscountCodeRequest)(nil),                 // 76: product.DeleteDiscountCodeRequest
-	(*DeleteDiscountCodeResponse)(nil),                // 77: product.DeleteDiscountCodeResponse
-	(*DiscountPlan)(nil),                              // 78: product.DiscountPlan
-	(*AddPlanToDiscountRequest)(nil),                  // 79: product.AddPlanToDiscountRequest
-	(*AddPlanToDiscountResponse)(nil),                 // 80: product.AddPlanToDiscountResponse
-	(*RemovePlanFromDiscountRequest)(nil),             // 81: product.RemovePlanFromDiscountRequest
-	(*RemovePlanFromDiscountResponse)(nil),            // 82: product.RemovePlanFromDiscountResponse
-	(*GetPlansByDiscountRequest)(nil),                 // 83: product.GetPlansByDiscountRequest
-	(*GetPlansByDiscountResponse)(nil),                // 84: product.GetPlansByDiscountResponse
-	(*DiscountOneTimeProduct)(nil),                    // 85: product.DiscountOneTimeProduct
-	(*AddOneTimeProductToDiscountRequest)(nil),        // 86: product.AddOneTimeProductToDiscountRequest
-	(*AddOneTimeProductToDiscountResponse)(nil),       // 87: product.AddOneTimeProductToDiscountResponse
-	(*RemoveOneTimeProductFromDiscountRequest)(nil),   // 88: product.RemoveOneTimeProductFromDiscountRequest
-	(*RemoveOneTimeProductFromDiscountResponse)(nil),  // 89: product.RemoveOneTimeProductFromDiscountResponse
-	(*GetOneTimeProductsByDiscountRequest)(nil),       // 90: product.GetOneTimeProductsByDiscountRequest
-	(*GetOneTimeProductsByDiscountResponse)(nil),      // 91: product.GetOneTimeProductsByDiscountResponse
-	(*DiscountPaymentProviderData)(nil),               // 92: product.DiscountPaymentProviderData
-	(*GetDiscountPaymentProviderDataRequest)(nil),     // 93: product.GetDiscountPaymentProviderDataRequest
-	(*GetDiscountPaymentProviderDataResponse)(nil),    // 94: product.GetDiscountPaymentProviderDataResponse
-	(*CreateDiscountPaymentProviderDataRequest)(nil),  // 95: product.CreateDiscountPaymentProviderDataRequest
-	(*CreateDiscountPaymentProviderDataResponse)(nil), // 96: product.CreateDiscountPaymentProviderDataResponse
-	(*UpdateDiscountPaymentProviderDataRequest)(nil),  // 97: product.UpdateDiscountPaymentProviderDataRequest
-	(*UpdateDiscountPaymentProviderDataResponse)(nil), // 98: product.UpdateDiscountPaymentProviderDataResponse
-	(*DeleteDiscountPaymentProviderDataRequest)(nil),  // 99: product.DeleteDiscountPaymentProviderDataRequest
-	(*DeleteDiscountPaymentProviderDataResponse)(nil), // 100: product.DeleteDiscountPaymentProviderDataResponse
-	(*DiscountCodeRedemption)(nil),                    // 101: product.DiscountCodeRedemption
-	(*RedeemDiscountCodeRequest)(nil),                 // 102: product.RedeemDiscountCodeRequest
-	(*RedeemDiscountCodeResponse)(nil),                // 103: product.RedeemDiscountCodeResponse
-	(*GetRedemptionsByUserRequest)(nil),               // 104: product.GetRedemptionsByUserRequest
-	(*GetRedemptionsByUserData)(nil),                  // 105: product.GetRedemptionsByUserData
-	(*GetRedemptionsByUserResponse)(nil),              // 106: product.GetRedemptionsByUserResponse
-	(*GetRedemptionsByDiscountCodeRequest)(nil),       // 107: product.GetRedemptionsByDiscountCodeRequest
-	(*GetRedemptionsByDiscountCodeData)(nil),          // 108: product.GetRedemptionsByDiscountCodeData
-	(*GetRedemptionsByDiscountCodeResponse)(nil),      // 109: product.GetRedemptionsByDiscountCodeResponse
-	(*ValidateDiscountCodeRequest)(nil),               // 110: product.ValidateDiscountCodeRequest
-	(*ValidateDiscountCodeResponse)(nil),              // 111: product.ValidateDiscountCodeResponse
+	(*QuotePriceRequest)(nil),                         // 41: product.QuotePriceRequest
+	(*PriceQuoteLine)(nil),                            // 42: product.PriceQuoteLine
+	(*PriceQuote)(nil),                                // 43: product.PriceQuote
+	(*QuotePriceResponse)(nil),                        // 44: product.QuotePriceResponse
+	(*PlanMeter)(nil),                                 // 45: product.PlanMeter
+	(*GetPlanMeterByIDRequest)(nil),                   // 46: product.GetPlanMeterByIDRequest
+	(*GetPlanMeterByIDResponse)(nil),                  // 47: product.GetPlanMeterByIDResponse
+	(*GetAllPlanMetersRequest)(nil),                   // 48: product.GetAllPlanMetersRequest
+	(*GetAllPlanMetersData)(nil),                      // 49: product.GetAllPlanMetersData
+	(*GetAllPlanMetersResponse)(nil),                  // 50: product.GetAllPlanMetersResponse
+	(*CreatePlanMeterRequest)(nil),                    // 51: product.CreatePlanMeterRequest
+	(*CreatePlanMeterResponse)(nil),                   // 52: product.CreatePlanMeterResponse
+	(*UpdatePlanMeterRequest)(nil),                    // 53: product.UpdatePlanMeterRequest
+	(*UpdatePlanMeterResponse)(nil),                   // 54: product.UpdatePlanMeterResponse
+	(*DeletePlanMeterRequest)(nil),                    // 55: product.DeletePlanMeterRequest
+	(*DeletePlanMeterResponse)(nil),                   // 56: product.DeletePlanMeterResponse
+	(*Discount)(nil),                                  // 57: product.Discount
+	(*GetDiscountByIDRequest)(nil),                    // 58: product.GetDiscountByIDRequest
+	(*GetDiscountByIDResponse)(nil),                   // 59: product.GetDiscountByIDResponse
+	(*GetDiscountByCodeRequest)(nil),                  // 60: product.GetDiscountByCodeRequest
+	(*GetDiscountByCodeResponse)(nil),                 // 61: product.GetDiscountByCodeResponse
+	(*CreateDiscountRequest)(nil),                     // 62: product.CreateDiscountRequest
+	(*CreateDiscountResponse)(nil),                    // 63: product.CreateDiscountResponse
+	(*UpdateDiscountRequest)(nil),                     // 64: product.UpdateDiscountRequest
+	(*UpdateDiscountResponse)(nil),                    // 65: product.UpdateDiscountResponse
+	(*DeleteDiscountRequest)(nil),                     // 66: product.DeleteDiscountRequest
+	(*DeleteDiscountResponse)(nil),                    // 67: product.DeleteDiscountResponse
+	(*GetAllDiscountsRequest)(nil),                    // 68: product.GetAllDiscountsRequest
+	(*GetAllDiscountsData)(nil),                       // 69: product.GetAllDiscountsData
+	(*GetAllDiscountsResponse)(nil),                   // 70: product.GetAllDiscountsResponse
+	(*DiscountCode)(nil),                              // 71: product.DiscountCode
+	(*GetDiscountCodeByIDRequest)(nil),                // 72: product.GetDiscountCodeByIDRequest
+	(*GetDiscountCodeByIDResponse)(nil),               // 73: product.GetDiscountCodeByIDResponse
+	(*GetDiscountCodeByCodeRequest)(nil),              // 74: product.GetDiscountCodeByCodeRequest
+	(*GetDiscountCodeByCodeResponse)(nil),             // 75: product.GetDiscountCodeByCodeResponse
+	(*GetDiscountCodesByDiscountRequest)(nil),         // 76: product.GetDiscountCodesByDiscountRequest
+	(*GetDiscountCodesByDiscountResponse)(nil),        // 77: product.GetDiscountCodesByDiscountResponse
+	(*CreateDiscountCodeRequest)(nil),                 // 78: product.CreateDiscountCodeRequest
+	(*CreateDiscountCodeResponse)(nil),                // 79: product.CreateDiscountCodeResponse
+	(*DeleteDiscountCodeRequest)(nil),                 // 80: product.DeleteDiscountCodeRequest
+	(*DeleteDiscountCodeResponse)(nil),                // 81: product.DeleteDiscountCodeResponse
+	(*GenerateDiscountCodesRequest)(nil),              // 82: product.GenerateDiscountCodesRequest
+	(*GenerateDiscountCodesResponse)(nil),             // 83: product.GenerateDiscountCodesResponse
+	(*ExportDiscountCodesRequest)(nil),                // 84: product.ExportDiscountCodesRequest
+	(*ExportDiscountCodesResponse)(nil),               // 85: product.ExportDiscountCodesResponse
+	(*DiscountPlan)(nil),                              // 86: product.DiscountPlan
+	(*AddPlanToDiscountRequest)(nil),                  // 87: product.AddPlanToDiscountRequest
+	(*AddPlanToDiscountResponse)(nil),                 // 88: product.AddPlanToDiscountResponse
+	(*RemovePlanFromDiscountRequest)(nil),             // 89: product.RemovePlanFromDiscountRequest
+	(*RemovePlanFromDiscountResponse)(nil),            // 90: product.RemovePlanFromDiscountResponse
+	(*GetPlansByDiscountRequest)(nil),                 // 91: product.GetPlansByDiscountRequest
+	(*GetPlansByDiscountResponse)(nil),                // 92: product.GetPlansByDiscountResponse
+	(*DiscountOneTimeProduct)(nil),                    // 93: product.DiscountOneTimeProduct
+	(*AddOneTimeProductToDiscountRequest)(nil),        // 94: product.AddOneTimeProductToDiscountRequest
+	(*AddOneTimeProductToDiscountResponse)(nil),       // 95: product.AddOneTimeProductToDiscountResponse
+	(*RemoveOneTimeProductFromDiscountRequest)(nil),   // 96: product.RemoveOneTimeProductFromDiscountRequest
+	(*RemoveOneTimeProductFromDiscountResponse)(nil),  // 97: product.RemoveOneTimeProductFromDiscountResponse
+	(*GetOneTimeProductsByDiscountRequest)(nil),       // 98: product.GetOneTimeProductsByDiscountRequest
+	(*GetOneTimeProductsByDiscountResponse)(nil),      // 99: product.GetOneTimeProductsByDiscountResponse
+	(*DiscountPaymentProviderData)(nil),               // 100: product.DiscountPaymentProviderData
+	(*GetDiscountPaymentProviderDataRequest)(nil),     // 101: product.GetDiscountPaymentProviderDataRequest
+	(*GetDiscountPaymentProviderDataResponse)(nil),    // 102: product.GetDiscountPaymentProviderDataResponse
+	(*CreateDiscountPaymentProviderDataRequest)(nil),  // 103: product.CreateDiscountPaymentProviderDataRequest
+	(*CreateDiscountPaymentProviderDataResponse)(nil), // 104: product.CreateDiscountPaymentProviderDataResponse
+	(*UpdateDiscountPaymentProviderDataRequest)(nil),  // 105: product.UpdateDiscountPaymentProviderDataRequest
+	(*UpdateDiscountPaymentProviderDataResponse)(nil), // 106: product.UpdateDiscountPaymentProviderDataResponse
+	(*DeleteDiscountPaymentProviderDataRequest)(nil),  // 107: product.DeleteDiscountPaymentProviderDataRequest
+	(*DeleteDiscountPaymentProviderDataResponse)(nil), // 108: product.DeleteDiscountPaymentProviderDataResponse
+	(*DiscountCodeRedemption)(nil),                    // 109: product.DiscountCodeRedemption
+	(*RedeemDiscountCodeRequest)(nil),                 // 110: product.RedeemDiscountCodeRequest
+	(*RedeemDiscountCodeResponse)(nil),                // 111: product.RedeemDiscountCodeResponse
+	(*GetRedemptionsByUserRequest)(nil),               // 112: product.GetRedemptionsByUserRequest
+	(*GetRedemptionsByUserData)(nil),                  // 113: product.GetRedemptionsByUserData
+	(*GetRedemptionsByUserResponse)(nil),              // 114: product.GetRedemptionsByUserResponse
+	(*GetRedemptionsByDiscountCodeRequest)(nil),       // 115: product.GetRedemptionsByDiscountCodeRequest
+	(*GetRedemptionsByDiscountCodeData)(nil),          // 116: product.GetRedemptionsByDiscountCodeData
+	(*GetRedemptionsByDiscountCodeResponse)(nil),      // 117: product.GetRedemptionsByDiscountCodeResponse
+	(*ValidateDiscountCodeRequest)(nil),               // 118: product.ValidateDiscountCodeRequest
+	(*ValidateDiscountCodeResponse)(nil),              // 119: product.ValidateDiscountCodeResponse
+	(*PreviewCheckoutRequest)(nil),                    // 120: product.PreviewCheckoutRequest
+	(*CheckoutLine)(nil),                              // 121: product.CheckoutLine
+	(*CheckoutPreview)(nil),                           // 122: product.CheckoutPreview
+	(*PreviewCheckoutResponse)(nil),                   // 123: product.PreviewCheckoutResponse
+	(*Referral)(nil),                                  // 124: product.Referral
+	(*GetReferralCodeRequest)(nil),                    // 125: product.GetReferralCodeRequest
+	(*GetReferralCodeResponse)(nil),                   // 126: product.GetReferralCodeResponse
+	(*ReferralStats)(nil),                             // 127: product.ReferralStats
+	(*GetReferralStatsRequest)(nil),                   // 128: product.GetReferralStatsRequest
+	(*GetReferralStatsResponse)(nil),                  // 129: product.GetReferralStatsResponse
+	(*GetReferralsByReferrerRequest)(nil),             // 130: product.GetReferralsByReferrerRequest
+	(*GetReferralsByReferrerData)(nil),                // 131: product.GetReferralsByReferrerData
+	(*GetReferralsByReferrerResponse)(nil),            // 132: product.GetReferralsByReferrerResponse
+	(*ProductPrice)(nil),                              // 133: product.ProductPrice
+	(*SetProductPriceRequest)(nil),                    // 134: product.SetProductPriceRequest
+	(*SetProductPriceResponse)(nil),                   // 135: product.SetProductPriceResponse
+	(*GetProductPricesRequest)(nil),                   // 136: product.GetProductPricesRequest
+	(*GetProductPricesResponse)(nil),                  // 137: product.GetProductPricesResponse
+	(*DeleteProductPriceRequest)(nil),                 // 138: product.DeleteProductPriceRequest
+	(*DeleteProductPriceResponse)(nil),                // 139: product.DeleteProductPriceResponse
+	(*Order)(nil),                                     // 140: product.Order
+	(*OrderItem)(nil),                                 // 141: product.OrderItem
+	(*OrderItemInput)(nil),                            // 142: product.OrderItemInput
+	(*CreateOrderRequest)(nil),                        // 143: product.CreateOrderRequest
+	(*CreateOrderResponse)(nil),                       // 144: product.CreateOrderResponse
+	(*GetOrderByIDRequest)(nil),                       // 145: product.GetOrderByIDRequest
+	(*GetOrderByIDResponse)(nil),                      // 146: product.GetOrderByIDResponse
+	(*GetOrdersByUserRequest)(nil),                    // 147: product.GetOrdersByUserRequest
+	(*OrderList)(nil),                                 // 148: product.OrderList
+	(*GetOrdersByUserResponse)(nil),                   // 149: product.GetOrdersByUserResponse
+	(*GetAllOrdersRequest)(nil),                       // 150: product.GetAllOrdersRequest
+	(*GetAllOrdersResponse)(nil),                      // 151: product.GetAllOrdersResponse
+	(*MarkOrderPaidRequest)(nil),                      // 152: product.MarkOrderPaidRequest
+	(*MarkOrderPaidResponse)(nil),                     // 153: product.MarkOrderPaidResponse
+	(*CancelOrderRequest)(nil),                        // 154: product.CancelOrderRequest
+	(*CancelOrderResponse)(nil),                       // 155: product.CancelOrderResponse
+	(*RefundOrderRequest)(nil),                        // 156: product.RefundOrderRequest
+	(*RefundOrderResponse)(nil),                       // 157: product.RefundOrderResponse
+	(*Currency)(nil),                                  // 158: product.Currency
+	(*GetCurrenciesRequest)(nil),                      // 159: product.GetCurrenciesRequest
+	(*GetCurrenciesResponse)(nil),                     // 160: product.GetCurrenciesResponse
+	(*GetCurrencyByIDRequest)(nil),                    // 161: product.GetCurrencyByIDRequest
+	(*GetCurrencyByIDResponse)(nil),                   // 162: product.GetCurrencyByIDResponse
+	(*CreateCurrencyRequest)(nil),                     // 163: product.CreateCurrencyRequest
+	(*CreateCurrencyResponse)(nil),                    // 164: product.CreateCurrencyResponse
+	(*UpdateCurrencyRequest)(nil),                     // 165: product.UpdateCurrencyRequest
+	(*UpdateCurrencyResponse)(nil),                    // 166: product.UpdateCurrencyResponse
+	(*DeleteCurrencyRequest)(nil),                     // 167: product.DeleteCurrencyRequest
+	(*DeleteCurrencyResponse)(nil),                    // 168: product.DeleteCurrencyResponse
+	(*ExchangeRate)(nil),                              // 169: product.ExchangeRate
+	(*GetExchangeRatesRequest)(nil),                   // 170: product.GetExchangeRatesRequest
+	(*GetExchangeRatesResponse)(nil),                  // 171: product.GetExchangeRatesResponse
+	(*SetExchangeRateRequest)(nil),                    // 172: product.SetExchangeRateRequest
+	(*SetExchangeRateResponse)(nil),                   // 173: product.SetExchangeRateResponse
+	(*ImportExchangeRatesRequest)(nil),                // 174: product.ImportExchangeRatesRequest
+	(*ImportExchangeRatesResponse)(nil),               // 175: product.ImportExchangeRatesResponse
+	(*DeleteExchangeRateRequest)(nil),                 // 176: product.DeleteExchangeRateRequest
+	(*DeleteExchangeRateResponse)(nil),                // 177: product.DeleteExchangeRateResponse
+	(*DerivePlanPricesRequest)(nil),                   // 178: product.DerivePlanPricesRequest
+	(*DerivePlanPricesResponse)(nil),                  // 179: product.DerivePlanPricesResponse
+	(*PlanEntitlement)(nil),                           // 180: product.PlanEntitlement
+	(*GetPlanEntitlementsRequest)(nil),                // 181: product.GetPlanEntitlementsRequest
+	(*GetPlanEntitlementsResponse)(nil),               // 182: product.GetPlanEntitlementsResponse
+	(*SetPlanEntitlementRequest)(nil),                 // 183: product.SetPlanEntitlementRequest
+	(*SetPlanEntitlementResponse)(nil),                // 184: product.SetPlanEntitlementResponse
+	(*DeletePlanEntitlementRequest)(nil),              // 185: product.DeletePlanEntitlementRequest
+	(*DeletePlanEntitlementResponse)(nil),             // 186: product.DeletePlanEntitlementResponse
 }
 var file_product_proto_depIdxs = []int32{
 	0,   // 0: product.GetProductByIDResponse.data:type_name -> product.Product
@@ -8232,133 +13813,228 @@ var file_product_proto_depIdxs = []int32{
 	30,  // 14: product.GetPlanPricesByPlanResponse.data:type_name -> product.PlanPrice
 	30,  // 15: product.CreatePlanPriceResponse.data:type_name -> product.PlanPrice
 	30,  // 16: product.UpdatePlanPriceResponse.data:type_name -> product.PlanPrice
-	41,  // 17: product.GetPlanMeterByIDResponse.data:type_name -> product.PlanMeter
-	41,  // 18: product.GetAllPlanMetersData.meters:type_name -> product.PlanMeter
-	45,  // 19: product.GetAllPlanMetersResponse.data:type_name -> product.GetAllPlanMetersData
-	41,  // 20: product.CreatePlanMeterResponse.data:type_name -> product.PlanMeter
-	41,  // 21: product.UpdatePlanMeterResponse.data:type_name -> product.PlanMeter
-	53,  // 22: product.GetDiscountByIDResponse.data:type_name -> product.Discount
-	53,  // 23: product.GetDiscountByCodeResponse.data:type_name -> product.Discount
-	53,  // 24: product.CreateDiscountResponse.data:type_name -> product.Discount
-	53,  // 25: product.UpdateDiscountResponse.data:type_name -> product.Discount
-	53,  // 26: product.GetAllDiscountsData.discounts:type_name -> product.Discount
-	65,  // 27: product.GetAllDiscountsResponse.data:type_name -> product.GetAllDiscountsData
-	67,  // 28: product.GetDiscountCodeByIDResponse.data:type_name -> product.DiscountCode
-	67,  // 29: product.GetDiscountCodeByCodeResponse.data:type_name -> product.DiscountCode
-	67,  // 30: product.GetDiscountCodesByDiscountResponse.data:type_name -> product.DiscountCode
-	67,  // 31: product.CreateDiscountCodeResponse.data:type_name -> product.DiscountCode
-	78,  // 32: product.AddPlanToDiscountResponse.data:type_name -> product.DiscountPlan
-	85,  // 33: product.AddOneTimeProductToDiscountResponse.data:type_name -> product.DiscountOneTimeProduct
-	92,  // 34: product.GetDiscountPaymentProviderDataResponse.data:type_name -> product.DiscountPaymentProviderData
-	92,  // 35: product.CreateDiscountPaymentProviderDataResponse.data:type_name -> product.DiscountPaymentProviderData
-	92,  // 36: product.UpdateDiscountPaymentProviderDataResponse.data:type_name -> product.DiscountPaymentProviderData
-	101, // 37: product.RedeemDiscountCodeResponse.data:type_name -> product.DiscountCodeRedemption
-	101, // 38: product.GetRedemptionsByUserData.redemptions:type_name -> product.DiscountCodeRedemption
-	105, // 39: product.GetRedemptionsByUserResponse.data:type_name -> product.GetRedemptionsByUserData
-	101, // 40: product.GetRedemptionsByDiscountCodeData.redemptions:type_name -> product.DiscountCodeRedemption
-	108, // 41: product.GetRedemptionsByDiscountCodeResponse.data:type_name -> product.GetRedemptionsByDiscountCodeData
-	53,  // 42: product.ValidateDiscountCodeResponse.discount:type_name -> product.Discount
-	1,   // 43: product.ProductService.GetProductByID:input_type -> product.GetProductByIDRequest
-	3,   // 44: product.ProductService.GetProductBySlug:input_type -> product.GetProductBySlugRequest
-	5,   // 45: product.ProductService.CreateProduct:input_type -> product.CreateProductRequest
-	7,   // 46: product.ProductService.UpdateProduct:input_type -> product.UpdateProductRequest
-	9,   // 47: product.ProductService.DeleteProduct:input_type -> product.DeleteProductRequest
-	11,  // 48: product.ProductService.GetAllProducts:input_type -> product.GetAllProductsRequest
-	15,  // 49: product.ProductService.GetPlanByID:input_type -> product.GetPlanByIDRequest
-	17,  // 50: product.ProductService.GetPlanBySlug:input_type -> product.GetPlanBySlugRequest
-	19,  // 51: product.ProductService.GetPlansByProduct:input_type -> product.GetPlansByProductRequest
-	21,  // 52: product.ProductService.CreatePlan:input_type -> product.CreatePlanRequest
-	23,  // 53: product.ProductService.UpdatePlan:input_type -> product.UpdatePlanRequest
-	25,  // 54: product.ProductService.DeletePlan:input_type -> product.DeletePlanRequest
-	27,  // 55: product.ProductService.GetAllPlans:input_type -> product.GetAllPlansRequest
-	31,  // 56: product.ProductService.GetPlanPrice:input_type -> product.GetPlanPriceRequest
-	33,  // 57: product.ProductService.GetPlanPricesByPlan:input_type -> product.GetPlanPricesByPlanRequest
-	35,  // 58: product.ProductService.CreatePlanPrice:input_type -> product.CreatePlanPriceRequest
-	37,  // 59: product.ProductService.UpdatePlanPrice:input_type -> product.UpdatePlanPriceRequest
-	39,  // 60: product.ProductService.DeletePlanPrice:input_type -> product.DeletePlanPriceRequest
-	42,  // 61: product.ProductService.GetPlanMeterByID:input_type -> product.GetPlanMeterByIDRequest
-	44,  // 62: product.ProductService.GetAllPlanMeters:input_type -> product.GetAllPlanMetersRequest
-	47,  // 63: product.ProductService.CreatePlanMeter:input_type -> product.CreatePlanMeterRequest
-	49,  // 64: product.ProductService.UpdatePlanMeter:input_type -> product.UpdatePlanMeterRequest
-	51,  // 65: product.ProductService.DeletePlanMeter:input_type -> product.DeletePlanMeterRequest
-	54,  // 66: product.ProductService.GetDiscountByID:input_type -> product.GetDiscountByIDRequest
-	56,  // 67: product.ProductService.GetDiscountByCode:input_type -> product.GetDiscountByCodeRequest
-	58,  // 68: product.ProductService.CreateDiscount:input_type -> product.CreateDiscountRequest
-	60,  // 69: product.ProductService.UpdateDiscount:input_type -> product.UpdateDiscountRequest
-	62,  // 70: product.ProductService.DeleteDiscount:input_type -> product.DeleteDiscountRequest
-	64,  // 71: product.ProductService.GetAllDiscounts:input_type -> product.GetAllDiscountsRequest
-	68,  // 72: product.ProductService.GetDiscountCodeByID:input_type -> product.GetDiscountCodeByIDRequest
-	70,  // 73: product.ProductService.GetDiscountCodeByCode:input_type -> product.GetDiscountCodeByCodeRequest
-	72,  // 74: product.ProductService.GetDiscountCodesByDiscount:input_type -> product.GetDiscountCodesByDiscountRequest
-	74,  // 75: product.ProductService.CreateDiscountCode:input_type -> product.CreateDiscountCodeRequest
-	76,  // 76: product.ProductService.DeleteDiscountCode:input_type -> product.DeleteDiscountCodeRequest
-	79,  // 77: product.ProductService.AddPlanToDiscount:input_type -> product.AddPlanToDiscountRequest
-	81,  // 78: product.ProductService.RemovePlanFromDiscount:input_type -> product.RemovePlanFromDiscountRequest
-	83,  // 79: product.ProductService.GetPlansByDiscount:input_type -> product.GetPlansByDiscountRequest
-	86,  // 80: product.ProductService.AddOneTimeProductToDiscount:input_type -> product.AddOneTimeProductToDiscountRequest
-	88,  // 81: product.ProductService.RemoveOneTimeProductFromDiscount:input_type -> product.RemoveOneTimeProductFromDiscountRequest
-	90,  // 82: product.ProductService.GetOneTimeProductsByDiscount:input_type -> product.GetOneTimeProductsByDiscountRequest
-	93,  // 83: product.ProductService.GetDiscountPaymentProviderData:input_type -> product.GetDiscountPaymentProviderDataRequest
-	95,  // 84: product.ProductService.CreateDiscountPaymentProviderData:input_type -> product.CreateDiscountPaymentProviderDataRequest
-	97,  // 85: product.ProductService.UpdateDiscountPaymentProviderData:input_type -> product.UpdateDiscountPaymentProviderDataRequest
-	99,  // 86: product.ProductService.DeleteDiscountPaymentProviderData:input_type -> product.DeleteDiscountPaymentProviderDataRequest
-	102, // 87: product.ProductService.RedeemDiscountCode:input_type -> product.RedeemDiscountCodeRequest
-	104, // 88: product.ProductService.GetRedemptionsByUser:input_type -> product.GetRedemptionsByUserRequest
-	107, // 89: product.ProductService.GetRedemptionsByDiscountCode:input_type -> product.GetRedemptionsByDiscountCodeRequest
-	110, // 90: product.ProductService.ValidateDiscountCode:input_type -> product.ValidateDiscountCodeRequest
-	2,   // 91: product.ProductService.GetProductByID:output_type -> product.GetProductByIDResponse
-	4,   // 92: product.ProductService.GetProductBySlug:output_type -> product.GetProductBySlugResponse
-	6,   // 93: product.ProductService.CreateProduct:output_type -> product.CreateProductResponse
-	8,   // 94: product.ProductService.UpdateProduct:output_type -> product.UpdateProductResponse
-	10,  // 95: product.ProductService.DeleteProduct:output_type -> product.DeleteProductResponse
-	13,  // 96: product.ProductService.GetAllProducts:output_type -> product.GetAllProductsResponse
-	16,  // 97: product.ProductService.GetPlanByID:output_type -> product.GetPlanByIDResponse
-	18,  // 98: product.ProductService.GetPlanBySlug:output_type -> product.GetPlanBySlugResponse
-	20,  // 99: product.ProductService.GetPlansByProduct:output_type -> product.GetPlansByProductResponse
-	22,  // 100: product.ProductService.CreatePlan:output_type -> product.CreatePlanResponse
-	24,  // 101: product.ProductService.UpdatePlan:output_type -> product.UpdatePlanResponse
-	26,  // 102: product.ProductService.DeletePlan:output_type -> product.DeletePlanResponse
-	29,  // 103: product.ProductService.GetAllPlans:output_type -> product.GetAllPlansResponse
-	32,  // 104: product.ProductService.GetPlanPrice:output_type -> product.GetPlanPriceResponse
-	34,  // 105: product.ProductService.GetPlanPricesByPlan:output_type -> product.GetPlanPricesByPlanResponse
-	36,  // 106: product.ProductService.CreatePlanPrice:output_type -> product.CreatePlanPriceResponse
-	38,  // 107: product.ProductService.UpdatePlanPrice:output_type -> product.UpdatePlanPriceResponse
-	40,  // 108: product.ProductService.DeletePlanPrice:output_type -> product.DeletePlanPriceResponse
-	43,  // 109: product.ProductService.GetPlanMeterByID:output_type -> product.GetPlanMeterByIDResponse
-	46,  // 110: product.ProductService.GetAllPlanMeters:output_type -> product.GetAllPlanMetersResponse
-	48,  // 111: product.ProductService.CreatePlanMeter:output_type -> product.CreatePlanMeterResponse
-	50,  // 112: product.ProductService.UpdatePlanMeter:output_type -> product.UpdatePlanMeterResponse
-	52,  // 113: product.ProductService.DeletePlanMeter:output_type -> product.DeletePlanMeterResponse
-	55,  // 114: product.ProductService.GetDiscountByID:output_type -> product.GetDiscountByIDResponse
-	57,  // 115: product.ProductService.GetDiscountByCode:output_type -> product.GetDiscountByCodeResponse
-	59,  // 116: product.ProductService.CreateDiscount:output_type -> product.CreateDiscountResponse
-	61,  // 117: product.ProductService.UpdateDiscount:output_type -> product.UpdateDiscountResponse
-	63,  // 118: product.ProductService.DeleteDiscount:output_type -> product.DeleteDiscountResponse
-	66,  // 119: product.ProductService.GetAllDiscounts:output_type -> product.GetAllDiscountsResponse
-	69,  // 120: product.ProductService.GetDiscountCodeByID:output_type -> product.GetDiscountCodeByIDResponse
-	71,  // 121: product.ProductService.GetDiscountCodeByCode:output_type -> product.GetDiscountCodeByCodeResponse
-	73,  // 122: product.ProductService.GetDiscountCodesByDiscount:output_type -> product.GetDiscountCodesByDiscountResponse
-	75,  // 123: product.ProductService.CreateDiscountCode:output_type -> product.CreateDiscountCodeResponse
-	77,  // 124: product.ProductService.DeleteDiscountCode:output_type -> product.DeleteDiscountCodeResponse
-	80,  // 125: product.ProductService.AddPlanToDiscount:output_type -> product.AddPlanToDiscountResponse
-	82,  // 126: product.ProductService.RemovePlanFromDiscount:output_type -> product.RemovePlanFromDiscountResponse
-	84,  // 127: product.ProductService.GetPlansByDiscount:output_type -> product.GetPlansByDiscountResponse
-	87,  // 128: product.ProductService.AddOneTimeProductToDiscount:output_type -> product.AddOneTimeProductToDiscountResponse
-	89,  // 129: product.ProductService.RemoveOneTimeProductFromDiscount:output_type -> product.RemoveOneTimeProductFromDiscountResponse
-	91,  // 130: product.ProductService.GetOneTimeProductsByDiscount:output_type -> product.GetOneTimeProductsByDiscountResponse
-	94,  // 131: product.ProductService.GetDiscountPaymentProviderData:output_type -> product.GetDiscountPaymentProviderDataResponse
-	96,  // 132: product.ProductService.CreateDiscountPaymentProviderData:output_type -> product.CreateDiscountPaymentProviderDataResponse
-	98,  // 133: product.ProductService.UpdateDiscountPaymentProviderData:output_type -> product.UpdateDiscountPaymentProviderDataResponse
-	100, // 134: product.ProductService.DeleteDiscountPaymentProviderData:output_type -> product.DeleteDiscountPaymentProviderDataResponse
-	103, // 135: product.ProductService.RedeemDiscountCode:output_type -> product.RedeemDiscountCodeResponse
-	106, // 136: product.ProductService.GetRedemptionsByUser:output_type -> product.GetRedemptionsByUserResponse
-	109, // 137: product.ProductService.GetRedemptionsByDiscountCode:output_type -> product.GetRedemptionsByDiscountCodeResponse
-	111, // 138: product.ProductService.ValidateDiscountCode:output_type -> product.ValidateDiscountCodeResponse
-	91,  // [91:139] is the sub-list for method output_type
-	43,  // [43:91] is the sub-list for method input_type
-	43,  // [43:43] is the sub-list for extension type_name
-	43,  // [43:43] is the sub-list for extension extendee
-	0,   // [0:43] is the sub-list for field type_name
+	42,  // 17: product.PriceQuote.lines:type_name -> product.PriceQuoteLine
+	43,  // 18: product.QuotePriceResponse.data:type_name -> product.PriceQuote
+	45,  // 19: product.GetPlanMeterByIDResponse.data:type_name -> product.PlanMeter
+	45,  // 20: product.GetAllPlanMetersData.meters:type_name -> product.PlanMeter
+	49,  // 21: product.GetAllPlanMetersResponse.data:type_name -> product.GetAllPlanMetersData
+	45,  // 22: product.CreatePlanMeterResponse.data:type_name -> product.PlanMeter
+	45,  // 23: product.UpdatePlanMeterResponse.data:type_name -> product.PlanMeter
+	57,  // 24: product.GetDiscountByIDResponse.data:type_name -> product.Discount
+	57,  // 25: product.GetDiscountByCodeResponse.data:type_name -> product.Discount
+	57,  // 26: product.CreateDiscountResponse.data:type_name -> product.Discount
+	57,  // 27: product.UpdateDiscountResponse.data:type_name -> product.Discount
+	57,  // 28: product.GetAllDiscountsData.discounts:type_name -> product.Discount
+	69,  // 29: product.GetAllDiscountsResponse.data:type_name -> product.GetAllDiscountsData
+	71,  // 30: product.GetDiscountCodeByIDResponse.data:type_name -> product.DiscountCode
+	71,  // 31: product.GetDiscountCodeByCodeResponse.data:type_name -> product.DiscountCode
+	71,  // 32: product.GetDiscountCodesByDiscountResponse.data:type_name -> product.DiscountCode
+	71,  // 33: product.CreateDiscountCodeResponse.data:type_name -> product.DiscountCode
+	71,  // 34: product.GenerateDiscountCodesResponse.data:type_name -> product.DiscountCode
+	86,  // 35: product.AddPlanToDiscountResponse.data:type_name -> product.DiscountPlan
+	93,  // 36: product.AddOneTimeProductToDiscountResponse.data:type_name -> product.DiscountOneTimeProduct
+	100, // 37: product.GetDiscountPaymentProviderDataResponse.data:type_name -> product.DiscountPaymentProviderData
+	100, // 38: product.CreateDiscountPaymentProviderDataResponse.data:type_name -> product.DiscountPaymentProviderData
+	100, // 39: product.UpdateDiscountPaymentProviderDataResponse.data:type_name -> product.DiscountPaymentProviderData
+	109, // 40: product.RedeemDiscountCodeResponse.data:type_name -> product.DiscountCodeRedemption
+	109, // 41: product.GetRedemptionsByUserData.redemptions:type_name -> product.DiscountCodeRedemption
+	113, // 42: product.GetRedemptionsByUserResponse.data:type_name -> product.GetRedemptionsByUserData
+	109, // 43: product.GetRedemptionsByDiscountCodeData.redemptions:type_name -> product.DiscountCodeRedemption
+	116, // 44: product.GetRedemptionsByDiscountCodeResponse.data:type_name -> product.GetRedemptionsByDiscountCodeData
+	57,  // 45: product.ValidateDiscountCodeResponse.discount:type_name -> product.Discount
+	121, // 46: product.CheckoutPreview.lines:type_name -> product.CheckoutLine
+	57,  // 47: product.CheckoutPreview.discount:type_name -> product.Discount
+	71,  // 48: product.CheckoutPreview.discount_code:type_name -> product.DiscountCode
+	122, // 49: product.PreviewCheckoutResponse.data:type_name -> product.CheckoutPreview
+	71,  // 50: product.GetReferralCodeResponse.data:type_name -> product.DiscountCode
+	71,  // 51: product.ReferralStats.code:type_name -> product.DiscountCode
+	127, // 52: product.GetReferralStatsResponse.data:type_name -> product.ReferralStats
+	124, // 53: product.GetReferralsByReferrerData.referrals:type_name -> product.Referral
+	131, // 54: product.GetReferralsByReferrerResponse.data:type_name -> product.GetReferralsByReferrerData
+	133, // 55: product.SetProductPriceResponse.data:type_name -> product.ProductPrice
+	133, // 56: product.GetProductPricesResponse.data:type_name -> product.ProductPrice
+	141, // 57: product.Order.items:type_name -> product.OrderItem
+	142, // 58: product.CreateOrderRequest.items:type_name -> product.OrderItemInput
+	140, // 59: product.CreateOrderResponse.data:type_name -> product.Order
+	140, // 60: product.GetOrderByIDResponse.data:type_name -> product.Order
+	140, // 61: product.OrderList.orders:type_name -> product.Order
+	148, // 62: product.GetOrdersByUserResponse.data:type_name -> product.OrderList
+	148, // 63: product.GetAllOrdersResponse.data:type_name -> product.OrderList
+	140, // 64: product.MarkOrderPaidResponse.data:type_name -> product.Order
+	140, // 65: product.CancelOrderResponse.data:type_name -> product.Order
+	140, // 66: product.RefundOrderResponse.data:type_name -> product.Order
+	158, // 67: product.GetCurrenciesResponse.data:type_name -> product.Currency
+	158, // 68: product.GetCurrencyByIDResponse.data:type_name -> product.Currency
+	158, // 69: product.CreateCurrencyResponse.data:type_name -> product.Currency
+	158, // 70: product.UpdateCurrencyResponse.data:type_name -> product.Currency
+	169, // 71: product.GetExchangeRatesResponse.data:type_name -> product.ExchangeRate
+	169, // 72: product.SetExchangeRateResponse.data:type_name -> product.ExchangeRate
+	169, // 73: product.ImportExchangeRatesResponse.data:type_name -> product.ExchangeRate
+	30,  // 74: product.DerivePlanPricesResponse.base_price:type_name -> product.PlanPrice
+	30,  // 75: product.DerivePlanPricesResponse.derived:type_name -> product.PlanPrice
+	180, // 76: product.GetPlanEntitlementsResponse.data:type_name -> product.PlanEntitlement
+	180, // 77: product.SetPlanEntitlementResponse.data:type_name -> product.PlanEntitlement
+	1,   // 78: product.ProductService.GetProductByID:input_type -> product.GetProductByIDRequest
+	3,   // 79: product.ProductService.GetProductBySlug:input_type -> product.GetProductBySlugRequest
+	5,   // 80: product.ProductService.CreateProduct:input_type -> product.CreateProductRequest
+	7,   // 81: product.ProductService.UpdateProduct:input_type -> product.UpdateProductRequest
+	9,   // 82: product.ProductService.DeleteProduct:input_type -> product.DeleteProductRequest
+	11,  // 83: product.ProductService.GetAllProducts:input_type -> product.GetAllProductsRequest
+	15,  // 84: product.ProductService.GetPlanByID:input_type -> product.GetPlanByIDRequest
+	17,  // 85: product.ProductService.GetPlanBySlug:input_type -> product.GetPlanBySlugRequest
+	19,  // 86: product.ProductService.GetPlansByProduct:input_type -> product.GetPlansByProductRequest
+	21,  // 87: product.ProductService.CreatePlan:input_type -> product.CreatePlanRequest
+	23,  // 88: product.ProductService.UpdatePlan:input_type -> product.UpdatePlanRequest
+	25,  // 89: product.ProductService.DeletePlan:input_type -> product.DeletePlanRequest
+	27,  // 90: product.ProductService.GetAllPlans:input_type -> product.GetAllPlansRequest
+	31,  // 91: product.ProductService.GetPlanPrice:input_type -> product.GetPlanPriceRequest
+	33,  // 92: product.ProductService.GetPlanPricesByPlan:input_type -> product.GetPlanPricesByPlanRequest
+	35,  // 93: product.ProductService.CreatePlanPrice:input_type -> product.CreatePlanPriceRequest
+	37,  // 94: product.ProductService.UpdatePlanPrice:input_type -> product.UpdatePlanPriceRequest
+	39,  // 95: product.ProductService.DeletePlanPrice:input_type -> product.DeletePlanPriceRequest
+	41,  // 96: product.ProductService.QuotePrice:input_type -> product.QuotePriceRequest
+	46,  // 97: product.ProductService.GetPlanMeterByID:input_type -> product.GetPlanMeterByIDRequest
+	48,  // 98: product.ProductService.GetAllPlanMeters:input_type -> product.GetAllPlanMetersRequest
+	51,  // 99: product.ProductService.CreatePlanMeter:input_type -> product.CreatePlanMeterRequest
+	53,  // 100: product.ProductService.UpdatePlanMeter:input_type -> product.UpdatePlanMeterRequest
+	55,  // 101: product.ProductService.DeletePlanMeter:input_type -> product.DeletePlanMeterRequest
+	181, // 102: product.ProductService.GetPlanEntitlements:input_type -> product.GetPlanEntitlementsRequest
+	183, // 103: product.ProductService.SetPlanEntitlement:input_type -> product.SetPlanEntitlementRequest
+	185, // 104: product.ProductService.DeletePlanEntitlement:input_type -> product.DeletePlanEntitlementRequest
+	58,  // 105: product.ProductService.GetDiscountByID:input_type -> product.GetDiscountByIDRequest
+	60,  // 106: product.ProductService.GetDiscountByCode:input_type -> product.GetDiscountByCodeRequest
+	62,  // 107: product.ProductService.CreateDiscount:input_type -> product.CreateDiscountRequest
+	64,  // 108: product.ProductService.UpdateDiscount:input_type -> product.UpdateDiscountRequest
+	66,  // 109: product.ProductService.DeleteDiscount:input_type -> product.DeleteDiscountRequest
+	68,  // 110: product.ProductService.GetAllDiscounts:input_type -> product.GetAllDiscountsRequest
+	72,  // 111: product.ProductService.GetDiscountCodeByID:input_type -> product.GetDiscountCodeByIDRequest
+	74,  // 112: product.ProductService.GetDiscountCodeByCode:input_type -> product.GetDiscountCodeByCodeRequest
+	76,  // 113: product.ProductService.GetDiscountCodesByDiscount:input_type -> product.GetDiscountCodesByDiscountRequest
+	78,  // 114: product.ProductService.CreateDiscountCode:input_type -> product.CreateDiscountCodeRequest
+	80,  // 115: product.ProductService.DeleteDiscountCode:input_type -> product.DeleteDiscountCodeRequest
+	82,  // 116: product.ProductService.GenerateDiscountCodes:input_type -> product.GenerateDiscountCodesRequest
+	84,  // 117: product.ProductService.ExportDiscountCodes:input_type -> product.ExportDiscountCodesRequest
+	87,  // 118: product.ProductService.AddPlanToDiscount:input_type -> product.AddPlanToDiscountRequest
+	89,  // 119: product.ProductService.RemovePlanFromDiscount:input_type -> product.RemovePlanFromDiscountRequest
+	91,  // 120: product.ProductService.GetPlansByDiscount:input_type -> product.GetPlansByDiscountRequest
+	94,  // 121: product.ProductService.AddOneTimeProductToDiscount:input_type -> product.AddOneTimeProductToDiscountRequest
+	96,  // 122: product.ProductService.RemoveOneTimeProductFromDiscount:input_type -> product.RemoveOneTimeProductFromDiscountRequest
+	98,  // 123: product.ProductService.GetOneTimeProductsByDiscount:input_type -> product.GetOneTimeProductsByDiscountRequest
+	101, // 124: product.ProductService.GetDiscountPaymentProviderData:input_type -> product.GetDiscountPaymentProviderDataRequest
+	103, // 125: product.ProductService.CreateDiscountPaymentProviderData:input_type -> product.CreateDiscountPaymentProviderDataRequest
+	105, // 126: product.ProductService.UpdateDiscountPaymentProviderData:input_type -> product.UpdateDiscountPaymentProviderDataRequest
+	107, // 127: product.ProductService.DeleteDiscountPaymentProviderData:input_type -> product.DeleteDiscountPaymentProviderDataRequest
+	110, // 128: product.ProductService.RedeemDiscountCode:input_type -> product.RedeemDiscountCodeRequest
+	112, // 129: product.ProductService.GetRedemptionsByUser:input_type -> product.GetRedemptionsByUserRequest
+	115, // 130: product.ProductService.GetRedemptionsByDiscountCode:input_type -> product.GetRedemptionsByDiscountCodeRequest
+	118, // 131: product.ProductService.ValidateDiscountCode:input_type -> product.ValidateDiscountCodeRequest
+	120, // 132: product.ProductService.PreviewCheckout:input_type -> product.PreviewCheckoutRequest
+	125, // 133: product.ProductService.GetReferralCode:input_type -> product.GetReferralCodeRequest
+	128, // 134: product.ProductService.GetReferralStats:input_type -> product.GetReferralStatsRequest
+	130, // 135: product.ProductService.GetReferralsByReferrer:input_type -> product.GetReferralsByReferrerRequest
+	134, // 136: product.ProductService.SetProductPrice:input_type -> product.SetProductPriceRequest
+	136, // 137: product.ProductService.GetProductPrices:input_type -> product.GetProductPricesRequest
+	138, // 138: product.ProductService.DeleteProductPrice:input_type -> product.DeleteProductPriceRequest
+	143, // 139: product.ProductService.CreateOrder:input_type -> product.CreateOrderRequest
+	145, // 140: product.ProductService.GetOrderByID:input_type -> product.GetOrderByIDRequest
+	147, // 141: product.ProductService.GetOrdersByUser:input_type -> product.GetOrdersByUserRequest
+	150, // 142: product.ProductService.GetAllOrders:input_type -> product.GetAllOrdersRequest
+	152, // 143: product.ProductService.MarkOrderPaid:input_type -> product.MarkOrderPaidRequest
+	154, // 144: product.ProductService.CancelOrder:input_type -> product.CancelOrderRequest
+	156, // 145: product.ProductService.RefundOrder:input_type -> product.RefundOrderRequest
+	159, // 146: product.ProductService.GetCurrencies:input_type -> product.GetCurrenciesRequest
+	161, // 147: product.ProductService.GetCurrencyByID:input_type -> product.GetCurrencyByIDRequest
+	163, // 148: product.ProductService.CreateCurrency:input_type -> product.CreateCurrencyRequest
+	165, // 149: product.ProductService.UpdateCurrency:input_type -> product.UpdateCurrencyRequest
+	167, // 150: product.ProductService.DeleteCurrency:input_type -> product.DeleteCurrencyRequest
+	170, // 151: product.ProductService.GetExchangeRates:input_type -> product.GetExchangeRatesRequest
+	172, // 152: product.ProductService.SetExchangeRate:input_type -> product.SetExchangeRateRequest
+	174, // 153: product.ProductService.ImportExchangeRates:input_type -> product.ImportExchangeRatesRequest
+	176, // 154: product.ProductService.DeleteExchangeRate:input_type -> product.DeleteExchangeRateRequest
+	178, // 155: product.ProductService.DerivePlanPrices:input_type -> product.DerivePlanPricesRequest
+	2,   // 156: product.ProductService.GetProductByID:output_type -> product.GetProductByIDResponse
+	4,   // 157: product.ProductService.GetProductBySlug:output_type -> product.GetProductBySlugResponse
+	6,   // 158: product.ProductService.CreateProduct:output_type -> product.CreateProductResponse
+	8,   // 159: product.ProductService.UpdateProduct:output_type -> product.UpdateProductResponse
+	10,  // 160: product.ProductService.DeleteProduct:output_type -> product.DeleteProductResponse
+	13,  // 161: product.ProductService.GetAllProducts:output_type -> product.GetAllProductsResponse
+	16,  // 162: product.ProductService.GetPlanByID:output_type -> product.GetPlanByIDResponse
+	18,  // 163: product.ProductService.GetPlanBySlug:output_type -> product.GetPlanBySlugResponse
+	20,  // 164: product.ProductService.GetPlansByProduct:output_type -> product.GetPlansByProductResponse
+	22,  // 165: product.ProductService.CreatePlan:output_type -> product.CreatePlanResponse
+	24,  // 166: product.ProductService.UpdatePlan:output_type -> product.UpdatePlanResponse
+	26,  // 167: product.ProductService.DeletePlan:output_type -> product.DeletePlanResponse
+	29,  // 168: product.ProductService.GetAllPlans:output_type -> product.GetAllPlansResponse
+	32,  // 169: product.ProductService.GetPlanPrice:output_type -> product.GetPlanPriceResponse
+	34,  // 170: product.ProductService.GetPlanPricesByPlan:output_type -> product.GetPlanPricesByPlanResponse
+	36,  // 171: product.ProductService.CreatePlanPrice:output_type -> product.CreatePlanPriceResponse
+	38,  // 172: product.ProductService.UpdatePlanPrice:output_type -> product.UpdatePlanPriceResponse
+	40,  // 173: product.ProductService.DeletePlanPrice:output_type -> product.DeletePlanPriceResponse
+	44,  // 174: product.ProductService.QuotePrice:output_type -> product.QuotePriceResponse
+	47,  // 175: product.ProductService.GetPlanMeterByID:output_type -> product.GetPlanMeterByIDResponse
+	50,  // 176: product.ProductService.GetAllPlanMeters:output_type -> product.GetAllPlanMetersResponse
+	52,  // 177: product.ProductService.CreatePlanMeter:output_type -> product.CreatePlanMeterResponse
+	54,  // 178: product.ProductService.UpdatePlanMeter:output_type -> product.UpdatePlanMeterResponse
+	56,  // 179: product.ProductService.DeletePlanMeter:output_type -> product.DeletePlanMeterResponse
+	182, // 180: product.ProductService.GetPlanEntitlements:output_type -> product.GetPlanEntitlementsResponse
+	184, // 181: product.ProductService.SetPlanEntitlement:output_type -> product.SetPlanEntitlementResponse
+	186, // 182: product.ProductService.DeletePlanEntitlement:output_type -> product.DeletePlanEntitlementResponse
+	59,  // 183: product.ProductService.GetDiscountByID:output_type -> product.GetDiscountByIDResponse
+	61,  // 184: product.ProductService.GetDiscountByCode:output_type -> product.GetDiscountByCodeResponse
+	63,  // 185: product.ProductService.CreateDiscount:output_type -> product.CreateDiscountResponse
+	65,  // 186: product.ProductService.UpdateDiscount:output_type -> product.UpdateDiscountResponse
+	67,  // 187: product.ProductService.DeleteDiscount:output_type -> product.DeleteDiscountResponse
+	70,  // 188: product.ProductService.GetAllDiscounts:output_type -> product.GetAllDiscountsResponse
+	73,  // 189: product.ProductService.GetDiscountCodeByID:output_type -> product.GetDiscountCodeByIDResponse
+	75,  // 190: product.ProductService.GetDiscountCodeByCode:output_type -> product.GetDiscountCodeByCodeResponse
+	77,  // 191: product.ProductService.GetDiscountCodesByDiscount:output_type -> product.GetDiscountCodesByDiscountResponse
+	79,  // 192: product.ProductService.CreateDiscountCode:output_type -> product.CreateDiscountCodeResponse
+	81,  // 193: product.ProductService.DeleteDiscountCode:output_type -> product.DeleteDiscountCodeResponse
+	83,  // 194: product.ProductService.GenerateDiscountCodes:output_type -> product.GenerateDiscountCodesResponse
+	85,  // 195: product.ProductService.ExportDiscountCodes:output_type -> product.ExportDiscountCodesResponse
+	88,  // 196: product.ProductService.AddPlanToDiscount:output_type -> product.AddPlanToDiscountResponse
+	90,  // 197: product.ProductService.RemovePlanFromDiscount:output_type -> product.RemovePlanFromDiscountResponse
+	92,  // 198: product.ProductService.GetPlansByDiscount:output_type -> product.GetPlansByDiscountResponse
+	95,  // 199: product.ProductService.AddOneTimeProductToDiscount:output_type -> product.AddOneTimeProductToDiscountResponse
+	97,  // 200: product.ProductService.RemoveOneTimeProductFromDiscount:output_type -> product.RemoveOneTimeProductFromDiscountResponse
+	99,  // 201: product.ProductService.GetOneTimeProductsByDiscount:output_type -> product.GetOneTimeProductsByDiscountResponse
+	102, // 202: product.ProductService.GetDiscountPaymentProviderData:output_type -> product.GetDiscountPaymentProviderDataResponse
+	104, // 203: product.ProductService.CreateDiscountPaymentProviderData:output_type -> product.CreateDiscountPaymentProviderDataResponse
+	106, // 204: product.ProductService.UpdateDiscountPaymentProviderData:output_type -> product.UpdateDiscountPaymentProviderDataResponse
+	108, // 205: product.ProductService.DeleteDiscountPaymentProviderData:output_type -> product.DeleteDiscountPaymentProviderDataResponse
+	111, // 206: product.ProductService.RedeemDiscountCode:output_type -> product.RedeemDiscountCodeResponse
+	114, // 207: product.ProductService.GetRedemptionsByUser:output_type -> product.GetRedemptionsByUserResponse
+	117, // 208: product.ProductService.GetRedemptionsByDiscountCode:output_type -> product.GetRedemptionsByDiscountCodeResponse
+	119, // 209: product.ProductService.ValidateDiscountCode:output_type -> product.ValidateDiscountCodeResponse
+	123, // 210: product.ProductService.PreviewCheckout:output_type -> product.PreviewCheckoutResponse
+	126, // 211: product.ProductService.GetReferralCode:output_type -> product.GetReferralCodeResponse
+	129, // 212: product.ProductService.GetReferralStats:output_type -> product.GetReferralStatsResponse
+	132, // 213: product.ProductService.GetReferralsByReferrer:output_type -> product.GetReferralsByReferrerResponse
+	135, // 214: product.ProductService.SetProductPrice:output_type -> product.SetProductPriceResponse
+	137, // 215: product.ProductService.GetProductPrices:output_type -> product.GetProductPricesResponse
+	139, // 216: product.ProductService.DeleteProductPrice:output_type -> product.DeleteProductPriceResponse
+	144, // 217: product.ProductService.CreateOrder:output_type -> product.CreateOrderResponse
+	146, // 218: product.ProductService.GetOrderByID:output_type -> product.GetOrderByIDResponse
+	149, // 219: product.ProductService.GetOrdersByUser:output_type -> product.GetOrdersByUserResponse
+	151, // 220: product.ProductService.GetAllOrders:output_type -> product.GetAllOrdersResponse
+	153, // 221: product.ProductService.MarkOrderPaid:output_type -> product.MarkOrderPaidResponse
+	155, // 222: product.ProductService.CancelOrder:output_type -> product.CancelOrderResponse
+	157, // 223: product.ProductService.RefundOrder:output_type -> product.RefundOrderResponse
+	160, // 224: product.ProductService.GetCurrencies:output_type -> product.GetCurrenciesResponse
+	162, // 225: product.ProductService.GetCurrencyByID:output_type -> product.GetCurrencyByIDResponse
+	164, // 226: product.ProductService.CreateCurrency:output_type -> product.CreateCurrencyResponse
+	166, // 227: product.ProductService.UpdateCurrency:output_type -> product.UpdateCurrencyResponse
+	168, // 228: product.ProductService.DeleteCurrency:output_type -> product.DeleteCurrencyResponse
+	171, // 229: product.ProductService.GetExchangeRates:output_type -> product.GetExchangeRatesResponse
+	173, // 230: product.ProductService.SetExchangeRate:output_type -> product.SetExchangeRateResponse
+	175, // 231: product.ProductService.ImportExchangeRates:output_type -> product.ImportExchangeRatesResponse
+	177, // 232: product.ProductService.DeleteExchangeRate:output_type -> product.DeleteExchangeRateResponse
+	179, // 233: product.ProductService.DerivePlanPrices:output_type -> product.DerivePlanPricesResponse
+	156, // [156:234] is the sub-list for method output_type
+	78,  // [78:156] is the sub-list for method input_type
+	78,  // [78:78] is the sub-list for extension type_name
+	78,  // [78:78] is the sub-list for extension extendee
+	0,   // [0:78] is the sub-list for field type_name
 }
 
 func init() { file_product_proto_init() }
@@ -8369,16 +14045,21 @@ func file_product_proto_init() {
 	file_product_proto_msgTypes[14].OneofWrappers = []any{}
 	file_product_proto_msgTypes[21].OneofWrappers = []any{}
 	file_product_proto_msgTypes[23].OneofWrappers = []any{}
-	file_product_proto_msgTypes[53].OneofWrappers = []any{}
-	file_product_proto_msgTypes[58].OneofWrappers = []any{}
-	file_product_proto_msgTypes[60].OneofWrappers = []any{}
+	file_product_proto_msgTypes[57].OneofWrappers = []any{}
+	file_product_proto_msgTypes[62].OneofWrappers = []any{}
+	file_product_proto_msgTypes[64].OneofWrappers = []any{}
+	file_product_proto_msgTypes[82].OneofWrappers = []any{}
+	file_product_proto_msgTypes[163].OneofWrappers = []any{}
+	file_product_proto_msgTypes[165].OneofWrappers = []any{}
+	file_product_proto_msgTypes[180].OneofWrappers = []any{}
+	file_product_proto_msgTypes[183].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_product_proto_rawDesc), len(file_product_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   112,
+			NumMessages:   187,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
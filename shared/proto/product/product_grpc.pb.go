@@ -37,11 +37,15 @@ const (
 	ProductService_CreatePlanPrice_FullMethodName                   = "/product.ProductService/CreatePlanPrice"
 	ProductService_UpdatePlanPrice_FullMethodName                   = "/product.ProductService/UpdatePlanPrice"
 	ProductService_DeletePlanPrice_FullMethodName                   = "/product.ProductService/DeletePlanPrice"
+	ProductService_QuotePrice_FullMethodName                        = "/product.ProductService/QuotePrice"
 	ProductService_GetPlanMeterByID_FullMethodName                  = "/product.ProductService/GetPlanMeterByID"
 	ProductService_GetAllPlanMeters_FullMethodName                  = "/product.ProductService/GetAllPlanMeters"
 	ProductService_CreatePlanMeter_FullMethodName                   = "/product.ProductService/CreatePlanMeter"
 	ProductService_UpdatePlanMeter_FullMethodName                   = "/product.ProductService/UpdatePlanMeter"
 	ProductService_DeletePlanMeter_FullMethodName                   = "/product.ProductService/DeletePlanMeter"
+	ProductService_GetPlanEntitlements_FullMethodName               = "/product.ProductService/GetPlanEntitlements"
+	ProductService_SetPlanEntitlement_FullMethodName                = "/product.ProductService/SetPlanEntitlement"
+	ProductService_DeletePlanEntitlement_FullMethodName             = "/product.ProductService/DeletePlanEntitlement"
 	ProductService_GetDiscountByID_FullMethodName                   = "/product.ProductService/GetDiscountByID"
 	ProductService_GetDiscountByCode_FullMethodName                 = "/product.ProductService/GetDiscountByCode"
 	ProductService_CreateDiscount_FullMethodName                    = "/product.ProductService/CreateDiscount"
@@ -53,6 +57,8 @@ const (
 	ProductService_GetDiscountCodesByDiscount_FullMethodName        = "/product.ProductService/GetDiscountCodesByDiscount"
 	ProductService_CreateDiscountCode_FullMethodName                = "/product.ProductService/CreateDiscountCode"
 	ProductService_DeleteDiscountCode_FullMethodName                = "/product.ProductService/DeleteDiscountCode"
+	ProductService_GenerateDiscountCodes_FullMethodName             = "/product.ProductService/GenerateDiscountCodes"
+	ProductService_ExportDiscountCodes_FullMethodName               = "/product.ProductService/ExportDiscountCodes"
 	ProductService_AddPlanToDiscount_FullMethodName                 = "/product.ProductService/AddPlanToDiscount"
 	ProductService_RemovePlanFromDiscount_FullMethodName            = "/product.ProductService/RemovePlanFromDiscount"
 	ProductService_GetPlansByDiscount_FullMethodName                = "/product.ProductService/GetPlansByDiscount"
@@ -67,6 +73,30 @@ const (
 	ProductService_GetRedemptionsByUser_FullMethodName              = "/product.ProductService/GetRedemptionsByUser"
 	ProductService_GetRedemptionsByDiscountCode_FullMethodName      = "/product.ProductService/GetRedemptionsByDiscountCode"
 	ProductService_ValidateDiscountCode_FullMethodName              = "/product.ProductService/ValidateDiscountCode"
+	ProductService_PreviewCheckout_FullMethodName                   = "/product.ProductService/PreviewCheckout"
+	ProductService_GetReferralCode_FullMethodName                   = "/product.ProductService/GetReferralCode"
+	ProductService_GetReferralStats_FullMethodName                  = "/product.ProductService/GetReferralStats"
+	ProductService_GetReferralsByReferrer_FullMethodName            = "/product.ProductService/GetReferralsByReferrer"
+	ProductService_SetProductPrice_FullMethodName                   = "/product.ProductService/SetProductPrice"
+	ProductService_GetProductPrices_FullMethodName                  = "/product.ProductService/GetProductPrices"
+	ProductService_DeleteProductPrice_FullMethodName                = "/product.ProductService/DeleteProductPrice"
+	ProductService_CreateOrder_FullMethodName                       = "/product.ProductService/CreateOrder"
+	ProductService_GetOrderByID_FullMethodName                      = "/product.ProductService/GetOrderByID"
+	ProductService_GetOrdersByUser_FullMethodName                   = "/product.ProductService/GetOrdersByUser"
+	ProductService_GetAllOrders_FullMethodName                      = "/product.ProductService/GetAllOrders"
+	ProductService_MarkOrderPaid_FullMethodName                     = "/product.ProductService/MarkOrderPaid"
+	ProductService_CancelOrder_FullMethodName                       = "/product.ProductService/CancelOrder"
+	ProductService_RefundOrder_FullMethodName                       = "/product.ProductService/RefundOrder"
+	ProductService_GetCurrencies_FullMethodName                     = "/product.ProductService/GetCurrencies"
+	ProductService_GetCurrencyByID_FullMethodName                   = "/product.ProductService/GetCurrencyByID"
+	ProductService_CreateCurrency_FullMethodName                    = "/product.ProductService/CreateCurrency"
+	ProductService_UpdateCurrency_FullMethodName                    = "/product.ProductService/UpdateCurrency"
+	ProductService_DeleteCurrency_FullMethodName                    = "/product.ProductService/DeleteCurrency"
+	ProductService_GetExchangeRates_FullMethodName                  = "/product.ProductService/GetExchangeRates"
+	ProductService_SetExchangeRate_FullMethodName                   = "/product.ProductService/SetExchangeRate"
+	ProductService_ImportExchangeRates_FullMethodName               = "/product.ProductService/ImportExchangeRates"
+	ProductService_DeleteExchangeRate_FullMethodName                = "/product.ProductService/DeleteExchangeRate"
+	ProductService_DerivePlanPrices_FullMethodName                  = "/product.ProductService/DerivePlanPrices"
 )
 
 // ProductServiceClient is the client API for ProductService service.
@@ -94,12 +124,17 @@ type ProductServiceClient interface {
 	CreatePlanPrice(ctx context.Context, in *CreatePlanPriceRequest, opts ...grpc.CallOption) (*CreatePlanPriceResponse, error)
 	UpdatePlanPrice(ctx context.Context, in *UpdatePlanPriceRequest, opts ...grpc.CallOption) (*UpdatePlanPriceResponse, error)
 	DeletePlanPrice(ctx context.Context, in *DeletePlanPriceRequest, opts ...grpc.CallOption) (*DeletePlanPriceResponse, error)
+	QuotePrice(ctx context.Context, in *QuotePriceRequest, opts ...grpc.CallOption) (*QuotePriceResponse, error)
 	// Plan Meter operations
 	GetPlanMeterByID(ctx context.Context, in *GetPlanMeterByIDRequest, opts ...grpc.CallOption) (*GetPlanMeterByIDResponse, error)
 	GetAllPlanMeters(ctx context.Context, in *GetAllPlanMetersRequest, opts ...grpc.CallOption) (*GetAllPlanMetersResponse, error)
 	CreatePlanMeter(ctx context.Context, in *CreatePlanMeterRequest, opts ...grpc.CallOption) (*CreatePlanMeterResponse, error)
 	UpdatePlanMeter(ctx context.Context, in *UpdatePlanMeterRequest, opts ...grpc.CallOption) (*UpdatePlanMeterResponse, error)
 	DeletePlanMeter(ctx context.Context, in *DeletePlanMeterRequest, opts ...grpc.CallOption) (*DeletePlanMeterResponse, error)
+	// Plan Entitlement operations
+	GetPlanEntitlements(ctx context.Context, in *GetPlanEntitlementsRequest, opts ...grpc.CallOption) (*GetPlanEntitlementsResponse, error)
+	SetPlanEntitlement(ctx context.Context, in *SetPlanEntitlementRequest, opts ...grpc.CallOption) (*SetPlanEntitlementResponse, error)
+	DeletePlanEntitlement(ctx context.Context, in *DeletePlanEntitlementRequest, opts ...grpc.CallOption) (*DeletePlanEntitlementResponse, error)
 	// Discount operations
 	GetDiscountByID(ctx context.Context, in *GetDiscountByIDRequest, opts ...grpc.CallOption) (*GetDiscountByIDResponse, error)
 	GetDiscountByCode(ctx context.Context, in *GetDiscountByCodeRequest, opts ...grpc.CallOption) (*GetDiscountByCodeResponse, error)
@@ -113,6 +148,8 @@ type ProductServiceClient interface {
 	GetDiscountCodesByDiscount(ctx context.Context, in *GetDiscountCodesByDiscountRequest, opts ...grpc.CallOption) (*GetDiscountCodesByDiscountResponse, error)
 	CreateDiscountCode(ctx context.Context, in *CreateDiscountCodeRequest, opts ...grpc.CallOption) (*CreateDiscountCodeResponse, error)
 	DeleteDiscountCode(ctx context.Context, in *DeleteDiscountCodeRequest, opts ...grpc.CallOption) (*DeleteDiscountCodeResponse, error)
+	GenerateDiscountCodes(ctx context.Context, in *GenerateDiscountCodesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GenerateDiscountCodesResponse], error)
+	ExportDiscountCodes(ctx context.Context, in *ExportDiscountCodesRequest, opts ...grpc.CallOption) (*ExportDiscountCodesResponse, error)
 	// Discount Plan operations (junction table)
 	AddPlanToDiscount(ctx context.Context, in *AddPlanToDiscountRequest, opts ...grpc.CallOption) (*AddPlanToDiscountResponse, error)
 	RemovePlanFromDiscount(ctx context.Context, in *RemovePlanFromDiscountRequest, opts ...grpc.CallOption) (*RemovePlanFromDiscountResponse, error)
@@ -132,6 +169,36 @@ type ProductServiceClient interface {
 	GetRedemptionsByDiscountCode(ctx context.Context, in *GetRedemptionsByDiscountCodeRequest, opts ...grpc.CallOption) (*GetRedemptionsByDiscountCodeResponse, error)
 	// Validation operations
 	ValidateDiscountCode(ctx context.Context, in *ValidateDiscountCodeRequest, opts ...grpc.CallOption) (*ValidateDiscountCodeResponse, error)
+	// Checkout operations
+	PreviewCheckout(ctx context.Context, in *PreviewCheckoutRequest, opts ...grpc.CallOption) (*PreviewCheckoutResponse, error)
+	// Referral operations
+	GetReferralCode(ctx context.Context, in *GetReferralCodeRequest, opts ...grpc.CallOption) (*GetReferralCodeResponse, error)
+	GetReferralStats(ctx context.Context, in *GetReferralStatsRequest, opts ...grpc.CallOption) (*GetReferralStatsResponse, error)
+	GetReferralsByReferrer(ctx context.Context, in *GetReferralsByReferrerRequest, opts ...grpc.CallOption) (*GetReferralsByReferrerResponse, error)
+	// Product price operations
+	SetProductPrice(ctx context.Context, in *SetProductPriceRequest, opts ...grpc.CallOption) (*SetProductPriceResponse, error)
+	GetProductPrices(ctx context.Context, in *GetProductPricesRequest, opts ...grpc.CallOption) (*GetProductPricesResponse, error)
+	DeleteProductPrice(ctx context.Context, in *DeleteProductPriceRequest, opts ...grpc.CallOption) (*DeleteProductPriceResponse, error)
+	// Order operations
+	CreateOrder(ctx context.Context, in *CreateOrderRequest, opts ...grpc.CallOption) (*CreateOrderResponse, error)
+	GetOrderByID(ctx context.Context, in *GetOrderByIDRequest, opts ...grpc.CallOption) (*GetOrderByIDResponse, error)
+	GetOrdersByUser(ctx context.Context, in *GetOrdersByUserRequest, opts ...grpc.CallOption) (*GetOrdersByUserResponse, error)
+	GetAllOrders(ctx context.Context, in *GetAllOrdersRequest, opts ...grpc.CallOption) (*GetAllOrdersResponse, error)
+	MarkOrderPaid(ctx context.Context, in *MarkOrderPaidRequest, opts ...grpc.CallOption) (*MarkOrderPaidResponse, error)
+	CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*CancelOrderResponse, error)
+	RefundOrder(ctx context.Context, in *RefundOrderRequest, opts ...grpc.CallOption) (*RefundOrderResponse, error)
+	// Currency operations
+	GetCurrencies(ctx context.Context, in *GetCurrenciesRequest, opts ...grpc.CallOption) (*GetCurrenciesResponse, error)
+	GetCurrencyByID(ctx context.Context, in *GetCurrencyByIDRequest, opts ...grpc.CallOption) (*GetCurrencyByIDResponse, error)
+	CreateCurrency(ctx context.Context, in *CreateCurrencyRequest, opts ...grpc.CallOption) (*CreateCurrencyResponse, error)
+	UpdateCurrency(ctx context.Context, in *UpdateCurrencyRequest, opts ...grpc.CallOption) (*UpdateCurrencyResponse, error)
+	DeleteCurrency(ctx context.Context, in *DeleteCurrencyRequest, opts ...grpc.CallOption) (*DeleteCurrencyResponse, error)
+	// Exchange rate operations
+	GetExchangeRates(ctx context.Context, in *GetExchangeRatesRequest, opts ...grpc.CallOption) (*GetExchangeRatesResponse, error)
+	SetExchangeRate(ctx context.Context, in *SetExchangeRateRequest, opts ...grpc.CallOption) (*SetExchangeRateResponse, error)
+	ImportExchangeRates(ctx context.Context, in *ImportExchangeRatesRequest, opts ...grpc.CallOption) (*ImportExchangeRatesResponse, error)
+	DeleteExchangeRate(ctx context.Context, in *DeleteExchangeRateRequest, opts ...grpc.CallOption) (*DeleteExchangeRateResponse, error)
+	DerivePlanPrices(ctx context.Context, in *DerivePlanPricesRequest, opts ...grpc.CallOption) (*DerivePlanPricesResponse, error)
 }
 
 type productServiceClient struct {
@@ -322,6 +389,16 @@ func (c *productServiceClient) DeletePlanPrice(ctx context.Context, in *DeletePl
 	return out, nil
 }
 
+func (c *productServiceClient) QuotePrice(ctx context.Context, in *QuotePriceRequest, opts ...grpc.CallOption) (*QuotePriceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuotePriceResponse)
+	err := c.cc.Invoke(ctx, ProductService_QuotePrice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *productServiceClient) GetPlanMeterByID(ctx context.Context, in *GetPlanMeterByIDRequest, opts ...grpc.CallOption) (*GetPlanMeterByIDResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetPlanMeterByIDResponse)
@@ -372,6 +449,36 @@ func (c *productServiceClient) DeletePlanMeter(ctx context.Context, in *DeletePl
 	return out, nil
 }
 
+func (c *productServiceClient) GetPlanEntitlements(ctx context.Context, in *GetPlanEntitlementsRequest, opts ...grpc.CallOption) (*GetPlanEntitlementsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPlanEntitlementsResponse)
+	err := c.cc.Invoke(ctx, ProductService_GetPlanEntitlements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SetPlanEntitlement(ctx context.Context, in *SetPlanEntitlementRequest, opts ...grpc.CallOption) (*SetPlanEntitlementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetPlanEntitlementResponse)
+	err := c.cc.Invoke(ctx, ProductService_SetPlanEntitlement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) DeletePlanEntitlement(ctx context.Context, in *DeletePlanEntitlementRequest, opts ...grpc.CallOption) (*DeletePlanEntitlementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePlanEntitlementResponse)
+	err := c.cc.Invoke(ctx, ProductService_DeletePlanEntitlement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *productServiceClient) GetDiscountByID(ctx context.Context, in *GetDiscountByIDRequest, opts ...grpc.CallOption) (*GetDiscountByIDResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetDiscountByIDResponse)
@@ -482,6 +589,35 @@ func (c *productServiceClient) DeleteDiscountCode(ctx context.Context, in *Delet
 	return out, nil
 }
 
+func (c *productServiceClient) GenerateDiscountCodes(ctx context.Context, in *GenerateDiscountCodesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GenerateDiscountCodesResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ProductService_ServiceDesc.Streams[0], ProductService_GenerateDiscountCodes_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GenerateDiscountCodesRequest, GenerateDiscountCodesResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ProductService_GenerateDiscountCodesClient = grpc.ServerStreamingClient[GenerateDiscountCodesResponse]
+
+func (c *productServiceClient) ExportDiscountCodes(ctx context.Context, in *ExportDiscountCodesRequest, opts ...grpc.CallOption) (*ExportDiscountCodesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportDiscountCodesResponse)
+	err := c.cc.Invoke(ctx, ProductService_ExportDiscountCodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *productServiceClient) AddPlanToDiscount(ctx context.Context, in *AddPlanToDiscountRequest, opts ...grpc.CallOption) (*AddPlanToDiscountResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AddPlanToDiscountResponse)
@@ -622,6 +758,246 @@ func (c *productServiceClient) ValidateDiscountCode(ctx context.Context, in *Val
 	return out, nil
 }
 
+func (c *productServiceClient) PreviewCheckout(ctx context.Context, in *PreviewCheckoutRequest, opts ...grpc.CallOption) (*PreviewCheckoutResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreviewCheckoutResponse)
+	err := c.cc.Invoke(ctx, ProductService_PreviewCheckout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetReferralCode(ctx context.Context, in *GetReferralCodeRequest, opts ...grpc.CallOption) (*GetReferralCodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReferralCodeResponse)
+	err := c.cc.Invoke(ctx, ProductService_GetReferralCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetReferralStats(ctx context.Context, in *GetReferralStatsRequest, opts ...grpc.CallOption) (*GetReferralStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReferralStatsResponse)
+	err := c.cc.Invoke(ctx, ProductService_GetReferralStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetReferralsByReferrer(ctx context.Context, in *GetReferralsByReferrerRequest, opts ...grpc.CallOption) (*GetReferralsByReferrerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReferralsByReferrerResponse)
+	err := c.cc.Invoke(ctx, ProductService_GetReferralsByReferrer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SetProductPrice(ctx context.Context, in *SetProductPriceRequest, opts ...grpc.CallOption) (*SetProductPriceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetProductPriceResponse)
+	err := c.cc.Invoke(ctx, ProductService_SetProductPrice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetProductPrices(ctx context.Context, in *GetProductPricesRequest, opts ...grpc.CallOption) (*GetProductPricesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProductPricesResponse)
+	err := c.cc.Invoke(ctx, ProductService_GetProductPrices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) DeleteProductPrice(ctx context.Context, in *DeleteProductPriceRequest, opts ...grpc.CallOption) (*DeleteProductPriceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteProductPriceResponse)
+	err := c.cc.Invoke(ctx, ProductService_DeleteProductPrice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) CreateOrder(ctx context.Context, in *CreateOrderRequest, opts ...grpc.CallOption) (*CreateOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateOrderResponse)
+	err := c.cc.Invoke(ctx, ProductService_CreateOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetOrderByID(ctx context.Context, in *GetOrderByIDRequest, opts ...grpc.CallOption) (*GetOrderByIDResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOrderByIDResponse)
+	err := c.cc.Invoke(ctx, ProductService_GetOrderByID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetOrdersByUser(ctx context.Context, in *GetOrdersByUserRequest, opts ...grpc.CallOption) (*GetOrdersByUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOrdersByUserResponse)
+	err := c.cc.Invoke(ctx, ProductService_GetOrdersByUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetAllOrders(ctx context.Context, in *GetAllOrdersRequest, opts ...grpc.CallOption) (*GetAllOrdersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAllOrdersResponse)
+	err := c.cc.Invoke(ctx, ProductService_GetAllOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) MarkOrderPaid(ctx context.Context, in *MarkOrderPaidRequest, opts ...grpc.CallOption) (*MarkOrderPaidResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarkOrderPaidResponse)
+	err := c.cc.Invoke(ctx, ProductService_MarkOrderPaid_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*CancelOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelOrderResponse)
+	err := c.cc.Invoke(ctx, ProductService_CancelOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) RefundOrder(ctx context.Context, in *RefundOrderRequest, opts ...grpc.CallOption) (*RefundOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefundOrderResponse)
+	err := c.cc.Invoke(ctx, ProductService_RefundOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetCurrencies(ctx context.Context, in *GetCurrenciesRequest, opts ...grpc.CallOption) (*GetCurrenciesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCurrenciesResponse)
+	err := c.cc.Invoke(ctx, ProductService_GetCurrencies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetCurrencyByID(ctx context.Context, in *GetCurrencyByIDRequest, opts ...grpc.CallOption) (*GetCurrencyByIDResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCurrencyByIDResponse)
+	err := c.cc.Invoke(ctx, ProductService_GetCurrencyByID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) CreateCurrency(ctx context.Context, in *CreateCurrencyRequest, opts ...grpc.CallOption) (*CreateCurrencyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCurrencyResponse)
+	err := c.cc.Invoke(ctx, ProductService_CreateCurrency_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) UpdateCurrency(ctx context.Context, in *UpdateCurrencyRequest, opts ...grpc.CallOption) (*UpdateCurrencyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCurrencyResponse)
+	err := c.cc.Invoke(ctx, ProductService_UpdateCurrency_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) DeleteCurrency(ctx context.Context, in *DeleteCurrencyRequest, opts ...grpc.CallOption) (*DeleteCurrencyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCurrencyResponse)
+	err := c.cc.Invoke(ctx, ProductService_DeleteCurrency_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetExchangeRates(ctx context.Context, in *GetExchangeRatesRequest, opts ...grpc.CallOption) (*GetExchangeRatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, ProductService_GetExchangeRates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SetExchangeRate(ctx context.Context, in *SetExchangeRateRequest, opts ...grpc.CallOption) (*SetExchangeRateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetExchangeRateResponse)
+	err := c.cc.Invoke(ctx, ProductService_SetExchangeRate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ImportExchangeRates(ctx context.Context, in *ImportExchangeRatesRequest, opts ...grpc.CallOption) (*ImportExchangeRatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, ProductService_ImportExchangeRates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) DeleteExchangeRate(ctx context.Context, in *DeleteExchangeRateRequest, opts ...grpc.CallOption) (*DeleteExchangeRateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteExchangeRateResponse)
+	err := c.cc.Invoke(ctx, ProductService_DeleteExchangeRate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) DerivePlanPrices(ctx context.Context, in *DerivePlanPricesRequest, opts ...grpc.CallOption) (*DerivePlanPricesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DerivePlanPricesResponse)
+	err := c.cc.Invoke(ctx, ProductService_DerivePlanPrices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProductServiceServer is the server API for ProductService service.
 // All implementations must embed UnimplementedProductServiceServer
 // for forward compatibility.
@@ -647,12 +1023,17 @@ type ProductServiceServer interface {
 	CreatePlanPrice(context.Context, *CreatePlanPriceRequest) (*CreatePlanPriceResponse, error)
 	UpdatePlanPrice(context.Context, *UpdatePlanPriceRequest) (*UpdatePlanPriceResponse, error)
 	DeletePlanPrice(context.Context, *DeletePlanPriceRequest) (*DeletePlanPriceResponse, error)
+	QuotePrice(context.Context, *QuotePriceRequest) (*QuotePriceResponse, error)
 	// Plan Meter operations
 	GetPlanMeterByID(context.Context, *GetPlanMeterByIDRequest) (*GetPlanMeterByIDResponse, error)
 	GetAllPlanMeters(context.Context, *GetAllPlanMetersRequest) (*GetAllPlanMetersResponse, error)
 	CreatePlanMeter(context.Context, *CreatePlanMeterRequest) (*CreatePlanMeterResponse, error)
 	UpdatePlanMeter(context.Context, *UpdatePlanMeterRequest) (*UpdatePlanMeterResponse, error)
 	DeletePlanMeter(context.Context, *DeletePlanMeterRequest) (*DeletePlanMeterResponse, error)
+	// Plan Entitlement operations
+	GetPlanEntitlements(context.Context, *GetPlanEntitlementsRequest) (*GetPlanEntitlementsResponse, error)
+	SetPlanEntitlement(context.Context, *SetPlanEntitlementRequest) (*SetPlanEntitlementResponse, error)
+	DeletePlanEntitlement(context.Context, *DeletePlanEntitlementRequest) (*DeletePlanEntitlementResponse, error)
 	// Discount operations
 	GetDiscountByID(context.Context, *GetDiscountByIDRequest) (*GetDiscountByIDResponse, error)
 	GetDiscountByCode(context.Context, *GetDiscountByCodeRequest) (*GetDiscountByCodeResponse, error)
@@ -666,6 +1047,8 @@ type ProductServiceServer interface {
 	GetDiscountCodesByDiscount(context.Context, *GetDiscountCodesByDiscountRequest) (*GetDiscountCodesByDiscountResponse, error)
 	CreateDiscountCode(context.Context, *CreateDiscountCodeRequest) (*CreateDiscountCodeResponse, error)
 	DeleteDiscountCode(context.Context, *DeleteDiscountCodeRequest) (*DeleteDiscountCodeResponse, error)
+	GenerateDiscountCodes(*GenerateDiscountCodesRequest, grpc.ServerStreamingServer[GenerateDiscountCodesResponse]) error
+	ExportDiscountCodes(context.Context, *ExportDiscountCodesRequest) (*ExportDiscountCodesResponse, error)
 	// Discount Plan operations (junction table)
 	AddPlanToDiscount(context.Context, *AddPlanToDiscountRequest) (*AddPlanToDiscountResponse, error)
 	RemovePlanFromDiscount(context.Context, *RemovePlanFromDiscountRequest) (*RemovePlanFromDiscountResponse, error)
@@ -685,6 +1068,36 @@ type ProductServiceServer interface {
 	GetRedemptionsByDiscountCode(context.Context, *GetRedemptionsByDiscountCodeRequest) (*GetRedemptionsByDiscountCodeResponse, error)
 	// Validation operations
 	ValidateDiscountCode(context.Context, *ValidateDiscountCodeRequest) (*ValidateDiscountCodeResponse, error)
+	// Checkout operations
+	PreviewCheckout(context.Context, *PreviewCheckoutRequest) (*PreviewCheckoutResponse, error)
+	// Referral operations
+	GetReferralCode(context.Context, *GetReferralCodeRequest) (*GetReferralCodeResponse, error)
+	GetReferralStats(context.Context, *GetReferralStatsRequest) (*GetReferralStatsResponse, error)
+	GetReferralsByReferrer(context.Context, *GetReferralsByReferrerRequest) (*GetReferralsByReferrerResponse, error)
+	// Product price operations
+	SetProductPrice(context.Context, *SetProductPriceRequest) (*SetProductPriceResponse, error)
+	GetProductPrices(context.Context, *GetProductPricesRequest) (*GetProductPricesResponse, error)
+	DeleteProductPrice(context.Context, *DeleteProductPriceRequest) (*DeleteProductPriceResponse, error)
+	// Order operations
+	CreateOrder(context.Context, *CreateOrderRequest) (*CreateOrderResponse, error)
+	GetOrderByID(context.Context, *GetOrderByIDRequest) (*GetOrderByIDResponse, error)
+	GetOrdersByUser(context.Context, *GetOrdersByUserRequest) (*GetOrdersByUserResponse, error)
+	GetAllOrders(context.Context, *GetAllOrdersRequest) (*GetAllOrdersResponse, error)
+	MarkOrderPaid(context.Context, *MarkOrderPaidRequest) (*MarkOrderPaidResponse, error)
+	CancelOrder(context.Context, *CancelOrderRequest) (*CancelOrderResponse, error)
+	RefundOrder(context.Context, *RefundOrderRequest) (*RefundOrderResponse, error)
+	// Currency operations
+	GetCurrencies(context.Context, *GetCurrenciesRequest) (*GetCurrenciesResponse, error)
+	GetCurrencyByID(context.Context, *GetCurrencyByIDRequest) (*GetCurrencyByIDResponse, error)
+	CreateCurrency(context.Context, *CreateCurrencyRequest) (*CreateCurrencyResponse, error)
+	UpdateCurrency(context.Context, *UpdateCurrencyRequest) (*UpdateCurrencyResponse, error)
+	DeleteCurrency(context.Context, *DeleteCurrencyRequest) (*DeleteCurrencyResponse, error)
+	// Exchange rate operations
+	GetExchangeRates(context.Context, *GetExchangeRatesRequest) (*GetExchangeRatesResponse, error)
+	SetExchangeRate(context.Context, *SetExchangeRateRequest) (*SetExchangeRateResponse, error)
+	ImportExchangeRates(context.Context, *ImportExchangeRatesRequest) (*ImportExchangeRatesResponse, error)
+	DeleteExchangeRate(context.Context, *DeleteExchangeRateRequest) (*DeleteExchangeRateResponse, error)
+	DerivePlanPrices(context.Context, *DerivePlanPricesRequest) (*DerivePlanPricesResponse, error)
 	mustEmbedUnimplementedProductServiceServer()
 }
 
@@ -749,6 +1162,9 @@ func (UnimplementedProductServiceServer) UpdatePlanPrice(context.Context, *Updat
 func (UnimplementedProductServiceServer) DeletePlanPrice(context.Context, *DeletePlanPriceRequest) (*DeletePlanPriceResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeletePlanPrice not implemented")
 }
+func (UnimplementedProductServiceServer) QuotePrice(context.Context, *QuotePriceRequest) (*QuotePriceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method QuotePrice not implemented")
+}
 func (UnimplementedProductServiceServer) GetPlanMeterByID(context.Context, *GetPlanMeterByIDRequest) (*GetPlanMeterByIDResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetPlanMeterByID not implemented")
 }
@@ -764,6 +1180,15 @@ func (UnimplementedProductServiceServer) UpdatePlanMeter(context.Context, *Updat
 func (UnimplementedProductServiceServer) DeletePlanMeter(context.Context, *DeletePlanMeterRequest) (*DeletePlanMeterResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeletePlanMeter not implemented")
 }
+func (UnimplementedProductServiceServer) GetPlanEntitlements(context.Context, *GetPlanEntitlementsRequest) (*GetPlanEntitlementsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPlanEntitlements not implemented")
+}
+func (UnimplementedProductServiceServer) SetPlanEntitlement(context.Context, *SetPlanEntitlementRequest) (*SetPlanEntitlementResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetPlanEntitlement not implemented")
+}
+func (UnimplementedProductServiceServer) DeletePlanEntitlement(context.Context, *DeletePlanEntitlementRequest) (*DeletePlanEntitlementResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeletePlanEntitlement not implemented")
+}
 func (UnimplementedProductServiceServer) GetDiscountByID(context.Context, *GetDiscountByIDRequest) (*GetDiscountByIDResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetDiscountByID not implemented")
 }
@@ -797,6 +1222,12 @@ func (UnimplementedProductServiceServer) CreateDiscountCode(context.Context, *Cr
 func (UnimplementedProductServiceServer) DeleteDiscountCode(context.Context, *DeleteDiscountCodeRequest) (*DeleteDiscountCodeResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteDiscountCode not implemented")
 }
+func (UnimplementedProductServiceServer) GenerateDiscountCodes(*GenerateDiscountCodesRequest, grpc.ServerStreamingServer[GenerateDiscountCodesResponse]) error {
+	return status.Error(codes.Unimplemented, "method GenerateDiscountCodes not implemented")
+}
+func (UnimplementedProductServiceServer) ExportDiscountCodes(context.Context, *ExportDiscountCodesRequest) (*ExportDiscountCodesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportDiscountCodes not implemented")
+}
 func (UnimplementedProductServiceServer) AddPlanToDiscount(context.Context, *AddPlanToDiscountRequest) (*AddPlanToDiscountResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method AddPlanToDiscount not implemented")
 }
@@ -839,6 +1270,78 @@ func (UnimplementedProductServiceServer) GetRedemptionsByDiscountCode(context.Co
 func (UnimplementedProductServiceServer) ValidateDiscountCode(context.Context, *ValidateDiscountCodeRequest) (*ValidateDiscountCodeResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ValidateDiscountCode not implemented")
 }
+func (UnimplementedProductServiceServer) PreviewCheckout(context.Context, *PreviewCheckoutRequest) (*PreviewCheckoutResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PreviewCheckout not implemented")
+}
+func (UnimplementedProductServiceServer) GetReferralCode(context.Context, *GetReferralCodeRequest) (*GetReferralCodeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetReferralCode not implemented")
+}
+func (UnimplementedProductServiceServer) GetReferralStats(context.Context, *GetReferralStatsRequest) (*GetReferralStatsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetReferralStats not implemented")
+}
+func (UnimplementedProductServiceServer) GetReferralsByReferrer(context.Context, *GetReferralsByReferrerRequest) (*GetReferralsByReferrerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetReferralsByReferrer not implemented")
+}
+func (UnimplementedProductServiceServer) SetProductPrice(context.Context, *SetProductPriceRequest) (*SetProductPriceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetProductPrice not implemented")
+}
+func (UnimplementedProductServiceServer) GetProductPrices(context.Context, *GetProductPricesRequest) (*GetProductPricesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetProductPrices not implemented")
+}
+func (UnimplementedProductServiceServer) DeleteProductPrice(context.Context, *DeleteProductPriceRequest) (*DeleteProductPriceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteProductPrice not implemented")
+}
+func (UnimplementedProductServiceServer) CreateOrder(context.Context, *CreateOrderRequest) (*CreateOrderResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateOrder not implemented")
+}
+func (UnimplementedProductServiceServer) GetOrderByID(context.Context, *GetOrderByIDRequest) (*GetOrderByIDResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetOrderByID not implemented")
+}
+func (UnimplementedProductServiceServer) GetOrdersByUser(context.Context, *GetOrdersByUserRequest) (*GetOrdersByUserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetOrdersByUser not implemented")
+}
+func (UnimplementedProductServiceServer) GetAllOrders(context.Context, *GetAllOrdersRequest) (*GetAllOrdersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAllOrders not implemented")
+}
+func (UnimplementedProductServiceServer) MarkOrderPaid(context.Context, *MarkOrderPaidRequest) (*MarkOrderPaidResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MarkOrderPaid not implemented")
+}
+func (UnimplementedProductServiceServer) CancelOrder(context.Context, *CancelOrderRequest) (*CancelOrderResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelOrder not implemented")
+}
+func (UnimplementedProductServiceServer) RefundOrder(context.Context, *RefundOrderRequest) (*RefundOrderResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RefundOrder not implemented")
+}
+func (UnimplementedProductServiceServer) GetCurrencies(context.Context, *GetCurrenciesRequest) (*GetCurrenciesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCurrencies not implemented")
+}
+func (UnimplementedProductServiceServer) GetCurrencyByID(context.Context, *GetCurrencyByIDRequest) (*GetCurrencyByIDResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCurrencyByID not implemented")
+}
+func (UnimplementedProductServiceServer) CreateCurrency(context.Context, *CreateCurrencyRequest) (*CreateCurrencyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateCurrency not implemented")
+}
+func (UnimplementedProductServiceServer) UpdateCurrency(context.Context, *UpdateCurrencyRequest) (*UpdateCurrencyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateCurrency not implemented")
+}
+func (UnimplementedProductServiceServer) DeleteCurrency(context.Context, *DeleteCurrencyRequest) (*DeleteCurrencyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteCurrency not implemented")
+}
+func (UnimplementedProductServiceServer) GetExchangeRates(context.Context, *GetExchangeRatesRequest) (*GetExchangeRatesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetExchangeRates not implemented")
+}
+func (UnimplementedProductServiceServer) SetExchangeRate(context.Context, *SetExchangeRateRequest) (*SetExchangeRateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetExchangeRate not implemented")
+}
+func (UnimplementedProductServiceServer) ImportExchangeRates(context.Context, *ImportExchangeRatesRequest) (*ImportExchangeRatesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImportExchangeRates not implemented")
+}
+func (UnimplementedProductServiceServer) DeleteExchangeRate(context.Context, *DeleteExchangeRateRequest) (*DeleteExchangeRateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteExchangeRate not implemented")
+}
+func (UnimplementedProductServiceServer) DerivePlanPrices(context.Context, *DerivePlanPricesRequest) (*DerivePlanPricesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DerivePlanPrices not implemented")
+}
 func (UnimplementedProductServiceServer) mustEmbedUnimplementedProductServiceServer() {}
 func (UnimplementedProductServiceServer) testEmbeddedByValue()                        {}
 
@@ -1184,35 +1687,53 @@ func _ProductService_DeletePlanPrice_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_GetPlanMeterByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPlanMeterByIDRequest)
+func _ProductService_QuotePrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuotePriceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).GetPlanMeterByID(ctx, in)
+		return srv.(ProductServiceServer).QuotePrice(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_GetPlanMeterByID_FullMethodName,
+		FullMethod: ProductService_QuotePrice_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).GetPlanMeterByID(ctx, req.(*GetPlanMeterByIDRequest))
+		return srv.(ProductServiceServer).QuotePrice(ctx, req.(*QuotePriceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_GetAllPlanMeters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllPlanMetersRequest)
+func _ProductService_GetPlanMeterByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlanMeterByIDRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).GetAllPlanMeters(ctx, in)
+		return srv.(ProductServiceServer).GetPlanMeterByID(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_GetAllPlanMeters_FullMethodName,
+		FullMethod: ProductService_GetPlanMeterByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetPlanMeterByID(ctx, req.(*GetPlanMeterByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetAllPlanMeters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllPlanMetersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetAllPlanMeters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetAllPlanMeters_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(ProductServiceServer).GetAllPlanMeters(ctx, req.(*GetAllPlanMetersRequest))
@@ -1274,6 +1795,60 @@ func _ProductService_DeletePlanMeter_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProductService_GetPlanEntitlements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlanEntitlementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetPlanEntitlements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetPlanEntitlements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetPlanEntitlements(ctx, req.(*GetPlanEntitlementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SetPlanEntitlement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPlanEntitlementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetPlanEntitlement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SetPlanEntitlement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetPlanEntitlement(ctx, req.(*SetPlanEntitlementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DeletePlanEntitlement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePlanEntitlementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DeletePlanEntitlement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_DeletePlanEntitlement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DeletePlanEntitlement(ctx, req.(*DeletePlanEntitlementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProductService_GetDiscountByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetDiscountByIDRequest)
 	if err := dec(in); err != nil {
@@ -1472,6 +2047,35 @@ func _ProductService_DeleteDiscountCode_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProductService_GenerateDiscountCodes_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GenerateDiscountCodesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProductServiceServer).GenerateDiscountCodes(m, &grpc.GenericServerStream[GenerateDiscountCodesRequest, GenerateDiscountCodesResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ProductService_GenerateDiscountCodesServer = grpc.ServerStreamingServer[GenerateDiscountCodesResponse]
+
+func _ProductService_ExportDiscountCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportDiscountCodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ExportDiscountCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ExportDiscountCodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ExportDiscountCodes(ctx, req.(*ExportDiscountCodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProductService_AddPlanToDiscount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddPlanToDiscountRequest)
 	if err := dec(in); err != nil {
@@ -1724,6 +2328,438 @@ func _ProductService_ValidateDiscountCode_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProductService_PreviewCheckout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewCheckoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).PreviewCheckout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_PreviewCheckout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).PreviewCheckout(ctx, req.(*PreviewCheckoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetReferralCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReferralCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetReferralCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetReferralCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetReferralCode(ctx, req.(*GetReferralCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetReferralStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReferralStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetReferralStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetReferralStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetReferralStats(ctx, req.(*GetReferralStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetReferralsByReferrer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReferralsByReferrerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetReferralsByReferrer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetReferralsByReferrer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetReferralsByReferrer(ctx, req.(*GetReferralsByReferrerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SetProductPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetProductPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetProductPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SetProductPrice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetProductPrice(ctx, req.(*SetProductPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetProductPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetProductPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetProductPrices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetProductPrices(ctx, req.(*GetProductPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DeleteProductPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProductPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DeleteProductPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_DeleteProductPrice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DeleteProductPrice(ctx, req.(*DeleteProductPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_CreateOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CreateOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CreateOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CreateOrder(ctx, req.(*CreateOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetOrderByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetOrderByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetOrderByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetOrderByID(ctx, req.(*GetOrderByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetOrdersByUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrdersByUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetOrdersByUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetOrdersByUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetOrdersByUser(ctx, req.(*GetOrdersByUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetAllOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetAllOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetAllOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetAllOrders(ctx, req.(*GetAllOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_MarkOrderPaid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkOrderPaidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).MarkOrderPaid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_MarkOrderPaid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).MarkOrderPaid(ctx, req.(*MarkOrderPaidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CancelOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CancelOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CancelOrder(ctx, req.(*CancelOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_RefundOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).RefundOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_RefundOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).RefundOrder(ctx, req.(*RefundOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetCurrencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCurrenciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetCurrencies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetCurrencies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetCurrencies(ctx, req.(*GetCurrenciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetCurrencyByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCurrencyByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetCurrencyByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetCurrencyByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetCurrencyByID(ctx, req.(*GetCurrencyByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_CreateCurrency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCurrencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CreateCurrency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CreateCurrency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CreateCurrency(ctx, req.(*CreateCurrencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_UpdateCurrency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCurrencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).UpdateCurrency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_UpdateCurrency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).UpdateCurrency(ctx, req.(*UpdateCurrencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DeleteCurrency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCurrencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DeleteCurrency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_DeleteCurrency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DeleteCurrency(ctx, req.(*DeleteCurrencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExchangeRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetExchangeRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetExchangeRates(ctx, req.(*GetExchangeRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SetExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetExchangeRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetExchangeRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SetExchangeRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetExchangeRate(ctx, req.(*SetExchangeRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ImportExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportExchangeRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ImportExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ImportExchangeRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ImportExchangeRates(ctx, req.(*ImportExchangeRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DeleteExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteExchangeRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DeleteExchangeRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_DeleteExchangeRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DeleteExchangeRate(ctx, req.(*DeleteExchangeRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DerivePlanPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DerivePlanPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DerivePlanPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_DerivePlanPrices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DerivePlanPrices(ctx, req.(*DerivePlanPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ProductService_ServiceDesc is the grpc.ServiceDesc for ProductService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1803,6 +2839,10 @@ var ProductService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeletePlanPrice",
 			Handler:    _ProductService_DeletePlanPrice_Handler,
 		},
+		{
+			MethodName: "QuotePrice",
+			Handler:    _ProductService_QuotePrice_Handler,
+		},
 		{
 			MethodName: "GetPlanMeterByID",
 			Handler:    _ProductService_GetPlanMeterByID_Handler,
@@ -1823,6 +2863,18 @@ var ProductService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeletePlanMeter",
 			Handler:    _ProductService_DeletePlanMeter_Handler,
 		},
+		{
+			MethodName: "GetPlanEntitlements",
+			Handler:    _ProductService_GetPlanEntitlements_Handler,
+		},
+		{
+			MethodName: "SetPlanEntitlement",
+			Handler:    _ProductService_SetPlanEntitlement_Handler,
+		},
+		{
+			MethodName: "DeletePlanEntitlement",
+			Handler:    _ProductService_DeletePlanEntitlement_Handler,
+		},
 		{
 			MethodName: "GetDiscountByID",
 			Handler:    _ProductService_GetDiscountByID_Handler,
@@ -1867,6 +2919,10 @@ var ProductService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteDiscountCode",
 			Handler:    _ProductService_DeleteDiscountCode_Handler,
 		},
+		{
+			MethodName: "ExportDiscountCodes",
+			Handler:    _ProductService_ExportDiscountCodes_Handler,
+		},
 		{
 			MethodName: "AddPlanToDiscount",
 			Handler:    _ProductService_AddPlanToDiscount_Handler,
@@ -1923,7 +2979,109 @@ var ProductService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ValidateDiscountCode",
 			Handler:    _ProductService_ValidateDiscountCode_Handler,
 		},
+		{
+			MethodName: "PreviewCheckout",
+			Handler:    _ProductService_PreviewCheckout_Handler,
+		},
+		{
+			MethodName: "GetReferralCode",
+			Handler:    _ProductService_GetReferralCode_Handler,
+		},
+		{
+			MethodName: "GetReferralStats",
+			Handler:    _ProductService_GetReferralStats_Handler,
+		},
+		{
+			MethodName: "GetReferralsByReferrer",
+			Handler:    _ProductService_GetReferralsByReferrer_Handler,
+		},
+		{
+			MethodName: "SetProductPrice",
+			Handler:    _ProductService_SetProductPrice_Handler,
+		},
+		{
+			MethodName: "GetProductPrices",
+			Handler:    _ProductService_GetProductPrices_Handler,
+		},
+		{
+			MethodName: "DeleteProductPrice",
+			Handler:    _ProductService_DeleteProductPrice_Handler,
+		},
+		{
+			MethodName: "CreateOrder",
+			Handler:    _ProductService_CreateOrder_Handler,
+		},
+		{
+			MethodName: "GetOrderByID",
+			Handler:    _ProductService_GetOrderByID_Handler,
+		},
+		{
+			MethodName: "GetOrdersByUser",
+			Handler:    _ProductService_GetOrdersByUser_Handler,
+		},
+		{
+			MethodName: "GetAllOrders",
+			Handler:    _ProductService_GetAllOrders_Handler,
+		},
+		{
+			MethodName: "MarkOrderPaid",
+			Handler:    _ProductService_MarkOrderPaid_Handler,
+		},
+		{
+			MethodName: "CancelOrder",
+			Handler:    _ProductService_CancelOrder_Handler,
+		},
+		{
+			MethodName: "RefundOrder",
+			Handler:    _ProductService_RefundOrder_Handler,
+		},
+		{
+			MethodName: "GetCurrencies",
+			Handler:    _ProductService_GetCurrencies_Handler,
+		},
+		{
+			MethodName: "GetCurrencyByID",
+			Handler:    _ProductService_GetCurrencyByID_Handler,
+		},
+		{
+			MethodName: "CreateCurrency",
+			Handler:    _ProductService_CreateCurrency_Handler,
+		},
+		{
+			MethodName: "UpdateCurrency",
+			Handler:    _ProductService_UpdateCurrency_Handler,
+		},
+		{
+			MethodName: "DeleteCurrency",
+			Handler:    _ProductService_DeleteCurrency_Handler,
+		},
+		{
+			MethodName: "GetExchangeRates",
+			Handler:    _ProductService_GetExchangeRates_Handler,
+		},
+		{
+			MethodName: "SetExchangeRate",
+			Handler:    _ProductService_SetExchangeRate_Handler,
+		},
+		{
+			MethodName: "ImportExchangeRates",
+			Handler:    _ProductService_ImportExchangeRates_Handler,
+		},
+		{
+			MethodName: "DeleteExchangeRate",
+			Handler:    _ProductService_DeleteExchangeRate_Handler,
+		},
+		{
+			MethodName: "DerivePlanPrices",
+			Handler:    _ProductService_DerivePlanPrices_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GenerateDiscountCodes",
+			Handler:       _ProductService_GenerateDiscountCodes_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "product.proto",
 }
@@ -35,7 +35,7 @@ type Subscription struct {
 	PaymentProviderStatus         string                 `protobuf:"bytes,10,opt,name=payment_provider_status,json=paymentProviderStatus,proto3" json:"payment_provider_status,omitempty"`
 	PaymentProviderId             int64                  `protobuf:"varint,11,opt,name=payment_provider_id,json=paymentProviderId,proto3" json:"payment_provider_id,omitempty"`
 	TrialEndsAt                   int64                  `protobuf:"varint,12,opt,name=trial_ends_at,json=trialEndsAt,proto3" json:"trial_ends_at,omitempty"`
-	Status                        string                 `protobuf:"bytes,13,opt,name=status,proto3" json:"status,omitempty"` // active, cancelled, expired, trialing, past_due, etc.
+	Status                        string                 `protobuf:"bytes,13,opt,name=status,proto3" json:"status,omitempty"` // trialing, active, past_due, paused, cancelled, expired
 	IntervalId                    int64                  `protobuf:"varint,14,opt,name=interval_id,json=intervalId,proto3" json:"interval_id,omitempty"`
 	IntervalCount                 int32                  `protobuf:"varint,15,opt,name=interval_count,json=intervalCount,proto3" json:"interval_count,omitempty"`
 	IsCanceledAtEndOfCycle        bool                   `protobuf:"varint,16,opt,name=is_canceled_at_end_of_cycle,json=isCanceledAtEndOfCycle,proto3" json:"is_canceled_at_end_of_cycle,omitempty"`
@@ -52,6 +52,8 @@ type Subscription struct {
 	Metadata                      string                 `protobuf:"bytes,27,opt,name=metadata,proto3" json:"metadata,omitempty"` // JSON string
 	CreatedAt                     int64                  `protobuf:"varint,28,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt                     int64                  `protobuf:"varint,29,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ScheduledPlanId               int64                  `protobuf:"varint,30,opt,name=scheduled_plan_id,json=scheduledPlanId,proto3" json:"scheduled_plan_id,omitempty"` // plan applied at the next renewal
+	ScheduledQuantity             int32                  `protobuf:"varint,31,opt,name=scheduled_quantity,json=scheduledQuantity,proto3" json:"scheduled_quantity,omitempty"`
 	unknownFields                 protoimpl.UnknownFields
 	sizeCache                     protoimpl.SizeCache
 }
@@ -289,6 +291,20 @@ func (x *Subscription) GetUpdatedAt() int64 {
 	return 0
 }
 
+func (x *Subscription) GetScheduledPlanId() int64 {
+	if x != nil {
+		return x.ScheduledPlanId
+	}
+	return 0
+}
+
+func (x *Subscription) GetScheduledQuantity() int32 {
+	if x != nil {
+		return x.ScheduledQuantity
+	}
+	return 0
+}
+
 type GetSubscriptionByIDRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1265,6 +1281,118 @@ func (x *CancelSubscriptionResponse) GetData() *Subscription {
 	return nil
 }
 
+type PauseSubscriptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseSubscriptionRequest) Reset() {
+	*x = PauseSubscriptionRequest{}
+	mi := &file_subscription_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseSubscriptionRequest) ProtoMessage() {}
+
+func (x *PauseSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*PauseSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *PauseSubscriptionRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PauseSubscriptionRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type PauseSubscriptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *Subscription          `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseSubscriptionResponse) Reset() {
+	*x = PauseSubscriptionResponse{}
+	mi := &file_subscription_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseSubscriptionResponse) ProtoMessage() {}
+
+func (x *PauseSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*PauseSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *PauseSubscriptionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *PauseSubscriptionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PauseSubscriptionResponse) GetData() *Subscription {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
 type ResumeSubscriptionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1274,7 +1402,7 @@ type ResumeSubscriptionRequest struct {
 
 func (x *ResumeSubscriptionRequest) Reset() {
 	*x = ResumeSubscriptionRequest{}
-	mi := &file_subscription_proto_msgTypes[15]
+	mi := &file_subscription_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1286,7 +1414,7 @@ func (x *ResumeSubscriptionRequest) String() string {
 func (*ResumeSubscriptionRequest) ProtoMessage() {}
 
 func (x *ResumeSubscriptionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_subscription_proto_msgTypes[15]
+	mi := &file_subscription_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1299,7 +1427,7 @@ func (x *ResumeSubscriptionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResumeSubscriptionRequest.ProtoReflect.Descriptor instead.
 func (*ResumeSubscriptionRequest) Descriptor() ([]byte, []int) {
-	return file_subscription_proto_rawDescGZIP(), []int{15}
+	return file_subscription_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ResumeSubscriptionRequest) GetId() int64 {
@@ -1320,7 +1448,7 @@ type ResumeSubscriptionResponse struct {
 
 func (x *ResumeSubscriptionResponse) Reset() {
 	*x = ResumeSubscriptionResponse{}
-	mi := &file_subscription_proto_msgTypes[16]
+	mi := &file_subscription_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1332,7 +1460,7 @@ func (x *ResumeSubscriptionResponse) String() string {
 func (*ResumeSubscriptionResponse) ProtoMessage() {}
 
 func (x *ResumeSubscriptionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_subscription_proto_msgTypes[16]
+	mi := &file_subscription_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1345,7 +1473,7 @@ func (x *ResumeSubscriptionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResumeSubscriptionResponse.ProtoReflect.Descriptor instead.
 func (*ResumeSubscriptionResponse) Descriptor() ([]byte, []int) {
-	return file_subscription_proto_rawDescGZIP(), []int{16}
+	return file_subscription_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ResumeSubscriptionResponse) GetSuccess() bool {
@@ -1379,7 +1507,7 @@ type RenewSubscriptionRequest struct {
 
 func (x *RenewSubscriptionRequest) Reset() {
 	*x = RenewSubscriptionRequest{}
-	mi := &file_subscription_proto_msgTypes[17]
+	mi := &file_subscription_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1391,7 +1519,7 @@ func (x *RenewSubscriptionRequest) String() string {
 func (*RenewSubscriptionRequest) ProtoMessage() {}
 
 func (x *RenewSubscriptionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_subscription_proto_msgTypes[17]
+	mi := &file_subscription_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1404,7 +1532,7 @@ func (x *RenewSubscriptionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RenewSubscriptionRequest.ProtoReflect.Descriptor instead.
 func (*RenewSubscriptionRequest) Descriptor() ([]byte, []int) {
-	return file_subscription_proto_rawDescGZIP(), []int{17}
+	return file_subscription_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *RenewSubscriptionRequest) GetId() int64 {
@@ -1432,7 +1560,7 @@ type RenewSubscriptionResponse struct {
 
 func (x *RenewSubscriptionResponse) Reset() {
 	*x = RenewSubscriptionResponse{}
-	mi := &file_subscription_proto_msgTypes[18]
+	mi := &file_subscription_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1444,7 +1572,7 @@ func (x *RenewSubscriptionResponse) String() string {
 func (*RenewSubscriptionResponse) ProtoMessage() {}
 
 func (x *RenewSubscriptionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_subscription_proto_msgTypes[18]
+	mi := &file_subscription_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1457,7 +1585,7 @@ func (x *RenewSubscriptionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RenewSubscriptionResponse.ProtoReflect.Descriptor instead.
 func (*RenewSubscriptionResponse) Descriptor() ([]byte, []int) {
-	return file_subscription_proto_rawDescGZIP(), []int{18}
+	return file_subscription_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *RenewSubscriptionResponse) GetSuccess() bool {
@@ -1492,7 +1620,7 @@ type GetAllSubscriptionsRequest struct {
 
 func (x *GetAllSubscriptionsRequest) Reset() {
 	*x = GetAllSubscriptionsRequest{}
-	mi := &file_subscription_proto_msgTypes[19]
+	mi := &file_subscription_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1504,7 +1632,7 @@ func (x *GetAllSubscriptionsRequest) String() string {
 func (*GetAllSubscriptionsRequest) ProtoMessage() {}
 
 func (x *GetAllSubscriptionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_subscription_proto_msgTypes[19]
+	mi := &file_subscription_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1517,7 +1645,7 @@ func (x *GetAllSubscriptionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllSubscriptionsRequest.ProtoReflect.Descriptor instead.
 func (*GetAllSubscriptionsRequest) Descriptor() ([]byte, []int) {
-	return file_subscription_proto_rawDescGZIP(), []int{19}
+	return file_subscription_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *GetAllSubscriptionsRequest) GetPage() int32 {
@@ -1553,7 +1681,7 @@ type GetAllSubscriptionsData struct {
 
 func (x *GetAllSubscriptionsData) Reset() {
 	*x = GetAllSubscriptionsData{}
-	mi := &file_subscription_proto_msgTypes[20]
+	mi := &file_subscription_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1565,7 +1693,7 @@ func (x *GetAllSubscriptionsData) String() string {
 func (*GetAllSubscriptionsData) ProtoMessage() {}
 
 func (x *GetAllSubscriptionsData) ProtoReflect() protoreflect.Message {
-	mi := &file_subscription_proto_msgTypes[20]
+	mi := &file_subscription_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1578,7 +1706,7 @@ func (x *GetAllSubscriptionsData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllSubscriptionsData.ProtoReflect.Descriptor instead.
 func (*GetAllSubscriptionsData) Descriptor() ([]byte, []int) {
-	return file_subscription_proto_rawDescGZIP(), []int{20}
+	return file_subscription_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *GetAllSubscriptionsData) GetSubscriptions() []*Subscription {
@@ -1620,7 +1748,7 @@ type GetAllSubscriptionsResponse struct {
 
 func (x *GetAllSubscriptionsResponse) Reset() {
 	*x = GetAllSubscriptionsResponse{}
-	mi := &file_subscription_proto_msgTypes[21]
+	mi := &file_subscription_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1632,7 +1760,7 @@ func (x *GetAllSubscriptionsResponse) String() string {
 func (*GetAllSubscriptionsResponse) ProtoMessage() {}
 
 func (x *GetAllSubscriptionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_subscription_proto_msgTypes[21]
+	mi := &file_subscription_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1645,7 +1773,7 @@ func (x *GetAllSubscriptionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllSubscriptionsResponse.ProtoReflect.Descriptor instead.
 func (*GetAllSubscriptionsResponse) Descriptor() ([]byte, []int) {
-	return file_subscription_proto_rawDescGZIP(), []int{21}
+	return file_subscription_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *GetAllSubscriptionsResponse) GetSuccess() bool {
@@ -1669,32 +1797,31 @@ func (x *GetAllSubscriptionsResponse) GetData() *GetAllSubscriptionsData {
 	return nil
 }
 
-type SubscriptionUsage struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	SubscriptionId int64                  `protobuf:"varint,2,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
-	UnitCount      int32                  `protobuf:"varint,3,opt,name=unit_count,json=unitCount,proto3" json:"unit_count,omitempty"`
-	CreatedAt      int64                  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt      int64                  `protobuf:"varint,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type ChangePlanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PlanId        int64                  `protobuf:"varint,2,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"` // defaults to the current quantity
+	Mode          string                 `protobuf:"bytes,4,opt,name=mode,proto3" json:"mode,omitempty"`          // now, period_end; empty picks now for upgrades and period_end for downgrades
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SubscriptionUsage) Reset() {
-	*x = SubscriptionUsage{}
-	mi := &file_subscription_proto_msgTypes[22]
+func (x *ChangePlanRequest) Reset() {
+	*x = ChangePlanRequest{}
+	mi := &file_subscription_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubscriptionUsage) String() string {
+func (x *ChangePlanRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubscriptionUsage) ProtoMessage() {}
+func (*ChangePlanRequest) ProtoMessage() {}
 
-func (x *SubscriptionUsage) ProtoReflect() protoreflect.Message {
-	mi := &file_subscription_proto_msgTypes[22]
+func (x *ChangePlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1705,69 +1832,67 @@ func (x *SubscriptionUsage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubscriptionUsage.ProtoReflect.Descriptor instead.
-func (*SubscriptionUsage) Descriptor() ([]byte, []int) {
-	return file_subscription_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use ChangePlanRequest.ProtoReflect.Descriptor instead.
+func (*ChangePlanRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *SubscriptionUsage) GetId() int64 {
+func (x *ChangePlanRequest) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *SubscriptionUsage) GetSubscriptionId() int64 {
-	if x != nil {
-		return x.SubscriptionId
-	}
-	return 0
-}
-
-func (x *SubscriptionUsage) GetUnitCount() int32 {
+func (x *ChangePlanRequest) GetPlanId() int64 {
 	if x != nil {
-		return x.UnitCount
+		return x.PlanId
 	}
 	return 0
 }
 
-func (x *SubscriptionUsage) GetCreatedAt() int64 {
+func (x *ChangePlanRequest) GetQuantity() int32 {
 	if x != nil {
-		return x.CreatedAt
+		return x.Quantity
 	}
 	return 0
 }
 
-func (x *SubscriptionUsage) GetUpdatedAt() int64 {
+func (x *ChangePlanRequest) GetMode() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Mode
 	}
-	return 0
+	return ""
 }
 
-type RecordUsageRequest struct {
+// Proration amounts are in the currency's minor unit
+type Proration struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
-	SubscriptionId int64                  `protobuf:"varint,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
-	UnitCount      int32                  `protobuf:"varint,2,opt,name=unit_count,json=unitCount,proto3" json:"unit_count,omitempty"`
+	CreditAmount   int64                  `protobuf:"varint,1,opt,name=credit_amount,json=creditAmount,proto3" json:"credit_amount,omitempty"` // unused share of the old plan
+	ChargeAmount   int64                  `protobuf:"varint,2,opt,name=charge_amount,json=chargeAmount,proto3" json:"charge_amount,omitempty"` // cost of the new plan for the rest of the period
+	NetAmount      int64                  `protobuf:"varint,3,opt,name=net_amount,json=netAmount,proto3" json:"net_amount,omitempty"`          // charge_amount - credit_amount
+	RemainingRatio float64                `protobuf:"fixed64,4,opt,name=remaining_ratio,json=remainingRatio,proto3" json:"remaining_ratio,omitempty"`
+	Mode           string                 `protobuf:"bytes,5,opt,name=mode,proto3" json:"mode,omitempty"`
+	EffectiveAt    int64                  `protobuf:"varint,6,opt,name=effective_at,json=effectiveAt,proto3" json:"effective_at,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *RecordUsageRequest) Reset() {
-	*x = RecordUsageRequest{}
-	mi := &file_subscription_proto_msgTypes[23]
+func (x *Proration) Reset() {
+	*x = Proration{}
+	mi := &file_subscription_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RecordUsageRequest) String() string {
+func (x *Proration) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecordUsageRequest) ProtoMessage() {}
+func (*Proration) ProtoMessage() {}
 
-func (x *RecordUsageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_subscription_proto_msgTypes[23]
+func (x *Proration) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1778,49 +1903,78 @@ func (x *RecordUsageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecordUsageRequest.ProtoReflect.Descriptor instead.
-func (*RecordUsageRequest) Descriptor() ([]byte, []int) {
-	return file_subscription_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use Proration.ProtoReflect.Descriptor instead.
+func (*Proration) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *RecordUsageRequest) GetSubscriptionId() int64 {
+func (x *Proration) GetCreditAmount() int64 {
 	if x != nil {
-		return x.SubscriptionId
+		return x.CreditAmount
 	}
 	return 0
 }
 
-func (x *RecordUsageRequest) GetUnitCount() int32 {
+func (x *Proration) GetChargeAmount() int64 {
 	if x != nil {
-		return x.UnitCount
+		return x.ChargeAmount
 	}
 	return 0
 }
 
-type RecordUsageResponse struct {
+func (x *Proration) GetNetAmount() int64 {
+	if x != nil {
+		return x.NetAmount
+	}
+	return 0
+}
+
+func (x *Proration) GetRemainingRatio() float64 {
+	if x != nil {
+		return x.RemainingRatio
+	}
+	return 0
+}
+
+func (x *Proration) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *Proration) GetEffectiveAt() int64 {
+	if x != nil {
+		return x.EffectiveAt
+	}
+	return 0
+}
+
+type ChangePlanResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Data          *SubscriptionUsage     `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Data          *Subscription          `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Proration     *Proration             `protobuf:"bytes,4,opt,name=proration,proto3" json:"proration,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RecordUsageResponse) Reset() {
-	*x = RecordUsageResponse{}
-	mi := &file_subscription_proto_msgTypes[24]
+func (x *ChangePlanResponse) Reset() {
+	*x = ChangePlanResponse{}
+	mi := &file_subscription_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RecordUsageResponse) String() string {
+func (x *ChangePlanResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecordUsageResponse) ProtoMessage() {}
+func (*ChangePlanResponse) ProtoMessage() {}
 
-func (x *RecordUsageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_subscription_proto_msgTypes[24]
+func (x *ChangePlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1831,44 +1985,287 @@ func (x *RecordUsageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecordUsageResponse.ProtoReflect.Descriptor instead.
-func (*RecordUsageResponse) Descriptor() ([]byte, []int) {
-	return file_subscription_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use ChangePlanResponse.ProtoReflect.Descriptor instead.
+func (*ChangePlanResponse) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *RecordUsageResponse) GetSuccess() bool {
+func (x *ChangePlanResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *RecordUsageResponse) GetMessage() string {
+func (x *ChangePlanResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *RecordUsageResponse) GetData() *SubscriptionUsage {
+func (x *ChangePlanResponse) GetData() *Subscription {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-type GetUsageBySubscriptionRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	SubscriptionId int64                  `protobuf:"varint,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
-	Page           int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	PerPage        int32                  `protobuf:"varint,3,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *ChangePlanResponse) GetProration() *Proration {
+	if x != nil {
+		return x.Proration
+	}
+	return nil
 }
 
-func (x *GetUsageBySubscriptionRequest) Reset() {
+type SubscriptionUsage struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SubscriptionId int64                  `protobuf:"varint,2,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	UnitCount      int32                  `protobuf:"varint,3,opt,name=unit_count,json=unitCount,proto3" json:"unit_count,omitempty"`
+	CreatedAt      int64                  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      int64                  `protobuf:"varint,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	MeterId        int64                  `protobuf:"varint,6,opt,name=meter_id,json=meterId,proto3" json:"meter_id,omitempty"`
+	IdempotencyKey string                 `protobuf:"bytes,7,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	RecordedAt     int64                  `protobuf:"varint,8,opt,name=recorded_at,json=recordedAt,proto3" json:"recorded_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SubscriptionUsage) Reset() {
+	*x = SubscriptionUsage{}
+	mi := &file_subscription_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscriptionUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscriptionUsage) ProtoMessage() {}
+
+func (x *SubscriptionUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscriptionUsage.ProtoReflect.Descriptor instead.
+func (*SubscriptionUsage) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SubscriptionUsage) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SubscriptionUsage) GetSubscriptionId() int64 {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return 0
+}
+
+func (x *SubscriptionUsage) GetUnitCount() int32 {
+	if x != nil {
+		return x.UnitCount
+	}
+	return 0
+}
+
+func (x *SubscriptionUsage) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *SubscriptionUsage) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *SubscriptionUsage) GetMeterId() int64 {
+	if x != nil {
+		return x.MeterId
+	}
+	return 0
+}
+
+func (x *SubscriptionUsage) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *SubscriptionUsage) GetRecordedAt() int64 {
+	if x != nil {
+		return x.RecordedAt
+	}
+	return 0
+}
+
+type RecordUsageRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SubscriptionId int64                  `protobuf:"varint,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	UnitCount      int32                  `protobuf:"varint,2,opt,name=unit_count,json=unitCount,proto3" json:"unit_count,omitempty"`
+	IdempotencyKey string                 `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"` // retries with the same key return the first record
+	Timestamp      int64                  `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`                                // when the usage happened, defaults to now
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RecordUsageRequest) Reset() {
+	*x = RecordUsageRequest{}
+	mi := &file_subscription_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordUsageRequest) ProtoMessage() {}
+
+func (x *RecordUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordUsageRequest.ProtoReflect.Descriptor instead.
+func (*RecordUsageRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RecordUsageRequest) GetSubscriptionId() int64 {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return 0
+}
+
+func (x *RecordUsageRequest) GetUnitCount() int32 {
+	if x != nil {
+		return x.UnitCount
+	}
+	return 0
+}
+
+func (x *RecordUsageRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *RecordUsageRequest) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type RecordUsageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *SubscriptionUsage     `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Duplicate     bool                   `protobuf:"varint,4,opt,name=duplicate,proto3" json:"duplicate,omitempty"` // true when the idempotency key was already recorded
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordUsageResponse) Reset() {
+	*x = RecordUsageResponse{}
+	mi := &file_subscription_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordUsageResponse) ProtoMessage() {}
+
+func (x *RecordUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordUsageResponse.ProtoReflect.Descriptor instead.
+func (*RecordUsageResponse) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RecordUsageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RecordUsageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RecordUsageResponse) GetData() *SubscriptionUsage {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *RecordUsageResponse) GetDuplicate() bool {
+	if x != nil {
+		return x.Duplicate
+	}
+	return false
+}
+
+type GetUsageBySubscriptionRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SubscriptionId int64                  `protobuf:"varint,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	Page           int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PerPage        int32                  `protobuf:"varint,3,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetUsageBySubscriptionRequest) Reset() {
 	*x = GetUsageBySubscriptionRequest{}
-	mi := &file_subscription_proto_msgTypes[25]
+	mi := &file_subscription_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1880,7 +2277,7 @@ func (x *GetUsageBySubscriptionRequest) String() string {
 func (*GetUsageBySubscriptionRequest) ProtoMessage() {}
 
 func (x *GetUsageBySubscriptionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_subscription_proto_msgTypes[25]
+	mi := &file_subscription_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1893,7 +2290,7 @@ func (x *GetUsageBySubscriptionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUsageBySubscriptionRequest.ProtoReflect.Descriptor instead.
 func (*GetUsageBySubscriptionRequest) Descriptor() ([]byte, []int) {
-	return file_subscription_proto_rawDescGZIP(), []int{25}
+	return file_subscription_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *GetUsageBySubscriptionRequest) GetSubscriptionId() int64 {
@@ -1929,7 +2326,7 @@ type GetUsageBySubscriptionData struct {
 
 func (x *GetUsageBySubscriptionData) Reset() {
 	*x = GetUsageBySubscriptionData{}
-	mi := &file_subscription_proto_msgTypes[26]
+	mi := &file_subscription_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1941,7 +2338,7 @@ func (x *GetUsageBySubscriptionData) String() string {
 func (*GetUsageBySubscriptionData) ProtoMessage() {}
 
 func (x *GetUsageBySubscriptionData) ProtoReflect() protoreflect.Message {
-	mi := &file_subscription_proto_msgTypes[26]
+	mi := &file_subscription_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1954,7 +2351,7 @@ func (x *GetUsageBySubscriptionData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUsageBySubscriptionData.ProtoReflect.Descriptor instead.
 func (*GetUsageBySubscriptionData) Descriptor() ([]byte, []int) {
-	return file_subscription_proto_rawDescGZIP(), []int{26}
+	return file_subscription_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *GetUsageBySubscriptionData) GetUsages() []*SubscriptionUsage {
@@ -1964,51 +2361,315 @@ func (x *GetUsageBySubscriptionData) GetUsages() []*SubscriptionUsage {
 	return nil
 }
 
-func (x *GetUsageBySubscriptionData) GetTotal() int32 {
+func (x *GetUsageBySubscriptionData) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetUsageBySubscriptionData) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetUsageBySubscriptionData) GetPerPage() int32 {
+	if x != nil {
+		return x.PerPage
+	}
+	return 0
+}
+
+type GetUsageBySubscriptionResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Success       bool                        `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                      `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *GetUsageBySubscriptionData `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsageBySubscriptionResponse) Reset() {
+	*x = GetUsageBySubscriptionResponse{}
+	mi := &file_subscription_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsageBySubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageBySubscriptionResponse) ProtoMessage() {}
+
+func (x *GetUsageBySubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageBySubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*GetUsageBySubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetUsageBySubscriptionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetUsageBySubscriptionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetUsageBySubscriptionResponse) GetData() *GetUsageBySubscriptionData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type UsageSummary struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	MeterId        int64                  `protobuf:"varint,1,opt,name=meter_id,json=meterId,proto3" json:"meter_id,omitempty"`
+	MeterName      string                 `protobuf:"bytes,2,opt,name=meter_name,json=meterName,proto3" json:"meter_name,omitempty"`
+	Aggregation    string                 `protobuf:"bytes,3,opt,name=aggregation,proto3" json:"aggregation,omitempty"` // sum, max, last
+	Quantity       int64                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	RecordCount    int64                  `protobuf:"varint,5,opt,name=record_count,json=recordCount,proto3" json:"record_count,omitempty"`
+	LastRecordedAt int64                  `protobuf:"varint,6,opt,name=last_recorded_at,json=lastRecordedAt,proto3" json:"last_recorded_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UsageSummary) Reset() {
+	*x = UsageSummary{}
+	mi := &file_subscription_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UsageSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsageSummary) ProtoMessage() {}
+
+func (x *UsageSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsageSummary.ProtoReflect.Descriptor instead.
+func (*UsageSummary) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *UsageSummary) GetMeterId() int64 {
+	if x != nil {
+		return x.MeterId
+	}
+	return 0
+}
+
+func (x *UsageSummary) GetMeterName() string {
+	if x != nil {
+		return x.MeterName
+	}
+	return ""
+}
+
+func (x *UsageSummary) GetAggregation() string {
+	if x != nil {
+		return x.Aggregation
+	}
+	return ""
+}
+
+func (x *UsageSummary) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *UsageSummary) GetRecordCount() int64 {
+	if x != nil {
+		return x.RecordCount
+	}
+	return 0
+}
+
+func (x *UsageSummary) GetLastRecordedAt() int64 {
+	if x != nil {
+		return x.LastRecordedAt
+	}
+	return 0
+}
+
+type GetUsageSummaryRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SubscriptionId int64                  `protobuf:"varint,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	PeriodStart    int64                  `protobuf:"varint,2,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"` // both empty selects the current billing period
+	PeriodEnd      int64                  `protobuf:"varint,3,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetUsageSummaryRequest) Reset() {
+	*x = GetUsageSummaryRequest{}
+	mi := &file_subscription_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsageSummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageSummaryRequest) ProtoMessage() {}
+
+func (x *GetUsageSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageSummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetUsageSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetUsageSummaryRequest) GetSubscriptionId() int64 {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return 0
+}
+
+func (x *GetUsageSummaryRequest) GetPeriodStart() int64 {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return 0
+}
+
+func (x *GetUsageSummaryRequest) GetPeriodEnd() int64 {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return 0
+}
+
+type GetUsageSummaryData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meters        []*UsageSummary        `protobuf:"bytes,1,rep,name=meters,proto3" json:"meters,omitempty"`
+	PeriodStart   int64                  `protobuf:"varint,2,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	PeriodEnd     int64                  `protobuf:"varint,3,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsageSummaryData) Reset() {
+	*x = GetUsageSummaryData{}
+	mi := &file_subscription_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsageSummaryData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageSummaryData) ProtoMessage() {}
+
+func (x *GetUsageSummaryData) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageSummaryData.ProtoReflect.Descriptor instead.
+func (*GetUsageSummaryData) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetUsageSummaryData) GetMeters() []*UsageSummary {
 	if x != nil {
-		return x.Total
+		return x.Meters
 	}
-	return 0
+	return nil
 }
 
-func (x *GetUsageBySubscriptionData) GetPage() int32 {
+func (x *GetUsageSummaryData) GetPeriodStart() int64 {
 	if x != nil {
-		return x.Page
+		return x.PeriodStart
 	}
 	return 0
 }
 
-func (x *GetUsageBySubscriptionData) GetPerPage() int32 {
+func (x *GetUsageSummaryData) GetPeriodEnd() int64 {
 	if x != nil {
-		return x.PerPage
+		return x.PeriodEnd
 	}
 	return 0
 }
 
-type GetUsageBySubscriptionResponse struct {
-	state         protoimpl.MessageState      `protogen:"open.v1"`
-	Success       bool                        `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                      `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Data          *GetUsageBySubscriptionData `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+type GetUsageSummaryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *GetUsageSummaryData   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUsageBySubscriptionResponse) Reset() {
-	*x = GetUsageBySubscriptionResponse{}
-	mi := &file_subscription_proto_msgTypes[27]
+func (x *GetUsageSummaryResponse) Reset() {
+	*x = GetUsageSummaryResponse{}
+	mi := &file_subscription_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUsageBySubscriptionResponse) String() string {
+func (x *GetUsageSummaryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUsageBySubscriptionResponse) ProtoMessage() {}
+func (*GetUsageSummaryResponse) ProtoMessage() {}
 
-func (x *GetUsageBySubscriptionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_subscription_proto_msgTypes[27]
+func (x *GetUsageSummaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2019,26 +2680,26 @@ func (x *GetUsageBySubscriptionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUsageBySubscriptionResponse.ProtoReflect.Descriptor instead.
-func (*GetUsageBySubscriptionResponse) Descriptor() ([]byte, []int) {
-	return file_subscription_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use GetUsageSummaryResponse.ProtoReflect.Descriptor instead.
+func (*GetUsageSummaryResponse) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *GetUsageBySubscriptionResponse) GetSuccess() bool {
+func (x *GetUsageSummaryResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *GetUsageBySubscriptionResponse) GetMessage() string {
+func (x *GetUsageSummaryResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *GetUsageBySubscriptionResponse) GetData() *GetUsageBySubscriptionData {
+func (x *GetUsageSummaryResponse) GetData() *GetUsageSummaryData {
 	if x != nil {
 		return x.Data
 	}
@@ -2062,7 +2723,7 @@ type SubscriptionDiscount struct {
 
 func (x *SubscriptionDiscount) Reset() {
 	*x = SubscriptionDiscount{}
-	mi := &file_subscription_proto_msgTypes[28]
+	mi := &file_subscription_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2074,7 +2735,7 @@ func (x *SubscriptionDiscount) String() string {
 func (*SubscriptionDiscount) ProtoMessage() {}
 
 func (x *SubscriptionDiscount) ProtoReflect() protoreflect.Message {
-	mi := &file_subscription_proto_msgTypes[28]
+	mi := &file_subscription_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2087,7 +2748,7 @@ func (x *SubscriptionDiscount) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubscriptionDiscount.ProtoReflect.Descriptor instead.
 func (*SubscriptionDiscount) Descriptor() ([]byte, []int) {
-	return file_subscription_proto_rawDescGZIP(), []int{28}
+	return file_subscription_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *SubscriptionDiscount) GetId() int64 {
@@ -2167,7 +2828,7 @@ type AddDiscountRequest struct {
 
 func (x *AddDiscountRequest) Reset() {
 	*x = AddDiscountRequest{}
-	mi := &file_subscription_proto_msgTypes[29]
+	mi := &file_subscription_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2179,7 +2840,7 @@ func (x *AddDiscountRequest) String() string {
 func (*AddDiscountRequest) ProtoMessage() {}
 
 func (x *AddDiscountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_subscription_proto_msgTypes[29]
+	mi := &file_subscription_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2192,7 +2853,7 @@ func (x *AddDiscountRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddDiscountRequest.ProtoReflect.Descriptor instead.
 func (*AddDiscountRequest) Descriptor() ([]byte, []int) {
-	return file_subscription_proto_rawDescGZIP(), []int{29}
+	return file_subscription_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *AddDiscountRequest) GetSubscriptionId() int64 {
@@ -2248,7 +2909,7 @@ type AddDiscountResponse struct {
 
 func (x *AddDiscountResponse) Reset() {
 	*x = AddDiscountResponse{}
-	mi := &file_subscription_proto_msgTypes[30]
+	mi := &file_subscription_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2260,7 +2921,7 @@ func (x *AddDiscountResponse) String() string {
 func (*AddDiscountResponse) ProtoMessage() {}
 
 func (x *AddDiscountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_subscription_proto_msgTypes[30]
+	mi := &file_subscription_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2273,7 +2934,7 @@ func (x *AddDiscountResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddDiscountResponse.ProtoReflect.Descriptor instead.
 func (*AddDiscountResponse) Descriptor() ([]byte, []int) {
-	return file_subscription_proto_rawDescGZIP(), []int{30}
+	return file_subscription_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *AddDiscountResponse) GetSuccess() bool {
@@ -2306,7 +2967,7 @@ type RemoveDiscountRequest struct {
 
 func (x *RemoveDiscountRequest) Reset() {
 	*x = RemoveDiscountRequest{}
-	mi := &file_subscription_proto_msgTypes[31]
+	mi := &file_subscription_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2318,7 +2979,7 @@ func (x *RemoveDiscountRequest) String() string {
 func (*RemoveDiscountRequest) ProtoMessage() {}
 
 func (x *RemoveDiscountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_subscription_proto_msgTypes[31]
+	mi := &file_subscription_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2331,7 +2992,7 @@ func (x *RemoveDiscountRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveDiscountRequest.ProtoReflect.Descriptor instead.
 func (*RemoveDiscountRequest) Descriptor() ([]byte, []int) {
-	return file_subscription_proto_rawDescGZIP(), []int{31}
+	return file_subscription_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *RemoveDiscountRequest) GetId() int64 {
@@ -2351,7 +3012,7 @@ type RemoveDiscountResponse struct {
 
 func (x *RemoveDiscountResponse) Reset() {
 	*x = RemoveDiscountResponse{}
-	mi := &file_subscription_proto_msgTypes[32]
+	mi := &file_subscription_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2363,7 +3024,7 @@ func (x *RemoveDiscountResponse) String() string {
 func (*RemoveDiscountResponse) ProtoMessage() {}
 
 func (x *RemoveDiscountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_subscription_proto_msgTypes[32]
+	mi := &file_subscription_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2376,7 +3037,7 @@ func (x *RemoveDiscountResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveDiscountResponse.ProtoReflect.Descriptor instead.
 func (*RemoveDiscountResponse) Descriptor() ([]byte, []int) {
-	return file_subscription_proto_rawDescGZIP(), []int{32}
+	return file_subscription_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *RemoveDiscountResponse) GetSuccess() bool {
@@ -2402,7 +3063,7 @@ type GetDiscountsBySubscriptionRequest struct {
 
 func (x *GetDiscountsBySubscriptionRequest) Reset() {
 	*x = GetDiscountsBySubscriptionRequest{}
-	mi := &file_subscription_proto_msgTypes[33]
+	mi := &file_subscription_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2414,7 +3075,7 @@ func (x *GetDiscountsBySubscriptionRequest) String() string {
 func (*GetDiscountsBySubscriptionRequest) ProtoMessage() {}
 
 func (x *GetDiscountsBySubscriptionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_subscription_proto_msgTypes[33]
+	mi := &file_subscription_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2427,7 +3088,7 @@ func (x *GetDiscountsBySubscriptionRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetDiscountsBySubscriptionRequest.ProtoReflect.Descriptor instead.
 func (*GetDiscountsBySubscriptionRequest) Descriptor() ([]byte, []int) {
-	return file_subscription_proto_rawDescGZIP(), []int{33}
+	return file_subscription_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *GetDiscountsBySubscriptionRequest) GetSubscriptionId() int64 {
@@ -2448,7 +3109,7 @@ type GetDiscountsBySubscriptionResponse struct {
 
 func (x *GetDiscountsBySubscriptionResponse) Reset() {
 	*x = GetDiscountsBySubscriptionResponse{}
-	mi := &file_subscription_proto_msgTypes[34]
+	mi := &file_subscription_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2460,7 +3121,7 @@ func (x *GetDiscountsBySubscriptionResponse) String() string {
 func (*GetDiscountsBySubscriptionResponse) ProtoMessage() {}
 
 func (x *GetDiscountsBySubscriptionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_subscription_proto_msgTypes[34]
+	mi := &file_subscription_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2473,7 +3134,7 @@ func (x *GetDiscountsBySubscriptionResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetDiscountsBySubscriptionResponse.ProtoReflect.Descriptor instead.
 func (*GetDiscountsBySubscriptionResponse) Descriptor() ([]byte, []int) {
-	return file_subscription_proto_rawDescGZIP(), []int{34}
+	return file_subscription_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *GetDiscountsBySubscriptionResponse) GetSuccess() bool {
@@ -2513,7 +3174,7 @@ type SubscriptionVersion struct {
 
 func (x *SubscriptionVersion) Reset() {
 	*x = SubscriptionVersion{}
-	mi := &file_subscription_proto_msgTypes[35]
+	mi := &file_subscription_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2525,7 +3186,7 @@ func (x *SubscriptionVersion) String() string {
 func (*SubscriptionVersion) ProtoMessage() {}
 
 func (x *SubscriptionVersion) ProtoReflect() protoreflect.Message {
-	mi := &file_subscription_proto_msgTypes[35]
+	mi := &file_subscription_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2538,7 +3199,7 @@ func (x *SubscriptionVersion) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubscriptionVersion.ProtoReflect.Descriptor instead.
 func (*SubscriptionVersion) Descriptor() ([]byte, []int) {
-	return file_subscription_proto_rawDescGZIP(), []int{35}
+	return file_subscription_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *SubscriptionVersion) GetVersionId() int32 {
@@ -2608,7 +3269,7 @@ type GetVersionHistoryRequest struct {
 
 func (x *GetVersionHistoryRequest) Reset() {
 	*x = GetVersionHistoryRequest{}
-	mi := &file_subscription_proto_msgTypes[36]
+	mi := &file_subscription_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2620,7 +3281,7 @@ func (x *GetVersionHistoryRequest) String() string {
 func (*GetVersionHistoryRequest) ProtoMessage() {}
 
 func (x *GetVersionHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_subscription_proto_msgTypes[36]
+	mi := &file_subscription_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2633,7 +3294,7 @@ func (x *GetVersionHistoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetVersionHistoryRequest.ProtoReflect.Descriptor instead.
 func (*GetVersionHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_subscription_proto_rawDescGZIP(), []int{36}
+	return file_subscription_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *GetVersionHistoryRequest) GetSubscriptionId() int64 {
@@ -2669,7 +3330,7 @@ type GetVersionHistoryData struct {
 
 func (x *GetVersionHistoryData) Reset() {
 	*x = GetVersionHistoryData{}
-	mi := &file_subscription_proto_msgTypes[37]
+	mi := &file_subscription_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2681,7 +3342,7 @@ func (x *GetVersionHistoryData) String() string {
 func (*GetVersionHistoryData) ProtoMessage() {}
 
 func (x *GetVersionHistoryData) ProtoReflect() protoreflect.Message {
-	mi := &file_subscription_proto_msgTypes[37]
+	mi := &file_subscription_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2694,7 +3355,7 @@ func (x *GetVersionHistoryData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetVersionHistoryData.ProtoReflect.Descriptor instead.
 func (*GetVersionHistoryData) Descriptor() ([]byte, []int) {
-	return file_subscription_proto_rawDescGZIP(), []int{37}
+	return file_subscription_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *GetVersionHistoryData) GetVersions() []*SubscriptionVersion {
@@ -2736,7 +3397,7 @@ type GetVersionHistoryResponse struct {
 
 func (x *GetVersionHistoryResponse) Reset() {
 	*x = GetVersionHistoryResponse{}
-	mi := &file_subscription_proto_msgTypes[38]
+	mi := &file_subscription_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2748,7 +3409,7 @@ func (x *GetVersionHistoryResponse) String() string {
 func (*GetVersionHistoryResponse) ProtoMessage() {}
 
 func (x *GetVersionHistoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_subscription_proto_msgTypes[38]
+	mi := &file_subscription_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2761,7 +3422,7 @@ func (x *GetVersionHistoryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetVersionHistoryResponse.ProtoReflect.Descriptor instead.
 func (*GetVersionHistoryResponse) Descriptor() ([]byte, []int) {
-	return file_subscription_proto_rawDescGZIP(), []int{38}
+	return file_subscription_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *GetVersionHistoryResponse) GetSuccess() bool {
@@ -2785,11 +3446,255 @@ func (x *GetVersionHistoryResponse) GetData() *GetVersionHistoryData {
 	return nil
 }
 
+type CheckEntitlementRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      int64                  `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	FeatureKey    string                 `protobuf:"bytes,2,opt,name=feature_key,json=featureKey,proto3" json:"feature_key,omitempty"`
+	Usage         int64                  `protobuf:"varint,3,opt,name=usage,proto3" json:"usage,omitempty"` // the caller's current count, for limit entitlements
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckEntitlementRequest) Reset() {
+	*x = CheckEntitlementRequest{}
+	mi := &file_subscription_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckEntitlementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckEntitlementRequest) ProtoMessage() {}
+
+func (x *CheckEntitlementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckEntitlementRequest.ProtoReflect.Descriptor instead.
+func (*CheckEntitlementRequest) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *CheckEntitlementRequest) GetTenantId() int64 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *CheckEntitlementRequest) GetFeatureKey() string {
+	if x != nil {
+		return x.FeatureKey
+	}
+	return ""
+}
+
+func (x *CheckEntitlementRequest) GetUsage() int64 {
+	if x != nil {
+		return x.Usage
+	}
+	return 0
+}
+
+type Entitlement struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TenantId       int64                  `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	FeatureKey     string                 `protobuf:"bytes,2,opt,name=feature_key,json=featureKey,proto3" json:"feature_key,omitempty"`
+	Type           string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"` // boolean, limit, quota; empty when the plan lacks the feature
+	Allowed        bool                   `protobuf:"varint,4,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	Unlimited      bool                   `protobuf:"varint,5,opt,name=unlimited,proto3" json:"unlimited,omitempty"`
+	Limit          int64                  `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	Used           int64                  `protobuf:"varint,7,opt,name=used,proto3" json:"used,omitempty"`
+	Remaining      int64                  `protobuf:"varint,8,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	SubscriptionId int64                  `protobuf:"varint,9,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"` // 0 when the tenant has no active subscription
+	PlanId         int64                  `protobuf:"varint,10,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	Reason         string                 `protobuf:"bytes,11,opt,name=reason,proto3" json:"reason,omitempty"` // why the feature is not allowed
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Entitlement) Reset() {
+	*x = Entitlement{}
+	mi := &file_subscription_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Entitlement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Entitlement) ProtoMessage() {}
+
+func (x *Entitlement) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Entitlement.ProtoReflect.Descriptor instead.
+func (*Entitlement) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *Entitlement) GetTenantId() int64 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *Entitlement) GetFeatureKey() string {
+	if x != nil {
+		return x.FeatureKey
+	}
+	return ""
+}
+
+func (x *Entitlement) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Entitlement) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+func (x *Entitlement) GetUnlimited() bool {
+	if x != nil {
+		return x.Unlimited
+	}
+	return false
+}
+
+func (x *Entitlement) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *Entitlement) GetUsed() int64 {
+	if x != nil {
+		return x.Used
+	}
+	return 0
+}
+
+func (x *Entitlement) GetRemaining() int64 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *Entitlement) GetSubscriptionId() int64 {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return 0
+}
+
+func (x *Entitlement) GetPlanId() int64 {
+	if x != nil {
+		return x.PlanId
+	}
+	return 0
+}
+
+func (x *Entitlement) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CheckEntitlementResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *Entitlement           `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckEntitlementResponse) Reset() {
+	*x = CheckEntitlementResponse{}
+	mi := &file_subscription_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckEntitlementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckEntitlementResponse) ProtoMessage() {}
+
+func (x *CheckEntitlementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_subscription_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckEntitlementResponse.ProtoReflect.Descriptor instead.
+func (*CheckEntitlementResponse) Descriptor() ([]byte, []int) {
+	return file_subscription_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *CheckEntitlementResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CheckEntitlementResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CheckEntitlementResponse) GetData() *Entitlement {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
 var File_subscription_proto protoreflect.FileDescriptor
 
 const file_subscription_proto_rawDesc = "" +
 	"\n" +
-	"\x12subscription.proto\x12\fsubscription\"\xa4\b\n" +
+	"\x12subscription.proto\x12\fsubscription\"\xff\b\n" +
 	"\fSubscription\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04uuid\x18\x02 \x01(\tR\x04uuid\x12\x17\n" +
@@ -2826,7 +3731,9 @@ const file_subscription_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x1c \x01(\x03R\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\x1d \x01(\x03R\tupdatedAt\",\n" +
+	"updated_at\x18\x1d \x01(\x03R\tupdatedAt\x12*\n" +
+	"\x11scheduled_plan_id\x18\x1e \x01(\x03R\x0fscheduledPlanId\x12-\n" +
+	"\x12scheduled_quantity\x18\x1f \x01(\x05R\x11scheduledQuantity\",\n" +
 	"\x1aGetSubscriptionByIDRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\"\x81\x01\n" +
 	"\x1bGetSubscriptionByIDResponse\x12\x18\n" +
@@ -2904,6 +3811,13 @@ const file_subscription_proto_rawDesc = "" +
 	"\x1aCancelSubscriptionResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12.\n" +
+	"\x04data\x18\x03 \x01(\v2\x1a.subscription.SubscriptionR\x04data\"B\n" +
+	"\x18PauseSubscriptionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\x7f\n" +
+	"\x19PauseSubscriptionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12.\n" +
 	"\x04data\x18\x03 \x01(\v2\x1a.subscription.SubscriptionR\x04data\"+\n" +
 	"\x19ResumeSubscriptionRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\"\x80\x01\n" +
@@ -2930,7 +3844,25 @@ const file_subscription_proto_rawDesc = "" +
 	"\x1bGetAllSubscriptionsResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x129\n" +
-	"\x04data\x18\x03 \x01(\v2%.subscription.GetAllSubscriptionsDataR\x04data\"\xa9\x01\n" +
+	"\x04data\x18\x03 \x01(\v2%.subscription.GetAllSubscriptionsDataR\x04data\"l\n" +
+	"\x11ChangePlanRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\aplan_id\x18\x02 \x01(\x03R\x06planId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12\x12\n" +
+	"\x04mode\x18\x04 \x01(\tR\x04mode\"\xd4\x01\n" +
+	"\tProration\x12#\n" +
+	"\rcredit_amount\x18\x01 \x01(\x03R\fcreditAmount\x12#\n" +
+	"\rcharge_amount\x18\x02 \x01(\x03R\fchargeAmount\x12\x1d\n" +
+	"\n" +
+	"net_amount\x18\x03 \x01(\x03R\tnetAmount\x12'\n" +
+	"\x0fremaining_ratio\x18\x04 \x01(\x01R\x0eremainingRatio\x12\x12\n" +
+	"\x04mode\x18\x05 \x01(\tR\x04mode\x12!\n" +
+	"\feffective_at\x18\x06 \x01(\x03R\veffectiveAt\"\xaf\x01\n" +
+	"\x12ChangePlanResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12.\n" +
+	"\x04data\x18\x03 \x01(\v2\x1a.subscription.SubscriptionR\x04data\x125\n" +
+	"\tproration\x18\x04 \x01(\v2\x17.subscription.ProrationR\tproration\"\x8e\x02\n" +
 	"\x11SubscriptionUsage\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12'\n" +
 	"\x0fsubscription_id\x18\x02 \x01(\x03R\x0esubscriptionId\x12\x1d\n" +
@@ -2939,15 +3871,22 @@ const file_subscription_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x04 \x01(\x03R\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\x05 \x01(\x03R\tupdatedAt\"\\\n" +
+	"updated_at\x18\x05 \x01(\x03R\tupdatedAt\x12\x19\n" +
+	"\bmeter_id\x18\x06 \x01(\x03R\ameterId\x12'\n" +
+	"\x0fidempotency_key\x18\a \x01(\tR\x0eidempotencyKey\x12\x1f\n" +
+	"\vrecorded_at\x18\b \x01(\x03R\n" +
+	"recordedAt\"\xa3\x01\n" +
 	"\x12RecordUsageRequest\x12'\n" +
 	"\x0fsubscription_id\x18\x01 \x01(\x03R\x0esubscriptionId\x12\x1d\n" +
 	"\n" +
-	"unit_count\x18\x02 \x01(\x05R\tunitCount\"~\n" +
+	"unit_count\x18\x02 \x01(\x05R\tunitCount\x12'\n" +
+	"\x0fidempotency_key\x18\x03 \x01(\tR\x0eidempotencyKey\x12\x1c\n" +
+	"\ttimestamp\x18\x04 \x01(\x03R\ttimestamp\"\x9c\x01\n" +
 	"\x13RecordUsageResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x123\n" +
-	"\x04data\x18\x03 \x01(\v2\x1f.subscription.SubscriptionUsageR\x04data\"w\n" +
+	"\x04data\x18\x03 \x01(\v2\x1f.subscription.SubscriptionUsageR\x04data\x12\x1c\n" +
+	"\tduplicate\x18\x04 \x01(\bR\tduplicate\"w\n" +
 	"\x1dGetUsageBySubscriptionRequest\x12'\n" +
 	"\x0fsubscription_id\x18\x01 \x01(\x03R\x0esubscriptionId\x12\x12\n" +
 	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x19\n" +
@@ -2960,7 +3899,29 @@ const file_subscription_proto_rawDesc = "" +
 	"\x1eGetUsageBySubscriptionResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12<\n" +
-	"\x04data\x18\x03 \x01(\v2(.subscription.GetUsageBySubscriptionDataR\x04data\"\x9e\x02\n" +
+	"\x04data\x18\x03 \x01(\v2(.subscription.GetUsageBySubscriptionDataR\x04data\"\xd3\x01\n" +
+	"\fUsageSummary\x12\x19\n" +
+	"\bmeter_id\x18\x01 \x01(\x03R\ameterId\x12\x1d\n" +
+	"\n" +
+	"meter_name\x18\x02 \x01(\tR\tmeterName\x12 \n" +
+	"\vaggregation\x18\x03 \x01(\tR\vaggregation\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x03R\bquantity\x12!\n" +
+	"\frecord_count\x18\x05 \x01(\x03R\vrecordCount\x12(\n" +
+	"\x10last_recorded_at\x18\x06 \x01(\x03R\x0elastRecordedAt\"\x83\x01\n" +
+	"\x16GetUsageSummaryRequest\x12'\n" +
+	"\x0fsubscription_id\x18\x01 \x01(\x03R\x0esubscriptionId\x12!\n" +
+	"\fperiod_start\x18\x02 \x01(\x03R\vperiodStart\x12\x1d\n" +
+	"\n" +
+	"period_end\x18\x03 \x01(\x03R\tperiodEnd\"\x8b\x01\n" +
+	"\x13GetUsageSummaryData\x122\n" +
+	"\x06meters\x18\x01 \x03(\v2\x1a.subscription.UsageSummaryR\x06meters\x12!\n" +
+	"\fperiod_start\x18\x02 \x01(\x03R\vperiodStart\x12\x1d\n" +
+	"\n" +
+	"period_end\x18\x03 \x01(\x03R\tperiodEnd\"\x84\x01\n" +
+	"\x17GetUsageSummaryResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x125\n" +
+	"\x04data\x18\x03 \x01(\v2!.subscription.GetUsageSummaryDataR\x04data\"\x9e\x02\n" +
 	"\x14SubscriptionDiscount\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12'\n" +
 	"\x0fsubscription_id\x18\x02 \x01(\x03R\x0esubscriptionId\x12\x1f\n" +
@@ -3024,7 +3985,30 @@ const file_subscription_proto_rawDesc = "" +
 	"\x19GetVersionHistoryResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x127\n" +
-	"\x04data\x18\x03 \x01(\v2#.subscription.GetVersionHistoryDataR\x04data2\xdb\r\n" +
+	"\x04data\x18\x03 \x01(\v2#.subscription.GetVersionHistoryDataR\x04data\"m\n" +
+	"\x17CheckEntitlementRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\x03R\btenantId\x12\x1f\n" +
+	"\vfeature_key\x18\x02 \x01(\tR\n" +
+	"featureKey\x12\x14\n" +
+	"\x05usage\x18\x03 \x01(\x03R\x05usage\"\xb9\x02\n" +
+	"\vEntitlement\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\x03R\btenantId\x12\x1f\n" +
+	"\vfeature_key\x18\x02 \x01(\tR\n" +
+	"featureKey\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x18\n" +
+	"\aallowed\x18\x04 \x01(\bR\aallowed\x12\x1c\n" +
+	"\tunlimited\x18\x05 \x01(\bR\tunlimited\x12\x14\n" +
+	"\x05limit\x18\x06 \x01(\x03R\x05limit\x12\x12\n" +
+	"\x04used\x18\a \x01(\x03R\x04used\x12\x1c\n" +
+	"\tremaining\x18\b \x01(\x03R\tremaining\x12'\n" +
+	"\x0fsubscription_id\x18\t \x01(\x03R\x0esubscriptionId\x12\x17\n" +
+	"\aplan_id\x18\n" +
+	" \x01(\x03R\x06planId\x12\x16\n" +
+	"\x06reason\x18\v \x01(\tR\x06reason\"}\n" +
+	"\x18CheckEntitlementResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12-\n" +
+	"\x04data\x18\x03 \x01(\v2\x19.subscription.EntitlementR\x04data2\xdd\x10\n" +
 	"\x13SubscriptionService\x12l\n" +
 	"\x13GetSubscriptionByID\x12(.subscription.GetSubscriptionByIDRequest\x1a).subscription.GetSubscriptionByIDResponse\"\x00\x12r\n" +
 	"\x15GetSubscriptionByUUID\x12*.subscription.GetSubscriptionByUUIDRequest\x1a+.subscription.GetSubscriptionByUUIDResponse\"\x00\x12u\n" +
@@ -3032,16 +4016,21 @@ const file_subscription_proto_rawDesc = "" +
 	"\x18GetSubscriptionsByTenant\x12-.subscription.GetSubscriptionsByTenantRequest\x1a..subscription.GetSubscriptionsByTenantResponse\"\x00\x12i\n" +
 	"\x12CreateSubscription\x12'.subscription.CreateSubscriptionRequest\x1a(.subscription.CreateSubscriptionResponse\"\x00\x12i\n" +
 	"\x12UpdateSubscription\x12'.subscription.UpdateSubscriptionRequest\x1a(.subscription.UpdateSubscriptionResponse\"\x00\x12i\n" +
-	"\x12CancelSubscription\x12'.subscription.CancelSubscriptionRequest\x1a(.subscription.CancelSubscriptionResponse\"\x00\x12i\n" +
+	"\x12CancelSubscription\x12'.subscription.CancelSubscriptionRequest\x1a(.subscription.CancelSubscriptionResponse\"\x00\x12f\n" +
+	"\x11PauseSubscription\x12&.subscription.PauseSubscriptionRequest\x1a'.subscription.PauseSubscriptionResponse\"\x00\x12i\n" +
 	"\x12ResumeSubscription\x12'.subscription.ResumeSubscriptionRequest\x1a(.subscription.ResumeSubscriptionResponse\"\x00\x12f\n" +
 	"\x11RenewSubscription\x12&.subscription.RenewSubscriptionRequest\x1a'.subscription.RenewSubscriptionResponse\"\x00\x12l\n" +
-	"\x13GetAllSubscriptions\x12(.subscription.GetAllSubscriptionsRequest\x1a).subscription.GetAllSubscriptionsResponse\"\x00\x12T\n" +
+	"\x13GetAllSubscriptions\x12(.subscription.GetAllSubscriptionsRequest\x1a).subscription.GetAllSubscriptionsResponse\"\x00\x12Q\n" +
+	"\n" +
+	"ChangePlan\x12\x1f.subscription.ChangePlanRequest\x1a .subscription.ChangePlanResponse\"\x00\x12T\n" +
 	"\vRecordUsage\x12 .subscription.RecordUsageRequest\x1a!.subscription.RecordUsageResponse\"\x00\x12u\n" +
-	"\x16GetUsageBySubscription\x12+.subscription.GetUsageBySubscriptionRequest\x1a,.subscription.GetUsageBySubscriptionResponse\"\x00\x12T\n" +
+	"\x16GetUsageBySubscription\x12+.subscription.GetUsageBySubscriptionRequest\x1a,.subscription.GetUsageBySubscriptionResponse\"\x00\x12`\n" +
+	"\x0fGetUsageSummary\x12$.subscription.GetUsageSummaryRequest\x1a%.subscription.GetUsageSummaryResponse\"\x00\x12T\n" +
 	"\vAddDiscount\x12 .subscription.AddDiscountRequest\x1a!.subscription.AddDiscountResponse\"\x00\x12]\n" +
 	"\x0eRemoveDiscount\x12#.subscription.RemoveDiscountRequest\x1a$.subscription.RemoveDiscountResponse\"\x00\x12\x81\x01\n" +
 	"\x1aGetDiscountsBySubscription\x12/.subscription.GetDiscountsBySubscriptionRequest\x1a0.subscription.GetDiscountsBySubscriptionResponse\"\x00\x12f\n" +
-	"\x11GetVersionHistory\x12&.subscription.GetVersionHistoryRequest\x1a'.subscription.GetVersionHistoryResponse\"\x00B(Z&shared/proto/subscription;subscriptionb\x06proto3"
+	"\x11GetVersionHistory\x12&.subscription.GetVersionHistoryRequest\x1a'.subscription.GetVersionHistoryResponse\"\x00\x12c\n" +
+	"\x10CheckEntitlement\x12%.subscription.CheckEntitlementRequest\x1a&.subscription.CheckEntitlementResponse\"\x00B(Z&shared/proto/subscription;subscriptionb\x06proto3"
 
 var (
 	file_subscription_proto_rawDescOnce sync.Once
@@ -3055,7 +4044,7 @@ func file_subscription_proto_rawDescGZIP() []byte {
 	return file_subscription_proto_rawDescData
 }
 
-var file_subscription_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
+var file_subscription_proto_msgTypes = make([]protoimpl.MessageInfo, 51)
 var file_subscription_proto_goTypes = []any{
 	(*Subscription)(nil),                       // 0: subscription.Subscription
 	(*GetSubscriptionByIDRequest)(nil),         // 1: subscription.GetSubscriptionByIDRequest
@@ -3072,87 +4061,113 @@ var file_subscription_proto_goTypes = []any{
 	(*UpdateSubscriptionResponse)(nil),         // 12: subscription.UpdateSubscriptionResponse
 	(*CancelSubscriptionRequest)(nil),          // 13: subscription.CancelSubscriptionRequest
 	(*CancelSubscriptionResponse)(nil),         // 14: subscription.CancelSubscriptionResponse
-	(*ResumeSubscriptionRequest)(nil),          // 15: subscription.ResumeSubscriptionRequest
-	(*ResumeSubscriptionResponse)(nil),         // 16: subscription.ResumeSubscriptionResponse
-	(*RenewSubscriptionRequest)(nil),           // 17: subscription.RenewSubscriptionRequest
-	(*RenewSubscriptionResponse)(nil),          // 18: subscription.RenewSubscriptionResponse
-	(*GetAllSubscriptionsRequest)(nil),         // 19: subscription.GetAllSubscriptionsRequest
-	(*GetAllSubscriptionsData)(nil),            // 20: subscription.GetAllSubscriptionsData
-	(*GetAllSubscriptionsResponse)(nil),        // 21: subscription.GetAllSubscriptionsResponse
-	(*SubscriptionUsage)(nil),                  // 22: subscription.SubscriptionUsage
-	(*RecordUsageRequest)(nil),                 // 23: subscription.RecordUsageRequest
-	(*RecordUsageResponse)(nil),                // 24: subscription.RecordUsageResponse
-	(*GetUsageBySubscriptionRequest)(nil),      // 25: subscription.GetUsageBySubscriptionRequest
-	(*GetUsageBySubscriptionData)(nil),         // 26: subscription.GetUsageBySubscriptionData
-	(*GetUsageBySubscriptionResponse)(nil),     // 27: subscription.GetUsageBySubscriptionResponse
-	(*SubscriptionDiscount)(nil),               // 28: subscription.SubscriptionDiscount
-	(*AddDiscountRequest)(nil),                 // 29: subscription.AddDiscountRequest
-	(*AddDiscountResponse)(nil),                // 30: subscription.AddDiscountResponse
-	(*RemoveDiscountRequest)(nil),              // 31: subscription.RemoveDiscountRequest
-	(*RemoveDiscountResponse)(nil),             // 32: subscription.RemoveDiscountResponse
-	(*GetDiscountsBySubscriptionRequest)(nil),  // 33: subscription.GetDiscountsBySubscriptionRequest
-	(*GetDiscountsBySubscriptionResponse)(nil), // 34: subscription.GetDiscountsBySubscriptionResponse
-	(*SubscriptionVersion)(nil),                // 35: subscription.SubscriptionVersion
-	(*GetVersionHistoryRequest)(nil),           // 36: subscription.GetVersionHistoryRequest
-	(*GetVersionHistoryData)(nil),              // 37: subscription.GetVersionHistoryData
-	(*GetVersionHistoryResponse)(nil),          // 38: subscription.GetVersionHistoryResponse
+	(*PauseSubscriptionRequest)(nil),           // 15: subscription.PauseSubscriptionRequest
+	(*PauseSubscriptionResponse)(nil),          // 16: subscription.PauseSubscriptionResponse
+	(*ResumeSubscriptionRequest)(nil),          // 17: subscription.ResumeSubscriptionRequest
+	(*ResumeSubscriptionResponse)(nil),         // 18: subscription.ResumeSubscriptionResponse
+	(*RenewSubscriptionRequest)(nil),           // 19: subscription.RenewSubscriptionRequest
+	(*RenewSubscriptionResponse)(nil),          // 20: subscription.RenewSubscriptionResponse
+	(*GetAllSubscriptionsRequest)(nil),         // 21: subscription.GetAllSubscriptionsRequest
+	(*GetAllSubscriptionsData)(nil),            // 22: subscription.GetAllSubscriptionsData
+	(*GetAllSubscriptionsResponse)(nil),        // 23: subscription.GetAllSubscriptionsResponse
+	(*ChangePlanRequest)(nil),                  // 24: subscription.ChangePlanRequest
+	(*Proration)(nil),                          // 25: subscription.Proration
+	(*ChangePlanResponse)(nil),                 // 26: subscription.ChangePlanResponse
+	(*SubscriptionUsage)(nil),                  // 27: subscription.SubscriptionUsage
+	(*RecordUsageRequest)(nil),                 // 28: subscription.RecordUsageRequest
+	(*RecordUsageResponse)(nil),                // 29: subscription.RecordUsageResponse
+	(*GetUsageBySubscriptionRequest)(nil),      // 30: subscription.GetUsageBySubscriptionRequest
+	(*GetUsageBySubscriptionData)(nil),         // 31: subscription.GetUsageBySubscriptionData
+	(*GetUsageBySubscriptionResponse)(nil),     // 32: subscription.GetUsageBySubscriptionResponse
+	(*UsageSummary)(nil),                       // 33: subscription.UsageSummary
+	(*GetUsageSummaryRequest)(nil),             // 34: subscription.GetUsageSummaryRequest
+	(*GetUsageSummaryData)(nil),                // 35: subscription.GetUsageSummaryData
+	(*GetUsageSummaryResponse)(nil),            // 36: subscription.GetUsageSummaryResponse
+	(*SubscriptionDiscount)(nil),               // 37: subscription.SubscriptionDiscount
+	(*AddDiscountRequest)(nil),                 // 38: subscription.AddDiscountRequest
+	(*AddDiscountResponse)(nil),                // 39: subscription.AddDiscountResponse
+	(*RemoveDiscountRequest)(nil),              // 40: subscription.RemoveDiscountRequest
+	(*RemoveDiscountResponse)(nil),             // 41: subscription.RemoveDiscountResponse
+	(*GetDiscountsBySubscriptionRequest)(nil),  // 42: subscription.GetDiscountsBySubscriptionRequest
+	(*GetDiscountsBySubscriptionResponse)(nil), // 43: subscription.GetDiscountsBySubscriptionResponse
+	(*SubscriptionVersion)(nil),                // 44: subscription.SubscriptionVersion
+	(*GetVersionHistoryRequest)(nil),           // 45: subscription.GetVersionHistoryRequest
+	(*GetVersionHistoryData)(nil),              // 46: subscription.GetVersionHistoryData
+	(*GetVersionHistoryResponse)(nil),          // 47: subscription.GetVersionHistoryResponse
+	(*CheckEntitlementRequest)(nil),            // 48: subscription.CheckEntitlementRequest
+	(*Entitlement)(nil),                        // 49: subscription.Entitlement
+	(*CheckEntitlementResponse)(nil),           // 50: subscription.CheckEntitlementResponse
 }
 var file_subscription_proto_depIdxs = []int32{
 	0,  // 0: subscription.GetSubscriptionByIDResponse.data:type_name -> subscription.Subscription
 	0,  // 1: subscription.GetSubscriptionByUUIDResponse.data:type_name -> subscription.Subscription
-	20, // 2: subscription.GetSubscriptionsByUserResponse.data:type_name -> subscription.GetAllSubscriptionsData
-	20, // 3: subscription.GetSubscriptionsByTenantResponse.data:type_name -> subscription.GetAllSubscriptionsData
+	22, // 2: subscription.GetSubscriptionsByUserResponse.data:type_name -> subscription.GetAllSubscriptionsData
+	22, // 3: subscription.GetSubscriptionsByTenantResponse.data:type_name -> subscription.GetAllSubscriptionsData
 	0,  // 4: subscription.CreateSubscriptionResponse.data:type_name -> subscription.Subscription
 	0,  // 5: subscription.UpdateSubscriptionResponse.data:type_name -> subscription.Subscription
 	0,  // 6: subscription.CancelSubscriptionResponse.data:type_name -> subscription.Subscription
-	0,  // 7: subscription.ResumeSubscriptionResponse.data:type_name -> subscription.Subscription
-	0,  // 8: subscription.RenewSubscriptionResponse.data:type_name -> subscription.Subscription
-	0,  // 9: subscription.GetAllSubscriptionsData.subscriptions:type_name -> subscription.Subscription
-	20, // 10: subscription.GetAllSubscriptionsResponse.data:type_name -> subscription.GetAllSubscriptionsData
-	22, // 11: subscription.RecordUsageResponse.data:type_name -> subscription.SubscriptionUsage
-	22, // 12: subscription.GetUsageBySubscriptionData.usages:type_name -> subscription.SubscriptionUsage
-	26, // 13: subscription.GetUsageBySubscriptionResponse.data:type_name -> subscription.GetUsageBySubscriptionData
-	28, // 14: subscription.AddDiscountResponse.data:type_name -> subscription.SubscriptionDiscount
-	28, // 15: subscription.GetDiscountsBySubscriptionResponse.data:type_name -> subscription.SubscriptionDiscount
-	35, // 16: subscription.GetVersionHistoryData.versions:type_name -> subscription.SubscriptionVersion
-	37, // 17: subscription.GetVersionHistoryResponse.data:type_name -> subscription.GetVersionHistoryData
-	1,  // 18: subscription.SubscriptionService.GetSubscriptionByID:input_type -> subscription.GetSubscriptionByIDRequest
-	3,  // 19: subscription.SubscriptionService.GetSubscriptionByUUID:input_type -> subscription.GetSubscriptionByUUIDRequest
-	5,  // 20: subscription.SubscriptionService.GetSubscriptionsByUser:input_type -> subscription.GetSubscriptionsByUserRequest
-	7,  // 21: subscription.SubscriptionService.GetSubscriptionsByTenant:input_type -> subscription.GetSubscriptionsByTenantRequest
-	9,  // 22: subscription.SubscriptionService.CreateSubscription:input_type -> subscription.CreateSubscriptionRequest
-	11, // 23: subscription.SubscriptionService.UpdateSubscription:input_type -> subscription.UpdateSubscriptionRequest
-	13, // 24: subscription.SubscriptionService.CancelSubscription:input_type -> subscription.CancelSubscriptionRequest
-	15, // 25: subscription.SubscriptionService.ResumeSubscription:input_type -> subscription.ResumeSubscriptionRequest
-	17, // 26: subscription.SubscriptionService.RenewSubscription:input_type -> subscription.RenewSubscriptionRequest
-	19, // 27: subscription.SubscriptionService.GetAllSubscriptions:input_type -> subscription.GetAllSubscriptionsRequest
-	23, // 28: subscription.SubscriptionService.RecordUsage:input_type -> subscription.RecordUsageRequest
-	25, // 29: subscription.SubscriptionService.GetUsageBySubscription:input_type -> subscription.GetUsageBySubscriptionRequest
-	29, // 30: subscription.SubscriptionService.AddDiscount:input_type -> subscription.AddDiscountRequest
-	31, // 31: subscription.SubscriptionService.RemoveDiscount:input_type -> subscription.RemoveDiscountRequest
-	33, // 32: subscription.SubscriptionService.GetDiscountsBySubscription:input_type -> subscription.GetDiscountsBySubscriptionRequest
-	36, // 33: subscription.SubscriptionService.GetVersionHistory:input_type -> subscription.GetVersionHistoryRequest
-	2,  // 34: subscription.SubscriptionService.GetSubscriptionByID:output_type -> subscription.GetSubscriptionByIDResponse
-	4,  // 35: subscription.SubscriptionService.GetSubscriptionByUUID:output_type -> subscription.GetSubscriptionByUUIDResponse
-	6,  // 36: subscription.SubscriptionService.GetSubscriptionsByUser:output_type -> subscription.GetSubscriptionsByUserResponse
-	8,  // 37: subscription.SubscriptionService.GetSubscriptionsByTenant:output_type -> subscription.GetSubscriptionsByTenantResponse
-	10, // 38: subscription.SubscriptionService.CreateSubscription:output_type -> subscription.CreateSubscriptionResponse
-	12, // 39: subscription.SubscriptionService.UpdateSubscription:output_type -> subscription.UpdateSubscriptionResponse
-	14, // 40: subscription.SubscriptionService.CancelSubscription:output_type -> subscription.CancelSubscriptionResponse
-	16, // 41: subscription.SubscriptionService.ResumeSubscription:output_type -> subscription.ResumeSubscriptionResponse
-	18, // 42: subscription.SubscriptionService.RenewSubscription:output_type -> subscription.RenewSubscriptionResponse
-	21, // 43: subscription.SubscriptionService.GetAllSubscriptions:output_type -> subscription.GetAllSubscriptionsResponse
-	24, // 44: subscription.SubscriptionService.RecordUsage:output_type -> subscription.RecordUsageResponse
-	27, // 45: subscription.SubscriptionService.GetUsageBySubscription:output_type -> subscription.GetUsageBySubscriptionResponse
-	30, // 46: subscription.SubscriptionService.AddDiscount:output_type -> subscription.AddDiscountResponse
-	32, // 47: subscription.SubscriptionService.RemoveDiscount:output_type -> subscription.RemoveDiscountResponse
-	34, // 48: subscription.SubscriptionService.GetDiscountsBySubscription:output_type -> subscription.GetDiscountsBySubscriptionResponse
-	38, // 49: subscription.SubscriptionService.GetVersionHistory:output_type -> subscription.GetVersionHistoryResponse
-	34, // [34:50] is the sub-list for method output_type
-	18, // [18:34] is the sub-list for method input_type
-	18, // [18:18] is the sub-list for extension type_name
-	18, // [18:18] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
+	0,  // 7: subscription.PauseSubscriptionResponse.data:type_name -> subscription.Subscription
+	0,  // 8: subscription.ResumeSubscriptionResponse.data:type_name -> subscription.Subscription
+	0,  // 9: subscription.RenewSubscriptionResponse.data:type_name -> subscription.Subscription
+	0,  // 10: subscription.GetAllSubscriptionsData.subscriptions:type_name -> subscription.Subscription
+	22, // 11: subscription.GetAllSubscriptionsResponse.data:type_name -> subscription.GetAllSubscriptionsData
+	0,  // 12: subscription.ChangePlanResponse.data:type_name -> subscription.Subscription
+	25, // 13: subscription.ChangePlanResponse.proration:type_name -> subscription.Proration
+	27, // 14: subscription.RecordUsageResponse.data:type_name -> subscription.SubscriptionUsage
+	27, // 15: subscription.GetUsageBySubscriptionData.usages:type_name -> subscription.SubscriptionUsage
+	31, // 16: subscription.GetUsageBySubscriptionResponse.data:type_name -> subscription.GetUsageBySubscriptionData
+	33, // 17: subscription.GetUsageSummaryData.meters:type_name -> subscription.UsageSummary
+	35, // 18: subscription.GetUsageSummaryResponse.data:type_name -> subscription.GetUsageSummaryData
+	37, // 19: subscription.AddDiscountResponse.data:type_name -> subscription.SubscriptionDiscount
+	37, // 20: subscription.GetDiscountsBySubscriptionResponse.data:type_name -> subscription.SubscriptionDiscount
+	44, // 21: subscription.GetVersionHistoryData.versions:type_name -> subscription.SubscriptionVersion
+	46, // 22: subscription.GetVersionHistoryResponse.data:type_name -> subscription.GetVersionHistoryData
+	49, // 23: subscription.CheckEntitlementResponse.data:type_name -> subscription.Entitlement
+	1,  // 24: subscription.SubscriptionService.GetSubscriptionByID:input_type -> subscription.GetSubscriptionByIDRequest
+	3,  // 25: subscription.SubscriptionService.GetSubscriptionByUUID:input_type -> subscription.GetSubscriptionByUUIDRequest
+	5,  // 26: subscription.SubscriptionService.GetSubscriptionsByUser:input_type -> subscription.GetSubscriptionsByUserRequest
+	7,  // 27: subscription.SubscriptionService.GetSubscriptionsByTenant:input_type -> subscription.GetSubscriptionsByTenantRequest
+	9,  // 28: subscription.SubscriptionService.CreateSubscription:input_type -> subscription.CreateSubscriptionRequest
+	11, // 29: subscription.SubscriptionService.UpdateSubscription:input_type -> subscription.UpdateSubscriptionRequest
+	13, // 30: subscription.SubscriptionService.CancelSubscription:input_type -> subscription.CancelSubscriptionRequest
+	15, // 31: subscription.SubscriptionService.PauseSubscription:input_type -> subscription.PauseSubscriptionRequest
+	17, // 32: subscription.SubscriptionService.ResumeSubscription:input_type -> subscription.ResumeSubscriptionRequest
+	19, // 33: subscription.SubscriptionService.RenewSubscription:input_type -> subscription.RenewSubscriptionRequest
+	21, // 34: subscription.SubscriptionService.GetAllSubscriptions:input_type -> subscription.GetAllSubscriptionsRequest
+	24, // 35: subscription.SubscriptionService.ChangePlan:input_type -> subscription.ChangePlanRequest
+	28, // 36: subscription.SubscriptionService.RecordUsage:input_type -> subscription.RecordUsageRequest
+	30, // 37: subscription.SubscriptionService.GetUsageBySubscription:input_type -> subscription.GetUsageBySubscriptionRequest
+	34, // 38: subscription.SubscriptionService.GetUsageSummary:input_type -> subscription.GetUsageSummaryRequest
+	38, // 39: subscription.SubscriptionService.AddDiscount:input_type -> subscription.AddDiscountRequest
+	40, // 40: subscription.SubscriptionService.RemoveDiscount:input_type -> subscription.RemoveDiscountRequest
+	42, // 41: subscription.SubscriptionService.GetDiscountsBySubscription:input_type -> subscription.GetDiscountsBySubscriptionRequest
+	45, // 42: subscription.SubscriptionService.GetVersionHistory:input_type -> subscription.GetVersionHistoryRequest
+	48, // 43: subscription.SubscriptionService.CheckEntitlement:input_type -> subscription.CheckEntitlementRequest
+	2,  // 44: subscription.SubscriptionService.GetSubscriptionByID:output_type -> subscription.GetSubscriptionByIDResponse
+	4,  // 45: subscription.SubscriptionService.GetSubscriptionByUUID:output_type -> subscription.GetSubscriptionByUUIDResponse
+	6,  // 46: subscription.SubscriptionService.GetSubscriptionsByUser:output_type -> subscription.GetSubscriptionsByUserResponse
+	8,  // 47: subscription.SubscriptionService.GetSubscriptionsByTenant:output_type -> subscription.GetSubscriptionsByTenantResponse
+	10, // 48: subscription.SubscriptionService.CreateSubscription:output_type -> subscription.CreateSubscriptionResponse
+	12, // 49: subscription.SubscriptionService.UpdateSubscription:output_type -> subscription.UpdateSubscriptionResponse
+	14, // 50: subscription.SubscriptionService.CancelSubscription:output_type -> subscription.CancelSubscriptionResponse
+	16, // 51: subscription.SubscriptionService.PauseSubscription:output_type -> subscription.PauseSubscriptionResponse
+	18, // 52: subscription.SubscriptionService.ResumeSubscription:output_type -> subscription.ResumeSubscriptionResponse
+	20, // 53: subscription.SubscriptionService.RenewSubscription:output_type -> subscription.RenewSubscriptionResponse
+	23, // 54: subscription.SubscriptionService.GetAllSubscriptions:output_type -> subscription.GetAllSubscriptionsResponse
+	26, // 55: subscription.SubscriptionService.ChangePlan:output_type -> subscription.ChangePlanResponse
+	29, // 56: subscription.SubscriptionService.RecordUsage:output_type -> subscription.RecordUsageResponse
+	32, // 57: subscription.SubscriptionService.GetUsageBySubscription:output_type -> subscription.GetUsageBySubscriptionResponse
+	36, // 58: subscription.SubscriptionService.GetUsageSummary:output_type -> subscription.GetUsageSummaryResponse
+	39, // 59: subscription.SubscriptionService.AddDiscount:output_type -> subscription.AddDiscountResponse
+	41, // 60: subscription.SubscriptionService.RemoveDiscount:output_type -> subscription.RemoveDiscountResponse
+	43, // 61: subscription.SubscriptionService.GetDiscountsBySubscription:output_type -> subscription.GetDiscountsBySubscriptionResponse
+	47, // 62: subscription.SubscriptionService.GetVersionHistory:output_type -> subscription.GetVersionHistoryResponse
+	50, // 63: subscription.SubscriptionService.CheckEntitlement:output_type -> subscription.CheckEntitlementResponse
+	44, // [44:64] is the sub-list for method output_type
+	24, // [24:44] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
 }
 
 func init() { file_subscription_proto_init() }
@@ -3166,7 +4181,7 @@ func file_subscription_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_subscription_proto_rawDesc), len(file_subscription_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   39,
+			NumMessages:   51,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
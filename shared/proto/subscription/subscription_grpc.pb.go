@@ -26,15 +26,19 @@ const (
 	SubscriptionService_CreateSubscription_FullMethodName         = "/subscription.SubscriptionService/CreateSubscription"
 	SubscriptionService_UpdateSubscription_FullMethodName         = "/subscription.SubscriptionService/UpdateSubscription"
 	SubscriptionService_CancelSubscription_FullMethodName         = "/subscription.SubscriptionService/CancelSubscription"
+	SubscriptionService_PauseSubscription_FullMethodName          = "/subscription.SubscriptionService/PauseSubscription"
 	SubscriptionService_ResumeSubscription_FullMethodName         = "/subscription.SubscriptionService/ResumeSubscription"
 	SubscriptionService_RenewSubscription_FullMethodName          = "/subscription.SubscriptionService/RenewSubscription"
 	SubscriptionService_GetAllSubscriptions_FullMethodName        = "/subscription.SubscriptionService/GetAllSubscriptions"
+	SubscriptionService_ChangePlan_FullMethodName                 = "/subscription.SubscriptionService/ChangePlan"
 	SubscriptionService_RecordUsage_FullMethodName                = "/subscription.SubscriptionService/RecordUsage"
 	SubscriptionService_GetUsageBySubscription_FullMethodName     = "/subscription.SubscriptionService/GetUsageBySubscription"
+	SubscriptionService_GetUsageSummary_FullMethodName            = "/subscription.SubscriptionService/GetUsageSummary"
 	SubscriptionService_AddDiscount_FullMethodName                = "/subscription.SubscriptionService/AddDiscount"
 	SubscriptionService_RemoveDiscount_FullMethodName             = "/subscription.SubscriptionService/RemoveDiscount"
 	SubscriptionService_GetDiscountsBySubscription_FullMethodName = "/subscription.SubscriptionService/GetDiscountsBySubscription"
 	SubscriptionService_GetVersionHistory_FullMethodName          = "/subscription.SubscriptionService/GetVersionHistory"
+	SubscriptionService_CheckEntitlement_FullMethodName           = "/subscription.SubscriptionService/CheckEntitlement"
 )
 
 // SubscriptionServiceClient is the client API for SubscriptionService service.
@@ -49,18 +53,23 @@ type SubscriptionServiceClient interface {
 	CreateSubscription(ctx context.Context, in *CreateSubscriptionRequest, opts ...grpc.CallOption) (*CreateSubscriptionResponse, error)
 	UpdateSubscription(ctx context.Context, in *UpdateSubscriptionRequest, opts ...grpc.CallOption) (*UpdateSubscriptionResponse, error)
 	CancelSubscription(ctx context.Context, in *CancelSubscriptionRequest, opts ...grpc.CallOption) (*CancelSubscriptionResponse, error)
+	PauseSubscription(ctx context.Context, in *PauseSubscriptionRequest, opts ...grpc.CallOption) (*PauseSubscriptionResponse, error)
 	ResumeSubscription(ctx context.Context, in *ResumeSubscriptionRequest, opts ...grpc.CallOption) (*ResumeSubscriptionResponse, error)
 	RenewSubscription(ctx context.Context, in *RenewSubscriptionRequest, opts ...grpc.CallOption) (*RenewSubscriptionResponse, error)
 	GetAllSubscriptions(ctx context.Context, in *GetAllSubscriptionsRequest, opts ...grpc.CallOption) (*GetAllSubscriptionsResponse, error)
+	ChangePlan(ctx context.Context, in *ChangePlanRequest, opts ...grpc.CallOption) (*ChangePlanResponse, error)
 	// Subscription Usage operations
 	RecordUsage(ctx context.Context, in *RecordUsageRequest, opts ...grpc.CallOption) (*RecordUsageResponse, error)
 	GetUsageBySubscription(ctx context.Context, in *GetUsageBySubscriptionRequest, opts ...grpc.CallOption) (*GetUsageBySubscriptionResponse, error)
+	GetUsageSummary(ctx context.Context, in *GetUsageSummaryRequest, opts ...grpc.CallOption) (*GetUsageSummaryResponse, error)
 	// Subscription Discount operations
 	AddDiscount(ctx context.Context, in *AddDiscountRequest, opts ...grpc.CallOption) (*AddDiscountResponse, error)
 	RemoveDiscount(ctx context.Context, in *RemoveDiscountRequest, opts ...grpc.CallOption) (*RemoveDiscountResponse, error)
 	GetDiscountsBySubscription(ctx context.Context, in *GetDiscountsBySubscriptionRequest, opts ...grpc.CallOption) (*GetDiscountsBySubscriptionResponse, error)
 	// Subscription Version operations (audit trail)
 	GetVersionHistory(ctx context.Context, in *GetVersionHistoryRequest, opts ...grpc.CallOption) (*GetVersionHistoryResponse, error)
+	// Entitlement operations
+	CheckEntitlement(ctx context.Context, in *CheckEntitlementRequest, opts ...grpc.CallOption) (*CheckEntitlementResponse, error)
 }
 
 type subscriptionServiceClient struct {
@@ -141,6 +150,16 @@ func (c *subscriptionServiceClient) CancelSubscription(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *subscriptionServiceClient) PauseSubscription(ctx context.Context, in *PauseSubscriptionRequest, opts ...grpc.CallOption) (*PauseSubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PauseSubscriptionResponse)
+	err := c.cc.Invoke(ctx, SubscriptionService_PauseSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *subscriptionServiceClient) ResumeSubscription(ctx context.Context, in *ResumeSubscriptionRequest, opts ...grpc.CallOption) (*ResumeSubscriptionResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ResumeSubscriptionResponse)
@@ -171,6 +190,16 @@ func (c *subscriptionServiceClient) GetAllSubscriptions(ctx context.Context, in
 	return out, nil
 }
 
+func (c *subscriptionServiceClient) ChangePlan(ctx context.Context, in *ChangePlanRequest, opts ...grpc.CallOption) (*ChangePlanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangePlanResponse)
+	err := c.cc.Invoke(ctx, SubscriptionService_ChangePlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *subscriptionServiceClient) RecordUsage(ctx context.Context, in *RecordUsageRequest, opts ...grpc.CallOption) (*RecordUsageResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(RecordUsageResponse)
@@ -191,6 +220,16 @@ func (c *subscriptionServiceClient) GetUsageBySubscription(ctx context.Context,
 	return out, nil
 }
 
+func (c *subscriptionServiceClient) GetUsageSummary(ctx context.Context, in *GetUsageSummaryRequest, opts ...grpc.CallOption) (*GetUsageSummaryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUsageSummaryResponse)
+	err := c.cc.Invoke(ctx, SubscriptionService_GetUsageSummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *subscriptionServiceClient) AddDiscount(ctx context.Context, in *AddDiscountRequest, opts ...grpc.CallOption) (*AddDiscountResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AddDiscountResponse)
@@ -231,6 +270,16 @@ func (c *subscriptionServiceClient) GetVersionHistory(ctx context.Context, in *G
 	return out, nil
 }
 
+func (c *subscriptionServiceClient) CheckEntitlement(ctx context.Context, in *CheckEntitlementRequest, opts ...grpc.CallOption) (*CheckEntitlementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckEntitlementResponse)
+	err := c.cc.Invoke(ctx, SubscriptionService_CheckEntitlement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SubscriptionServiceServer is the server API for SubscriptionService service.
 // All implementations must embed UnimplementedSubscriptionServiceServer
 // for forward compatibility.
@@ -243,18 +292,23 @@ type SubscriptionServiceServer interface {
 	CreateSubscription(context.Context, *CreateSubscriptionRequest) (*CreateSubscriptionResponse, error)
 	UpdateSubscription(context.Context, *UpdateSubscriptionRequest) (*UpdateSubscriptionResponse, error)
 	CancelSubscription(context.Context, *CancelSubscriptionRequest) (*CancelSubscriptionResponse, error)
+	PauseSubscription(context.Context, *PauseSubscriptionRequest) (*PauseSubscriptionResponse, error)
 	ResumeSubscription(context.Context, *ResumeSubscriptionRequest) (*ResumeSubscriptionResponse, error)
 	RenewSubscription(context.Context, *RenewSubscriptionRequest) (*RenewSubscriptionResponse, error)
 	GetAllSubscriptions(context.Context, *GetAllSubscriptionsRequest) (*GetAllSubscriptionsResponse, error)
+	ChangePlan(context.Context, *ChangePlanRequest) (*ChangePlanResponse, error)
 	// Subscription Usage operations
 	RecordUsage(context.Context, *RecordUsageRequest) (*RecordUsageResponse, error)
 	GetUsageBySubscription(context.Context, *GetUsageBySubscriptionRequest) (*GetUsageBySubscriptionResponse, error)
+	GetUsageSummary(context.Context, *GetUsageSummaryRequest) (*GetUsageSummaryResponse, error)
 	// Subscription Discount operations
 	AddDiscount(context.Context, *AddDiscountRequest) (*AddDiscountResponse, error)
 	RemoveDiscount(context.Context, *RemoveDiscountRequest) (*RemoveDiscountResponse, error)
 	GetDiscountsBySubscription(context.Context, *GetDiscountsBySubscriptionRequest) (*GetDiscountsBySubscriptionResponse, error)
 	// Subscription Version operations (audit trail)
 	GetVersionHistory(context.Context, *GetVersionHistoryRequest) (*GetVersionHistoryResponse, error)
+	// Entitlement operations
+	CheckEntitlement(context.Context, *CheckEntitlementRequest) (*CheckEntitlementResponse, error)
 	mustEmbedUnimplementedSubscriptionServiceServer()
 }
 
@@ -286,6 +340,9 @@ func (UnimplementedSubscriptionServiceServer) UpdateSubscription(context.Context
 func (UnimplementedSubscriptionServiceServer) CancelSubscription(context.Context, *CancelSubscriptionRequest) (*CancelSubscriptionResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CancelSubscription not implemented")
 }
+func (UnimplementedSubscriptionServiceServer) PauseSubscription(context.Context, *PauseSubscriptionRequest) (*PauseSubscriptionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PauseSubscription not implemented")
+}
 func (UnimplementedSubscriptionServiceServer) ResumeSubscription(context.Context, *ResumeSubscriptionRequest) (*ResumeSubscriptionResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ResumeSubscription not implemented")
 }
@@ -295,12 +352,18 @@ func (UnimplementedSubscriptionServiceServer) RenewSubscription(context.Context,
 func (UnimplementedSubscriptionServiceServer) GetAllSubscriptions(context.Context, *GetAllSubscriptionsRequest) (*GetAllSubscriptionsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetAllSubscriptions not implemented")
 }
+func (UnimplementedSubscriptionServiceServer) ChangePlan(context.Context, *ChangePlanRequest) (*ChangePlanResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ChangePlan not implemented")
+}
 func (UnimplementedSubscriptionServiceServer) RecordUsage(context.Context, *RecordUsageRequest) (*RecordUsageResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method RecordUsage not implemented")
 }
 func (UnimplementedSubscriptionServiceServer) GetUsageBySubscription(context.Context, *GetUsageBySubscriptionRequest) (*GetUsageBySubscriptionResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetUsageBySubscription not implemented")
 }
+func (UnimplementedSubscriptionServiceServer) GetUsageSummary(context.Context, *GetUsageSummaryRequest) (*GetUsageSummaryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUsageSummary not implemented")
+}
 func (UnimplementedSubscriptionServiceServer) AddDiscount(context.Context, *AddDiscountRequest) (*AddDiscountResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method AddDiscount not implemented")
 }
@@ -313,6 +376,9 @@ func (UnimplementedSubscriptionServiceServer) GetDiscountsBySubscription(context
 func (UnimplementedSubscriptionServiceServer) GetVersionHistory(context.Context, *GetVersionHistoryRequest) (*GetVersionHistoryResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetVersionHistory not implemented")
 }
+func (UnimplementedSubscriptionServiceServer) CheckEntitlement(context.Context, *CheckEntitlementRequest) (*CheckEntitlementResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CheckEntitlement not implemented")
+}
 func (UnimplementedSubscriptionServiceServer) mustEmbedUnimplementedSubscriptionServiceServer() {}
 func (UnimplementedSubscriptionServiceServer) testEmbeddedByValue()                             {}
 
@@ -460,6 +526,24 @@ func _SubscriptionService_CancelSubscription_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SubscriptionService_PauseSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionServiceServer).PauseSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubscriptionService_PauseSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionServiceServer).PauseSubscription(ctx, req.(*PauseSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SubscriptionService_ResumeSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ResumeSubscriptionRequest)
 	if err := dec(in); err != nil {
@@ -514,6 +598,24 @@ func _SubscriptionService_GetAllSubscriptions_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SubscriptionService_ChangePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionServiceServer).ChangePlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubscriptionService_ChangePlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionServiceServer).ChangePlan(ctx, req.(*ChangePlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SubscriptionService_RecordUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RecordUsageRequest)
 	if err := dec(in); err != nil {
@@ -550,6 +652,24 @@ func _SubscriptionService_GetUsageBySubscription_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SubscriptionService_GetUsageSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsageSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionServiceServer).GetUsageSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubscriptionService_GetUsageSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionServiceServer).GetUsageSummary(ctx, req.(*GetUsageSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SubscriptionService_AddDiscount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddDiscountRequest)
 	if err := dec(in); err != nil {
@@ -622,6 +742,24 @@ func _SubscriptionService_GetVersionHistory_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SubscriptionService_CheckEntitlement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckEntitlementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionServiceServer).CheckEntitlement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubscriptionService_CheckEntitlement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionServiceServer).CheckEntitlement(ctx, req.(*CheckEntitlementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SubscriptionService_ServiceDesc is the grpc.ServiceDesc for SubscriptionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -657,6 +795,10 @@ var SubscriptionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelSubscription",
 			Handler:    _SubscriptionService_CancelSubscription_Handler,
 		},
+		{
+			MethodName: "PauseSubscription",
+			Handler:    _SubscriptionService_PauseSubscription_Handler,
+		},
 		{
 			MethodName: "ResumeSubscription",
 			Handler:    _SubscriptionService_ResumeSubscription_Handler,
@@ -669,6 +811,10 @@ var SubscriptionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAllSubscriptions",
 			Handler:    _SubscriptionService_GetAllSubscriptions_Handler,
 		},
+		{
+			MethodName: "ChangePlan",
+			Handler:    _SubscriptionService_ChangePlan_Handler,
+		},
 		{
 			MethodName: "RecordUsage",
 			Handler:    _SubscriptionService_RecordUsage_Handler,
@@ -677,6 +823,10 @@ var SubscriptionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUsageBySubscription",
 			Handler:    _SubscriptionService_GetUsageBySubscription_Handler,
 		},
+		{
+			MethodName: "GetUsageSummary",
+			Handler:    _SubscriptionService_GetUsageSummary_Handler,
+		},
 		{
 			MethodName: "AddDiscount",
 			Handler:    _SubscriptionService_AddDiscount_Handler,
@@ -693,6 +843,10 @@ var SubscriptionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetVersionHistory",
 			Handler:    _SubscriptionService_GetVersionHistory_Handler,
 		},
+		{
+			MethodName: "CheckEntitlement",
+			Handler:    _SubscriptionService_CheckEntitlement_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "subscription.proto",
@@ -229,6 +229,270 @@ func (x *TenantUser) GetUpdatedAt() int64 {
 	return 0
 }
 
+type SeatUsage struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TenantId       int64                  `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Used           int64                  `protobuf:"varint,2,opt,name=used,proto3" json:"used,omitempty"`
+	Limit          int64                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Unlimited      bool                   `protobuf:"varint,4,opt,name=unlimited,proto3" json:"unlimited,omitempty"`
+	Remaining      int64                  `protobuf:"varint,5,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	SubscriptionId int64                  `protobuf:"varint,6,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"` // 0 when the tenant has no active subscription
+	Enforced       bool                   `protobuf:"varint,7,opt,name=enforced,proto3" json:"enforced,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SeatUsage) Reset() {
+	*x = SeatUsage{}
+	mi := &file_tenant_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeatUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatUsage) ProtoMessage() {}
+
+func (x *SeatUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_tenant_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatUsage.ProtoReflect.Descriptor instead.
+func (*SeatUsage) Descriptor() ([]byte, []int) {
+	return file_tenant_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SeatUsage) GetTenantId() int64 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *SeatUsage) GetUsed() int64 {
+	if x != nil {
+		return x.Used
+	}
+	return 0
+}
+
+func (x *SeatUsage) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SeatUsage) GetUnlimited() bool {
+	if x != nil {
+		return x.Unlimited
+	}
+	return false
+}
+
+func (x *SeatUsage) GetRemaining() int64 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *SeatUsage) GetSubscriptionId() int64 {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return 0
+}
+
+func (x *SeatUsage) GetEnforced() bool {
+	if x != nil {
+		return x.Enforced
+	}
+	return false
+}
+
+type TenantInvitation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Uuid          string                 `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	TenantId      int64                  `protobuf:"varint,3,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Email         string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	Role          string                 `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"`
+	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"` // pending, accepted, declined, revoked
+	IsExpired     bool                   `protobuf:"varint,7,opt,name=is_expired,json=isExpired,proto3" json:"is_expired,omitempty"`
+	InvitedBy     int64                  `protobuf:"varint,8,opt,name=invited_by,json=invitedBy,proto3" json:"invited_by,omitempty"`
+	AcceptedBy    int64                  `protobuf:"varint,9,opt,name=accepted_by,json=acceptedBy,proto3" json:"accepted_by,omitempty"` // 0 after acceptance until the invitee registers
+	ExpiresAt     int64                  `protobuf:"varint,10,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	SentCount     int32                  `protobuf:"varint,11,opt,name=sent_count,json=sentCount,proto3" json:"sent_count,omitempty"`
+	LastSentAt    int64                  `protobuf:"varint,12,opt,name=last_sent_at,json=lastSentAt,proto3" json:"last_sent_at,omitempty"`
+	AcceptedAt    int64                  `protobuf:"varint,13,opt,name=accepted_at,json=acceptedAt,proto3" json:"accepted_at,omitempty"`
+	DeclinedAt    int64                  `protobuf:"varint,14,opt,name=declined_at,json=declinedAt,proto3" json:"declined_at,omitempty"`
+	RevokedAt     int64                  `protobuf:"varint,15,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,16,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     int64                  `protobuf:"varint,17,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TenantInvitation) Reset() {
+	*x = TenantInvitation{}
+	mi := &file_tenant_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantInvitation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantInvitation) ProtoMessage() {}
+
+func (x *TenantInvitation) ProtoReflect() protoreflect.Message {
+	mi := &file_tenant_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantInvitation.ProtoReflect.Descriptor instead.
+func (*TenantInvitation) Descriptor() ([]byte, []int) {
+	return file_tenant_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TenantInvitation) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TenantInvitation) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *TenantInvitation) GetTenantId() int64 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *TenantInvitation) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *TenantInvitation) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *TenantInvitation) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *TenantInvitation) GetIsExpired() bool {
+	if x != nil {
+		return x.IsExpired
+	}
+	return false
+}
+
+func (x *TenantInvitation) GetInvitedBy() int64 {
+	if x != nil {
+		return x.InvitedBy
+	}
+	return 0
+}
+
+func (x *TenantInvitation) GetAcceptedBy() int64 {
+	if x != nil {
+		return x.AcceptedBy
+	}
+	return 0
+}
+
+func (x *TenantInvitation) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *TenantInvitation) GetSentCount() int32 {
+	if x != nil {
+		return x.SentCount
+	}
+	return 0
+}
+
+func (x *TenantInvitation) GetLastSentAt() int64 {
+	if x != nil {
+		return x.LastSentAt
+	}
+	return 0
+}
+
+func (x *TenantInvitation) GetAcceptedAt() int64 {
+	if x != nil {
+		return x.AcceptedAt
+	}
+	return 0
+}
+
+func (x *TenantInvitation) GetDeclinedAt() int64 {
+	if x != nil {
+		return x.DeclinedAt
+	}
+	return 0
+}
+
+func (x *TenantInvitation) GetRevokedAt() int64 {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return 0
+}
+
+func (x *TenantInvitation) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *TenantInvitation) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
 type TenantSetting struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -243,7 +507,7 @@ type TenantSetting struct {
 
 func (x *TenantSetting) Reset() {
 	*x = TenantSetting{}
-	mi := &file_tenant_proto_msgTypes[2]
+	mi := &file_tenant_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -255,7 +519,7 @@ func (x *TenantSetting) String() string {
 func (*TenantSetting) ProtoMessage() {}
 
 func (x *TenantSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_tenant_proto_msgTypes[2]
+	mi := &file_tenant_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -268,7 +532,7 @@ func (x *TenantSetting) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TenantSetting.ProtoReflect.Descriptor instead.
 func (*TenantSetting) Descriptor() ([]byte, []int) {
-	return file_tenant_proto_rawDescGZIP(), []int{2}
+	return file_tenant_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *TenantSetting) GetId() int64 {
@@ -322,7 +586,7 @@ type GetTenantByIDRequest struct {
 
 func (x *GetTenantByIDRequest) Reset() {
 	*x = GetTenantByIDRequest{}
-	mi := &file_tenant_proto_msgTypes[3]
+	mi := &file_tenant_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -334,7 +598,7 @@ func (x *GetTenantByIDRequest) String() string {
 func (*GetTenantByIDRequest) ProtoMessage() {}
 
 func (x *GetTenantByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tenant_proto_msgTypes[3]
+	mi := &file_tenant_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -347,7 +611,7 @@ func (x *GetTenantByIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTenantByIDRequest.ProtoReflect.Descriptor instead.
 func (*GetTenantByIDRequest) Descriptor() ([]byte, []int) {
-	return file_tenant_proto_rawDescGZIP(), []int{3}
+	return file_tenant_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetTenantByIDRequest) GetId() int64 {
@@ -368,7 +632,7 @@ type GetTenantByIDResponse struct {
 
 func (x *GetTenantByIDResponse) Reset() {
 	*x = GetTenantByIDResponse{}
-	mi := &file_tenant_proto_msgTypes[4]
+	mi := &file_tenant_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -380,7 +644,7 @@ func (x *GetTenantByIDResponse) String() string {
 func (*GetTenantByIDResponse) ProtoMessage() {}
 
 func (x *GetTenantByIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tenant_proto_msgTypes[4]
+	mi := &file_tenant_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -393,7 +657,7 @@ func (x *GetTenantByIDResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTenantByIDResponse.ProtoReflect.Descriptor instead.
 func (*GetTenantByIDResponse) Descriptor() ([]byte, []int) {
-	return file_tenant_proto_rawDescGZIP(), []int{4}
+	return file_tenant_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetTenantByIDResponse) GetSuccess() bool {
@@ -426,7 +690,7 @@ type GetTenantByUUIDRequest struct {
 
 func (x *GetTenantByUUIDRequest) Reset() {
 	*x = GetTenantByUUIDRequest{}
-	mi := &file_tenant_proto_msgTypes[5]
+	mi := &file_tenant_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -438,7 +702,7 @@ func (x *GetTenantByUUIDRequest) String() string {
 func (*GetTenantByUUIDRequest) ProtoMessage() {}
 
 func (x *GetTenantByUUIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tenant_proto_msgTypes[5]
+	mi := &file_tenant_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -451,7 +715,7 @@ func (x *GetTenantByUUIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTenantByUUIDRequest.ProtoReflect.Descriptor instead.
 func (*GetTenantByUUIDRequest) Descriptor() ([]byte, []int) {
-	return file_tenant_proto_rawDescGZIP(), []int{5}
+	return file_tenant_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetTenantByUUIDRequest) GetUuid() string {
@@ -472,7 +736,7 @@ type GetTenantByUUIDResponse struct {
 
 func (x *GetTenantByUUIDResponse) Reset() {
 	*x = GetTenantByUUIDResponse{}
-	mi := &file_tenant_proto_msgTypes[6]
+	mi := &file_tenant_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -484,7 +748,7 @@ func (x *GetTenantByUUIDResponse) String() string {
 func (*GetTenantByUUIDResponse) ProtoMessage() {}
 
 func (x *GetTenantByUUIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tenant_proto_msgTypes[6]
+	mi := &file_tenant_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -497,7 +761,7 @@ func (x *GetTenantByUUIDResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTenantByUUIDResponse.ProtoReflect.Descriptor instead.
 func (*GetTenantByUUIDResponse) Descriptor() ([]byte, []int) {
-	return file_tenant_proto_rawDescGZIP(), []int{6}
+	return file_tenant_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetTenantByUUIDResponse) GetSuccess() bool {
@@ -530,7 +794,7 @@ type GetTenantBySlugRequest struct {
 
 func (x *GetTenantBySlugRequest) Reset() {
 	*x = GetTenantBySlugRequest{}
-	mi := &file_tenant_proto_msgTypes[7]
+	mi := &file_tenant_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -542,7 +806,7 @@ func (x *GetTenantBySlugRequest) String() string {
 func (*GetTenantBySlugRequest) ProtoMessage() {}
 
 func (x *GetTenantBySlugRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tenant_proto_msgTypes[7]
+	mi := &file_tenant_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -555,7 +819,7 @@ func (x *GetTenantBySlugRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTenantBySlugRequest.ProtoReflect.Descriptor instead.
 func (*GetTenantBySlugRequest) Descriptor() ([]byte, []int) {
-	return file_tenant_proto_rawDescGZIP(), []int{7}
+	return file_tenant_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetTenantBySlugRequest) GetSlug() string {
@@ -576,7 +840,7 @@ type GetTenantBySlugResponse struct {
 
 func (x *GetTenantBySlugResponse) Reset() {
 	*x = GetTenantBySlugResponse{}
-	mi := &file_tenant_proto_msgTypes[8]
+	mi := &file_tenant_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -588,7 +852,7 @@ func (x *GetTenantBySlugResponse) String() string {
 func (*GetTenantBySlugResponse) ProtoMessage() {}
 
 func (x *GetTenantBySlugResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tenant_proto_msgTypes[8]
+	mi := &file_tenant_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -601,7 +865,7 @@ func (x *GetTenantBySlugResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTenantBySlugResponse.ProtoReflect.Descriptor instead.
 func (*GetTenantBySlugResponse) Descriptor() ([]byte, []int) {
-	return file_tenant_proto_rawDescGZIP(), []int{8}
+	return file_tenant_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetTenantBySlugResponse) GetSuccess() bool {
@@ -625,31 +889,30 @@ func (x *GetTenantBySlugResponse) GetData() *Tenant {
 	return nil
 }
 
-type CreateTenantRequest struct {
+// GetTenantByDomain only finds tenants whose custom domain is verified. The
+// domain may carry a port, as in an HTTP Host header.
+type GetTenantByDomainRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Slug          string                 `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
-	Domain        string                 `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"`
-	CreatedBy     int64                  `protobuf:"varint,4,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTenantRequest) Reset() {
-	*x = CreateTenantRequest{}
-	mi := &file_tenant_proto_msgTypes[9]
+func (x *GetTenantByDomainRequest) Reset() {
+	*x = GetTenantByDomainRequest{}
+	mi := &file_tenant_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTenantRequest) String() string {
+func (x *GetTenantByDomainRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTenantRequest) ProtoMessage() {}
+func (*GetTenantByDomainRequest) ProtoMessage() {}
 
-func (x *CreateTenantRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tenant_proto_msgTypes[9]
+func (x *GetTenantByDomainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tenant_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -660,123 +923,2809 @@ func (x *CreateTenantRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTenantRequest.ProtoReflect.Descriptor instead.
-func (*CreateTenantRequest) Descriptor() ([]byte, []int) {
-	return file_tenant_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use GetTenantByDomainRequest.ProtoReflect.Descriptor instead.
+func (*GetTenantByDomainRequest) Descriptor() ([]byte, []int) {
+	return file_tenant_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *CreateTenantRequest) GetName() string {
+func (x *GetTenantByDomainRequest) GetDomain() string {
 	if x != nil {
-		return x.Name
+		return x.Domain
 	}
 	return ""
 }
 
-func (x *CreateTenantRequest) GetSlug() string {
-	if x != nil {
-		return x.Slug
-	}
-	return ""
+type GetTenantByDomainResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *Tenant                `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTenantRequest) GetDomain() string {
+func (x *GetTenantByDomainResponse) Reset() {
+	*x = GetTenantByDomainResponse{}
+	mi := &file_tenant_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTenantByDomainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTenantByDomainResponse) ProtoMessage() {}
+
+func (x *GetTenantByDomainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tenant_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTenantByDomainResponse.ProtoReflect.Descriptor instead.
+func (*GetTenantByDomainResponse) Descriptor() ([]byte, []int) {
+	return file_tenant_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetTenantByDomainResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetTenantByDomainResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetTenantByDomainResponse) GetData() *Tenant {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// DomainVerification tells the tenant which TXT record to publish
+type DomainVerification struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      int64                  `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Domain        string                 `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	RecordType    string                 `protobuf:"bytes,3,opt,name=record_type,json=recordType,proto3" json:"record_type,omitempty"` // always TXT
+	RecordName    string                 `protobuf:"bytes,4,opt,name=record_name,json=recordName,proto3" json:"record_name,omitempty"`
+	RecordValue   string                 `protobuf:"bytes,5,opt,name=record_value,json=recordValue,proto3" json:"record_value,omitempty"`
+	Verified      bool                   `protobuf:"varint,6,opt,name=verified,proto3" json:"verified,omitempty"`
+	VerifiedAt    int64                  `protobuf:"varint,7,opt,name=verified_at,json=verifiedAt,proto3" json:"verified_at,omitempty"`
+	LastCheckedAt int64                  `protobuf:"varint,8,opt,name=last_checked_at,json=lastCheckedAt,proto3" json:"last_checked_at,omitempty"`
+	LastError     string                 `protobuf:"bytes,9,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DomainVerification) Reset() {
+	*x = DomainVerification{}
+	mi := &file_tenant_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DomainVerification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DomainVerification) ProtoMessage() {}
+
+func (x *DomainVerification) ProtoReflect() protoreflect.Message {
+	mi := &file_tenant_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DomainVerification.ProtoReflect.Descriptor instead.
+func (*DomainVerification) Descriptor() ([]byte, []int) {
+	return file_tenant_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DomainVerification) GetTenantId() int64 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *DomainVerification) GetDomain() string {
 	if x != nil {
 		return x.Domain
 	}
 	return ""
 }
 
-func (x *CreateTenantRequest) GetCreatedBy() int64 {
+func (x *DomainVerification) GetRecordType() string {
 	if x != nil {
-		return x.CreatedBy
+		return x.RecordType
+	}
+	return ""
+}
+
+func (x *DomainVerification) GetRecordName() string {
+	if x != nil {
+		return x.RecordName
+	}
+	return ""
+}
+
+func (x *DomainVerification) GetRecordValue() string {
+	if x != nil {
+		return x.RecordValue
+	}
+	return ""
+}
+
+func (x *DomainVerification) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+func (x *DomainVerification) GetVerifiedAt() int64 {
+	if x != nil {
+		return x.VerifiedAt
 	}
 	return 0
 }
 
-type CreateTenantResponse struct {
+func (x *DomainVerification) GetLastCheckedAt() int64 {
+	if x != nil {
+		return x.LastCheckedAt
+	}
+	return 0
+}
+
+func (x *DomainVerification) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+type GetDomainVerificationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      int64                  `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDomainVerificationRequest) Reset() {
+	*x = GetDomainVerificationRequest{}
+	mi := &file_tenant_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDomainVerificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDomainVerificationRequest) ProtoMessage() {}
+
+func (x *GetDomainVerificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tenant_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDomainVerificationRequest.ProtoReflect.Descriptor instead.
+func (*GetDomainVerificationRequest) Descriptor() ([]byte, []int) {
+	return file_tenant_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetDomainVerificationRequest) GetTenantId() int64 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+type GetDomainVerificationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Data          *Tenant                `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Data          *DomainVerification    `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTenantResponse) Reset() {
-	*x = CreateTenantResponse{}
-	mi := &file_tenant_proto_msgTypes[10]
+func (x *GetDomainVerificationResponse) Reset() {
+	*x = GetDomainVerificationResponse{}
+	mi := &file_tenant_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTenantResponse) String() string {
+func (x *GetDomainVerificationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTenantResponse) ProtoMessage() {}
+func (*GetDomainVerificationResponse) ProtoMessage() {}
+
+func (x *GetDomainVerificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tenant_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDomainVerificationResponse.ProtoReflect.Descriptor instead.
+func (*GetDomainVerificationResponse) Descriptor() ([]byte, []int) {
+	return file_tenant_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetDomainVerificationResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetDomainVerificationResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetDomainVerificationResponse) GetData() *DomainVerification {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type VerifyDomainRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      int64                  `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyDomainRequest) Reset() {
+	*x = VerifyDomainRequest{}
+	mi := &file_tenant_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyDomainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyDomainRequest) ProtoMessage() {}
+
+func (x *VerifyDomainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tenant_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyDomainRequest.ProtoReflect.Descriptor instead.
+func (*VerifyDomainRequest) Descriptor() ([]byte, []int) {
+	return file_tenant_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *VerifyDomainRequest) GetTenantId() int64 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+type VerifyDomainResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // true when the check ran, even if the record was missing
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *DomainVerification    `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyDomainResponse) Reset() {
+	*x = VerifyDomainResponse{}
+	mi := &file_tenant_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyDomainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyDomainResponse) ProtoMessage() {}
+
+func (x *VerifyDomainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tenant_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyDomainResponse.ProtoReflect.Descriptor instead.
+func (*VerifyDomainResponse) Descriptor() ([]byte, []int) {
+	return file_tenant_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *VerifyDomainResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *VerifyDomainResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *VerifyDomainResponse) GetData() *DomainVerification {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type CreateTenantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Slug          string                 `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	Domain        string                 `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"`
+	CreatedBy     int64                  `protobuf:"varint,4,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTenantRequest) Reset() {
+	*x = CreateTenantRequest{}
+	mi := &file_tenant_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTenantRequest) ProtoMessage() {}
+
+func (x *CreateTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tenant_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTenantRequest.ProtoReflect.Descriptor instead.
+func (*CreateTenantRequest) Descriptor() ([]byte, []int) {
+	return file_tenant_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CreateTenantRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTenantRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *CreateTenantRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *CreateTenantRequest) GetCreatedBy() int64 {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return 0
+}
+
+type CreateTenantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *Tenant                `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTenantResponse) Reset() {
+	*x = CreateTenantResponse{}
+	mi := &file_tenant_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTenantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTenantResponse) ProtoMessage() {}
+
+func (x *CreateTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tenant_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTenantResponse.ProtoReflect.Descriptor instead.
+func (*CreateTenantResponse) Descriptor() ([]byte, []int) {
+	return file_tenant_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CreateTenantResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CreateTenantResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreateTenantResponse) GetData() *Tenant {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type UpdateTenantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Domain        string                 `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTenantRequest) Reset() {
+	*x = UpdateTenantRequest{}
+	mi := &file_tenant_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTenantRequest) ProtoMessage() {}
+
+func (x *UpdateTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tenant_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTenantRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTenantRequest) Descriptor() ([]byte, []int) {
+	return file_tenant_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UpdateTenantRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateTenantRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateTenantRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+type UpdateTenantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *Tenant                `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTenantResponse) Reset() {
+	*x = UpdateTenantResponse{}
+	mi := &file_tenant_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTenantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTenantResponse) ProtoMessage() {}
+
+func (x *UpdateTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tenant_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTenantResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTenantResponse) Descriptor() ([]byte, []int) {
+	return file_tenant_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UpdateTenantResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UpdateTenantResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UpdateTenantResponse) GetData() *Tenant {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type DeleteTenantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTenantRequest) Reset() {
+	*x = DeleteTenantRequest{}
+	mi := &file_tenant_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTenantRequest) ProtoMessage() {}
+
+func (x *DeleteTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tenant_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTenantRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTenantRequest) Descriptor() ([]byte, []int) {
+	return file_tenant_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DeleteTenantRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteTenantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTenantResponse) Reset() {
+	*x = DeleteTenantResponse{}
+	mi := &file_tenant_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTenantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTenantResponse) ProtoMessage() {}
+
+func (x *DeleteTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tenant_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTenantResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTenantResponse) Descriptor() ([]byte, []int) {
+	return file_tenant_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *DeleteTenantResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteTenantResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type RestoreTenantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreTenantRequest) Reset() {
+	*x = RestoreTenantRequest{}
+	mi := &file_tenant_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreTenantRequest) ProtoMessage() {}
+
+func (x *RestoreTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tenant_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreTenantRequest.ProtoReflect.Descriptor instead.
+func (*RestoreTenantRequest) Descriptor() ([]byte, []int) {
+	return file_tenant_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RestoreTenantRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RestoreTenantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *Tenant                `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreTenantResponse) Reset() {
+	*x = RestoreTenantResponse{}
+	mi := &file_tenant_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreTenantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreTenantResponse) ProtoMessage() {}
+
+func (x *RestoreTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tenant_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreTenantResponse.ProtoReflect.Descriptor instead.
+func (*RestoreTenantResponse) Descriptor() ([]byte, []int) {
+	return file_tenant_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RestoreTenantResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RestoreTenantResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RestoreTenantResponse) GetData() *Tenant {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GetAllTenantsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PerPage       int32                  `protobuf:"varint,2,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	Search        string                 `protobuf:"bytes,3,opt,name=search,proto3" json:"search,omitempty"`
+	SortBy        string                 `protobuf:"bytes,4,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	SortOrder     string                 `protobuf:"bytes,5,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllTenantsRequest) Reset() {
+	*x = GetAllTenantsRequest{}
+	mi := &file_tenant_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllTenantsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllTenantsRequest) ProtoMessage() {}
+
+func (x *GetAllTenantsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tenant_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllTenantsRequest.ProtoReflect.Descriptor instead.
+func (*GetAllTenantsRequest) Descriptor() ([]byte, []int) {
+	return file_tenant_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetAllTenantsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetAllTenantsRequest) GetPerPage() int32 {
+	if x != nil {
+		return x.PerPage
+	}
+	return 0
+}
+
+func (x *GetAllTenantsRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *GetAllTenantsRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *GetAllTenantsRequest) GetSortOrder() string {
+	if x != nil {
+		return x.SortOrder
+	}
+	return ""
+}
+
+type GetAllTenantsData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tenants       []*Tenant              `protobuf:"bytes,1,rep,name=tenants,proto3" json:"tenants,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PerPage       int32                  `protobuf:"varint,4,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllTenantsData) Reset() {
+	*x = GetAllTenantsData{}
+	mi := &file_tenant_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllTenantsData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllTenantsData) ProtoMessage() {}
+
+func (x *GetAllTenantsData) ProtoReflect() protoreflect.Message {
+	mi := &file_tenant_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllTenantsData.ProtoReflect.Descriptor instead.
+func (*GetAllTenantsData) Descriptor() ([]byte, []int) {
+	return file_tenant_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetAllTenantsData) GetTenants() []*Tenant {
+	if x != nil {
+		return x.Tenants
+	}
+	return nil
+}
+
+func (x *GetAllTenantsData) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetAllTenantsData) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetAllTenantsData) GetPerPage() int32 {
+	if x != nil {
+		return x.PerPage
+	}
+	return 0
+}
+
+type GetAllTenantsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *GetAllTenantsData     `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllTenantsResponse) Reset() {
+	*x = GetAllTenantsResponse{}
+	mi := &file_tenant_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllTenantsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllTenantsResponse) ProtoMessage() {}
+
+func (x *GetAllTenantsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tenant_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllTenantsResponse.ProtoReflect.Descriptor instead.
+func (*GetAllTenantsResponse) Descriptor() ([]byte, []int) {
+	return file_tenant_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetAllTenantsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetAllTenantsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetAllTenantsResponse) GetData() *GetAllTenantsData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type AddUserToTenantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TenantId      int64                  `protobuf:"varint,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	IsDefault     bool                   `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	Email         string                 `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddUserToTenantRequest) Reset() {
+	*x = AddUserToTenantRequest{}
+	mi := &file_tenant_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddUserToTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddUserToTenantRequest) ProtoMessage() {}
+
+func (x *AddUserToTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tenant_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddUserToTenantRequest.ProtoReflect.Descriptor instead.
+func (*AddUserToTenantRequest) Descriptor() ([]byte, []int) {
+	return file_tenant_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *AddUserToTenantRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AddUserToTenantRequest) GetTenantId() int64 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *AddUserToTenantRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *AddUserToTenantRequest) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+func (x *AddUserToTenantRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type AddUserToTenantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *TenantUser            `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddUserToTenantResponse) Reset() {
+	*x = AddUserToTenantResponse{}
+	mi := &file_tenant_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddUserToTenantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddUserToTenantResponse) ProtoMessage() {}
+
+func (x *AddUserToTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tenant_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddUserToTenantResponse.ProtoReflect.Descriptor instead.
+func (*AddUserToTenantResponse) Descriptor() ([]byte, []int) {
+	return file_tenant_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *AddUserToTenantResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *AddUserToTenantResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AddUserToTenantResponse) GetData() *TenantUser {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type RemoveUserFromTenantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TenantId      int64                  `protobuf:"varint,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	PerformedBy   int64                  `protobuf:"varint,3,opt,name=performed_by,json=performedBy,proto3" json:"performed_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveUserFromTenantRequest) Reset() {
+	*x = RemoveUserFromTenantRequest{}
+	mi := &file_tenant_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveUserFromTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveUserFromTenantRequest) ProtoMessage() {}
+
+func (x *RemoveUserFromTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tenant_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveUserFromTenantRequest.ProtoReflect.Descriptor instead.
+func (*RemoveUserFromTenantRequest) Descriptor() ([]byte, []int) {
+	return file_tenant_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RemoveUserFromTenantRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RemoveUserFromTenantRequest) GetTenantId() int64 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *RemoveUserFromTenantRequest) GetPerformedBy() int64 {
+	if x != nil {
+		return x.PerformedBy
+	}
+	return 0
+}
+
+type RemoveUserFromTenantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveUserFromTenantResponse) Reset() {
+	*x = RemoveUserFromTenantResponse{}
+	mi := &file_tenant_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveUserFromTenantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveUserFromTenantResponse) ProtoMessage() {}
+
+func (x *RemoveUserFromTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tenant_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveUserFromTenantResponse.ProtoReflect.Descriptor instead.
+func (*RemoveUserFromTenantResponse) Descriptor() ([]byte, []int) {
+	return file_tenant_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RemoveUserFromTenantResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RemoveUserFromTenantResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetTenantUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      int64                  `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTenantUsersRequest) Reset() {
+	*x = GetTenantUsersRequest{}
+	mi := &file_tenant_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTenantUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTenantUsersRequest) ProtoMessage() {}
+
+func (x *GetTenantUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tenant_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTenantUsersRequest.ProtoReflect.Descriptor instead.
+func (*GetTenantUsersRequest) Descriptor() ([]byte, []int) {
+	return file_tenant_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetTenantUsersRequest) GetTenantId() int64 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+type GetTenantUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          []*TenantUser          `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTenantUsersResponse) Reset() {
+	*x = GetTenantUsersResponse{}
+	mi := &file_tenant_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTenantUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTenantUsersResponse) ProtoMessage() {}
+
+func (x *GetTenantUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tenant_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTenantUsersResponse.ProtoReflect.Descriptor instead.
+func (*GetTenantUsersResponse) Descriptor() ([]byte, []int) {
+	return file_tenant_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetTenantUsersResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetTenantUsersResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetTenantUsersResponse) GetData() []*TenantUser {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GetSeatUsageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      int64                  `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSeatUsageRequest) Reset() {
+	*x = GetSeatUsageRequest{}
+	mi := &file_tenant_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSeatUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSeatUsageRequest) ProtoMessage() {}
+
+func (x *GetSeatUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tenant_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSeatUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetSeatUsageRequest) Descriptor() ([]byte, []int) {
+	return file_tenant_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetSeatUsageRequest) GetTenantId() int64 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+type GetSeatUsageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *SeatUsage             `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSeatUsageResponse) Reset() {
+	*x = GetSeatUsageResponse{}
+	mi := &file_tenant_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSeatUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSeatUsageResponse) ProtoMessage() {}
+
+func (x *GetSeatUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tenant_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSeatUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetSeatUsageResponse) Descriptor() ([]byte, []int) {
+	return file_tenant_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetSeatUsageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetSeatUsageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetSeatUsageResponse) GetData() *SeatUsage {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// OwnershipChange is an audit record. action is transferred, granted or
+// revoked; from_user_id lost ownership and to_user_id gained it.
+type OwnershipChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId      int64                  `protobuf:"varint,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Action        string                 `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	FromUserId    int64                  `protobuf:"varint,4,opt,name=from_user_id,json=fromUserId,proto3" json:"from_user_id,omitempty"`
+	ToUserId      int64                  `protobuf:"varint,5,opt,name=to_user_id,json=toUserId,proto3" json:"to_user_id,omitempty"`
+	PerformedBy   int64                  `protobuf:"varint,6,opt,name=performed_by,json=performedBy,proto3" json:"performed_by,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OwnershipChange) Reset() {
+	*x = OwnershipChange{}
+	mi := &file_tenant_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OwnershipChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OwnershipChange) ProtoMessage() {}
+
+func (x *OwnershipChange) ProtoReflect() protoreflect.Message {
+	mi := &file_tenant_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OwnershipChange.ProtoReflect.Descriptor instead.
+func (*OwnershipChange) Descriptor() ([]byte, []int) {
+	return file_tenant_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *OwnershipChange) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *OwnershipChange) GetTenantId() int64 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *OwnershipChange) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *OwnershipChange) GetFromUserId() int64 {
+	if x != nil {
+		return x.FromUserId
+	}
+	return 0
+}
+
+func (x *OwnershipChange) GetToUserId() int64 {
+	if x != nil {
+		return x.ToUserId
+	}
+	return 0
+}
+
+func (x *OwnershipChange) GetPerformedBy() int64 {
+	if x != nil {
+		return x.PerformedBy
+	}
+	return 0
+}
+
+func (x *OwnershipChange) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// TransferOwnership makes to_user_id an owner and from_user_id an admin in
+// one transaction
+type TransferOwnershipRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      int64                  `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	FromUserId    int64                  `protobuf:"varint,2,opt,name=from_user_id,json=fromUserId,proto3" json:"from_user_id,omitempty"`
+	ToUserId      int64                  `protobuf:"varint,3,opt,name=to_user_id,json=toUserId,proto3" json:"to_user_id,omitempty"`
+	PerformedBy   int64                  `protobuf:"varint,4,opt,name=performed_by,json=performedBy,proto3" json:"performed_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferOwnershipRequest) Reset() {
+	*x = TransferOwnershipRequest{}
+	mi := &file_tenant_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferOwnershipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferOwnershipRequest) ProtoMessage() {}
+
+func (x *TransferOwnershipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tenant_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferOwnershipRequest.ProtoReflect.Descriptor instead.
+func (*TransferOwnershipRequest) Descriptor() ([]byte, []int) {
+	return file_tenant_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *TransferOwnershipRequest) GetTenantId() int64 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *TransferOwnershipRequest) GetFromUserId() int64 {
+	if x != nil {
+		return x.FromUserId
+	}
+	return 0
+}
+
+func (x *TransferOwnershipRequest) GetToUserId() int64 {
+	if x != nil {
+		return x.ToUserId
+	}
+	return 0
+}
+
+func (x *TransferOwnershipRequest) GetPerformedBy() int64 {
+	if x != nil {
+		return x.PerformedBy
+	}
+	return 0
+}
+
+type TransferOwnershipResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *OwnershipChange       `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferOwnershipResponse) Reset() {
+	*x = TransferOwnershipResponse{}
+	mi := &file_tenant_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferOwnershipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferOwnershipResponse) ProtoMessage() {}
+
+func (x *TransferOwnershipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tenant_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferOwnershipResponse.ProtoReflect.Descriptor instead.
+func (*TransferOwnershipResponse) Descriptor() ([]byte, []int) {
+	return file_tenant_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *TransferOwnershipResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *TransferOwnershipResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *TransferOwnershipResponse) GetData() *OwnershipChange {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GetOwnershipChangesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      int64                  `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOwnershipChangesRequest) Reset() {
+	*x = GetOwnershipChangesRequest{}
+	mi := &file_tenant_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOwnershipChangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOwnershipChangesRequest) ProtoMessage() {}
+
+func (x *GetOwnershipChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tenant_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOwnershipChangesRequest.ProtoReflect.Descriptor instead.
+func (*GetOwnershipChangesRequest) Descriptor() ([]byte, []int) {
+	return file_tenant_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetOwnershipChangesRequest) GetTenantId() int64 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+type GetOwnershipChangesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          []*OwnershipChange     `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOwnershipChangesResponse) Reset() {
+	*x = GetOwnershipChangesResponse{}
+	mi := &file_tenant_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOwnershipChangesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOwnershipChangesResponse) ProtoMessage() {}
+
+func (x *GetOwnershipChangesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tenant_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOwnershipChangesResponse.ProtoReflect.Descriptor instead.
+func (*GetOwnershipChangesResponse) Descriptor() ([]byte, []int) {
+	return file_tenant_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetOwnershipChangesResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetOwnershipChangesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetOwnershipChangesResponse) GetData() []*OwnershipChange {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type InviteUserToTenantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      int64                  `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	InvitedBy     int64                  `protobuf:"varint,4,opt,name=invited_by,json=invitedBy,proto3" json:"invited_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteUserToTenantRequest) Reset() {
+	*x = InviteUserToTenantRequest{}
+	mi := &file_tenant_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteUserToTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteUserToTenantRequest) ProtoMessage() {}
+
+func (x *InviteUserToTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tenant_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteUserToTenantRequest.ProtoReflect.Descriptor instead.
+func (*InviteUserToTenantRequest) Descriptor() ([]byte, []int) {
+	return file_tenant_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *InviteUserToTenantRequest) GetTenantId() int64 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *InviteUserToTenantRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *InviteUserToTenantRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *InviteUserToTenantRequest) GetInvitedBy() int64 {
+	if x != nil {
+		return x.InvitedBy
+	}
+	return 0
+}
+
+type InviteUserToTenantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *TenantInvitation      `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteUserToTenantResponse) Reset() {
+	*x = InviteUserToTenantResponse{}
+	mi := &file_tenant_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteUserToTenantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteUserToTenantResponse) ProtoMessage() {}
+
+func (x *InviteUserToTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tenant_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteUserToTenantResponse.ProtoReflect.Descriptor instead.
+func (*InviteUserToTenantResponse) Descriptor() ([]byte, []int) {
+	return file_tenant_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *InviteUserToTenantResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *InviteUserToTenantResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *InviteUserToTenantResponse) GetData() *TenantInvitation {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GetTenantInvitationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      int64                  `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // empty for all
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTenantInvitationsRequest) Reset() {
+	*x = GetTenantInvitationsRequest{}
+	mi := &file_tenant_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTenantInvitationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTenantInvitationsRequest) ProtoMessage() {}
+
+func (x *GetTenantInvitationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tenant_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTenantInvitationsRequest.ProtoReflect.Descriptor instead.
+func (*GetTenantInvitationsRequest) Descriptor() ([]byte, []int) {
+	return file_tenant_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetTenantInvitationsRequest) GetTenantId() int64 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *GetTenantInvitationsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type GetTenantInvitationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          []*TenantInvitation    `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTenantInvitationsResponse) Reset() {
+	*x = GetTenantInvitationsResponse{}
+	mi := &file_tenant_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTenantInvitationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTenantInvitationsResponse) ProtoMessage() {}
+
+func (x *GetTenantInvitationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tenant_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTenantInvitationsResponse.ProtoReflect.Descriptor instead.
+func (*GetTenantInvitationsResponse) Descriptor() ([]byte, []int) {
+	return file_tenant_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetTenantInvitationsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetTenantInvitationsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetTenantInvitationsResponse) GetData() []*TenantInvitation {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GetInvitationByTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInvitationByTokenRequest) Reset() {
+	*x = GetInvitationByTokenRequest{}
+	mi := &file_tenant_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInvitationByTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvitationByTokenRequest) ProtoMessage() {}
+
+func (x *GetInvitationByTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tenant_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvitationByTokenRequest.ProtoReflect.Descriptor instead.
+func (*GetInvitationByTokenRequest) Descriptor() ([]byte, []int) {
+	return file_tenant_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetInvitationByTokenRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type GetInvitationByTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *TenantInvitation      `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Tenant        *Tenant                `protobuf:"bytes,4,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInvitationByTokenResponse) Reset() {
+	*x = GetInvitationByTokenResponse{}
+	mi := &file_tenant_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInvitationByTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvitationByTokenResponse) ProtoMessage() {}
+
+func (x *GetInvitationByTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tenant_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvitationByTokenResponse.ProtoReflect.Descriptor instead.
+func (*GetInvitationByTokenResponse) Descriptor() ([]byte, []int) {
+	return file_tenant_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetInvitationByTokenResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetInvitationByTokenResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetInvitationByTokenResponse) GetData() *TenantInvitation {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *GetInvitationByTokenResponse) GetTenant() *Tenant {
+	if x != nil {
+		return x.Tenant
+	}
+	return nil
+}
+
+type AcceptInvitationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 0 when the invitee has no account yet
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`                  // the signed-in user's email; must match the invitation
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptInvitationRequest) Reset() {
+	*x = AcceptInvitationRequest{}
+	mi := &file_tenant_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptInvitationRequest) ProtoMessage() {}
+
+func (x *AcceptInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tenant_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptInvitationRequest.ProtoReflect.Descriptor instead.
+func (*AcceptInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_tenant_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *AcceptInvitationRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *AcceptInvitationRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AcceptInvitationRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type AcceptInvitationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *TenantInvitation      `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptInvitationResponse) Reset() {
+	*x = AcceptInvitationResponse{}
+	mi := &file_tenant_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptInvitationResponse) ProtoMessage() {}
+
+func (x *AcceptInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tenant_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptInvitationResponse.ProtoReflect.Descriptor instead.
+func (*AcceptInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_tenant_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *AcceptInvitationResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *AcceptInvitationResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AcceptInvitationResponse) GetData() *TenantInvitation {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type DeclineInvitationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeclineInvitationRequest) Reset() {
+	*x = DeclineInvitationRequest{}
+	mi := &file_tenant_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeclineInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclineInvitationRequest) ProtoMessage() {}
+
+func (x *DeclineInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tenant_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclineInvitationRequest.ProtoReflect.Descriptor instead.
+func (*DeclineInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_tenant_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *DeclineInvitationRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type DeclineInvitationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *TenantInvitation      `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeclineInvitationResponse) Reset() {
+	*x = DeclineInvitationResponse{}
+	mi := &file_tenant_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeclineInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclineInvitationResponse) ProtoMessage() {}
+
+func (x *DeclineInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tenant_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclineInvitationResponse.ProtoReflect.Descriptor instead.
+func (*DeclineInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_tenant_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *DeclineInvitationResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeclineInvitationResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DeclineInvitationResponse) GetData() *TenantInvitation {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type RevokeInvitationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId      int64                  `protobuf:"varint,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"` // when set, the invitation must belong to this tenant
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeInvitationRequest) Reset() {
+	*x = RevokeInvitationRequest{}
+	mi := &file_tenant_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeInvitationRequest) ProtoMessage() {}
+
+func (x *RevokeInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tenant_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeInvitationRequest.ProtoReflect.Descriptor instead.
+func (*RevokeInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_tenant_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *RevokeInvitationRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RevokeInvitationRequest) GetTenantId() int64 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+type RevokeInvitationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *TenantInvitation      `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeInvitationResponse) Reset() {
+	*x = RevokeInvitationResponse{}
+	mi := &file_tenant_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeInvitationResponse) ProtoMessage() {}
+
+func (x *RevokeInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tenant_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeInvitationResponse.ProtoReflect.Descriptor instead.
+func (*RevokeInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_tenant_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *RevokeInvitationResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RevokeInvitationResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RevokeInvitationResponse) GetData() *TenantInvitation {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ResendInvitationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId      int64                  `protobuf:"varint,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"` // when set, the invitation must belong to this tenant
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResendInvitationRequest) Reset() {
+	*x = ResendInvitationRequest{}
+	mi := &file_tenant_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResendInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResendInvitationRequest) ProtoMessage() {}
+
+func (x *ResendInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tenant_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResendInvitationRequest.ProtoReflect.Descriptor instead.
+func (*ResendInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_tenant_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ResendInvitationRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ResendInvitationRequest) GetTenantId() int64 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+type ResendInvitationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *TenantInvitation      `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResendInvitationResponse) Reset() {
+	*x = ResendInvitationResponse{}
+	mi := &file_tenant_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResendInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResendInvitationResponse) ProtoMessage() {}
+
+func (x *ResendInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tenant_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResendInvitationResponse.ProtoReflect.Descriptor instead.
+func (*ResendInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_tenant_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ResendInvitationResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ResendInvitationResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ResendInvitationResponse) GetData() *TenantInvitation {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type Permission struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Permission) Reset() {
+	*x = Permission{}
+	mi := &file_tenant_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Permission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Permission) ProtoMessage() {}
+
+func (x *Permission) ProtoReflect() protoreflect.Message {
+	mi := &file_tenant_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Permission.ProtoReflect.Descriptor instead.
+func (*Permission) Descriptor() ([]byte, []int) {
+	return file_tenant_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *Permission) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Permission) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// TenantRole is a built-in role (id 0, is_builtin) or a custom role of a
+// tenant
+type TenantRole struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId      int64                  `protobuf:"varint,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Permissions   []string               `protobuf:"bytes,5,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	IsBuiltin     bool                   `protobuf:"varint,6,opt,name=is_builtin,json=isBuiltin,proto3" json:"is_builtin,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     int64                  `protobuf:"varint,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TenantRole) Reset() {
+	*x = TenantRole{}
+	mi := &file_tenant_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantRole) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantRole) ProtoMessage() {}
+
+func (x *TenantRole) ProtoReflect() protoreflect.Message {
+	mi := &file_tenant_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantRole.ProtoReflect.Descriptor instead.
+func (*TenantRole) Descriptor() ([]byte, []int) {
+	return file_tenant_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *TenantRole) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TenantRole) GetTenantId() int64 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *TenantRole) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
 
-func (x *CreateTenantResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tenant_proto_msgTypes[10]
+func (x *TenantRole) GetDescription() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Description
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use CreateTenantResponse.ProtoReflect.Descriptor instead.
-func (*CreateTenantResponse) Descriptor() ([]byte, []int) {
-	return file_tenant_proto_rawDescGZIP(), []int{10}
+func (x *TenantRole) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
 }
 
-func (x *CreateTenantResponse) GetSuccess() bool {
+func (x *TenantRole) GetIsBuiltin() bool {
 	if x != nil {
-		return x.Success
+		return x.IsBuiltin
 	}
 	return false
 }
 
-func (x *CreateTenantResponse) GetMessage() string {
+func (x *TenantRole) GetCreatedAt() int64 {
 	if x != nil {
-		return x.Message
+		return x.CreatedAt
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateTenantResponse) GetData() *Tenant {
+func (x *TenantRole) GetUpdatedAt() int64 {
 	if x != nil {
-		return x.Data
+		return x.UpdatedAt
 	}
-	return nil
+	return 0
 }
 
-type UpdateTenantRequest struct {
+type AuthorizeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Domain        string                 `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TenantId      int64                  `protobuf:"varint,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Permission    string                 `protobuf:"bytes,3,opt,name=permission,proto3" json:"permission,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateTenantRequest) Reset() {
-	*x = UpdateTenantRequest{}
-	mi := &file_tenant_proto_msgTypes[11]
+func (x *AuthorizeRequest) Reset() {
+	*x = AuthorizeRequest{}
+	mi := &file_tenant_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTenantRequest) String() string {
+func (x *AuthorizeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTenantRequest) ProtoMessage() {}
+func (*AuthorizeRequest) ProtoMessage() {}
 
-func (x *UpdateTenantRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tenant_proto_msgTypes[11]
+func (x *AuthorizeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tenant_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -787,56 +3736,58 @@ func (x *UpdateTenantRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTenantRequest.ProtoReflect.Descriptor instead.
-func (*UpdateTenantRequest) Descriptor() ([]byte, []int) {
-	return file_tenant_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use AuthorizeRequest.ProtoReflect.Descriptor instead.
+func (*AuthorizeRequest) Descriptor() ([]byte, []int) {
+	return file_tenant_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *UpdateTenantRequest) GetId() int64 {
+func (x *AuthorizeRequest) GetUserId() int64 {
 	if x != nil {
-		return x.Id
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *UpdateTenantRequest) GetName() string {
+func (x *AuthorizeRequest) GetTenantId() int64 {
 	if x != nil {
-		return x.Name
+		return x.TenantId
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateTenantRequest) GetDomain() string {
+func (x *AuthorizeRequest) GetPermission() string {
 	if x != nil {
-		return x.Domain
+		return x.Permission
 	}
 	return ""
 }
 
-type UpdateTenantResponse struct {
+type AuthorizeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Data          *Tenant                `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Allowed       bool                   `protobuf:"varint,3,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	Role          string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`     // empty when the user is not a member
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"` // why the check was denied
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateTenantResponse) Reset() {
-	*x = UpdateTenantResponse{}
-	mi := &file_tenant_proto_msgTypes[12]
+func (x *AuthorizeResponse) Reset() {
+	*x = AuthorizeResponse{}
+	mi := &file_tenant_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTenantResponse) String() string {
+func (x *AuthorizeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTenantResponse) ProtoMessage() {}
+func (*AuthorizeResponse) ProtoMessage() {}
 
-func (x *UpdateTenantResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tenant_proto_msgTypes[12]
+func (x *AuthorizeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tenant_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -847,54 +3798,76 @@ func (x *UpdateTenantResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTenantResponse.ProtoReflect.Descriptor instead.
-func (*UpdateTenantResponse) Descriptor() ([]byte, []int) {
-	return file_tenant_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use AuthorizeResponse.ProtoReflect.Descriptor instead.
+func (*AuthorizeResponse) Descriptor() ([]byte, []int) {
+	return file_tenant_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *UpdateTenantResponse) GetSuccess() bool {
+func (x *AuthorizeResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *UpdateTenantResponse) GetMessage() string {
+func (x *AuthorizeResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *UpdateTenantResponse) GetData() *Tenant {
+func (x *AuthorizeResponse) GetAllowed() bool {
 	if x != nil {
-		return x.Data
+		return x.Allowed
 	}
-	return nil
+	return false
 }
 
-type DeleteTenantRequest struct {
+func (x *AuthorizeResponse) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *AuthorizeResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// A member may only hand out or take away permissions their own role grants.
+// Set role to grant a role, permissions (plus role_id when redefining one) to
+// define a custom role, and target_user_id to change or remove a member.
+type AuthorizeRoleChangeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TenantId      int64                  `protobuf:"varint,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	Permissions   []string               `protobuf:"bytes,4,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	RoleId        int64                  `protobuf:"varint,5,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	TargetUserId  int64                  `protobuf:"varint,6,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteTenantRequest) Reset() {
-	*x = DeleteTenantRequest{}
-	mi := &file_tenant_proto_msgTypes[13]
+func (x *AuthorizeRoleChangeRequest) Reset() {
+	*x = AuthorizeRoleChangeRequest{}
+	mi := &file_tenant_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTenantRequest) String() string {
+func (x *AuthorizeRoleChangeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTenantRequest) ProtoMessage() {}
+func (*AuthorizeRoleChangeRequest) ProtoMessage() {}
 
-func (x *DeleteTenantRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tenant_proto_msgTypes[13]
+func (x *AuthorizeRoleChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tenant_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -905,41 +3878,78 @@ func (x *DeleteTenantRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTenantRequest.ProtoReflect.Descriptor instead.
-func (*DeleteTenantRequest) Descriptor() ([]byte, []int) {
-	return file_tenant_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use AuthorizeRoleChangeRequest.ProtoReflect.Descriptor instead.
+func (*AuthorizeRoleChangeRequest) Descriptor() ([]byte, []int) {
+	return file_tenant_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *DeleteTenantRequest) GetId() int64 {
+func (x *AuthorizeRoleChangeRequest) GetUserId() int64 {
 	if x != nil {
-		return x.Id
+		return x.UserId
 	}
 	return 0
 }
 
-type DeleteTenantResponse struct {
+func (x *AuthorizeRoleChangeRequest) GetTenantId() int64 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *AuthorizeRoleChangeRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *AuthorizeRoleChangeRequest) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *AuthorizeRoleChangeRequest) GetRoleId() int64 {
+	if x != nil {
+		return x.RoleId
+	}
+	return 0
+}
+
+func (x *AuthorizeRoleChangeRequest) GetTargetUserId() int64 {
+	if x != nil {
+		return x.TargetUserId
+	}
+	return 0
+}
+
+type AuthorizeRoleChangeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Allowed       bool                   `protobuf:"varint,3,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"` // why the change was denied
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteTenantResponse) Reset() {
-	*x = DeleteTenantResponse{}
-	mi := &file_tenant_proto_msgTypes[14]
+func (x *AuthorizeRoleChangeResponse) Reset() {
+	*x = AuthorizeRoleChangeResponse{}
+	mi := &file_tenant_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTenantResponse) String() string {
+func (x *AuthorizeRoleChangeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTenantResponse) ProtoMessage() {}
+func (*AuthorizeRoleChangeResponse) ProtoMessage() {}
 
-func (x *DeleteTenantResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tenant_proto_msgTypes[14]
+func (x *AuthorizeRoleChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tenant_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -950,51 +3960,60 @@ func (x *DeleteTenantResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTenantResponse.ProtoReflect.Descriptor instead.
-func (*DeleteTenantResponse) Descriptor() ([]byte, []int) {
-	return file_tenant_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use AuthorizeRoleChangeResponse.ProtoReflect.Descriptor instead.
+func (*AuthorizeRoleChangeResponse) Descriptor() ([]byte, []int) {
+	return file_tenant_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *DeleteTenantResponse) GetSuccess() bool {
+func (x *AuthorizeRoleChangeResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *DeleteTenantResponse) GetMessage() string {
+func (x *AuthorizeRoleChangeResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-type GetAllTenantsRequest struct {
+func (x *AuthorizeRoleChangeResponse) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+func (x *AuthorizeRoleChangeResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type GetPermissionsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	PerPage       int32                  `protobuf:"varint,2,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
-	Search        string                 `protobuf:"bytes,3,opt,name=search,proto3" json:"search,omitempty"`
-	SortBy        string                 `protobuf:"bytes,4,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
-	SortOrder     string                 `protobuf:"bytes,5,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAllTenantsRequest) Reset() {
-	*x = GetAllTenantsRequest{}
-	mi := &file_tenant_proto_msgTypes[15]
+func (x *GetPermissionsRequest) Reset() {
+	*x = GetPermissionsRequest{}
+	mi := &file_tenant_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllTenantsRequest) String() string {
+func (x *GetPermissionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllTenantsRequest) ProtoMessage() {}
+func (*GetPermissionsRequest) ProtoMessage() {}
 
-func (x *GetAllTenantsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tenant_proto_msgTypes[15]
+func (x *GetPermissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tenant_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1005,71 +4024,93 @@ func (x *GetAllTenantsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllTenantsRequest.ProtoReflect.Descriptor instead.
-func (*GetAllTenantsRequest) Descriptor() ([]byte, []int) {
-	return file_tenant_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use GetPermissionsRequest.ProtoReflect.Descriptor instead.
+func (*GetPermissionsRequest) Descriptor() ([]byte, []int) {
+	return file_tenant_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *GetAllTenantsRequest) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
+type GetPermissionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          []*Permission          `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAllTenantsRequest) GetPerPage() int32 {
+func (x *GetPermissionsResponse) Reset() {
+	*x = GetPermissionsResponse{}
+	mi := &file_tenant_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPermissionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPermissionsResponse) ProtoMessage() {}
+
+func (x *GetPermissionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tenant_proto_msgTypes[63]
 	if x != nil {
-		return x.PerPage
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GetAllTenantsRequest) GetSearch() string {
+// Deprecated: Use GetPermissionsResponse.ProtoReflect.Descriptor instead.
+func (*GetPermissionsResponse) Descriptor() ([]byte, []int) {
+	return file_tenant_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetPermissionsResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Search
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-func (x *GetAllTenantsRequest) GetSortBy() string {
+func (x *GetPermissionsResponse) GetMessage() string {
 	if x != nil {
-		return x.SortBy
+		return x.Message
 	}
 	return ""
 }
 
-func (x *GetAllTenantsRequest) GetSortOrder() string {
+func (x *GetPermissionsResponse) GetData() []*Permission {
 	if x != nil {
-		return x.SortOrder
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-type GetAllTenantsData struct {
+type GetTenantRolesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tenants       []*Tenant              `protobuf:"bytes,1,rep,name=tenants,proto3" json:"tenants,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PerPage       int32                  `protobuf:"varint,4,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	TenantId      int64                  `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAllTenantsData) Reset() {
-	*x = GetAllTenantsData{}
-	mi := &file_tenant_proto_msgTypes[16]
+func (x *GetTenantRolesRequest) Reset() {
+	*x = GetTenantRolesRequest{}
+	mi := &file_tenant_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllTenantsData) String() string {
+func (x *GetTenantRolesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllTenantsData) ProtoMessage() {}
+func (*GetTenantRolesRequest) ProtoMessage() {}
 
-func (x *GetAllTenantsData) ProtoReflect() protoreflect.Message {
-	mi := &file_tenant_proto_msgTypes[16]
+func (x *GetTenantRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tenant_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1080,63 +4121,42 @@ func (x *GetAllTenantsData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllTenantsData.ProtoReflect.Descriptor instead.
-func (*GetAllTenantsData) Descriptor() ([]byte, []int) {
-	return file_tenant_proto_rawDescGZIP(), []int{16}
-}
-
-func (x *GetAllTenantsData) GetTenants() []*Tenant {
-	if x != nil {
-		return x.Tenants
-	}
-	return nil
-}
-
-func (x *GetAllTenantsData) GetTotal() int32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-func (x *GetAllTenantsData) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
+// Deprecated: Use GetTenantRolesRequest.ProtoReflect.Descriptor instead.
+func (*GetTenantRolesRequest) Descriptor() ([]byte, []int) {
+	return file_tenant_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *GetAllTenantsData) GetPerPage() int32 {
+func (x *GetTenantRolesRequest) GetTenantId() int64 {
 	if x != nil {
-		return x.PerPage
+		return x.TenantId
 	}
 	return 0
 }
 
-type GetAllTenantsResponse struct {
+type GetTenantRolesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Data          *GetAllTenantsData     `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Data          []*TenantRole          `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAllTenantsResponse) Reset() {
-	*x = GetAllTenantsResponse{}
-	mi := &file_tenant_proto_msgTypes[17]
+func (x *GetTenantRolesResponse) Reset() {
+	*x = GetTenantRolesResponse{}
+	mi := &file_tenant_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllTenantsResponse) String() string {
+func (x *GetTenantRolesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllTenantsResponse) ProtoMessage() {}
+func (*GetTenantRolesResponse) ProtoMessage() {}
 
-func (x *GetAllTenantsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tenant_proto_msgTypes[17]
+func (x *GetTenantRolesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tenant_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1147,58 +4167,57 @@ func (x *GetAllTenantsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllTenantsResponse.ProtoReflect.Descriptor instead.
-func (*GetAllTenantsResponse) Descriptor() ([]byte, []int) {
-	return file_tenant_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use GetTenantRolesResponse.ProtoReflect.Descriptor instead.
+func (*GetTenantRolesResponse) Descriptor() ([]byte, []int) {
+	return file_tenant_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *GetAllTenantsResponse) GetSuccess() bool {
+func (x *GetTenantRolesResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *GetAllTenantsResponse) GetMessage() string {
+func (x *GetTenantRolesResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *GetAllTenantsResponse) GetData() *GetAllTenantsData {
+func (x *GetTenantRolesResponse) GetData() []*TenantRole {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-type AddUserToTenantRequest struct {
+type CreateTenantRoleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	TenantId      int64                  `protobuf:"varint,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
-	IsDefault     bool                   `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
-	Email         string                 `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
+	TenantId      int64                  `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Permissions   []string               `protobuf:"bytes,4,rep,name=permissions,proto3" json:"permissions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddUserToTenantRequest) Reset() {
-	*x = AddUserToTenantRequest{}
-	mi := &file_tenant_proto_msgTypes[18]
+func (x *CreateTenantRoleRequest) Reset() {
+	*x = CreateTenantRoleRequest{}
+	mi := &file_tenant_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddUserToTenantRequest) String() string {
+func (x *CreateTenantRoleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddUserToTenantRequest) ProtoMessage() {}
+func (*CreateTenantRoleRequest) ProtoMessage() {}
 
-func (x *AddUserToTenantRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tenant_proto_msgTypes[18]
+func (x *CreateTenantRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tenant_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1209,70 +4228,63 @@ func (x *AddUserToTenantRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddUserToTenantRequest.ProtoReflect.Descriptor instead.
-func (*AddUserToTenantRequest) Descriptor() ([]byte, []int) {
-	return file_tenant_proto_rawDescGZIP(), []int{18}
-}
-
-func (x *AddUserToTenantRequest) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+// Deprecated: Use CreateTenantRoleRequest.ProtoReflect.Descriptor instead.
+func (*CreateTenantRoleRequest) Descriptor() ([]byte, []int) {
+	return file_tenant_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *AddUserToTenantRequest) GetTenantId() int64 {
+func (x *CreateTenantRoleRequest) GetTenantId() int64 {
 	if x != nil {
 		return x.TenantId
 	}
 	return 0
 }
 
-func (x *AddUserToTenantRequest) GetRole() string {
+func (x *CreateTenantRoleRequest) GetName() string {
 	if x != nil {
-		return x.Role
+		return x.Name
 	}
 	return ""
 }
 
-func (x *AddUserToTenantRequest) GetIsDefault() bool {
+func (x *CreateTenantRoleRequest) GetDescription() string {
 	if x != nil {
-		return x.IsDefault
+		return x.Description
 	}
-	return false
+	return ""
 }
 
-func (x *AddUserToTenantRequest) GetEmail() string {
+func (x *CreateTenantRoleRequest) GetPermissions() []string {
 	if x != nil {
-		return x.Email
+		return x.Permissions
 	}
-	return ""
+	return nil
 }
 
-type AddUserToTenantResponse struct {
+type CreateTenantRoleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Data          *TenantUser            `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Data          *TenantRole            `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddUserToTenantResponse) Reset() {
-	*x = AddUserToTenantResponse{}
-	mi := &file_tenant_proto_msgTypes[19]
+func (x *CreateTenantRoleResponse) Reset() {
+	*x = CreateTenantRoleResponse{}
+	mi := &file_tenant_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddUserToTenantResponse) String() string {
+func (x *CreateTenantRoleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddUserToTenantResponse) ProtoMessage() {}
+func (*CreateTenantRoleResponse) ProtoMessage() {}
 
-func (x *AddUserToTenantResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tenant_proto_msgTypes[19]
+func (x *CreateTenantRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tenant_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1283,55 +4295,57 @@ func (x *AddUserToTenantResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddUserToTenantResponse.ProtoReflect.Descriptor instead.
-func (*AddUserToTenantResponse) Descriptor() ([]byte, []int) {
-	return file_tenant_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use CreateTenantRoleResponse.ProtoReflect.Descriptor instead.
+func (*CreateTenantRoleResponse) Descriptor() ([]byte, []int) {
+	return file_tenant_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *AddUserToTenantResponse) GetSuccess() bool {
+func (x *CreateTenantRoleResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *AddUserToTenantResponse) GetMessage() string {
+func (x *CreateTenantRoleResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *AddUserToTenantResponse) GetData() *TenantUser {
+func (x *CreateTenantRoleResponse) GetData() *TenantRole {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-type RemoveUserFromTenantRequest struct {
+type UpdateTenantRoleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	TenantId      int64                  `protobuf:"varint,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Permissions   []string               `protobuf:"bytes,4,rep,name=permissions,proto3" json:"permissions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveUserFromTenantRequest) Reset() {
-	*x = RemoveUserFromTenantRequest{}
-	mi := &file_tenant_proto_msgTypes[20]
+func (x *UpdateTenantRoleRequest) Reset() {
+	*x = UpdateTenantRoleRequest{}
+	mi := &file_tenant_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveUserFromTenantRequest) String() string {
+func (x *UpdateTenantRoleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveUserFromTenantRequest) ProtoMessage() {}
+func (*UpdateTenantRoleRequest) ProtoMessage() {}
 
-func (x *RemoveUserFromTenantRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tenant_proto_msgTypes[20]
+func (x *UpdateTenantRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tenant_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1342,48 +4356,63 @@ func (x *RemoveUserFromTenantRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveUserFromTenantRequest.ProtoReflect.Descriptor instead.
-func (*RemoveUserFromTenantRequest) Descriptor() ([]byte, []int) {
-	return file_tenant_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use UpdateTenantRoleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTenantRoleRequest) Descriptor() ([]byte, []int) {
+	return file_tenant_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *RemoveUserFromTenantRequest) GetUserId() int64 {
+func (x *UpdateTenantRoleRequest) GetId() int64 {
 	if x != nil {
-		return x.UserId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *RemoveUserFromTenantRequest) GetTenantId() int64 {
+func (x *UpdateTenantRoleRequest) GetTenantId() int64 {
 	if x != nil {
 		return x.TenantId
 	}
 	return 0
 }
 
-type RemoveUserFromTenantResponse struct {
+func (x *UpdateTenantRoleRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateTenantRoleRequest) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+type UpdateTenantRoleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Data          *TenantRole            `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveUserFromTenantResponse) Reset() {
-	*x = RemoveUserFromTenantResponse{}
-	mi := &file_tenant_proto_msgTypes[21]
+func (x *UpdateTenantRoleResponse) Reset() {
+	*x = UpdateTenantRoleResponse{}
+	mi := &file_tenant_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveUserFromTenantResponse) String() string {
+func (x *UpdateTenantRoleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveUserFromTenantResponse) ProtoMessage() {}
+func (*UpdateTenantRoleResponse) ProtoMessage() {}
 
-func (x *RemoveUserFromTenantResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tenant_proto_msgTypes[21]
+func (x *UpdateTenantRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tenant_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1394,47 +4423,55 @@ func (x *RemoveUserFromTenantResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveUserFromTenantResponse.ProtoReflect.Descriptor instead.
-func (*RemoveUserFromTenantResponse) Descriptor() ([]byte, []int) {
-	return file_tenant_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use UpdateTenantRoleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTenantRoleResponse) Descriptor() ([]byte, []int) {
+	return file_tenant_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *RemoveUserFromTenantResponse) GetSuccess() bool {
+func (x *UpdateTenantRoleResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *RemoveUserFromTenantResponse) GetMessage() string {
+func (x *UpdateTenantRoleResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-type GetTenantUsersRequest struct {
+func (x *UpdateTenantRoleResponse) GetData() *TenantRole {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type DeleteTenantRoleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TenantId      int64                  `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId      int64                  `protobuf:"varint,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTenantUsersRequest) Reset() {
-	*x = GetTenantUsersRequest{}
-	mi := &file_tenant_proto_msgTypes[22]
+func (x *DeleteTenantRoleRequest) Reset() {
+	*x = DeleteTenantRoleRequest{}
+	mi := &file_tenant_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTenantUsersRequest) String() string {
+func (x *DeleteTenantRoleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTenantUsersRequest) ProtoMessage() {}
+func (*DeleteTenantRoleRequest) ProtoMessage() {}
 
-func (x *GetTenantUsersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tenant_proto_msgTypes[22]
+func (x *DeleteTenantRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tenant_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1445,42 +4482,48 @@ func (x *GetTenantUsersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTenantUsersRequest.ProtoReflect.Descriptor instead.
-func (*GetTenantUsersRequest) Descriptor() ([]byte, []int) {
-	return file_tenant_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use DeleteTenantRoleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTenantRoleRequest) Descriptor() ([]byte, []int) {
+	return file_tenant_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *GetTenantUsersRequest) GetTenantId() int64 {
+func (x *DeleteTenantRoleRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeleteTenantRoleRequest) GetTenantId() int64 {
 	if x != nil {
 		return x.TenantId
 	}
 	return 0
 }
 
-type GetTenantUsersResponse struct {
+type DeleteTenantRoleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Data          []*TenantUser          `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTenantUsersResponse) Reset() {
-	*x = GetTenantUsersResponse{}
-	mi := &file_tenant_proto_msgTypes[23]
+func (x *DeleteTenantRoleResponse) Reset() {
+	*x = DeleteTenantRoleResponse{}
+	mi := &file_tenant_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTenantUsersResponse) String() string {
+func (x *DeleteTenantRoleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTenantUsersResponse) ProtoMessage() {}
+func (*DeleteTenantRoleResponse) ProtoMessage() {}
 
-func (x *GetTenantUsersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tenant_proto_msgTypes[23]
+func (x *DeleteTenantRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tenant_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1491,32 +4534,25 @@ func (x *GetTenantUsersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTenantUsersResponse.ProtoReflect.Descriptor instead.
-func (*GetTenantUsersResponse) Descriptor() ([]byte, []int) {
-	return file_tenant_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use DeleteTenantRoleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTenantRoleResponse) Descriptor() ([]byte, []int) {
+	return file_tenant_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *GetTenantUsersResponse) GetSuccess() bool {
+func (x *DeleteTenantRoleResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *GetTenantUsersResponse) GetMessage() string {
+func (x *DeleteTenantRoleResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *GetTenantUsersResponse) GetData() []*TenantUser {
-	if x != nil {
-		return x.Data
-	}
-	return nil
-}
-
 type GetUserTenantsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -1526,7 +4562,7 @@ type GetUserTenantsRequest struct {
 
 func (x *GetUserTenantsRequest) Reset() {
 	*x = GetUserTenantsRequest{}
-	mi := &file_tenant_proto_msgTypes[24]
+	mi := &file_tenant_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1538,7 +4574,7 @@ func (x *GetUserTenantsRequest) String() string {
 func (*GetUserTenantsRequest) ProtoMessage() {}
 
 func (x *GetUserTenantsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tenant_proto_msgTypes[24]
+	mi := &file_tenant_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1551,7 +4587,7 @@ func (x *GetUserTenantsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserTenantsRequest.ProtoReflect.Descriptor instead.
 func (*GetUserTenantsRequest) Descriptor() ([]byte, []int) {
-	return file_tenant_proto_rawDescGZIP(), []int{24}
+	return file_tenant_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *GetUserTenantsRequest) GetUserId() int64 {
@@ -1572,7 +4608,7 @@ type GetUserTenantsResponse struct {
 
 func (x *GetUserTenantsResponse) Reset() {
 	*x = GetUserTenantsResponse{}
-	mi := &file_tenant_proto_msgTypes[25]
+	mi := &file_tenant_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1584,7 +4620,7 @@ func (x *GetUserTenantsResponse) String() string {
 func (*GetUserTenantsResponse) ProtoMessage() {}
 
 func (x *GetUserTenantsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tenant_proto_msgTypes[25]
+	mi := &file_tenant_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1597,7 +4633,7 @@ func (x *GetUserTenantsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserTenantsResponse.ProtoReflect.Descriptor instead.
 func (*GetUserTenantsResponse) Descriptor() ([]byte, []int) {
-	return file_tenant_proto_rawDescGZIP(), []int{25}
+	return file_tenant_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *GetUserTenantsResponse) GetSuccess() bool {
@@ -1626,13 +4662,14 @@ type UpdateUserRoleRequest struct {
 	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	TenantId      int64                  `protobuf:"varint,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
 	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	PerformedBy   int64                  `protobuf:"varint,4,opt,name=performed_by,json=performedBy,proto3" json:"performed_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateUserRoleRequest) Reset() {
 	*x = UpdateUserRoleRequest{}
-	mi := &file_tenant_proto_msgTypes[26]
+	mi := &file_tenant_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1644,7 +4681,7 @@ func (x *UpdateUserRoleRequest) String() string {
 func (*UpdateUserRoleRequest) ProtoMessage() {}
 
 func (x *UpdateUserRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tenant_proto_msgTypes[26]
+	mi := &file_tenant_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1657,7 +4694,7 @@ func (x *UpdateUserRoleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUserRoleRequest.ProtoReflect.Descriptor instead.
 func (*UpdateUserRoleRequest) Descriptor() ([]byte, []int) {
-	return file_tenant_proto_rawDescGZIP(), []int{26}
+	return file_tenant_proto_rawDescGZIP(), []int{74}
 }
 
 func (x *UpdateUserRoleRequest) GetUserId() int64 {
@@ -1681,6 +4718,13 @@ func (x *UpdateUserRoleRequest) GetRole() string {
 	return ""
 }
 
+func (x *UpdateUserRoleRequest) GetPerformedBy() int64 {
+	if x != nil {
+		return x.PerformedBy
+	}
+	return 0
+}
+
 type UpdateUserRoleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -1691,7 +4735,7 @@ type UpdateUserRoleResponse struct {
 
 func (x *UpdateUserRoleResponse) Reset() {
 	*x = UpdateUserRoleResponse{}
-	mi := &file_tenant_proto_msgTypes[27]
+	mi := &file_tenant_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1703,7 +4747,7 @@ func (x *UpdateUserRoleResponse) String() string {
 func (*UpdateUserRoleResponse) ProtoMessage() {}
 
 func (x *UpdateUserRoleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tenant_proto_msgTypes[27]
+	mi := &file_tenant_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1716,7 +4760,7 @@ func (x *UpdateUserRoleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUserRoleResponse.ProtoReflect.Descriptor instead.
 func (*UpdateUserRoleResponse) Descriptor() ([]byte, []int) {
-	return file_tenant_proto_rawDescGZIP(), []int{27}
+	return file_tenant_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *UpdateUserRoleResponse) GetSuccess() bool {
@@ -1743,7 +4787,7 @@ type SetDefaultTenantRequest struct {
 
 func (x *SetDefaultTenantRequest) Reset() {
 	*x = SetDefaultTenantRequest{}
-	mi := &file_tenant_proto_msgTypes[28]
+	mi := &file_tenant_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1755,7 +4799,7 @@ func (x *SetDefaultTenantRequest) String() string {
 func (*SetDefaultTenantRequest) ProtoMessage() {}
 
 func (x *SetDefaultTenantRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tenant_proto_msgTypes[28]
+	mi := &file_tenant_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1768,7 +4812,7 @@ func (x *SetDefaultTenantRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetDefaultTenantRequest.ProtoReflect.Descriptor instead.
 func (*SetDefaultTenantRequest) Descriptor() ([]byte, []int) {
-	return file_tenant_proto_rawDescGZIP(), []int{28}
+	return file_tenant_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *SetDefaultTenantRequest) GetUserId() int64 {
@@ -1795,7 +4839,7 @@ type SetDefaultTenantResponse struct {
 
 func (x *SetDefaultTenantResponse) Reset() {
 	*x = SetDefaultTenantResponse{}
-	mi := &file_tenant_proto_msgTypes[29]
+	mi := &file_tenant_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1807,7 +4851,7 @@ func (x *SetDefaultTenantResponse) String() string {
 func (*SetDefaultTenantResponse) ProtoMessage() {}
 
 func (x *SetDefaultTenantResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tenant_proto_msgTypes[29]
+	mi := &file_tenant_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1820,7 +4864,7 @@ func (x *SetDefaultTenantResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetDefaultTenantResponse.ProtoReflect.Descriptor instead.
 func (*SetDefaultTenantResponse) Descriptor() ([]byte, []int) {
-	return file_tenant_proto_rawDescGZIP(), []int{29}
+	return file_tenant_proto_rawDescGZIP(), []int{77}
 }
 
 func (x *SetDefaultTenantResponse) GetSuccess() bool {
@@ -1847,7 +4891,7 @@ type GetSettingRequest struct {
 
 func (x *GetSettingRequest) Reset() {
 	*x = GetSettingRequest{}
-	mi := &file_tenant_proto_msgTypes[30]
+	mi := &file_tenant_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1859,7 +4903,7 @@ func (x *GetSettingRequest) String() string {
 func (*GetSettingRequest) ProtoMessage() {}
 
 func (x *GetSettingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tenant_proto_msgTypes[30]
+	mi := &file_tenant_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1872,7 +4916,7 @@ func (x *GetSettingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSettingRequest.ProtoReflect.Descriptor instead.
 func (*GetSettingRequest) Descriptor() ([]byte, []int) {
-	return file_tenant_proto_rawDescGZIP(), []int{30}
+	return file_tenant_proto_rawDescGZIP(), []int{78}
 }
 
 func (x *GetSettingRequest) GetTenantId() int64 {
@@ -1900,7 +4944,7 @@ type GetSettingResponse struct {
 
 func (x *GetSettingResponse) Reset() {
 	*x = GetSettingResponse{}
-	mi := &file_tenant_proto_msgTypes[31]
+	mi := &file_tenant_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1912,7 +4956,7 @@ func (x *GetSettingResponse) String() string {
 func (*GetSettingResponse) ProtoMessage() {}
 
 func (x *GetSettingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tenant_proto_msgTypes[31]
+	mi := &file_tenant_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1925,7 +4969,7 @@ func (x *GetSettingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSettingResponse.ProtoReflect.Descriptor instead.
 func (*GetSettingResponse) Descriptor() ([]byte, []int) {
-	return file_tenant_proto_rawDescGZIP(), []int{31}
+	return file_tenant_proto_rawDescGZIP(), []int{79}
 }
 
 func (x *GetSettingResponse) GetSuccess() bool {
@@ -1958,7 +5002,7 @@ type GetAllSettingsRequest struct {
 
 func (x *GetAllSettingsRequest) Reset() {
 	*x = GetAllSettingsRequest{}
-	mi := &file_tenant_proto_msgTypes[32]
+	mi := &file_tenant_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1970,7 +5014,7 @@ func (x *GetAllSettingsRequest) String() string {
 func (*GetAllSettingsRequest) ProtoMessage() {}
 
 func (x *GetAllSettingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tenant_proto_msgTypes[32]
+	mi := &file_tenant_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1983,7 +5027,7 @@ func (x *GetAllSettingsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllSettingsRequest.ProtoReflect.Descriptor instead.
 func (*GetAllSettingsRequest) Descriptor() ([]byte, []int) {
-	return file_tenant_proto_rawDescGZIP(), []int{32}
+	return file_tenant_proto_rawDescGZIP(), []int{80}
 }
 
 func (x *GetAllSettingsRequest) GetTenantId() int64 {
@@ -2004,7 +5048,7 @@ type GetAllSettingsResponse struct {
 
 func (x *GetAllSettingsResponse) Reset() {
 	*x = GetAllSettingsResponse{}
-	mi := &file_tenant_proto_msgTypes[33]
+	mi := &file_tenant_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2016,7 +5060,7 @@ func (x *GetAllSettingsResponse) String() string {
 func (*GetAllSettingsResponse) ProtoMessage() {}
 
 func (x *GetAllSettingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tenant_proto_msgTypes[33]
+	mi := &file_tenant_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2029,7 +5073,7 @@ func (x *GetAllSettingsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllSettingsResponse.ProtoReflect.Descriptor instead.
 func (*GetAllSettingsResponse) Descriptor() ([]byte, []int) {
-	return file_tenant_proto_rawDescGZIP(), []int{33}
+	return file_tenant_proto_rawDescGZIP(), []int{81}
 }
 
 func (x *GetAllSettingsResponse) GetSuccess() bool {
@@ -2064,7 +5108,7 @@ type SetSettingRequest struct {
 
 func (x *SetSettingRequest) Reset() {
 	*x = SetSettingRequest{}
-	mi := &file_tenant_proto_msgTypes[34]
+	mi := &file_tenant_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2076,7 +5120,7 @@ func (x *SetSettingRequest) String() string {
 func (*SetSettingRequest) ProtoMessage() {}
 
 func (x *SetSettingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tenant_proto_msgTypes[34]
+	mi := &file_tenant_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2089,7 +5133,7 @@ func (x *SetSettingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetSettingRequest.ProtoReflect.Descriptor instead.
 func (*SetSettingRequest) Descriptor() ([]byte, []int) {
-	return file_tenant_proto_rawDescGZIP(), []int{34}
+	return file_tenant_proto_rawDescGZIP(), []int{82}
 }
 
 func (x *SetSettingRequest) GetTenantId() int64 {
@@ -2124,7 +5168,7 @@ type SetSettingResponse struct {
 
 func (x *SetSettingResponse) Reset() {
 	*x = SetSettingResponse{}
-	mi := &file_tenant_proto_msgTypes[35]
+	mi := &file_tenant_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2136,7 +5180,7 @@ func (x *SetSettingResponse) String() string {
 func (*SetSettingResponse) ProtoMessage() {}
 
 func (x *SetSettingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tenant_proto_msgTypes[35]
+	mi := &file_tenant_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2149,7 +5193,7 @@ func (x *SetSettingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetSettingResponse.ProtoReflect.Descriptor instead.
 func (*SetSettingResponse) Descriptor() ([]byte, []int) {
-	return file_tenant_proto_rawDescGZIP(), []int{35}
+	return file_tenant_proto_rawDescGZIP(), []int{83}
 }
 
 func (x *SetSettingResponse) GetSuccess() bool {
@@ -2183,7 +5227,7 @@ type DeleteSettingRequest struct {
 
 func (x *DeleteSettingRequest) Reset() {
 	*x = DeleteSettingRequest{}
-	mi := &file_tenant_proto_msgTypes[36]
+	mi := &file_tenant_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2195,7 +5239,7 @@ func (x *DeleteSettingRequest) String() string {
 func (*DeleteSettingRequest) ProtoMessage() {}
 
 func (x *DeleteSettingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tenant_proto_msgTypes[36]
+	mi := &file_tenant_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2208,7 +5252,7 @@ func (x *DeleteSettingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteSettingRequest.ProtoReflect.Descriptor instead.
 func (*DeleteSettingRequest) Descriptor() ([]byte, []int) {
-	return file_tenant_proto_rawDescGZIP(), []int{36}
+	return file_tenant_proto_rawDescGZIP(), []int{84}
 }
 
 func (x *DeleteSettingRequest) GetTenantId() int64 {
@@ -2235,7 +5279,7 @@ type DeleteSettingResponse struct {
 
 func (x *DeleteSettingResponse) Reset() {
 	*x = DeleteSettingResponse{}
-	mi := &file_tenant_proto_msgTypes[37]
+	mi := &file_tenant_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2247,7 +5291,7 @@ func (x *DeleteSettingResponse) String() string {
 func (*DeleteSettingResponse) ProtoMessage() {}
 
 func (x *DeleteSettingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tenant_proto_msgTypes[37]
+	mi := &file_tenant_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2260,7 +5304,7 @@ func (x *DeleteSettingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteSettingResponse.ProtoReflect.Descriptor instead.
 func (*DeleteSettingResponse) Descriptor() ([]byte, []int) {
-	return file_tenant_proto_rawDescGZIP(), []int{37}
+	return file_tenant_proto_rawDescGZIP(), []int{85}
 }
 
 func (x *DeleteSettingResponse) GetSuccess() bool {
@@ -2307,7 +5351,45 @@ const file_tenant_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\a \x01(\x03R\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\b \x01(\x03R\tupdatedAt\"\xa2\x01\n" +
+	"updated_at\x18\b \x01(\x03R\tupdatedAt\"\xd3\x01\n" +
+	"\tSeatUsage\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\x03R\btenantId\x12\x12\n" +
+	"\x04used\x18\x02 \x01(\x03R\x04used\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x03R\x05limit\x12\x1c\n" +
+	"\tunlimited\x18\x04 \x01(\bR\tunlimited\x12\x1c\n" +
+	"\tremaining\x18\x05 \x01(\x03R\tremaining\x12'\n" +
+	"\x0fsubscription_id\x18\x06 \x01(\x03R\x0esubscriptionId\x12\x1a\n" +
+	"\benforced\x18\a \x01(\bR\benforced\"\xf3\x03\n" +
+	"\x10TenantInvitation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04uuid\x18\x02 \x01(\tR\x04uuid\x12\x1b\n" +
+	"\ttenant_id\x18\x03 \x01(\x03R\btenantId\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\x12\x12\n" +
+	"\x04role\x18\x05 \x01(\tR\x04role\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"is_expired\x18\a \x01(\bR\tisExpired\x12\x1d\n" +
+	"\n" +
+	"invited_by\x18\b \x01(\x03R\tinvitedBy\x12\x1f\n" +
+	"\vaccepted_by\x18\t \x01(\x03R\n" +
+	"acceptedBy\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\n" +
+	" \x01(\x03R\texpiresAt\x12\x1d\n" +
+	"\n" +
+	"sent_count\x18\v \x01(\x05R\tsentCount\x12 \n" +
+	"\flast_sent_at\x18\f \x01(\x03R\n" +
+	"lastSentAt\x12\x1f\n" +
+	"\vaccepted_at\x18\r \x01(\x03R\n" +
+	"acceptedAt\x12\x1f\n" +
+	"\vdeclined_at\x18\x0e \x01(\x03R\n" +
+	"declinedAt\x12\x1d\n" +
+	"\n" +
+	"revoked_at\x18\x0f \x01(\x03R\trevokedAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x10 \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x11 \x01(\x03R\tupdatedAt\"\xa2\x01\n" +
 	"\rTenantSetting\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1b\n" +
 	"\ttenant_id\x18\x02 \x01(\x03R\btenantId\x12\x10\n" +
@@ -2334,7 +5416,39 @@ const file_tenant_proto_rawDesc = "" +
 	"\x17GetTenantBySlugResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\"\n" +
-	"\x04data\x18\x03 \x01(\v2\x0e.tenant.TenantR\x04data\"t\n" +
+	"\x04data\x18\x03 \x01(\v2\x0e.tenant.TenantR\x04data\"2\n" +
+	"\x18GetTenantByDomainRequest\x12\x16\n" +
+	"\x06domain\x18\x01 \x01(\tR\x06domain\"s\n" +
+	"\x19GetTenantByDomainResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\"\n" +
+	"\x04data\x18\x03 \x01(\v2\x0e.tenant.TenantR\x04data\"\xb2\x02\n" +
+	"\x12DomainVerification\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\x03R\btenantId\x12\x16\n" +
+	"\x06domain\x18\x02 \x01(\tR\x06domain\x12\x1f\n" +
+	"\vrecord_type\x18\x03 \x01(\tR\n" +
+	"recordType\x12\x1f\n" +
+	"\vrecord_name\x18\x04 \x01(\tR\n" +
+	"recordName\x12!\n" +
+	"\frecord_value\x18\x05 \x01(\tR\vrecordValue\x12\x1a\n" +
+	"\bverified\x18\x06 \x01(\bR\bverified\x12\x1f\n" +
+	"\vverified_at\x18\a \x01(\x03R\n" +
+	"verifiedAt\x12&\n" +
+	"\x0flast_checked_at\x18\b \x01(\x03R\rlastCheckedAt\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\t \x01(\tR\tlastError\";\n" +
+	"\x1cGetDomainVerificationRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\x03R\btenantId\"\x83\x01\n" +
+	"\x1dGetDomainVerificationResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12.\n" +
+	"\x04data\x18\x03 \x01(\v2\x1a.tenant.DomainVerificationR\x04data\"2\n" +
+	"\x13VerifyDomainRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\x03R\btenantId\"z\n" +
+	"\x14VerifyDomainResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12.\n" +
+	"\x04data\x18\x03 \x01(\v2\x1a.tenant.DomainVerificationR\x04data\"t\n" +
 	"\x13CreateTenantRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
 	"\x04slug\x18\x02 \x01(\tR\x04slug\x12\x16\n" +
@@ -2357,7 +5471,13 @@ const file_tenant_proto_rawDesc = "" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\"J\n" +
 	"\x14DeleteTenantResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\x95\x01\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"&\n" +
+	"\x14RestoreTenantRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"o\n" +
+	"\x15RestoreTenantResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\"\n" +
+	"\x04data\x18\x03 \x01(\v2\x0e.tenant.TenantR\x04data\"\x95\x01\n" +
 	"\x14GetAllTenantsRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x19\n" +
 	"\bper_page\x18\x02 \x01(\x05R\aperPage\x12\x16\n" +
@@ -2384,10 +5504,11 @@ const file_tenant_proto_rawDesc = "" +
 	"\x17AddUserToTenantResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12&\n" +
-	"\x04data\x18\x03 \x01(\v2\x12.tenant.TenantUserR\x04data\"S\n" +
+	"\x04data\x18\x03 \x01(\v2\x12.tenant.TenantUserR\x04data\"v\n" +
 	"\x1bRemoveUserFromTenantRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1b\n" +
-	"\ttenant_id\x18\x02 \x01(\x03R\btenantId\"R\n" +
+	"\ttenant_id\x18\x02 \x01(\x03R\btenantId\x12!\n" +
+	"\fperformed_by\x18\x03 \x01(\x03R\vperformedBy\"R\n" +
 	"\x1cRemoveUserFromTenantResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\"4\n" +
@@ -2396,17 +5517,180 @@ const file_tenant_proto_rawDesc = "" +
 	"\x16GetTenantUsersResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12&\n" +
-	"\x04data\x18\x03 \x03(\v2\x12.tenant.TenantUserR\x04data\"0\n" +
+	"\x04data\x18\x03 \x03(\v2\x12.tenant.TenantUserR\x04data\"2\n" +
+	"\x13GetSeatUsageRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\x03R\btenantId\"q\n" +
+	"\x14GetSeatUsageResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12%\n" +
+	"\x04data\x18\x03 \x01(\v2\x11.tenant.SeatUsageR\x04data\"\xd8\x01\n" +
+	"\x0fOwnershipChange\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\x03R\btenantId\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\tR\x06action\x12 \n" +
+	"\ffrom_user_id\x18\x04 \x01(\x03R\n" +
+	"fromUserId\x12\x1c\n" +
+	"\n" +
+	"to_user_id\x18\x05 \x01(\x03R\btoUserId\x12!\n" +
+	"\fperformed_by\x18\x06 \x01(\x03R\vperformedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\x03R\tcreatedAt\"\x9a\x01\n" +
+	"\x18TransferOwnershipRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\x03R\btenantId\x12 \n" +
+	"\ffrom_user_id\x18\x02 \x01(\x03R\n" +
+	"fromUserId\x12\x1c\n" +
+	"\n" +
+	"to_user_id\x18\x03 \x01(\x03R\btoUserId\x12!\n" +
+	"\fperformed_by\x18\x04 \x01(\x03R\vperformedBy\"|\n" +
+	"\x19TransferOwnershipResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12+\n" +
+	"\x04data\x18\x03 \x01(\v2\x17.tenant.OwnershipChangeR\x04data\"9\n" +
+	"\x1aGetOwnershipChangesRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\x03R\btenantId\"~\n" +
+	"\x1bGetOwnershipChangesResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12+\n" +
+	"\x04data\x18\x03 \x03(\v2\x17.tenant.OwnershipChangeR\x04data\"\x81\x01\n" +
+	"\x19InviteUserToTenantRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\x03R\btenantId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12\x1d\n" +
+	"\n" +
+	"invited_by\x18\x04 \x01(\x03R\tinvitedBy\"~\n" +
+	"\x1aInviteUserToTenantResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12,\n" +
+	"\x04data\x18\x03 \x01(\v2\x18.tenant.TenantInvitationR\x04data\"R\n" +
+	"\x1bGetTenantInvitationsRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\x03R\btenantId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"\x80\x01\n" +
+	"\x1cGetTenantInvitationsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12,\n" +
+	"\x04data\x18\x03 \x03(\v2\x18.tenant.TenantInvitationR\x04data\"3\n" +
+	"\x1bGetInvitationByTokenRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\xa8\x01\n" +
+	"\x1cGetInvitationByTokenResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12,\n" +
+	"\x04data\x18\x03 \x01(\v2\x18.tenant.TenantInvitationR\x04data\x12&\n" +
+	"\x06tenant\x18\x04 \x01(\v2\x0e.tenant.TenantR\x06tenant\"^\n" +
+	"\x17AcceptInvitationRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\"|\n" +
+	"\x18AcceptInvitationResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12,\n" +
+	"\x04data\x18\x03 \x01(\v2\x18.tenant.TenantInvitationR\x04data\"0\n" +
+	"\x18DeclineInvitationRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"}\n" +
+	"\x19DeclineInvitationResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12,\n" +
+	"\x04data\x18\x03 \x01(\v2\x18.tenant.TenantInvitationR\x04data\"F\n" +
+	"\x17RevokeInvitationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\x03R\btenantId\"|\n" +
+	"\x18RevokeInvitationResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12,\n" +
+	"\x04data\x18\x03 \x01(\v2\x18.tenant.TenantInvitationR\x04data\"F\n" +
+	"\x17ResendInvitationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\x03R\btenantId\"|\n" +
+	"\x18ResendInvitationResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12,\n" +
+	"\x04data\x18\x03 \x01(\v2\x18.tenant.TenantInvitationR\x04data\"@\n" +
+	"\n" +
+	"Permission\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\"\xee\x01\n" +
+	"\n" +
+	"TenantRole\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\x03R\btenantId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12 \n" +
+	"\vpermissions\x18\x05 \x03(\tR\vpermissions\x12\x1d\n" +
+	"\n" +
+	"is_builtin\x18\x06 \x01(\bR\tisBuiltin\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\x03R\tupdatedAt\"h\n" +
+	"\x10AuthorizeRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\x03R\btenantId\x12\x1e\n" +
+	"\n" +
+	"permission\x18\x03 \x01(\tR\n" +
+	"permission\"\x8d\x01\n" +
+	"\x11AuthorizeResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\aallowed\x18\x03 \x01(\bR\aallowed\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\"\xc7\x01\n" +
+	"\x1aAuthorizeRoleChangeRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\x03R\btenantId\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12 \n" +
+	"\vpermissions\x18\x04 \x03(\tR\vpermissions\x12\x17\n" +
+	"\arole_id\x18\x05 \x01(\x03R\x06roleId\x12$\n" +
+	"\x0etarget_user_id\x18\x06 \x01(\x03R\ftargetUserId\"\x83\x01\n" +
+	"\x1bAuthorizeRoleChangeResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\aallowed\x18\x03 \x01(\bR\aallowed\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"\x17\n" +
+	"\x15GetPermissionsRequest\"t\n" +
+	"\x16GetPermissionsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12&\n" +
+	"\x04data\x18\x03 \x03(\v2\x12.tenant.PermissionR\x04data\"4\n" +
+	"\x15GetTenantRolesRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\x03R\btenantId\"t\n" +
+	"\x16GetTenantRolesResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12&\n" +
+	"\x04data\x18\x03 \x03(\v2\x12.tenant.TenantRoleR\x04data\"\x8e\x01\n" +
+	"\x17CreateTenantRoleRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\x03R\btenantId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12 \n" +
+	"\vpermissions\x18\x04 \x03(\tR\vpermissions\"v\n" +
+	"\x18CreateTenantRoleResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12&\n" +
+	"\x04data\x18\x03 \x01(\v2\x12.tenant.TenantRoleR\x04data\"\x8a\x01\n" +
+	"\x17UpdateTenantRoleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\x03R\btenantId\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12 \n" +
+	"\vpermissions\x18\x04 \x03(\tR\vpermissions\"v\n" +
+	"\x18UpdateTenantRoleResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12&\n" +
+	"\x04data\x18\x03 \x01(\v2\x12.tenant.TenantRoleR\x04data\"F\n" +
+	"\x17DeleteTenantRoleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\x03R\btenantId\"N\n" +
+	"\x18DeleteTenantRoleResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"0\n" +
 	"\x15GetUserTenantsRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\"t\n" +
 	"\x16GetUserTenantsResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12&\n" +
-	"\x04data\x18\x03 \x03(\v2\x12.tenant.TenantUserR\x04data\"a\n" +
+	"\x04data\x18\x03 \x03(\v2\x12.tenant.TenantUserR\x04data\"\x84\x01\n" +
 	"\x15UpdateUserRoleRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1b\n" +
 	"\ttenant_id\x18\x02 \x01(\x03R\btenantId\x12\x12\n" +
-	"\x04role\x18\x03 \x01(\tR\x04role\"L\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12!\n" +
+	"\fperformed_by\x18\x04 \x01(\x03R\vperformedBy\"L\n" +
 	"\x16UpdateUserRoleResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\"O\n" +
@@ -2442,21 +5726,42 @@ const file_tenant_proto_rawDesc = "" +
 	"\x03key\x18\x02 \x01(\tR\x03key\"K\n" +
 	"\x15DeleteSettingResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage2\x80\v\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage2\xd3\x19\n" +
 	"\rTenantService\x12N\n" +
 	"\rGetTenantByID\x12\x1c.tenant.GetTenantByIDRequest\x1a\x1d.tenant.GetTenantByIDResponse\"\x00\x12T\n" +
 	"\x0fGetTenantByUUID\x12\x1e.tenant.GetTenantByUUIDRequest\x1a\x1f.tenant.GetTenantByUUIDResponse\"\x00\x12T\n" +
-	"\x0fGetTenantBySlug\x12\x1e.tenant.GetTenantBySlugRequest\x1a\x1f.tenant.GetTenantBySlugResponse\"\x00\x12K\n" +
+	"\x0fGetTenantBySlug\x12\x1e.tenant.GetTenantBySlugRequest\x1a\x1f.tenant.GetTenantBySlugResponse\"\x00\x12Z\n" +
+	"\x11GetTenantByDomain\x12 .tenant.GetTenantByDomainRequest\x1a!.tenant.GetTenantByDomainResponse\"\x00\x12K\n" +
 	"\fCreateTenant\x12\x1b.tenant.CreateTenantRequest\x1a\x1c.tenant.CreateTenantResponse\"\x00\x12K\n" +
 	"\fUpdateTenant\x12\x1b.tenant.UpdateTenantRequest\x1a\x1c.tenant.UpdateTenantResponse\"\x00\x12K\n" +
 	"\fDeleteTenant\x12\x1b.tenant.DeleteTenantRequest\x1a\x1c.tenant.DeleteTenantResponse\"\x00\x12N\n" +
-	"\rGetAllTenants\x12\x1c.tenant.GetAllTenantsRequest\x1a\x1d.tenant.GetAllTenantsResponse\"\x00\x12T\n" +
+	"\rRestoreTenant\x12\x1c.tenant.RestoreTenantRequest\x1a\x1d.tenant.RestoreTenantResponse\"\x00\x12N\n" +
+	"\rGetAllTenants\x12\x1c.tenant.GetAllTenantsRequest\x1a\x1d.tenant.GetAllTenantsResponse\"\x00\x12f\n" +
+	"\x15GetDomainVerification\x12$.tenant.GetDomainVerificationRequest\x1a%.tenant.GetDomainVerificationResponse\"\x00\x12K\n" +
+	"\fVerifyDomain\x12\x1b.tenant.VerifyDomainRequest\x1a\x1c.tenant.VerifyDomainResponse\"\x00\x12T\n" +
 	"\x0fAddUserToTenant\x12\x1e.tenant.AddUserToTenantRequest\x1a\x1f.tenant.AddUserToTenantResponse\"\x00\x12c\n" +
 	"\x14RemoveUserFromTenant\x12#.tenant.RemoveUserFromTenantRequest\x1a$.tenant.RemoveUserFromTenantResponse\"\x00\x12Q\n" +
 	"\x0eGetTenantUsers\x12\x1d.tenant.GetTenantUsersRequest\x1a\x1e.tenant.GetTenantUsersResponse\"\x00\x12Q\n" +
 	"\x0eGetUserTenants\x12\x1d.tenant.GetUserTenantsRequest\x1a\x1e.tenant.GetUserTenantsResponse\"\x00\x12Q\n" +
 	"\x0eUpdateUserRole\x12\x1d.tenant.UpdateUserRoleRequest\x1a\x1e.tenant.UpdateUserRoleResponse\"\x00\x12W\n" +
-	"\x10SetDefaultTenant\x12\x1f.tenant.SetDefaultTenantRequest\x1a .tenant.SetDefaultTenantResponse\"\x00\x12E\n" +
+	"\x10SetDefaultTenant\x12\x1f.tenant.SetDefaultTenantRequest\x1a .tenant.SetDefaultTenantResponse\"\x00\x12K\n" +
+	"\fGetSeatUsage\x12\x1b.tenant.GetSeatUsageRequest\x1a\x1c.tenant.GetSeatUsageResponse\"\x00\x12Z\n" +
+	"\x11TransferOwnership\x12 .tenant.TransferOwnershipRequest\x1a!.tenant.TransferOwnershipResponse\"\x00\x12`\n" +
+	"\x13GetOwnershipChanges\x12\".tenant.GetOwnershipChangesRequest\x1a#.tenant.GetOwnershipChangesResponse\"\x00\x12]\n" +
+	"\x12InviteUserToTenant\x12!.tenant.InviteUserToTenantRequest\x1a\".tenant.InviteUserToTenantResponse\"\x00\x12c\n" +
+	"\x14GetTenantInvitations\x12#.tenant.GetTenantInvitationsRequest\x1a$.tenant.GetTenantInvitationsResponse\"\x00\x12c\n" +
+	"\x14GetInvitationByToken\x12#.tenant.GetInvitationByTokenRequest\x1a$.tenant.GetInvitationByTokenResponse\"\x00\x12W\n" +
+	"\x10AcceptInvitation\x12\x1f.tenant.AcceptInvitationRequest\x1a .tenant.AcceptInvitationResponse\"\x00\x12Z\n" +
+	"\x11DeclineInvitation\x12 .tenant.DeclineInvitationRequest\x1a!.tenant.DeclineInvitationResponse\"\x00\x12W\n" +
+	"\x10RevokeInvitation\x12\x1f.tenant.RevokeInvitationRequest\x1a .tenant.RevokeInvitationResponse\"\x00\x12W\n" +
+	"\x10ResendInvitation\x12\x1f.tenant.ResendInvitationRequest\x1a .tenant.ResendInvitationResponse\"\x00\x12B\n" +
+	"\tAuthorize\x12\x18.tenant.AuthorizeRequest\x1a\x19.tenant.AuthorizeResponse\"\x00\x12`\n" +
+	"\x13AuthorizeRoleChange\x12\".tenant.AuthorizeRoleChangeRequest\x1a#.tenant.AuthorizeRoleChangeResponse\"\x00\x12Q\n" +
+	"\x0eGetPermissions\x12\x1d.tenant.GetPermissionsRequest\x1a\x1e.tenant.GetPermissionsResponse\"\x00\x12Q\n" +
+	"\x0eGetTenantRoles\x12\x1d.tenant.GetTenantRolesRequest\x1a\x1e.tenant.GetTenantRolesResponse\"\x00\x12W\n" +
+	"\x10CreateTenantRole\x12\x1f.tenant.CreateTenantRoleRequest\x1a .tenant.CreateTenantRoleResponse\"\x00\x12W\n" +
+	"\x10UpdateTenantRole\x12\x1f.tenant.UpdateTenantRoleRequest\x1a .tenant.UpdateTenantRoleResponse\"\x00\x12W\n" +
+	"\x10DeleteTenantRole\x12\x1f.tenant.DeleteTenantRoleRequest\x1a .tenant.DeleteTenantRoleResponse\"\x00\x12E\n" +
 	"\n" +
 	"GetSetting\x12\x19.tenant.GetSettingRequest\x1a\x1a.tenant.GetSettingResponse\"\x00\x12Q\n" +
 	"\x0eGetAllSettings\x12\x1d.tenant.GetAllSettingsRequest\x1a\x1e.tenant.GetAllSettingsResponse\"\x00\x12E\n" +
@@ -2476,100 +5781,209 @@ func file_tenant_proto_rawDescGZIP() []byte {
 	return file_tenant_proto_rawDescData
 }
 
-var file_tenant_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
+var file_tenant_proto_msgTypes = make([]protoimpl.MessageInfo, 86)
 var file_tenant_proto_goTypes = []any{
-	(*Tenant)(nil),                       // 0: tenant.Tenant
-	(*TenantUser)(nil),                   // 1: tenant.TenantUser
-	(*TenantSetting)(nil),                // 2: tenant.TenantSetting
-	(*GetTenantByIDRequest)(nil),         // 3: tenant.GetTenantByIDRequest
-	(*GetTenantByIDResponse)(nil),        // 4: tenant.GetTenantByIDResponse
-	(*GetTenantByUUIDRequest)(nil),       // 5: tenant.GetTenantByUUIDRequest
-	(*GetTenantByUUIDResponse)(nil),      // 6: tenant.GetTenantByUUIDResponse
-	(*GetTenantBySlugRequest)(nil),       // 7: tenant.GetTenantBySlugRequest
-	(*GetTenantBySlugResponse)(nil),      // 8: tenant.GetTenantBySlugResponse
-	(*CreateTenantRequest)(nil),          // 9: tenant.CreateTenantRequest
-	(*CreateTenantResponse)(nil),         // 10: tenant.CreateTenantResponse
-	(*UpdateTenantRequest)(nil),          // 11: tenant.UpdateTenantRequest
-	(*UpdateTenantResponse)(nil),         // 12: tenant.UpdateTenantResponse
-	(*DeleteTenantRequest)(nil),          // 13: tenant.DeleteTenantRequest
-	(*DeleteTenantResponse)(nil),         // 14: tenant.DeleteTenantResponse
-	(*GetAllTenantsRequest)(nil),         // 15: tenant.GetAllTenantsRequest
-	(*GetAllTenantsData)(nil),            // 16: tenant.GetAllTenantsData
-	(*GetAllTenantsResponse)(nil),        // 17: tenant.GetAllTenantsResponse
-	(*AddUserToTenantRequest)(nil),       // 18: tenant.AddUserToTenantRequest
-	(*AddUserToTenantResponse)(nil),      // 19: tenant.AddUserToTenantResponse
-	(*RemoveUserFromTenantRequest)(nil),  // 20: tenant.RemoveUserFromTenantRequest
-	(*RemoveUserFromTenantResponse)(nil), // 21: tenant.RemoveUserFromTenantResponse
-	(*GetTenantUsersRequest)(nil),        // 22: tenant.GetTenantUsersRequest
-	(*GetTenantUsersResponse)(nil),       // 23: tenant.GetTenantUsersResponse
-	(*GetUserTenantsRequest)(nil),        // 24: tenant.GetUserTenantsRequest
-	(*GetUserTenantsResponse)(nil),       // 25: tenant.GetUserTenantsResponse
-	(*UpdateUserRoleRequest)(nil),        // 26: tenant.UpdateUserRoleRequest
-	(*UpdateUserRoleResponse)(nil),       // 27: tenant.UpdateUserRoleResponse
-	(*SetDefaultTenantRequest)(nil),      // 28: tenant.SetDefaultTenantRequest
-	(*SetDefaultTenantResponse)(nil),     // 29: tenant.SetDefaultTenantResponse
-	(*GetSettingRequest)(nil),            // 30: tenant.GetSettingRequest
-	(*GetSettingResponse)(nil),           // 31: tenant.GetSettingResponse
-	(*GetAllSettingsRequest)(nil),        // 32: tenant.GetAllSettingsRequest
-	(*GetAllSettingsResponse)(nil),       // 33: tenant.GetAllSettingsResponse
-	(*SetSettingRequest)(nil),            // 34: tenant.SetSettingRequest
-	(*SetSettingResponse)(nil),           // 35: tenant.SetSettingResponse
-	(*DeleteSettingRequest)(nil),         // 36: tenant.DeleteSettingRequest
-	(*DeleteSettingResponse)(nil),        // 37: tenant.DeleteSettingResponse
+	(*Tenant)(nil),                        // 0: tenant.Tenant
+	(*TenantUser)(nil),                    // 1: tenant.TenantUser
+	(*SeatUsage)(nil),                     // 2: tenant.SeatUsage
+	(*TenantInvitation)(nil),              // 3: tenant.TenantInvitation
+	(*TenantSetting)(nil),                 // 4: tenant.TenantSetting
+	(*GetTenantByIDRequest)(nil),          // 5: tenant.GetTenantByIDRequest
+	(*GetTenantByIDResponse)(nil),         // 6: tenant.GetTenantByIDResponse
+	(*GetTenantByUUIDRequest)(nil),        // 7: tenant.GetTenantByUUIDRequest
+	(*GetTenantByUUIDResponse)(nil),       // 8: tenant.GetTenantByUUIDResponse
+	(*GetTenantBySlugRequest)(nil),        // 9: tenant.GetTenantBySlugRequest
+	(*GetTenantBySlugResponse)(nil),       // 10: tenant.GetTenantBySlugResponse
+	(*GetTenantByDomainRequest)(nil),      // 11: tenant.GetTenantByDomainRequest
+	(*GetTenantByDomainResponse)(nil),     // 12: tenant.GetTenantByDomainResponse
+	(*DomainVerification)(nil),            // 13: tenant.DomainVerification
+	(*GetDomainVerificationRequest)(nil),  // 14: tenant.GetDomainVerificationRequest
+	(*GetDomainVerificationResponse)(nil), // 15: tenant.GetDomainVerificationResponse
+	(*VerifyDomainRequest)(nil),           // 16: tenant.VerifyDomainRequest
+	(*VerifyDomainResponse)(nil),          // 17: tenant.VerifyDomainResponse
+	(*CreateTenantRequest)(nil),           // 18: tenant.CreateTenantRequest
+	(*CreateTenantResponse)(nil),          // 19: tenant.CreateTenantResponse
+	(*UpdateTenantRequest)(nil),           // 20: tenant.UpdateTenantRequest
+	(*UpdateTenantResponse)(nil),          // 21: tenant.UpdateTenantResponse
+	(*DeleteTenantRequest)(nil),           // 22: tenant.DeleteTenantRequest
+	(*DeleteTenantResponse)(nil),          // 23: tenant.DeleteTenantResponse
+	(*RestoreTenantRequest)(nil),          // 24: tenant.RestoreTenantRequest
+	(*RestoreTenantResponse)(nil),         // 25: tenant.RestoreTenantResponse
+	(*GetAllTenantsRequest)(nil),          // 26: tenant.GetAllTenantsRequest
+	(*GetAllTenantsData)(nil),             // 27: tenant.GetAllTenantsData
+	(*GetAllTenantsResponse)(nil),         // 28: tenant.GetAllTenantsResponse
+	(*AddUserToTenantRequest)(nil),        // 29: tenant.AddUserToTenantRequest
+	(*AddUserToTenantResponse)(nil),       // 30: tenant.AddUserToTenantResponse
+	(*RemoveUserFromTenantRequest)(nil),   // 31: tenant.RemoveUserFromTenantRequest
+	(*RemoveUserFromTenantResponse)(nil),  // 32: tenant.RemoveUserFromTenantResponse
+	(*GetTenantUsersRequest)(nil),         // 33: tenant.GetTenantUsersRequest
+	(*GetTenantUsersResponse)(nil),        // 34: tenant.GetTenantUsersResponse
+	(*GetSeatUsageRequest)(nil),           // 35: tenant.GetSeatUsageRequest
+	(*GetSeatUsageResponse)(nil),          // 36: tenant.GetSeatUsageResponse
+	(*OwnershipChange)(nil),               // 37: tenant.OwnershipChange
+	(*TransferOwnershipRequest)(nil),      // 38: tenant.TransferOwnershipRequest
+	(*TransferOwnershipResponse)(nil),     // 39: tenant.TransferOwnershipResponse
+	(*GetOwnershipChangesRequest)(nil),    // 40: tenant.GetOwnershipChangesRequest
+	(*GetOwnershipChangesResponse)(nil),   // 41: tenant.GetOwnershipChangesResponse
+	(*InviteUserToTenantRequest)(nil),     // 42: tenant.InviteUserToTenantRequest
+	(*InviteUserToTenantResponse)(nil),    // 43: tenant.InviteUserToTenantResponse
+	(*GetTenantInvitationsRequest)(nil),   // 44: tenant.GetTenantInvitationsRequest
+	(*GetTenantInvitationsResponse)(nil),  // 45: tenant.GetTenantInvitationsResponse
+	(*GetInvitationByTokenRequest)(nil),   // 46: tenant.GetInvitationByTokenRequest
+	(*GetInvitationByTokenResponse)(nil),  // 47: tenant.GetInvitationByTokenResponse
+	(*AcceptInvitationRequest)(nil),       // 48: tenant.AcceptInvitationRequest
+	(*AcceptInvitationResponse)(nil),      // 49: tenant.AcceptInvitationResponse
+	(*DeclineInvitationRequest)(nil),      // 50: tenant.DeclineInvitationRequest
+	(*DeclineInvitationResponse)(nil),     // 51: tenant.DeclineInvitationResponse
+	(*RevokeInvitationRequest)(nil),       // 52: tenant.RevokeInvitationRequest
+	(*RevokeInvitationResponse)(nil),      // 53: tenant.RevokeInvitationResponse
+	(*ResendInvitationRequest)(nil),       // 54: tenant.ResendInvitationRequest
+	(*ResendInvitationResponse)(nil),      // 55: tenant.ResendInvitationResponse
+	(*Permission)(nil),                    // 56: tenant.Permission
+	(*TenantRole)(nil),                    // 57: tenant.TenantRole
+	(*AuthorizeRequest)(nil),              // 58: tenant.AuthorizeRequest
+	(*AuthorizeResponse)(nil),             // 59: tenant.AuthorizeResponse
+	(*AuthorizeRoleChangeRequest)(nil),    // 60: tenant.AuthorizeRoleChangeRequest
+	(*AuthorizeRoleChangeResponse)(nil),   // 61: tenant.AuthorizeRoleChangeResponse
+	(*GetPermissionsRequest)(nil),         // 62: tenant.GetPermissionsRequest
+	(*GetPermissionsResponse)(nil),        // 63: tenant.GetPermissionsResponse
+	(*GetTenantRolesRequest)(nil),         // 64: tenant.GetTenantRolesRequest
+	(*GetTenantRolesResponse)(nil),        // 65: tenant.GetTenantRolesResponse
+	(*CreateTenantRoleRequest)(nil),       // 66: tenant.CreateTenantRoleRequest
+	(*CreateTenantRoleResponse)(nil),      // 67: tenant.CreateTenantRoleResponse
+	(*UpdateTenantRoleRequest)(nil),       // 68: tenant.UpdateTenantRoleRequest
+	(*UpdateTenantRoleResponse)(nil),      // 69: tenant.UpdateTenantRoleResponse
+	(*DeleteTenantRoleRequest)(nil),       // 70: tenant.DeleteTenantRoleRequest
+	(*DeleteTenantRoleResponse)(nil),      // 71: tenant.DeleteTenantRoleResponse
+	(*GetUserTenantsRequest)(nil),         // 72: tenant.GetUserTenantsRequest
+	(*GetUserTenantsResponse)(nil),        // 73: tenant.GetUserTenantsResponse
+	(*UpdateUserRoleRequest)(nil),         // 74: tenant.UpdateUserRoleRequest
+	(*UpdateUserRoleResponse)(nil),        // 75: tenant.UpdateUserRoleResponse
+	(*SetDefaultTenantRequest)(nil),       // 76: tenant.SetDefaultTenantRequest
+	(*SetDefaultTenantResponse)(nil),      // 77: tenant.SetDefaultTenantResponse
+	(*GetSettingRequest)(nil),             // 78: tenant.GetSettingRequest
+	(*GetSettingResponse)(nil),            // 79: tenant.GetSettingResponse
+	(*GetAllSettingsRequest)(nil),         // 80: tenant.GetAllSettingsRequest
+	(*GetAllSettingsResponse)(nil),        // 81: tenant.GetAllSettingsResponse
+	(*SetSettingRequest)(nil),             // 82: tenant.SetSettingRequest
+	(*SetSettingResponse)(nil),            // 83: tenant.SetSettingResponse
+	(*DeleteSettingRequest)(nil),          // 84: tenant.DeleteSettingRequest
+	(*DeleteSettingResponse)(nil),         // 85: tenant.DeleteSettingResponse
 }
 var file_tenant_proto_depIdxs = []int32{
 	0,  // 0: tenant.GetTenantByIDResponse.data:type_name -> tenant.Tenant
 	0,  // 1: tenant.GetTenantByUUIDResponse.data:type_name -> tenant.Tenant
 	0,  // 2: tenant.GetTenantBySlugResponse.data:type_name -> tenant.Tenant
-	0,  // 3: tenant.CreateTenantResponse.data:type_name -> tenant.Tenant
-	0,  // 4: tenant.UpdateTenantResponse.data:type_name -> tenant.Tenant
-	0,  // 5: tenant.GetAllTenantsData.tenants:type_name -> tenant.Tenant
-	16, // 6: tenant.GetAllTenantsResponse.data:type_name -> tenant.GetAllTenantsData
-	1,  // 7: tenant.AddUserToTenantResponse.data:type_name -> tenant.TenantUser
-	1,  // 8: tenant.GetTenantUsersResponse.data:type_name -> tenant.TenantUser
-	1,  // 9: tenant.GetUserTenantsResponse.data:type_name -> tenant.TenantUser
-	2,  // 10: tenant.GetSettingResponse.data:type_name -> tenant.TenantSetting
-	2,  // 11: tenant.GetAllSettingsResponse.data:type_name -> tenant.TenantSetting
-	2,  // 12: tenant.SetSettingResponse.data:type_name -> tenant.TenantSetting
-	3,  // 13: tenant.TenantService.GetTenantByID:input_type -> tenant.GetTenantByIDRequest
-	5,  // 14: tenant.TenantService.GetTenantByUUID:input_type -> tenant.GetTenantByUUIDRequest
-	7,  // 15: tenant.TenantService.GetTenantBySlug:input_type -> tenant.GetTenantBySlugRequest
-	9,  // 16: tenant.TenantService.CreateTenant:input_type -> tenant.CreateTenantRequest
-	11, // 17: tenant.TenantService.UpdateTenant:input_type -> tenant.UpdateTenantRequest
-	13, // 18: tenant.TenantService.DeleteTenant:input_type -> tenant.DeleteTenantRequest
-	15, // 19: tenant.TenantService.GetAllTenants:input_type -> tenant.GetAllTenantsRequest
-	18, // 20: tenant.TenantService.AddUserToTenant:input_type -> tenant.AddUserToTenantRequest
-	20, // 21: tenant.TenantService.RemoveUserFromTenant:input_type -> tenant.RemoveUserFromTenantRequest
-	22, // 22: tenant.TenantService.GetTenantUsers:input_type -> tenant.GetTenantUsersRequest
-	24, // 23: tenant.TenantService.GetUserTenants:input_type -> tenant.GetUserTenantsRequest
-	26, // 24: tenant.TenantService.UpdateUserRole:input_type -> tenant.UpdateUserRoleRequest
-	28, // 25: tenant.TenantService.SetDefaultTenant:input_type -> tenant.SetDefaultTenantRequest
-	30, // 26: tenant.TenantService.GetSetting:input_type -> tenant.GetSettingRequest
-	32, // 27: tenant.TenantService.GetAllSettings:input_type -> tenant.GetAllSettingsRequest
-	34, // 28: tenant.TenantService.SetSetting:input_type -> tenant.SetSettingRequest
-	36, // 29: tenant.TenantService.DeleteSetting:input_type -> tenant.DeleteSettingRequest
-	4,  // 30: tenant.TenantService.GetTenantByID:output_type -> tenant.GetTenantByIDResponse
-	6,  // 31: tenant.TenantService.GetTenantByUUID:output_type -> tenant.GetTenantByUUIDResponse
-	8,  // 32: tenant.TenantService.GetTenantBySlug:output_type -> tenant.GetTenantBySlugResponse
-	10, // 33: tenant.TenantService.CreateTenant:output_type -> tenant.CreateTenantResponse
-	12, // 34: tenant.TenantService.UpdateTenant:output_type -> tenant.UpdateTenantResponse
-	14, // 35: tenant.TenantService.DeleteTenant:output_type -> tenant.DeleteTenantResponse
-	17, // 36: tenant.TenantService.GetAllTenants:output_type -> tenant.GetAllTenantsResponse
-	19, // 37: tenant.TenantService.AddUserToTenant:output_type -> tenant.AddUserToTenantResponse
-	21, // 38: tenant.TenantService.RemoveUserFromTenant:output_type -> tenant.RemoveUserFromTenantResponse
-	23, // 39: tenant.TenantService.GetTenantUsers:output_type -> tenant.GetTenantUsersResponse
-	25, // 40: tenant.TenantService.GetUserTenants:output_type -> tenant.GetUserTenantsResponse
-	27, // 41: tenant.TenantService.UpdateUserRole:output_type -> tenant.UpdateUserRoleResponse
-	29, // 42: tenant.TenantService.SetDefaultTenant:output_type -> tenant.SetDefaultTenantResponse
-	31, // 43: tenant.TenantService.GetSetting:output_type -> tenant.GetSettingResponse
-	33, // 44: tenant.TenantService.GetAllSettings:output_type -> tenant.GetAllSettingsResponse
-	35, // 45: tenant.TenantService.SetSetting:output_type -> tenant.SetSettingResponse
-	37, // 46: tenant.TenantService.DeleteSetting:output_type -> tenant.DeleteSettingResponse
-	30, // [30:47] is the sub-list for method output_type
-	13, // [13:30] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	0,  // 3: tenant.GetTenantByDomainResponse.data:type_name -> tenant.Tenant
+	13, // 4: tenant.GetDomainVerificationResponse.data:type_name -> tenant.DomainVerification
+	13, // 5: tenant.VerifyDomainResponse.data:type_name -> tenant.DomainVerification
+	0,  // 6: tenant.CreateTenantResponse.data:type_name -> tenant.Tenant
+	0,  // 7: tenant.UpdateTenantResponse.data:type_name -> tenant.Tenant
+	0,  // 8: tenant.RestoreTenantResponse.data:type_name -> tenant.Tenant
+	0,  // 9: tenant.GetAllTenantsData.tenants:type_name -> tenant.Tenant
+	27, // 10: tenant.GetAllTenantsResponse.data:type_name -> tenant.GetAllTenantsData
+	1,  // 11: tenant.AddUserToTenantResponse.data:type_name -> tenant.TenantUser
+	1,  // 12: tenant.GetTenantUsersResponse.data:type_name -> tenant.TenantUser
+	2,  // 13: tenant.GetSeatUsageResponse.data:type_name -> tenant.SeatUsage
+	37, // 14: tenant.TransferOwnershipResponse.data:type_name -> tenant.OwnershipChange
+	37, // 15: tenant.GetOwnershipChangesResponse.data:type_name -> tenant.OwnershipChange
+	3,  // 16: tenant.InviteUserToTenantResponse.data:type_name -> tenant.TenantInvitation
+	3,  // 17: tenant.GetTenantInvitationsResponse.data:type_name -> tenant.TenantInvitation
+	3,  // 18: tenant.GetInvitationByTokenResponse.data:type_name -> tenant.TenantInvitation
+	0,  // 19: tenant.GetInvitationByTokenResponse.tenant:type_name -> tenant.Tenant
+	3,  // 20: tenant.AcceptInvitationResponse.data:type_name -> tenant.TenantInvitation
+	3,  // 21: tenant.DeclineInvitationResponse.data:type_name -> tenant.TenantInvitation
+	3,  // 22: tenant.RevokeInvitationResponse.data:type_name -> tenant.TenantInvitation
+	3,  // 23: tenant.ResendInvitationResponse.data:type_name -> tenant.TenantInvitation
+	56, // 24: tenant.GetPermissionsResponse.data:type_name -> tenant.Permission
+	57, // 25: tenant.GetTenantRolesResponse.data:type_name -> tenant.TenantRole
+	57, // 26: tenant.CreateTenantRoleResponse.data:type_name -> tenant.TenantRole
+	57, // 27: tenant.UpdateTenantRoleResponse.data:type_name -> tenant.TenantRole
+	1,  // 28: tenant.GetUserTenantsResponse.data:type_name -> tenant.TenantUser
+	4,  // 29: tenant.GetSettingResponse.data:type_name -> tenant.TenantSetting
+	4,  // 30: tenant.GetAllSettingsResponse.data:type_name -> tenant.TenantSetting
+	4,  // 31: tenant.SetSettingResponse.data:type_name -> tenant.TenantSetting
+	5,  // 32: tenant.TenantService.GetTenantByID:input_type -> tenant.GetTenantByIDRequest
+	7,  // 33: tenant.TenantService.GetTenantByUUID:input_type -> tenant.GetTenantByUUIDRequest
+	9,  // 34: tenant.TenantService.GetTenantBySlug:input_type -> tenant.GetTenantBySlugRequest
+	11, // 35: tenant.TenantService.GetTenantByDomain:input_type -> tenant.GetTenantByDomainRequest
+	18, // 36: tenant.TenantService.CreateTenant:input_type -> tenant.CreateTenantRequest
+	20, // 37: tenant.TenantService.UpdateTenant:input_type -> tenant.UpdateTenantRequest
+	22, // 38: tenant.TenantService.DeleteTenant:input_type -> tenant.DeleteTenantRequest
+	24, // 39: tenant.TenantService.RestoreTenant:input_type -> tenant.RestoreTenantRequest
+	26, // 40: tenant.TenantService.GetAllTenants:input_type -> tenant.GetAllTenantsRequest
+	14, // 41: tenant.TenantService.GetDomainVerification:input_type -> tenant.GetDomainVerificationRequest
+	16, // 42: tenant.TenantService.VerifyDomain:input_type -> tenant.VerifyDomainRequest
+	29, // 43: tenant.TenantService.AddUserToTenant:input_type -> tenant.AddUserToTenantRequest
+	31, // 44: tenant.TenantService.RemoveUserFromTenant:input_type -> tenant.RemoveUserFromTenantRequest
+	33, // 45: tenant.TenantService.GetTenantUsers:input_type -> tenant.GetTenantUsersRequest
+	72, // 46: tenant.TenantService.GetUserTenants:input_type -> tenant.GetUserTenantsRequest
+	74, // 47: tenant.TenantService.UpdateUserRole:input_type -> tenant.UpdateUserRoleRequest
+	76, // 48: tenant.TenantService.SetDefaultTenant:input_type -> tenant.SetDefaultTenantRequest
+	35, // 49: tenant.TenantService.GetSeatUsage:input_type -> tenant.GetSeatUsageRequest
+	38, // 50: tenant.TenantService.TransferOwnership:input_type -> tenant.TransferOwnershipRequest
+	40, // 51: tenant.TenantService.GetOwnershipChanges:input_type -> tenant.GetOwnershipChangesRequest
+	42, // 52: tenant.TenantService.InviteUserToTenant:input_type -> tenant.InviteUserToTenantRequest
+	44, // 53: tenant.TenantService.GetTenantInvitations:input_type -> tenant.GetTenantInvitationsRequest
+	46, // 54: tenant.TenantService.GetInvitationByToken:input_type -> tenant.GetInvitationByTokenRequest
+	48, // 55: tenant.TenantService.AcceptInvitation:input_type -> tenant.AcceptInvitationRequest
+	50, // 56: tenant.TenantService.DeclineInvitation:input_type -> tenant.DeclineInvitationRequest
+	52, // 57: tenant.TenantService.RevokeInvitation:input_type -> tenant.RevokeInvitationRequest
+	54, // 58: tenant.TenantService.ResendInvitation:input_type -> tenant.ResendInvitationRequest
+	58, // 59: tenant.TenantService.Authorize:input_type -> tenant.AuthorizeRequest
+	60, // 60: tenant.TenantService.AuthorizeRoleChange:input_type -> tenant.AuthorizeRoleChangeRequest
+	62, // 61: tenant.TenantService.GetPermissions:input_type -> tenant.GetPermissionsRequest
+	64, // 62: tenant.TenantService.GetTenantRoles:input_type -> tenant.GetTenantRolesRequest
+	66, // 63: tenant.TenantService.CreateTenantRole:input_type -> tenant.CreateTenantRoleRequest
+	68, // 64: tenant.TenantService.UpdateTenantRole:input_type -> tenant.UpdateTenantRoleRequest
+	70, // 65: tenant.TenantService.DeleteTenantRole:input_type -> tenant.DeleteTenantRoleRequest
+	78, // 66: tenant.TenantService.GetSetting:input_type -> tenant.GetSettingRequest
+	80, // 67: tenant.TenantService.GetAllSettings:input_type -> tenant.GetAllSettingsRequest
+	82, // 68: tenant.TenantService.SetSetting:input_type -> tenant.SetSettingRequest
+	84, // 69: tenant.TenantService.DeleteSetting:input_type -> tenant.DeleteSettingRequest
+	6,  // 70: tenant.TenantService.GetTenantByID:output_type -> tenant.GetTenantByIDResponse
+	8,  // 71: tenant.TenantService.GetTenantByUUID:output_type -> tenant.GetTenantByUUIDResponse
+	10, // 72: tenant.TenantService.GetTenantBySlug:output_type -> tenant.GetTenantBySlugResponse
+	12, // 73: tenant.TenantService.GetTenantByDomain:output_type -> tenant.GetTenantByDomainResponse
+	19, // 74: tenant.TenantService.CreateTenant:output_type -> tenant.CreateTenantResponse
+	21, // 75: tenant.TenantService.UpdateTenant:output_type -> tenant.UpdateTenantResponse
+	23, // 76: tenant.TenantService.DeleteTenant:output_type -> tenant.DeleteTenantResponse
+	25, // 77: tenant.TenantService.RestoreTenant:output_type -> tenant.RestoreTenantResponse
+	28, // 78: tenant.TenantService.GetAllTenants:output_type -> tenant.GetAllTenantsResponse
+	15, // 79: tenant.TenantService.GetDomainVerification:output_type -> tenant.GetDomainVerificationResponse
+	17, // 80: tenant.TenantService.VerifyDomain:output_type -> tenant.VerifyDomainResponse
+	30, // 81: tenant.TenantService.AddUserToTenant:output_type -> tenant.AddUserToTenantResponse
+	32, // 82: tenant.TenantService.RemoveUserFromTenant:output_type -> tenant.RemoveUserFromTenantResponse
+	34, // 83: tenant.TenantService.GetTenantUsers:output_type -> tenant.GetTenantUsersResponse
+	73, // 84: tenant.TenantService.GetUserTenants:output_type -> tenant.GetUserTenantsResponse
+	75, // 85: tenant.TenantService.UpdateUserRole:output_type -> tenant.UpdateUserRoleResponse
+	77, // 86: tenant.TenantService.SetDefaultTenant:output_type -> tenant.SetDefaultTenantResponse
+	36, // 87: tenant.TenantService.GetSeatUsage:output_type -> tenant.GetSeatUsageResponse
+	39, // 88: tenant.TenantService.TransferOwnership:output_type -> tenant.TransferOwnershipResponse
+	41, // 89: tenant.TenantService.GetOwnershipChanges:output_type -> tenant.GetOwnershipChangesResponse
+	43, // 90: tenant.TenantService.InviteUserToTenant:output_type -> tenant.InviteUserToTenantResponse
+	45, // 91: tenant.TenantService.GetTenantInvitations:output_type -> tenant.GetTenantInvitationsResponse
+	47, // 92: tenant.TenantService.GetInvitationByToken:output_type -> tenant.GetInvitationByTokenResponse
+	49, // 93: tenant.TenantService.AcceptInvitation:output_type -> tenant.AcceptInvitationResponse
+	51, // 94: tenant.TenantService.DeclineInvitation:output_type -> tenant.DeclineInvitationResponse
+	53, // 95: tenant.TenantService.RevokeInvitation:output_type -> tenant.RevokeInvitationResponse
+	55, // 96: tenant.TenantService.ResendInvitation:output_type -> tenant.ResendInvitationResponse
+	59, // 97: tenant.TenantService.Authorize:output_type -> tenant.AuthorizeResponse
+	61, // 98: tenant.TenantService.AuthorizeRoleChange:output_type -> tenant.AuthorizeRoleChangeResponse
+	63, // 99: tenant.TenantService.GetPermissions:output_type -> tenant.GetPermissionsResponse
+	65, // 100: tenant.TenantService.GetTenantRoles:output_type -> tenant.GetTenantRolesResponse
+	67, // 101: tenant.TenantService.CreateTenantRole:output_type -> tenant.CreateTenantRoleResponse
+	69, // 102: tenant.TenantService.UpdateTenantRole:output_type -> tenant.UpdateTenantRoleResponse
+	71, // 103: tenant.TenantService.DeleteTenantRole:output_type -> tenant.DeleteTenantRoleResponse
+	79, // 104: tenant.TenantService.GetSetting:output_type -> tenant.GetSettingResponse
+	81, // 105: tenant.TenantService.GetAllSettings:output_type -> tenant.GetAllSettingsResponse
+	83, // 106: tenant.TenantService.SetSetting:output_type -> tenant.SetSettingResponse
+	85, // 107: tenant.TenantService.DeleteSetting:output_type -> tenant.DeleteSettingResponse
+	70, // [70:108] is the sub-list for method output_type
+	32, // [32:70] is the sub-list for method input_type
+	32, // [32:32] is the sub-list for extension type_name
+	32, // [32:32] is the sub-list for extension extendee
+	0,  // [0:32] is the sub-list for field type_name
 }
 
 func init() { file_tenant_proto_init() }
@@ -2583,7 +5997,7 @@ func file_tenant_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_tenant_proto_rawDesc), len(file_tenant_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   38,
+			NumMessages:   86,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,23 +19,44 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	TenantService_GetTenantByID_FullMethodName        = "/tenant.TenantService/GetTenantByID"
-	TenantService_GetTenantByUUID_FullMethodName      = "/tenant.TenantService/GetTenantByUUID"
-	TenantService_GetTenantBySlug_FullMethodName      = "/tenant.TenantService/GetTenantBySlug"
-	TenantService_CreateTenant_FullMethodName         = "/tenant.TenantService/CreateTenant"
-	TenantService_UpdateTenant_FullMethodName         = "/tenant.TenantService/UpdateTenant"
-	TenantService_DeleteTenant_FullMethodName         = "/tenant.TenantService/DeleteTenant"
-	TenantService_GetAllTenants_FullMethodName        = "/tenant.TenantService/GetAllTenants"
-	TenantService_AddUserToTenant_FullMethodName      = "/tenant.TenantService/AddUserToTenant"
-	TenantService_RemoveUserFromTenant_FullMethodName = "/tenant.TenantService/RemoveUserFromTenant"
-	TenantService_GetTenantUsers_FullMethodName       = "/tenant.TenantService/GetTenantUsers"
-	TenantService_GetUserTenants_FullMethodName       = "/tenant.TenantService/GetUserTenants"
-	TenantService_UpdateUserRole_FullMethodName       = "/tenant.TenantService/UpdateUserRole"
-	TenantService_SetDefaultTenant_FullMethodName     = "/tenant.TenantService/SetDefaultTenant"
-	TenantService_GetSetting_FullMethodName           = "/tenant.TenantService/GetSetting"
-	TenantService_GetAllSettings_FullMethodName       = "/tenant.TenantService/GetAllSettings"
-	TenantService_SetSetting_FullMethodName           = "/tenant.TenantService/SetSetting"
-	TenantService_DeleteSetting_FullMethodName        = "/tenant.TenantService/DeleteSetting"
+	TenantService_GetTenantByID_FullMethodName         = "/tenant.TenantService/GetTenantByID"
+	TenantService_GetTenantByUUID_FullMethodName       = "/tenant.TenantService/GetTenantByUUID"
+	TenantService_GetTenantBySlug_FullMethodName       = "/tenant.TenantService/GetTenantBySlug"
+	TenantService_GetTenantByDomain_FullMethodName     = "/tenant.TenantService/GetTenantByDomain"
+	TenantService_CreateTenant_FullMethodName          = "/tenant.TenantService/CreateTenant"
+	TenantService_UpdateTenant_FullMethodName          = "/tenant.TenantService/UpdateTenant"
+	TenantService_DeleteTenant_FullMethodName          = "/tenant.TenantService/DeleteTenant"
+	TenantService_RestoreTenant_FullMethodName         = "/tenant.TenantService/RestoreTenant"
+	TenantService_GetAllTenants_FullMethodName         = "/tenant.TenantService/GetAllTenants"
+	TenantService_GetDomainVerification_FullMethodName = "/tenant.TenantService/GetDomainVerification"
+	TenantService_VerifyDomain_FullMethodName          = "/tenant.TenantService/VerifyDomain"
+	TenantService_AddUserToTenant_FullMethodName       = "/tenant.TenantService/AddUserToTenant"
+	TenantService_RemoveUserFromTenant_FullMethodName  = "/tenant.TenantService/RemoveUserFromTenant"
+	TenantService_GetTenantUsers_FullMethodName        = "/tenant.TenantService/GetTenantUsers"
+	TenantService_GetUserTenants_FullMethodName        = "/tenant.TenantService/GetUserTenants"
+	TenantService_UpdateUserRole_FullMethodName        = "/tenant.TenantService/UpdateUserRole"
+	TenantService_SetDefaultTenant_FullMethodName      = "/tenant.TenantService/SetDefaultTenant"
+	TenantService_GetSeatUsage_FullMethodName          = "/tenant.TenantService/GetSeatUsage"
+	TenantService_TransferOwnership_FullMethodName     = "/tenant.TenantService/TransferOwnership"
+	TenantService_GetOwnershipChanges_FullMethodName   = "/tenant.TenantService/GetOwnershipChanges"
+	TenantService_InviteUserToTenant_FullMethodName    = "/tenant.TenantService/InviteUserToTenant"
+	TenantService_GetTenantInvitations_FullMethodName  = "/tenant.TenantService/GetTenantInvitations"
+	TenantService_GetInvitationByToken_FullMethodName  = "/tenant.TenantService/GetInvitationByToken"
+	TenantService_AcceptInvitation_FullMethodName      = "/tenant.TenantService/AcceptInvitation"
+	TenantService_DeclineInvitation_FullMethodName     = "/tenant.TenantService/DeclineInvitation"
+	TenantService_RevokeInvitation_FullMethodName      = "/tenant.TenantService/RevokeInvitation"
+	TenantService_ResendInvitation_FullMethodName      = "/tenant.TenantService/ResendInvitation"
+	TenantService_Authorize_FullMethodName             = "/tenant.TenantService/Authorize"
+	TenantService_AuthorizeRoleChange_FullMethodName   = "/tenant.TenantService/AuthorizeRoleChange"
+	TenantService_GetPermissions_FullMethodName        = "/tenant.TenantService/GetPermissions"
+	TenantService_GetTenantRoles_FullMethodName        = "/tenant.TenantService/GetTenantRoles"
+	TenantService_CreateTenantRole_FullMethodName      = "/tenant.TenantService/CreateTenantRole"
+	TenantService_UpdateTenantRole_FullMethodName      = "/tenant.TenantService/UpdateTenantRole"
+	TenantService_DeleteTenantRole_FullMethodName      = "/tenant.TenantService/DeleteTenantRole"
+	TenantService_GetSetting_FullMethodName            = "/tenant.TenantService/GetSetting"
+	TenantService_GetAllSettings_FullMethodName        = "/tenant.TenantService/GetAllSettings"
+	TenantService_SetSetting_FullMethodName            = "/tenant.TenantService/SetSetting"
+	TenantService_DeleteSetting_FullMethodName         = "/tenant.TenantService/DeleteSetting"
 )
 
 // TenantServiceClient is the client API for TenantService service.
@@ -46,10 +67,15 @@ type TenantServiceClient interface {
 	GetTenantByID(ctx context.Context, in *GetTenantByIDRequest, opts ...grpc.CallOption) (*GetTenantByIDResponse, error)
 	GetTenantByUUID(ctx context.Context, in *GetTenantByUUIDRequest, opts ...grpc.CallOption) (*GetTenantByUUIDResponse, error)
 	GetTenantBySlug(ctx context.Context, in *GetTenantBySlugRequest, opts ...grpc.CallOption) (*GetTenantBySlugResponse, error)
+	GetTenantByDomain(ctx context.Context, in *GetTenantByDomainRequest, opts ...grpc.CallOption) (*GetTenantByDomainResponse, error)
 	CreateTenant(ctx context.Context, in *CreateTenantRequest, opts ...grpc.CallOption) (*CreateTenantResponse, error)
 	UpdateTenant(ctx context.Context, in *UpdateTenantRequest, opts ...grpc.CallOption) (*UpdateTenantResponse, error)
 	DeleteTenant(ctx context.Context, in *DeleteTenantRequest, opts ...grpc.CallOption) (*DeleteTenantResponse, error)
+	RestoreTenant(ctx context.Context, in *RestoreTenantRequest, opts ...grpc.CallOption) (*RestoreTenantResponse, error)
 	GetAllTenants(ctx context.Context, in *GetAllTenantsRequest, opts ...grpc.CallOption) (*GetAllTenantsResponse, error)
+	// Custom domain operations
+	GetDomainVerification(ctx context.Context, in *GetDomainVerificationRequest, opts ...grpc.CallOption) (*GetDomainVerificationResponse, error)
+	VerifyDomain(ctx context.Context, in *VerifyDomainRequest, opts ...grpc.CallOption) (*VerifyDomainResponse, error)
 	// TenantUser operations
 	AddUserToTenant(ctx context.Context, in *AddUserToTenantRequest, opts ...grpc.CallOption) (*AddUserToTenantResponse, error)
 	RemoveUserFromTenant(ctx context.Context, in *RemoveUserFromTenantRequest, opts ...grpc.CallOption) (*RemoveUserFromTenantResponse, error)
@@ -57,6 +83,26 @@ type TenantServiceClient interface {
 	GetUserTenants(ctx context.Context, in *GetUserTenantsRequest, opts ...grpc.CallOption) (*GetUserTenantsResponse, error)
 	UpdateUserRole(ctx context.Context, in *UpdateUserRoleRequest, opts ...grpc.CallOption) (*UpdateUserRoleResponse, error)
 	SetDefaultTenant(ctx context.Context, in *SetDefaultTenantRequest, opts ...grpc.CallOption) (*SetDefaultTenantResponse, error)
+	GetSeatUsage(ctx context.Context, in *GetSeatUsageRequest, opts ...grpc.CallOption) (*GetSeatUsageResponse, error)
+	// Ownership operations
+	TransferOwnership(ctx context.Context, in *TransferOwnershipRequest, opts ...grpc.CallOption) (*TransferOwnershipResponse, error)
+	GetOwnershipChanges(ctx context.Context, in *GetOwnershipChangesRequest, opts ...grpc.CallOption) (*GetOwnershipChangesResponse, error)
+	// Invitation operations
+	InviteUserToTenant(ctx context.Context, in *InviteUserToTenantRequest, opts ...grpc.CallOption) (*InviteUserToTenantResponse, error)
+	GetTenantInvitations(ctx context.Context, in *GetTenantInvitationsRequest, opts ...grpc.CallOption) (*GetTenantInvitationsResponse, error)
+	GetInvitationByToken(ctx context.Context, in *GetInvitationByTokenRequest, opts ...grpc.CallOption) (*GetInvitationByTokenResponse, error)
+	AcceptInvitation(ctx context.Context, in *AcceptInvitationRequest, opts ...grpc.CallOption) (*AcceptInvitationResponse, error)
+	DeclineInvitation(ctx context.Context, in *DeclineInvitationRequest, opts ...grpc.CallOption) (*DeclineInvitationResponse, error)
+	RevokeInvitation(ctx context.Context, in *RevokeInvitationRequest, opts ...grpc.CallOption) (*RevokeInvitationResponse, error)
+	ResendInvitation(ctx context.Context, in *ResendInvitationRequest, opts ...grpc.CallOption) (*ResendInvitationResponse, error)
+	// Role and permission operations
+	Authorize(ctx context.Context, in *AuthorizeRequest, opts ...grpc.CallOption) (*AuthorizeResponse, error)
+	AuthorizeRoleChange(ctx context.Context, in *AuthorizeRoleChangeRequest, opts ...grpc.CallOption) (*AuthorizeRoleChangeResponse, error)
+	GetPermissions(ctx context.Context, in *GetPermissionsRequest, opts ...grpc.CallOption) (*GetPermissionsResponse, error)
+	GetTenantRoles(ctx context.Context, in *GetTenantRolesRequest, opts ...grpc.CallOption) (*GetTenantRolesResponse, error)
+	CreateTenantRole(ctx context.Context, in *CreateTenantRoleRequest, opts ...grpc.CallOption) (*CreateTenantRoleResponse, error)
+	UpdateTenantRole(ctx context.Context, in *UpdateTenantRoleRequest, opts ...grpc.CallOption) (*UpdateTenantRoleResponse, error)
+	DeleteTenantRole(ctx context.Context, in *DeleteTenantRoleRequest, opts ...grpc.CallOption) (*DeleteTenantRoleResponse, error)
 	// TenantSetting operations
 	GetSetting(ctx context.Context, in *GetSettingRequest, opts ...grpc.CallOption) (*GetSettingResponse, error)
 	GetAllSettings(ctx context.Context, in *GetAllSettingsRequest, opts ...grpc.CallOption) (*GetAllSettingsResponse, error)
@@ -102,6 +148,16 @@ func (c *tenantServiceClient) GetTenantBySlug(ctx context.Context, in *GetTenant
 	return out, nil
 }
 
+func (c *tenantServiceClient) GetTenantByDomain(ctx context.Context, in *GetTenantByDomainRequest, opts ...grpc.CallOption) (*GetTenantByDomainResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTenantByDomainResponse)
+	err := c.cc.Invoke(ctx, TenantService_GetTenantByDomain_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tenantServiceClient) CreateTenant(ctx context.Context, in *CreateTenantRequest, opts ...grpc.CallOption) (*CreateTenantResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateTenantResponse)
@@ -132,6 +188,16 @@ func (c *tenantServiceClient) DeleteTenant(ctx context.Context, in *DeleteTenant
 	return out, nil
 }
 
+func (c *tenantServiceClient) RestoreTenant(ctx context.Context, in *RestoreTenantRequest, opts ...grpc.CallOption) (*RestoreTenantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreTenantResponse)
+	err := c.cc.Invoke(ctx, TenantService_RestoreTenant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tenantServiceClient) GetAllTenants(ctx context.Context, in *GetAllTenantsRequest, opts ...grpc.CallOption) (*GetAllTenantsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetAllTenantsResponse)
@@ -142,6 +208,26 @@ func (c *tenantServiceClient) GetAllTenants(ctx context.Context, in *GetAllTenan
 	return out, nil
 }
 
+func (c *tenantServiceClient) GetDomainVerification(ctx context.Context, in *GetDomainVerificationRequest, opts ...grpc.CallOption) (*GetDomainVerificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDomainVerificationResponse)
+	err := c.cc.Invoke(ctx, TenantService_GetDomainVerification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantServiceClient) VerifyDomain(ctx context.Context, in *VerifyDomainRequest, opts ...grpc.CallOption) (*VerifyDomainResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyDomainResponse)
+	err := c.cc.Invoke(ctx, TenantService_VerifyDomain_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tenantServiceClient) AddUserToTenant(ctx context.Context, in *AddUserToTenantRequest, opts ...grpc.CallOption) (*AddUserToTenantResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AddUserToTenantResponse)
@@ -202,6 +288,176 @@ func (c *tenantServiceClient) SetDefaultTenant(ctx context.Context, in *SetDefau
 	return out, nil
 }
 
+func (c *tenantServiceClient) GetSeatUsage(ctx context.Context, in *GetSeatUsageRequest, opts ...grpc.CallOption) (*GetSeatUsageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSeatUsageResponse)
+	err := c.cc.Invoke(ctx, TenantService_GetSeatUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantServiceClient) TransferOwnership(ctx context.Context, in *TransferOwnershipRequest, opts ...grpc.CallOption) (*TransferOwnershipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransferOwnershipResponse)
+	err := c.cc.Invoke(ctx, TenantService_TransferOwnership_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantServiceClient) GetOwnershipChanges(ctx context.Context, in *GetOwnershipChangesRequest, opts ...grpc.CallOption) (*GetOwnershipChangesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOwnershipChangesResponse)
+	err := c.cc.Invoke(ctx, TenantService_GetOwnershipChanges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantServiceClient) InviteUserToTenant(ctx context.Context, in *InviteUserToTenantRequest, opts ...grpc.CallOption) (*InviteUserToTenantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InviteUserToTenantResponse)
+	err := c.cc.Invoke(ctx, TenantService_InviteUserToTenant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantServiceClient) GetTenantInvitations(ctx context.Context, in *GetTenantInvitationsRequest, opts ...grpc.CallOption) (*GetTenantInvitationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTenantInvitationsResponse)
+	err := c.cc.Invoke(ctx, TenantService_GetTenantInvitations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantServiceClient) GetInvitationByToken(ctx context.Context, in *GetInvitationByTokenRequest, opts ...grpc.CallOption) (*GetInvitationByTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetInvitationByTokenResponse)
+	err := c.cc.Invoke(ctx, TenantService_GetInvitationByToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantServiceClient) AcceptInvitation(ctx context.Context, in *AcceptInvitationRequest, opts ...grpc.CallOption) (*AcceptInvitationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptInvitationResponse)
+	err := c.cc.Invoke(ctx, TenantService_AcceptInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantServiceClient) DeclineInvitation(ctx context.Context, in *DeclineInvitationRequest, opts ...grpc.CallOption) (*DeclineInvitationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeclineInvitationResponse)
+	err := c.cc.Invoke(ctx, TenantService_DeclineInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantServiceClient) RevokeInvitation(ctx context.Context, in *RevokeInvitationRequest, opts ...grpc.CallOption) (*RevokeInvitationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeInvitationResponse)
+	err := c.cc.Invoke(ctx, TenantService_RevokeInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantServiceClient) ResendInvitation(ctx context.Context, in *ResendInvitationRequest, opts ...grpc.CallOption) (*ResendInvitationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResendInvitationResponse)
+	err := c.cc.Invoke(ctx, TenantService_ResendInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantServiceClient) Authorize(ctx context.Context, in *AuthorizeRequest, opts ...grpc.CallOption) (*AuthorizeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthorizeResponse)
+	err := c.cc.Invoke(ctx, TenantService_Authorize_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantServiceClient) AuthorizeRoleChange(ctx context.Context, in *AuthorizeRoleChangeRequest, opts ...grpc.CallOption) (*AuthorizeRoleChangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthorizeRoleChangeResponse)
+	err := c.cc.Invoke(ctx, TenantService_AuthorizeRoleChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantServiceClient) GetPermissions(ctx context.Context, in *GetPermissionsRequest, opts ...grpc.CallOption) (*GetPermissionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPermissionsResponse)
+	err := c.cc.Invoke(ctx, TenantService_GetPermissions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantServiceClient) GetTenantRoles(ctx context.Context, in *GetTenantRolesRequest, opts ...grpc.CallOption) (*GetTenantRolesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTenantRolesResponse)
+	err := c.cc.Invoke(ctx, TenantService_GetTenantRoles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantServiceClient) CreateTenantRole(ctx context.Context, in *CreateTenantRoleRequest, opts ...grpc.CallOption) (*CreateTenantRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateTenantRoleResponse)
+	err := c.cc.Invoke(ctx, TenantService_CreateTenantRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantServiceClient) UpdateTenantRole(ctx context.Context, in *UpdateTenantRoleRequest, opts ...grpc.CallOption) (*UpdateTenantRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateTenantRoleResponse)
+	err := c.cc.Invoke(ctx, TenantService_UpdateTenantRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantServiceClient) DeleteTenantRole(ctx context.Context, in *DeleteTenantRoleRequest, opts ...grpc.CallOption) (*DeleteTenantRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTenantRoleResponse)
+	err := c.cc.Invoke(ctx, TenantService_DeleteTenantRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tenantServiceClient) GetSetting(ctx context.Context, in *GetSettingRequest, opts ...grpc.CallOption) (*GetSettingResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetSettingResponse)
@@ -250,10 +506,15 @@ type TenantServiceServer interface {
 	GetTenantByID(context.Context, *GetTenantByIDRequest) (*GetTenantByIDResponse, error)
 	GetTenantByUUID(context.Context, *GetTenantByUUIDRequest) (*GetTenantByUUIDResponse, error)
 	GetTenantBySlug(context.Context, *GetTenantBySlugRequest) (*GetTenantBySlugResponse, error)
+	GetTenantByDomain(context.Context, *GetTenantByDomainRequest) (*GetTenantByDomainResponse, error)
 	CreateTenant(context.Context, *CreateTenantRequest) (*CreateTenantResponse, error)
 	UpdateTenant(context.Context, *UpdateTenantRequest) (*UpdateTenantResponse, error)
 	DeleteTenant(context.Context, *DeleteTenantRequest) (*DeleteTenantResponse, error)
+	RestoreTenant(context.Context, *RestoreTenantRequest) (*RestoreTenantResponse, error)
 	GetAllTenants(context.Context, *GetAllTenantsRequest) (*GetAllTenantsResponse, error)
+	// Custom domain operations
+	GetDomainVerification(context.Context, *GetDomainVerificationRequest) (*GetDomainVerificationResponse, error)
+	VerifyDomain(context.Context, *VerifyDomainRequest) (*VerifyDomainResponse, error)
 	// TenantUser operations
 	AddUserToTenant(context.Context, *AddUserToTenantRequest) (*AddUserToTenantResponse, error)
 	RemoveUserFromTenant(context.Context, *RemoveUserFromTenantRequest) (*RemoveUserFromTenantResponse, error)
@@ -261,6 +522,26 @@ type TenantServiceServer interface {
 	GetUserTenants(context.Context, *GetUserTenantsRequest) (*GetUserTenantsResponse, error)
 	UpdateUserRole(context.Context, *UpdateUserRoleRequest) (*UpdateUserRoleResponse, error)
 	SetDefaultTenant(context.Context, *SetDefaultTenantRequest) (*SetDefaultTenantResponse, error)
+	GetSeatUsage(context.Context, *GetSeatUsageRequest) (*GetSeatUsageResponse, error)
+	// Ownership operations
+	TransferOwnership(context.Context, *TransferOwnershipRequest) (*TransferOwnershipResponse, error)
+	GetOwnershipChanges(context.Context, *GetOwnershipChangesRequest) (*GetOwnershipChangesResponse, error)
+	// Invitation operations
+	InviteUserToTenant(context.Context, *InviteUserToTenantRequest) (*InviteUserToTenantResponse, error)
+	GetTenantInvitations(context.Context, *GetTenantInvitationsRequest) (*GetTenantInvitationsResponse, error)
+	GetInvitationByToken(context.Context, *GetInvitationByTokenRequest) (*GetInvitationByTokenResponse, error)
+	AcceptInvitation(context.Context, *AcceptInvitationRequest) (*AcceptInvitationResponse, error)
+	DeclineInvitation(context.Context, *DeclineInvitationRequest) (*DeclineInvitationResponse, error)
+	RevokeInvitation(context.Context, *RevokeInvitationRequest) (*RevokeInvitationResponse, error)
+	ResendInvitation(context.Context, *ResendInvitationRequest) (*ResendInvitationResponse, error)
+	// Role and permission operations
+	Authorize(context.Context, *AuthorizeRequest) (*AuthorizeResponse, error)
+	AuthorizeRoleChange(context.Context, *AuthorizeRoleChangeRequest) (*AuthorizeRoleChangeResponse, error)
+	GetPermissions(context.Context, *GetPermissionsRequest) (*GetPermissionsResponse, error)
+	GetTenantRoles(context.Context, *GetTenantRolesRequest) (*GetTenantRolesResponse, error)
+	CreateTenantRole(context.Context, *CreateTenantRoleRequest) (*CreateTenantRoleResponse, error)
+	UpdateTenantRole(context.Context, *UpdateTenantRoleRequest) (*UpdateTenantRoleResponse, error)
+	DeleteTenantRole(context.Context, *DeleteTenantRoleRequest) (*DeleteTenantRoleResponse, error)
 	// TenantSetting operations
 	GetSetting(context.Context, *GetSettingRequest) (*GetSettingResponse, error)
 	GetAllSettings(context.Context, *GetAllSettingsRequest) (*GetAllSettingsResponse, error)
@@ -285,6 +566,9 @@ func (UnimplementedTenantServiceServer) GetTenantByUUID(context.Context, *GetTen
 func (UnimplementedTenantServiceServer) GetTenantBySlug(context.Context, *GetTenantBySlugRequest) (*GetTenantBySlugResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetTenantBySlug not implemented")
 }
+func (UnimplementedTenantServiceServer) GetTenantByDomain(context.Context, *GetTenantByDomainRequest) (*GetTenantByDomainResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTenantByDomain not implemented")
+}
 func (UnimplementedTenantServiceServer) CreateTenant(context.Context, *CreateTenantRequest) (*CreateTenantResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CreateTenant not implemented")
 }
@@ -294,9 +578,18 @@ func (UnimplementedTenantServiceServer) UpdateTenant(context.Context, *UpdateTen
 func (UnimplementedTenantServiceServer) DeleteTenant(context.Context, *DeleteTenantRequest) (*DeleteTenantResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteTenant not implemented")
 }
+func (UnimplementedTenantServiceServer) RestoreTenant(context.Context, *RestoreTenantRequest) (*RestoreTenantResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreTenant not implemented")
+}
 func (UnimplementedTenantServiceServer) GetAllTenants(context.Context, *GetAllTenantsRequest) (*GetAllTenantsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetAllTenants not implemented")
 }
+func (UnimplementedTenantServiceServer) GetDomainVerification(context.Context, *GetDomainVerificationRequest) (*GetDomainVerificationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDomainVerification not implemented")
+}
+func (UnimplementedTenantServiceServer) VerifyDomain(context.Context, *VerifyDomainRequest) (*VerifyDomainResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method VerifyDomain not implemented")
+}
 func (UnimplementedTenantServiceServer) AddUserToTenant(context.Context, *AddUserToTenantRequest) (*AddUserToTenantResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method AddUserToTenant not implemented")
 }
@@ -315,6 +608,57 @@ func (UnimplementedTenantServiceServer) UpdateUserRole(context.Context, *UpdateU
 func (UnimplementedTenantServiceServer) SetDefaultTenant(context.Context, *SetDefaultTenantRequest) (*SetDefaultTenantResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method SetDefaultTenant not implemented")
 }
+func (UnimplementedTenantServiceServer) GetSeatUsage(context.Context, *GetSeatUsageRequest) (*GetSeatUsageResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSeatUsage not implemented")
+}
+func (UnimplementedTenantServiceServer) TransferOwnership(context.Context, *TransferOwnershipRequest) (*TransferOwnershipResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method TransferOwnership not implemented")
+}
+func (UnimplementedTenantServiceServer) GetOwnershipChanges(context.Context, *GetOwnershipChangesRequest) (*GetOwnershipChangesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetOwnershipChanges not implemented")
+}
+func (UnimplementedTenantServiceServer) InviteUserToTenant(context.Context, *InviteUserToTenantRequest) (*InviteUserToTenantResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method InviteUserToTenant not implemented")
+}
+func (UnimplementedTenantServiceServer) GetTenantInvitations(context.Context, *GetTenantInvitationsRequest) (*GetTenantInvitationsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTenantInvitations not implemented")
+}
+func (UnimplementedTenantServiceServer) GetInvitationByToken(context.Context, *GetInvitationByTokenRequest) (*GetInvitationByTokenResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetInvitationByToken not implemented")
+}
+func (UnimplementedTenantServiceServer) AcceptInvitation(context.Context, *AcceptInvitationRequest) (*AcceptInvitationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AcceptInvitation not implemented")
+}
+func (UnimplementedTenantServiceServer) DeclineInvitation(context.Context, *DeclineInvitationRequest) (*DeclineInvitationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeclineInvitation not implemented")
+}
+func (UnimplementedTenantServiceServer) RevokeInvitation(context.Context, *RevokeInvitationRequest) (*RevokeInvitationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeInvitation not implemented")
+}
+func (UnimplementedTenantServiceServer) ResendInvitation(context.Context, *ResendInvitationRequest) (*ResendInvitationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResendInvitation not implemented")
+}
+func (UnimplementedTenantServiceServer) Authorize(context.Context, *AuthorizeRequest) (*AuthorizeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Authorize not implemented")
+}
+func (UnimplementedTenantServiceServer) AuthorizeRoleChange(context.Context, *AuthorizeRoleChangeRequest) (*AuthorizeRoleChangeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AuthorizeRoleChange not implemented")
+}
+func (UnimplementedTenantServiceServer) GetPermissions(context.Context, *GetPermissionsRequest) (*GetPermissionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPermissions not implemented")
+}
+func (UnimplementedTenantServiceServer) GetTenantRoles(context.Context, *GetTenantRolesRequest) (*GetTenantRolesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTenantRoles not implemented")
+}
+func (UnimplementedTenantServiceServer) CreateTenantRole(context.Context, *CreateTenantRoleRequest) (*CreateTenantRoleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateTenantRole not implemented")
+}
+func (UnimplementedTenantServiceServer) UpdateTenantRole(context.Context, *UpdateTenantRoleRequest) (*UpdateTenantRoleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateTenantRole not implemented")
+}
+func (UnimplementedTenantServiceServer) DeleteTenantRole(context.Context, *DeleteTenantRoleRequest) (*DeleteTenantRoleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteTenantRole not implemented")
+}
 func (UnimplementedTenantServiceServer) GetSetting(context.Context, *GetSettingRequest) (*GetSettingResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetSetting not implemented")
 }
@@ -402,6 +746,24 @@ func _TenantService_GetTenantBySlug_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TenantService_GetTenantByDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTenantByDomainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServiceServer).GetTenantByDomain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantService_GetTenantByDomain_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServiceServer).GetTenantByDomain(ctx, req.(*GetTenantByDomainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TenantService_CreateTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateTenantRequest)
 	if err := dec(in); err != nil {
@@ -456,6 +818,24 @@ func _TenantService_DeleteTenant_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TenantService_RestoreTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServiceServer).RestoreTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantService_RestoreTenant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServiceServer).RestoreTenant(ctx, req.(*RestoreTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TenantService_GetAllTenants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetAllTenantsRequest)
 	if err := dec(in); err != nil {
@@ -474,6 +854,42 @@ func _TenantService_GetAllTenants_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TenantService_GetDomainVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDomainVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServiceServer).GetDomainVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantService_GetDomainVerification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServiceServer).GetDomainVerification(ctx, req.(*GetDomainVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenantService_VerifyDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyDomainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServiceServer).VerifyDomain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantService_VerifyDomain_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServiceServer).VerifyDomain(ctx, req.(*VerifyDomainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TenantService_AddUserToTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddUserToTenantRequest)
 	if err := dec(in); err != nil {
@@ -582,6 +998,312 @@ func _TenantService_SetDefaultTenant_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TenantService_GetSeatUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSeatUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServiceServer).GetSeatUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantService_GetSeatUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServiceServer).GetSeatUsage(ctx, req.(*GetSeatUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenantService_TransferOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferOwnershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServiceServer).TransferOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantService_TransferOwnership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServiceServer).TransferOwnership(ctx, req.(*TransferOwnershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenantService_GetOwnershipChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOwnershipChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServiceServer).GetOwnershipChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantService_GetOwnershipChanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServiceServer).GetOwnershipChanges(ctx, req.(*GetOwnershipChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenantService_InviteUserToTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteUserToTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServiceServer).InviteUserToTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantService_InviteUserToTenant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServiceServer).InviteUserToTenant(ctx, req.(*InviteUserToTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenantService_GetTenantInvitations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTenantInvitationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServiceServer).GetTenantInvitations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantService_GetTenantInvitations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServiceServer).GetTenantInvitations(ctx, req.(*GetTenantInvitationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenantService_GetInvitationByToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInvitationByTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServiceServer).GetInvitationByToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantService_GetInvitationByToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServiceServer).GetInvitationByToken(ctx, req.(*GetInvitationByTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenantService_AcceptInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServiceServer).AcceptInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantService_AcceptInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServiceServer).AcceptInvitation(ctx, req.(*AcceptInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenantService_DeclineInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeclineInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServiceServer).DeclineInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantService_DeclineInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServiceServer).DeclineInvitation(ctx, req.(*DeclineInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenantService_RevokeInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServiceServer).RevokeInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantService_RevokeInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServiceServer).RevokeInvitation(ctx, req.(*RevokeInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenantService_ResendInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResendInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServiceServer).ResendInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantService_ResendInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServiceServer).ResendInvitation(ctx, req.(*ResendInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenantService_Authorize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthorizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServiceServer).Authorize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantService_Authorize_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServiceServer).Authorize(ctx, req.(*AuthorizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenantService_AuthorizeRoleChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthorizeRoleChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServiceServer).AuthorizeRoleChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantService_AuthorizeRoleChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServiceServer).AuthorizeRoleChange(ctx, req.(*AuthorizeRoleChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenantService_GetPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServiceServer).GetPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantService_GetPermissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServiceServer).GetPermissions(ctx, req.(*GetPermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenantService_GetTenantRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTenantRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServiceServer).GetTenantRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantService_GetTenantRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServiceServer).GetTenantRoles(ctx, req.(*GetTenantRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenantService_CreateTenantRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTenantRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServiceServer).CreateTenantRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantService_CreateTenantRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServiceServer).CreateTenantRole(ctx, req.(*CreateTenantRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenantService_UpdateTenantRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTenantRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServiceServer).UpdateTenantRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantService_UpdateTenantRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServiceServer).UpdateTenantRole(ctx, req.(*UpdateTenantRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenantService_DeleteTenantRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTenantRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServiceServer).DeleteTenantRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantService_DeleteTenantRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServiceServer).DeleteTenantRole(ctx, req.(*DeleteTenantRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TenantService_GetSetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetSettingRequest)
 	if err := dec(in); err != nil {
@@ -673,6 +1395,10 @@ var TenantService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTenantBySlug",
 			Handler:    _TenantService_GetTenantBySlug_Handler,
 		},
+		{
+			MethodName: "GetTenantByDomain",
+			Handler:    _TenantService_GetTenantByDomain_Handler,
+		},
 		{
 			MethodName: "CreateTenant",
 			Handler:    _TenantService_CreateTenant_Handler,
@@ -685,10 +1411,22 @@ var TenantService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteTenant",
 			Handler:    _TenantService_DeleteTenant_Handler,
 		},
+		{
+			MethodName: "RestoreTenant",
+			Handler:    _TenantService_RestoreTenant_Handler,
+		},
 		{
 			MethodName: "GetAllTenants",
 			Handler:    _TenantService_GetAllTenants_Handler,
 		},
+		{
+			MethodName: "GetDomainVerification",
+			Handler:    _TenantService_GetDomainVerification_Handler,
+		},
+		{
+			MethodName: "VerifyDomain",
+			Handler:    _TenantService_VerifyDomain_Handler,
+		},
 		{
 			MethodName: "AddUserToTenant",
 			Handler:    _TenantService_AddUserToTenant_Handler,
@@ -713,6 +1451,74 @@ var TenantService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetDefaultTenant",
 			Handler:    _TenantService_SetDefaultTenant_Handler,
 		},
+		{
+			MethodName: "GetSeatUsage",
+			Handler:    _TenantService_GetSeatUsage_Handler,
+		},
+		{
+			MethodName: "TransferOwnership",
+			Handler:    _TenantService_TransferOwnership_Handler,
+		},
+		{
+			MethodName: "GetOwnershipChanges",
+			Handler:    _TenantService_GetOwnershipChanges_Handler,
+		},
+		{
+			MethodName: "InviteUserToTenant",
+			Handler:    _TenantService_InviteUserToTenant_Handler,
+		},
+		{
+			MethodName: "GetTenantInvitations",
+			Handler:    _TenantService_GetTenantInvitations_Handler,
+		},
+		{
+			MethodName: "GetInvitationByToken",
+			Handler:    _TenantService_GetInvitationByToken_Handler,
+		},
+		{
+			MethodName: "AcceptInvitation",
+			Handler:    _TenantService_AcceptInvitation_Handler,
+		},
+		{
+			MethodName: "DeclineInvitation",
+			Handler:    _TenantService_DeclineInvitation_Handler,
+		},
+		{
+			MethodName: "RevokeInvitation",
+			Handler:    _TenantService_RevokeInvitation_Handler,
+		},
+		{
+			MethodName: "ResendInvitation",
+			Handler:    _TenantService_ResendInvitation_Handler,
+		},
+		{
+			MethodName: "Authorize",
+			Handler:    _TenantService_Authorize_Handler,
+		},
+		{
+			MethodName: "AuthorizeRoleChange",
+			Handler:    _TenantService_AuthorizeRoleChange_Handler,
+		},
+		{
+			MethodName: "GetPermissions",
+			Handler:    _TenantService_GetPermissions_Handler,
+		},
+		{
+			MethodName: "GetTenantRoles",
+			Handler:    _TenantService_GetTenantRoles_Handler,
+		},
+		{
+			MethodName: "CreateTenantRole",
+			Handler:    _TenantService_CreateTenantRole_Handler,
+		},
+		{
+			MethodName: "UpdateTenantRole",
+			Handler:    _TenantService_UpdateTenantRole_Handler,
+		},
+		{
+			MethodName: "DeleteTenantRole",
+			Handler:    _TenantService_DeleteTenantRole_Handler,
+		},
 		{
 			MethodName: "GetSetting",
 			Handler:    _TenantService_GetSetting_Handler,
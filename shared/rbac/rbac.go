@@ -0,0 +1,118 @@
+package rbac
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// Tenant permissions. tenant-service Authorize checks them against the
+// permissions of a member's role.
+const (
+	PermTenantRead        = "tenant.read"
+	PermTenantUpdate      = "tenant.update"
+	PermTenantDelete      = "tenant.delete"
+	PermMembersRead       = "members.read"
+	PermMembersInvite     = "members.invite"
+	PermMembersRemove     = "members.remove"
+	PermMembersUpdateRole = "members.update_role"
+	PermSettingsRead      = "settings.read"
+	PermSettingsUpdate    = "settings.update"
+	PermRolesManage       = "roles.manage"
+)
+
+// Built-in tenant roles. Every tenant has them; custom roles are defined
+// per tenant.
+const (
+	RoleOwner  = "owner"
+	RoleAdmin  = "admin"
+	RoleMember = "member"
+	RoleGuest  = "guest"
+)
+
+// Permission describes a permission for role editors
+type Permission struct {
+	Key         string
+	Description string
+}
+
+var permissions = []Permission{
+	{PermTenantRead, "View the tenant"},
+	{PermTenantUpdate, "Edit the tenant's name and domain"},
+	{PermTenantDelete, "Delete the tenant"},
+	{PermMembersRead, "View members, invitations and seat usage"},
+	{PermMembersInvite, "Add and invite members"},
+	{PermMembersRemove, "Remove members"},
+	{PermMembersUpdateRole, "Change the role of members"},
+	{PermSettingsRead, "View tenant settings"},
+	{PermSettingsUpdate, "Change tenant settings"},
+	{PermRolesManage, "Create, edit and delete custom roles"},
+}
+
+var builtinRoles = []string{RoleOwner, RoleAdmin, RoleMember, RoleGuest}
+
+var builtinPermissions = map[string][]string{
+	RoleOwner: {
+		PermTenantRead, PermTenantUpdate, PermTenantDelete,
+		PermMembersRead, PermMembersInvite, PermMembersRemove, PermMembersUpdateRole,
+		PermSettingsRead, PermSettingsUpdate, PermRolesManage,
+	},
+	RoleAdmin: {
+		PermTenantRead, PermTenantUpdate,
+		PermMembersRead, PermMembersInvite, PermMembersRemove, PermMembersUpdateRole,
+		PermSettingsRead, PermSettingsUpdate, PermRolesManage,
+	},
+	RoleMember: {PermTenantRead, PermMembersRead, PermSettingsRead},
+	RoleGuest:  {PermTenantRead},
+}
+
+var roleNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,49}$`)
+
+// Permissions lists every permission in display order
+func Permissions() []Permission {
+	result := make([]Permission, len(permissions))
+	copy(result, permissions)
+	return result
+}
+
+// IsValidPermission reports whether key is a known permission
+func IsValidPermission(key string) bool {
+	for _, p := range permissions {
+		if p.Key == key {
+			return true
+		}
+	}
+	return false
+}
+
+// BuiltinRoles lists the built-in roles from most to least privileged
+func BuiltinRoles() []string {
+	result := make([]string, len(builtinRoles))
+	copy(result, builtinRoles)
+	return result
+}
+
+// IsBuiltinRole reports whether role is one of the built-in roles
+func IsBuiltinRole(role string) bool {
+	_, ok := builtinPermissions[role]
+	return ok
+}
+
+// BuiltinPermissions returns the permissions of a built-in role
+func BuiltinPermissions(role string) ([]string, bool) {
+	perms, ok := builtinPermissions[role]
+	if !ok {
+		return nil, false
+	}
+	result := make([]string, len(perms))
+	copy(result, perms)
+	return result, true
+}
+
+// ValidateRoleName checks a custom role name: lowercase letters, digits,
+// '_' and '-', up to 50 characters
+func ValidateRoleName(name string) error {
+	if !roleNamePattern.MatchString(name) {
+		return fmt.Errorf("invalid role name %q: use lowercase letters, digits, '_' or '-'", name)
+	}
+	return nil
+}
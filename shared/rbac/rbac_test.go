@@ -0,0 +1,137 @@
+package rbac
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestBuiltinPermissions(t *testing.T) {
+	tests := []struct {
+		role    string
+		allowed []string
+		denied  []string
+	}{
+		{RoleOwner, []string{PermTenantDelete, PermRolesManage, PermMembersUpdateRole}, nil},
+		{RoleAdmin, []string{PermTenantUpdate, PermMembersInvite, PermRolesManage}, []string{PermTenantDelete}},
+		{RoleMember, []string{PermTenantRead, PermMembersRead, PermSettingsRead}, []string{PermMembersInvite, PermSettingsUpdate, PermRolesManage}},
+		{RoleGuest, []string{PermTenantRead}, []string{PermMembersRead, PermSettingsRead}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.role, func(t *testing.T) {
+			perms, ok := BuiltinPermissions(tt.role)
+			if !ok {
+				t.Fatalf("BuiltinPermissions(%q) ok = false, want true", tt.role)
+			}
+
+			granted := make(map[string]bool, len(perms))
+			for _, perm := range perms {
+				if !IsValidPermission(perm) {
+					t.Errorf("%s has unknown permission %q", tt.role, perm)
+				}
+				granted[perm] = true
+			}
+			for _, perm := range tt.allowed {
+				if !granted[perm] {
+					t.Errorf("%s lacks %q", tt.role, perm)
+				}
+			}
+			for _, perm := range tt.denied {
+				if granted[perm] {
+					t.Errorf("%s has %q", tt.role, perm)
+				}
+			}
+		})
+	}
+}
+
+func TestBuiltinRolesAreOrderedByPrivilege(t *testing.T) {
+	roles := BuiltinRoles()
+	for i := 1; i < len(roles); i++ {
+		higher, _ := BuiltinPermissions(roles[i-1])
+		lower, _ := BuiltinPermissions(roles[i])
+
+		granted := make(map[string]bool, len(higher))
+		for _, perm := range higher {
+			granted[perm] = true
+		}
+		for _, perm := range lower {
+			if !granted[perm] {
+				t.Errorf("%s has %q but %s does not", roles[i], perm, roles[i-1])
+			}
+		}
+	}
+}
+
+func TestBuiltinPermissionsReturnsACopy(t *testing.T) {
+	perms, _ := BuiltinPermissions(RoleGuest)
+	perms[0] = PermTenantDelete
+
+	again, _ := BuiltinPermissions(RoleGuest)
+	if again[0] != PermTenantRead {
+		t.Errorf("BuiltinPermissions() shares its slice: got %q", again[0])
+	}
+}
+
+func TestIsBuiltinRole(t *testing.T) {
+	tests := []struct {
+		role string
+		want bool
+	}{
+		{RoleOwner, true},
+		{RoleGuest, true},
+		{"billing", false},
+		{"Owner", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		if got := IsBuiltinRole(tt.role); got != tt.want {
+			t.Errorf("IsBuiltinRole(%q) = %v, want %v", tt.role, got, tt.want)
+		}
+	}
+	if _, ok := BuiltinPermissions("billing"); ok {
+		t.Error(`BuiltinPermissions("billing") ok = true, want false`)
+	}
+}
+
+func TestIsValidPermission(t *testing.T) {
+	for _, perm := range Permissions() {
+		if !IsValidPermission(perm.Key) {
+			t.Errorf("IsValidPermission(%q) = false, want true", perm.Key)
+		}
+	}
+	for _, perm := range []string{"", "tenant.*", "billing.read"} {
+		if IsValidPermission(perm) {
+			t.Errorf("IsValidPermission(%q) = true, want false", perm)
+		}
+	}
+}
+
+func TestValidateRoleName(t *testing.T) {
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		{"billing", false},
+		{"support-team_2", false},
+		{"9lives", false},
+		{strings.Repeat("a", 50), false},
+		{strings.Repeat("a", 51), true},
+		{"", true},
+		{"Billing", true},
+		{"-billing", true},
+		{"_billing", true},
+		{"billing team", true},
+		{"billing.read", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateRoleName(tt.name)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateRoleName(%q) error = %v, wantErr %v", tt.name, err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,187 @@
+package tenancy
+
+import (
+	"context"
+	"strconv"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// HeaderName is the HTTP header clients use to pick their active tenant
+const HeaderName = "X-Tenant-ID"
+
+// MetadataKey is the gRPC metadata key the gateway forwards the verified
+// active tenant in
+const MetadataKey = "x-tenant-id"
+
+// UserMetadataKey is the gRPC metadata key the gateway forwards the signed-in
+// user's ID in. Calls without it come from other services.
+const UserMetadataKey = "x-user-id"
+
+type contextKey struct{}
+
+type userContextKey struct{}
+
+// scope is what a context is scoped to: one tenant, or every tenant
+type scope struct {
+	tenantID   int64
+	allTenants bool
+}
+
+// WithTenantID returns a context carrying the active tenant. Outgoing gRPC
+// calls made with UnaryClientInterceptor forward it as metadata.
+func WithTenantID(ctx context.Context, tenantID int64) context.Context {
+	return context.WithValue(ctx, contextKey{}, scope{tenantID: tenantID})
+}
+
+// WithoutTenant returns a context that works across tenants on purpose,
+// such as a scheduler or purge job, or a lookup that spans tenants by
+// design. database.TenantDB lets its queries bypass row-level security
+// instead of refusing them. It replaces any active tenant, and a later
+// WithTenantID replaces it.
+func WithoutTenant(ctx context.Context) context.Context {
+	return context.WithValue(ctx, contextKey{}, scope{allTenants: true})
+}
+
+// TenantID returns the active tenant of the context, or 0
+func TenantID(ctx context.Context) int64 {
+	s, _ := ctx.Value(contextKey{}).(scope)
+	return s.tenantID
+}
+
+// AllTenants reports whether ctx was made by WithoutTenant
+func AllTenants(ctx context.Context) bool {
+	s, _ := ctx.Value(contextKey{}).(scope)
+	return s.allTenants
+}
+
+// WithUserID returns a context carrying the signed-in user a call is made
+// for. Outgoing gRPC calls made with UnaryClientInterceptor forward it as
+// metadata.
+func WithUserID(ctx context.Context, userID int64) context.Context {
+	return context.WithValue(ctx, userContextKey{}, userID)
+}
+
+// UserID returns the signed-in user of the context, or 0 for calls made by
+// other services on their own behalf
+func UserID(ctx context.Context) int64 {
+	userID, _ := ctx.Value(userContextKey{}).(int64)
+	return userID
+}
+
+// ResolveTenantID returns the tenant a request works on. With an active
+// tenant, a zero requested ID means the active tenant and any other ID is
+// refused. Calls made for a user must have an active tenant; only internal
+// callers without one may name any tenant.
+func ResolveTenantID(ctx context.Context, requested int64) (int64, error) {
+	active := TenantID(ctx)
+	if active == 0 {
+		if UserID(ctx) > 0 {
+			return 0, status.Errorf(codes.PermissionDenied, "no active tenant: the call must carry %s metadata", MetadataKey)
+		}
+		return requested, nil
+	}
+	if requested == active || requested == 0 {
+		return active, nil
+	}
+	return 0, status.Errorf(codes.PermissionDenied, "tenant %d is not the active tenant", requested)
+}
+
+// Scope resolves the tenant a request works on like ResolveTenantID and
+// returns ctx with it as the active tenant, so later queries and calls are
+// scoped to it
+func Scope(ctx context.Context, requested int64) (context.Context, int64, error) {
+	tenantID, err := ResolveTenantID(ctx, requested)
+	if err != nil {
+		return ctx, 0, err
+	}
+	if tenantID > 0 {
+		ctx = WithTenantID(ctx, tenantID)
+	}
+	return ctx, tenantID, nil
+}
+
+// UnaryClientInterceptor forwards the context's active tenant and user as
+// metadata
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(outgoingContext(ctx), method, req, reply, cc, opts...)
+	}
+}
+
+// StreamClientInterceptor is UnaryClientInterceptor for streaming calls
+func StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return streamer(outgoingContext(ctx), desc, cc, method, opts...)
+	}
+}
+
+func outgoingContext(ctx context.Context) context.Context {
+	if tenantID := TenantID(ctx); tenantID > 0 {
+		ctx = metadata.AppendToOutgoingContext(ctx, MetadataKey, strconv.FormatInt(tenantID, 10))
+	}
+	if userID := UserID(ctx); userID > 0 {
+		ctx = metadata.AppendToOutgoingContext(ctx, UserMetadataKey, strconv.FormatInt(userID, 10))
+	}
+	return ctx
+}
+
+// UnaryServerInterceptor reads the active tenant and user from incoming
+// metadata so handlers can use TenantID and ResolveTenantID. Malformed
+// values are rejected.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := incomingContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor is UnaryServerInterceptor for streaming calls
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := incomingContext(ss.Context())
+		if err != nil {
+			return err
+		}
+		return handler(srv, &scopedStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// scopedStream replaces the context of a server stream
+type scopedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *scopedStream) Context() context.Context {
+	return s.ctx
+}
+
+func incomingContext(ctx context.Context) (context.Context, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx, nil
+	}
+
+	if values := md.Get(MetadataKey); len(values) > 0 {
+		tenantID, err := strconv.ParseInt(values[0], 10, 64)
+		if err != nil || tenantID <= 0 {
+			return ctx, status.Errorf(codes.InvalidArgument, "invalid %s metadata", MetadataKey)
+		}
+		ctx = WithTenantID(ctx, tenantID)
+	}
+	if values := md.Get(UserMetadataKey); len(values) > 0 {
+		userID, err := strconv.ParseInt(values[0], 10, 64)
+		if err != nil || userID <= 0 {
+			return ctx, status.Errorf(codes.InvalidArgument, "invalid %s metadata", UserMetadataKey)
+		}
+		ctx = WithUserID(ctx, userID)
+	}
+	return ctx, nil
+}
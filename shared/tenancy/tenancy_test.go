@@ -0,0 +1,109 @@
+package tenancy
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestResolveTenantID(t *testing.T) {
+	tests := []struct {
+		name      string
+		active    int64
+		userID    int64
+		requested int64
+		want      int64
+		wantErr   bool
+	}{
+		{"active tenant requested", 7, 1, 7, 7, false},
+		{"zero means the active tenant", 7, 1, 0, 7, false},
+		{"other tenant refused", 7, 1, 8, 0, true},
+		{"user without active tenant refused", 0, 1, 8, 0, true},
+		{"user without any tenant refused", 0, 1, 0, 0, true},
+		{"internal caller names any tenant", 0, 0, 8, 8, false},
+		{"internal caller with active tenant", 7, 0, 8, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.active > 0 {
+				ctx = WithTenantID(ctx, tt.active)
+			}
+			if tt.userID > 0 {
+				ctx = WithUserID(ctx, tt.userID)
+			}
+
+			got, err := ResolveTenantID(ctx, tt.requested)
+			if tt.wantErr {
+				if status.Code(err) != codes.PermissionDenied {
+					t.Fatalf("ResolveTenantID() error = %v, want PermissionDenied", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ResolveTenantID() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("ResolveTenantID() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScope(t *testing.T) {
+	ctx, tenantID, err := Scope(context.Background(), 8)
+	if err != nil {
+		t.Fatalf("Scope() error = %v", err)
+	}
+	if tenantID != 8 || TenantID(ctx) != 8 {
+		t.Errorf("Scope() = %d with active tenant %d, want 8", tenantID, TenantID(ctx))
+	}
+}
+
+func TestWithoutTenant(t *testing.T) {
+	ctx := WithoutTenant(WithTenantID(context.Background(), 7))
+	if !AllTenants(ctx) || TenantID(ctx) != 0 {
+		t.Errorf("WithoutTenant() = tenant %d, all tenants %v, want 0, true", TenantID(ctx), AllTenants(ctx))
+	}
+
+	ctx = WithTenantID(ctx, 8)
+	if AllTenants(ctx) || TenantID(ctx) != 8 {
+		t.Errorf("WithTenantID() after WithoutTenant() = tenant %d, all tenants %v, want 8, false", TenantID(ctx), AllTenants(ctx))
+	}
+}
+
+func TestIncomingContext(t *testing.T) {
+	tests := []struct {
+		name       string
+		md         metadata.MD
+		wantTenant int64
+		wantUser   int64
+		wantErr    bool
+	}{
+		{"no metadata", metadata.MD{}, 0, 0, false},
+		{"tenant and user", metadata.Pairs(MetadataKey, "7", UserMetadataKey, "3"), 7, 3, false},
+		{"user only", metadata.Pairs(UserMetadataKey, "3"), 0, 3, false},
+		{"malformed tenant", metadata.Pairs(MetadataKey, "abc"), 0, 0, true},
+		{"zero tenant", metadata.Pairs(MetadataKey, "0"), 0, 0, true},
+		{"malformed user", metadata.Pairs(UserMetadataKey, "-1"), 0, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, err := incomingContext(metadata.NewIncomingContext(context.Background(), tt.md))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("incomingContext() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if TenantID(ctx) != tt.wantTenant || UserID(ctx) != tt.wantUser {
+				t.Errorf("incomingContext() tenant = %d, user = %d, want %d, %d", TenantID(ctx), UserID(ctx), tt.wantTenant, tt.wantUser)
+			}
+		})
+	}
+}
@@ -28,6 +28,22 @@ func StringPtr(s string) *string {
 	return &s
 }
 
+// Int64Value converts *int64 to int64, returns 0 if nil
+func Int64Value(i *int64) int64 {
+	if i == nil {
+		return 0
+	}
+	return *i
+}
+
+// Int64Ptr converts int64 to *int64, returns nil if zero
+func Int64Ptr(i int64) *int64 {
+	if i == 0 {
+		return nil
+	}
+	return &i
+}
+
 // TimeToUnix converts *time.Time to Unix timestamp, returns 0 if nil
 func TimeToUnix(t *time.Time) int64 {
 	if t == nil {